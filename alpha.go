@@ -0,0 +1,206 @@
+// Copyright © 2019 Xavier Basty <xavier@hexbee.net>
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package gocolor
+
+import (
+	"fmt"
+	"image/color"
+	"math"
+
+	"github.com/Hexbee-net/gocolor/internal/interp"
+)
+
+// Blend modes of the W3C Compositing and Blending specification.
+const (
+	BlendNormal     = "normal"
+	BlendMultiply   = "multiply"
+	BlendScreen     = "screen"
+	BlendOverlay    = "overlay"
+	BlendDarken     = "darken"
+	BlendLighten    = "lighten"
+	BlendHardLight  = "hard-light"
+	BlendDifference = "difference"
+	BlendExclusion  = "exclusion"
+)
+
+var blendFunctions = map[string]func(b, s float64) float64{
+	BlendNormal:     func(b, s float64) float64 { return s },
+	BlendMultiply:   func(b, s float64) float64 { return b * s },
+	BlendScreen:     blendScreen,
+	BlendOverlay:    func(b, s float64) float64 { return blendHardLight(s, b) },
+	BlendDarken:     math.Min,
+	BlendLighten:    math.Max,
+	BlendHardLight:  blendHardLight,
+	BlendDifference: func(b, s float64) float64 { return math.Abs(b - s) },
+	BlendExclusion:  func(b, s float64) float64 { return b + s - 2*b*s },
+}
+
+// NRGBA is an sRGB color with a straight (not premultiplied) alpha.
+// All the components are in the [0, 1] range.
+type NRGBA struct {
+	R, G, B, A float64
+}
+
+// RGBA is an sRGB color with a premultiplied alpha: the red, green and blue
+// components are multiplied by the alpha, and are in the [0, A] range.
+type RGBA struct {
+	R, G, B, A float64
+}
+
+// Models for the colors with an alpha channel.
+var (
+	NRGBAModel = color.ModelFunc(nrgbaModel)
+	RGBAModel  = color.ModelFunc(rgbaModel)
+)
+
+// RGBA returns the alpha-premultiplied red, green, blue and alpha values
+// for the color. Components out of the [0, 1] range are clipped.
+func (c NRGBA) RGBA() (r, g, b, a uint32) {
+	return c.Premultiply().RGBA()
+}
+
+// RGBA returns the alpha-premultiplied red, green, blue and alpha values
+// for the color. Components out of the [0, A] range are clipped.
+func (c RGBA) RGBA() (r, g, b, a uint32) {
+	alpha := interp.Clamp01(c.A)
+	v := vector{c.R, c.G, c.B}.mapfunc(func(v float64) float64 {
+		return math.Round(math.Min(interp.Clamp01(v), alpha) * 0xffff)
+	})
+	return uint32(v.v0), uint32(v.v1), uint32(v.v2), uint32(math.Round(alpha * 0xffff))
+}
+
+// Premultiply returns the color with a premultiplied alpha.
+func (c NRGBA) Premultiply() RGBA {
+	return RGBA{c.R * c.A, c.G * c.A, c.B * c.A, c.A}
+}
+
+// Unpremultiply returns the color with a straight alpha. Fully transparent
+// colors are transparent black.
+func (c RGBA) Unpremultiply() NRGBA {
+	if c.A == 0 {
+		return NRGBA{}
+	}
+	return NRGBA{c.R / c.A, c.G / c.A, c.B / c.A, c.A}
+}
+
+// Over returns the color composited over a backdrop, with the Porter-Duff
+// source-over operator.
+func (c RGBA) Over(backdrop RGBA) RGBA {
+	f := 1 - c.A
+	return RGBA{c.R + backdrop.R*f, c.G + backdrop.G*f, c.B + backdrop.B*f, c.A + backdrop.A*f}
+}
+
+// Over returns the color composited over a backdrop, with the Porter-Duff
+// source-over operator.
+func (c NRGBA) Over(backdrop NRGBA) NRGBA {
+	return c.Premultiply().Over(backdrop.Premultiply()).Unpremultiply()
+}
+
+// Blend returns the color blended with a backdrop with one of the Blend
+// modes, then composited over it. The parts of the color over transparent
+// areas of the backdrop keep the color as is, as specified by the W3C
+// Compositing and Blending specification.
+func (c NRGBA) Blend(backdrop NRGBA, mode string) (NRGBA, error) {
+	blend, ok := blendFunctions[mode]
+	if !ok {
+		return NRGBA{}, fmt.Errorf("unrecognized blend mode: %v", mode)
+	}
+
+	mix := func(s, b float64) float64 {
+		s = (1-backdrop.A)*s + backdrop.A*blend(b, s)
+		return c.A*s + backdrop.A*b*(1-c.A)
+	}
+	return RGBA{
+		R: mix(c.R, backdrop.R),
+		G: mix(c.G, backdrop.G),
+		B: mix(c.B, backdrop.B),
+		A: c.A + backdrop.A*(1-c.A),
+	}.Unpremultiply(), nil
+}
+
+// InterpolateNRGBA returns the color at the position t of the [0, 1] range
+// between two colors. The interpolation is done on premultiplied components,
+// so that the color of transparent colors does not leak into the result.
+func InterpolateNRGBA(c1, c2 NRGBA, t float64) NRGBA {
+	p1, p2 := c1.Premultiply(), c2.Premultiply()
+	lerp := func(a, b float64) float64 {
+		return a + t*(b-a)
+	}
+	return RGBA{lerp(p1.R, p2.R), lerp(p1.G, p2.G), lerp(p1.B, p2.B), lerp(p1.A, p2.A)}.Unpremultiply()
+}
+
+// ColorToNRGBA converts any color.Color to sRGB coordinates with a straight
+// alpha, in the [0, 1] range. Colors without an alpha channel are opaque.
+func ColorToNRGBA(c color.Color) NRGBA {
+	v, alpha := colorToNRGBA(c)
+	return NRGBA{v.v0, v.v1, v.v2, alpha}
+}
+
+// NRGBA returns the color as an sRGB color with a straight alpha. Colors out
+// of the sRGB gamut are clipped, and a missing alpha is transparent.
+func (c CSSColor) NRGBA() (NRGBA, error) {
+	r, g, b, err := c.SRGB()
+	if err != nil {
+		return NRGBA{}, err
+	}
+	return NRGBA{interp.Clamp01(r), interp.Clamp01(g), interp.Clamp01(b), interp.Clamp01(c.Alpha)}, nil
+}
+
+////////////////////////////////////////
+
+func blendScreen(b, s float64) float64 {
+	return b + s - b*s
+}
+
+func blendHardLight(b, s float64) float64 {
+	if s <= 0.5 {
+		return b * 2 * s
+	}
+	return blendScreen(b, 2*s-1)
+}
+
+func nrgbaModel(c color.Color) color.Color {
+	if _, ok := c.(NRGBA); ok {
+		return c
+	}
+	return ColorToNRGBA(c)
+}
+
+func rgbaModel(c color.Color) color.Color {
+	if _, ok := c.(RGBA); ok {
+		return c
+	}
+	return ColorToNRGBA(c).Premultiply()
+}
+
+// colorToNRGBA converts any color.Color to sRGB coordinates and a straight
+// alpha, clipped to the [0, 1] range.
+func colorToNRGBA(c color.Color) (vector, float64) {
+	switch c := c.(type) {
+	case NRGBA:
+		return colorToRGB(c), interp.Clamp01(c.A)
+	case RGBA:
+		return colorToRGB(c), interp.Clamp01(c.A)
+	case color.NRGBA64:
+		return colorToRGB(c), float64(c.A) / 0xffff
+	case color.NRGBA:
+		return colorToRGB(c), float64(c.A) / 0xff
+	case xyzColor, HSL, HSV, color.YCbCr:
+		return colorToRGB(c), 1
+	default:
+		_, _, _, a := c.RGBA()
+		return colorToRGB(c), float64(a) / 0xffff
+	}
+}
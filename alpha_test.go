@@ -0,0 +1,179 @@
+// Copyright © 2019 Xavier Basty <xavier@hexbee.net>
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package gocolor_test
+
+import (
+	"image/color"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/Hexbee-net/gocolor"
+)
+
+func TestHEXtoRGBA(t *testing.T) {
+	tests := []struct {
+		from string
+		to   []float64
+	}{
+		{"#123456", []float64{0.07058824, 0.20392157, 0.33725490, 1}},
+		{"#12345680", []float64{0.07058824, 0.20392157, 0.33725490, 0.50196078}},
+		{"#a008", []float64{0.66666666, 0, 0, 0.53333333}},
+		{"a00", []float64{0.66666666, 0, 0, 1}},
+		{"red", []float64{1, 0, 0, 1}},
+	}
+
+	for _, test := range tests {
+		r, g, b, a, err := gocolor.HEXtoRGBA(test.from)
+		require.NoError(t, err, test.from)
+		assert.InDeltaSlice(t, test.to, []float64{r, g, b, a}, 1e-8, test.from)
+	}
+
+	_, _, _, _, err := gocolor.HEXtoRGBA("#1234567")
+	assert.Error(t, err)
+}
+
+func TestRGBAtoHEX(t *testing.T) {
+	hex, err := gocolor.RGBAtoHEX(1, 0.5, 0, 0.5)
+	require.NoError(t, err)
+	assert.Equal(t, "#FF800080", hex)
+
+	r, g, b, a, err := gocolor.HEXtoRGBA(hex)
+	require.NoError(t, err)
+	hex2, err := gocolor.RGBAtoHEX(r, g, b, a)
+	require.NoError(t, err)
+	assert.Equal(t, hex, hex2)
+
+	_, err = gocolor.RGBAtoHEX(1, 0, 0, 2)
+	assert.Error(t, err)
+	_, err = gocolor.RGBAtoHEX(2, 0, 0, 1)
+	assert.Error(t, err)
+}
+
+func TestPremultiply(t *testing.T) {
+	c := gocolor.NRGBA{R: 1, G: 0.5, B: 0.25, A: 0.5}
+	p := c.Premultiply()
+	assert.Equal(t, gocolor.RGBA{R: 0.5, G: 0.25, B: 0.125, A: 0.5}, p)
+	assert.Equal(t, c, p.Unpremultiply())
+	assert.Equal(t, gocolor.NRGBA{}, gocolor.RGBA{}.Unpremultiply())
+
+	r, g, b, a := c.RGBA()
+	assert.Equal(t, []uint32{0x8000, 0x4000, 0x2000, 0x8000}, []uint32{r, g, b, a})
+
+	nrgba := gocolor.NRGBAModel.Convert(color.NRGBA{R: 0xff, G: 0x80, B: 0, A: 0x80}).(gocolor.NRGBA)
+	assert.InDeltaSlice(t, []float64{1, 0x80 / 255.0, 0, 0x80 / 255.0}, []float64{nrgba.R, nrgba.G, nrgba.B, nrgba.A}, 1e-9)
+
+	rgba := gocolor.RGBAModel.Convert(color.RGBA{R: 0x40, G: 0, B: 0, A: 0x80}).(gocolor.RGBA)
+	assert.InDelta(t, 0x40/255.0, rgba.R, 1e-9)
+	assert.InDelta(t, 0x80/255.0, rgba.A, 1e-9)
+
+	// Colors without an alpha channel are opaque.
+	assert.Equal(t, 1.0, gocolor.ColorToNRGBA(gocolor.Lab{L: 50}).A)
+	assert.Equal(t, 1.0, gocolor.ColorToNRGBA(color.Gray{Y: 0x80}).A)
+}
+
+func TestOver(t *testing.T) {
+	red := gocolor.NRGBA{R: 1, A: 0.5}
+	blue := gocolor.NRGBA{B: 1, A: 1}
+
+	c := red.Over(blue)
+	assert.InDeltaSlice(t, []float64{0.5, 0, 0.5, 1}, []float64{c.R, c.G, c.B, c.A}, 1e-12)
+
+	// The color of a transparent backdrop does not leak into the result.
+	c = red.Over(gocolor.NRGBA{G: 1})
+	assert.Equal(t, red, c)
+
+	c = red.Over(gocolor.NRGBA{B: 1, A: 0.5})
+	assert.InDeltaSlice(t, []float64{2.0 / 3, 0, 1.0 / 3, 0.75}, []float64{c.R, c.G, c.B, c.A}, 1e-12)
+}
+
+func TestBlend(t *testing.T) {
+	src := gocolor.NRGBA{R: 1, G: 0.5, B: 0.25, A: 1}
+	backdrop := gocolor.NRGBA{R: 0.5, G: 0.5, B: 0.5, A: 1}
+
+	tests := []struct {
+		mode string
+		to   []float64
+	}{
+		{gocolor.BlendNormal, []float64{1, 0.5, 0.25, 1}},
+		{gocolor.BlendMultiply, []float64{0.5, 0.25, 0.125, 1}},
+		{gocolor.BlendScreen, []float64{1, 0.75, 0.625, 1}},
+		{gocolor.BlendOverlay, []float64{1, 0.5, 0.25, 1}},
+		{gocolor.BlendDarken, []float64{0.5, 0.5, 0.25, 1}},
+		{gocolor.BlendLighten, []float64{1, 0.5, 0.5, 1}},
+		{gocolor.BlendHardLight, []float64{1, 0.5, 0.25, 1}},
+		{gocolor.BlendDifference, []float64{0.5, 0, 0.25, 1}},
+		{gocolor.BlendExclusion, []float64{0.5, 0.5, 0.5, 1}},
+	}
+	for _, test := range tests {
+		c, err := src.Blend(backdrop, test.mode)
+		require.NoError(t, err, test.mode)
+		assert.InDeltaSlice(t, test.to, []float64{c.R, c.G, c.B, c.A}, 1e-12, test.mode)
+	}
+
+	// Blending over a transparent backdrop keeps the source color.
+	c, err := src.Blend(gocolor.NRGBA{}, gocolor.BlendMultiply)
+	require.NoError(t, err)
+	assert.Equal(t, src, c)
+
+	// Blending with a transparent source keeps the backdrop.
+	c, err = gocolor.NRGBA{R: 1}.Blend(backdrop, gocolor.BlendDifference)
+	require.NoError(t, err)
+	assert.Equal(t, backdrop, c)
+
+	_, err = src.Blend(backdrop, "unknown")
+	assert.Error(t, err)
+}
+
+func TestInterpolateNRGBA(t *testing.T) {
+	red := gocolor.NRGBA{R: 1, A: 1}
+	transparent := gocolor.NRGBA{B: 1}
+
+	// The color of the transparent color does not leak into the result.
+	c := gocolor.InterpolateNRGBA(red, transparent, 0.5)
+	assert.InDeltaSlice(t, []float64{1, 0, 0, 0.5}, []float64{c.R, c.G, c.B, c.A}, 1e-12)
+
+	c = gocolor.InterpolateNRGBA(red, gocolor.NRGBA{B: 1, A: 0.5}, 0.5)
+	assert.InDeltaSlice(t, []float64{2.0 / 3, 0, 1.0 / 3, 0.75}, []float64{c.R, c.G, c.B, c.A}, 1e-12)
+
+	assert.Equal(t, red, gocolor.InterpolateNRGBA(red, transparent, 0))
+}
+
+func TestCSSColorNRGBA(t *testing.T) {
+	c, err := gocolor.ParseCSSColor("#ff000080")
+	require.NoError(t, err)
+	n, err := c.NRGBA()
+	require.NoError(t, err)
+	assert.InDeltaSlice(t, []float64{1, 0, 0, 0x80 / 255.0}, []float64{n.R, n.G, n.B, n.A}, 1e-9)
+}
+
+func TestTransformApplySliceAlpha(t *testing.T) {
+	tr, err := gocolor.NewTransform(gocolor.SRGB, gocolor.CIEXYZ, gocolor.Observer2, gocolor.RefIlluminantD65, "")
+	require.NoError(t, err)
+
+	x, y, z := tr.Apply(1, 0.5, 0.25)
+
+	dst := make([]float64, 8)
+	require.NoError(t, tr.ApplySliceAlpha(dst, []float64{1, 0.5, 0.25, 0.5, 1, 1, 1, 0}, false))
+	assert.InDeltaSlice(t, []float64{x, y, z, 0.5}, dst[:4], 1e-12)
+
+	require.NoError(t, tr.ApplySliceAlpha(dst, []float64{0.5, 0.25, 0.125, 0.5, 1, 1, 1, 0}, true))
+	assert.InDeltaSlice(t, []float64{x / 2, y / 2, z / 2, 0.5}, dst[:4], 1e-12)
+	assert.Equal(t, []float64{0, 0, 0, 0}, dst[4:])
+
+	assert.Error(t, tr.ApplySliceAlpha(dst, make([]float64, 3), false))
+	assert.Error(t, tr.ApplySliceAlpha(dst[:4], dst, false))
+}
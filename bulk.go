@@ -0,0 +1,369 @@
+// Copyright © 2019 Xavier Basty <xavier@hexbee.net>
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package gocolor
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"sync"
+
+	"github.com/Hexbee-net/gocolor/internal/interp"
+)
+
+// The batch conversions below process many colors at once: the color space
+// is resolved a single time, and the values are not range checked.
+// Interleaved buffers store the three coordinates of each color one after
+// the other, planar buffers store each coordinate in its own slice.
+// The XYZ coordinates use the white point of the RGB color space.
+
+// RGBtoXYZSlice converts interleaved RGB coordinates to XYZ.
+func RGBtoXYZSlice(dst, src []float64, space string) error {
+	s, err := getRGBSpace(space)
+	if err != nil {
+		return err
+	}
+	if err := checkInterleaved(len(dst), len(src)); err != nil {
+		return err
+	}
+
+	for i := 0; i+2 < len(src); i += 3 {
+		v := s.toXYZ.vdot(s.decode(vector{src[i], src[i+1], src[i+2]}))
+		dst[i], dst[i+1], dst[i+2] = v.v0, v.v1, v.v2
+	}
+
+	return nil
+}
+
+// XYZtoRGBSlice converts interleaved XYZ coordinates to RGB.
+func XYZtoRGBSlice(dst, src []float64, space string) error {
+	s, err := getRGBSpace(space)
+	if err != nil {
+		return err
+	}
+	if err := checkInterleaved(len(dst), len(src)); err != nil {
+		return err
+	}
+
+	for i := 0; i+2 < len(src); i += 3 {
+		v := s.fromXYZ.vdot(vector{src[i], src[i+1], src[i+2]})
+		v = s.encode(v)
+		dst[i], dst[i+1], dst[i+2] = v.v0, v.v1, v.v2
+	}
+
+	return nil
+}
+
+// RGBtoXYZSlice32 converts interleaved RGB coordinates to XYZ.
+func RGBtoXYZSlice32(dst, src []float32, space string) error {
+	s, err := getRGBSpace(space)
+	if err != nil {
+		return err
+	}
+	if err := checkInterleaved(len(dst), len(src)); err != nil {
+		return err
+	}
+
+	for i := 0; i+2 < len(src); i += 3 {
+		v := s.toXYZ.vdot(s.decode(vector{float64(src[i]), float64(src[i+1]), float64(src[i+2])}))
+		dst[i], dst[i+1], dst[i+2] = float32(v.v0), float32(v.v1), float32(v.v2)
+	}
+
+	return nil
+}
+
+// XYZtoRGBSlice32 converts interleaved XYZ coordinates to RGB.
+func XYZtoRGBSlice32(dst, src []float32, space string) error {
+	s, err := getRGBSpace(space)
+	if err != nil {
+		return err
+	}
+	if err := checkInterleaved(len(dst), len(src)); err != nil {
+		return err
+	}
+
+	for i := 0; i+2 < len(src); i += 3 {
+		v := s.fromXYZ.vdot(vector{float64(src[i]), float64(src[i+1]), float64(src[i+2])})
+		v = s.encode(v)
+		dst[i], dst[i+1], dst[i+2] = float32(v.v0), float32(v.v1), float32(v.v2)
+	}
+
+	return nil
+}
+
+// RGBtoXYZPlanes converts planar RGB coordinates to XYZ.
+func RGBtoXYZPlanes(dst, src [3][]float64, space string) error {
+	s, err := getRGBSpace(space)
+	if err != nil {
+		return err
+	}
+	n, err := checkPlanes(dst, src)
+	if err != nil {
+		return err
+	}
+
+	for i := 0; i < n; i++ {
+		v := s.toXYZ.vdot(s.decode(vector{src[0][i], src[1][i], src[2][i]}))
+		dst[0][i], dst[1][i], dst[2][i] = v.v0, v.v1, v.v2
+	}
+
+	return nil
+}
+
+// XYZtoRGBPlanes converts planar XYZ coordinates to RGB.
+func XYZtoRGBPlanes(dst, src [3][]float64, space string) error {
+	s, err := getRGBSpace(space)
+	if err != nil {
+		return err
+	}
+	n, err := checkPlanes(dst, src)
+	if err != nil {
+		return err
+	}
+
+	for i := 0; i < n; i++ {
+		v := s.fromXYZ.vdot(vector{src[0][i], src[1][i], src[2][i]})
+		v = s.encode(v)
+		dst[0][i], dst[1][i], dst[2][i] = v.v0, v.v1, v.v2
+	}
+
+	return nil
+}
+
+// RGBtoXYZPlanes32 converts planar RGB coordinates to XYZ.
+func RGBtoXYZPlanes32(dst, src [3][]float32, space string) error {
+	s, err := getRGBSpace(space)
+	if err != nil {
+		return err
+	}
+	n, err := checkPlanes32(dst, src)
+	if err != nil {
+		return err
+	}
+
+	for i := 0; i < n; i++ {
+		v := s.toXYZ.vdot(s.decode(vector{float64(src[0][i]), float64(src[1][i]), float64(src[2][i])}))
+		dst[0][i], dst[1][i], dst[2][i] = float32(v.v0), float32(v.v1), float32(v.v2)
+	}
+
+	return nil
+}
+
+// XYZtoRGBPlanes32 converts planar XYZ coordinates to RGB.
+func XYZtoRGBPlanes32(dst, src [3][]float32, space string) error {
+	s, err := getRGBSpace(space)
+	if err != nil {
+		return err
+	}
+	n, err := checkPlanes32(dst, src)
+	if err != nil {
+		return err
+	}
+
+	for i := 0; i < n; i++ {
+		v := s.fromXYZ.vdot(vector{float64(src[0][i]), float64(src[1][i]), float64(src[2][i])})
+		v = s.encode(v)
+		dst[0][i], dst[1][i], dst[2][i] = float32(v.v0), float32(v.v1), float32(v.v2)
+	}
+
+	return nil
+}
+
+// RGB8toXYZSlice converts interleaved 8 bits RGB values to XYZ coordinates,
+// using a lookup table for the transfer function of the color space.
+func RGB8toXYZSlice(dst []float64, src []uint8, space string) error {
+	s, err := getRGBSpace(space)
+	if err != nil {
+		return err
+	}
+	if err := checkInterleaved(len(dst), len(src)); err != nil {
+		return err
+	}
+
+	lut := decodingLUTs(space, 8, s)
+	for i := 0; i+2 < len(src); i += 3 {
+		v := s.toXYZ.vdot(vector{lut[0][src[i]], lut[1][src[i+1]], lut[2][src[i+2]]})
+		dst[i], dst[i+1], dst[i+2] = v.v0, v.v1, v.v2
+	}
+
+	return nil
+}
+
+// RGB16toXYZSlice converts interleaved 16 bits RGB values to XYZ coordinates,
+// using a lookup table for the transfer function of the color space.
+func RGB16toXYZSlice(dst []float64, src []uint16, space string) error {
+	s, err := getRGBSpace(space)
+	if err != nil {
+		return err
+	}
+	if err := checkInterleaved(len(dst), len(src)); err != nil {
+		return err
+	}
+
+	lut := decodingLUTs(space, 16, s)
+	for i := 0; i+2 < len(src); i += 3 {
+		v := s.toXYZ.vdot(vector{lut[0][src[i]], lut[1][src[i+1]], lut[2][src[i+2]]})
+		dst[i], dst[i+1], dst[i+2] = v.v0, v.v1, v.v2
+	}
+
+	return nil
+}
+
+// XYZtoRGB8Slice converts interleaved XYZ coordinates to 8 bits RGB values,
+// using a lookup table for the transfer function of the color space.
+// Colors out of the gamut of the color space are clipped.
+func XYZtoRGB8Slice(dst []uint8, src []float64, space string) error {
+	s, err := getRGBSpace(space)
+	if err != nil {
+		return err
+	}
+	if err := checkInterleaved(len(dst), len(src)); err != nil {
+		return err
+	}
+
+	lut := encodingLUTs8(space, s)
+	index := func(v float64) int {
+		return int(interp.Clamp01(v)*encodingLUTMax + 0.5)
+	}
+	for i := 0; i+2 < len(src); i += 3 {
+		v := s.fromXYZ.vdot(vector{src[i], src[i+1], src[i+2]})
+		dst[i], dst[i+1], dst[i+2] = lut[0][index(v.v0)], lut[1][index(v.v1)], lut[2][index(v.v2)]
+	}
+
+	return nil
+}
+
+// XYZtoRGB16Slice converts interleaved XYZ coordinates to 16 bits RGB values.
+// Colors out of the gamut of the color space are clipped.
+//
+// A lookup table on linear values would lack precision in the dark tones for
+// 16 bits values, so the transfer function is always evaluated.
+func XYZtoRGB16Slice(dst []uint16, src []float64, space string) error {
+	s, err := getRGBSpace(space)
+	if err != nil {
+		return err
+	}
+	if err := checkInterleaved(len(dst), len(src)); err != nil {
+		return err
+	}
+
+	for i := 0; i+2 < len(src); i += 3 {
+		v := s.fromXYZ.vdot(vector{src[i], src[i+1], src[i+2]})
+		v = s.encode(v.mapfunc(interp.Clamp01))
+		dst[i], dst[i+1], dst[i+2] = uint16(quantize(v.v0, 0.5)), uint16(quantize(v.v1, 0.5)), uint16(quantize(v.v2, 0.5))
+	}
+
+	return nil
+}
+
+////////////////////////////////////////
+
+// Largest index of the encoding lookup tables, indexed by linear values.
+const encodingLUTMax = 0xffff
+
+// Lookup tables of the transfer functions, built on first use.
+var transferLUTs sync.Map
+
+type lutKey struct {
+	space string
+	bits  int
+}
+
+// dropTransferLUTs removes the lookup tables of a color space.
+func dropTransferLUTs(space string) {
+	transferLUTs.Range(func(key, _ interface{}) bool {
+		if key.(lutKey).space == space {
+			transferLUTs.Delete(key)
+		}
+		return true
+	})
+}
+
+// decodingLUTs returns the linear values of all the integer values with the
+// given number of bits, for each channel.
+func decodingLUTs(space string, bits int, s rgbSpace) [3][]float64 {
+	key := lutKey{space, bits}
+	if lut, ok := transferLUTs.Load(key); ok {
+		return lut.([3][]float64)
+	}
+
+	n := 1 << uint(bits)
+	var lut [3][]float64
+	for c := range lut {
+		lut[c] = make([]float64, n)
+		for i := range lut[c] {
+			lut[c][i] = s.linearize[c](float64(i) / float64(n-1))
+		}
+	}
+
+	transferLUTs.Store(key, lut)
+	return lut
+}
+
+// encodingLUTs8 returns the 8 bits values of linear values sampled on 16 bits,
+// for each channel.
+func encodingLUTs8(space string, s rgbSpace) [3][]uint8 {
+	key := lutKey{space, -8}
+	if lut, ok := transferLUTs.Load(key); ok {
+		return lut.([3][]uint8)
+	}
+
+	var lut [3][]uint8
+	for c := range lut {
+		lut[c] = make([]uint8, encodingLUTMax+1)
+		for i := range lut[c] {
+			v := s.compand[c](float64(i) / encodingLUTMax)
+			lut[c][i] = uint8(math.Min(math.Max(math.Round(v*0xff), 0), 0xff))
+		}
+	}
+
+	transferLUTs.Store(key, lut)
+	return lut
+}
+
+func checkInterleaved(dst, src int) error {
+	if src%3 != 0 {
+		return fmt.Errorf("source length is not a multiple of 3 (%v)", src)
+	}
+	if dst < src {
+		return fmt.Errorf("destination is shorter than the source (%v < %v)", dst, src)
+	}
+	return nil
+}
+
+func checkPlanes(dst, src [3][]float64) (int, error) {
+	return checkPlaneLengths(
+		[3]int{len(dst[0]), len(dst[1]), len(dst[2])},
+		[3]int{len(src[0]), len(src[1]), len(src[2])})
+}
+
+func checkPlanes32(dst, src [3][]float32) (int, error) {
+	return checkPlaneLengths(
+		[3]int{len(dst[0]), len(dst[1]), len(dst[2])},
+		[3]int{len(src[0]), len(src[1]), len(src[2])})
+}
+
+func checkPlaneLengths(dst, src [3]int) (int, error) {
+	n := src[0]
+	for c := 0; c < 3; c++ {
+		if src[c] != n {
+			return 0, errors.New("mismatching source plane lengths")
+		}
+		if dst[c] < n {
+			return 0, fmt.Errorf("destination plane is shorter than the source (%v < %v)", dst[c], n)
+		}
+	}
+	return n, nil
+}
@@ -0,0 +1,206 @@
+// Copyright © 2019 Xavier Basty <xavier@hexbee.net>
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package gocolor_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/Hexbee-net/gocolor"
+)
+
+// bulkPixels returns interleaved 8 bits RGB values covering the RGB cube.
+func bulkPixels(n int) []uint8 {
+	pix := make([]uint8, 3*n)
+	for i := range pix {
+		pix[i] = uint8(i * 37 % 256)
+	}
+	return pix
+}
+
+func TestRGBtoXYZSlice(t *testing.T) {
+	spaces := []string{gocolor.SRGB, gocolor.AdobeRGB, gocolor.BT2020}
+	pix := bulkPixels(100)
+
+	for _, space := range spaces {
+		src := make([]float64, len(pix))
+		src32 := make([]float32, len(pix))
+		src16 := make([]uint16, len(pix))
+		var planes [3][]float64
+		var planes32 [3][]float32
+		for i, v := range pix {
+			src[i] = float64(v) / 0xff
+			src32[i] = float32(src[i])
+			src16[i] = uint16(v) * 0x101
+			planes[i%3] = append(planes[i%3], src[i])
+			planes32[i%3] = append(planes32[i%3], src32[i])
+		}
+
+		xyz := make([]float64, len(src))
+		assert.NoError(t, gocolor.RGBtoXYZSlice(xyz, src, space))
+		xyz32 := make([]float32, len(src))
+		assert.NoError(t, gocolor.RGBtoXYZSlice32(xyz32, src32, space))
+		xyz8 := make([]float64, len(src))
+		assert.NoError(t, gocolor.RGB8toXYZSlice(xyz8, pix, space))
+		xyz16 := make([]float64, len(src))
+		assert.NoError(t, gocolor.RGB16toXYZSlice(xyz16, src16, space))
+		xyzPlanes := [3][]float64{make([]float64, 100), make([]float64, 100), make([]float64, 100)}
+		assert.NoError(t, gocolor.RGBtoXYZPlanes(xyzPlanes, planes, space))
+		xyzPlanes32 := [3][]float32{make([]float32, 100), make([]float32, 100), make([]float32, 100)}
+		assert.NoError(t, gocolor.RGBtoXYZPlanes32(xyzPlanes32, planes32, space))
+
+		for i := 0; i < len(src); i += 3 {
+			x, y, z, err := gocolor.RGBtoXYZ(src[i], src[i+1], src[i+2], space)
+			assert.NoError(t, err)
+			for c, v := range []float64{x, y, z} {
+				assert.InDeltaf(t, v, xyz[i+c], 1e-12, "XYZ is wrong for %v", space)
+				assert.InDeltaf(t, v, float64(xyz32[i+c]), 1e-6, "XYZ (float32) is wrong for %v", space)
+				assert.InDeltaf(t, v, xyz8[i+c], 1e-12, "XYZ (8 bits) is wrong for %v", space)
+				assert.InDeltaf(t, v, xyz16[i+c], 1e-12, "XYZ (16 bits) is wrong for %v", space)
+				assert.InDeltaf(t, v, xyzPlanes[c][i/3], 1e-12, "XYZ (planar) is wrong for %v", space)
+				assert.InDeltaf(t, v, float64(xyzPlanes32[c][i/3]), 1e-6, "XYZ (planar float32) is wrong for %v", space)
+			}
+		}
+
+		rgb := make([]float64, len(src))
+		assert.NoError(t, gocolor.XYZtoRGBSlice(rgb, xyz, space))
+		rgb32 := make([]float32, len(src))
+		assert.NoError(t, gocolor.XYZtoRGBSlice32(rgb32, xyz32, space))
+		rgb8 := make([]uint8, len(src))
+		assert.NoError(t, gocolor.XYZtoRGB8Slice(rgb8, xyz, space))
+		rgb16 := make([]uint16, len(src))
+		assert.NoError(t, gocolor.XYZtoRGB16Slice(rgb16, xyz, space))
+		rgbPlanes := [3][]float64{make([]float64, 100), make([]float64, 100), make([]float64, 100)}
+		assert.NoError(t, gocolor.XYZtoRGBPlanes(rgbPlanes, xyzPlanes, space))
+		rgbPlanes32 := [3][]float32{make([]float32, 100), make([]float32, 100), make([]float32, 100)}
+		assert.NoError(t, gocolor.XYZtoRGBPlanes32(rgbPlanes32, xyzPlanes32, space))
+
+		for i := 0; i < len(src); i += 3 {
+			r, g, b, err := gocolor.XYZtoRGB(xyz[i], xyz[i+1], xyz[i+2], space)
+			if err != nil {
+				// The white point of the space is out of the range accepted by XYZtoRGB.
+				continue
+			}
+			for c, v := range []float64{r, g, b} {
+				assert.InDeltaf(t, v, rgb[i+c], 1e-12, "RGB is wrong for %v", space)
+				assert.InDeltaf(t, v, float64(rgb32[i+c]), 1e-3, "RGB (float32) is wrong for %v", space)
+				assert.InDeltaf(t, v, rgbPlanes[c][i/3], 1e-12, "RGB (planar) is wrong for %v", space)
+				assert.InDeltaf(t, v, float64(rgbPlanes32[c][i/3]), 1e-3, "RGB (planar float32) is wrong for %v", space)
+			}
+		}
+		// Pure gamma curves amplify the rounding of the tabulated matrices in the dark tones.
+		for i := range src {
+			assert.InDeltaf(t, pix[i], rgb8[i], 1, "RGB (8 bits) is wrong for %v", space)
+			assert.InDeltaf(t, src16[i], rgb16[i], 32, "RGB (16 bits) is wrong for %v", space)
+		}
+	}
+}
+
+func TestRGBtoXYZSlice_InvalidParameters(t *testing.T) {
+	buf := make([]float64, 6)
+
+	assert.Error(t, gocolor.RGBtoXYZSlice(buf, buf, "unknown"))
+	assert.Error(t, gocolor.RGBtoXYZSlice(buf, buf[:5], gocolor.SRGB))
+	assert.Error(t, gocolor.RGBtoXYZSlice(buf[:3], buf, gocolor.SRGB))
+	assert.Error(t, gocolor.XYZtoRGBSlice(buf[:3], buf, gocolor.SRGB))
+	assert.Error(t, gocolor.RGB8toXYZSlice(buf, make([]uint8, 4), gocolor.SRGB))
+	assert.Error(t, gocolor.XYZtoRGB8Slice(make([]uint8, 3), buf, gocolor.SRGB))
+	assert.Error(t, gocolor.RGBtoXYZPlanes([3][]float64{buf, buf, buf}, [3][]float64{buf, buf, buf[:1]}, gocolor.SRGB))
+	assert.Error(t, gocolor.XYZtoRGBPlanes([3][]float64{buf, buf, buf[:1]}, [3][]float64{buf, buf, buf}, gocolor.SRGB))
+
+	buf32 := make([]float32, 6)
+	assert.Error(t, gocolor.RGBtoXYZPlanes32([3][]float32{buf32, buf32, buf32}, [3][]float32{buf32, buf32, buf32}, "unknown"))
+	assert.Error(t, gocolor.RGBtoXYZPlanes32([3][]float32{buf32, buf32, buf32}, [3][]float32{buf32[:1], buf32, buf32}, gocolor.SRGB))
+	assert.Error(t, gocolor.XYZtoRGBPlanes32([3][]float32{buf32[:1], buf32, buf32}, [3][]float32{buf32, buf32, buf32}, gocolor.SRGB))
+
+	// Out of gamut colors are clipped when quantized.
+	rgb8 := make([]uint8, 3)
+	assert.NoError(t, gocolor.XYZtoRGB8Slice(rgb8, []float64{0.1, 0.5, 0.05}, gocolor.SRGB))
+	assert.Equal(t, uint8(0), rgb8[0])
+}
+
+////////////////////////////////////////
+
+const benchPixels = 1 << 16
+
+func BenchmarkRGBtoXYZ(b *testing.B) {
+	pix := bulkPixels(benchPixels)
+	b.SetBytes(int64(len(pix)))
+	b.ResetTimer()
+	for n := 0; n < b.N; n++ {
+		for i := 0; i < len(pix); i += 3 {
+			_, _, _, _ = gocolor.RGBtoXYZ(float64(pix[i])/0xff, float64(pix[i+1])/0xff, float64(pix[i+2])/0xff, gocolor.SRGB)
+		}
+	}
+}
+
+func BenchmarkRGBtoXYZSlice(b *testing.B) {
+	pix := bulkPixels(benchPixels)
+	src := make([]float64, len(pix))
+	for i, v := range pix {
+		src[i] = float64(v) / 0xff
+	}
+	dst := make([]float64, len(src))
+	b.SetBytes(int64(len(pix)))
+	b.ResetTimer()
+	for n := 0; n < b.N; n++ {
+		_ = gocolor.RGBtoXYZSlice(dst, src, gocolor.SRGB)
+	}
+}
+
+func BenchmarkRGB8toXYZSlice(b *testing.B) {
+	pix := bulkPixels(benchPixels)
+	dst := make([]float64, len(pix))
+	b.SetBytes(int64(len(pix)))
+	b.ResetTimer()
+	for n := 0; n < b.N; n++ {
+		_ = gocolor.RGB8toXYZSlice(dst, pix, gocolor.SRGB)
+	}
+}
+
+func BenchmarkXYZtoRGB(b *testing.B) {
+	xyz := make([]float64, 3*benchPixels)
+	_ = gocolor.RGB8toXYZSlice(xyz, bulkPixels(benchPixels), gocolor.SRGB)
+	b.SetBytes(int64(len(xyz)))
+	b.ResetTimer()
+	for n := 0; n < b.N; n++ {
+		for i := 0; i < len(xyz); i += 3 {
+			_, _, _, _ = gocolor.XYZtoRGB(xyz[i], xyz[i+1], xyz[i+2], gocolor.SRGB)
+		}
+	}
+}
+
+func BenchmarkXYZtoRGBSlice(b *testing.B) {
+	xyz := make([]float64, 3*benchPixels)
+	_ = gocolor.RGB8toXYZSlice(xyz, bulkPixels(benchPixels), gocolor.SRGB)
+	dst := make([]float64, len(xyz))
+	b.SetBytes(int64(len(xyz)))
+	b.ResetTimer()
+	for n := 0; n < b.N; n++ {
+		_ = gocolor.XYZtoRGBSlice(dst, xyz, gocolor.SRGB)
+	}
+}
+
+func BenchmarkXYZtoRGB8Slice(b *testing.B) {
+	xyz := make([]float64, 3*benchPixels)
+	_ = gocolor.RGB8toXYZSlice(xyz, bulkPixels(benchPixels), gocolor.SRGB)
+	dst := make([]uint8, len(xyz))
+	b.SetBytes(int64(len(xyz)))
+	b.ResetTimer()
+	for n := 0; n < b.N; n++ {
+		_ = gocolor.XYZtoRGB8Slice(dst, xyz, gocolor.SRGB)
+	}
+}
@@ -0,0 +1,157 @@
+// Copyright © 2019 Xavier Basty <xavier@hexbee.net>
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package gocolor
+
+import (
+	"math"
+)
+
+// Default CIECAM02 viewing conditions: average surround, a background
+// of 20% of the white luminance and an adapting luminance of 64 cd/m².
+const (
+	cam02DefaultLA = 64.0
+	cam02DefaultYb = 20.0
+)
+
+// Hunt-Pointer-Estevez cone response space.
+var conversionXyzHpe = matrix{
+	0.38971, 0.68898, -0.07868,
+	-0.22981, 1.18340, 0.04641,
+	0.00000, 0.00000, 1.00000,
+}
+
+// cam02 holds the CIECAM02 parameters derived from a set of viewing conditions.
+type cam02 struct {
+	white vector // White point, with a luminance of 100
+
+	rgbD    vector  // Degree of adaptation applied to each sharpened cone response
+	fl      float64 // Luminance level adaptation factor
+	n, z    float64
+	nbb     float64
+	nc, c   float64
+	aw      float64 // Achromatic response of the white
+	catToPE matrix  // Adapted CAT02 responses to Hunt-Pointer-Estevez space
+}
+
+// newCAM02 computes the CIECAM02 model for an average surround.
+// The white point is expected with a luminance of 100, la is the adapting
+// luminance in cd/m², yb the relative luminance of the background and d
+// the degree of adaptation, or a negative value to derive it from la.
+func newCAM02(white vector, la, yb, d float64) cam02 {
+	const (
+		f  = 1.0
+		c  = 0.69
+		nc = 1.0
+	)
+
+	mCat := chromaticAdaptation[ChromaCAT02]
+	mCatInv, _ := mCat.inverse()
+
+	if d < 0 {
+		d = f * (1 - (1/3.6)*math.Exp((-la-42)/92))
+		d = math.Min(math.Max(d, 0), 1)
+	}
+
+	m := cam02{white: white, nc: nc, c: c}
+
+	rgbW := mCat.vdot(white)
+	m.rgbD = vector{
+		d*white.v1/rgbW.v0 + 1 - d,
+		d*white.v1/rgbW.v1 + 1 - d,
+		d*white.v1/rgbW.v2 + 1 - d,
+	}
+
+	k := 1 / (5*la + 1)
+	k4 := k * k * k * k
+	m.fl = 0.2*k4*(5*la) + 0.1*math.Pow(1-k4, 2)*math.Cbrt(5*la)
+
+	m.n = yb / white.v1
+	m.z = 1.48 + math.Sqrt(m.n)
+	m.nbb = 0.725 * math.Pow(1/m.n, 0.2)
+
+	m.catToPE = conversionXyzHpe.mdot(mCatInv)
+	m.aw = m.achromatic(m.responses(white))
+
+	return m
+}
+
+// responses computes the post-adaptation cone responses of a color.
+func (m cam02) responses(xyz vector) vector {
+	rgbC := chromaticAdaptation[ChromaCAT02].vdot(xyz).vmul(m.rgbD)
+	rgbP := m.catToPE.vdot(rgbC)
+
+	return rgbP.mapfunc(func(v float64) float64 {
+		p := math.Pow(m.fl*math.Abs(v)/100, 0.42)
+		return math.Copysign(400*p/(27.13+p), v) + 0.1
+	})
+}
+
+func (m cam02) achromatic(rgbA vector) float64 {
+	return (2*rgbA.v0 + rgbA.v1 + rgbA.v2/20 - 0.305) * m.nbb
+}
+
+// jmh computes the CIECAM02 lightness, colorfulness and hue angle (in radians) of a color.
+func (m cam02) jmh(xyz vector) (j, cm, h float64) {
+	rgbA := m.responses(xyz)
+
+	a := rgbA.v0 - 12*rgbA.v1/11 + rgbA.v2/11
+	b := (rgbA.v0 + rgbA.v1 - 2*rgbA.v2) / 9
+
+	h = math.Atan2(b, a)
+	if h < 0 {
+		h += 2 * math.Pi
+	}
+
+	j = 100 * math.Pow(math.Max(m.achromatic(rgbA)/m.aw, 0), m.c*m.z)
+
+	et := 0.25 * (math.Cos(h+2) + 3.8)
+	t := (50000.0 / 13 * m.nc * m.nbb * et * math.Hypot(a, b)) /
+		(rgbA.v0 + rgbA.v1 + 21.0/20*rgbA.v2)
+	chroma := math.Pow(t, 0.9) * math.Sqrt(j/100) * math.Pow(1.64-math.Pow(0.29, m.n), 0.73)
+	cm = chroma * math.Pow(m.fl, 0.25)
+
+	return j, cm, h
+}
+
+// ucs computes the CAM02-UCS coordinates of a color.
+func (m cam02) ucs(xyz vector) vector {
+	j, cm, h := m.jmh(xyz)
+
+	jp := 1.7 * j / (1 + 0.007*j)
+	mp := math.Log(1+0.0228*cm) / 0.0228
+
+	return vector{jp, mp * math.Cos(h), mp * math.Sin(h)}
+}
+
+// XYZtoCAM02UCS converts a color from XYZ coordinates to the CAM02-UCS
+// uniform color space (J', a', b'), for an average surround, an adapting
+// luminance of 64 cd/m² and a background with a luminance factor of 20%.
+//
+// See "Uniform colour spaces based on CIECAM02 colour appearance model", Luo et al., 2006.
+func XYZtoCAM02UCS(x, y, z float64, observer int, illuminant string) (j, a, b float64, err error) {
+	if err := checkXYZ(x, y, z); err != nil {
+		return 0, 0, 0, err
+	}
+
+	wp, err := getWhitePoint(observer, illuminant)
+	if err != nil {
+		return 0, 0, 0, err
+	}
+
+	m := newCAM02(wp.vscale(100), cam02DefaultLA, cam02DefaultYb, -1)
+	ucs := m.ucs(vector{x, y, z}.vscale(100))
+
+	return ucs.v0, ucs.v1, ucs.v2, nil
+}
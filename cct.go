@@ -0,0 +1,161 @@
+// Copyright © 2019 Xavier Basty <xavier@hexbee.net>
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package gocolor
+
+import (
+	"errors"
+	"fmt"
+	"math"
+)
+
+// Range of temperatures, in Kelvin, searched for correlated color temperatures.
+const (
+	minCCT = 1000.0
+	maxCCT = 100000.0
+)
+
+// Second radiation constant (m·K), as used by the CIE.
+const planckC2 = 1.4388e-2
+
+// PlanckianSpectrum computes the spectral distribution of a black body radiator
+// at the given temperature, in Kelvin, normalized to 100 at 560nm.
+func PlanckianSpectrum(temperature float64) ([]float64, error) {
+	if temperature <= 0 {
+		return nil, fmt.Errorf("temperature is not positive (%v)", temperature)
+	}
+
+	radiance := func(nm float64) float64 {
+		l := nm * 1e-9
+		return math.Pow(l, -5) / math.Expm1(planckC2/(l*temperature))
+	}
+
+	ref := radiance(spec560nm.nanometers())
+	spd := make([]float64, spectralSamples)
+	for i := range spd {
+		spd[i] = 100 * radiance(Wavelength(i).nanometers()) / ref
+	}
+
+	return spd, nil
+}
+
+// DaylightSpectrum computes the spectral distribution of the CIE daylight
+// illuminant with the given correlated color temperature, in Kelvin,
+// normalized to 100 at 560nm.
+//
+// The CIE daylight model is defined for temperatures from 4000K to 25000K.
+func DaylightSpectrum(cct float64) ([]float64, error) {
+	if cct < 4000 || cct > 25000 {
+		return nil, fmt.Errorf("temperature is out of the [4000, 25000] range (%v)", cct)
+	}
+
+	var xd float64
+	if cct <= 7000 {
+		xd = -4.6070e9/math.Pow(cct, 3) + 2.9678e6/math.Pow(cct, 2) + 0.09911e3/cct + 0.244063
+	} else {
+		xd = -2.0064e9/math.Pow(cct, 3) + 1.9018e6/math.Pow(cct, 2) + 0.24748e3/cct + 0.237040
+	}
+	yd := -3*xd*xd + 2.870*xd - 0.275
+
+	m := 0.0241 + 0.2562*xd - 0.7341*yd
+	m1 := (-1.3515 - 1.7703*xd + 5.9114*yd) / m
+	m2 := (0.0300 - 31.4424*xd + 30.0717*yd) / m
+
+	spd := make([]float64, spectralSamples)
+	for i := range spd {
+		spd[i] = daylightS0[i] + m1*daylightS1[i] + m2*daylightS2[i]
+	}
+
+	return spd, nil
+}
+
+// XYZtoCCT computes the correlated color temperature, in Kelvin, of a color
+// from its XYZ coordinates for the 2° standard observer, along with its
+// distance to the Planckian locus (Duv) in the CIE 1960 UCS.
+// Duv is positive above the locus and negative below it.
+func XYZtoCCT(x, y, z float64) (cct, duv float64, err error) {
+	if x < 0 || y < 0 || z < 0 {
+		return 0, 0, errors.New("XYZ coordinates are negative")
+	}
+	if x+15*y+3*z == 0 {
+		return 0, 0, errors.New("color has no chromaticity")
+	}
+
+	u, v := xyzToUV(vector{x, y, z})
+	cct, duv = uvToCCT(u, v)
+	return cct, duv, nil
+}
+
+// SpectralToCCT computes the correlated color temperature, in Kelvin, of a
+// light source from its spectral distribution, along with its Duv.
+func SpectralToCCT(spd []float64) (cct, duv float64, err error) {
+	w, err := spectralWeights(Observer2, spd)
+	if err != nil {
+		return 0, 0, err
+	}
+
+	u, v := xyzToUV(w.white())
+	cct, duv = uvToCCT(u, v)
+	return cct, duv, nil
+}
+
+////////////////////////////////////////
+
+// xyzToUV computes the CIE 1960 UCS chromaticity coordinates.
+func xyzToUV(xyz vector) (u, v float64) {
+	d := xyz.v0 + 15*xyz.v1 + 3*xyz.v2
+	return 4 * xyz.v0 / d, 6 * xyz.v1 / d
+}
+
+// planckianUV computes the CIE 1960 UCS chromaticity of a black body radiator.
+func planckianUV(temperature float64) (u, v float64) {
+	spd, _ := PlanckianSpectrum(temperature)
+	w, _ := spectralWeights(Observer2, spd)
+	return xyzToUV(w.white())
+}
+
+// uvToCCT finds the closest point of the Planckian locus with a golden-section
+// search on the reciprocal temperature, along which the locus is close to uniform.
+func uvToCCT(u, v float64) (cct, duv float64) {
+	dist := func(mired float64) float64 {
+		pu, pv := planckianUV(1e6 / mired)
+		return math.Hypot(u-pu, v-pv)
+	}
+
+	const phi = 0.6180339887498949
+	lo, hi := 1e6/maxCCT, 1e6/minCCT
+	a := hi - phi*(hi-lo)
+	b := lo + phi*(hi-lo)
+	da, db := dist(a), dist(b)
+	for hi-lo > 1e-6 {
+		if da < db {
+			hi, b, db = b, a, da
+			a = hi - phi*(hi-lo)
+			da = dist(a)
+		} else {
+			lo, a, da = a, b, db
+			b = lo + phi*(hi-lo)
+			db = dist(b)
+		}
+	}
+
+	cct = 1e6 / ((lo + hi) / 2)
+	pu, pv := planckianUV(cct)
+	duv = math.Hypot(u-pu, v-pv)
+	if v < pv {
+		duv = -duv
+	}
+
+	return cct, duv
+}
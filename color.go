@@ -99,8 +99,10 @@ const (
 // The library assumes wavelength intervals of 10nm, starting at 340nm and ending at 830nm.
 //
 // Spectral colors are the lowest level, most "raw" measurement of color.
-// You may convert spectral colors to any other color space, but you can't
-// convert any other color space back to spectral.
+// You may convert spectral colors to any other color space. Converting other
+// color spaces back to spectral is ambiguous, since many spectra produce the
+// same color; XYZtoSpectral and RGBtoSpectral reconstruct a plausible smooth
+// reflectance instead.
 //
 // See `Spectral power distribution http://en.wikipedia.org/wiki/Spectral_power_distribution
 // on Wikipedia for some higher level details on how these work.
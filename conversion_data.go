@@ -14,6 +14,8 @@
 
 package gocolor
 
+import "github.com/Hexbee-net/gocolor/internal/colormath"
+
 var conversionRgbXyz = map[string]matrix{
 	AdobeRGB: {
 		0.5767309, 0.1855540, 0.1881852,
@@ -60,6 +62,11 @@ var conversionRgbXyz = map[string]matrix{
 		0.2748840, 0.6581315, 0.0669845,
 		0.0242545, 0.1087821, 0.6921735,
 	},
+	DisplayP3: {
+		0.4865709486482162, 0.2656676931690931, 0.1982172852343625,
+		0.2289745640697488, 0.6917385218365064, 0.0792869140937450,
+		0.0000000000000000, 0.0451133818589026, 1.0439443689009760,
+	},
 	DonRGB4: {
 		0.6457711, 0.1933511, 0.1250978,
 		0.2783496, 0.6879702, 0.0336802,
@@ -95,11 +102,7 @@ var conversionRgbXyz = map[string]matrix{
 		0.2124132, 0.7010437, 0.0865432,
 		0.0187423, 0.1119313, 0.9581563,
 	},
-	SRGB: {
-		0.4124564, 0.3575761, 0.1804375,
-		0.2126729, 0.7151522, 0.0721750,
-		0.0193339, 0.1191920, 0.9503041,
-	},
+	SRGB: matrixOf(colormath.SRGBToXYZ),
 	WideGamutRGB: {
 		0.7161046, 0.1009296, 0.1471858,
 		0.2581874, 0.7249378, 0.0168748,
@@ -153,6 +156,11 @@ var conversionXyzRgb = map[string]matrix{
 		-1.1119763, 2.0590183, 0.0159614,
 		0.0821699, -0.2807254, 1.4559877,
 	},
+	DisplayP3: {
+		2.4934969119414254, -0.9313836179191239, -0.4027107844507168,
+		-0.8294889695615747, 1.7626640603183463, 0.0236246858419436,
+		0.0358458302437845, -0.0761723892680418, 0.9568845240076872,
+	},
 	DonRGB4: {
 		1.7603902, -0.4881198, -0.2536126,
 		-0.7126288, 1.6527432, 0.0416715,
@@ -337,3 +345,11 @@ var chromaticAdaptationInverse = map[string]matrix{
 		0, 0, 1,
 	},
 }
+
+// Oklab matrices, from XYZ coordinates relative to illuminant D65.
+var (
+	conversionXyzOklabLms    = matrixOf(colormath.XYZToOklabLMS)
+	conversionOklabLmsLab    = matrixOf(colormath.OklabLMSToLab)
+	conversionOklabLmsXyz, _ = conversionXyzOklabLms.inverse()
+	conversionOklabLabLms, _ = conversionOklabLmsLab.inverse()
+)
@@ -20,6 +20,7 @@ import (
 	"github.com/stretchr/testify/assert"
 
 	"github.com/Hexbee-net/gocolor"
+	"github.com/Hexbee-net/gocolor/named"
 )
 
 const precision = 1e-8
@@ -1010,12 +1011,15 @@ func TestCMYtoRGB(t *testing.T) {
 
 func TestHEXtoRGB_InvalidParameters(t *testing.T) {
 	tests := []string{
-		"aaaaaaaa",
+		"aaaaaaaaa",
+		"aaaaaaa",
+		"aaaaa",
 		"gggggg",
 		"fg0000",
 		"00fg00",
 		"0000fg",
-		"a000",
+		"000000fg",
+		"000g",
 		"g00",
 		"0g0",
 		"00g",
@@ -1054,8 +1058,15 @@ func TestHEXtoRGB(t *testing.T) {
 		{"#123456", []float64{0.07058824, 0.20392157, 0.33725490}},
 
 		{"#123456", []float64{0.07058824, 0.20392157, 0.33725490}},
+		{"#12345678", []float64{0.07058824, 0.20392157, 0.33725490}},
+		{"#a008", []float64{0.66666666, 0.00000000, 0.00000000}},
 
-		// 	TODO: test named colors
+		{"aliceblue", []float64{0.94117647, 0.97254902, 1.00000000}},
+		{"AliceBlue", []float64{0.94117647, 0.97254902, 1.00000000}},
+		{"Alice Blue", []float64{0.94117647, 0.97254902, 1.00000000}},
+		{"alice-blue", []float64{0.94117647, 0.97254902, 1.00000000}},
+		{"alice_blue", []float64{0.94117647, 0.97254902, 1.00000000}},
+		{"slate grey", []float64{0.43921569, 0.50196078, 0.56470588}},
 	}
 
 	for n := 0; n < len(tests); n++ {
@@ -1067,6 +1078,33 @@ func TestHEXtoRGB(t *testing.T) {
 	}
 }
 
+func TestNamedToRGB(t *testing.T) {
+	r, g, b, err := gocolor.NamedToRGB("gray", named.CSS)
+	assert.NoError(t, err)
+	assert.InDeltaSlice(t, []float64{0x80 / 255.0, 0x80 / 255.0, 0x80 / 255.0}, []float64{r, g, b}, precision)
+
+	r, g, b, err = gocolor.NamedToRGB("Gray", named.X11)
+	assert.NoError(t, err)
+	assert.InDeltaSlice(t, []float64{0xbe / 255.0, 0xbe / 255.0, 0xbe / 255.0}, []float64{r, g, b}, precision)
+
+	r, g, b, err = gocolor.NamedToRGB("Light Grey", named.X11)
+	assert.NoError(t, err)
+	assert.InDeltaSlice(t, []float64{0xd3 / 255.0, 0xd3 / 255.0, 0xd3 / 255.0}, []float64{r, g, b}, precision)
+
+	_, _, _, err = gocolor.NamedToRGB("azurex11", named.CSS)
+	assert.Error(t, err)
+
+	// The palette defaults to the Wikipedia one.
+	r, g, b, err = gocolor.NamedToRGB("azurex11", nil)
+	assert.NoError(t, err)
+	assert.InDeltaSlice(t, []float64{0xf0 / 255.0, 0xff / 255.0, 0xff / 255.0}, []float64{r, g, b}, precision)
+
+	_, _, _, err = gocolor.NamedToRGB("cornflowerblu", named.CSS)
+	unknown, ok := err.(*named.UnknownColorError)
+	assert.True(t, ok)
+	assert.Equal(t, []string{"cornflowerblue"}, unknown.Suggestions)
+}
+
 func TestXYZtoRGB_InvalidParameters(t *testing.T) {
 	tests := [][]float64{
 		{-1, 0, 0},
@@ -1246,14 +1284,79 @@ func TestCMYKtoCMY(t *testing.T) {
 	}
 }
 
-func TestXYZtoLAB_InvalidParameters(t *testing.T) {}
-func TestXYZtoLAB(t *testing.T)                   {}
+func TestXYZtoLAB_InvalidParameters(t *testing.T) {
+	tests := [][]float64{
+		{-1, 0, 0},
+		{0, -1, 0},
+		{0, 0, -1},
+		{2, 0, 0},
+		{0, 2, 0},
+		{0, 0, 2},
+	}
+
+	for n := 0; n < len(tests); n++ {
+		_, _, _, err := gocolor.XYZtoLAB(tests[n][0], tests[n][1], tests[n][2], gocolor.Observer2, gocolor.RefIlluminantD65)
+		assert.Errorf(t, err, "no error for test #%v", n+1)
+	}
+
+	_, _, _, err := gocolor.XYZtoLAB(0, 0, 0, gocolor.Observer2, "unknown")
+	assert.Error(t, err)
+}
+
+func TestXYZtoLAB(t *testing.T) {
+	tests := []ConversionTest{
+		{from: []float64{0.00000000, 0.00000000, 0.00000000}, to: []float64{000.00000000, 000.00000000, 000.00000000}},
+		{from: []float64{0.41245640, 0.21267290, 0.01933390}, to: []float64{053.24079414, 080.09245960, 067.20319652}},
+		{from: []float64{0.20000000, 0.30000000, 0.40000000}, to: []float64{061.65422221, -37.32133644, -09.35307610}},
+		{from: []float64{0.00100000, 0.00100000, 0.00100000}, to: []float64{000.90329630, 000.20289538, 000.12705794}},
+	}
+
+	for n := 0; n < len(tests); n++ {
+		l, a, b, err := gocolor.XYZtoLAB(tests[n].from[0], tests[n].from[1], tests[n].from[2], gocolor.Observer2, gocolor.RefIlluminantD65)
+
+		assert.NoError(t, err)
+		assert.InDeltaf(t, tests[n].to[0], l, precision, "L is wrong for test #%v", n+1)
+		assert.InDeltaf(t, tests[n].to[1], a, precision, "a is wrong for test #%v", n+1)
+		assert.InDeltaf(t, tests[n].to[2], b, precision, "b is wrong for test #%v", n+1)
+	}
+}
 
 func TestXYZtoXYY_InvalidParameters(t *testing.T) {}
 func TestXYZtoXYY(t *testing.T)                   {}
 
-func TestXYZtoLUV_InvalidParameters(t *testing.T) {}
-func TestXYZtoLUV(t *testing.T)                   {}
+func TestXYZtoLUV_InvalidParameters(t *testing.T) {
+	tests := [][]float64{
+		{-1, 0, 0},
+		{0, -1, 0},
+		{0, 0, -1},
+		{2, 0, 0},
+		{0, 2, 0},
+		{0, 0, 2},
+	}
+
+	for n := 0; n < len(tests); n++ {
+		_, _, _, err := gocolor.XYZtoLUV(tests[n][0], tests[n][1], tests[n][2], gocolor.Observer2, gocolor.RefIlluminantD65)
+		assert.Errorf(t, err, "no error for test #%v", n+1)
+	}
+}
+
+func TestXYZtoLUV(t *testing.T) {
+	tests := []ConversionTest{
+		{from: []float64{0.00000000, 0.00000000, 0.00000000}, to: []float64{000.00000000, 000.00000000, 000.00000000}},
+		{from: []float64{0.41245640, 0.21267290, 0.01933390}, to: []float64{053.24079414, 175.01502995, 037.75642902}},
+		{from: []float64{0.20000000, 0.30000000, 0.40000000}, to: []float64{061.65422221, -49.89095780, -08.58346356}},
+		{from: []float64{0.00100000, 0.00100000, 0.00100000}, to: []float64{000.90329630, 000.14897558, 000.06279969}},
+	}
+
+	for n := 0; n < len(tests); n++ {
+		l, u, v, err := gocolor.XYZtoLUV(tests[n].from[0], tests[n].from[1], tests[n].from[2], gocolor.Observer2, gocolor.RefIlluminantD65)
+
+		assert.NoError(t, err)
+		assert.InDeltaf(t, tests[n].to[0], l, precision, "L is wrong for test #%v", n+1)
+		assert.InDeltaf(t, tests[n].to[1], u, precision, "u is wrong for test #%v", n+1)
+		assert.InDeltaf(t, tests[n].to[2], v, precision, "v is wrong for test #%v", n+1)
+	}
+}
 
 func TestXYYtoXYZ_InvalidParameters(t *testing.T) {}
 func TestXYYtoXYZ(t *testing.T)                   {}
@@ -1263,11 +1366,71 @@ func TestXYZtoIPT(t *testing.T)                   {}
 
 ////////////////////////////////////////
 
-func TestLABtoXYZ_InvalidParameters(t *testing.T) {}
-func TestLABtoXYZ(t *testing.T)                   {}
+func TestLABtoXYZ_InvalidParameters(t *testing.T) {
+	_, _, _, err := gocolor.LABtoXYZ(-1, 0, 0, gocolor.Observer2, gocolor.RefIlluminantD65)
+	assert.Error(t, err)
+	_, _, _, err = gocolor.LABtoXYZ(101, 0, 0, gocolor.Observer2, gocolor.RefIlluminantD65)
+	assert.Error(t, err)
+}
+
+func TestLABtoXYZ(t *testing.T) {
+	tests := []ConversionTest{
+		{from: []float64{000.00000000, 000.00000000, 000.00000000}, to: []float64{0.00000000, 0.00000000, 0.00000000}},
+		{from: []float64{053.24079414, 080.09245960, 067.20319652}, to: []float64{0.41245640, 0.21267290, 0.01933390}},
+		{from: []float64{061.65422221, -37.32133644, -09.35307610}, to: []float64{0.20000000, 0.30000000, 0.40000000}},
+		{from: []float64{000.90329630, 000.20289538, 000.12705794}, to: []float64{0.00100000, 0.00100000, 0.00100000}},
+	}
+
+	for n := 0; n < len(tests); n++ {
+		x, y, z, err := gocolor.LABtoXYZ(tests[n].from[0], tests[n].from[1], tests[n].from[2], gocolor.Observer2, gocolor.RefIlluminantD65)
+
+		assert.NoError(t, err)
+		assert.InDeltaf(t, tests[n].to[0], x, precision, "x is wrong for test #%v", n+1)
+		assert.InDeltaf(t, tests[n].to[1], y, precision, "y is wrong for test #%v", n+1)
+		assert.InDeltaf(t, tests[n].to[2], z, precision, "z is wrong for test #%v", n+1)
+	}
+}
 
-func TestLUVtoXYZ_InvalidParameters(t *testing.T) {}
-func TestLUVtoXYZ(t *testing.T)                   {}
+func TestLABtoXYZ_RoundTrip(t *testing.T) {
+	// Dark colors use the linear segment of the lightness function.
+	for _, v := range []float64{0.0001, 0.001, 0.005, 216.0 / 24389.0, 0.01, 0.1, 0.5, 0.9} {
+		l, a, b, err := gocolor.XYZtoLAB(v, v, v*0.8, gocolor.Observer2, gocolor.RefIlluminantD65)
+		assert.NoError(t, err)
+		x, y, z, err := gocolor.LABtoXYZ(l, a, b, gocolor.Observer2, gocolor.RefIlluminantD65)
+		assert.NoError(t, err)
+		assert.InDeltaSlice(t, []float64{v, v, v * 0.8}, []float64{x, y, z}, 1e-12, "Y = %v", v)
+
+		l, u, w, err := gocolor.XYZtoLUV(v, v, v*0.8, gocolor.Observer2, gocolor.RefIlluminantD65)
+		assert.NoError(t, err)
+		x, y, z, err = gocolor.LUVtoXYZ(l, u, w, gocolor.Observer2, gocolor.RefIlluminantD65)
+		assert.NoError(t, err)
+		assert.InDeltaSlice(t, []float64{v, v, v * 0.8}, []float64{x, y, z}, 1e-12, "Y = %v", v)
+	}
+}
+
+func TestLUVtoXYZ_InvalidParameters(t *testing.T) {
+	_, _, _, err := gocolor.LUVtoXYZ(-1, 0, 0, gocolor.Observer2, gocolor.RefIlluminantD65)
+	assert.Error(t, err)
+	_, _, _, err = gocolor.LUVtoXYZ(101, 0, 0, gocolor.Observer2, gocolor.RefIlluminantD65)
+	assert.Error(t, err)
+}
+
+func TestLUVtoXYZ(t *testing.T) {
+	tests := []ConversionTest{
+		{from: []float64{053.24079414, 175.01502995, 037.75642902}, to: []float64{0.41245640, 0.21267290, 0.01933390}},
+		{from: []float64{061.65422221, -49.89095780, -08.58346356}, to: []float64{0.20000000, 0.30000000, 0.40000000}},
+		{from: []float64{000.90329630, 000.14897558, 000.06279969}, to: []float64{0.00100000, 0.00100000, 0.00100000}},
+	}
+
+	for n := 0; n < len(tests); n++ {
+		x, y, z, err := gocolor.LUVtoXYZ(tests[n].from[0], tests[n].from[1], tests[n].from[2], gocolor.Observer2, gocolor.RefIlluminantD65)
+
+		assert.NoError(t, err)
+		assert.InDeltaf(t, tests[n].to[0], x, precision, "x is wrong for test #%v", n+1)
+		assert.InDeltaf(t, tests[n].to[1], y, precision, "y is wrong for test #%v", n+1)
+		assert.InDeltaf(t, tests[n].to[2], z, precision, "z is wrong for test #%v", n+1)
+	}
+}
 
 func TestLABtoLCHAB_InvalidParameters(t *testing.T) {}
 func TestLABtoLCHAB(t *testing.T)                   {}
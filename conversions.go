@@ -21,6 +21,7 @@ import (
 	"strconv"
 	"strings"
 
+	"github.com/Hexbee-net/gocolor/internal/colormath"
 	"github.com/Hexbee-net/gocolor/named"
 )
 
@@ -158,6 +159,20 @@ func RGBtoHEX(r, g, b float64) (string, error) {
 	return fmt.Sprintf("#%02X%02X%02X", ri, gi, bi), nil
 }
 
+// RGBAtoHEX converts a color from base RGB coordinates and a straight alpha
+// to #RRGGBBAA.
+func RGBAtoHEX(r, g, b, a float64) (string, error) {
+	if a < 0 || a > 1 {
+		return "", fmt.Errorf("alpha is out of the [0, 1] range (%v)", a)
+	}
+	hex, err := RGBtoHEX(r, g, b)
+	if err != nil {
+		return "", err
+	}
+
+	return fmt.Sprintf("%s%02X", hex, int(math.Round(a*255))), nil
+}
+
 // RGBtoXYZ converts a color from RGB coordinates to XYZ.
 // The illuminant for the XYZ color is D65 and the observer's angle 2°.
 func RGBtoXYZ(r, g, b float64, space string) (x, y, z float64, err error) {
@@ -165,56 +180,11 @@ func RGBtoXYZ(r, g, b float64, space string) (x, y, z float64, err error) {
 		return 0, 0, 0, err
 	}
 
-	switch space {
-	case SRGB:
-		linearize := func(v float64) float64 {
-			if v <= 0.04045 {
-				return v / 12.92
-			}
-			return math.Pow((v+0.055)/1.055, 2.4)
-		}
-		r = linearize(r)
-		g = linearize(g)
-		b = linearize(b)
-
-	case BT2020:
-		linearize := func(v float64) float64 {
-			if v <= 0.08124794403514049 {
-				return v / 4.5
-			}
-			return math.Pow((v+0.099)/1.099, 1/0.45)
-		}
-		r = linearize(r)
-		g = linearize(g)
-		b = linearize(b)
-
-	case BT202012b:
-		linearize := func(v float64) float64 {
-			if v <= 0.081697877417347 {
-				return v / 4.5
-			}
-			return math.Pow((v+0.0993)/1.0993, 1/0.45)
-		}
-		r = linearize(r)
-		g = linearize(g)
-		b = linearize(b)
-
-	default:
-		gamma, ok := RGBGamma[space]
-		if !ok {
-			return 0, 0, 0, fmt.Errorf("could not find gamma for RGB color space: %v", space)
-		}
-		r = math.Pow(r, gamma)
-		g = math.Pow(g, gamma)
-		b = math.Pow(b, gamma)
-	}
-
-	m, ok := conversionRgbXyz[space]
-	if !ok {
-		return 0, 0, 0, fmt.Errorf("could not find conversion matrix for RGB color space: %v", space)
+	v, err := rgbToXYZ(vector{r, g, b}, space)
+	if err != nil {
+		return 0, 0, 0, err
 	}
 
-	v := m.vdot(vector{r, g, b})
 	return math.Max(v.v0, 0), math.Max(v.v1, 0), math.Max(v.v2, 0), nil
 }
 
@@ -368,65 +338,78 @@ func CMYtoRGB(c, m, y float64) (float64, float64, float64, error) {
 }
 
 // HEXtoRGB converts a color from HTML #RRGGBB to RGB coordinates.
+// The alpha of #RGBA and #RRGGBBAA colors is discarded.
+// The color can also be one of the names of named.Wikipedia, resolved with
+// named.Palette.Resolve, see NamedToRGB to restrict the names to a palette.
 func HEXtoRGB(html string) (r, g, b float64, err error) {
+	r, g, b, _, err = HEXtoRGBA(html)
+	return r, g, b, err
+}
+
+// HEXtoRGBA converts a color from HTML #RRGGBBAA to RGB coordinates and a
+// straight alpha. The #RGB, #RGBA and #RRGGBB forms are also accepted, with
+// an alpha of 1 when it is not specified.
+func HEXtoRGBA(html string) (r, g, b, a float64, err error) {
 	html = strings.TrimSpace(html)
 	if len(html) == 0 {
-		return 0, 0, 0, errors.New("input is empty")
+		return 0, 0, 0, 0, errors.New("input is empty")
 	}
 	if html[0] == '#' {
 		html = html[1:]
-	} else {
-		if val, ok := named.NamedColors[strings.ToLower(html)]; ok {
-			html = val[1:]
+	} else if c, ok := named.Wikipedia.Lookup(html); ok {
+		html = c.Hex[1:]
+	} else if strings.TrimLeft(html, "0123456789abcdefABCDEF") != "" {
+		c, err := named.Wikipedia.Resolve(html)
+		if err != nil {
+			return 0, 0, 0, 0, err
 		}
+		html = c.Hex[1:]
 	}
 
+	var digits int
 	switch len(html) {
 	// Long html code
-	case 6:
-		ri, err := strconv.ParseUint(html[0:2], 16, 64)
-		if err != nil {
-			return 0, 0, 0, err
-		}
-		r = float64(ri) / 255
+	case 6, 8:
+		digits = 2
 
-		gi, err := strconv.ParseUint(html[2:4], 16, 64)
-		if err != nil {
-			return 0, 0, 0, err
-		}
-		g = float64(gi) / 255
+	// Short html code
+	case 3, 4:
+		digits = 1
 
-		bi, err := strconv.ParseUint(html[4:6], 16, 64)
-		if err != nil {
-			return 0, 0, 0, err
-		}
-		b = float64(bi) / 255
+	default:
+		return 0, 0, 0, 0, fmt.Errorf("input '%s' is not in #RRGGBB or #RRGGBBAA format", html)
+	}
 
-	// Short html code
-	case 3:
-		ri, err := strconv.ParseUint(html[0:1], 16, 64)
+	v := []float64{0, 0, 0, 1}
+	for i := 0; i*digits < len(html); i++ {
+		n, err := strconv.ParseUint(html[i*digits:(i+1)*digits], 16, 64)
 		if err != nil {
-			return 0, 0, 0, err
+			return 0, 0, 0, 0, err
 		}
-		r = float64(ri*16+ri) / 255
-
-		gi, err := strconv.ParseUint(html[1:2], 16, 64)
-		if err != nil {
-			return 0, 0, 0, err
+		if digits == 1 {
+			n = n*16 + n
 		}
-		g = float64(gi*16+gi) / 255
+		v[i] = float64(n) / 255
+	}
 
-		bi, err := strconv.ParseUint(html[2:3], 16, 64)
-		if err != nil {
-			return 0, 0, 0, err
-		}
-		b = float64(bi*16+bi) / 255
+	return v[0], v[1], v[2], v[3], nil
+}
 
-	default:
-		return 0, 0, 0, fmt.Errorf("input '%s' is not in #RRGGBB format", html)
+// NamedToRGB converts a color from a name of a palette to RGB coordinates.
+// The name is resolved with named.Palette.Resolve, and the error of unknown
+// names is a *named.UnknownColorError suggesting the closest names. The palette
+// defaults to named.Wikipedia.
+func NamedToRGB(name string, palette *named.Palette) (r, g, b float64, err error) {
+	if palette == nil {
+		palette = named.Wikipedia
 	}
 
-	return r, g, b, nil
+	c, err := palette.Resolve(name)
+	if err != nil {
+		return 0, 0, 0, err
+	}
+
+	return HEXtoRGB(c.Hex)
 }
 
 // XYZtoRGB converts a color from XYZ coordinates to RGB.
@@ -436,59 +419,12 @@ func XYZtoRGB(x, y, z float64, space string) (r, g, b float64, err error) {
 		return 0, 0, 0, err
 	}
 
-	m, ok := conversionXyzRgb[space]
-	if !ok {
-		return 0, 0, 0, fmt.Errorf("unrecognized RGB color space: %v", space)
-	}
-
-	v := m.vdot(vector{x, y, z})
-	r, g, b = v.v0, v.v1, v.v2
-
-	switch space {
-	case SRGB:
-		delinearize := func(v float64) float64 {
-			if v <= 0.0031308 {
-				return v * 12.92
-			}
-			return 1.055*math.Pow(v, 1/2.4) - 0.055
-		}
-		r = delinearize(r)
-		g = delinearize(g)
-		b = delinearize(b)
-
-	case BT2020:
-		delinearize := func(v float64) float64 {
-			if v < 0.018 {
-				return v * 4.5
-			}
-			return 1.099*math.Pow(v, 0.45) - 0.099
-		}
-		r = delinearize(r)
-		g = delinearize(g)
-		b = delinearize(b)
-
-	case BT202012b:
-		delinearize := func(v float64) float64 {
-			if v < 0.0181 {
-				return v * 4.5
-			}
-			return 1.0993*math.Pow(v, 0.45) - 0.0993
-		}
-		r = delinearize(r)
-		g = delinearize(g)
-		b = delinearize(b)
-
-	default:
-		gamma, ok := RGBGamma[space]
-		if !ok {
-			return 0, 0, 0, fmt.Errorf("unrecognized RGB color space: %v", space)
-		}
-		r = math.Pow(r, 1/gamma)
-		g = math.Pow(g, 1/gamma)
-		b = math.Pow(b, 1/gamma)
+	v, err := xyzToRGB(vector{x, y, z}, space)
+	if err != nil {
+		return 0, 0, 0, err
 	}
 
-	return r, g, b, nil
+	return v.v0, v.v1, v.v2, nil
 }
 
 ////////////////////////////////////////
@@ -546,33 +482,8 @@ func XYZtoLAB(x, y, z float64, observer int, illuminant string) (l, a, b float64
 		return 0, 0, 0, err
 	}
 
-	x /= wp.v0
-	y /= wp.v1
-	z /= wp.v2
-
-	if x > CieE {
-		x = math.Pow(x, 1/3)
-	} else {
-		x = (7.787 * x) + (16.0 / 116.0)
-	}
-
-	if y > CieE {
-		y = math.Pow(y, 1/3)
-	} else {
-		y = (7.787 * y) + (16.0 / 116.0)
-	}
-
-	if z > CieE {
-		z = math.Pow(z, 1/3)
-	} else {
-		z = (7.787 * z) + (16.0 / 116.0)
-	}
-
-	l = (116.0 * y) - 16.0
-	a = 500.0 * (x - y)
-	b = 200.0 * (y - z)
-
-	return l, a, b, nil
+	lab := xyzToLab(vector{x, y, z}, *wp)
+	return lab.v0, lab.v1, lab.v2, nil
 }
 
 // XYZtoXYY converts a color from XYZ coordinates to xyY.
@@ -612,12 +523,7 @@ func XYZtoLUV(x, y, z float64, observer int, illuminant string) (l, u, v float64
 		v = (9.0 * y) / d
 	}
 
-	y = y / wp.v1
-	if y > CieE {
-		y = math.Pow(y, 1/3)
-	} else {
-		y = (7.787 * y) + (16.0 / 116.0)
-	}
+	y = colormath.LabF(y / wp.v1)
 
 	refU := (4.0 * wp.v0) / (wp.v0 + (15.0 * wp.v1) + (3.0 * wp.v2))
 	refV := (9.0 * wp.v1) / (wp.v0 + (15.0 * wp.v1) + (3.0 * wp.v2))
@@ -688,33 +594,8 @@ func LABtoXYZ(l, a, b float64, observer int, illuminant string) (x, y, z float64
 		return 0, 0, 0, err
 	}
 
-	y = (l + 16) / 116
-	x = a/500 + y
-	z = y - b/200
-
-	if px := math.Pow(x, 3); px > CieE {
-		x = px
-	} else {
-		x = (x - 16/116) / 7.787
-	}
-
-	if py := math.Pow(y, 3); py > CieE {
-		y = py
-	} else {
-		y = (y - 16/116) / 7.787
-	}
-
-	if pz := math.Pow(z, 3); pz > CieE {
-		z = pz
-	} else {
-		z = (z - 16/116) / 7.787
-	}
-
-	x *= wp.v0
-	y *= wp.v1
-	z *= wp.v2
-
-	return x, y, z, nil
+	xyz := labToXYZ(vector{l, a, b}, *wp)
+	return xyz.v0, xyz.v1, xyz.v2, nil
 }
 
 // LUVtoXYZ converts a color from Luv coordinates to XYZ.
@@ -887,6 +768,9 @@ func SpectralToXYZ(color []float64, observer int, refIlluminant []float64) (x, y
 ////////////////////////////////////////
 
 // RGBtoCMYK converts a color from RGB coordinates to CMYK.
+//
+// This is the naive device independent conversion; use the transforms of an
+// output profile from the icc package to get the values for an actual press.
 func RGBtoCMYK(r, g, b float64) (c, m, y, k float64, err error) {
 	if c, m, y, err = RGBtoCMY(r, g, b); err != nil {
 		return 0, 0, 0, 0, err
@@ -1053,27 +937,44 @@ func checkXYZ(x, y, z float64) error {
 }
 
 func checkLAB(l, a, b float64) error {
-	if l < 0 || l > 1 {
-		return fmt.Errorf("L is out of the [0, 1] range (%v)", l)
-	}
-	if a < 0 || a > 1 {
-		return fmt.Errorf("a is out of the [0, 1] range (%v)", l)
-	}
-	if b < 0 || b > 1 {
-		return fmt.Errorf("b is out of the [0, 1] range (%v)", l)
+	if l < 0 || l > 100 {
+		return fmt.Errorf("L is out of the [0, 100] range (%v)", l)
 	}
 	return nil
 }
 
 func checkLUV(l, u, v float64) error {
-	if l < 0 || l > 1 {
-		return fmt.Errorf("L is out of the [0, 1] range (%v)", l)
-	}
-	if u < 0 || u > 1 {
-		return fmt.Errorf("u is out of the [0, 1] range (%v)", u)
-	}
-	if v < 0 || v > 1 {
-		return fmt.Errorf("v is out of the [0, 1] range (%v)", v)
+	if l < 0 || l > 100 {
+		return fmt.Errorf("L is out of the [0, 100] range (%v)", l)
 	}
 	return nil
 }
+
+////////////////////////////////////////
+
+// xyzToLab converts XYZ coordinates to Lab relative to the given white point,
+// without any range checking.
+func xyzToLab(xyz, wp vector) vector {
+	return vectorOf(colormath.XYZToLab(xyz.array(), wp.array()))
+}
+
+// labToXYZ converts Lab coordinates relative to the given white point to XYZ,
+// without any range checking.
+func labToXYZ(lab, wp vector) vector {
+	return vectorOf(colormath.LabToXYZ(lab.array(), wp.array()))
+}
+
+// xyzToOklab converts XYZ coordinates relative to illuminant D65 to Oklab,
+// without any range checking.
+func xyzToOklab(xyz vector) vector {
+	return vectorOf(colormath.XYZToOklab(xyz.array()))
+}
+
+// oklabToXYZ converts Oklab coordinates to XYZ relative to illuminant D65,
+// without any range checking.
+func oklabToXYZ(lab vector) vector {
+	lms := conversionOklabLabLms.vdot(lab).mapfunc(func(v float64) float64 {
+		return v * v * v
+	})
+	return conversionOklabLmsXyz.vdot(lms)
+}
@@ -0,0 +1,112 @@
+// Copyright © 2019 Xavier Basty <xavier@hexbee.net>
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package gocolor
+
+import (
+	"math"
+)
+
+// Maximum chromaticity distance between a light source and its reference
+// illuminant for the color rendering index to be meaningful.
+const criMaxDC = 5.4e-3
+
+// ColorRendering holds the CIE 13.3 color rendering properties of a light source.
+type ColorRendering struct {
+	CCT float64     // Correlated color temperature of the source, in Kelvin
+	DC  float64     // Chromaticity distance to the reference illuminant, in the CIE 1960 UCS
+	Ra  float64     // General color rendering index, the mean of R1 to R8
+	Ri  [14]float64 // Special color rendering indices of the 14 test color samples
+}
+
+// Reliable reports whether the source is close enough to the Planckian locus
+// for its color rendering index to be meaningful.
+func (c ColorRendering) Reliable() bool {
+	return c.DC < criMaxDC
+}
+
+// ColorRenderingIndex computes the CIE 13.3 color rendering index of a light
+// source from its spectral distribution.
+//
+// The reference illuminant is a Planckian radiator for sources below 5000K,
+// and a CIE daylight illuminant otherwise, both at the correlated color
+// temperature of the source. The test color samples are adapted with a
+// von Kries transform and compared in the CIE 1964 U*V*W* color space.
+//
+// See https://en.wikipedia.org/wiki/Color_rendering_index for more information.
+func ColorRenderingIndex(spd []float64) (ColorRendering, error) {
+	var cri ColorRendering
+
+	test, err := spectralWeights(Observer2, spd)
+	if err != nil {
+		return cri, err
+	}
+
+	uk, vk := xyzToUV(test.white())
+	cri.CCT, _ = uvToCCT(uk, vk)
+
+	var refSPD []float64
+	if cri.CCT < 5000 {
+		refSPD, err = PlanckianSpectrum(cri.CCT)
+	} else {
+		refSPD, err = DaylightSpectrum(math.Min(cri.CCT, 25000))
+	}
+	if err != nil {
+		return cri, err
+	}
+	ref, err := spectralWeights(Observer2, refSPD)
+	if err != nil {
+		return cri, err
+	}
+
+	ur, vr := xyzToUV(ref.white())
+	cri.DC = math.Hypot(uk-ur, vk-vr)
+
+	cd := func(u, v float64) (c, d float64) {
+		return (4 - u - 10*v) / v, (1.708*v + 0.404 - 1.481*u) / v
+	}
+	ck, dk := cd(uk, vk)
+	cr, dr := cd(ur, vr)
+
+	uvw := func(y, u, v float64) vector {
+		w := 25*math.Cbrt(100*y) - 17
+		return vector{13 * w * (u - ur), 13 * w * (v - vr), w}
+	}
+
+	for i, sample := range TestColorSamples {
+		xyzK := test.xyz(sample)
+		xyzR := ref.xyz(sample)
+
+		// Von Kries adaptation of the sample under the test source.
+		uki, vki := xyzToUV(xyzK)
+		cki, dki := cd(uki, vki)
+		den := 16.518 + 1.481*(cr/ck)*cki - (dr/dk)*dki
+		uka := (10.872 + 0.404*(cr/ck)*cki - 4*(dr/dk)*dki) / den
+		vka := 5.520 / den
+
+		uri, vri := xyzToUV(xyzR)
+
+		k := uvw(xyzK.v1, uka, vka)
+		r := uvw(xyzR.v1, uri, vri)
+
+		de := math.Sqrt(math.Pow(k.v0-r.v0, 2) + math.Pow(k.v1-r.v1, 2) + math.Pow(k.v2-r.v2, 2))
+		cri.Ri[i] = 100 - 4.6*de
+	}
+
+	for i := 0; i < 8; i++ {
+		cri.Ra += cri.Ri[i] / 8
+	}
+
+	return cri, nil
+}
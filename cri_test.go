@@ -0,0 +1,114 @@
+// Copyright © 2019 Xavier Basty <xavier@hexbee.net>
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package gocolor_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/Hexbee-net/gocolor"
+)
+
+func TestSpectralToCCT(t *testing.T) {
+	tests := []struct {
+		illuminant string
+		cct        float64
+	}{
+		{gocolor.RefIlluminantA, 2856},
+		{gocolor.RefIlluminantD50, 5003},
+		{gocolor.RefIlluminantD65, 6504},
+	}
+
+	for n := 0; n < len(tests); n++ {
+		cct, _, err := gocolor.SpectralToCCT(gocolor.IlluminantsSpectres[tests[n].illuminant])
+		assert.NoError(t, err)
+		assert.InDeltaf(t, tests[n].cct, cct, 5, "CCT is wrong for illuminant %v", tests[n].illuminant)
+	}
+
+	cct, duv, err := gocolor.XYZtoCCT(0.95047, 1.00000, 1.08883)
+	assert.NoError(t, err)
+	assert.InDelta(t, 6504, cct, 5)
+	assert.InDelta(t, 0.0032, duv, 1e-4)
+
+	_, _, err = gocolor.XYZtoCCT(0, 0, 0)
+	assert.Errorf(t, err, "black should return an error")
+}
+
+func TestDaylightSpectrum(t *testing.T) {
+	spd, err := gocolor.DaylightSpectrum(6504)
+	assert.NoError(t, err)
+
+	d65 := gocolor.IlluminantsSpectres[gocolor.RefIlluminantD65]
+	for i := range spd {
+		assert.InDeltaf(t, d65[i], spd[i], 0.5, "D65 is wrong for sample #%v", i)
+	}
+
+	_, err = gocolor.DaylightSpectrum(3000)
+	assert.Errorf(t, err, "invalid temperature should return an error")
+}
+
+func TestPlanckianSpectrum(t *testing.T) {
+	spd, err := gocolor.PlanckianSpectrum(2856)
+	assert.NoError(t, err)
+
+	a := gocolor.IlluminantsSpectres[gocolor.RefIlluminantA]
+	for i := range spd {
+		assert.InDeltaf(t, a[i], spd[i], 0.1, "illuminant A is wrong for sample #%v", i)
+	}
+
+	_, err = gocolor.PlanckianSpectrum(0)
+	assert.Errorf(t, err, "invalid temperature should return an error")
+}
+
+func TestColorRenderingIndex(t *testing.T) {
+	// The fluorescent illuminants of the package are the 5nm CIE tables
+	// sampled every 10nm, which misses mercury emission lines such as the
+	// ones at 405nm, 435nm and 545nm, so their indices differ from the ones
+	// of CIE 15:2004, table T.8.1 (F2: 64, F7: 90, F11: 83). The reference
+	// values were computed with an independent implementation of CIE 13.3
+	// using the 10nm tables of the package.
+	tests := []struct {
+		illuminant string
+		ra         float64
+		ri         [14]float64
+	}{
+		{gocolor.RefIlluminantF2, 59.08, [14]float64{53.99, 78.28, 90.64, 53.06, 49.35, 55.56, 71.95, 19.83, -91.17, 52.01, 42.52, 53.93, 59.28, 93.89}},
+		{gocolor.RefIlluminantF7, 91.27, [14]float64{89.64, 95.75, 97.38, 90.25, 89.97, 91.73, 93.61, 81.82, 57.07, 90.46, 89.02, 93.21, 91.45, 98.37}},
+		{gocolor.RefIlluminantF11, 85.71, [14]float64{97.55, 97.55, 58.37, 93.41, 84.60, 76.15, 92.45, 85.57, 6.02, 59.30, 77.36, 59.83, 94.61, 71.60}},
+	}
+
+	for n := 0; n < len(tests); n++ {
+		cri, err := gocolor.ColorRenderingIndex(gocolor.IlluminantsSpectres[tests[n].illuminant])
+		assert.NoError(t, err)
+		assert.True(t, cri.Reliable())
+		assert.InDeltaf(t, tests[n].ra, cri.Ra, 0.01, "Ra is wrong for illuminant %v", tests[n].illuminant)
+		assert.InDeltaSlicef(t, tests[n].ri[:], cri.Ri[:], 0.01, "Ri are wrong for illuminant %v", tests[n].illuminant)
+	}
+
+	// Reference illuminants render colors perfectly.
+	cri, err := gocolor.ColorRenderingIndex(gocolor.IlluminantsSpectres[gocolor.RefIlluminantA])
+	assert.NoError(t, err)
+	assert.InDelta(t, 100, cri.Ra, 0.01)
+
+	cri, err = gocolor.ColorRenderingIndex(gocolor.IlluminantsSpectres[gocolor.RefIlluminantD65])
+	assert.NoError(t, err)
+	for i, r := range cri.Ri {
+		assert.InDeltaf(t, 100, r, 0.1, "R%v is wrong for D65", i+1)
+	}
+
+	_, err = gocolor.ColorRenderingIndex([]float64{1, 2, 3})
+	assert.Errorf(t, err, "invalid spectral distribution should return an error")
+}
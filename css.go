@@ -0,0 +1,384 @@
+// Copyright © 2019 Xavier Basty <xavier@hexbee.net>
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package gocolor
+
+import (
+	"fmt"
+	"math"
+)
+
+// CSS color spaces, named as in the color() and color-mix() functions of the
+// CSS Color Module Level 4.
+const (
+	CSSSRGB        = "srgb"         // sRGB, from rgb(), hex colors and color names
+	CSSSRGBLinear  = "srgb-linear"  // Linear sRGB
+	CSSDisplayP3   = "display-p3"   // Display P3
+	CSSA98RGB      = "a98-rgb"      // Adobe RGB (1998)
+	CSSProPhotoRGB = "prophoto-rgb" // ProPhoto RGB
+	CSSRec2020     = "rec2020"      // ITU-R BT.2020
+	CSSXYZD50      = "xyz-d50"      // CIE XYZ, relative to illuminant D50
+	CSSXYZD65      = "xyz-d65"      // CIE XYZ, relative to illuminant D65
+	CSSHSL         = "hsl"          // HSL of sRGB
+	CSSHWB         = "hwb"          // HWB of sRGB
+	CSSLab         = "lab"          // CIELab, relative to illuminant D50
+	CSSLCh         = "lch"          // Polar form of CIELab
+	CSSOklab       = "oklab"        // Oklab
+	CSSOklch       = "oklch"        // Polar form of Oklab
+)
+
+// CSSColor is a color of the CSS Color Module Level 4, in the coordinates of
+// one of the CSS color spaces:
+//
+//	srgb, srgb-linear, display-p3, a98-rgb, prophoto-rgb, rec2020: R, G and B in the [0, 1] range
+//	xyz-d50, xyz-d65: X, Y and Z, with a luminance of 1 for the white
+//	hsl: hue in degrees, saturation and lightness in the [0, 100] range
+//	hwb: hue in degrees, whiteness and blackness in the [0, 100] range
+//	lab: L* in the [0, 100] range, a* and b*
+//	lch: L* in the [0, 100] range, chroma and hue in degrees
+//	oklab: L in the [0, 1] range, a and b
+//	oklch: L in the [0, 1] range, chroma and hue in degrees
+type CSSColor struct {
+	Space  string
+	Coords [3]float64
+
+	// Alpha is the opacity of the color, in the [0, 1] range.
+	Alpha float64
+
+	// Missing flags the components given as "none", the three coordinates
+	// followed by alpha. Missing components have a value of 0.
+	Missing [4]bool
+}
+
+// cssSpaceChannels lists the color spaces of CSS colors, with the names of
+// their channels in relative color syntax.
+var cssSpaceChannels = map[string][3]string{
+	CSSSRGB:        {"r", "g", "b"},
+	CSSSRGBLinear:  {"r", "g", "b"},
+	CSSDisplayP3:   {"r", "g", "b"},
+	CSSA98RGB:      {"r", "g", "b"},
+	CSSProPhotoRGB: {"r", "g", "b"},
+	CSSRec2020:     {"r", "g", "b"},
+	CSSXYZD50:      {"x", "y", "z"},
+	CSSXYZD65:      {"x", "y", "z"},
+	CSSHSL:         {"h", "s", "l"},
+	CSSHWB:         {"h", "w", "b"},
+	CSSLab:         {"l", "a", "b"},
+	CSSLCh:         {"l", "c", "h"},
+	CSSOklab:       {"l", "a", "b"},
+	CSSOklch:       {"l", "c", "h"},
+}
+
+// cssSpaceBases maps the CSS color spaces derived from another one to it.
+var cssSpaceBases = map[string]string{
+	CSSSRGB:       CSSSRGB,
+	CSSSRGBLinear: CSSSRGB,
+	CSSHSL:        CSSSRGB,
+	CSSHWB:        CSSSRGB,
+	CSSLab:        CSSLab,
+	CSSLCh:        CSSLab,
+	CSSOklab:      CSSOklab,
+	CSSOklch:      CSSOklab,
+}
+
+// cssRGBSpaces maps the CSS RGB color spaces to the gocolor ones.
+var cssRGBSpaces = map[string]string{
+	CSSSRGB:        SRGB,
+	CSSDisplayP3:   DisplayP3,
+	CSSA98RGB:      AdobeRGB,
+	CSSProPhotoRGB: ProPhotoRGB,
+	CSSRec2020:     BT2020,
+}
+
+// Chroma below which the hue of a converted color is powerless, and flagged as
+// missing. They absorb the rounding errors of the conversion matrices.
+const (
+	achromaticHSL   = 1e-4   // Saturation of HSL, and 100 - whiteness - blackness of HWB
+	achromaticLCh   = 0.0015 // Chroma of LCh
+	achromaticOklch = 0.0004 // Chroma of Oklch
+)
+
+// Convert converts the color to another CSS color space. Missing components
+// are treated as 0, and the hue of achromatic colors is flagged as missing in
+// polar color spaces.
+func (c CSSColor) Convert(space string) (CSSColor, error) {
+	if _, ok := cssSpaceChannels[space]; !ok {
+		return CSSColor{}, fmt.Errorf("unrecognized CSS color space: %v", space)
+	}
+	if c.Space == space {
+		return c, nil
+	}
+
+	v := vector{c.Coords[0], c.Coords[1], c.Coords[2]}
+	if base := cssSpaceBases[c.Space]; base != "" && base == cssSpaceBases[space] {
+		// Conversions within a family of spaces skip XYZ, which keeps them
+		// exact.
+		v = cssFromBase(space, cssToBase(c.Space, v))
+	} else {
+		xyz, err := cssToXYZ(c.Space, v)
+		if err != nil {
+			return CSSColor{}, err
+		}
+		if v, err = cssFromXYZ(space, xyz); err != nil {
+			return CSSColor{}, err
+		}
+	}
+
+	out := CSSColor{
+		Space:   space,
+		Coords:  [3]float64{v.v0, v.v1, v.v2},
+		Alpha:   c.Alpha,
+		Missing: [4]bool{false, false, false, c.Missing[3]},
+	}
+	switch {
+	case space == CSSHSL && v.v1 < achromaticHSL,
+		space == CSSHWB && v.v1+v.v2 > 100-achromaticHSL:
+		out.Coords[0], out.Missing[0] = 0, true
+	case space == CSSLCh && v.v1 < achromaticLCh,
+		space == CSSOklch && v.v1 < achromaticOklch:
+		out.Coords[2], out.Missing[2] = 0, true
+	}
+
+	return out, nil
+}
+
+// SRGB returns the sRGB coordinates of the color, which can be out of the
+// [0, 1] range for colors out of the sRGB gamut.
+func (c CSSColor) SRGB() (r, g, b float64, err error) {
+	s, err := c.Convert(CSSSRGB)
+	if err != nil {
+		return 0, 0, 0, err
+	}
+	return s.Coords[0], s.Coords[1], s.Coords[2], nil
+}
+
+////////////////////////////////////////
+
+var (
+	cssD50 = observerWhitePoints[Observer2][RefIlluminantD50]
+	cssD65 = observerWhitePoints[Observer2][RefIlluminantD65]
+
+	cssD50toD65 = getAdaptationMatrix(cssD50, cssD65, ChromaBradford)
+	cssD65toD50 = getAdaptationMatrix(cssD65, cssD50, ChromaBradford)
+)
+
+// cssToXYZ converts the coordinates of a CSS color space to XYZ relative to
+// illuminant D65.
+func cssToXYZ(space string, v vector) (vector, error) {
+	switch space {
+	case CSSSRGBLinear:
+		return conversionRgbXyz[SRGB].vdot(v), nil
+	case CSSXYZD65:
+		return v, nil
+	case CSSXYZD50:
+		return cssD50toD65.vdot(v), nil
+	case CSSHSL:
+		return cssToXYZ(CSSSRGB, hslToSRGB(v))
+	case CSSHWB:
+		return cssToXYZ(CSSSRGB, hwbToSRGB(v))
+	case CSSLab:
+		return cssD50toD65.vdot(labToXYZ(v, cssD50)), nil
+	case CSSLCh:
+		return cssToXYZ(CSSLab, lchToLab(v))
+	case CSSOklab:
+		return oklabToXYZ(v), nil
+	case CSSOklch:
+		return oklabToXYZ(lchToLab(v)), nil
+	}
+
+	rgb, ok := cssRGBSpaces[space]
+	if !ok {
+		return vector{}, fmt.Errorf("unrecognized CSS color space: %v", space)
+	}
+	xyz, err := rgbToXYZ(v, rgb)
+	if err != nil {
+		return vector{}, err
+	}
+	if RGBIlluminants[rgb] == RefIlluminantD50 {
+		xyz = cssD50toD65.vdot(xyz)
+	}
+	return xyz, nil
+}
+
+// cssFromXYZ converts XYZ coordinates relative to illuminant D65 to the
+// coordinates of a CSS color space.
+func cssFromXYZ(space string, xyz vector) (vector, error) {
+	switch space {
+	case CSSSRGBLinear:
+		return conversionXyzRgb[SRGB].vdot(xyz), nil
+	case CSSXYZD65:
+		return xyz, nil
+	case CSSXYZD50:
+		return cssD65toD50.vdot(xyz), nil
+	case CSSHSL, CSSHWB:
+		rgb, err := cssFromXYZ(CSSSRGB, xyz)
+		if err != nil {
+			return vector{}, err
+		}
+		if space == CSSHSL {
+			return srgbToHSL(rgb), nil
+		}
+		return srgbToHWB(rgb), nil
+	case CSSLab:
+		return xyzToLab(cssD65toD50.vdot(xyz), cssD50), nil
+	case CSSLCh:
+		return labToLCh(xyzToLab(cssD65toD50.vdot(xyz), cssD50)), nil
+	case CSSOklab:
+		return xyzToOklab(xyz), nil
+	case CSSOklch:
+		return labToLCh(xyzToOklab(xyz)), nil
+	}
+
+	rgb, ok := cssRGBSpaces[space]
+	if !ok {
+		return vector{}, fmt.Errorf("unrecognized CSS color space: %v", space)
+	}
+	if RGBIlluminants[rgb] == RefIlluminantD50 {
+		xyz = cssD65toD50.vdot(xyz)
+	}
+	return xyzToRGB(xyz, rgb)
+}
+
+// cssToBase converts the coordinates of a CSS color space to the ones of the
+// space it derives from.
+func cssToBase(space string, v vector) vector {
+	switch space {
+	case CSSSRGBLinear:
+		compand, _ := rgbCompanding(SRGB)
+		return v.mapfunc(compand)
+	case CSSHSL:
+		return hslToSRGB(v)
+	case CSSHWB:
+		return hwbToSRGB(v)
+	case CSSLCh, CSSOklch:
+		return lchToLab(v)
+	}
+	return v
+}
+
+// cssFromBase converts the coordinates of the space a CSS color space derives
+// from to the ones of the space.
+func cssFromBase(space string, v vector) vector {
+	switch space {
+	case CSSSRGBLinear:
+		linearize, _ := rgbLinearization(SRGB)
+		return v.mapfunc(linearize)
+	case CSSHSL:
+		return srgbToHSL(v)
+	case CSSHWB:
+		return srgbToHWB(v)
+	case CSSLCh, CSSOklch:
+		return labToLCh(v)
+	}
+	return v
+}
+
+// lchToLab converts polar coordinates to rectangular ones, with the hue in
+// degrees.
+func lchToLab(v vector) vector {
+	h := radians(v.v2)
+	return vector{v.v0, v.v1 * math.Cos(h), v.v1 * math.Sin(h)}
+}
+
+// labToLCh converts rectangular coordinates to polar ones, with the hue in
+// degrees in the [0, 360) range.
+func labToLCh(v vector) vector {
+	h := degrees(math.Atan2(v.v2, v.v1))
+	if h < 0 {
+		h += 360
+	}
+	return vector{v.v0, math.Hypot(v.v1, v.v2), h}
+}
+
+// hslToSRGB converts HSL coordinates, with the saturation and lightness in the
+// [0, 100] range, to sRGB.
+func hslToSRGB(v vector) vector {
+	h := math.Mod(v.v0, 360)
+	if h < 0 {
+		h += 360
+	}
+	s, l := v.v1/100, v.v2/100
+
+	f := func(n float64) float64 {
+		k := math.Mod(n+h/30, 12)
+		a := s * math.Min(l, 1-l)
+		return l - a*math.Max(-1, math.Min(k-3, math.Min(9-k, 1)))
+	}
+	return vector{f(0), f(8), f(4)}
+}
+
+// srgbToHSL converts sRGB coordinates to HSL, with the saturation and
+// lightness in the [0, 100] range.
+func srgbToHSL(v vector) vector {
+	maxVal, minVal := max(v.v0, v.v1, v.v2), min(v.v0, v.v1, v.v2)
+	d := maxVal - minVal
+	l := (maxVal + minVal) / 2
+
+	var h, s float64
+	if d != 0 {
+		if l != 0 && l != 1 {
+			s = (maxVal - l) / math.Min(l, 1-l)
+		}
+		h = rgbHue(v, maxVal, d)
+	}
+
+	// Negative saturations of out of gamut colors are turned around.
+	if s < 0 {
+		s = -s
+		h = math.Mod(h+180, 360)
+	}
+	return vector{h, s * 100, l * 100}
+}
+
+// hwbToSRGB converts HWB coordinates, with the whiteness and blackness in the
+// [0, 100] range, to sRGB.
+func hwbToSRGB(v vector) vector {
+	w, b := v.v1/100, v.v2/100
+	if w+b >= 1 {
+		gray := w / (w + b)
+		return vector{gray, gray, gray}
+	}
+
+	rgb := hslToSRGB(vector{v.v0, 100, 50})
+	f := func(c float64) float64 { return c*(1-w-b) + w }
+	return vector{f(rgb.v0), f(rgb.v1), f(rgb.v2)}
+}
+
+// srgbToHWB converts sRGB coordinates to HWB, with the whiteness and
+// blackness in the [0, 100] range.
+func srgbToHWB(v vector) vector {
+	maxVal, minVal := max(v.v0, v.v1, v.v2), min(v.v0, v.v1, v.v2)
+	var h float64
+	if d := maxVal - minVal; d != 0 {
+		h = rgbHue(v, maxVal, d)
+	}
+	return vector{h, minVal * 100, (1 - maxVal) * 100}
+}
+
+// rgbHue returns the hue of an RGB color in degrees, in the [0, 360) range.
+func rgbHue(v vector, maxVal, d float64) float64 {
+	var h float64
+	switch maxVal {
+	case v.v0:
+		h = (v.v1-v.v2)/d + 6
+		if v.v1 >= v.v2 {
+			h -= 6
+		}
+	case v.v1:
+		h = (v.v2-v.v0)/d + 2
+	default:
+		h = (v.v0-v.v1)/d + 4
+	}
+	return math.Mod(h*60, 360)
+}
@@ -0,0 +1,262 @@
+// Copyright © 2019 Xavier Basty <xavier@hexbee.net>
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package gocolor
+
+import (
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+
+	"github.com/Hexbee-net/gocolor/internal/interp"
+)
+
+// CSS notations of colors.
+const (
+	CSSNotationHex   = "hex"   // #RGB, #RGBA, #RRGGBB or #RRGGBBAA
+	CSSNotationRGB   = "rgb"   // rgb()
+	CSSNotationHSL   = "hsl"   // hsl()
+	CSSNotationHWB   = "hwb"   // hwb()
+	CSSNotationLab   = "lab"   // lab()
+	CSSNotationLCh   = "lch"   // lch()
+	CSSNotationOklab = "oklab" // oklab()
+	CSSNotationOklch = "oklch" // oklch()
+	CSSNotationColor = "color" // color(), with a predefined color space
+)
+
+// DefaultCSSPrecision is the default number of decimals of serialized colors.
+const DefaultCSSPrecision = 5
+
+// CSSFormat describes the serialization of a color.
+type CSSFormat struct {
+	// Notation of the color. Defaults to the notation of the color space of
+	// the color: rgb() for srgb, color() for the predefined RGB and XYZ color
+	// spaces, and the function of the same name for the other ones.
+	Notation string
+
+	// Color space of the color() notation. Defaults to the color space of the
+	// color when it has a color() form, and to srgb otherwise.
+	Space string
+
+	// Maximal number of decimals of the numbers, with trailing zeros removed.
+	// Defaults to DefaultCSSPrecision, a negative value rounds to integers.
+	// Hex colors are always rounded to 8 bits.
+	Precision int
+
+	// Whether to use the comma separated syntax of rgb() and hsl(), with
+	// rgba() and hsla() for translucent colors.
+	Legacy bool
+}
+
+// cssNotationSpaces maps the notations to their color space.
+var cssNotationSpaces = map[string]string{
+	CSSNotationHex:   CSSSRGB,
+	CSSNotationRGB:   CSSSRGB,
+	CSSNotationHSL:   CSSHSL,
+	CSSNotationHWB:   CSSHWB,
+	CSSNotationLab:   CSSLab,
+	CSSNotationLCh:   CSSLCh,
+	CSSNotationOklab: CSSOklab,
+	CSSNotationOklch: CSSOklch,
+}
+
+// Format serializes the color in CSS.
+//
+// Colors out of the sRGB gamut are clipped in the hex, rgb(), hsl() and hwb()
+// notations, and missing components are serialized as none, except in the
+// hex notation and the legacy syntax where they are 0.
+func (c CSSColor) Format(f CSSFormat) (string, error) {
+	notation := f.Notation
+	if notation == "" {
+		notation = CSSNotationColor
+		for n, s := range cssNotationSpaces {
+			if s == c.Space && n != CSSNotationHex {
+				notation = n
+			}
+		}
+	}
+
+	space, ok := cssNotationSpaces[notation]
+	if notation == CSSNotationColor {
+		space = f.Space
+		if space == "" {
+			space = c.Space
+			if !isColorFunctionSpace(space) {
+				space = CSSSRGB
+			}
+		}
+		if _, ok := cssSpaceChannels[space]; !ok || !isColorFunctionSpace(space) {
+			return "", fmt.Errorf("unrecognized color() color space: %v", space)
+		}
+	} else if !ok {
+		return "", fmt.Errorf("unrecognized CSS notation: %v", notation)
+	}
+
+	precision := f.Precision
+	if precision == 0 {
+		precision = DefaultCSSPrecision
+	} else if precision < 0 {
+		precision = 0
+	}
+
+	v, err := c.Convert(space)
+	if err != nil {
+		return "", err
+	}
+	legacy := f.Legacy && (notation == CSSNotationRGB || notation == CSSNotationHSL)
+	if legacy || notation == CSSNotationHex {
+		v.Missing = [4]bool{}
+	}
+
+	// Clip colors to the sRGB gamut.
+	switch notation {
+	case CSSNotationHex, CSSNotationRGB:
+		for i := range v.Coords {
+			v.Coords[i] = interp.Clamp01(v.Coords[i])
+		}
+	case CSSNotationHSL, CSSNotationHWB:
+		rgb := cssToBase(space, vector{v.Coords[0], v.Coords[1], v.Coords[2]})
+		if !inUnitCube(rgb) {
+			clipped := cssFromBase(space, vector{interp.Clamp01(rgb.v0), interp.Clamp01(rgb.v1), interp.Clamp01(rgb.v2)})
+			v.Coords = [3]float64{clipped.v0, clipped.v1, clipped.v2}
+		}
+	}
+
+	if notation == CSSNotationHex {
+		return formatCSSHex(v.Coords, interp.Clamp01(v.Alpha)), nil
+	}
+
+	num := func(i int, scale float64, unit string) string {
+		if v.Missing[i] {
+			return "none"
+		}
+		return formatCSSNumber(v.Coords[i]*scale, precision) + unit
+	}
+
+	var args [3]string
+	switch notation {
+	case CSSNotationRGB:
+		for i := range args {
+			args[i] = num(i, 255, "")
+		}
+	case CSSNotationHSL, CSSNotationHWB:
+		args = [3]string{num(0, 1, ""), num(1, 1, "%"), num(2, 1, "%")}
+	default:
+		for i := range args {
+			args[i] = num(i, 1, "")
+		}
+	}
+
+	var alpha string
+	switch {
+	case v.Missing[3]:
+		alpha = "none"
+	case v.Alpha < 1:
+		alpha = formatCSSAlpha(interp.Clamp01(v.Alpha), precision)
+	}
+
+	var b strings.Builder
+	b.WriteString(notation)
+	if legacy && alpha != "" {
+		b.WriteByte('a')
+	}
+	b.WriteByte('(')
+	if notation == CSSNotationColor {
+		b.WriteString(space)
+		b.WriteByte(' ')
+	}
+	if legacy {
+		b.WriteString(strings.Join(args[:], ", "))
+		if alpha != "" {
+			b.WriteString(", ")
+			b.WriteString(alpha)
+		}
+	} else {
+		b.WriteString(strings.Join(args[:], " "))
+		if alpha != "" {
+			b.WriteString(" / ")
+			b.WriteString(alpha)
+		}
+	}
+	b.WriteByte(')')
+
+	return b.String(), nil
+}
+
+// String serializes the color in CSS, in the notation of its color space.
+func (c CSSColor) String() string {
+	s, err := c.Format(CSSFormat{})
+	if err != nil {
+		return fmt.Sprintf("%%!(%v)", err)
+	}
+	return s
+}
+
+// formatCSSHex serializes sRGB coordinates and alpha in the shortest hex
+// notation.
+func formatCSSHex(rgb [3]float64, alpha float64) string {
+	bytes := []uint8{
+		uint8(math.Round(rgb[0] * 255)),
+		uint8(math.Round(rgb[1] * 255)),
+		uint8(math.Round(rgb[2] * 255)),
+		uint8(math.Round(alpha * 255)),
+	}
+	if bytes[3] == 0xff {
+		bytes = bytes[:3]
+	}
+
+	short := true
+	for _, v := range bytes {
+		short = short && v>>4 == v&0xf
+	}
+
+	var b strings.Builder
+	b.WriteByte('#')
+	for _, v := range bytes {
+		if short {
+			fmt.Fprintf(&b, "%x", v&0xf)
+		} else {
+			fmt.Fprintf(&b, "%02x", v)
+		}
+	}
+	return b.String()
+}
+
+// formatCSSAlpha formats an alpha value. As in CSSOM, 8 bits values, such as
+// the ones of hex colors, are serialized with the fewest decimals rounding to
+// the same 8 bits value: 0.5 rather than 0.50196 for 0x80.
+func formatCSSAlpha(alpha float64, precision int) string {
+	b := math.Round(alpha * 255)
+	if math.Abs(alpha*255-b) < 1e-9 {
+		for d := 0; d < precision; d++ {
+			p := math.Pow(10, float64(d))
+			if math.Round(math.Round(alpha*p)/p*255) == b {
+				return formatCSSNumber(alpha, d)
+			}
+		}
+	}
+	return formatCSSNumber(alpha, precision)
+}
+
+// formatCSSNumber formats a number with at most the given number of decimals.
+func formatCSSNumber(v float64, precision int) string {
+	p := math.Pow(10, float64(precision))
+	v = math.Round(v*p) / p
+	if v == 0 {
+		// No negative zero.
+		v = 0
+	}
+	return strconv.FormatFloat(v, 'f', -1, 64)
+}
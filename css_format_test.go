@@ -0,0 +1,117 @@
+// Copyright © 2019 Xavier Basty <xavier@hexbee.net>
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package gocolor_test
+
+import (
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/Hexbee-net/gocolor"
+)
+
+func TestCSSColorFormat(t *testing.T) {
+	tests := []struct {
+		input    string
+		format   gocolor.CSSFormat
+		expected string
+	}{
+		{"#ff0000", gocolor.CSSFormat{Notation: gocolor.CSSNotationHex}, "#f00"},
+		{"#ff000080", gocolor.CSSFormat{Notation: gocolor.CSSNotationHex}, "#ff000080"},
+		{"#ff0000ff", gocolor.CSSFormat{Notation: gocolor.CSSNotationHex}, "#f00"},
+		{"#11223344", gocolor.CSSFormat{Notation: gocolor.CSSNotationHex}, "#1234"},
+		{"rgb(18 52 86)", gocolor.CSSFormat{Notation: gocolor.CSSNotationHex}, "#123456"},
+		{"hsl(120 100% 25%)", gocolor.CSSFormat{Notation: gocolor.CSSNotationHex}, "#008000"},
+		{"color(display-p3 1 0 0)", gocolor.CSSFormat{Notation: gocolor.CSSNotationHex}, "#f00"},
+		{"#f00", gocolor.CSSFormat{}, "rgb(255 0 0)"},
+		{"#ff000080", gocolor.CSSFormat{}, "rgb(255 0 0 / 0.5)"},
+		{"#0000ff33", gocolor.CSSFormat{}, "rgb(0 0 255 / 0.2)"},
+		{"#00000001", gocolor.CSSFormat{}, "rgb(0 0 0 / 0.004)"},
+		{"rgb(0 0 0 / 0.50196)", gocolor.CSSFormat{}, "rgb(0 0 0 / 0.50196)"},
+		{"#ff000080", gocolor.CSSFormat{Precision: 2}, "rgb(255 0 0 / 0.5)"},
+		{"rgb(127.5 0 0)", gocolor.CSSFormat{Precision: -1}, "rgb(128 0 0)"},
+		{"#ff000080", gocolor.CSSFormat{Legacy: true, Precision: 2}, "rgba(255, 0, 0, 0.5)"},
+		{"#f00", gocolor.CSSFormat{Notation: gocolor.CSSNotationHSL, Legacy: true}, "hsl(0, 100%, 50%)"},
+		{"rgb(none 0 0)", gocolor.CSSFormat{Legacy: true}, "rgb(0, 0, 0)"},
+		{"#0f0", gocolor.CSSFormat{Notation: gocolor.CSSNotationHSL}, "hsl(120 100% 50%)"},
+		{"#0f0", gocolor.CSSFormat{Notation: gocolor.CSSNotationHWB}, "hwb(120 0% 0%)"},
+		{"#808080", gocolor.CSSFormat{Notation: gocolor.CSSNotationHSL, Precision: 2}, "hsl(none 0% 50.2%)"},
+		{"#f00", gocolor.CSSFormat{Notation: gocolor.CSSNotationLab, Precision: 1}, "lab(54.3 80.8 69.9)"},
+		{"#f00", gocolor.CSSFormat{Notation: gocolor.CSSNotationOklch, Precision: 2}, "oklch(0.63 0.26 29.23)"},
+		{"#f00", gocolor.CSSFormat{Notation: gocolor.CSSNotationColor, Space: gocolor.CSSDisplayP3, Precision: 3}, "color(display-p3 0.918 0.2 0.139)"},
+		{"#f00", gocolor.CSSFormat{Notation: gocolor.CSSNotationColor}, "color(srgb 1 0 0)"},
+		{"lch(50 30 40 / none)", gocolor.CSSFormat{}, "lch(50 30 40 / none)"},
+		{"color(rec2020 0.5 0.25 1.5)", gocolor.CSSFormat{}, "color(rec2020 0.5 0.25 1.5)"},
+		{"color(xyz 0.2 0.3 0.4)", gocolor.CSSFormat{}, "color(xyz-d65 0.2 0.3 0.4)"},
+		{"oklab(0.5 -0.00001 0)", gocolor.CSSFormat{Precision: 3}, "oklab(0.5 0 0)"},
+	}
+	for _, test := range tests {
+		c, err := gocolor.ParseCSSColor(test.input)
+		require.NoError(t, err, test.input)
+		s, err := c.Format(test.format)
+		require.NoError(t, err, test.input)
+		assert.Equal(t, test.expected, s, test.input)
+	}
+}
+
+func TestCSSColorFormatGamut(t *testing.T) {
+	// A Display P3 red, out of the sRGB gamut.
+	c, err := gocolor.ParseCSSColor("color(display-p3 1 0 0)")
+	require.NoError(t, err)
+
+	s, err := c.Format(gocolor.CSSFormat{Notation: gocolor.CSSNotationRGB})
+	require.NoError(t, err)
+	assert.Equal(t, "rgb(255 0 0)", s)
+
+	s, err = c.Format(gocolor.CSSFormat{Notation: gocolor.CSSNotationHSL})
+	require.NoError(t, err)
+	assert.Equal(t, "hsl(0 100% 50%)", s)
+
+	_, err = c.Format(gocolor.CSSFormat{Notation: "unknown"})
+	assert.Error(t, err)
+	_, err = c.Format(gocolor.CSSFormat{Notation: gocolor.CSSNotationColor, Space: gocolor.CSSOklab})
+	assert.Error(t, err)
+}
+
+func TestCSSColorRoundTrip(t *testing.T) {
+	inputs := []string{
+		"#12345678",
+		"rgb(10 20 30 / 0.4)",
+		"hsl(200 50% 40%)",
+		"hwb(10 20% 30%)",
+		"lab(40 -20 30)",
+		"lch(60 40 200 / 0.25)",
+		"oklab(0.6 0.1 -0.1)",
+		"oklch(0.7 0.1 none)",
+		"color(prophoto-rgb 0.3 0.4 0.5)",
+		"color(srgb-linear 0.3 0.4 0.5)",
+		"color(a98-rgb 0.3 0.4 0.5)",
+		"color(xyz-d50 0.3 0.4 0.5)",
+	}
+	for _, input := range inputs {
+		c, err := gocolor.ParseCSSColor(input)
+		require.NoError(t, err, input)
+		parsed, err := gocolor.ParseCSSColor(c.String())
+		require.NoError(t, err, c.String())
+		assert.Equal(t, c.Space, parsed.Space, input)
+		assert.Equal(t, c.Missing, parsed.Missing, input)
+		assert.InDeltaSlice(t, c.Coords[:], parsed.Coords[:], 1e-5, input)
+		// 8 bits alphas are serialized with the fewest decimals that round to
+		// the same 8 bits value.
+		assert.Equal(t, math.Round(c.Alpha*255), math.Round(parsed.Alpha*255), input)
+	}
+}
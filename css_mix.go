@@ -0,0 +1,221 @@
+// Copyright © 2019 Xavier Basty <xavier@hexbee.net>
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package gocolor
+
+import (
+	"errors"
+	"fmt"
+	"math"
+)
+
+// Hue interpolation methods of color-mix().
+const (
+	HueShorter    = "shorter"    // Along the shorter arc between the hues
+	HueLonger     = "longer"     // Along the longer arc between the hues
+	HueIncreasing = "increasing" // With increasing hue angles
+	HueDecreasing = "decreasing" // With decreasing hue angles
+)
+
+// cssAnalogousChannels maps the channels of the color spaces to their
+// category, for the missing components carried forward to the interpolation
+// space.
+var cssAnalogousChannels = map[string][3]string{
+	CSSSRGB:        {"red", "green", "blue"},
+	CSSSRGBLinear:  {"red", "green", "blue"},
+	CSSDisplayP3:   {"red", "green", "blue"},
+	CSSA98RGB:      {"red", "green", "blue"},
+	CSSProPhotoRGB: {"red", "green", "blue"},
+	CSSRec2020:     {"red", "green", "blue"},
+	CSSXYZD50:      {"red", "green", "blue"},
+	CSSXYZD65:      {"red", "green", "blue"},
+	CSSHSL:         {"hue", "colorfulness", "lightness"},
+	CSSHWB:         {"hue", "", ""},
+	CSSLab:         {"lightness", "opposing a", "opposing b"},
+	CSSLCh:         {"lightness", "colorfulness", "hue"},
+	CSSOklab:       {"lightness", "opposing a", "opposing b"},
+	CSSOklch:       {"lightness", "colorfulness", "hue"},
+}
+
+// MixCSSColors mixes two colors following the color-mix() function of the CSS
+// Color Module Level 5, in the given interpolation color space.
+//
+// The weights p1 and p2 of the colors are in the [0, 1] range: they are
+// normalized when their sum differs from 1, and a sum lower than 1 scales the
+// alpha of the result. The hue method is used by the polar color spaces, and
+// defaults to HueShorter.
+//
+// The interpolation is done with premultiplied alpha, unless the alpha of
+// both colors is missing, and a component missing from one of the colors takes
+// the value of the other one.
+func MixCSSColors(space, hue string, c1 CSSColor, p1 float64, c2 CSSColor, p2 float64) (CSSColor, error) {
+	if _, ok := cssSpaceChannels[space]; !ok {
+		return CSSColor{}, fmt.Errorf("unrecognized CSS color space: %v", space)
+	}
+	switch hue {
+	case "", HueShorter, HueLonger, HueIncreasing, HueDecreasing:
+	default:
+		return CSSColor{}, fmt.Errorf("unrecognized hue interpolation method: %v", hue)
+	}
+	if p1 < 0 || p1 > 1 {
+		return CSSColor{}, fmt.Errorf("weight is out of the [0, 1] range (%v)", p1)
+	}
+	if p2 < 0 || p2 > 1 {
+		return CSSColor{}, fmt.Errorf("weight is out of the [0, 1] range (%v)", p2)
+	}
+	sum := p1 + p2
+	if sum == 0 {
+		return CSSColor{}, errors.New("weights sum to 0")
+	}
+	alphaScale := math.Min(sum, 1)
+	t := p2 / sum
+
+	a, err := toInterpolationSpace(c1, space)
+	if err != nil {
+		return CSSColor{}, err
+	}
+	b, err := toInterpolationSpace(c2, space)
+	if err != nil {
+		return CSSColor{}, err
+	}
+
+	hueIndex := -1
+	for i, category := range cssAnalogousChannels[space] {
+		if category == "hue" {
+			hueIndex = i
+		}
+	}
+
+	// Missing components take the value of the other color.
+	out := CSSColor{Space: space}
+	for i := range out.Missing {
+		switch {
+		case a.Missing[i] && b.Missing[i]:
+			out.Missing[i] = true
+		case a.Missing[i]:
+			a.set(i, b.get(i))
+		case b.Missing[i]:
+			b.set(i, a.get(i))
+		}
+	}
+
+	// Premultiplied alpha. Colors whose alphas are both missing are mixed
+	// as opaque ones.
+	alphaA, alphaB := a.Alpha, b.Alpha
+	if out.Missing[3] {
+		alphaA, alphaB = 1, 1
+	}
+	alpha := alphaA + (alphaB-alphaA)*t
+	for i := range out.Coords {
+		if out.Missing[i] {
+			continue
+		}
+		if i == hueIndex {
+			out.Coords[i] = interpolateHue(a.Coords[i], b.Coords[i], t, hue)
+			continue
+		}
+
+		v := a.Coords[i]*alphaA + (b.Coords[i]*alphaB-a.Coords[i]*alphaA)*t
+		if alpha != 0 {
+			v /= alpha
+		}
+		out.Coords[i] = v
+	}
+
+	if !out.Missing[3] {
+		out.Alpha = alpha * alphaScale
+	}
+
+	return out, nil
+}
+
+// toInterpolationSpace converts a color to the interpolation color space,
+// carrying its missing components forward to the analogous components.
+func toInterpolationSpace(c CSSColor, space string) (CSSColor, error) {
+	out, err := c.Convert(space)
+	if err != nil {
+		return CSSColor{}, err
+	}
+
+	src, dst := cssAnalogousChannels[c.Space], cssAnalogousChannels[space]
+	for i, missing := range c.Missing[:3] {
+		if !missing || src[i] == "" {
+			continue
+		}
+		for j := range dst {
+			if dst[j] == src[i] {
+				out.Coords[j], out.Missing[j] = 0, true
+			}
+		}
+	}
+
+	return out, nil
+}
+
+func (c *CSSColor) get(i int) float64 {
+	if i == 3 {
+		return c.Alpha
+	}
+	return c.Coords[i]
+}
+
+func (c *CSSColor) set(i int, v float64) {
+	if i == 3 {
+		c.Alpha = v
+	} else {
+		c.Coords[i] = v
+	}
+	c.Missing[i] = false
+}
+
+// interpolateHue interpolates between two hues in degrees.
+func interpolateHue(h1, h2, t float64, method string) float64 {
+	h1, h2 = math.Mod(h1, 360), math.Mod(h2, 360)
+	if h1 < 0 {
+		h1 += 360
+	}
+	if h2 < 0 {
+		h2 += 360
+	}
+
+	d := h2 - h1
+	switch method {
+	case "", HueShorter:
+		if d > 180 {
+			h1 += 360
+		} else if d < -180 {
+			h2 += 360
+		}
+	case HueLonger:
+		if 0 < d && d < 180 {
+			h1 += 360
+		} else if -180 < d && d <= 0 {
+			h2 += 360
+		}
+	case HueIncreasing:
+		if d < 0 {
+			h2 += 360
+		}
+	case HueDecreasing:
+		if d > 0 {
+			h1 += 360
+		}
+	}
+
+	h := math.Mod(h1+(h2-h1)*t, 360)
+	if h < 0 {
+		h += 360
+	}
+	return h
+}
@@ -0,0 +1,164 @@
+// Copyright © 2019 Xavier Basty <xavier@hexbee.net>
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package gocolor_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/Hexbee-net/gocolor"
+)
+
+func TestColorMix(t *testing.T) {
+	tests := []struct {
+		input  string
+		space  string
+		coords [3]float64
+		alpha  float64
+	}{
+		{"color-mix(in srgb, red, blue)", gocolor.CSSSRGB, [3]float64{0.5, 0, 0.5}, 1},
+		{"color-mix(in srgb, red 30%, blue)", gocolor.CSSSRGB, [3]float64{0.3, 0, 0.7}, 1},
+		{"color-mix(in srgb, 30% red, blue)", gocolor.CSSSRGB, [3]float64{0.3, 0, 0.7}, 1},
+		{"color-mix(in srgb, red, 30% blue)", gocolor.CSSSRGB, [3]float64{0.7, 0, 0.3}, 1},
+		{"color-mix(in srgb, red 60%, blue 60%)", gocolor.CSSSRGB, [3]float64{0.5, 0, 0.5}, 1},
+		{"color-mix(in srgb, red 20%, blue 20%)", gocolor.CSSSRGB, [3]float64{0.5, 0, 0.5}, 0.4},
+		{"color-mix(in srgb, rgb(255 0 0 / 0.5), blue)", gocolor.CSSSRGB, [3]float64{1.0 / 3, 0, 2.0 / 3}, 0.75},
+		{"color-mix(in srgb-linear, white, black)", gocolor.CSSSRGBLinear, [3]float64{0.5, 0.5, 0.5}, 1},
+		{"color-mix(in hsl, hsl(10 100% 50%), hsl(350 100% 50%))", gocolor.CSSHSL, [3]float64{0, 100, 50}, 1},
+		{"color-mix(in hsl shorter hue, hsl(10 100% 50%), hsl(350 100% 50%))", gocolor.CSSHSL, [3]float64{0, 100, 50}, 1},
+		{"color-mix(in hsl longer hue, hsl(10 100% 50%), hsl(350 100% 50%))", gocolor.CSSHSL, [3]float64{180, 100, 50}, 1},
+		{"color-mix(in hsl increasing hue, hsl(10 100% 50%), hsl(350 100% 50%))", gocolor.CSSHSL, [3]float64{180, 100, 50}, 1},
+		{"color-mix(in hsl decreasing hue, hsl(10 100% 50%), hsl(350 100% 50%))", gocolor.CSSHSL, [3]float64{0, 100, 50}, 1},
+		{"color-mix(in hsl decreasing hue, hsl(350 100% 50%), hsl(10 100% 50%))", gocolor.CSSHSL, [3]float64{180, 100, 50}, 1},
+		{"color-mix(in oklch, oklch(0.5 0.1 none), oklch(0.7 0.2 120))", gocolor.CSSOklch, [3]float64{0.6, 0.15, 120}, 1},
+		{"color-mix(in oklch, lch(50 none 120), oklch(0.6 0.2 120))", gocolor.CSSOklch, [3]float64{0.584, 0.2, 120}, 1},
+		{"color-mix(in lab, lab(40 10 20 / none), lab(60 30 40 / 0.5))", gocolor.CSSLab, [3]float64{50, 20, 30}, 0.5},
+		{"rgb(from color-mix(in srgb, red, blue) r g b / 0.5)", gocolor.CSSSRGB, [3]float64{0.5, 0, 0.5}, 0.5},
+	}
+	for _, test := range tests {
+		c, err := gocolor.ParseCSSColor(test.input)
+		require.NoError(t, err, test.input)
+		assert.Equal(t, test.space, c.Space, test.input)
+		assert.InDeltaSlice(t, test.coords[:], c.Coords[:], 1e-2, test.input)
+		assert.InDelta(t, test.alpha, c.Alpha, 1e-9, test.input)
+	}
+}
+
+func TestColorMixMissing(t *testing.T) {
+	// The powerless hue of white takes the hue of blue.
+	c, err := gocolor.ParseCSSColor("color-mix(in oklch, white, blue)")
+	require.NoError(t, err)
+	blue, err := gocolor.ParseCSSColor("oklch(from blue l c h)")
+	require.NoError(t, err)
+	assert.InDelta(t, blue.Coords[2], c.Coords[2], 1e-9)
+	assert.False(t, c.Missing[2])
+
+	// Components missing from both colors stay missing.
+	c, err = gocolor.ParseCSSColor("color-mix(in oklch, oklch(0.5 0.1 none), oklch(0.7 0.2 none))")
+	require.NoError(t, err)
+	assert.True(t, c.Missing[2])
+
+	// Colors whose alphas are both missing are not premultiplied.
+	c, err = gocolor.ParseCSSColor("color-mix(in srgb, rgb(255 0 0 / none), rgb(0 0 255 / none))")
+	require.NoError(t, err)
+	assert.Equal(t, "rgb(127.5 0 127.5 / none)", c.String())
+}
+
+func TestColorMixErrors(t *testing.T) {
+	tests := []struct {
+		input  string
+		offset int
+	}{
+		{"color-mix(srgb, red, blue)", 10},
+		{"color-mix(in foo, red, blue)", 13},
+		{"color-mix(in srgb longer hue, red, blue)", 18},
+		{"color-mix(in hsl sideways hue, red, blue)", 17},
+		{"color-mix(in srgb red, blue)", 18},
+		{"color-mix(in srgb, red 150%, blue)", 23},
+		{"color-mix(in srgb, red 0.5, blue)", 23},
+		{"color-mix(in srgb, red 0%, blue 0%)", 0},
+		{"color-mix(in srgb, red, blue", 28},
+	}
+	for _, test := range tests {
+		_, err := gocolor.ParseCSSColor(test.input)
+		require.Error(t, err, test.input)
+		syntaxErr, ok := err.(*gocolor.CSSSyntaxError)
+		require.True(t, ok, test.input)
+		assert.Equal(t, test.offset, syntaxErr.Offset, "%v: %v", test.input, err)
+	}
+
+	red := gocolor.CSSColor{Space: gocolor.CSSSRGB, Coords: [3]float64{1, 0, 0}, Alpha: 1}
+	_, err := gocolor.MixCSSColors("foo", "", red, 0.5, red, 0.5)
+	assert.Error(t, err)
+	_, err = gocolor.MixCSSColors(gocolor.CSSSRGB, "foo", red, 0.5, red, 0.5)
+	assert.Error(t, err)
+	_, err = gocolor.MixCSSColors(gocolor.CSSSRGB, "", red, -0.5, red, 0.5)
+	assert.Error(t, err)
+	_, err = gocolor.MixCSSColors(gocolor.CSSSRGB, "", red, 0, red, 0)
+	assert.Error(t, err)
+}
+
+func TestParseCSSColorVars(t *testing.T) {
+	vars := map[string]string{
+		"--accent":  "#f00",
+		"--mixed":   "color-mix(in srgb, var(--accent), blue)",
+		"--red":     " 255 ",
+		"--broken":  "rgb(1 2)",
+		"--cycle":   "var(--cycle)",
+		"--percent": "25%",
+	}
+
+	tests := []struct {
+		input  string
+		coords [3]float64
+		alpha  float64
+	}{
+		{"var(--accent)", [3]float64{1, 0, 0}, 1},
+		{"rgb(from var(--accent) r g b / 50%)", [3]float64{1, 0, 0}, 0.5},
+		{"rgb(from var(--mixed) r g b)", [3]float64{0.5, 0, 0.5}, 1},
+		{"rgb(var(--red) 0 0)", [3]float64{1, 0, 0}, 1},
+		{"var(--undefined, blue)", [3]float64{0, 0, 1}, 1},
+		{"var(--undefined, rgb(0 0 255))", [3]float64{0, 0, 1}, 1},
+		{"color-mix(in srgb, var(--accent) var(--percent), blue)", [3]float64{0.25, 0, 0.75}, 1},
+		{"color-mix(in srgb, var(--accent), blue var(--percent))", [3]float64{0.75, 0, 0.25}, 1},
+	}
+	for _, test := range tests {
+		c, err := gocolor.ParseCSSColorVars(test.input, vars)
+		require.NoError(t, err, test.input)
+		assert.InDeltaSlice(t, test.coords[:], c.Coords[:], 1e-9, test.input)
+		assert.InDelta(t, test.alpha, c.Alpha, 1e-9, test.input)
+	}
+
+	errors := map[string]int{
+		"var(--undefined)":              0,
+		"rgb(from var(--broken) r g b)": 9,
+		"var(--cycle)":                  0,
+		"var(accent)":                   4,
+		"var(--accent":                  12,
+	}
+	for input, offset := range errors {
+		_, err := gocolor.ParseCSSColorVars(input, vars)
+		require.Error(t, err, input)
+		syntaxErr, ok := err.(*gocolor.CSSSyntaxError)
+		require.True(t, ok, input)
+		assert.Equal(t, offset, syntaxErr.Offset, "%v: %v", input, err)
+	}
+
+	// var() is only resolved with custom properties.
+	_, err := gocolor.ParseCSSColor("var(--accent)")
+	assert.Error(t, err)
+}
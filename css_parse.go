@@ -0,0 +1,891 @@
+// Copyright © 2019 Xavier Basty <xavier@hexbee.net>
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package gocolor
+
+import (
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+
+	"github.com/Hexbee-net/gocolor/named"
+)
+
+// CSSSyntaxError is the error returned when a CSS color cannot be parsed.
+type CSSSyntaxError struct {
+	Offset int    // Offset of the error in the input, in bytes
+	Msg    string // Description of the error
+}
+
+func (e *CSSSyntaxError) Error() string {
+	return fmt.Sprintf("%v at offset %v", e.Msg, e.Offset)
+}
+
+// ParseCSSColor parses a color of the CSS Color Module Level 4:
+//
+//   - hex colors (#RGB, #RGBA, #RRGGBB and #RRGGBBAA), color names and transparent,
+//   - the rgb(), rgba(), hsl(), hsla(), hwb(), lab(), lch(), oklab(), oklch()
+//     and color() functions, with the legacy comma separated syntax of rgb()
+//     and hsl(),
+//   - numbers, percentages, angles in deg, rad, grad or turn, and the none
+//     keyword,
+//   - relative colors, such as rgb(from #f00 r g calc(b + 20) / 50%), with
+//     calc() expressions,
+//   - color-mix(), such as color-mix(in oklch longer hue, red 30%, blue).
+//
+// Failures are reported with a *CSSSyntaxError.
+func ParseCSSColor(s string) (CSSColor, error) {
+	return ParseCSSColorVars(s, nil)
+}
+
+// ParseCSSColorVars parses a CSS color like ParseCSSColor, and also resolves
+// color-mix() functions and var() references to the given custom properties,
+// such as rgb(from var(--accent) r g b / 50%). The names of the properties
+// include their leading dashes.
+func ParseCSSColorVars(s string, vars map[string]string) (CSSColor, error) {
+	p := cssParser{s: s, vars: vars, substitutions: new(int)}
+
+	p.skipSpace()
+	c, err := p.color()
+	if err != nil {
+		return CSSColor{}, err
+	}
+	p.skipSpace()
+	if p.pos < len(p.s) {
+		return CSSColor{}, p.errorf(p.pos, "unexpected %q after the color", p.s[p.pos:])
+	}
+
+	return c, nil
+}
+
+////////////////////////////////////////
+
+// cssValue is a component value, with its unit: empty for numbers, "%" for
+// percentages and "deg" for angles.
+type cssValue struct {
+	v    float64
+	unit string
+	none bool
+	pos  int
+}
+
+// cssComponent describes the values accepted by a component of a color
+// function, and how they map to the coordinates of its color space.
+type cssComponent struct {
+	number  float64 // Coordinate of a number of 1
+	percent float64 // Coordinate of 100%, 0 when percentages are not accepted
+	hue     bool    // Whether angles are accepted
+	min     float64 // Range of the coordinate, when min < max
+	max     float64
+}
+
+var (
+	cssAlpha    = cssComponent{number: 1, percent: 1, min: 0, max: 1}
+	cssHue      = cssComponent{number: 1, hue: true}
+	cssUnitRGB  = cssComponent{number: 1, percent: 1}
+	cssPercent  = cssComponent{number: 1, percent: 100, min: 0, max: math.Inf(1)}
+	cssLightLab = cssComponent{number: 1, percent: 100, min: 0, max: 100}
+	cssLightOk  = cssComponent{number: 1, percent: 1, min: 0, max: 1}
+)
+
+// cssFunctions lists the color functions, with their color space and their
+// components.
+var cssFunctions = map[string]struct {
+	space      string
+	components [3]cssComponent
+}{
+	"rgb": {CSSSRGB, [3]cssComponent{
+		{number: 1.0 / 255, percent: 1, min: 0, max: 1},
+		{number: 1.0 / 255, percent: 1, min: 0, max: 1},
+		{number: 1.0 / 255, percent: 1, min: 0, max: 1},
+	}},
+	"hsl":   {CSSHSL, [3]cssComponent{cssHue, cssPercent, {number: 1, percent: 100}}},
+	"hwb":   {CSSHWB, [3]cssComponent{cssHue, {number: 1, percent: 100}, {number: 1, percent: 100}}},
+	"lab":   {CSSLab, [3]cssComponent{cssLightLab, {number: 1, percent: 125}, {number: 1, percent: 125}}},
+	"lch":   {CSSLCh, [3]cssComponent{cssLightLab, {number: 1, percent: 150, min: 0, max: math.Inf(1)}, cssHue}},
+	"oklab": {CSSOklab, [3]cssComponent{cssLightOk, {number: 1, percent: 0.4}, {number: 1, percent: 0.4}}},
+	"oklch": {CSSOklch, [3]cssComponent{cssLightOk, {number: 1, percent: 0.4, min: 0, max: math.Inf(1)}, cssHue}},
+	"color": {"", [3]cssComponent{cssUnitRGB, cssUnitRGB, cssUnitRGB}},
+}
+
+// cssAngleUnits maps the angle units to degrees.
+var cssAngleUnits = map[string]float64{
+	"deg":  1,
+	"rad":  180 / math.Pi,
+	"grad": 0.9,
+	"turn": 360,
+}
+
+// maxCSSVarDepth is the maximal nesting of var() references, which stops
+// cyclic references.
+const maxCSSVarDepth = 32
+
+// maxCSSVarSubstitutions is the maximal number of var() references resolved
+// while parsing a color, which stops properties referencing several times
+// each other from expanding exponentially.
+const maxCSSVarSubstitutions = 1024
+
+type cssParser struct {
+	s     string
+	pos   int
+	vars  map[string]string
+	depth int
+
+	// Number of var() references resolved, shared by the parsers of the
+	// substituted values.
+	substitutions *int
+}
+
+func (p *cssParser) errorf(pos int, format string, args ...interface{}) error {
+	return &CSSSyntaxError{Offset: pos, Msg: fmt.Sprintf(format, args...)}
+}
+
+func (p *cssParser) peek() byte {
+	if p.pos < len(p.s) {
+		return p.s[p.pos]
+	}
+	return 0
+}
+
+func (p *cssParser) skipSpace() bool {
+	start := p.pos
+	for p.pos < len(p.s) && strings.IndexByte(" \t\n\r\f", p.s[p.pos]) >= 0 {
+		p.pos++
+	}
+	return p.pos > start
+}
+
+func (p *cssParser) expect(c byte) error {
+	if p.pos >= len(p.s) {
+		return p.errorf(p.pos, "expected %q, got the end of the input", c)
+	}
+	if p.s[p.pos] != c {
+		return p.errorf(p.pos, "expected %q, got %q", c, p.s[p.pos])
+	}
+	p.pos++
+	return nil
+}
+
+func isIdentStart(c byte) bool {
+	return c >= 'a' && c <= 'z' || c >= 'A' && c <= 'Z' || c == '_'
+}
+
+func isIdentChar(c byte) bool {
+	return isIdentStart(c) || c >= '0' && c <= '9' || c == '-'
+}
+
+// ident reads an identifier, or the characters of a hex color.
+func (p *cssParser) ident() string {
+	start := p.pos
+	for p.pos < len(p.s) && isIdentChar(p.s[p.pos]) {
+		p.pos++
+	}
+	return p.s[start:p.pos]
+}
+
+func (p *cssParser) color() (CSSColor, error) {
+	start := p.pos
+	if p.peek() == '#' {
+		p.pos++
+		return p.hex(p.ident(), start)
+	}
+	if !isIdentStart(p.peek()) {
+		if p.pos >= len(p.s) {
+			return CSSColor{}, p.errorf(start, "expected a color, got the end of the input")
+		}
+		return CSSColor{}, p.errorf(start, "expected a color, got %q", p.s[p.pos])
+	}
+
+	name := strings.ToLower(p.ident())
+	if p.peek() == '(' {
+		p.pos++
+		switch name {
+		case "var":
+			return p.colorVariable(start)
+		case "color-mix":
+			return p.colorMix(start)
+		}
+		return p.function(name, start)
+	}
+
+	switch name {
+	case "transparent":
+		return CSSColor{Space: CSSSRGB}, nil
+	case "currentcolor":
+		return CSSColor{}, p.errorf(start, "currentcolor depends on the context of the color")
+	}
+	c, ok := named.CSS.Lookup(name)
+	if !ok || c.Name != name {
+		if suggestions := named.CSS.Suggest(name, 1); len(suggestions) > 0 {
+			return CSSColor{}, p.errorf(start, "unknown color name: %v, did you mean %v?", name, suggestions[0])
+		}
+		return CSSColor{}, p.errorf(start, "unknown color name: %v", name)
+	}
+	return p.hex(c.Hex[1:], start)
+}
+
+// hex parses the digits of a hex color, starting at the given position.
+func (p *cssParser) hex(digits string, start int) (CSSColor, error) {
+	for i := 0; i < len(digits); i++ {
+		if _, err := strconv.ParseUint(digits[i:i+1], 16, 8); err != nil {
+			return CSSColor{}, p.errorf(start+1+i, "invalid hex digit %q", digits[i])
+		}
+	}
+
+	var v [4]float64
+	switch len(digits) {
+	case 3, 4:
+		for i := range digits {
+			d, _ := strconv.ParseUint(digits[i:i+1], 16, 8)
+			v[i] = float64(d*17) / 255
+		}
+	case 6, 8:
+		for i := 0; i < len(digits); i += 2 {
+			d, _ := strconv.ParseUint(digits[i:i+2], 16, 8)
+			v[i/2] = float64(d) / 255
+		}
+	default:
+		return CSSColor{}, p.errorf(start, "hex colors must have 3, 4, 6 or 8 digits, got %v", len(digits))
+	}
+	if len(digits) == 3 || len(digits) == 6 {
+		v[3] = 1
+	}
+
+	return CSSColor{Space: CSSSRGB, Coords: [3]float64{v[0], v[1], v[2]}, Alpha: v[3]}, nil
+}
+
+func (p *cssParser) function(name string, start int) (CSSColor, error) {
+	legacyAllowed := false
+	switch name {
+	case "rgba", "hsla":
+		name = name[:3]
+		legacyAllowed = true
+	case "rgb", "hsl":
+		legacyAllowed = true
+	}
+	f, ok := cssFunctions[name]
+	if !ok {
+		return CSSColor{}, p.errorf(start, "unknown color function: %v", name)
+	}
+	components := f.components
+	space := f.space
+
+	p.skipSpace()
+
+	// Relative color syntax.
+	var origin *CSSColor
+	if p.keywordAhead("from") {
+		p.pos += len("from")
+		if !p.skipSpace() {
+			return CSSColor{}, p.errorf(p.pos, "expected a space after from")
+		}
+		c, err := p.color()
+		if err != nil {
+			return CSSColor{}, err
+		}
+		origin = &c
+		legacyAllowed = false
+		p.skipSpace()
+	}
+
+	if name == "color" {
+		spacePos := p.pos
+		space = strings.ToLower(p.ident())
+		if space == "xyz" {
+			space = CSSXYZD65
+		}
+		if _, ok := cssSpaceChannels[space]; !ok || !isColorFunctionSpace(space) {
+			if space == "" {
+				return CSSColor{}, p.errorf(spacePos, "expected a color space")
+			}
+			return CSSColor{}, p.errorf(spacePos, "unknown color space: %v", space)
+		}
+		p.skipSpace()
+	}
+
+	var channels map[string]cssValue
+	if origin != nil {
+		c, err := origin.Convert(space)
+		if err != nil {
+			return CSSColor{}, p.errorf(start, "%v", err)
+		}
+		channels = map[string]cssValue{"alpha": {v: c.Alpha, none: c.Missing[3]}}
+		for i, ch := range cssSpaceChannels[space] {
+			channels[ch] = cssValue{v: c.Coords[i] / components[i].number, none: c.Missing[i]}
+		}
+	}
+
+	var values [3]cssValue
+	legacy := false
+	for i := range values {
+		if i > 0 {
+			p.skipSpace()
+			if i == 1 && legacyAllowed && p.peek() == ',' {
+				legacy = true
+			}
+			if legacy {
+				if err := p.expect(','); err != nil {
+					return CSSColor{}, err
+				}
+				p.skipSpace()
+			}
+		}
+		if p.peek() == ')' || p.peek() == '/' || p.pos >= len(p.s) {
+			return CSSColor{}, p.errorf(p.pos, "expected 3 components, got %v", i)
+		}
+
+		var err error
+		if values[i], err = p.value(channels); err != nil {
+			return CSSColor{}, err
+		}
+	}
+
+	alpha := cssValue{v: 1}
+	if a, ok := channels["alpha"]; ok {
+		alpha = a
+	}
+	p.skipSpace()
+	if legacy && p.peek() == ',' || !legacy && p.peek() == '/' {
+		p.pos++
+		p.skipSpace()
+		var err error
+		if alpha, err = p.value(channels); err != nil {
+			return CSSColor{}, err
+		}
+		p.skipSpace()
+	}
+	if err := p.expect(')'); err != nil {
+		if p.pos < len(p.s) && isIdentChar(p.s[p.pos]) || p.peek() == '.' || p.peek() == '+' {
+			return CSSColor{}, p.errorf(p.pos, "too many components")
+		}
+		return CSSColor{}, err
+	}
+
+	if legacy {
+		for i, v := range append(values[:], alpha) {
+			if v.none {
+				return CSSColor{}, p.errorf(v.pos, "none is not allowed in the legacy syntax")
+			}
+			if name == "rgb" && i > 0 && i < 3 && v.unit != values[0].unit {
+				return CSSColor{}, p.errorf(v.pos, "numbers and percentages cannot be mixed in the legacy syntax")
+			}
+			if name == "hsl" && (i == 1 || i == 2) && v.unit != "%" {
+				return CSSColor{}, p.errorf(v.pos, "expected a percentage")
+			}
+		}
+	}
+
+	c := CSSColor{Space: space}
+	for i, v := range values {
+		var err error
+		if c.Coords[i], err = p.resolve(v, components[i]); err != nil {
+			return CSSColor{}, err
+		}
+		c.Missing[i] = v.none
+	}
+	var err error
+	if c.Alpha, err = p.resolve(alpha, cssAlpha); err != nil {
+		return CSSColor{}, err
+	}
+	c.Missing[3] = alpha.none
+
+	return c, nil
+}
+
+// isColorFunctionSpace reports whether a color space is accepted by the
+// color() function.
+func isColorFunctionSpace(space string) bool {
+	switch space {
+	case CSSHSL, CSSHWB, CSSLab, CSSLCh, CSSOklab, CSSOklch:
+		return false
+	}
+	return true
+}
+
+// keywordAhead reports whether the input continues with a keyword.
+func (p *cssParser) keywordAhead(keyword string) bool {
+	end := p.pos + len(keyword)
+	if end > len(p.s) || !strings.EqualFold(p.s[p.pos:end], keyword) {
+		return false
+	}
+	return end == len(p.s) || !isIdentChar(p.s[end])
+}
+
+// resolve converts a component value to a coordinate.
+func (p *cssParser) resolve(v cssValue, c cssComponent) (float64, error) {
+	if v.none {
+		return 0, nil
+	}
+
+	var r float64
+	switch v.unit {
+	case "":
+		r = v.v * c.number
+	case "%":
+		if c.percent == 0 {
+			return 0, p.errorf(v.pos, "percentages are not allowed here")
+		}
+		r = v.v / 100 * c.percent
+	case "deg":
+		if !c.hue {
+			return 0, p.errorf(v.pos, "angles are only allowed for hues")
+		}
+		r = v.v
+	}
+
+	if c.hue {
+		r = math.Mod(r, 360)
+		if r < 0 {
+			r += 360
+		}
+	}
+	if c.min < c.max {
+		r = math.Max(c.min, math.Min(c.max, r))
+	}
+
+	return r, nil
+}
+
+// value parses a component value: a number, a percentage, an angle, none, a
+// channel keyword of relative colors, or a calc() expression.
+func (p *cssParser) value(channels map[string]cssValue) (cssValue, error) {
+	start := p.pos
+	if !isIdentStart(p.peek()) {
+		return p.number()
+	}
+
+	name := strings.ToLower(p.ident())
+	switch {
+	case name == "none":
+		return cssValue{none: true, pos: start}, nil
+	case name == "calc" && p.peek() == '(':
+		p.pos++
+		p.skipSpace()
+		v, err := p.sum(channels)
+		if err != nil {
+			return cssValue{}, err
+		}
+		p.skipSpace()
+		if err := p.expect(')'); err != nil {
+			return cssValue{}, err
+		}
+		v.pos = start
+		return v, nil
+	}
+
+	if name == "var" && p.peek() == '(' {
+		p.pos++
+		sub, err := p.variable(start)
+		if err != nil {
+			return cssValue{}, err
+		}
+		v, err := sub.value(channels)
+		if err == nil {
+			err = sub.end()
+		}
+		if err != nil {
+			return cssValue{}, p.variableError(start, err)
+		}
+		v.pos = start
+		return v, nil
+	}
+
+	if v, ok := channels[name]; ok {
+		v.pos = start
+		return v, nil
+	}
+	if channels == nil {
+		return cssValue{}, p.errorf(start, "unexpected keyword: %v", name)
+	}
+	return cssValue{}, p.errorf(start, "unknown channel keyword: %v", name)
+}
+
+// number parses a number, with an optional unit.
+func (p *cssParser) number() (cssValue, error) {
+	start := p.pos
+	i := p.pos
+	if i < len(p.s) && (p.s[i] == '+' || p.s[i] == '-') {
+		i++
+	}
+	digits := 0
+	for ; i < len(p.s) && p.s[i] >= '0' && p.s[i] <= '9'; i++ {
+		digits++
+	}
+	if i < len(p.s) && p.s[i] == '.' {
+		i++
+		for ; i < len(p.s) && p.s[i] >= '0' && p.s[i] <= '9'; i++ {
+			digits++
+		}
+	}
+	if digits == 0 {
+		if p.pos >= len(p.s) {
+			return cssValue{}, p.errorf(start, "expected a number, got the end of the input")
+		}
+		return cssValue{}, p.errorf(start, "expected a number, got %q", p.s[p.pos])
+	}
+	if i+1 < len(p.s) && (p.s[i] == 'e' || p.s[i] == 'E') {
+		j := i + 1
+		if p.s[j] == '+' || p.s[j] == '-' {
+			j++
+		}
+		if j < len(p.s) && p.s[j] >= '0' && p.s[j] <= '9' {
+			for i = j; i < len(p.s) && p.s[i] >= '0' && p.s[i] <= '9'; i++ {
+			}
+		}
+	}
+
+	v, err := strconv.ParseFloat(p.s[start:i], 64)
+	if err != nil {
+		return cssValue{}, p.errorf(start, "invalid number: %v", p.s[start:i])
+	}
+	p.pos = i
+
+	if p.peek() == '%' {
+		p.pos++
+		return cssValue{v: v, unit: "%", pos: start}, nil
+	}
+	unitPos := p.pos
+	unit := strings.ToLower(p.ident())
+	if unit == "" {
+		return cssValue{v: v, pos: start}, nil
+	}
+	f, ok := cssAngleUnits[unit]
+	if !ok {
+		return cssValue{}, p.errorf(unitPos, "unknown unit: %v", unit)
+	}
+	return cssValue{v: v * f, unit: "deg", pos: start}, nil
+}
+
+// sum parses the additions and subtractions of a calc() expression.
+func (p *cssParser) sum(channels map[string]cssValue) (cssValue, error) {
+	a, err := p.product(channels)
+	if err != nil {
+		return cssValue{}, err
+	}
+
+	for {
+		save := p.pos
+		p.skipSpace()
+		op := p.peek()
+		if op != '+' && op != '-' {
+			p.pos = save
+			return a, nil
+		}
+		opPos := p.pos
+		p.pos++
+		p.skipSpace()
+
+		b, err := p.product(channels)
+		if err != nil {
+			return cssValue{}, err
+		}
+		if a.unit != b.unit {
+			return cssValue{}, p.errorf(opPos, "cannot add or subtract values of different units")
+		}
+		if op == '+' {
+			a.v += b.v
+		} else {
+			a.v -= b.v
+		}
+	}
+}
+
+// product parses the multiplications and divisions of a calc() expression.
+func (p *cssParser) product(channels map[string]cssValue) (cssValue, error) {
+	a, err := p.term(channels)
+	if err != nil {
+		return cssValue{}, err
+	}
+
+	for {
+		save := p.pos
+		p.skipSpace()
+		op := p.peek()
+		if op != '*' && op != '/' {
+			p.pos = save
+			return a, nil
+		}
+		opPos := p.pos
+		p.pos++
+		p.skipSpace()
+
+		b, err := p.term(channels)
+		if err != nil {
+			return cssValue{}, err
+		}
+		if op == '*' {
+			if a.unit != "" && b.unit != "" {
+				return cssValue{}, p.errorf(opPos, "cannot multiply two values with units")
+			}
+			if a.unit == "" {
+				a.unit = b.unit
+			}
+			a.v *= b.v
+		} else {
+			if b.unit != "" {
+				return cssValue{}, p.errorf(opPos, "cannot divide by a value with a unit")
+			}
+			if b.v == 0 {
+				return cssValue{}, p.errorf(opPos, "division by zero")
+			}
+			a.v /= b.v
+		}
+	}
+}
+
+// term parses an operand of a calc() expression.
+func (p *cssParser) term(channels map[string]cssValue) (cssValue, error) {
+	start := p.pos
+	if p.peek() == '(' {
+		p.pos++
+		p.skipSpace()
+		v, err := p.sum(channels)
+		if err != nil {
+			return cssValue{}, err
+		}
+		p.skipSpace()
+		return v, p.expect(')')
+	}
+
+	if isIdentStart(p.peek()) {
+		switch strings.ToLower(p.ident()) {
+		case "pi":
+			return cssValue{v: math.Pi, pos: start}, nil
+		case "e":
+			return cssValue{v: math.E, pos: start}, nil
+		}
+		p.pos = start
+	}
+
+	if p.keywordAhead("none") {
+		return cssValue{}, p.errorf(start, "none is not allowed in calc()")
+	}
+	v, err := p.value(channels)
+	if err != nil {
+		return cssValue{}, err
+	}
+
+	// Missing channels of the origin color are 0 in calculations.
+	if v.none {
+		v = cssValue{pos: start}
+	}
+	return v, nil
+}
+
+////////////////////////////////////////
+
+// colorMix parses the arguments of color-mix().
+func (p *cssParser) colorMix(start int) (CSSColor, error) {
+	p.skipSpace()
+	if !p.keywordAhead("in") {
+		return CSSColor{}, p.errorf(p.pos, "expected the interpolation color space")
+	}
+	p.pos += len("in")
+	p.skipSpace()
+
+	spacePos := p.pos
+	space := strings.ToLower(p.ident())
+	if space == "xyz" {
+		space = CSSXYZD65
+	}
+	if _, ok := cssSpaceChannels[space]; !ok {
+		if space == "" {
+			return CSSColor{}, p.errorf(spacePos, "expected a color space")
+		}
+		return CSSColor{}, p.errorf(spacePos, "unknown color space: %v", space)
+	}
+	p.skipSpace()
+
+	hue := ""
+	if isIdentStart(p.peek()) {
+		huePos := p.pos
+		hue = strings.ToLower(p.ident())
+		switch hue {
+		case HueShorter, HueLonger, HueIncreasing, HueDecreasing:
+		default:
+			return CSSColor{}, p.errorf(huePos, "unknown hue interpolation method: %v", hue)
+		}
+		if space != CSSHSL && space != CSSHWB && space != CSSLCh && space != CSSOklch {
+			return CSSColor{}, p.errorf(huePos, "hue interpolation methods require a polar color space")
+		}
+		p.skipSpace()
+		if !p.keywordAhead("hue") {
+			return CSSColor{}, p.errorf(p.pos, "expected hue")
+		}
+		p.pos += len("hue")
+		p.skipSpace()
+	}
+
+	var colors [2]CSSColor
+	var weights [2]float64
+	var given [2]bool
+	for i := range colors {
+		if err := p.expect(','); err != nil {
+			return CSSColor{}, err
+		}
+		p.skipSpace()
+
+		// The percentage is before or after the color.
+		var err error
+		if weights[i], given[i], err = p.mixPercentage(); err != nil {
+			return CSSColor{}, err
+		}
+		if colors[i], err = p.color(); err != nil {
+			return CSSColor{}, err
+		}
+		p.skipSpace()
+		if !given[i] {
+			if weights[i], given[i], err = p.mixPercentage(); err != nil {
+				return CSSColor{}, err
+			}
+		}
+	}
+	if err := p.expect(')'); err != nil {
+		return CSSColor{}, err
+	}
+
+	switch {
+	case !given[0] && !given[1]:
+		weights = [2]float64{0.5, 0.5}
+	case !given[0]:
+		weights[0] = 1 - weights[1]
+	case !given[1]:
+		weights[1] = 1 - weights[0]
+	case weights[0]+weights[1] == 0:
+		return CSSColor{}, p.errorf(start, "color-mix() percentages sum to 0")
+	}
+
+	c, err := MixCSSColors(space, hue, colors[0], weights[0], colors[1], weights[1])
+	if err != nil {
+		return CSSColor{}, p.errorf(start, "%v", err)
+	}
+	return c, nil
+}
+
+// mixPercentage parses an optional percentage of color-mix(), in the [0, 100]
+// range, returned as a fraction.
+func (p *cssParser) mixPercentage() (float64, bool, error) {
+	c := p.peek()
+	if !(c >= '0' && c <= '9' || c == '.' || c == '+' || c == '-' || p.keywordAhead("calc") || p.keywordAhead("var")) {
+		return 0, false, nil
+	}
+
+	start := p.pos
+	v, err := p.value(nil)
+	if err != nil {
+		// The var() reference can be the color.
+		if p.s[start] == 'v' || p.s[start] == 'V' {
+			p.pos = start
+			return 0, false, nil
+		}
+		return 0, false, err
+	}
+	if v.unit != "%" || v.none {
+		return 0, false, p.errorf(v.pos, "expected a percentage")
+	}
+	if v.v < 0 || v.v > 100 {
+		return 0, false, p.errorf(v.pos, "percentage is out of the [0%%, 100%%] range (%v%%)", v.v)
+	}
+	p.skipSpace()
+	return v.v / 100, true, nil
+}
+
+// colorVariable parses a var() reference to a color.
+func (p *cssParser) colorVariable(start int) (CSSColor, error) {
+	sub, err := p.variable(start)
+	if err != nil {
+		return CSSColor{}, err
+	}
+	c, err := sub.color()
+	if err == nil {
+		err = sub.end()
+	}
+	if err != nil {
+		return CSSColor{}, p.variableError(start, err)
+	}
+	return c, nil
+}
+
+// variable parses the arguments of var(), and returns a parser of the value of
+// the custom property, or of the fallback value when it is not defined.
+func (p *cssParser) variable(start int) (*cssParser, error) {
+	if p.depth >= maxCSSVarDepth {
+		return nil, p.errorf(start, "too many nested var() references")
+	}
+	if *p.substitutions >= maxCSSVarSubstitutions {
+		return nil, p.errorf(start, "too many var() references")
+	}
+	*p.substitutions++
+
+	p.skipSpace()
+	namePos := p.pos
+	name := p.ident()
+	if !strings.HasPrefix(name, "--") || len(name) == 2 {
+		return nil, p.errorf(namePos, "expected a custom property name")
+	}
+	p.skipSpace()
+
+	value, defined := p.vars[name]
+	if p.peek() == ',' {
+		p.pos++
+		fallbackPos := p.pos
+		level := 0
+		for ; p.pos < len(p.s); p.pos++ {
+			if c := p.s[p.pos]; c == '(' {
+				level++
+			} else if c == ')' {
+				if level == 0 {
+					break
+				}
+				level--
+			}
+		}
+		if !defined {
+			value, defined = p.s[fallbackPos:p.pos], true
+		}
+	}
+	if err := p.expect(')'); err != nil {
+		return nil, err
+	}
+	if !defined {
+		return nil, p.errorf(start, "undefined custom property: %v", name)
+	}
+
+	sub := &cssParser{s: value, vars: p.vars, depth: p.depth + 1, substitutions: p.substitutions}
+	sub.skipSpace()
+	return sub, nil
+}
+
+// end checks that the whole input has been parsed.
+func (p *cssParser) end() error {
+	p.skipSpace()
+	if p.pos < len(p.s) {
+		return p.errorf(p.pos, "unexpected %q", p.s[p.pos:])
+	}
+	return nil
+}
+
+// variableError reports an error in the value of a var() reference, at the
+// position of the reference.
+func (p *cssParser) variableError(start int, err error) error {
+	e, ok := err.(*CSSSyntaxError)
+	if !ok {
+		return p.errorf(start, "in var(): %v", err)
+	}
+	if strings.HasPrefix(e.Msg, "in var()") {
+		return p.errorf(start, "%v", e.Msg)
+	}
+	return p.errorf(start, "in var(): %v", e.Msg)
+}
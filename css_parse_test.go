@@ -0,0 +1,184 @@
+// Copyright © 2019 Xavier Basty <xavier@hexbee.net>
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package gocolor_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/Hexbee-net/gocolor"
+)
+
+func TestParseCSSColor(t *testing.T) {
+	tests := []struct {
+		input  string
+		space  string
+		coords [3]float64
+		alpha  float64
+		delta  float64
+	}{
+		{"#f00", gocolor.CSSSRGB, [3]float64{1, 0, 0}, 1, 1e-12},
+		{"#FF000080", gocolor.CSSSRGB, [3]float64{1, 0, 0}, 128.0 / 255, 1e-12},
+		{"#0f08", gocolor.CSSSRGB, [3]float64{0, 1, 0}, 136.0 / 255, 1e-12},
+		{"  AliceBlue ", gocolor.CSSSRGB, [3]float64{240.0 / 255, 248.0 / 255, 1}, 1, 1e-12},
+		{"transparent", gocolor.CSSSRGB, [3]float64{0, 0, 0}, 0, 1e-12},
+		{"rgb(255 127.5 0)", gocolor.CSSSRGB, [3]float64{1, 0.5, 0}, 1, 1e-12},
+		{"rgb(100% 50% 0% / 25%)", gocolor.CSSSRGB, [3]float64{1, 0.5, 0}, 0.25, 1e-12},
+		{"RGBA(255, 0, 0, 0.5)", gocolor.CSSSRGB, [3]float64{1, 0, 0}, 0.5, 1e-12},
+		{"rgb(100%,0%,0%)", gocolor.CSSSRGB, [3]float64{1, 0, 0}, 1, 1e-12},
+		{"rgb(300 -20 0 / 2)", gocolor.CSSSRGB, [3]float64{1, 0, 0}, 1, 1e-12},
+		{"hsl(120deg 100% 50%)", gocolor.CSSHSL, [3]float64{120, 100, 50}, 1, 1e-12},
+		{"hsla(120, 100%, 50%, .5)", gocolor.CSSHSL, [3]float64{120, 100, 50}, 0.5, 1e-12},
+		{"hsl(0.5turn 100 50)", gocolor.CSSHSL, [3]float64{180, 100, 50}, 1, 1e-12},
+		{"hsl(-90 100% 50%)", gocolor.CSSHSL, [3]float64{270, 100, 50}, 1, 1e-12},
+		{"hsl(3.14159265358979rad 100% 50%)", gocolor.CSSHSL, [3]float64{180, 100, 50}, 1, 1e-9},
+		{"hsl(100grad 100% 50%)", gocolor.CSSHSL, [3]float64{90, 100, 50}, 1, 1e-12},
+		{"hwb(0 20% 30%)", gocolor.CSSHWB, [3]float64{0, 20, 30}, 1, 1e-12},
+		{"lab(50% 100% -50%)", gocolor.CSSLab, [3]float64{50, 125, -62.5}, 1, 1e-12},
+		{"lab(120 0 0)", gocolor.CSSLab, [3]float64{100, 0, 0}, 1, 1e-12},
+		{"lch(50 30 400)", gocolor.CSSLCh, [3]float64{50, 30, 40}, 1, 1e-12},
+		{"oklab(0.5 -0.1 1e-1)", gocolor.CSSOklab, [3]float64{0.5, -0.1, 0.1}, 1, 1e-12},
+		{"oklch(60% 50% 30deg / 0.8)", gocolor.CSSOklch, [3]float64{0.6, 0.2, 30}, 0.8, 1e-12},
+		{"color(display-p3 1 0.5 0)", gocolor.CSSDisplayP3, [3]float64{1, 0.5, 0}, 1, 1e-12},
+		{"color(rec2020 100% 50% 1.5 / 0.1)", gocolor.CSSRec2020, [3]float64{1, 0.5, 1.5}, 0.1, 1e-12},
+		{"color(xyz 0.2 0.3 0.4)", gocolor.CSSXYZD65, [3]float64{0.2, 0.3, 0.4}, 1, 1e-12},
+		{"color(xyz-d50 0.2 0.3 0.4)", gocolor.CSSXYZD50, [3]float64{0.2, 0.3, 0.4}, 1, 1e-12},
+		{"rgb(calc(255 / 2) calc((1 + 1) * 10%) 0)", gocolor.CSSSRGB, [3]float64{0.5, 0.2, 0}, 1, 1e-12},
+	}
+	for _, test := range tests {
+		c, err := gocolor.ParseCSSColor(test.input)
+		require.NoError(t, err, test.input)
+		assert.Equal(t, test.space, c.Space, test.input)
+		assert.InDeltaSlice(t, test.coords[:], c.Coords[:], test.delta, test.input)
+		assert.InDelta(t, test.alpha, c.Alpha, 1e-12, test.input)
+	}
+}
+
+func TestParseCSSColorNone(t *testing.T) {
+	c, err := gocolor.ParseCSSColor("oklch(0.5 none 120 / none)")
+	require.NoError(t, err)
+	assert.Equal(t, [4]bool{false, true, false, true}, c.Missing)
+	assert.Equal(t, 0.0, c.Coords[1])
+	assert.Equal(t, 0.0, c.Alpha)
+}
+
+func TestParseCSSColorRelative(t *testing.T) {
+	tests := []struct {
+		input  string
+		space  string
+		coords [3]float64
+		alpha  float64
+	}{
+		{"rgb(from red r g b / 50%)", gocolor.CSSSRGB, [3]float64{1, 0, 0}, 0.5},
+		{"rgb(from #ff000080 b r g)", gocolor.CSSSRGB, [3]float64{0, 1, 0}, 128.0 / 255},
+		{"rgb(from red calc(r / 2) g calc(b + 51))", gocolor.CSSSRGB, [3]float64{0.5, 0, 0.2}, 1},
+		{"hsl(from red calc(h + 120) s l)", gocolor.CSSHSL, [3]float64{120, 100, 50}, 1},
+		{"hwb(from hsl(240 100% 50%) h w b / alpha)", gocolor.CSSHWB, [3]float64{240, 0, 0}, 1},
+		{"color(from color(display-p3 1 0 0) display-p3 r calc(g + 0.5) b)", gocolor.CSSDisplayP3, [3]float64{1, 0.5, 0}, 1},
+		{"oklch(from oklch(0.5 0.1 90) calc(l * 2) c calc(h + 90))", gocolor.CSSOklch, [3]float64{1, 0.1, 180}, 1},
+	}
+	for _, test := range tests {
+		c, err := gocolor.ParseCSSColor(test.input)
+		require.NoError(t, err, test.input)
+		assert.Equal(t, test.space, c.Space, test.input)
+		assert.InDeltaSlice(t, test.coords[:], c.Coords[:], 1e-6, test.input)
+		assert.InDelta(t, test.alpha, c.Alpha, 1e-12, test.input)
+	}
+
+	// The powerless hue of an achromatic origin stays missing.
+	c, err := gocolor.ParseCSSColor("oklch(from white l c h)")
+	require.NoError(t, err)
+	assert.True(t, c.Missing[2])
+	c, err = gocolor.ParseCSSColor("oklch(from white l c calc(h + 10))")
+	require.NoError(t, err)
+	assert.False(t, c.Missing[2])
+	assert.InDelta(t, 10, c.Coords[2], 1e-12)
+}
+
+func TestParseCSSColorErrors(t *testing.T) {
+	tests := []struct {
+		input  string
+		offset int
+	}{
+		{"", 0},
+		{"#12345", 0},
+		{"#12g", 3},
+		{"notacolor", 0},
+		{"azurex11", 0},
+		{"alice-blue", 0},
+		{"currentColor", 0},
+		{"foo(1 2 3)", 0},
+		{"rgb(255 0 0", 11},
+		{"rgb(255 0 0 0)", 12},
+		{"rgb(255 0)", 9},
+		{"rgb(255 0 x)", 10},
+		{"rgb(255, 0%, 0)", 9},
+		{"rgb(255, 0, none)", 12},
+		{"hsl(120, 100, 50%)", 9},
+		{"rgb(255 0 0 / 1deg)", 14},
+		{"lab(50 10deg 0)", 7},
+		{"hsl(10% 50% 50%)", 4},
+		{"hsl(10px 50% 50%)", 6},
+		{"color(foo 1 2 3)", 6},
+		{"color(hsl 1 2 3)", 6},
+		{"color( )", 7},
+		{"rgb(from red r g q)", 17},
+		{"rgb(from red r g calc(b +))", 25},
+		{"rgb(from red r g calc(b * 1deg * 1deg))", 31},
+		{"rgb(from red r g calc(none))", 22},
+		{"rgb(from red r g calc(b / 0))", 24},
+		{"rgb(fromred r g b)", 4},
+		{"red blue", 4},
+	}
+	for _, test := range tests {
+		_, err := gocolor.ParseCSSColor(test.input)
+		require.Error(t, err, test.input)
+		syntaxErr, ok := err.(*gocolor.CSSSyntaxError)
+		require.True(t, ok, test.input)
+		assert.Equal(t, test.offset, syntaxErr.Offset, "%v: %v", test.input, err)
+	}
+
+	_, err := gocolor.ParseCSSColor("rgb(255 0 0")
+	assert.EqualError(t, err, `expected ')', got the end of the input at offset 11`)
+
+	_, err = gocolor.ParseCSSColor("rgb(from rde r g b)")
+	assert.EqualError(t, err, `unknown color name: rde, did you mean red? at offset 9`)
+}
+
+func TestParseCSSColorVarsErrors(t *testing.T) {
+	_, err := gocolor.ParseCSSColorVars("var(--x)", map[string]string{"--x": "rgb(1 2)"})
+	assert.EqualError(t, err, `in var(): expected 3 components, got 2 at offset 0`)
+
+	_, err = gocolor.ParseCSSColorVars("var(--x)", map[string]string{"--x": "var(--y)", "--y": "rgb(1 2)"})
+	assert.EqualError(t, err, `in var(): expected 3 components, got 2 at offset 0`)
+
+	// Each property references the next one twice, which would resolve 2^24
+	// references without a limit.
+	vars := map[string]string{"--a24": "1"}
+	for i := 0; i < 24; i++ {
+		vars[fmt.Sprintf("--a%v", i)] = fmt.Sprintf("calc(var(--a%v) + var(--a%v))", i+1, i+1)
+	}
+	_, err = gocolor.ParseCSSColorVars("rgb(var(--a0) 0 0)", vars)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "too many var() references")
+
+	// References within the limit are resolved.
+	c, err := gocolor.ParseCSSColorVars("rgb(calc(var(--a15) / 4) 0 0)", vars)
+	require.NoError(t, err)
+	assert.InDelta(t, 128.0/255, c.Coords[0], 1e-9)
+}
@@ -0,0 +1,74 @@
+// Copyright © 2019 Xavier Basty <xavier@hexbee.net>
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package gocolor_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/Hexbee-net/gocolor"
+)
+
+func TestCSSColorConvert(t *testing.T) {
+	red := gocolor.CSSColor{Space: gocolor.CSSSRGB, Coords: [3]float64{1, 0, 0}, Alpha: 0.5}
+
+	tests := []struct {
+		space    string
+		expected [3]float64
+		delta    float64
+	}{
+		{gocolor.CSSSRGB, [3]float64{1, 0, 0}, 1e-12},
+		{gocolor.CSSSRGBLinear, [3]float64{1, 0, 0}, 1e-9},
+		{gocolor.CSSHSL, [3]float64{0, 100, 50}, 1e-9},
+		{gocolor.CSSHWB, [3]float64{0, 0, 0}, 1e-9},
+		{gocolor.CSSXYZD65, [3]float64{0.4124564, 0.2126729, 0.0193339}, 1e-6},
+		{gocolor.CSSLab, [3]float64{54.29, 80.80, 69.89}, 0.05},
+		{gocolor.CSSLCh, [3]float64{54.29, 106.84, 40.85}, 0.05},
+		{gocolor.CSSOklab, [3]float64{0.62796, 0.22486, 0.12585}, 1e-3},
+		{gocolor.CSSOklch, [3]float64{0.62796, 0.25768, 29.23}, 1e-2},
+		{gocolor.CSSDisplayP3, [3]float64{0.91749, 0.20029, 0.13856}, 1e-3},
+	}
+	for _, test := range tests {
+		c, err := red.Convert(test.space)
+		require.NoError(t, err)
+		assert.Equal(t, test.space, c.Space)
+		assert.Equal(t, 0.5, c.Alpha)
+		assert.InDeltaSlice(t, test.expected[:], c.Coords[:], test.delta, test.space)
+
+		// Back to sRGB.
+		r, g, b, err := c.SRGB()
+		require.NoError(t, err)
+		assert.InDeltaSlice(t, []float64{1, 0, 0}, []float64{r, g, b}, 1e-5, test.space)
+	}
+
+	_, err := red.Convert("unknown")
+	assert.Error(t, err)
+}
+
+func TestCSSColorConvertAchromatic(t *testing.T) {
+	gray := gocolor.CSSColor{Space: gocolor.CSSSRGB, Coords: [3]float64{0.5, 0.5, 0.5}, Alpha: 1}
+
+	for space, hue := range map[string]int{gocolor.CSSHSL: 0, gocolor.CSSHWB: 0, gocolor.CSSLCh: 2, gocolor.CSSOklch: 2} {
+		c, err := gray.Convert(space)
+		require.NoError(t, err)
+		assert.True(t, c.Missing[hue], space)
+	}
+
+	c, err := gray.Convert(gocolor.CSSLab)
+	require.NoError(t, err)
+	assert.Equal(t, [4]bool{}, c.Missing)
+}
@@ -0,0 +1,114 @@
+// Copyright © 2019 Xavier Basty <xavier@hexbee.net>
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package gocolor
+
+import (
+	"math"
+)
+
+// Color difference formulas.
+const (
+	DistanceCIE76     = "CIE76"     // Euclidean distance in CIELab (ΔE*ab)
+	DistanceCIEDE2000 = "CIEDE2000" // CIEDE2000 color difference (ΔE00)
+	DistanceOklab     = "Oklab"     // Euclidean distance in Oklab
+)
+
+// DeltaE2000 computes the CIEDE2000 color difference between two CIELab
+// colors, with the parametric factors kL, kC and kH set to 1.
+//
+// See "The CIEDE2000 Color-Difference Formula: Implementation Notes,
+// Supplementary Test Data, and Mathematical Observations" by G. Sharma,
+// W. Wu and E. N. Dalal for more information.
+func DeltaE2000(l1, a1, b1, l2, a2, b2 float64) float64 {
+	return deltaE2000(vector{l1, a1, b1}, vector{l2, a2, b2})
+}
+
+////////////////////////////////////////
+
+var pow25to7 = math.Pow(25, 7)
+
+func deltaE76(x, y vector) float64 {
+	return x.vsub(y).norm()
+}
+
+func deltaE2000(x, y vector) float64 {
+	// Chroma dependent scaling of the a* axis.
+	cm := (math.Hypot(x.v1, x.v2) + math.Hypot(y.v1, y.v2)) / 2
+	cm7 := math.Pow(cm, 7)
+	g := 0.5 * (1 - math.Sqrt(cm7/(cm7+pow25to7)))
+
+	a1, a2 := x.v1*(1+g), y.v1*(1+g)
+	c1, c2 := math.Hypot(a1, x.v2), math.Hypot(a2, y.v2)
+	h1, h2 := labHue(a1, x.v2), labHue(a2, y.v2)
+
+	// Differences in lightness, chroma and hue.
+	dl := y.v0 - x.v0
+	dc := c2 - c1
+	dh := 0.0
+	if c1*c2 != 0 {
+		dh = h2 - h1
+		if dh > 180 {
+			dh -= 360
+		} else if dh < -180 {
+			dh += 360
+		}
+	}
+	dH := 2 * math.Sqrt(c1*c2) * math.Sin(radians(dh)/2)
+
+	// Means of the lightness, chroma and hue.
+	lm := (x.v0 + y.v0) / 2
+	cmp := (c1 + c2) / 2
+	hm := h1 + h2
+	if c1*c2 != 0 {
+		if math.Abs(h1-h2) > 180 {
+			if hm < 360 {
+				hm += 360
+			} else {
+				hm -= 360
+			}
+		}
+		hm /= 2
+	}
+
+	t := 1 -
+		0.17*math.Cos(radians(hm-30)) +
+		0.24*math.Cos(radians(2*hm)) +
+		0.32*math.Cos(radians(3*hm+6)) -
+		0.20*math.Cos(radians(4*hm-63))
+	dTheta := 30 * math.Exp(-math.Pow((hm-275)/25, 2))
+	cmp7 := math.Pow(cmp, 7)
+	rc := 2 * math.Sqrt(cmp7/(cmp7+pow25to7))
+
+	sl := 1 + 0.015*math.Pow(lm-50, 2)/math.Sqrt(20+math.Pow(lm-50, 2))
+	sc := 1 + 0.045*cmp
+	sh := 1 + 0.015*cmp*t
+	rt := -math.Sin(radians(2*dTheta)) * rc
+
+	l, c, h := dl/sl, dc/sc, dH/sh
+	return math.Sqrt(l*l + c*c + h*h + rt*c*h)
+}
+
+// labHue returns the hue angle in degrees, in the [0, 360) range, of a* and b*
+// coordinates.
+func labHue(a, b float64) float64 {
+	if a == 0 && b == 0 {
+		return 0
+	}
+	h := math.Atan2(b, a) * (180 / math.Pi)
+	if h < 0 {
+		h += 360
+	}
+	return h
+}
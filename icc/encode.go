@@ -0,0 +1,474 @@
+// Copyright © 2019 Xavier Basty <xavier@hexbee.net>
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package icc
+
+import (
+	"bytes"
+	"crypto/md5"
+	"encoding/binary"
+	"fmt"
+	"io"
+	"math"
+	"sort"
+	"unicode/utf16"
+
+	"github.com/Hexbee-net/gocolor"
+	"github.com/Hexbee-net/gocolor/internal/interp"
+)
+
+// d50 is the illuminant of the profile connection space.
+var d50 = XYZNumber{0.9642, 1, 0.8249}
+
+// NewRGBProfile returns a version 4 display profile describing an RGB color
+// space known to gocolor, either built-in or registered.
+//
+// The colorants are adapted to the D50 illuminant of the profile connection
+// space with the Bradford transform, which is recorded in the chromatic
+// adaptation tag. The creation date is left unset, so that encoding the
+// profile always yields the same data; it can be set on the returned header.
+func NewRGBProfile(space string) (*Profile, error) {
+	def, err := gocolor.LookupRGBSpace(space)
+	if err != nil {
+		return nil, err
+	}
+
+	chad := bradfordAdaptation(def.White, d50.array())
+	colorants := chad.mul(def.ToXYZ)
+
+	p := &Profile{
+		Header: Header{
+			Version:         0x04300000,
+			Class:           ClassDisplay,
+			ColorSpace:      SpaceRGB,
+			PCS:             SpaceXYZ,
+			RenderingIntent: Perceptual,
+			Illuminant:      d50,
+		},
+		Tags: map[Signature]interface{}{
+			TagProfileDescription:  []LocalizedString{{Language: "en", Country: "US", Text: space}},
+			TagCopyright:           []LocalizedString{{Language: "en", Country: "US", Text: "No copyright, use freely"}},
+			TagMediaWhitePoint:     []XYZNumber{d50},
+			TagChromaticAdaptation: []float64{chad[0][0], chad[0][1], chad[0][2], chad[1][0], chad[1][1], chad[1][2], chad[2][0], chad[2][1], chad[2][2]},
+		},
+	}
+
+	colorantTags := []Signature{TagRedColorant, TagGreenColorant, TagBlueColorant}
+	trcTags := []Signature{TagRedTRC, TagGreenTRC, TagBlueTRC}
+	for c := 0; c < 3; c++ {
+		p.Tags[colorantTags[c]] = []XYZNumber{{colorants[0][c], colorants[1][c], colorants[2][c]}}
+		p.Tags[trcTags[c]] = def.Transfer[c]
+	}
+
+	return p, nil
+}
+
+// Encode writes an ICC profile.
+//
+// The size of the profile is computed from its content, and so is the profile
+// ID for version 4 profiles. Tags with identical data share the same storage.
+func Encode(w io.Writer, p *Profile) error {
+	b, err := Marshal(p)
+	if err != nil {
+		return err
+	}
+
+	_, err = w.Write(b)
+	return err
+}
+
+// Marshal returns the binary form of an ICC profile. See Encode.
+func Marshal(p *Profile) ([]byte, error) {
+	sigs := make([]Signature, 0, len(p.Tags))
+	for s := range p.Tags {
+		sigs = append(sigs, s)
+	}
+	sort.Slice(sigs, func(i, j int) bool { return sigs[i] < sigs[j] })
+
+	table := make([]byte, 4+12*len(sigs))
+	binary.BigEndian.PutUint32(table, uint32(len(sigs)))
+
+	var data bytes.Buffer
+	start := headerSize + len(table)
+	shared := map[string]int{}
+	for i, s := range sigs {
+		t, err := encodeTag(p.Tags[s], p.Version.Major(), s)
+		if err != nil {
+			return nil, fmt.Errorf("invalid %v tag: %v", s, err)
+		}
+
+		offset, ok := shared[string(t)]
+		if !ok {
+			offset = start + data.Len()
+			shared[string(t)] = offset
+			data.Write(t)
+			for data.Len()%4 != 0 {
+				data.WriteByte(0)
+			}
+		}
+
+		entry := table[4+12*i:]
+		binary.BigEndian.PutUint32(entry, uint32(s))
+		binary.BigEndian.PutUint32(entry[4:], uint32(offset))
+		binary.BigEndian.PutUint32(entry[8:], uint32(len(t)))
+	}
+
+	b := make([]byte, headerSize, start+data.Len())
+	writeHeader(b, &p.Header, uint32(cap(b)))
+	b = append(b, table...)
+	b = append(b, data.Bytes()...)
+
+	if p.Version.Major() >= 4 {
+		// The ID is computed with the flags, rendering intent and ID zeroed.
+		id := append([]byte(nil), b...)
+		copy(id[44:48], make([]byte, 4))
+		copy(id[64:68], make([]byte, 4))
+		copy(id[84:100], make([]byte, 16))
+		sum := md5.Sum(id)
+		copy(b[84:100], sum[:])
+	}
+
+	return b, nil
+}
+
+func writeHeader(b []byte, h *Header, size uint32) {
+	put32 := func(o int, v uint32) { binary.BigEndian.PutUint32(b[o:], v) }
+
+	put32(0, size)
+	put32(4, uint32(h.CMM))
+	put32(8, uint32(h.Version))
+	put32(12, uint32(h.Class))
+	put32(16, uint32(h.ColorSpace))
+	put32(20, uint32(h.PCS))
+	if !h.Date.IsZero() {
+		d := h.Date.UTC()
+		for i, v := range []int{d.Year(), int(d.Month()), d.Day(), d.Hour(), d.Minute(), d.Second()} {
+			binary.BigEndian.PutUint16(b[24+2*i:], uint16(v))
+		}
+	}
+	put32(36, uint32(profileMagic))
+	put32(40, uint32(h.Platform))
+	put32(44, h.Flags)
+	put32(48, uint32(h.Manufacturer))
+	put32(52, uint32(h.Model))
+	binary.BigEndian.PutUint64(b[56:], h.Attributes)
+	put32(64, uint32(h.RenderingIntent))
+	copy(b[68:], xyzNumberBytes(h.Illuminant))
+	put32(80, uint32(h.Creator))
+}
+
+////////////////////////////////////////
+
+func encodeTag(v interface{}, major int, tag Signature) ([]byte, error) {
+	switch v := v.(type) {
+	case []XYZNumber:
+		b := typeHeader(typeXYZ)
+		for _, n := range v {
+			b = append(b, xyzNumberBytes(n)...)
+		}
+		return b, nil
+
+	case gocolor.TransferCurve:
+		return encodeCurve(v), nil
+
+	case []float64:
+		b := typeHeader(typeS15Fixed16Array)
+		for _, f := range v {
+			b = append(b, s15Fixed16Bytes(f)...)
+		}
+		return b, nil
+
+	case string:
+		if major >= 4 {
+			return encodeMultiLocalizedUnicode([]LocalizedString{{Language: "en", Country: "US", Text: v}}), nil
+		}
+		if tag == TagProfileDescription {
+			return encodeTextDescription(v), nil
+		}
+		return append(append(typeHeader(typeText), v...), 0), nil
+
+	case []LocalizedString:
+		return encodeMultiLocalizedUnicode(v), nil
+
+	case *LUT:
+		return encodeLut(v)
+
+	case *LUTAtoB:
+		return encodeLutElements(v.elements(), true)
+
+	case *LUTBtoA:
+		return encodeLutElements(v.elements(), false)
+
+	case RawTag:
+		return append([]byte(nil), v.Data...), nil
+
+	default:
+		return nil, fmt.Errorf("unsupported tag value type (%T)", v)
+	}
+}
+
+func typeHeader(t Signature) []byte {
+	b := make([]byte, 8)
+	binary.BigEndian.PutUint32(b, uint32(t))
+	return b
+}
+
+func appendU16(b []byte, v uint16) []byte {
+	return append(b, byte(v>>8), byte(v))
+}
+
+func appendU32(b []byte, v uint32) []byte {
+	return append(b, byte(v>>24), byte(v>>16), byte(v>>8), byte(v))
+}
+
+func s15Fixed16Bytes(v float64) []byte {
+	return appendU32(nil, uint32(int32(math.Round(v*0x10000))))
+}
+
+func xyzNumberBytes(n XYZNumber) []byte {
+	return append(append(s15Fixed16Bytes(n.X), s15Fixed16Bytes(n.Y)...), s15Fixed16Bytes(n.Z)...)
+}
+
+// encodeCurve encodes a table as a curv element, and a parametric curve as
+// a para element using the simplest function type able to represent it.
+func encodeCurve(c gocolor.TransferCurve) []byte {
+	if len(c.Table) > 0 {
+		b := appendU32(typeHeader(typeCurve), uint32(len(c.Table)))
+		for _, v := range c.Table {
+			b = appendU16(b, uint16(math.Round(interp.Clamp01(v)*0xffff)))
+		}
+		return b
+	}
+
+	var params []float64
+	switch {
+	case c.A == 1 && c.B == 0 && c.C == 0 && c.D == 0 && c.E == 0 && c.F == 0:
+		params = []float64{c.Gamma}
+	case c.E == 0 && c.F == 0:
+		params = []float64{c.Gamma, c.A, c.B, c.C, c.D}
+	default:
+		params = []float64{c.Gamma, c.A, c.B, c.C, c.D, c.E, c.F}
+	}
+
+	fn := 0
+	for i, n := range parametricParams {
+		if n == len(params) {
+			fn = i
+		}
+	}
+
+	b := appendU16(appendU16(typeHeader(typeParametricCurve), uint16(fn)), 0)
+	for _, p := range params {
+		b = append(b, s15Fixed16Bytes(p)...)
+	}
+	return b
+}
+
+func encodeMultiLocalizedUnicode(strs []LocalizedString) []byte {
+	b := appendU32(appendU32(typeHeader(typeMultiLocalizedUnicode), uint32(len(strs))), 12)
+
+	var text []byte
+	offset := 16 + 12*len(strs)
+	for _, s := range strs {
+		units := utf16.Encode([]rune(s.Text))
+		b = append(b, fmt.Sprintf("%-2.2s%-2.2s", s.Language, s.Country)...)
+		b = appendU32(b, uint32(2*len(units)))
+		b = appendU32(b, uint32(offset+len(text)))
+		for _, u := range units {
+			text = appendU16(text, u)
+		}
+	}
+
+	return append(b, text...)
+}
+
+// encodeTextDescription encodes a version 2 textDescription element, with
+// empty Unicode and ScriptCode descriptions.
+func encodeTextDescription(s string) []byte {
+	b := appendU32(typeHeader(typeTextDescription), uint32(len(s)+1))
+	b = append(append(b, s...), 0)
+	b = appendU32(appendU32(b, 0), 0)
+	b = appendU16(b, 0)
+	return append(b, make([]byte, 68)...)
+}
+
+////////////////////////////////////////
+
+// encodeLut encodes a lut8 or lut16 element. Curves which are not tables of
+// the size of the element are sampled.
+func encodeLut(l *LUT) ([]byte, error) {
+	if err := l.check(); err != nil {
+		return nil, err
+	}
+	if l.Precision != 8 && l.Precision != 16 {
+		return nil, fmt.Errorf("LUT precision is neither 8 nor 16 bits (%v)", l.Precision)
+	}
+	if err := checkGridPoints(&l.CLUT); err != nil {
+		return nil, err
+	}
+	grid := l.CLUT.GridPoints[0]
+	for _, g := range l.CLUT.GridPoints {
+		if g != grid {
+			return nil, fmt.Errorf("LUT grid points differ between input channels (%v)", l.CLUT.GridPoints)
+		}
+	}
+
+	t, inEntries, outEntries := typeLut8, 256, 256
+	if l.Precision == 16 {
+		t, inEntries, outEntries = typeLut16, tableEntries(l.InputCurves), tableEntries(l.OutputCurves)
+	}
+
+	b := append(typeHeader(t), byte(l.InputChannels), byte(l.OutputChannels), byte(grid), 0)
+	for i := 0; i < 9; i++ {
+		b = append(b, s15Fixed16Bytes(l.Matrix[i/3][i%3])...)
+	}
+	if l.Precision == 16 {
+		b = appendU16(appendU16(b, uint16(inEntries)), uint16(outEntries))
+	}
+
+	for _, c := range l.InputCurves {
+		b = appendValues(b, sampleCurve(c, inEntries), l.Precision)
+	}
+	b = appendValues(b, l.CLUT.Data, l.Precision)
+	for _, c := range l.OutputCurves {
+		b = appendValues(b, sampleCurve(c, outEntries), l.Precision)
+	}
+
+	return b, nil
+}
+
+// encodeLutElements encodes the elements of a lutAtoB element when atob is
+// set, and of a lutBtoA element otherwise. The color lookup table is stored
+// with 16 bits of precision.
+func encodeLutElements(e *lutElements, atob bool) ([]byte, error) {
+	if err := e.check(atob); err != nil {
+		return nil, err
+	}
+
+	if e.clut != nil {
+		if err := checkGridPoints(e.clut); err != nil {
+			return nil, err
+		}
+	}
+
+	t := typeLutAtoB
+	if !atob {
+		t = typeLutBtoA
+	}
+
+	b := append(typeHeader(t), byte(e.in), byte(e.out), 0, 0)
+	b = append(b, make([]byte, 20)...)
+	setOffset := func(field int) { binary.BigEndian.PutUint32(b[field:], uint32(len(b))) }
+
+	setOffset(12)
+	b = appendCurves(b, e.b)
+
+	if e.matrix != nil {
+		setOffset(16)
+		for _, v := range e.matrix {
+			b = append(b, s15Fixed16Bytes(v)...)
+		}
+	}
+
+	if e.m != nil {
+		setOffset(20)
+		b = appendCurves(b, e.m)
+	}
+
+	if e.clut != nil {
+		setOffset(24)
+		grid := make([]byte, 16)
+		for i, g := range e.clut.GridPoints {
+			grid[i] = byte(g)
+		}
+		b = append(append(b, grid...), 2, 0, 0, 0)
+		b = appendValues(b, e.clut.Data, 16)
+		for len(b)%4 != 0 {
+			b = append(b, 0)
+		}
+	}
+
+	if e.a != nil {
+		setOffset(28)
+		b = appendCurves(b, e.a)
+	}
+
+	return b, nil
+}
+
+// checkGridPoints checks that the grid points of a color lookup table fit in
+// a byte.
+func checkGridPoints(c *CLUT) error {
+	for _, g := range c.GridPoints {
+		if g > 0xff {
+			return fmt.Errorf("CLUT grid points are out of the [1, 255] range (%v)", g)
+		}
+	}
+	return nil
+}
+
+// tableEntries returns the number of entries of the lut16 tables of a set of
+// curves: the size of their largest table, or 256 for parametric curves.
+func tableEntries(curves []gocolor.TransferCurve) int {
+	n := 0
+	for _, c := range curves {
+		if len(c.Table) > n {
+			n = len(c.Table)
+		}
+	}
+	if n < 2 {
+		return 256
+	}
+	if n > 4096 {
+		return 4096
+	}
+	return n
+}
+
+// sampleCurve returns the table of a curve with n entries.
+func sampleCurve(c gocolor.TransferCurve, n int) []float64 {
+	if len(c.Table) == n {
+		return c.Table
+	}
+
+	t := make([]float64, n)
+	for i := range t {
+		t[i] = c.ToLinear(float64(i) / float64(n-1))
+	}
+	return t
+}
+
+// appendValues appends values in the [0, 1] range with 8 or 16 bits.
+func appendValues(b []byte, v []float64, precision int) []byte {
+	for _, f := range v {
+		f = interp.Clamp01(f)
+		if precision == 8 {
+			b = append(b, byte(math.Round(f*0xff)))
+		} else {
+			b = appendU16(b, uint16(math.Round(f*0xffff)))
+		}
+	}
+	return b
+}
+
+// appendCurves appends curv or para elements, each aligned on 4 bytes.
+func appendCurves(b []byte, curves []gocolor.TransferCurve) []byte {
+	for _, c := range curves {
+		b = append(b, encodeCurve(c)...)
+		for len(b)%4 != 0 {
+			b = append(b, 0)
+		}
+	}
+	return b
+}
@@ -0,0 +1,183 @@
+// Copyright © 2019 Xavier Basty <xavier@hexbee.net>
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package icc_test
+
+import (
+	"bytes"
+	"crypto/md5"
+	"encoding/binary"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/Hexbee-net/gocolor"
+	"github.com/Hexbee-net/gocolor/icc"
+)
+
+func TestNewRGBProfile(t *testing.T) {
+	spaces := []string{
+		gocolor.AdobeRGB, gocolor.AppleRGB, gocolor.BestRGB, gocolor.BetaRGB, gocolor.BruceRGB,
+		gocolor.BT2020, gocolor.BT202012b, gocolor.CieRGB, gocolor.ColorMatchRGB, gocolor.DonRGB4, gocolor.EktaSpacePS5,
+		gocolor.NtscRGB, gocolor.PalSecamRGB, gocolor.ProPhotoRGB, gocolor.SmptecRGB, gocolor.SRGB,
+		gocolor.WideGamutRGB,
+	}
+
+	for _, space := range spaces {
+		p, err := icc.NewRGBProfile(space)
+		require.NoError(t, err, space)
+
+		var buf bytes.Buffer
+		require.NoError(t, icc.Encode(&buf, p), space)
+		data := buf.Bytes()
+
+		parsed, err := icc.Parse(data)
+		require.NoError(t, err, space)
+		assert.Equal(t, uint32(len(data)), parsed.Size, space)
+		assert.Equal(t, 4, parsed.Version.Major(), space)
+		assert.Equal(t, icc.ClassDisplay, parsed.Class, space)
+		assert.Equal(t, p.Date, parsed.Date, space)
+		assert.Equal(t, space, parsed.Description(), space)
+		assert.Equal(t, "No copyright, use freely", parsed.Copyright(), space)
+		assert.IsType(t, []icc.LocalizedString{}, parsed.Tags[icc.TagProfileDescription], space)
+		_, ok := parsed.ChromaticAdaptation()
+		assert.True(t, ok, space)
+
+		// The profile ID is the MD5 of the profile with the flags, rendering
+		// intent and ID zeroed.
+		id := append([]byte(nil), data...)
+		copy(id[44:48], make([]byte, 4))
+		copy(id[64:68], make([]byte, 4))
+		copy(id[84:100], make([]byte, 16))
+		assert.Equal(t, md5.Sum(id), parsed.ID, space)
+
+		// The D50 colorants add up to the PCS white. The NTSC matrix is the one
+		// of illuminant C while the space is tabulated with D50, so it cannot.
+		var sum icc.XYZNumber
+		for _, tag := range []icc.Signature{icc.TagRedColorant, icc.TagGreenColorant, icc.TagBlueColorant} {
+			xyz, ok := parsed.XYZ(tag)
+			require.True(t, ok, space)
+			sum.X, sum.Y, sum.Z = sum.X+xyz.X, sum.Y+xyz.Y, sum.Z+xyz.Z
+		}
+		if space != gocolor.NtscRGB {
+			assert.InDelta(t, 0.9642, sum.X, 2e-3, space)
+			assert.InDelta(t, 1, sum.Y, 2e-3, space)
+			assert.InDelta(t, 0.8249, sum.Z, 2e-3, space)
+		}
+
+		// Going back to an RGB space yields the original definition.
+		ref, err := gocolor.LookupRGBSpace(space)
+		require.NoError(t, err)
+		def, err := parsed.RGBSpace()
+		require.NoError(t, err, space)
+		for i := 0; i < 3; i++ {
+			assert.InDelta(t, ref.White[i], def.White[i], 1e-4, space)
+			for j := 0; j < 3; j++ {
+				assert.InDelta(t, ref.ToXYZ[i][j], def.ToXYZ[i][j], 1e-4, space)
+			}
+		}
+		for _, v := range []float64{0.01, 0.2, 0.5, 0.9} {
+			assert.InDelta(t, ref.Transfer[0].ToLinear(v), def.Transfer[0].ToLinear(v), 1e-4, space)
+		}
+	}
+
+	_, err := icc.NewRGBProfile("unknown")
+	assert.Error(t, err)
+}
+
+func TestEncodeSharedTags(t *testing.T) {
+	p, err := icc.NewRGBProfile(gocolor.SRGB)
+	require.NoError(t, err)
+	data, err := icc.Marshal(p)
+	require.NoError(t, err)
+
+	// The three identical TRCs are stored once.
+	offsets := map[uint32]int{}
+	count := int(binary.BigEndian.Uint32(data[128:]))
+	for i := 0; i < count; i++ {
+		e := data[132+12*i:]
+		switch icc.Signature(binary.BigEndian.Uint32(e)) {
+		case icc.TagRedTRC, icc.TagGreenTRC, icc.TagBlueTRC:
+			offsets[binary.BigEndian.Uint32(e[4:])]++
+		}
+	}
+	assert.Len(t, offsets, 1)
+}
+
+func TestEncodeV2(t *testing.T) {
+	p := &icc.Profile{
+		Header: icc.Header{
+			Version:    0x02100000,
+			Class:      icc.ClassDisplay,
+			ColorSpace: icc.SpaceGray,
+			PCS:        icc.SpaceXYZ,
+		},
+		Tags: map[icc.Signature]interface{}{
+			icc.TagProfileDescription: "Gray 1.8",
+			icc.TagCopyright:          "Public domain",
+			icc.TagGrayTRC:            gocolor.TransferCurve{Table: []float64{0, 0.25, 1}},
+			icc.TagMediaWhitePoint:    []icc.XYZNumber{{0.9642, 1, 0.8249}},
+		},
+	}
+
+	data, err := icc.Marshal(p)
+	require.NoError(t, err)
+	assert.Equal(t, make([]byte, 16), data[84:100])
+
+	parsed, err := icc.Parse(data)
+	require.NoError(t, err)
+	assert.Equal(t, "Gray 1.8", parsed.Description())
+	assert.Equal(t, "Public domain", parsed.Copyright())
+	assert.IsType(t, "", parsed.Tags[icc.TagProfileDescription])
+	trc := parsed.Tags[icc.TagGrayTRC].(gocolor.TransferCurve)
+	assert.InDeltaSlice(t, []float64{0, 0.25, 1}, trc.Table, 1e-4)
+
+	p.Tags[icc.TagAToB0] = &icc.LUT{InputChannels: 1, OutputChannels: 1, Precision: 12}
+	_, err = icc.Marshal(p)
+	assert.Error(t, err)
+}
+
+func TestEncodeLUTs(t *testing.T) {
+	p, err := icc.Parse(lutProfile())
+	require.NoError(t, err)
+
+	data, err := icc.Marshal(p)
+	require.NoError(t, err)
+	parsed, err := icc.Parse(data)
+	require.NoError(t, err)
+	assert.Equal(t, p.Tags, parsed.Tags)
+
+	// Parametric curves of lut16 tags are sampled.
+	l := parsed.Tags[icc.TagAToB0].(*icc.LUT)
+	l.InputCurves = []gocolor.TransferCurve{gocolor.GammaCurve(2), gocolor.GammaCurve(2)}
+	data, err = icc.Marshal(parsed)
+	require.NoError(t, err)
+	parsed, err = icc.Parse(data)
+	require.NoError(t, err)
+	curve := parsed.Tags[icc.TagAToB0].(*icc.LUT).InputCurves[0]
+	assert.Len(t, curve.Table, 256)
+	assert.InDelta(t, 0.25, curve.ToLinear(0.5), 1e-4)
+
+	invalid := []interface{}{
+		&icc.LUT{InputChannels: 2, OutputChannels: 1, Precision: 16},
+		&icc.LUTAtoB{InputChannels: 1, OutputChannels: 3},
+		&icc.LUTBtoA{InputChannels: 1, OutputChannels: 1, B: make([]gocolor.TransferCurve, 1), CLUT: &icc.CLUT{GridPoints: []int{2}, Outputs: 1}},
+	}
+	for _, v := range invalid {
+		parsed.Tags[icc.TagAToB0] = v
+		_, err = icc.Marshal(parsed)
+		assert.Error(t, err, "%T", v)
+	}
+}
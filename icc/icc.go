@@ -0,0 +1,327 @@
+// Copyright © 2019 Xavier Basty <xavier@hexbee.net>
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package icc reads and writes ICC color profiles, as specified by the
+// International Color Consortium in ICC.1:2001-04 (version 2) and ICC.1:2010
+// (version 4).
+//
+// Matrix/TRC RGB profiles can be turned into RGB color spaces usable by the
+// gocolor conversion functions, and the RGB color spaces known to gocolor can
+// be written as display profiles.
+package icc
+
+import (
+	"bytes"
+	"encoding/binary"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"time"
+)
+
+// Signature is a four characters code identifying ICC profile elements.
+type Signature uint32
+
+// String returns the four characters of the signature.
+func (s Signature) String() string {
+	b := []byte{byte(s >> 24), byte(s >> 16), byte(s >> 8), byte(s)}
+	return string(bytes.TrimRight(b, "\x00"))
+}
+
+func sig(s string) Signature {
+	return Signature(binary.BigEndian.Uint32([]byte(s)))
+}
+
+// Profile classes.
+var (
+	ClassInput      = sig("scnr")
+	ClassDisplay    = sig("mntr")
+	ClassOutput     = sig("prtr")
+	ClassLink       = sig("link")
+	ClassColorSpace = sig("spac")
+	ClassAbstract   = sig("abst")
+	ClassNamedColor = sig("nmcl")
+)
+
+// Color spaces of the profile data and connection spaces.
+var (
+	SpaceXYZ  = sig("XYZ ")
+	SpaceLab  = sig("Lab ")
+	SpaceRGB  = sig("RGB ")
+	SpaceGray = sig("GRAY")
+	SpaceCMYK = sig("CMYK")
+	SpaceCMY  = sig("CMY ")
+)
+
+// Tags signatures.
+var (
+	TagAToB0               = sig("A2B0")
+	TagAToB1               = sig("A2B1")
+	TagAToB2               = sig("A2B2")
+	TagBToA0               = sig("B2A0")
+	TagBToA1               = sig("B2A1")
+	TagBToA2               = sig("B2A2")
+	TagBlueColorant        = sig("bXYZ")
+	TagBlueTRC             = sig("bTRC")
+	TagChromaticAdaptation = sig("chad")
+	TagCopyright           = sig("cprt")
+	TagGrayTRC             = sig("kTRC")
+	TagGreenColorant       = sig("gXYZ")
+	TagGreenTRC            = sig("gTRC")
+	TagMediaBlackPoint     = sig("bkpt")
+	TagMediaWhitePoint     = sig("wtpt")
+	TagProfileDescription  = sig("desc")
+	TagRedColorant         = sig("rXYZ")
+	TagRedTRC              = sig("rTRC")
+)
+
+// Tag types signatures.
+var (
+	typeCurve                 = sig("curv")
+	typeLut16                 = sig("mft2")
+	typeLut8                  = sig("mft1")
+	typeLutAtoB               = sig("mAB ")
+	typeLutBtoA               = sig("mBA ")
+	typeMultiLocalizedUnicode = sig("mluc")
+	typeParametricCurve       = sig("para")
+	typeS15Fixed16Array       = sig("sf32")
+	typeText                  = sig("text")
+	typeTextDescription       = sig("desc")
+	typeXYZ                   = sig("XYZ ")
+)
+
+var profileMagic = sig("acsp")
+
+// RenderingIntent selects how colors are mapped between profiles.
+type RenderingIntent uint32
+
+const (
+	Perceptual           RenderingIntent = 0
+	RelativeColorimetric RenderingIntent = 1
+	Saturation           RenderingIntent = 2
+	AbsoluteColorimetric RenderingIntent = 3
+)
+
+// Version is the version of the ICC specification a profile conforms to,
+// in its binary-coded decimal form.
+type Version uint32
+
+// Major returns the major version number.
+func (v Version) Major() int {
+	return int(v >> 24)
+}
+
+// Minor returns the minor version number.
+func (v Version) Minor() int {
+	return int(v>>20) & 0xf
+}
+
+// Bugfix returns the bug fix version number.
+func (v Version) Bugfix() int {
+	return int(v>>16) & 0xf
+}
+
+func (v Version) String() string {
+	return fmt.Sprintf("%d.%d.%d", v.Major(), v.Minor(), v.Bugfix())
+}
+
+// XYZNumber is a set of XYZ tristimulus values, with Y = 1 for the perfect diffuser.
+type XYZNumber struct {
+	X, Y, Z float64
+}
+
+// Header is the fixed size header of an ICC profile.
+type Header struct {
+	Size            uint32
+	CMM             Signature
+	Version         Version
+	Class           Signature
+	ColorSpace      Signature
+	PCS             Signature
+	Date            time.Time
+	Platform        Signature
+	Flags           uint32
+	Manufacturer    Signature
+	Model           Signature
+	Attributes      uint64
+	RenderingIntent RenderingIntent
+	Illuminant      XYZNumber
+	Creator         Signature
+	ID              [16]byte
+}
+
+// Profile is a decoded ICC profile.
+//
+// Tags holds the decoded value of each tag, whose type depends on the type
+// of the tag data:
+//
+//	XYZ        []XYZNumber
+//	curv, para gocolor.TransferCurve
+//	sf32       []float64
+//	desc, text string
+//	mluc       []LocalizedString
+//	mft1, mft2 *LUT
+//	mAB        *LUTAtoB
+//	mBA        *LUTBtoA
+//
+// Tags of any other type are kept as RawTag.
+type Profile struct {
+	Header
+	Tags map[Signature]interface{}
+}
+
+// RawTag holds the undecoded data of a tag whose type is not supported.
+type RawTag struct {
+	Type Signature
+	Data []byte
+}
+
+const headerSize = 128
+
+// Decode reads and parses an ICC profile.
+func Decode(r io.Reader) (*Profile, error) {
+	data, err := ioutil.ReadAll(r)
+	if err != nil {
+		return nil, err
+	}
+
+	return Parse(data)
+}
+
+// Parse parses an ICC profile.
+func Parse(data []byte) (*Profile, error) {
+	if len(data) < headerSize+4 {
+		return nil, fmt.Errorf("profile is too short (%v bytes)", len(data))
+	}
+	if s := Signature(binary.BigEndian.Uint32(data[36:])); s != profileMagic {
+		return nil, fmt.Errorf("invalid profile file signature (%v)", s)
+	}
+
+	p := &Profile{Tags: map[Signature]interface{}{}}
+	p.Header = parseHeader(data)
+	if int64(p.Size) > int64(len(data)) {
+		return nil, fmt.Errorf("profile size is larger than the data (%v > %v)", p.Size, len(data))
+	}
+	if p.Size < headerSize+4 {
+		return nil, fmt.Errorf("profile size is too small (%v)", p.Size)
+	}
+	data = data[:p.Size]
+
+	count := binary.BigEndian.Uint32(data[headerSize:])
+	if uint64(count)*12 > uint64(len(data)-headerSize-4) {
+		return nil, fmt.Errorf("tag count is out of the profile bounds (%v)", count)
+	}
+
+	for i := 0; i < int(count); i++ {
+		entry := data[headerSize+4+12*i:]
+		s := Signature(binary.BigEndian.Uint32(entry))
+		offset := binary.BigEndian.Uint32(entry[4:])
+		size := binary.BigEndian.Uint32(entry[8:])
+
+		if uint64(offset)+uint64(size) > uint64(len(data)) {
+			return nil, fmt.Errorf("%v tag is out of the profile bounds (offset %v, size %v)", s, offset, size)
+		}
+
+		v, err := decodeTag(data[offset : offset+size])
+		if err != nil {
+			return nil, fmt.Errorf("invalid %v tag: %v", s, err)
+		}
+		p.Tags[s] = v
+	}
+
+	return p, nil
+}
+
+func parseHeader(data []byte) Header {
+	u16 := func(o int) int { return int(binary.BigEndian.Uint16(data[o:])) }
+	u32 := func(o int) uint32 { return binary.BigEndian.Uint32(data[o:]) }
+
+	h := Header{
+		Size:         u32(0),
+		CMM:          Signature(u32(4)),
+		Version:      Version(u32(8)),
+		Class:        Signature(u32(12)),
+		ColorSpace:   Signature(u32(16)),
+		PCS:          Signature(u32(20)),
+		Platform:     Signature(u32(40)),
+		Flags:        u32(44),
+		Manufacturer: Signature(u32(48)),
+		Model:        Signature(u32(52)),
+		Attributes:   binary.BigEndian.Uint64(data[56:]),
+
+		RenderingIntent: RenderingIntent(u32(64) & 0xffff),
+		Illuminant:      readXYZNumber(data[68:]),
+		Creator:         Signature(u32(80)),
+	}
+	if u16(24) != 0 {
+		h.Date = time.Date(u16(24), time.Month(u16(26)), u16(28), u16(30), u16(32), u16(34), 0, time.UTC)
+	}
+	copy(h.ID[:], data[84:100])
+
+	return h
+}
+
+////////////////////////////////////////
+
+// XYZ returns the value of an XYZ tag, such as a colorant or the media white point.
+func (p *Profile) XYZ(tag Signature) (XYZNumber, bool) {
+	v, ok := p.Tags[tag].([]XYZNumber)
+	if !ok || len(v) == 0 {
+		return XYZNumber{}, false
+	}
+	return v[0], true
+}
+
+// ChromaticAdaptation returns the matrix of the chromatic adaptation tag,
+// converting XYZ values from the actual illuminant to the PCS illuminant.
+func (p *Profile) ChromaticAdaptation() ([3][3]float64, bool) {
+	var m [3][3]float64
+
+	v, ok := p.Tags[TagChromaticAdaptation].([]float64)
+	if !ok || len(v) != 9 {
+		return m, false
+	}
+	for i := range v {
+		m[i/3][i%3] = v[i]
+	}
+	return m, true
+}
+
+// Description returns the profile description, in English when localized
+// descriptions are available.
+func (p *Profile) Description() string {
+	return p.text(TagProfileDescription)
+}
+
+// Copyright returns the profile copyright notice.
+func (p *Profile) Copyright() string {
+	return p.text(TagCopyright)
+}
+
+func (p *Profile) text(tag Signature) string {
+	switch v := p.Tags[tag].(type) {
+	case string:
+		return v
+	case []LocalizedString:
+		for _, s := range v {
+			if s.Language == "en" {
+				return s.Text
+			}
+		}
+		if len(v) > 0 {
+			return v[0].Text
+		}
+	}
+	return ""
+}
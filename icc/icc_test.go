@@ -0,0 +1,452 @@
+// Copyright © 2019 Xavier Basty <xavier@hexbee.net>
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package icc_test
+
+import (
+	"bytes"
+	"encoding/binary"
+	"math"
+	"testing"
+	"time"
+	"unicode/utf16"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/Hexbee-net/gocolor"
+	"github.com/Hexbee-net/gocolor/icc"
+)
+
+// profileBuilder assembles synthetic ICC profiles.
+type profileBuilder struct {
+	version    uint32
+	class      string
+	space, pcs string
+	tags       []taggedData
+}
+
+type taggedData struct {
+	sig  string
+	data []byte
+}
+
+func (p *profileBuilder) add(sig string, data []byte) *profileBuilder {
+	p.tags = append(p.tags, taggedData{sig, data})
+	return p
+}
+
+func (p *profileBuilder) bytes() []byte {
+	var tags bytes.Buffer
+	table := make([]byte, 4+12*len(p.tags))
+	binary.BigEndian.PutUint32(table, uint32(len(p.tags)))
+
+	offset := 128 + len(table)
+	for i, t := range p.tags {
+		e := table[4+12*i:]
+		copy(e, t.sig)
+		binary.BigEndian.PutUint32(e[4:], uint32(offset+tags.Len()))
+		binary.BigEndian.PutUint32(e[8:], uint32(len(t.data)))
+		tags.Write(t.data)
+		for tags.Len()%4 != 0 {
+			tags.WriteByte(0)
+		}
+	}
+
+	h := make([]byte, 128)
+	binary.BigEndian.PutUint32(h, uint32(128+len(table)+tags.Len()))
+	copy(h[4:], "test")
+	binary.BigEndian.PutUint32(h[8:], p.version)
+	copy(h[12:], p.class)
+	copy(h[16:], p.space)
+	copy(h[20:], p.pcs)
+	for i, v := range []uint16{2019, 6, 15, 12, 30, 45} {
+		binary.BigEndian.PutUint16(h[24+2*i:], v)
+	}
+	copy(h[36:], "acsp")
+	binary.BigEndian.PutUint32(h[64:], 1)
+	copy(h[68:], xyzNumber(0.9642, 1, 0.8249))
+
+	return append(append(h, table...), tags.Bytes()...)
+}
+
+func s15(v float64) []byte {
+	b := make([]byte, 4)
+	binary.BigEndian.PutUint32(b, uint32(int32(math.Round(v*0x10000))))
+	return b
+}
+
+func xyzNumber(x, y, z float64) []byte {
+	return append(append(s15(x), s15(y)...), s15(z)...)
+}
+
+func typed(sig string, data ...[]byte) []byte {
+	b := append([]byte(sig), 0, 0, 0, 0)
+	for _, d := range data {
+		b = append(b, d...)
+	}
+	return b
+}
+
+func u16s(v ...uint16) []byte {
+	b := make([]byte, 2*len(v))
+	for i := range v {
+		binary.BigEndian.PutUint16(b[2*i:], v[i])
+	}
+	return b
+}
+
+func u32s(v ...uint32) []byte {
+	b := make([]byte, 4*len(v))
+	for i := range v {
+		binary.BigEndian.PutUint32(b[4*i:], v[i])
+	}
+	return b
+}
+
+func xyzTag(x, y, z float64) []byte {
+	return typed("XYZ ", xyzNumber(x, y, z))
+}
+
+func sf32Tag(v ...float64) []byte {
+	b := typed("sf32")
+	for _, f := range v {
+		b = append(b, s15(f)...)
+	}
+	return b
+}
+
+func gammaTag(gamma float64) []byte {
+	return typed("curv", u32s(1), u16s(uint16(gamma*0x100)))
+}
+
+func paraTag(fn uint16, params ...float64) []byte {
+	b := typed("para", u16s(fn, 0))
+	for _, p := range params {
+		b = append(b, s15(p)...)
+	}
+	return b
+}
+
+func mlucTag(lang, country, text string) []byte {
+	units := utf16.Encode([]rune(text))
+	return typed("mluc", u32s(1, 12), []byte(lang+country), u32s(uint32(2*len(units)), 28), u16s(units...))
+}
+
+func descTag(text string) []byte {
+	return typed("desc", u32s(uint32(len(text)+1)), []byte(text), []byte{0}, u32s(0, 0), u16s(0), make([]byte, 68))
+}
+
+// sRGB colorants adapted to D50, and the Bradford adaptation from D65 to D50.
+var (
+	srgbColorants = [3][3]float64{
+		{0.4360747, 0.2225045, 0.0139322},
+		{0.3850649, 0.7168786, 0.0971045},
+		{0.1430804, 0.0606169, 0.7141733},
+	}
+	srgbChad = []float64{
+		1.0478112, 0.0228866, -0.0501270,
+		0.0295424, 0.9904844, -0.0170491,
+		-0.0092345, 0.0150436, 0.7521316,
+	}
+)
+
+func srgbProfile(version uint32) *profileBuilder {
+	p := &profileBuilder{version: version, class: "mntr", space: "RGB ", pcs: "XYZ "}
+	p.add("rXYZ", xyzTag(srgbColorants[0][0], srgbColorants[0][1], srgbColorants[0][2]))
+	p.add("gXYZ", xyzTag(srgbColorants[1][0], srgbColorants[1][1], srgbColorants[1][2]))
+	p.add("bXYZ", xyzTag(srgbColorants[2][0], srgbColorants[2][1], srgbColorants[2][2]))
+	return p
+}
+
+////////////////////////////////////////
+
+func TestParseV4(t *testing.T) {
+	trc := paraTag(3, 2.4, 1/1.055, 0.055/1.055, 1/12.92, 0.04045)
+	data := srgbProfile(0x04300000).
+		add("desc", mlucTag("en", "US", "sRGB v4 ✓")).
+		add("wtpt", xyzTag(0.9642, 1, 0.8249)).
+		add("chad", sf32Tag(srgbChad...)).
+		add("rTRC", trc).add("gTRC", trc).add("bTRC", trc).
+		add("zzzz", typed("zzzz", []byte{1, 2, 3, 4})).
+		bytes()
+
+	p, err := icc.Decode(bytes.NewReader(data))
+	require.NoError(t, err)
+
+	assert.Equal(t, uint32(len(data)), p.Size)
+	assert.Equal(t, "test", p.CMM.String())
+	assert.Equal(t, "4.3.0", p.Version.String())
+	assert.Equal(t, icc.ClassDisplay, p.Class)
+	assert.Equal(t, icc.SpaceRGB, p.ColorSpace)
+	assert.Equal(t, icc.SpaceXYZ, p.PCS)
+	assert.Equal(t, time.Date(2019, 6, 15, 12, 30, 45, 0, time.UTC), p.Date)
+	assert.Equal(t, icc.RelativeColorimetric, p.RenderingIntent)
+	assert.InDelta(t, 0.9642, p.Illuminant.X, 1e-4)
+	assert.Equal(t, "sRGB v4 ✓", p.Description())
+
+	chad, ok := p.ChromaticAdaptation()
+	assert.True(t, ok)
+	assert.InDelta(t, 1.0478112, chad[0][0], 1e-4)
+	assert.InDelta(t, 0.7521316, chad[2][2], 1e-4)
+
+	curve, ok := p.Tags[icc.TagRedTRC].(gocolor.TransferCurve)
+	require.True(t, ok)
+	assert.InDelta(t, 0.214041, curve.ToLinear(0.5), 1e-4)
+
+	raw, ok := p.Tags[icc.Signature(0x7a7a7a7a)].(icc.RawTag)
+	require.True(t, ok)
+	assert.Equal(t, "zzzz", raw.Type.String())
+
+	def, err := p.RGBSpace()
+	require.NoError(t, err)
+	assert.InDelta(t, 0.95047, def.White[0], 2e-3)
+	assert.InDelta(t, 1.08883, def.White[2], 2e-3)
+
+	ref, err := gocolor.LookupRGBSpace(gocolor.SRGB)
+	require.NoError(t, err)
+	for i := 0; i < 3; i++ {
+		for j := 0; j < 3; j++ {
+			assert.InDelta(t, ref.ToXYZ[i][j], def.ToXYZ[i][j], 2e-3)
+		}
+	}
+
+	require.NoError(t, p.Register("test sRGB v4"))
+	defer func() { assert.NoError(t, gocolor.UnregisterRGBSpace("test sRGB v4")) }()
+	assert.Error(t, p.Register("test sRGB v4"))
+
+	for _, c := range [][3]float64{{0.5, 0.5, 0.5}, {0.2, 0.5, 0.8}, {0.9, 0.1, 0.3}} {
+		x, y, z, err := gocolor.RGBtoXYZ(c[0], c[1], c[2], "test sRGB v4")
+		require.NoError(t, err)
+		ex, ey, ez, err := gocolor.RGBtoXYZ(c[0], c[1], c[2], gocolor.SRGB)
+		require.NoError(t, err)
+		assert.InDelta(t, ex, x, 2e-3)
+		assert.InDelta(t, ey, y, 2e-3)
+		assert.InDelta(t, ez, z, 2e-3)
+
+		r, g, b, err := gocolor.XYZtoRGB(x, y, z, "test sRGB v4")
+		require.NoError(t, err)
+		assert.InDelta(t, c[0], r, 1e-9)
+		assert.InDelta(t, c[1], g, 1e-9)
+		assert.InDelta(t, c[2], b, 1e-9)
+	}
+}
+
+func TestParseV2(t *testing.T) {
+	// Version 2 profiles without chromatic adaptation tag are adapted with
+	// the Bradford transform to the media white point.
+	trc := gammaTag(2.2)
+	data := srgbProfile(0x02100000).
+		add("desc", descTag("Legacy RGB")).
+		add("cprt", typed("text", []byte("Public domain\x00"))).
+		add("wtpt", xyzTag(0.95047, 1, 1.08883)).
+		add("rTRC", trc).add("gTRC", trc).add("bTRC", trc).
+		bytes()
+
+	p, err := icc.Parse(data)
+	require.NoError(t, err)
+	assert.Equal(t, 2, p.Version.Major())
+	assert.Equal(t, 1, p.Version.Minor())
+	assert.Equal(t, "Legacy RGB", p.Description())
+	assert.Equal(t, "Public domain", p.Copyright())
+
+	wtpt, ok := p.XYZ(icc.TagMediaWhitePoint)
+	assert.True(t, ok)
+	assert.InDelta(t, 1.08883, wtpt.Z, 1e-4)
+
+	def, err := p.RGBSpace()
+	require.NoError(t, err)
+	assert.InDelta(t, 0.95047, def.White[0], 1e-3)
+	assert.InDelta(t, 1.08883, def.White[2], 1e-3)
+	assert.InDelta(t, 2.2, def.Transfer[1].Gamma, 1e-2)
+
+	// The columns of the matrix add up to the white point.
+	for i := 0; i < 3; i++ {
+		assert.InDelta(t, def.White[i], def.ToXYZ[i][0]+def.ToXYZ[i][1]+def.ToXYZ[i][2], 2e-3)
+	}
+}
+
+func TestParseCurves(t *testing.T) {
+	data := (&profileBuilder{version: 0x04200000, class: "mntr", space: "GRAY", pcs: "XYZ "}).
+		add("kTRC", typed("curv", u32s(3), u16s(0, 0x4000, 0xffff))).
+		add("aaaa", typed("curv", u32s(0))).
+		add("bbbb", paraTag(1, 2, 2, -0.5)).
+		add("cccc", paraTag(2, 1, 1, 0, 0.25)).
+		add("dddd", paraTag(4, 1, 1, 0, 0.5, 0.5, 0.1, 0.2)).
+		bytes()
+
+	p, err := icc.Parse(data)
+	require.NoError(t, err)
+
+	table := p.Tags[icc.TagGrayTRC].(gocolor.TransferCurve)
+	assert.Len(t, table.Table, 3)
+	assert.InDelta(t, 0.25, table.ToLinear(0.5), 1e-4)
+	assert.InDelta(t, 0.125, table.ToLinear(0.25), 1e-4)
+	assert.InDelta(t, 0.25, table.FromLinear(0.125), 1e-4)
+	assert.InDelta(t, 0.75, table.FromLinear(0.625), 1e-4)
+
+	identity := p.Tags[icc.Signature(0x61616161)].(gocolor.TransferCurve)
+	assert.InDelta(t, 0.3, identity.ToLinear(0.3), 1e-12)
+
+	// (2x - 0.5)² above 0.25, and 0 below.
+	c1 := p.Tags[icc.Signature(0x62626262)].(gocolor.TransferCurve)
+	assert.InDelta(t, 0, c1.ToLinear(0.2), 1e-12)
+	assert.InDelta(t, 0.25, c1.ToLinear(0.5), 1e-4)
+	assert.InDelta(t, 0.5, c1.FromLinear(0.25), 1e-4)
+
+	// x + 0.25 everywhere.
+	c2 := p.Tags[icc.Signature(0x63636363)].(gocolor.TransferCurve)
+	assert.InDelta(t, 0.35, c2.ToLinear(0.1), 1e-4)
+	assert.InDelta(t, 0.75, c2.ToLinear(0.5), 1e-4)
+
+	// x + 0.1 above 0.5, 0.5x + 0.2 below.
+	c4 := p.Tags[icc.Signature(0x64646464)].(gocolor.TransferCurve)
+	assert.InDelta(t, 0.8, c4.ToLinear(0.7), 1e-4)
+	assert.InDelta(t, 0.3, c4.ToLinear(0.2), 1e-4)
+	assert.InDelta(t, 0.7, c4.FromLinear(0.8), 1e-4)
+	assert.InDelta(t, 0.2, c4.FromLinear(0.3), 1e-4)
+
+	_, err = p.RGBSpace()
+	assert.Error(t, err)
+}
+
+// lutProfile returns a printer profile with lut16, lut8, lutAtoB and lutBtoA
+// tags.
+func lutProfile() []byte {
+	identity := s15(1)
+	zero := s15(0)
+	matrix := bytes.Join([][]byte{identity, zero, zero, zero, identity, zero, zero, zero, identity}, nil)
+
+	// lut16 with 2 inputs, 1 output and 2 grid points.
+	mft2 := typed("mft2", []byte{2, 1, 2, 0}, matrix, u16s(2, 2),
+		u16s(0, 0xffff, 0, 0xffff),
+		u16s(0, 0x5555, 0xaaaa, 0xffff),
+		u16s(0xffff, 0))
+
+	// lut8 with 1 input, 2 outputs and 3 grid points.
+	mft1 := typed("mft1", []byte{1, 2, 3, 0}, matrix,
+		make([]byte, 256),
+		[]byte{0, 0xff, 0x80, 0x80, 0xff, 0},
+		make([]byte, 2*256))
+
+	// lutAtoB with 1 input and 3 outputs: B curves and a CLUT.
+	gamma1 := typed("curv", u32s(0))
+	clut := append(append([]byte{2}, make([]byte, 15)...), 2, 0, 0, 0)
+	clut = append(clut, u16s(0, 0, 0, 0xffff, 0x8000, 0)...)
+	mAB := typed("mAB ", []byte{1, 3, 0, 0}, u32s(32, 0, 0, 68, 0))
+	mAB = append(mAB, gamma1...)
+	mAB = append(mAB, gamma1...)
+	mAB = append(mAB, gamma1...)
+	mAB = append(mAB, clut...)
+
+	// lutBtoA with 3 inputs and 3 outputs: B curves, a matrix and M curves.
+	mBA := typed("mBA ", []byte{3, 3, 0, 0}, u32s(32, 68, 116, 0, 0))
+	for i := 0; i < 3; i++ {
+		mBA = append(mBA, gamma1...)
+	}
+	mBA = append(mBA, matrix...)
+	mBA = append(mBA, xyzNumber(0.1, 0.2, 0.3)...)
+	for i := 0; i < 3; i++ {
+		mBA = append(mBA, paraTag(0, 1)...)
+	}
+
+	return (&profileBuilder{version: 0x04200000, class: "prtr", space: "CMYK", pcs: "Lab "}).
+		add("A2B0", mft2).add("A2B1", mft1).add("A2B2", mAB).add("B2A0", mBA).
+		bytes()
+}
+
+func TestParseLUTs(t *testing.T) {
+	p, err := icc.Parse(lutProfile())
+	require.NoError(t, err)
+
+	l16 := p.Tags[icc.TagAToB0].(*icc.LUT)
+	assert.Equal(t, 16, l16.Precision)
+	assert.Equal(t, 2, l16.InputChannels)
+	assert.Equal(t, 1, l16.OutputChannels)
+	assert.Equal(t, []int{2, 2}, l16.CLUT.GridPoints)
+	assert.InDeltaSlice(t, []float64{0, 1.0 / 3, 2.0 / 3, 1}, l16.CLUT.Data, 1e-6)
+	assert.InDelta(t, 1, l16.OutputCurves[0].ToLinear(0), 1e-9)
+	assert.Equal(t, 1.0, l16.Matrix[1][1])
+
+	l8 := p.Tags[icc.TagAToB1].(*icc.LUT)
+	assert.Equal(t, 8, l8.Precision)
+	assert.Equal(t, []int{3}, l8.CLUT.GridPoints)
+	assert.Equal(t, 2, l8.CLUT.Outputs)
+	assert.InDeltaSlice(t, []float64{0, 1, 128.0 / 255, 128.0 / 255, 1, 0}, l8.CLUT.Data, 1e-9)
+
+	ab := p.Tags[icc.TagAToB2].(*icc.LUTAtoB)
+	assert.Equal(t, 1, ab.InputChannels)
+	assert.Equal(t, 3, ab.OutputChannels)
+	assert.Len(t, ab.B, 3)
+	assert.Nil(t, ab.A)
+	assert.Nil(t, ab.Matrix)
+	require.NotNil(t, ab.CLUT)
+	assert.Equal(t, []int{2}, ab.CLUT.GridPoints)
+	assert.InDelta(t, 0x8000/65535.0, ab.CLUT.Data[4], 1e-9)
+
+	ba := p.Tags[icc.TagBToA0].(*icc.LUTBtoA)
+	assert.Len(t, ba.B, 3)
+	assert.Len(t, ba.M, 3)
+	require.NotNil(t, ba.Matrix)
+	assert.InDelta(t, 0.3, ba.Matrix[11], 1e-4)
+	assert.Nil(t, ba.CLUT)
+}
+
+func TestParseErrors(t *testing.T) {
+	_, err := icc.Parse(make([]byte, 64))
+	assert.Error(t, err)
+
+	valid := srgbProfile(0x04300000).bytes()
+
+	data := append([]byte(nil), valid...)
+	copy(data[36:], "xxxx")
+	_, err = icc.Parse(data)
+	assert.Error(t, err)
+
+	// Declared size larger than the data.
+	_, err = icc.Parse(valid[:len(valid)-4])
+	assert.Error(t, err)
+
+	// Tag out of bounds.
+	data = append([]byte(nil), valid...)
+	binary.BigEndian.PutUint32(data[128+4+8:], 1000)
+	_, err = icc.Parse(data)
+	assert.Error(t, err)
+
+	// Tag count out of bounds.
+	data = append([]byte(nil), valid...)
+	binary.BigEndian.PutUint32(data[128:], 1000)
+	_, err = icc.Parse(data)
+	assert.Error(t, err)
+
+	// Truncated curve.
+	data = (&profileBuilder{version: 0x04200000, class: "mntr", space: "GRAY", pcs: "XYZ "}).
+		add("kTRC", typed("curv", u32s(100), u16s(0, 1))).
+		bytes()
+	_, err = icc.Parse(data)
+	assert.Error(t, err)
+
+	// lutBtoA without CLUT and with different numbers of channels.
+	data = (&profileBuilder{version: 0x04200000, class: "prtr", space: "GRAY", pcs: "Lab "}).
+		add("B2A0", typed("mBA ", []byte{3, 1, 0, 0}, u32s(32, 0, 0, 0, 0), paraTag(0, 1), paraTag(0, 1), paraTag(0, 1))).
+		bytes()
+	_, err = icc.Parse(data)
+	assert.Error(t, err)
+
+	// Missing TRC.
+	p, err := icc.Parse(valid)
+	require.NoError(t, err)
+	_, err = p.RGBSpace()
+	assert.Error(t, err)
+}
@@ -0,0 +1,142 @@
+// Copyright © 2019 Xavier Basty <xavier@hexbee.net>
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package icc
+
+import (
+	"fmt"
+)
+
+// perceptualBlack is the black point of the perceptual reference medium of
+// version 4 profiles.
+var perceptualBlack = XYZNumber{0.00336, 0.0034731, 0.00287}
+
+// NewLink returns the transform converting device values of the source profile
+// to device values of the destination profile, through the PCS.
+//
+// When bpc is set, the black point compensation maps the black point of the
+// source profile to the one of the destination profile, following the Adobe
+// algorithm. It does not apply to the absolute colorimetric intent.
+func NewLink(src, dst *Profile, intent RenderingIntent, bpc bool, interpolation string) (*Transform, error) {
+	in, err := src.DeviceToPCS(intent, SpaceXYZ, interpolation)
+	if err != nil {
+		return nil, fmt.Errorf("invalid source profile: %v", err)
+	}
+	out, err := dst.PCSToDevice(intent, SpaceXYZ, interpolation)
+	if err != nil {
+		return nil, fmt.Errorf("invalid destination profile: %v", err)
+	}
+
+	t := &Transform{in: in.in, out: out.out}
+	t.stages = append(t.stages, in.stages...)
+
+	if bpc && intent != AbsoluteColorimetric {
+		srcBlack, err := src.BlackPoint(intent)
+		if err != nil {
+			return nil, fmt.Errorf("invalid source profile: %v", err)
+		}
+		dstBlack, err := dst.BlackPoint(intent)
+		if err != nil {
+			return nil, fmt.Errorf("invalid destination profile: %v", err)
+		}
+		if srcBlack != dstBlack {
+			t.stages = append(t.stages, blackPointCompensation(srcBlack, dstBlack))
+		}
+	}
+
+	t.stages = append(t.stages, out.stages...)
+	return t, nil
+}
+
+// blackPointCompensation returns the stage scaling each XYZ coordinate
+// linearly so that the source black point maps to the destination one, while
+// the D50 white point is kept.
+func blackPointCompensation(src, dst XYZNumber) stage {
+	var scale, offset [3]float64
+	s, d, w := src.array(), dst.array(), d50.array()
+	for i := range scale {
+		scale[i] = (w[i] - d[i]) / (w[i] - s[i])
+		offset[i] = d[i] - scale[i]*s[i]
+	}
+
+	return func(v []float64) []float64 {
+		return []float64{
+			v[0]*scale[0] + offset[0],
+			v[1]*scale[1] + offset[1],
+			v[2]*scale[2] + offset[2],
+		}
+	}
+}
+
+// BlackPoint returns the black point of the profile for a rendering intent,
+// as relative colorimetric XYZ values.
+//
+// Version 4 profiles use the black point of the perceptual reference medium
+// for the perceptual and saturation intents. The black point of CMYK output
+// profiles for the relative colorimetric intent is the darkest color reached
+// by the perceptual tables, which takes the ink limit into account. In the
+// other cases, it is the color of the darkest device values, with its
+// chromaticity discarded.
+func (p *Profile) BlackPoint(intent RenderingIntent) (XYZNumber, error) {
+	if intent == AbsoluteColorimetric {
+		intent = RelativeColorimetric
+	}
+
+	_, lut := p.Tags[TagAToB0]
+	if p.Version.Major() >= 4 && (intent == Perceptual || intent == Saturation) && lut {
+		return perceptualBlack, nil
+	}
+
+	var device []float64
+	if intent == RelativeColorimetric && p.Class == ClassOutput && p.ColorSpace == SpaceCMYK {
+		toDevice, err := p.PCSToDevice(Perceptual, SpaceLab, Tetrahedral)
+		if err != nil {
+			return XYZNumber{}, err
+		}
+		if device, err = toDevice.Apply([]float64{0, 0, 0}); err != nil {
+			return XYZNumber{}, err
+		}
+	} else {
+		switch p.ColorSpace {
+		case SpaceRGB:
+			device = []float64{0, 0, 0}
+		case SpaceGray:
+			device = []float64{0}
+		case SpaceCMY:
+			device = []float64{1, 1, 1}
+		case SpaceCMYK:
+			device = []float64{1, 1, 1, 1}
+		default:
+			return XYZNumber{}, fmt.Errorf("unsupported color space for black point detection (%v)", p.ColorSpace)
+		}
+	}
+
+	toLab, err := p.DeviceToPCS(intent, SpaceLab, Tetrahedral)
+	if err != nil {
+		return XYZNumber{}, err
+	}
+	lab, err := toLab.Apply(device)
+	if err != nil {
+		return XYZNumber{}, err
+	}
+
+	// Blacks lighter than mid gray are not real black points.
+	l := lab[0]
+	if l > 50 {
+		l = 0
+	}
+	xyz := labToXYZ([]float64{l, 0, 0})
+
+	return XYZNumber{xyz[0], xyz[1], xyz[2]}, nil
+}
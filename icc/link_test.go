@@ -0,0 +1,107 @@
+// Copyright © 2019 Xavier Basty <xavier@hexbee.net>
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package icc_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/Hexbee-net/gocolor"
+	"github.com/Hexbee-net/gocolor/icc"
+)
+
+// grayishProfile returns an sRGB profile whose black is 5% of its white.
+func grayishProfile(t *testing.T) *icc.Profile {
+	p, err := icc.NewRGBProfile(gocolor.SRGB)
+	require.NoError(t, err)
+
+	srgb := p.Tags[icc.TagRedTRC].(gocolor.TransferCurve)
+	curve := make([]float64, 256)
+	for i := range curve {
+		curve[i] = 0.05 + 0.95*srgb.ToLinear(float64(i)/255)
+	}
+	for _, tag := range []icc.Signature{icc.TagRedTRC, icc.TagGreenTRC, icc.TagBlueTRC} {
+		p.Tags[tag] = gocolor.TransferCurve{Table: curve}
+	}
+	return p
+}
+
+func TestBlackPoint(t *testing.T) {
+	srgb, err := icc.NewRGBProfile(gocolor.SRGB)
+	require.NoError(t, err)
+	black, err := srgb.BlackPoint(icc.RelativeColorimetric)
+	require.NoError(t, err)
+	assert.InDelta(t, 0, black.Y, 1e-9)
+
+	black, err = grayishProfile(t).BlackPoint(icc.Perceptual)
+	require.NoError(t, err)
+	assert.InDelta(t, 0.05, black.Y, 1e-3)
+	assert.InDelta(t, 0.05*0.9642, black.X, 1e-3)
+
+	// Version 4 LUT based profiles use the perceptual reference medium black.
+	cmyk, _ := cmykProfile(t)
+	black, err = cmyk.BlackPoint(icc.Perceptual)
+	require.NoError(t, err)
+	assert.InDelta(t, 0.0034731, black.Y, 1e-7)
+
+	black, err = cmyk.BlackPoint(icc.RelativeColorimetric)
+	require.NoError(t, err)
+	assert.InDelta(t, 0, black.Y, 1e-3)
+}
+
+func TestNewLink(t *testing.T) {
+	grayish := grayishProfile(t)
+	srgb, err := icc.NewRGBProfile(gocolor.SRGB)
+	require.NoError(t, err)
+
+	plain, err := icc.NewLink(grayish, srgb, icc.RelativeColorimetric, false, icc.Tetrahedral)
+	require.NoError(t, err)
+	bpc, err := icc.NewLink(grayish, srgb, icc.RelativeColorimetric, true, icc.Tetrahedral)
+	require.NoError(t, err)
+
+	black, err := plain.Apply([]float64{0, 0, 0})
+	require.NoError(t, err)
+	assert.Greater(t, black[1], 0.2)
+
+	black, err = bpc.Apply([]float64{0, 0, 0})
+	require.NoError(t, err)
+	assert.InDeltaSlice(t, []float64{0, 0, 0}, black, 1e-3)
+
+	white, err := bpc.Apply([]float64{1, 1, 1})
+	require.NoError(t, err)
+	assert.InDeltaSlice(t, []float64{1, 1, 1}, white, 1e-3)
+
+	// The paper of the CMYK profile maps to the white of sRGB.
+	cmyk, _ := cmykProfile(t)
+	link, err := icc.NewLink(cmyk, srgb, icc.RelativeColorimetric, true, icc.Trilinear)
+	require.NoError(t, err)
+	assert.Equal(t, 4, link.Inputs())
+	assert.Equal(t, 3, link.Outputs())
+	paper, err := link.Apply([]float64{0, 0, 0, 0})
+	require.NoError(t, err)
+	assert.InDeltaSlice(t, []float64{1, 1, 1}, paper, 2e-3)
+
+	// Absolute colorimetric keeps the tint of the paper.
+	link, err = icc.NewLink(cmyk, srgb, icc.AbsoluteColorimetric, true, icc.Trilinear)
+	require.NoError(t, err)
+	paper, err = link.Apply([]float64{0, 0, 0, 0})
+	require.NoError(t, err)
+	assert.Less(t, paper[2], 0.98)
+
+	_, err = icc.NewLink(cmyk, srgb, icc.RelativeColorimetric, false, "unknown")
+	assert.Error(t, err)
+}
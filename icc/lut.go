@@ -0,0 +1,504 @@
+// Copyright © 2019 Xavier Basty <xavier@hexbee.net>
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package icc
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/Hexbee-net/gocolor"
+	"github.com/Hexbee-net/gocolor/internal/interp"
+)
+
+// maxChannels is the largest number of channels of a LUT.
+const maxChannels = 15
+
+// CLUT is a multi-dimensional color lookup table.
+//
+// Data holds the output values of each grid point, normalized to the [0, 1]
+// range. The grid points are ordered with the first input channel varying
+// the slowest, and the output channels of each grid point are contiguous.
+type CLUT struct {
+	GridPoints []int // Number of grid points along each input channel
+	Outputs    int   // Number of output channels
+	Data       []float64
+}
+
+// LUT is the content of a lut8 (mft1) or lut16 (mft2) tag.
+//
+// The data goes through the matrix (when the input is XYZ), the input curves,
+// the color lookup table, and the output curves.
+type LUT struct {
+	InputChannels  int
+	OutputChannels int
+	Precision      int // 8 or 16 bits
+	Matrix         [3][3]float64
+	InputCurves    []gocolor.TransferCurve
+	CLUT           CLUT
+	OutputCurves   []gocolor.TransferCurve
+}
+
+// LUTAtoB is the content of a lutAtoB (mAB) tag.
+//
+// The data goes through the A curves, the color lookup table, the M curves,
+// the matrix and the B curves. All the elements but the B curves are optional.
+type LUTAtoB struct {
+	InputChannels  int
+	OutputChannels int
+	A              []gocolor.TransferCurve
+	CLUT           *CLUT
+	M              []gocolor.TransferCurve
+	Matrix         *[12]float64 // 3×3 matrix, in row order, followed by the offsets
+	B              []gocolor.TransferCurve
+}
+
+// LUTBtoA is the content of a lutBtoA (mBA) tag.
+//
+// The data goes through the B curves, the matrix, the M curves, the color
+// lookup table and the A curves. All the elements but the B curves are optional.
+type LUTBtoA struct {
+	InputChannels  int
+	OutputChannels int
+	B              []gocolor.TransferCurve
+	Matrix         *[12]float64 // 3×3 matrix, in row order, followed by the offsets
+	M              []gocolor.TransferCurve
+	CLUT           *CLUT
+	A              []gocolor.TransferCurve
+}
+
+////////////////////////////////////////
+
+func checkChannels(in, out int) error {
+	if in < 1 || in > maxChannels {
+		return fmt.Errorf("number of input channels is out of the [1, %v] range (%v)", maxChannels, in)
+	}
+	if out < 1 || out > maxChannels {
+		return fmt.Errorf("number of output channels is out of the [1, %v] range (%v)", maxChannels, out)
+	}
+	return nil
+}
+
+// checkCLUT checks that a color lookup table matches the channels of its LUT,
+// and that its data matches its grid points.
+func checkCLUT(c *CLUT, in, out int) error {
+	if len(c.GridPoints) != in {
+		return fmt.Errorf("number of CLUT dimensions does not match the number of input channels (%v)", len(c.GridPoints))
+	}
+	if c.Outputs != out {
+		return fmt.Errorf("number of CLUT outputs does not match the number of output channels (%v)", c.Outputs)
+	}
+	if clutSize(c.GridPoints, out, len(c.Data)) != len(c.Data) {
+		return fmt.Errorf("CLUT data does not match the grid points (%v values)", len(c.Data))
+	}
+	return nil
+}
+
+// clutSize returns the number of values of a color lookup table, or -1 when
+// it is larger than limit.
+func clutSize(grid []int, out int, limit int) int {
+	n := out
+	for _, g := range grid {
+		n *= g
+		if n > limit || g < 1 {
+			return -1
+		}
+	}
+	return n
+}
+
+// decodeTables decodes n tables of count values from the data of a lut8
+// or lut16 tag.
+func decodeTables(b []byte, n, count, precision int) []gocolor.TransferCurve {
+	curves := make([]gocolor.TransferCurve, n)
+	for i := range curves {
+		curves[i].Table = decodeValues(b[i*count*precision/8:], count, precision)
+	}
+	return curves
+}
+
+// decodeValues decodes count values of 8 or 16 bits, normalized to [0, 1].
+func decodeValues(b []byte, count, precision int) []float64 {
+	v := make([]float64, count)
+	for i := range v {
+		if precision == 8 {
+			v[i] = float64(b[i]) / 0xff
+		} else {
+			v[i] = float64(u16(b[2*i:])) / 0xffff
+		}
+	}
+	return v
+}
+
+func decodeLut8(b []byte) (*LUT, error) {
+	return decodeLut(b, 8)
+}
+
+func decodeLut16(b []byte) (*LUT, error) {
+	return decodeLut(b, 16)
+}
+
+func decodeLut(b []byte, precision int) (*LUT, error) {
+	header := 48
+	if precision == 16 {
+		header = 52
+	}
+	if len(b) < header {
+		return nil, fmt.Errorf("LUT data is too short (%v bytes)", len(b))
+	}
+
+	l := &LUT{
+		InputChannels:  int(b[8]),
+		OutputChannels: int(b[9]),
+		Precision:      precision,
+	}
+	if err := checkChannels(l.InputChannels, l.OutputChannels); err != nil {
+		return nil, err
+	}
+
+	for i := 0; i < 9; i++ {
+		l.Matrix[i/3][i%3] = s15Fixed16(b[12+4*i:])
+	}
+
+	inEntries, outEntries := 256, 256
+	if precision == 16 {
+		inEntries, outEntries = int(u16(b[48:])), int(u16(b[50:]))
+		if inEntries < 2 || outEntries < 2 {
+			return nil, fmt.Errorf("LUT tables must have at least 2 entries (%v, %v)", inEntries, outEntries)
+		}
+	}
+
+	grid := make([]int, l.InputChannels)
+	for i := range grid {
+		grid[i] = int(b[10])
+	}
+
+	bytesPerValue := precision / 8
+	remaining := (len(b) - header) / bytesPerValue
+	inSize := l.InputChannels * inEntries
+	outSize := l.OutputChannels * outEntries
+	clutLen := clutSize(grid, l.OutputChannels, remaining)
+	if clutLen < 0 || inSize+clutLen+outSize > remaining {
+		return nil, fmt.Errorf("LUT tables are out of bounds (%v grid points)", b[10])
+	}
+
+	data := b[header:]
+	l.InputCurves = decodeTables(data, l.InputChannels, inEntries, precision)
+	data = data[inSize*bytesPerValue:]
+	l.CLUT = CLUT{GridPoints: grid, Outputs: l.OutputChannels, Data: decodeValues(data, clutLen, precision)}
+	data = data[clutLen*bytesPerValue:]
+	l.OutputCurves = decodeTables(data, l.OutputChannels, outEntries, precision)
+
+	return l, nil
+}
+
+// check checks that the curves and the color lookup table of a LUT match its
+// channels.
+func (l *LUT) check() error {
+	if err := checkChannels(l.InputChannels, l.OutputChannels); err != nil {
+		return err
+	}
+	if len(l.InputCurves) != l.InputChannels || len(l.OutputCurves) != l.OutputChannels {
+		return fmt.Errorf("number of LUT curves does not match the number of channels (%v, %v)", len(l.InputCurves), len(l.OutputCurves))
+	}
+	return checkCLUT(&l.CLUT, l.InputChannels, l.OutputChannels)
+}
+
+////////////////////////////////////////
+
+// lutElements holds the elements of a lutAtoB or lutBtoA tag.
+type lutElements struct {
+	in, out int
+	b       []gocolor.TransferCurve
+	matrix  *[12]float64
+	m       []gocolor.TransferCurve
+	clut    *CLUT
+	a       []gocolor.TransferCurve
+}
+
+// decodeLutElements decodes the elements of a lutAtoB tag when atob is set,
+// and of a lutBtoA tag otherwise.
+func decodeLutElements(b []byte, atob bool) (*lutElements, error) {
+	if len(b) < 32 {
+		return nil, fmt.Errorf("LUT data is too short (%v bytes)", len(b))
+	}
+
+	e := &lutElements{in: int(b[8]), out: int(b[9])}
+	if err := checkChannels(e.in, e.out); err != nil {
+		return nil, err
+	}
+
+	// The B and M curves are on the PCS side, the A curves on the device side.
+	bm, a := e.out, e.in
+	if !atob {
+		bm, a = e.in, e.out
+	}
+
+	at := func(field int) ([]byte, bool, error) {
+		offset := u32(b[field:])
+		if offset == 0 {
+			return nil, false, nil
+		}
+		if uint64(offset) >= uint64(len(b)) {
+			return nil, false, fmt.Errorf("LUT element is out of bounds (offset %v)", offset)
+		}
+		return b[offset:], true, nil
+	}
+
+	data, ok, err := at(12)
+	if err != nil {
+		return nil, err
+	}
+	if !ok {
+		return nil, errors.New("LUT is missing the B curves")
+	}
+	if e.b, err = decodeCurves(data, bm); err != nil {
+		return nil, err
+	}
+
+	if data, ok, err = at(16); err != nil {
+		return nil, err
+	} else if ok {
+		if len(data) < 48 {
+			return nil, errors.New("LUT matrix is out of bounds")
+		}
+		e.matrix = new([12]float64)
+		for i := range e.matrix {
+			e.matrix[i] = s15Fixed16(data[4*i:])
+		}
+	}
+
+	if data, ok, err = at(20); err != nil {
+		return nil, err
+	} else if ok {
+		if e.m, err = decodeCurves(data, bm); err != nil {
+			return nil, err
+		}
+	}
+
+	if data, ok, err = at(24); err != nil {
+		return nil, err
+	} else if ok {
+		if e.clut, err = decodeCLUT(data, e.in, e.out); err != nil {
+			return nil, err
+		}
+	}
+
+	if data, ok, err = at(28); err != nil {
+		return nil, err
+	} else if ok {
+		if e.a, err = decodeCurves(data, a); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := e.check(atob); err != nil {
+		return nil, err
+	}
+	return e, nil
+}
+
+// check checks that the elements of a lutAtoB tag, when atob is set, or of a
+// lutBtoA tag match its channels and form a complete pipeline.
+func (e *lutElements) check(atob bool) error {
+	if err := checkChannels(e.in, e.out); err != nil {
+		return err
+	}
+
+	bm, a := e.out, e.in
+	if !atob {
+		bm, a = e.in, e.out
+	}
+	if len(e.b) != bm || (e.m != nil && len(e.m) != bm) || (e.a != nil && len(e.a) != a) {
+		return fmt.Errorf("number of LUT curves does not match the number of channels (%v, %v, %v)", len(e.b), len(e.m), len(e.a))
+	}
+	if e.matrix != nil && bm != 3 {
+		return fmt.Errorf("LUT matrix needs 3 channels (%v)", bm)
+	}
+	if e.clut == nil {
+		if e.in != e.out {
+			return fmt.Errorf("LUT without CLUT has different numbers of input and output channels (%v, %v)", e.in, e.out)
+		}
+		return nil
+	}
+	return checkCLUT(e.clut, e.in, e.out)
+}
+
+func decodeCLUT(b []byte, in, out int) (*CLUT, error) {
+	if len(b) < 20 {
+		return nil, fmt.Errorf("CLUT data is too short (%v bytes)", len(b))
+	}
+
+	grid := make([]int, in)
+	for i := range grid {
+		grid[i] = int(b[i])
+	}
+
+	precision := int(b[16]) * 8
+	if precision != 8 && precision != 16 {
+		return nil, fmt.Errorf("CLUT precision is neither 1 nor 2 bytes (%v)", b[16])
+	}
+
+	remaining := (len(b) - 20) / (precision / 8)
+	n := clutSize(grid, out, remaining)
+	if n < 0 {
+		return nil, fmt.Errorf("CLUT data is out of bounds (grid points %v)", grid)
+	}
+
+	return &CLUT{GridPoints: grid, Outputs: out, Data: decodeValues(b[20:], n, precision)}, nil
+}
+
+func (l *LUTAtoB) elements() *lutElements {
+	return &lutElements{in: l.InputChannels, out: l.OutputChannels, b: l.B, matrix: l.Matrix, m: l.M, clut: l.CLUT, a: l.A}
+}
+
+func (l *LUTBtoA) elements() *lutElements {
+	return &lutElements{in: l.InputChannels, out: l.OutputChannels, b: l.B, matrix: l.Matrix, m: l.M, clut: l.CLUT, a: l.A}
+}
+
+func decodeLutAtoB(b []byte) (*LUTAtoB, error) {
+	e, err := decodeLutElements(b, true)
+	if err != nil {
+		return nil, err
+	}
+
+	return &LUTAtoB{
+		InputChannels:  e.in,
+		OutputChannels: e.out,
+		A:              e.a,
+		CLUT:           e.clut,
+		M:              e.m,
+		Matrix:         e.matrix,
+		B:              e.b,
+	}, nil
+}
+
+func decodeLutBtoA(b []byte) (*LUTBtoA, error) {
+	e, err := decodeLutElements(b, false)
+	if err != nil {
+		return nil, err
+	}
+
+	return &LUTBtoA{
+		InputChannels:  e.in,
+		OutputChannels: e.out,
+		B:              e.b,
+		Matrix:         e.matrix,
+		M:              e.m,
+		CLUT:           e.clut,
+		A:              e.a,
+	}, nil
+}
+
+////////////////////////////////////////
+
+// Interpolation methods of the color lookup tables.
+const (
+	Trilinear   = "trilinear"   // Multilinear interpolation between the corners of the grid cell
+	Tetrahedral = "tetrahedral" // Interpolation in the tetrahedron of the grid cell containing the input
+)
+
+// Eval returns the interpolated output values of the table for input values
+// in the [0, 1] range, one per grid dimension.
+//
+// Tetrahedral interpolation applies to the last three input channels; any
+// other input channel is interpolated linearly. Values are clamped to the
+// [0, 1] range.
+func (c *CLUT) Eval(in []float64, interpolation string) ([]float64, error) {
+	if len(in) != len(c.GridPoints) {
+		return nil, fmt.Errorf("expected %v input values, got %v", len(c.GridPoints), len(in))
+	}
+	if err := checkCLUT(c, len(c.GridPoints), c.Outputs); err != nil {
+		return nil, err
+	}
+	if interpolation != Trilinear && interpolation != Tetrahedral {
+		return nil, fmt.Errorf("unknown interpolation method: %v", interpolation)
+	}
+
+	return c.interpolate(in, interpolation == Tetrahedral), nil
+}
+
+// interpolate evaluates a table whose dimensions match the input values.
+func (c *CLUT) interpolate(in []float64, tetrahedral bool) []float64 {
+	strides := make([]int, len(c.GridPoints))
+	s := c.Outputs
+	for i := len(strides) - 1; i >= 0; i-- {
+		strides[i] = s
+		s *= c.GridPoints[i]
+	}
+
+	out := make([]float64, c.Outputs)
+	c.eval(in, 0, 0, strides, tetrahedral, out)
+	return out
+}
+
+func (c *CLUT) eval(in []float64, dim, offset int, strides []int, tetrahedral bool, out []float64) {
+	n := len(c.GridPoints)
+	if dim == n {
+		copy(out, c.Data[offset:offset+c.Outputs])
+		return
+	}
+	if n-dim == 3 {
+		c.cell(in[dim:], offset, strides[dim:], tetrahedral, out)
+		return
+	}
+
+	i, f := interp.GridCell(in[dim], c.GridPoints[dim])
+	c.eval(in, dim+1, offset+i*strides[dim], strides, tetrahedral, out)
+	if f == 0 {
+		return
+	}
+
+	hi := make([]float64, c.Outputs)
+	c.eval(in, dim+1, offset+(i+1)*strides[dim], strides, tetrahedral, hi)
+	for k := range out {
+		out[k] += f * (hi[k] - out[k])
+	}
+}
+
+// cell interpolates the last three input channels within their grid cell.
+func (c *CLUT) cell(in []float64, offset int, strides []int, tetrahedral bool, out []float64) {
+	dim := len(c.GridPoints) - 3
+
+	var step [3]int
+	var f [3]float64
+	for k := 0; k < 3; k++ {
+		i, r := interp.GridCell(in[k], c.GridPoints[dim+k])
+		offset += i * strides[k]
+		f[k] = r
+		if c.GridPoints[dim+k] > 1 {
+			step[k] = strides[k]
+		}
+	}
+
+	add := func(corner int, w float64) {
+		o := offset + (corner&1)*step[0] + (corner>>1&1)*step[1] + (corner>>2&1)*step[2]
+		for k := range out {
+			out[k] += w * c.Data[o+k]
+		}
+	}
+
+	if tetrahedral {
+		corners, weights := interp.Tetrahedral(f)
+		for i, w := range weights {
+			add(corners[i], w)
+		}
+		return
+	}
+	for corner, w := range interp.Trilinear(f) {
+		if w != 0 {
+			add(corner, w)
+		}
+	}
+}
@@ -0,0 +1,152 @@
+// Copyright © 2019 Xavier Basty <xavier@hexbee.net>
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package icc
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/Hexbee-net/gocolor"
+)
+
+type mat3 [3][3]float64
+
+func (a mat3) mul(b mat3) mat3 {
+	var m mat3
+	for i := 0; i < 3; i++ {
+		for j := 0; j < 3; j++ {
+			m[i][j] = a[i][0]*b[0][j] + a[i][1]*b[1][j] + a[i][2]*b[2][j]
+		}
+	}
+	return m
+}
+
+func (a mat3) apply(v [3]float64) [3]float64 {
+	return [3]float64{
+		a[0][0]*v[0] + a[0][1]*v[1] + a[0][2]*v[2],
+		a[1][0]*v[0] + a[1][1]*v[1] + a[1][2]*v[2],
+		a[2][0]*v[0] + a[2][1]*v[1] + a[2][2]*v[2],
+	}
+}
+
+func (a mat3) inverse() (mat3, error) {
+	d := a[0][0]*(a[1][1]*a[2][2]-a[1][2]*a[2][1]) -
+		a[0][1]*(a[1][0]*a[2][2]-a[1][2]*a[2][0]) +
+		a[0][2]*(a[1][0]*a[2][1]-a[1][1]*a[2][0])
+	if d == 0 {
+		return mat3{}, errors.New("matrix is not invertible")
+	}
+
+	return mat3{
+		{(a[1][1]*a[2][2] - a[1][2]*a[2][1]) / d, (a[0][2]*a[2][1] - a[0][1]*a[2][2]) / d, (a[0][1]*a[1][2] - a[0][2]*a[1][1]) / d},
+		{(a[1][2]*a[2][0] - a[1][0]*a[2][2]) / d, (a[0][0]*a[2][2] - a[0][2]*a[2][0]) / d, (a[0][2]*a[1][0] - a[0][0]*a[1][2]) / d},
+		{(a[1][0]*a[2][1] - a[1][1]*a[2][0]) / d, (a[0][1]*a[2][0] - a[0][0]*a[2][1]) / d, (a[0][0]*a[1][1] - a[0][1]*a[1][0]) / d},
+	}, nil
+}
+
+// bradford is the cone response matrix of the Bradford chromatic adaptation,
+// the one used by ICC profiles.
+var bradford = mat3{
+	{0.8951, 0.2664, -0.1614},
+	{-0.7502, 1.7135, 0.0367},
+	{0.0389, -0.0685, 1.0296},
+}
+
+// bradfordAdaptation returns the matrix adapting XYZ values from the source
+// white to the target white.
+func bradfordAdaptation(source, target [3]float64) mat3 {
+	inv, _ := bradford.inverse()
+	s, t := bradford.apply(source), bradford.apply(target)
+	scale := mat3{{t[0] / s[0], 0, 0}, {0, t[1] / s[1], 0}, {0, 0, t[2] / s[2]}}
+	return inv.mul(scale).mul(bradford)
+}
+
+func (n XYZNumber) array() [3]float64 {
+	return [3]float64{n.X, n.Y, n.Z}
+}
+
+////////////////////////////////////////
+
+// RGBSpace returns the RGB color space described by a matrix/TRC profile.
+//
+// The colorants of the profile are relative to the PCS illuminant; they are
+// adapted back to the actual white point of the device using the chromatic
+// adaptation tag, or using the Bradford transform to the media white point
+// for version 2 profiles without one. The resulting space converts to XYZ
+// values relative to its own white point, as the built-in RGB spaces do.
+func (p *Profile) RGBSpace() (gocolor.RGBSpaceDefinition, error) {
+	var def gocolor.RGBSpaceDefinition
+
+	if p.ColorSpace != SpaceRGB {
+		return def, fmt.Errorf("profile color space is not RGB (%v)", p.ColorSpace)
+	}
+	if p.PCS != SpaceXYZ && p.PCS != SpaceLab {
+		return def, fmt.Errorf("unsupported profile connection space (%v)", p.PCS)
+	}
+
+	var colorants mat3
+	for c, tag := range []Signature{TagRedColorant, TagGreenColorant, TagBlueColorant} {
+		xyz, ok := p.XYZ(tag)
+		if !ok {
+			return def, fmt.Errorf("profile is missing the %v tag", tag)
+		}
+		colorants[0][c], colorants[1][c], colorants[2][c] = xyz.X, xyz.Y, xyz.Z
+	}
+
+	for c, tag := range []Signature{TagRedTRC, TagGreenTRC, TagBlueTRC} {
+		trc, ok := p.Tags[tag].(gocolor.TransferCurve)
+		if !ok {
+			return def, fmt.Errorf("profile is missing the %v tag", tag)
+		}
+		def.Transfer[c] = trc
+	}
+
+	pcsWhite := p.Illuminant.array()
+	if pcsWhite[1] == 0 {
+		pcsWhite = [3]float64{0.9642, 1, 0.8249}
+	}
+
+	// Adaptation from the device white to the PCS white.
+	var chad mat3
+	if m, ok := p.ChromaticAdaptation(); ok {
+		chad = m
+	} else if wtpt, ok := p.XYZ(TagMediaWhitePoint); ok && p.Version.Major() < 4 && wtpt.Y > 0 {
+		chad = bradfordAdaptation(wtpt.array(), pcsWhite)
+	} else {
+		chad = mat3{{1, 0, 0}, {0, 1, 0}, {0, 0, 1}}
+	}
+
+	inv, err := chad.inverse()
+	if err != nil {
+		return def, fmt.Errorf("invalid chromatic adaptation: %v", err)
+	}
+
+	def.ToXYZ = inv.mul(colorants)
+	white := inv.apply(pcsWhite)
+	def.White = [3]float64{white[0] / white[1], 1, white[2] / white[1]}
+
+	return def, nil
+}
+
+// Register registers the RGB color space of a matrix/TRC profile under the
+// given name, making it available to the gocolor conversion functions.
+func (p *Profile) Register(name string) error {
+	def, err := p.RGBSpace()
+	if err != nil {
+		return err
+	}
+
+	return gocolor.RegisterRGBSpace(name, def)
+}
@@ -0,0 +1,230 @@
+// Copyright © 2019 Xavier Basty <xavier@hexbee.net>
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package icc
+
+import (
+	"bytes"
+	"encoding/binary"
+	"fmt"
+	"unicode/utf16"
+
+	"github.com/Hexbee-net/gocolor"
+)
+
+// LocalizedString is one of the translations of a multi-localized text tag.
+type LocalizedString struct {
+	Language string // ISO 639-1 language code
+	Country  string // ISO 3166-1 country code
+	Text     string
+}
+
+func decodeTag(b []byte) (interface{}, error) {
+	if len(b) < 8 {
+		return nil, fmt.Errorf("tag data is too short (%v bytes)", len(b))
+	}
+
+	switch t := Signature(binary.BigEndian.Uint32(b)); t {
+	case typeXYZ:
+		return decodeXYZ(b), nil
+	case typeCurve, typeParametricCurve:
+		c, _, err := decodeCurve(b)
+		return c, err
+	case typeS15Fixed16Array:
+		return decodeS15Fixed16Array(b), nil
+	case typeText:
+		return string(bytes.TrimRight(b[8:], "\x00")), nil
+	case typeTextDescription:
+		return decodeTextDescription(b)
+	case typeMultiLocalizedUnicode:
+		return decodeMultiLocalizedUnicode(b)
+	case typeLut8:
+		return decodeLut8(b)
+	case typeLut16:
+		return decodeLut16(b)
+	case typeLutAtoB:
+		return decodeLutAtoB(b)
+	case typeLutBtoA:
+		return decodeLutBtoA(b)
+	default:
+		return RawTag{Type: t, Data: append([]byte(nil), b...)}, nil
+	}
+}
+
+////////////////////////////////////////
+
+func u16(b []byte) uint16 {
+	return binary.BigEndian.Uint16(b)
+}
+
+func u32(b []byte) uint32 {
+	return binary.BigEndian.Uint32(b)
+}
+
+func s15Fixed16(b []byte) float64 {
+	return float64(int32(binary.BigEndian.Uint32(b))) / 0x10000
+}
+
+func readXYZNumber(b []byte) XYZNumber {
+	return XYZNumber{s15Fixed16(b), s15Fixed16(b[4:]), s15Fixed16(b[8:])}
+}
+
+func decodeXYZ(b []byte) []XYZNumber {
+	v := make([]XYZNumber, (len(b)-8)/12)
+	for i := range v {
+		v[i] = readXYZNumber(b[8+12*i:])
+	}
+	return v
+}
+
+func decodeS15Fixed16Array(b []byte) []float64 {
+	v := make([]float64, (len(b)-8)/4)
+	for i := range v {
+		v[i] = s15Fixed16(b[8+4*i:])
+	}
+	return v
+}
+
+// parametricParams is the number of parameters of each parametric curve type.
+var parametricParams = []int{1, 3, 4, 5, 7}
+
+// decodeCurve decodes a curv or para element, and returns its size in bytes.
+func decodeCurve(b []byte) (gocolor.TransferCurve, int, error) {
+	var c gocolor.TransferCurve
+	if len(b) < 12 {
+		return c, 0, fmt.Errorf("curve data is too short (%v bytes)", len(b))
+	}
+
+	switch t := Signature(u32(b)); t {
+	case typeCurve:
+		n := int(u32(b[8:]))
+		size := 12 + 2*n
+		if n < 0 || len(b) < size {
+			return c, 0, fmt.Errorf("curve entries are out of bounds (%v)", n)
+		}
+
+		switch n {
+		case 0:
+			return gocolor.GammaCurve(1), size, nil
+		case 1:
+			return gocolor.GammaCurve(float64(u16(b[12:])) / 0x100), size, nil
+		}
+
+		c.Table = make([]float64, n)
+		for i := range c.Table {
+			c.Table[i] = float64(u16(b[12+2*i:])) / 0xffff
+		}
+		return c, size, nil
+
+	case typeParametricCurve:
+		fn := int(u16(b[8:]))
+		if fn >= len(parametricParams) {
+			return c, 0, fmt.Errorf("unknown parametric curve function type (%v)", fn)
+		}
+		size := 12 + 4*parametricParams[fn]
+		if len(b) < size {
+			return c, 0, fmt.Errorf("parametric curve data is too short (%v bytes)", len(b))
+		}
+
+		p := make([]float64, 7)
+		for i := 0; i < parametricParams[fn]; i++ {
+			p[i] = s15Fixed16(b[12+4*i:])
+		}
+
+		c.Gamma = p[0]
+		switch fn {
+		case 0:
+			c.A = 1
+		case 1, 2:
+			// Y = (aX + b)^g [+ c] for X >= -b/a, and 0 [or c] otherwise.
+			c.A, c.B = p[1], p[2]
+			if c.A != 0 {
+				c.D = -c.B / c.A
+			}
+			c.E, c.F = p[3], p[3]
+		case 3, 4:
+			c.A, c.B, c.C, c.D, c.E, c.F = p[1], p[2], p[3], p[4], p[5], p[6]
+		}
+		return c, size, nil
+
+	default:
+		return c, 0, fmt.Errorf("unexpected curve type (%v)", t)
+	}
+}
+
+// decodeCurves decodes a sequence of n curves, each aligned on 4 bytes.
+func decodeCurves(b []byte, n int) ([]gocolor.TransferCurve, error) {
+	curves := make([]gocolor.TransferCurve, n)
+	for i := range curves {
+		c, size, err := decodeCurve(b)
+		if err != nil {
+			return nil, err
+		}
+		curves[i] = c
+
+		size = (size + 3) &^ 3
+		if size > len(b) {
+			size = len(b)
+		}
+		b = b[size:]
+	}
+	return curves, nil
+}
+
+func decodeTextDescription(b []byte) (string, error) {
+	if len(b) < 12 {
+		return "", fmt.Errorf("description data is too short (%v bytes)", len(b))
+	}
+
+	n := u32(b[8:])
+	if uint64(n) > uint64(len(b)-12) {
+		return "", fmt.Errorf("description length is out of bounds (%v)", n)
+	}
+
+	return string(bytes.TrimRight(b[12:12+n], "\x00")), nil
+}
+
+func decodeMultiLocalizedUnicode(b []byte) ([]LocalizedString, error) {
+	if len(b) < 16 {
+		return nil, fmt.Errorf("localized text data is too short (%v bytes)", len(b))
+	}
+
+	n, recordSize := uint64(u32(b[8:])), uint64(u32(b[12:]))
+	if recordSize < 12 || 16+n*recordSize > uint64(len(b)) {
+		return nil, fmt.Errorf("localized text records are out of bounds (%v × %v bytes)", n, recordSize)
+	}
+
+	strs := make([]LocalizedString, n)
+	for i := range strs {
+		r := b[16+uint64(i)*recordSize:]
+		length, offset := uint64(u32(r[4:])), uint64(u32(r[8:]))
+		if offset+length > uint64(len(b)) {
+			return nil, fmt.Errorf("localized text is out of bounds (offset %v, length %v)", offset, length)
+		}
+
+		text := b[offset : offset+length]
+		units := make([]uint16, len(text)/2)
+		for j := range units {
+			units[j] = u16(text[2*j:])
+		}
+
+		strs[i] = LocalizedString{
+			Language: string(r[0:2]),
+			Country:  string(r[2:4]),
+			Text:     string(utf16.Decode(units)),
+		}
+	}
+
+	return strs, nil
+}
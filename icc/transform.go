@@ -0,0 +1,427 @@
+// Copyright © 2019 Xavier Basty <xavier@hexbee.net>
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package icc
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/Hexbee-net/gocolor"
+	"github.com/Hexbee-net/gocolor/internal/colormath"
+	"github.com/Hexbee-net/gocolor/internal/interp"
+)
+
+// Transform converts colors between the device space of a profile and the
+// profile connection space.
+//
+// Device values are in the [0, 1] range. PCS values are either XYZ coordinates,
+// with Y = 1 for the perfect diffuser, or L*a*b* coordinates, with L* in the
+// [0, 100] range; both are relative to the D50 illuminant.
+type Transform struct {
+	stages  []stage
+	in, out int
+}
+
+type stage func(v []float64) []float64
+
+// Apply converts a color.
+func (t *Transform) Apply(in []float64) ([]float64, error) {
+	if len(in) != t.in {
+		return nil, fmt.Errorf("expected %v input values, got %v", t.in, len(in))
+	}
+
+	v := append([]float64(nil), in...)
+	for _, s := range t.stages {
+		v = s(v)
+	}
+	return v, nil
+}
+
+// Inputs returns the number of input values of the transform.
+func (t *Transform) Inputs() int {
+	return t.in
+}
+
+// Outputs returns the number of output values of the transform.
+func (t *Transform) Outputs() int {
+	return t.out
+}
+
+////////////////////////////////////////
+
+// DeviceToPCS returns the transform converting device values to PCS values
+// expressed in the pcs color space, SpaceXYZ or SpaceLab.
+//
+// The AToB tag of the rendering intent is used, falling back to the AToB0 tag,
+// and to the matrix/TRC or gray TRC model when the profile has no AToB tag.
+// The absolute colorimetric intent uses the relative colorimetric data scaled
+// by the media white point. The interpolation is Trilinear or Tetrahedral.
+func (p *Profile) DeviceToPCS(intent RenderingIntent, pcs Signature, interpolation string) (*Transform, error) {
+	if err := checkTransformArgs(intent, pcs, interpolation); err != nil {
+		return nil, err
+	}
+
+	t := &Transform{}
+	relative := intent
+	if intent == AbsoluteColorimetric {
+		relative = RelativeColorimetric
+	}
+
+	lut := p.intentTag([]Signature{TagAToB0, TagAToB1, TagAToB2}, relative)
+	if err := checkLUTTag(lut, true); err != nil {
+		return nil, err
+	}
+
+	switch lut := lut.(type) {
+	case *LUT:
+		t.in = lut.InputChannels
+		t.stages = append(t.stages,
+			curvesStage(lut.InputCurves),
+			clutStage(&lut.CLUT, interpolation),
+			curvesStage(lut.OutputCurves),
+			p.decodePCS(lut.Precision == 16))
+
+	case *LUTAtoB:
+		t.in = lut.InputChannels
+		t.stages = append(t.stages, curvesStage(lut.A))
+		if lut.CLUT != nil {
+			t.stages = append(t.stages, clutStage(lut.CLUT, interpolation))
+		}
+		t.stages = append(t.stages, curvesStage(lut.M), matrixStage(lut.Matrix), curvesStage(lut.B), p.decodePCS(false))
+
+	default:
+		s, n, err := p.shaperToXYZ()
+		if err != nil {
+			return nil, err
+		}
+		t.in = n
+		t.stages = append(t.stages, s)
+	}
+
+	if intent == AbsoluteColorimetric {
+		t.stages = append(t.stages, p.mediaWhiteScaling(false))
+	}
+	if pcs == SpaceLab {
+		t.stages = append(t.stages, xyzToLab)
+	}
+	t.out = 3
+
+	return t, nil
+}
+
+// PCSToDevice returns the transform converting PCS values expressed in the
+// pcs color space, SpaceXYZ or SpaceLab, to device values.
+//
+// The BToA tag of the rendering intent is used, falling back to the BToA0 tag,
+// and to the inverse of the matrix/TRC or gray TRC model when the profile has
+// no BToA tag. The interpolation is Trilinear or Tetrahedral.
+func (p *Profile) PCSToDevice(intent RenderingIntent, pcs Signature, interpolation string) (*Transform, error) {
+	if err := checkTransformArgs(intent, pcs, interpolation); err != nil {
+		return nil, err
+	}
+
+	t := &Transform{in: 3}
+	if pcs == SpaceLab {
+		t.stages = append(t.stages, labToXYZ)
+	}
+
+	relative := intent
+	if intent == AbsoluteColorimetric {
+		relative = RelativeColorimetric
+		t.stages = append(t.stages, p.mediaWhiteScaling(true))
+	}
+
+	lut := p.intentTag([]Signature{TagBToA0, TagBToA1, TagBToA2}, relative)
+	if err := checkLUTTag(lut, false); err != nil {
+		return nil, err
+	}
+
+	switch lut := lut.(type) {
+	case *LUT:
+		t.out = lut.OutputChannels
+		t.stages = append(t.stages, p.encodePCS(lut.Precision == 16))
+		if p.PCS == SpaceXYZ {
+			t.stages = append(t.stages, matrixStage(&[12]float64{
+				lut.Matrix[0][0], lut.Matrix[0][1], lut.Matrix[0][2],
+				lut.Matrix[1][0], lut.Matrix[1][1], lut.Matrix[1][2],
+				lut.Matrix[2][0], lut.Matrix[2][1], lut.Matrix[2][2],
+			}))
+		}
+		t.stages = append(t.stages,
+			curvesStage(lut.InputCurves),
+			clutStage(&lut.CLUT, interpolation),
+			curvesStage(lut.OutputCurves))
+
+	case *LUTBtoA:
+		t.out = lut.OutputChannels
+		t.stages = append(t.stages, p.encodePCS(false), curvesStage(lut.B), matrixStage(lut.Matrix), curvesStage(lut.M))
+		if lut.CLUT != nil {
+			t.stages = append(t.stages, clutStage(lut.CLUT, interpolation))
+		}
+		t.stages = append(t.stages, curvesStage(lut.A))
+
+	default:
+		s, n, err := p.shaperFromXYZ()
+		if err != nil {
+			return nil, err
+		}
+		t.out = n
+		t.stages = append(t.stages, s)
+	}
+
+	return t, nil
+}
+
+func checkTransformArgs(intent RenderingIntent, pcs Signature, interpolation string) error {
+	if intent > AbsoluteColorimetric {
+		return fmt.Errorf("unknown rendering intent (%v)", intent)
+	}
+	if pcs != SpaceXYZ && pcs != SpaceLab {
+		return fmt.Errorf("unsupported profile connection space (%v)", pcs)
+	}
+	if interpolation != Trilinear && interpolation != Tetrahedral {
+		return fmt.Errorf("unknown interpolation method: %v", interpolation)
+	}
+	return nil
+}
+
+// checkLUTTag checks that the elements of an AToB tag, when atob is set, or
+// of a BToA tag match each other, and that the tag has 3 PCS channels. Other
+// values are left to the matrix/TRC model.
+func checkLUTTag(v interface{}, atob bool) error {
+	var in, out int
+	switch lut := v.(type) {
+	case *LUT:
+		if err := lut.check(); err != nil {
+			return err
+		}
+		in, out = lut.InputChannels, lut.OutputChannels
+	case *LUTAtoB:
+		if err := lut.elements().check(true); err != nil {
+			return err
+		}
+		in, out = lut.InputChannels, lut.OutputChannels
+	case *LUTBtoA:
+		if err := lut.elements().check(false); err != nil {
+			return err
+		}
+		in, out = lut.InputChannels, lut.OutputChannels
+	default:
+		return nil
+	}
+
+	pcs := out
+	if !atob {
+		pcs = in
+	}
+	if pcs != 3 {
+		return fmt.Errorf("number of PCS channels of the LUT is not 3 (%v)", pcs)
+	}
+	return nil
+}
+
+// intentTag returns the LUT tag of the rendering intent, falling back to the
+// tag of the perceptual intent.
+func (p *Profile) intentTag(tags []Signature, intent RenderingIntent) interface{} {
+	if v, ok := p.Tags[tags[intent]]; ok {
+		return v
+	}
+	return p.Tags[tags[0]]
+}
+
+// mediaWhiteScaling converts relative colorimetric XYZ values to absolute
+// ones using the media white point, or back when inverse is set.
+func (p *Profile) mediaWhiteScaling(inverse bool) stage {
+	wtpt, ok := p.XYZ(TagMediaWhitePoint)
+	if !ok || wtpt.Y == 0 {
+		wtpt = d50
+	}
+
+	scale := [3]float64{wtpt.X / d50.X, wtpt.Y / d50.Y, wtpt.Z / d50.Z}
+	if inverse {
+		scale = [3]float64{1 / scale[0], 1 / scale[1], 1 / scale[2]}
+	}
+
+	return func(v []float64) []float64 {
+		return []float64{v[0] * scale[0], v[1] * scale[1], v[2] * scale[2]}
+	}
+}
+
+////////////////////////////////////////
+
+// curvesStage applies a curve to each channel. Absent curves leave the values
+// unchanged; the number of curves is checked by checkLUTTag otherwise.
+func curvesStage(curves []gocolor.TransferCurve) stage {
+	return func(v []float64) []float64 {
+		if curves == nil {
+			return v
+		}
+		for i, c := range curves {
+			v[i] = c.ToLinear(interp.Clamp01(v[i]))
+		}
+		return v
+	}
+}
+
+func clutStage(c *CLUT, interpolation string) stage {
+	return func(v []float64) []float64 {
+		return c.interpolate(v, interpolation == Tetrahedral)
+	}
+}
+
+func matrixStage(m *[12]float64) stage {
+	return func(v []float64) []float64 {
+		if m == nil {
+			return v
+		}
+		return []float64{
+			m[0]*v[0] + m[1]*v[1] + m[2]*v[2] + m[9],
+			m[3]*v[0] + m[4]*v[1] + m[5]*v[2] + m[10],
+			m[6]*v[0] + m[7]*v[1] + m[8]*v[2] + m[11],
+		}
+	}
+}
+
+// shaperToXYZ returns the stage converting device values to XYZ values with
+// the matrix/TRC or gray TRC model of the profile, and the number of inputs.
+func (p *Profile) shaperToXYZ() (stage, int, error) {
+	if trc, ok := p.Tags[TagGrayTRC].(gocolor.TransferCurve); ok {
+		return func(v []float64) []float64 {
+			y := trc.ToLinear(interp.Clamp01(v[0]))
+			return []float64{d50.X * y, d50.Y * y, d50.Z * y}
+		}, 1, nil
+	}
+
+	m, trc, err := p.shaper()
+	if err != nil {
+		return nil, 0, err
+	}
+
+	return func(v []float64) []float64 {
+		l := m.apply([3]float64{trc[0].ToLinear(interp.Clamp01(v[0])), trc[1].ToLinear(interp.Clamp01(v[1])), trc[2].ToLinear(interp.Clamp01(v[2]))})
+		return l[:]
+	}, 3, nil
+}
+
+// shaperFromXYZ returns the stage converting XYZ values to device values with
+// the matrix/TRC or gray TRC model of the profile, and the number of outputs.
+func (p *Profile) shaperFromXYZ() (stage, int, error) {
+	if trc, ok := p.Tags[TagGrayTRC].(gocolor.TransferCurve); ok {
+		return func(v []float64) []float64 {
+			return []float64{interp.Clamp01(trc.FromLinear(v[1]))}
+		}, 1, nil
+	}
+
+	m, trc, err := p.shaper()
+	if err != nil {
+		return nil, 0, err
+	}
+	inv, err := m.inverse()
+	if err != nil {
+		return nil, 0, fmt.Errorf("invalid colorants: %v", err)
+	}
+
+	return func(v []float64) []float64 {
+		l := inv.apply([3]float64{v[0], v[1], v[2]})
+		return []float64{
+			interp.Clamp01(trc[0].FromLinear(interp.Clamp01(l[0]))),
+			interp.Clamp01(trc[1].FromLinear(interp.Clamp01(l[1]))),
+			interp.Clamp01(trc[2].FromLinear(interp.Clamp01(l[2]))),
+		}
+	}, 3, nil
+}
+
+// shaper returns the D50 colorants matrix and the TRCs of a matrix/TRC profile.
+func (p *Profile) shaper() (mat3, [3]gocolor.TransferCurve, error) {
+	var m mat3
+	var trc [3]gocolor.TransferCurve
+
+	for c, tag := range []Signature{TagRedColorant, TagGreenColorant, TagBlueColorant} {
+		xyz, ok := p.XYZ(tag)
+		if !ok {
+			return m, trc, errors.New("profile has neither LUT nor matrix/TRC tags")
+		}
+		m[0][c], m[1][c], m[2][c] = xyz.X, xyz.Y, xyz.Z
+	}
+	for c, tag := range []Signature{TagRedTRC, TagGreenTRC, TagBlueTRC} {
+		t, ok := p.Tags[tag].(gocolor.TransferCurve)
+		if !ok {
+			return m, trc, fmt.Errorf("profile is missing the %v tag", tag)
+		}
+		trc[c] = t
+	}
+
+	return m, trc, nil
+}
+
+////////////////////////////////////////
+
+// Largest XYZ value of the PCS encoding, and scale of the legacy 16 bits
+// L*a*b* encoding of version 2 profiles and lut16 tags.
+const (
+	pcsXYZMax    = 1 + 32767.0/32768
+	pcsLabLegacy = 0xffff / float64(0xff00)
+)
+
+// decodePCS returns the stage converting normalized PCS values to XYZ values.
+func (p *Profile) decodePCS(legacy bool) stage {
+	if p.PCS == SpaceXYZ {
+		return func(v []float64) []float64 {
+			return []float64{v[0] * pcsXYZMax, v[1] * pcsXYZMax, v[2] * pcsXYZMax}
+		}
+	}
+
+	scale := 1.0
+	if legacy {
+		scale = pcsLabLegacy
+	}
+	return func(v []float64) []float64 {
+		return labToXYZ([]float64{v[0] * scale * 100, v[1]*scale*255 - 128, v[2]*scale*255 - 128})
+	}
+}
+
+// encodePCS returns the stage converting XYZ values to normalized PCS values.
+func (p *Profile) encodePCS(legacy bool) stage {
+	if p.PCS == SpaceXYZ {
+		return func(v []float64) []float64 {
+			return []float64{interp.Clamp01(v[0] / pcsXYZMax), interp.Clamp01(v[1] / pcsXYZMax), interp.Clamp01(v[2] / pcsXYZMax)}
+		}
+	}
+
+	scale := 1.0
+	if legacy {
+		scale = pcsLabLegacy
+	}
+	return func(v []float64) []float64 {
+		lab := xyzToLab(v)
+		return []float64{
+			interp.Clamp01(lab[0] / 100 / scale),
+			interp.Clamp01((lab[1] + 128) / 255 / scale),
+			interp.Clamp01((lab[2] + 128) / 255 / scale),
+		}
+	}
+}
+
+// xyzToLab converts D50 XYZ values to L*a*b*.
+func xyzToLab(v []float64) []float64 {
+	lab := colormath.XYZToLab([3]float64{v[0], v[1], v[2]}, d50.array())
+	return lab[:]
+}
+
+// labToXYZ converts L*a*b* values to D50 XYZ.
+func labToXYZ(v []float64) []float64 {
+	xyz := colormath.LabToXYZ([3]float64{v[0], v[1], v[2]}, d50.array())
+	return xyz[:]
+}
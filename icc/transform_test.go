@@ -0,0 +1,340 @@
+// Copyright © 2019 Xavier Basty <xavier@hexbee.net>
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package icc_test
+
+import (
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/Hexbee-net/gocolor"
+	"github.com/Hexbee-net/gocolor/icc"
+)
+
+// clutBytes samples a function over a grid and encodes it as a 16 bits CLUT
+// element of a lutAtoB or lutBtoA tag.
+func clutBytes(grid, in int, f func(v []float64) []float64) []byte {
+	b := make([]byte, 20)
+	for i := 0; i < in; i++ {
+		b[i] = byte(grid)
+	}
+	b[16] = 2
+
+	n := 1
+	for i := 0; i < in; i++ {
+		n *= grid
+	}
+
+	v := make([]float64, in)
+	for i := 0; i < n; i++ {
+		for d, idx := in-1, i; d >= 0; d, idx = d-1, idx/grid {
+			v[d] = float64(idx%grid) / float64(grid-1)
+		}
+		for _, o := range f(v) {
+			b = append(b, u16s(uint16(math.Round(math.Min(math.Max(o, 0), 1)*0xffff)))...)
+		}
+	}
+	return b
+}
+
+// lutTag encodes a lutAtoB or lutBtoA tag made of identity curves and a CLUT.
+func lutTag(sig string, in, out int, clut []byte) []byte {
+	identity := typed("curv", u32s(0))
+	pcsSide, deviceSide := out, in
+	if sig == "mBA " {
+		pcsSide, deviceSide = in, out
+	}
+
+	clutOffset := 32 + 12*pcsSide
+	aOffset := clutOffset + (len(clut)+3)&^3
+
+	b := typed(sig, []byte{byte(in), byte(out), 0, 0}, u32s(32, 0, 0, uint32(clutOffset), uint32(aOffset)))
+	for i := 0; i < pcsSide; i++ {
+		b = append(b, identity...)
+	}
+	b = append(b, clut...)
+	for len(b)%4 != 0 {
+		b = append(b, 0)
+	}
+	for i := 0; i < deviceSide; i++ {
+		b = append(b, identity...)
+	}
+	return b
+}
+
+// pressModel is a naive CMYK press, printing on paper as white as sRGB white.
+type pressModel struct {
+	toLab, toRGB *gocolor.Transform
+}
+
+func newPressModel(t *testing.T) pressModel {
+	toLab, err := gocolor.NewTransform(gocolor.SRGB, gocolor.CIELab, gocolor.Observer2, gocolor.RefIlluminantD50, gocolor.ChromaBradford)
+	require.NoError(t, err)
+	toRGB, err := gocolor.NewTransform(gocolor.CIELab, gocolor.SRGB, gocolor.Observer2, gocolor.RefIlluminantD50, gocolor.ChromaBradford)
+	require.NoError(t, err)
+	return pressModel{toLab, toRGB}
+}
+
+func (m pressModel) lab(cmyk []float64) []float64 {
+	k := 1 - cmyk[3]
+	l, a, b := m.toLab.Apply((1-cmyk[0])*k, (1-cmyk[1])*k, (1-cmyk[2])*k)
+	return []float64{l, a, b}
+}
+
+func (m pressModel) cmyk(lab []float64) []float64 {
+	r, g, b := m.toRGB.Apply(lab[0], lab[1], lab[2])
+	c, mm, y := 1-clamp(r), 1-clamp(g), 1-clamp(b)
+	k := math.Min(c, math.Min(mm, y))
+	if k == 1 {
+		return []float64{0, 0, 0, 1}
+	}
+	return []float64{(c - k) / (1 - k), (mm - k) / (1 - k), (y - k) / (1 - k), k}
+}
+
+func clamp(v float64) float64 {
+	return math.Min(math.Max(v, 0), 1)
+}
+
+func encodeLab(lab []float64) []float64 {
+	return []float64{lab[0] / 100, (lab[1] + 128) / 255, (lab[2] + 128) / 255}
+}
+
+func decodeLab(v []float64) []float64 {
+	return []float64{v[0] * 100, v[1]*255 - 128, v[2]*255 - 128}
+}
+
+func deltaE(a, b []float64) float64 {
+	return math.Sqrt((a[0]-b[0])*(a[0]-b[0]) + (a[1]-b[1])*(a[1]-b[1]) + (a[2]-b[2])*(a[2]-b[2]))
+}
+
+func cmykProfile(t *testing.T) (*icc.Profile, pressModel) {
+	m := newPressModel(t)
+
+	colorimetric := clutBytes(11, 4, func(v []float64) []float64 { return encodeLab(m.lab(v)) })
+	perceptual := clutBytes(11, 4, func(v []float64) []float64 {
+		lab := m.lab(v)
+		return encodeLab([]float64{10 + 0.9*lab[0], lab[1], lab[2]})
+	})
+	inverse := clutBytes(17, 3, func(v []float64) []float64 { return m.cmyk(decodeLab(v)) })
+
+	data := (&profileBuilder{version: 0x04300000, class: "prtr", space: "CMYK", pcs: "Lab "}).
+		add("A2B0", lutTag("mAB ", 4, 3, perceptual)).
+		add("A2B1", lutTag("mAB ", 4, 3, colorimetric)).
+		add("B2A0", lutTag("mBA ", 3, 4, inverse)).
+		add("B2A1", lutTag("mBA ", 3, 4, inverse)).
+		add("wtpt", xyzTag(0.9, 0.93, 0.75)).
+		bytes()
+
+	p, err := icc.Parse(data)
+	require.NoError(t, err)
+	return p, m
+}
+
+////////////////////////////////////////
+
+func TestCLUTEval(t *testing.T) {
+	linear := func(v []float64) []float64 { return []float64{0.2*v[0] + 0.3*v[1] + 0.5*v[2]} }
+	product := func(v []float64) []float64 { return []float64{v[0] * v[1] * v[2]} }
+
+	eval := func(c *icc.CLUT, v []float64, interpolation string) []float64 {
+		out, err := c.Eval(v, interpolation)
+		require.NoError(t, err)
+		return out
+	}
+
+	grid := func(f func(v []float64) []float64) *icc.CLUT {
+		c := &icc.CLUT{GridPoints: []int{2, 2, 2}, Outputs: 1}
+		for i := 0; i < 8; i++ {
+			c.Data = append(c.Data, f([]float64{float64(i >> 2 & 1), float64(i >> 1 & 1), float64(i & 1)})...)
+		}
+		return c
+	}
+
+	for _, v := range [][]float64{{0.1, 0.5, 0.9}, {0.9, 0.5, 0.1}, {0.5, 0.9, 0.1}, {0.3, 0.3, 0.3}, {1, 0, 0.7}} {
+		// Both methods are exact for linear functions, only the trilinear one
+		// for multilinear ones.
+		assert.InDelta(t, linear(v)[0], eval(grid(linear), v, icc.Trilinear)[0], 1e-12)
+		assert.InDelta(t, linear(v)[0], eval(grid(linear), v, icc.Tetrahedral)[0], 1e-12)
+		assert.InDelta(t, product(v)[0], eval(grid(product), v, icc.Trilinear)[0], 1e-12)
+	}
+	assert.InDelta(t, 0.1*0.5*0.9, eval(grid(product), []float64{0.1, 0.5, 0.9}, icc.Tetrahedral)[0], 0.1)
+	assert.NotEqual(t, eval(grid(product), []float64{0.1, 0.5, 0.9}, icc.Tetrahedral)[0], 0.1*0.5*0.9)
+
+	// Grid points are returned as is, and values are clamped to the grid.
+	c := &icc.CLUT{GridPoints: []int{3, 2}, Outputs: 2, Data: []float64{0, 1, 0.1, 0.9, 0.2, 0.8, 0.3, 0.7, 0.4, 0.6, 0.5, 0.5}}
+	assert.InDeltaSlice(t, []float64{0.3, 0.7}, eval(c, []float64{0.5, 1}, icc.Tetrahedral), 1e-12)
+	assert.InDeltaSlice(t, []float64{0.25, 0.75}, eval(c, []float64{0.5, 0.5}, icc.Trilinear), 1e-12)
+	assert.InDeltaSlice(t, []float64{0.4, 0.6}, eval(c, []float64{2, -1}, icc.Trilinear), 1e-12)
+
+	// Input values must match the grid dimensions, and the data the grid points.
+	_, err := c.Eval([]float64{0.5}, icc.Trilinear)
+	assert.Error(t, err)
+	_, err = (&icc.CLUT{GridPoints: []int{2, 2}, Outputs: 1, Data: []float64{0, 1}}).Eval([]float64{0.5, 0.5}, icc.Trilinear)
+	assert.Error(t, err)
+}
+
+func TestCMYKProfile(t *testing.T) {
+	p, m := cmykProfile(t)
+
+	samples := [][]float64{
+		{0, 0, 0, 0}, {1, 0, 0, 0}, {0, 1, 0, 0}, {0, 0, 1, 0}, {0, 0, 0, 1},
+		{0.2, 0.4, 0.6, 0}, {0.7, 0.1, 0, 0.3}, {0, 0.5, 0.5, 0.5}, {0.35, 0.65, 0.15, 0.05},
+	}
+
+	for _, interpolation := range []string{icc.Trilinear, icc.Tetrahedral} {
+		toLab, err := p.DeviceToPCS(icc.RelativeColorimetric, icc.SpaceLab, interpolation)
+		require.NoError(t, err)
+		toCMYK, err := p.PCSToDevice(icc.RelativeColorimetric, icc.SpaceLab, interpolation)
+		require.NoError(t, err)
+		assert.Equal(t, 4, toLab.Inputs())
+		assert.Equal(t, 4, toCMYK.Outputs())
+
+		for _, cmyk := range samples {
+			lab, err := toLab.Apply(cmyk)
+			require.NoError(t, err)
+			assert.Less(t, deltaE(m.lab(cmyk), lab), 1.5, "%v %v", interpolation, cmyk)
+
+			// The inverse table is less accurate along the gamut boundary.
+			back, err := toCMYK.Apply(lab)
+			require.NoError(t, err)
+			lab2, err := toLab.Apply(back)
+			require.NoError(t, err)
+			assert.Less(t, deltaE(lab, lab2), 4.0, "%v %v → %v", interpolation, cmyk, back)
+		}
+	}
+
+	toXYZ, err := p.DeviceToPCS(icc.RelativeColorimetric, icc.SpaceXYZ, icc.Tetrahedral)
+	require.NoError(t, err)
+	paper, err := toXYZ.Apply([]float64{0, 0, 0, 0})
+	require.NoError(t, err)
+	assert.InDeltaSlice(t, []float64{0.9642, 1, 0.8249}, paper, 2e-3)
+
+	_, err = toXYZ.Apply([]float64{0, 0, 0})
+	assert.Error(t, err)
+}
+
+func TestCMYKRenderingIntents(t *testing.T) {
+	p, _ := cmykProfile(t)
+	cmyk := []float64{0.2, 0.4, 0.6, 0.1}
+
+	apply := func(intent icc.RenderingIntent, pcs icc.Signature) []float64 {
+		tr, err := p.DeviceToPCS(intent, pcs, icc.Tetrahedral)
+		require.NoError(t, err)
+		v, err := tr.Apply(cmyk)
+		require.NoError(t, err)
+		return v
+	}
+
+	perceptual := apply(icc.Perceptual, icc.SpaceLab)
+	relative := apply(icc.RelativeColorimetric, icc.SpaceLab)
+	saturation := apply(icc.Saturation, icc.SpaceLab)
+	assert.InDelta(t, 10+0.9*relative[0], perceptual[0], 0.5)
+	assert.Equal(t, perceptual, saturation)
+
+	// Absolute colorimetric values are scaled by the media white point.
+	rel := apply(icc.RelativeColorimetric, icc.SpaceXYZ)
+	abs := apply(icc.AbsoluteColorimetric, icc.SpaceXYZ)
+	assert.InDelta(t, rel[0]*0.9/0.9642, abs[0], 1e-3)
+	assert.InDelta(t, rel[1]*0.93, abs[1], 1e-3)
+	assert.InDelta(t, rel[2]*0.75/0.8249, abs[2], 1e-3)
+
+	toCMYK, err := p.PCSToDevice(icc.AbsoluteColorimetric, icc.SpaceXYZ, icc.Tetrahedral)
+	require.NoError(t, err)
+	back, err := toCMYK.Apply(abs)
+	require.NoError(t, err)
+	toCMYK, err = p.PCSToDevice(icc.RelativeColorimetric, icc.SpaceXYZ, icc.Tetrahedral)
+	require.NoError(t, err)
+	expected, err := toCMYK.Apply(rel)
+	require.NoError(t, err)
+	assert.InDeltaSlice(t, expected, back, 1e-6)
+
+	_, err = p.DeviceToPCS(4, icc.SpaceLab, icc.Tetrahedral)
+	assert.Error(t, err)
+	_, err = p.DeviceToPCS(icc.Perceptual, icc.SpaceRGB, icc.Tetrahedral)
+	assert.Error(t, err)
+	_, err = p.DeviceToPCS(icc.Perceptual, icc.SpaceLab, "cubic")
+	assert.Error(t, err)
+}
+
+func TestTransformLUTChannels(t *testing.T) {
+	// A CMYK to CMYK table cannot convert to the PCS.
+	clut := clutBytes(2, 4, func(v []float64) []float64 { return v })
+	data := (&profileBuilder{version: 0x04300000, class: "prtr", space: "CMYK", pcs: "Lab "}).
+		add("A2B0", lutTag("mAB ", 4, 4, clut)).
+		bytes()
+	p, err := icc.Parse(data)
+	require.NoError(t, err)
+	_, err = p.DeviceToPCS(icc.Perceptual, icc.SpaceLab, icc.Trilinear)
+	assert.Error(t, err)
+
+	// Tags built in memory are checked as well.
+	p.Tags[icc.TagBToA0] = &icc.LUTBtoA{InputChannels: 3, OutputChannels: 3, B: make([]gocolor.TransferCurve, 2)}
+	_, err = p.PCSToDevice(icc.Perceptual, icc.SpaceLab, icc.Trilinear)
+	assert.Error(t, err)
+}
+
+func TestMatrixTRCTransform(t *testing.T) {
+	p, err := icc.NewRGBProfile(gocolor.SRGB)
+	require.NoError(t, err)
+	data, err := icc.Marshal(p)
+	require.NoError(t, err)
+	p, err = icc.Parse(data)
+	require.NoError(t, err)
+
+	toXYZ, err := p.DeviceToPCS(icc.Perceptual, icc.SpaceXYZ, icc.Trilinear)
+	require.NoError(t, err)
+	fromXYZ, err := p.PCSToDevice(icc.Perceptual, icc.SpaceXYZ, icc.Trilinear)
+	require.NoError(t, err)
+	ref, err := gocolor.NewTransform(gocolor.SRGB, gocolor.CIEXYZ, gocolor.Observer2, gocolor.RefIlluminantD50, gocolor.ChromaBradford)
+	require.NoError(t, err)
+
+	for _, rgb := range [][]float64{{1, 1, 1}, {0.2, 0.5, 0.8}, {0.9, 0.1, 0.3}} {
+		xyz, err := toXYZ.Apply(rgb)
+		require.NoError(t, err)
+		x, y, z := ref.Apply(rgb[0], rgb[1], rgb[2])
+		assert.InDeltaSlice(t, []float64{x, y, z}, xyz, 2e-3)
+
+		back, err := fromXYZ.Apply(xyz)
+		require.NoError(t, err)
+		assert.InDeltaSlice(t, rgb, back, 1e-6)
+	}
+}
+
+func TestLegacyLabEncoding(t *testing.T) {
+	// An identity lut16 from RGB to the legacy L*a*b* encoding.
+	identity := s15(1)
+	zero := s15(0)
+	matrix := append(append(append(identity, zero...), zero...), append(append(append(zero, identity...), zero...), append(append(zero, zero...), identity...)...)...)
+	tables := u16s(0, 0xffff, 0, 0xffff, 0, 0xffff)
+	var clut []byte
+	for i := 0; i < 8; i++ {
+		clut = append(clut, u16s(uint16(i>>2&1)*0xffff, uint16(i>>1&1)*0xffff, uint16(i&1)*0xffff)...)
+	}
+	mft2 := typed("mft2", []byte{3, 3, 2, 0}, matrix, u16s(2, 2), tables, clut, tables)
+
+	data := (&profileBuilder{version: 0x02100000, class: "scnr", space: "RGB ", pcs: "Lab "}).
+		add("A2B0", mft2).
+		bytes()
+	p, err := icc.Parse(data)
+	require.NoError(t, err)
+
+	tr, err := p.DeviceToPCS(icc.Perceptual, icc.SpaceLab, icc.Trilinear)
+	require.NoError(t, err)
+	lab, err := tr.Apply([]float64{0xff00 / 65535.0, 0x8000 / 65535.0, 0x8000 / 65535.0})
+	require.NoError(t, err)
+	assert.InDeltaSlice(t, []float64{100, 0, 0}, lab, 1e-3)
+}
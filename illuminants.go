@@ -14,6 +14,8 @@
 
 package gocolor
 
+import "github.com/Hexbee-net/gocolor/internal/colormath"
+
 // Reference illuminants
 const (
 	RefIlluminantA         = "A"
@@ -37,8 +39,8 @@ const (
 )
 
 const (
-	CieE = 216.0 / 24389.0
-	CieK = 24389.0 / 27.0
+	CieE = colormath.E
+	CieK = colormath.K
 )
 
 var observerWhitePoints = map[int]map[string]vector{
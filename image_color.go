@@ -0,0 +1,251 @@
+// Copyright © 2019 Xavier Basty <xavier@hexbee.net>
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package gocolor
+
+import (
+	"image/color"
+	"math"
+
+	"github.com/Hexbee-net/gocolor/internal/interp"
+)
+
+// The colors of the image/color package are assumed to be sRGB colors.
+// The types below implement color.Color, so that they can be used with the
+// standard library, and the matching models convert any color.Color to them.
+// XYZ and Lab coordinates use illuminant D65 and the 2° standard observer,
+// which is the reference white of sRGB.
+
+// XYZ is a CIE 1931 XYZ color, with a luminance (Y) of 1 for the reference white.
+type XYZ struct {
+	X, Y, Z float64
+}
+
+// Lab is a CIE L*a*b* color, with a lightness (L) in the [0, 100] range.
+type Lab struct {
+	L, A, B float64
+}
+
+// HSL is an sRGB color in hue, saturation and lightness coordinates.
+// The hue is in degrees, in the [0, 360] range, the saturation and
+// lightness in the [0, 1] range.
+type HSL struct {
+	H, S, L float64
+}
+
+// HSV is an sRGB color in hue, saturation and value coordinates.
+// The hue is in degrees, in the [0, 360] range, the saturation and
+// value in the [0, 1] range.
+type HSV struct {
+	H, S, V float64
+}
+
+// LinearRGB is an sRGB color with linear (not gamma companded) components,
+// in the [0, 1] range.
+type LinearRGB struct {
+	R, G, B float64
+}
+
+// Models for the color types of the package.
+var (
+	XYZModel       = color.ModelFunc(xyzModel)
+	LabModel       = color.ModelFunc(labModel)
+	HSLModel       = color.ModelFunc(hslModel)
+	HSVModel       = color.ModelFunc(hsvModel)
+	LinearRGBModel = color.ModelFunc(linearRGBModel)
+)
+
+// RGBA returns the alpha-premultiplied red, green, blue and alpha values
+// for the color. Colors out of the sRGB gamut are clipped.
+func (c XYZ) RGBA() (r, g, b, a uint32) {
+	return xyzToRGBA(c.xyz())
+}
+
+// RGBA returns the alpha-premultiplied red, green, blue and alpha values
+// for the color. Colors out of the sRGB gamut are clipped.
+func (c Lab) RGBA() (r, g, b, a uint32) {
+	return xyzToRGBA(c.xyz())
+}
+
+// RGBA returns the alpha-premultiplied red, green, blue and alpha values
+// for the color.
+func (c HSL) RGBA() (r, g, b, a uint32) {
+	h := math.Mod(math.Mod(c.H, 360)+360, 360)
+	rf, gf, bf, _ := HSLtoRGB(h, interp.Clamp01(c.S), interp.Clamp01(c.L))
+	return rgbToRGBA(vector{rf, gf, bf})
+}
+
+// RGBA returns the alpha-premultiplied red, green, blue and alpha values
+// for the color.
+func (c HSV) RGBA() (r, g, b, a uint32) {
+	h := math.Mod(math.Mod(c.H, 360)+360, 360)
+	rf, gf, bf, _ := HSVtoRGB(h, interp.Clamp01(c.S), interp.Clamp01(c.V))
+	return rgbToRGBA(vector{rf, gf, bf})
+}
+
+// RGBA returns the alpha-premultiplied red, green, blue and alpha values
+// for the color. Components out of the [0, 1] range are clipped.
+func (c LinearRGB) RGBA() (r, g, b, a uint32) {
+	compand, _ := rgbCompanding(SRGB)
+	return rgbToRGBA(vector{c.R, c.G, c.B}.mapfunc(compand))
+}
+
+// ColorToRGB converts any color.Color to sRGB coordinates in the [0, 1] range.
+// The color is un-premultiplied, and its alpha channel is discarded, see
+// ColorToNRGBA to keep it.
+//
+// Colors with straight alpha or with a higher precision than 16 bits per
+// channel, such as color.NRGBA64, color.YCbCr or the types of this package,
+// are converted without going through their RGBA method.
+func ColorToRGB(c color.Color) (r, g, b float64) {
+	v := colorToRGB(c)
+	return v.v0, v.v1, v.v2
+}
+
+////////////////////////////////////////
+
+func (c XYZ) xyz() vector {
+	return vector{c.X, c.Y, c.Z}
+}
+
+func (c Lab) xyz() vector {
+	return labToXYZ(vector{c.L, c.A, c.B}, observerWhitePoints[Observer2][RefIlluminantD65])
+}
+
+func (c LinearRGB) xyz() vector {
+	return conversionRgbXyz[SRGB].vdot(vector{c.R, c.G, c.B})
+}
+
+// xyzColor is implemented by the colors of the package with an exact XYZ representation.
+type xyzColor interface {
+	xyz() vector
+}
+
+func xyzModel(c color.Color) color.Color {
+	if _, ok := c.(XYZ); ok {
+		return c
+	}
+	v := colorToXYZ(c)
+	return XYZ{v.v0, v.v1, v.v2}
+}
+
+func labModel(c color.Color) color.Color {
+	if _, ok := c.(Lab); ok {
+		return c
+	}
+	v := xyzToLab(colorToXYZ(c), observerWhitePoints[Observer2][RefIlluminantD65])
+	return Lab{v.v0, v.v1, v.v2}
+}
+
+func hslModel(c color.Color) color.Color {
+	if _, ok := c.(HSL); ok {
+		return c
+	}
+	v := colorToRGB(c)
+	h, s, l, _ := RGBtoHSL(v.v0, v.v1, v.v2)
+	return HSL{h, s, l}
+}
+
+func hsvModel(c color.Color) color.Color {
+	if _, ok := c.(HSV); ok {
+		return c
+	}
+	v := colorToRGB(c)
+	h, s, val, _ := RGBtoHSV(v.v0, v.v1, v.v2)
+	return HSV{h, s, val}
+}
+
+func linearRGBModel(c color.Color) color.Color {
+	if _, ok := c.(LinearRGB); ok {
+		return c
+	}
+	if xc, ok := c.(xyzColor); ok {
+		v := conversionXyzRgb[SRGB].vdot(xc.xyz())
+		return LinearRGB{v.v0, v.v1, v.v2}
+	}
+	linearize, _ := rgbLinearization(SRGB)
+	v := colorToRGB(c).mapfunc(linearize)
+	return LinearRGB{v.v0, v.v1, v.v2}
+}
+
+// colorToXYZ converts any color.Color to XYZ coordinates.
+func colorToXYZ(c color.Color) vector {
+	if xc, ok := c.(xyzColor); ok {
+		return xc.xyz()
+	}
+	v, _ := rgbToXYZ(colorToRGB(c), SRGB)
+	return v
+}
+
+// colorToRGB converts any color.Color to sRGB coordinates, clipped to the [0, 1] range.
+func colorToRGB(c color.Color) vector {
+	var v vector
+
+	switch c := c.(type) {
+	case xyzColor:
+		v, _ = xyzToRGB(c.xyz(), SRGB)
+
+	case HSL:
+		h := math.Mod(math.Mod(c.H, 360)+360, 360)
+		v.v0, v.v1, v.v2, _ = HSLtoRGB(h, interp.Clamp01(c.S), interp.Clamp01(c.L))
+
+	case HSV:
+		h := math.Mod(math.Mod(c.H, 360)+360, 360)
+		v.v0, v.v1, v.v2, _ = HSVtoRGB(h, interp.Clamp01(c.S), interp.Clamp01(c.V))
+
+	case NRGBA:
+		v = vector{c.R, c.G, c.B}
+
+	case RGBA:
+		n := c.Unpremultiply()
+		v = vector{n.R, n.G, n.B}
+
+	case color.NRGBA64:
+		v = vector{float64(c.R), float64(c.G), float64(c.B)}.vscale(1.0 / 0xffff)
+
+	case color.NRGBA:
+		v = vector{float64(c.R), float64(c.G), float64(c.B)}.vscale(1.0 / 0xff)
+
+	case color.YCbCr:
+		// JFIF full range conversion, as used by color.YCbCr.
+		y, cb, cr := float64(c.Y), float64(c.Cb)-128, float64(c.Cr)-128
+		v = vector{
+			y + 1.402*cr,
+			y - 0.344136*cb - 0.714136*cr,
+			y + 1.772*cb,
+		}.vscale(1.0 / 0xff)
+
+	default:
+		r, g, b, a := c.RGBA()
+		if a == 0 {
+			return vector{}
+		}
+		v = vector{float64(r), float64(g), float64(b)}.vscale(1.0 / float64(a))
+	}
+
+	return v.mapfunc(interp.Clamp01)
+}
+
+// rgbToRGBA converts sRGB coordinates to opaque 16 bits color.Color values.
+func rgbToRGBA(v vector) (r, g, b, a uint32) {
+	v = v.mapfunc(func(v float64) float64 {
+		return math.Round(interp.Clamp01(v) * 0xffff)
+	})
+	return uint32(v.v0), uint32(v.v1), uint32(v.v2), 0xffff
+}
+
+func xyzToRGBA(xyz vector) (r, g, b, a uint32) {
+	v, _ := xyzToRGB(xyz, SRGB)
+	return rgbToRGBA(v)
+}
@@ -0,0 +1,114 @@
+// Copyright © 2019 Xavier Basty <xavier@hexbee.net>
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package gocolor_test
+
+import (
+	"image/color"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/Hexbee-net/gocolor"
+)
+
+func TestColorModels(t *testing.T) {
+	white := gocolor.LabModel.Convert(color.White).(gocolor.Lab)
+	assert.InDelta(t, 100, white.L, 1e-2)
+	assert.InDelta(t, 0, white.A, 1e-2)
+	assert.InDelta(t, 0, white.B, 1e-2)
+
+	xyz := gocolor.XYZModel.Convert(color.White).(gocolor.XYZ)
+	assert.InDelta(t, 0.95047, xyz.X, 1e-3)
+	assert.InDelta(t, 1, xyz.Y, 1e-3)
+	assert.InDelta(t, 1.08883, xyz.Z, 1e-3)
+
+	red := color.NRGBA{R: 0xff, A: 0xff}
+	hsl := gocolor.HSLModel.Convert(red).(gocolor.HSL)
+	assert.Equal(t, gocolor.HSL{H: 0, S: 1, L: 0.5}, hsl)
+	hsv := gocolor.HSVModel.Convert(red).(gocolor.HSV)
+	assert.Equal(t, gocolor.HSV{H: 0, S: 1, V: 1}, hsv)
+
+	gray := gocolor.LinearRGBModel.Convert(color.Gray16{Y: 0x8000}).(gocolor.LinearRGB)
+	assert.InDelta(t, 0.214, gray.R, 1e-3)
+	assert.Equal(t, gray.R, gray.G)
+	assert.Equal(t, gray.R, gray.B)
+
+	// Conversions between the types of the package do not lose precision.
+	lab := gocolor.Lab{L: 53.2, A: 80.1, B: 67.2}
+	back := gocolor.LabModel.Convert(gocolor.XYZModel.Convert(lab)).(gocolor.Lab)
+	assert.InDelta(t, lab.L, back.L, 1e-9)
+	assert.InDelta(t, lab.A, back.A, 1e-9)
+	assert.InDelta(t, lab.B, back.B, 1e-9)
+
+	lin := gocolor.LinearRGBModel.Convert(lab).(gocolor.LinearRGB)
+	back = gocolor.LabModel.Convert(lin).(gocolor.Lab)
+	assert.InDelta(t, lab.L, back.L, 1e-3)
+	assert.InDelta(t, lab.A, back.A, 1e-3)
+
+	// Models return colors of their own type unchanged.
+	assert.Equal(t, lab, gocolor.LabModel.Convert(lab))
+	assert.Equal(t, hsl, gocolor.HSLModel.Convert(hsl))
+}
+
+func TestColorRGBA(t *testing.T) {
+	colors := []color.Color{
+		gocolor.XYZ{X: 0.95047, Y: 1, Z: 1.08883},
+		gocolor.Lab{L: 100},
+		gocolor.HSL{H: 120, S: 0.3, L: 1},
+		gocolor.HSV{H: 360, V: 1},
+		gocolor.LinearRGB{R: 1, G: 1, B: 1},
+	}
+	for _, c := range colors {
+		r, g, b, a := c.RGBA()
+		assert.Equalf(t, [4]uint32{0xffff, 0xffff, 0xffff, 0xffff}, [4]uint32{r, g, b, a}, "white is wrong for %T", c)
+	}
+
+	// Out of gamut colors are clipped.
+	r, g, b, _ := gocolor.Lab{L: 50, A: 120, B: -120}.RGBA()
+	assert.True(t, r <= 0xffff && g <= 0xffff && b <= 0xffff)
+
+	// 16 bits colors survive a round trip through the models.
+	src := color.NRGBA64{R: 0x1234, G: 0xabcd, B: 0x7f00, A: 0xffff}
+	for _, m := range []color.Model{gocolor.XYZModel, gocolor.LabModel, gocolor.HSLModel, gocolor.HSVModel, gocolor.LinearRGBModel} {
+		dst := color.NRGBA64Model.Convert(m.Convert(src)).(color.NRGBA64)
+		assert.InDelta(t, src.R, dst.R, 1)
+		assert.InDelta(t, src.G, dst.G, 1)
+		assert.InDelta(t, src.B, dst.B, 1)
+		assert.Equal(t, src.A, dst.A)
+	}
+}
+
+func TestColorToRGB(t *testing.T) {
+	r, g, b := gocolor.ColorToRGB(color.YCbCr{Y: 0x80, Cb: 0x80, Cr: 0x80})
+	assert.InDelta(t, 128.0/255, r, 1e-9)
+	assert.InDelta(t, 128.0/255, g, 1e-9)
+	assert.InDelta(t, 128.0/255, b, 1e-9)
+
+	// Saturated YCbCr colors are clipped to the RGB cube.
+	r, _, _ = gocolor.ColorToRGB(color.YCbCr{Y: 0xff, Cb: 0x80, Cr: 0xff})
+	assert.Equal(t, 1.0, r)
+
+	// Premultiplied colors are un-premultiplied.
+	r, g, b = gocolor.ColorToRGB(color.RGBA{R: 0x40, G: 0x20, A: 0x80})
+	assert.InDelta(t, 0.5, r, 1e-2)
+	assert.InDelta(t, 0.25, g, 1e-2)
+	assert.Equal(t, 0.0, b)
+
+	r, g, b = gocolor.ColorToRGB(color.NRGBA64{R: 0xffff, G: 0x8000, B: 0, A: 0x10})
+	assert.Equal(t, [3]float64{1, float64(0x8000) / 0xffff, 0}, [3]float64{r, g, b})
+
+	r, g, b = gocolor.ColorToRGB(color.Transparent)
+	assert.Equal(t, [3]float64{0, 0, 0}, [3]float64{r, g, b})
+}
@@ -0,0 +1,452 @@
+// Copyright © 2019 Xavier Basty <xavier@hexbee.net>
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package gocolor
+
+import (
+	"errors"
+	"fmt"
+	"image"
+	"image/color"
+	"math"
+	"runtime"
+	"sync"
+
+	"github.com/Hexbee-net/gocolor/internal/interp"
+)
+
+// Gamut mapping methods, applied to colors out of the gamut of the destination color space.
+const (
+	GamutClip   = "clip"   // Clip each channel to the [0, 1] range
+	GamutChroma = "chroma" // Reduce the CIELab chroma, preserving lightness and hue, then clip
+	GamutNone   = "none"   // Keep out of gamut values, for floating point destinations only
+)
+
+// Dithering methods, applied when quantizing colors to integer samples.
+const (
+	DitherNone           = "none"            // Round to the nearest value
+	DitherOrdered        = "ordered"         // 4x4 Bayer matrix
+	DitherFloydSteinberg = "floyd-steinberg" // Error diffusion
+)
+
+// ImageConversion describes the conversion of an image between color spaces.
+type ImageConversion struct {
+	// Source and destination color spaces: one of the RGB color spaces, CIEXYZ,
+	// CIELab or Oklab. The source space can be omitted for planar images, which
+	// carry their own.
+	Source, Destination string
+
+	// Chromatic adaptation method used between the white points of the color
+	// spaces. Defaults to ChromaBradford.
+	Adaptation string
+
+	// Gamut mapping method. Defaults to GamutChroma for the perceptual
+	// rendering intent, and to GamutClip otherwise.
+	GamutMapping string
+
+	// Rendering intent. Defaults to IntentRelativeColorimetric.
+	Intent string
+
+	// Whether to apply black point compensation, for the colorimetric and
+	// saturation intents. It is always applied with the perceptual intent,
+	// and has no effect between built-in spaces, see NewTransformWithIntent.
+	BlackPointCompensation bool
+
+	// Dithering method used when quantizing. Defaults to DitherNone.
+	Dithering string
+
+	// Number of goroutines converting strips of rows in parallel.
+	// Defaults to runtime.GOMAXPROCS(0).
+	Workers int
+}
+
+// ConvertImage converts an image between RGB color spaces, and quantizes the
+// result to 16 bits per channel.
+//
+// The samples of the source image are the coordinates of the source color
+// space, rather than sRGB as usually assumed by the image/color package.
+// Alpha is preserved, error diffusion dithering does not cross the boundaries
+// of the strips of rows converted in parallel.
+func ConvertImage(src image.Image, conv ImageConversion) (*image.NRGBA64, error) {
+	if !isRGBSpace(conv.Destination) {
+		return nil, fmt.Errorf("%v images cannot be quantized, use ConvertImagePlanar", conv.Destination)
+	}
+	if conv.GamutMapping == GamutNone {
+		return nil, errors.New("integer images cannot hold out of gamut colors")
+	}
+
+	pc, read, err := newImageConverter(src, conv)
+	if err != nil {
+		return nil, err
+	}
+
+	var thresholds func(x, y int) float64
+	switch conv.Dithering {
+	case "", DitherNone, DitherFloydSteinberg:
+		thresholds = func(x, y int) float64 { return 0.5 }
+	case DitherOrdered:
+		thresholds = func(x, y int) float64 { return (bayer4[y&3][x&3] + 0.5) / 16 }
+	default:
+		return nil, fmt.Errorf("unrecognized dithering method: %v", conv.Dithering)
+	}
+	diffuse := conv.Dithering == DitherFloydSteinberg
+
+	r := src.Bounds()
+	dst := image.NewNRGBA64(r)
+
+	parallelRows(r, conv.Workers, func(y0, y1 int) {
+		// Quantization errors of the current and the next rows.
+		var cur, next []vector
+		if diffuse {
+			cur = make([]vector, r.Dx()+2)
+			next = make([]vector, r.Dx()+2)
+		}
+
+		for y := y0; y < y1; y++ {
+			for x := r.Min.X; x < r.Max.X; x++ {
+				v, alpha := read(x, y)
+				v = pc.convert(v)
+
+				t := thresholds(x, y)
+				i := x - r.Min.X + 1
+				if diffuse {
+					v = vector{v.v0 + cur[i].v0, v.v1 + cur[i].v1, v.v2 + cur[i].v2}
+				}
+				q := vector{quantize(v.v0, t), quantize(v.v1, t), quantize(v.v2, t)}
+				if diffuse {
+					e := v.vsub(q.vscale(1.0 / 0xffff))
+					cur[i+1] = addScaled(cur[i+1], e, 7.0/16)
+					next[i-1] = addScaled(next[i-1], e, 3.0/16)
+					next[i] = addScaled(next[i], e, 5.0/16)
+					next[i+1] = addScaled(next[i+1], e, 1.0/16)
+				}
+
+				dst.SetNRGBA64(x, y, color.NRGBA64{
+					R: uint16(q.v0),
+					G: uint16(q.v1),
+					B: uint16(q.v2),
+					A: uint16(quantize(alpha, 0.5)),
+				})
+			}
+
+			if diffuse {
+				cur, next = next, cur
+				for i := range next {
+					next[i] = vector{}
+				}
+			}
+		}
+	})
+
+	return dst, nil
+}
+
+// ConvertImagePlanar converts an image between color spaces into a Planar64
+// image, without quantization. The dithering method is ignored.
+//
+// The samples of the source image are the coordinates of the source color
+// space, rather than sRGB as usually assumed by the image/color package.
+func ConvertImagePlanar(src image.Image, conv ImageConversion) (*Planar64, error) {
+	dst := NewPlanar64(src.Bounds(), conv.Destination)
+	if err := convertImageInto(dst, src, conv); err != nil {
+		return nil, err
+	}
+	return dst, nil
+}
+
+// ConvertImagePlanar32 converts an image between color spaces into a Planar32
+// image, without quantization. The dithering method is ignored.
+//
+// The samples of the source image are the coordinates of the source color
+// space, rather than sRGB as usually assumed by the image/color package.
+func ConvertImagePlanar32(src image.Image, conv ImageConversion) (*Planar32, error) {
+	dst := NewPlanar32(src.Bounds(), conv.Destination)
+	if err := convertImageInto(dst, src, conv); err != nil {
+		return nil, err
+	}
+	return dst, nil
+}
+
+////////////////////////////////////////
+
+// Thresholds of the ordered dithering.
+var bayer4 = [4][4]float64{
+	{0, 8, 2, 10},
+	{12, 4, 14, 6},
+	{3, 11, 1, 9},
+	{15, 7, 13, 5},
+}
+
+func convertImageInto(dst Planar, src image.Image, conv ImageConversion) error {
+	pc, read, err := newImageConverter(src, conv)
+	if err != nil {
+		return err
+	}
+
+	r := src.Bounds()
+	parallelRows(r, conv.Workers, func(y0, y1 int) {
+		for y := y0; y < y1; y++ {
+			for x := r.Min.X; x < r.Max.X; x++ {
+				v, alpha := read(x, y)
+				v = pc.convert(v)
+				dst.SetValues(x, y, v.v0, v.v1, v.v2, alpha)
+			}
+		}
+	})
+
+	return nil
+}
+
+// pixelConverter converts pixels between two color spaces, with the
+// chromatic adaptation, the black point compensation and the color space
+// matrices fused together.
+type pixelConverter struct {
+	src, dst imageSpace
+	m        matrix // Source linear coordinates to destination linear coordinates
+	offset   vector // Added to the destination linear coordinates
+	gamut    string
+}
+
+// newPixelConverter creates a converter between two color spaces, with CIEXYZ
+// and CIELab coordinates relative to illuminant D65 and the 2° observer.
+func newPixelConverter(src, dst, adaptation, gamut string) (*pixelConverter, error) {
+	return newPixelConverterWhite(src, dst, observerWhitePoints[Observer2][RefIlluminantD65], adaptation, gamut)
+}
+
+// newPixelConverterWhite creates a converter between two color spaces, with
+// CIEXYZ and CIELab coordinates relative to the given white point.
+func newPixelConverterWhite(src, dst string, white vector, adaptation, gamut string) (*pixelConverter, error) {
+	return newPixelConverterIntent(src, dst, white, adaptation, gamut, IntentRelativeColorimetric, false)
+}
+
+// newPixelConverterIntent creates a converter between two color spaces for a
+// rendering intent, with CIEXYZ and CIELab coordinates relative to the given
+// white point.
+func newPixelConverterIntent(src, dst string, white vector, adaptation, gamut, intent string, bpc bool) (*pixelConverter, error) {
+	if adaptation == "" {
+		adaptation = ChromaBradford
+	}
+	if _, ok := chromaticAdaptation[adaptation]; !ok {
+		return nil, fmt.Errorf("unrecognized chromatic adaptation method: %v", adaptation)
+	}
+
+	switch intent {
+	case "":
+		intent = IntentRelativeColorimetric
+	case IntentPerceptual:
+		bpc = true
+	case IntentAbsoluteColorimetric:
+		bpc = false
+	case IntentRelativeColorimetric, IntentSaturation:
+	default:
+		return nil, fmt.Errorf("unrecognized rendering intent: %v", intent)
+	}
+
+	switch gamut {
+	case "":
+		gamut = GamutClip
+		if intent == IntentPerceptual {
+			gamut = GamutChroma
+		}
+	case GamutClip, GamutChroma, GamutNone:
+	default:
+		return nil, fmt.Errorf("unrecognized gamut mapping method: %v", gamut)
+	}
+
+	pc := &pixelConverter{gamut: gamut}
+
+	var err error
+	if pc.src, err = getImageSpace(src, white); err != nil {
+		return nil, err
+	}
+	if pc.dst, err = getImageSpace(dst, white); err != nil {
+		return nil, err
+	}
+
+	if src == dst {
+		pc.m = identityMatrix
+		return pc, nil
+	}
+
+	// Source linear coordinates to XYZ relative to the destination white.
+	toXYZ := pc.src.toXYZ
+	if intent != IntentAbsoluteColorimetric && pc.src.white != pc.dst.white {
+		toXYZ = getAdaptationMatrix(pc.src.white, pc.dst.white, adaptation).mdot(toXYZ)
+	}
+
+	if bpc {
+		scale, offset := blackPointCompensation(
+			toXYZ.vdot(pc.src.decode(vector{})),
+			pc.dst.toXYZ.vdot(pc.dst.decode(vector{})),
+			pc.dst.white)
+		toXYZ = scale.diag().mdot(toXYZ)
+		pc.offset = pc.dst.fromXYZ.vdot(offset)
+	}
+
+	pc.m = pc.dst.fromXYZ.mdot(toXYZ)
+
+	return pc, nil
+}
+
+func (pc *pixelConverter) convert(v vector) vector {
+	v = pc.m.vdot(pc.src.decode(v))
+	if pc.offset != (vector{}) {
+		v = vector{v.v0 + pc.offset.v0, v.v1 + pc.offset.v1, v.v2 + pc.offset.v2}
+	}
+
+	if pc.dst.rgb {
+		switch pc.gamut {
+		case GamutChroma:
+			v = pc.mapChroma(v).mapfunc(interp.Clamp01)
+		case GamutClip:
+			v = v.mapfunc(interp.Clamp01)
+		}
+	}
+
+	return pc.dst.encode(v)
+}
+
+// mapChroma brings a linear RGB color into the gamut of the destination space,
+// by reducing its chroma with a bisection.
+func (pc *pixelConverter) mapChroma(v vector) vector {
+	if inUnitCube(v) {
+		return v
+	}
+
+	lab := xyzToLab(pc.dst.toXYZ.vdot(v), pc.dst.white)
+	l := math.Min(math.Max(lab.v0, 0), 100)
+
+	rgb := func(f float64) vector {
+		return pc.dst.fromXYZ.vdot(labToXYZ(vector{l, lab.v1 * f, lab.v2 * f}, pc.dst.white))
+	}
+
+	lo, hi := 0.0, 1.0
+	for i := 0; i < 24; i++ {
+		mid := (lo + hi) / 2
+		if inUnitCube(rgb(mid)) {
+			lo = mid
+		} else {
+			hi = mid
+		}
+	}
+
+	return rgb(lo)
+}
+
+func inUnitCube(v vector) bool {
+	const eps = 1e-9
+	return v.v0 >= -eps && v.v0 <= 1+eps &&
+		v.v1 >= -eps && v.v1 <= 1+eps &&
+		v.v2 >= -eps && v.v2 <= 1+eps
+}
+
+// newImageConverter resolves the conversion of an image, and returns a
+// function reading the samples of its pixels.
+func newImageConverter(src image.Image, conv ImageConversion) (*pixelConverter, func(x, y int) (vector, float64), error) {
+	if p, ok := src.(Planar); ok {
+		if conv.Source == "" {
+			conv.Source = p.ColorSpace()
+		} else if conv.Source != p.ColorSpace() {
+			return nil, nil, fmt.Errorf("mismatching source color space: %v (image is %v)", conv.Source, p.ColorSpace())
+		}
+	} else if !isRGBSpace(conv.Source) {
+		return nil, nil, fmt.Errorf("%v images must be planar images", conv.Source)
+	}
+
+	pc, err := conv.pixelConverter(conv.Source)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return pc, pixelReader(src), nil
+}
+
+// pixelConverter creates the converter of the conversion, from the given source
+// color space.
+func (conv ImageConversion) pixelConverter(src string) (*pixelConverter, error) {
+	return newPixelConverterIntent(src, conv.Destination, observerWhitePoints[Observer2][RefIlluminantD65],
+		conv.Adaptation, conv.GamutMapping, conv.Intent, conv.BlackPointCompensation)
+}
+
+// pixelReader returns a function reading the samples of the pixels of an
+// image, with straight alpha.
+func pixelReader(src image.Image) func(x, y int) (vector, float64) {
+	switch img := src.(type) {
+	case Planar:
+		return func(x, y int) (vector, float64) {
+			c0, c1, c2, alpha := img.Values(x, y)
+			return vector{c0, c1, c2}, alpha
+		}
+
+	case *image.NRGBA64:
+		return func(x, y int) (vector, float64) {
+			c := img.NRGBA64At(x, y)
+			return vector{float64(c.R), float64(c.G), float64(c.B)}.vscale(1.0 / 0xffff), float64(c.A) / 0xffff
+		}
+
+	case *image.NRGBA:
+		return func(x, y int) (vector, float64) {
+			c := img.NRGBAAt(x, y)
+			return vector{float64(c.R), float64(c.G), float64(c.B)}.vscale(1.0 / 0xff), float64(c.A) / 0xff
+		}
+	}
+
+	return func(x, y int) (vector, float64) {
+		r, g, b, a := src.At(x, y).RGBA()
+		if a == 0 {
+			return vector{}, 0
+		}
+		return vector{float64(r), float64(g), float64(b)}.vscale(1.0 / float64(a)), float64(a) / 0xffff
+	}
+}
+
+// parallelRows calls f on strips of rows of the rectangle, from several goroutines.
+func parallelRows(r image.Rectangle, workers int, f func(y0, y1 int)) {
+	n := r.Dy()
+	if n <= 0 || r.Dx() <= 0 {
+		return
+	}
+	if workers <= 0 {
+		workers = runtime.GOMAXPROCS(0)
+	}
+	if workers > n {
+		workers = n
+	}
+
+	strip := (n + workers - 1) / workers
+	var wg sync.WaitGroup
+	for y := r.Min.Y; y < r.Max.Y; y += strip {
+		y1 := y + strip
+		if y1 > r.Max.Y {
+			y1 = r.Max.Y
+		}
+		wg.Add(1)
+		go func(y0, y1 int) {
+			defer wg.Done()
+			f(y0, y1)
+		}(y, y1)
+	}
+	wg.Wait()
+}
+
+// quantize converts a value in the [0, 1] range to a 16 bits sample, rounding
+// up when the fractional part exceeds the threshold.
+func quantize(v, threshold float64) float64 {
+	q := math.Floor(interp.Clamp01(v)*0xffff + 1 - threshold)
+	return math.Min(math.Max(q, 0), 0xffff)
+}
+
+func addScaled(a, b vector, f float64) vector {
+	return vector{a.v0 + b.v0*f, a.v1 + b.v1*f, a.v2 + b.v2*f}
+}
@@ -0,0 +1,191 @@
+// Copyright © 2019 Xavier Basty <xavier@hexbee.net>
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package gocolor_test
+
+import (
+	"image"
+	"image/color"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/Hexbee-net/gocolor"
+)
+
+// gradientImage builds an image covering a wide range of colors.
+func gradientImage(w, h int) *image.NRGBA64 {
+	img := image.NewNRGBA64(image.Rect(-3, 5, w-3, h+5))
+	r := img.Bounds()
+	for y := r.Min.Y; y < r.Max.Y; y++ {
+		for x := r.Min.X; x < r.Max.X; x++ {
+			img.SetNRGBA64(x, y, color.NRGBA64{
+				R: uint16((x - r.Min.X) * 0xffff / (w - 1)),
+				G: uint16((y - r.Min.Y) * 0xffff / (h - 1)),
+				B: uint16((x + y) * 0x7ff),
+				A: uint16(0xffff - (x-r.Min.X)*0x100),
+			})
+		}
+	}
+	return img
+}
+
+func TestConvertImage(t *testing.T) {
+	src := gradientImage(32, 24)
+
+	// Round trip through a wider color space.
+	wide, err := gocolor.ConvertImagePlanar(src, gocolor.ImageConversion{
+		Source:      gocolor.SRGB,
+		Destination: gocolor.ProPhotoRGB,
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, src.Bounds(), wide.Bounds())
+	assert.Equal(t, gocolor.ProPhotoRGB, wide.Space)
+
+	// The tabulated matrices are not exact inverses, which costs a few 16 bits levels.
+	back, err := gocolor.ConvertImage(wide, gocolor.ImageConversion{Destination: gocolor.SRGB, Workers: 5})
+	assert.NoError(t, err)
+	r := src.Bounds()
+	for y := r.Min.Y; y < r.Max.Y; y++ {
+		for x := r.Min.X; x < r.Max.X; x++ {
+			a, b := src.NRGBA64At(x, y), back.NRGBA64At(x, y)
+			assert.InDeltaf(t, a.R, b.R, 8, "red is wrong at (%v, %v)", x, y)
+			assert.InDeltaf(t, a.G, b.G, 8, "green is wrong at (%v, %v)", x, y)
+			assert.InDeltaf(t, a.B, b.B, 8, "blue is wrong at (%v, %v)", x, y)
+			assert.Equalf(t, a.A, b.A, "alpha is wrong at (%v, %v)", x, y)
+		}
+	}
+
+	// The result does not depend on the number of workers.
+	one, err := gocolor.ConvertImage(src, gocolor.ImageConversion{Source: gocolor.SRGB, Destination: gocolor.AdobeRGB, Workers: 1})
+	assert.NoError(t, err)
+	many, err := gocolor.ConvertImage(src, gocolor.ImageConversion{Source: gocolor.SRGB, Destination: gocolor.AdobeRGB, Workers: 7})
+	assert.NoError(t, err)
+	assert.Equal(t, one.Pix, many.Pix)
+}
+
+func TestConvertImageLab(t *testing.T) {
+	src := image.NewNRGBA(image.Rect(0, 0, 2, 1))
+	src.SetNRGBA(0, 0, color.NRGBA{R: 0xff, G: 0xff, B: 0xff, A: 0xff})
+	src.SetNRGBA(1, 0, color.NRGBA{R: 0xff, A: 0xff})
+
+	lab, err := gocolor.ConvertImagePlanar(src, gocolor.ImageConversion{Source: gocolor.SRGB, Destination: gocolor.CIELab})
+	assert.NoError(t, err)
+
+	l, a, b, alpha := lab.Values(0, 0)
+	assert.InDelta(t, 100, l, 1e-2)
+	assert.InDelta(t, 0, a, 1e-2)
+	assert.InDelta(t, 0, b, 1e-2)
+	assert.Equal(t, 1.0, alpha)
+
+	l, a, b, _ = lab.Values(1, 0)
+	assert.InDelta(t, 53.24, l, 0.05)
+	assert.InDelta(t, 80.09, a, 0.1)
+	assert.InDelta(t, 67.20, b, 0.1)
+
+	back, err := gocolor.ConvertImage(lab, gocolor.ImageConversion{Destination: gocolor.SRGB})
+	assert.NoError(t, err)
+	assert.Equal(t, color.NRGBA64{R: 0xffff, G: 0xffff, B: 0xffff, A: 0xffff}, back.NRGBA64At(0, 0))
+	c := back.NRGBA64At(1, 0)
+	assert.InDelta(t, 0xffff, c.R, 8)
+	assert.InDelta(t, 0, c.G, 8)
+	assert.InDelta(t, 0, c.B, 8)
+}
+
+func TestConvertImageGamutMapping(t *testing.T) {
+	// Saturated ProPhoto green, far out of the sRGB gamut.
+	src := gocolor.NewPlanar64(image.Rect(0, 0, 1, 1), gocolor.ProPhotoRGB)
+	src.SetValues(0, 0, 0.2, 0.8, 0.1, 1)
+
+	lab, err := gocolor.ConvertImagePlanar(src, gocolor.ImageConversion{Destination: gocolor.CIELab})
+	assert.NoError(t, err)
+	l, _, _, _ := lab.Values(0, 0)
+
+	unmapped, err := gocolor.ConvertImagePlanar(src, gocolor.ImageConversion{Destination: gocolor.SRGB, GamutMapping: gocolor.GamutNone})
+	assert.NoError(t, err)
+	_, g, b, _ := unmapped.Values(0, 0)
+	assert.True(t, g > 1 || b < 0)
+
+	for _, gamut := range []string{gocolor.GamutClip, gocolor.GamutChroma} {
+		mapped, err := gocolor.ConvertImagePlanar(src, gocolor.ImageConversion{Destination: gocolor.SRGB, GamutMapping: gamut})
+		assert.NoError(t, err)
+		c0, c1, c2, _ := mapped.Values(0, 0)
+		for _, v := range []float64{c0, c1, c2} {
+			assert.Truef(t, v >= 0 && v <= 1, "%v is out of gamut for %v", v, gamut)
+		}
+	}
+
+	// Chroma reduction preserves the lightness.
+	mapped, err := gocolor.ConvertImagePlanar(src, gocolor.ImageConversion{Destination: gocolor.SRGB, GamutMapping: gocolor.GamutChroma})
+	assert.NoError(t, err)
+	lab, err = gocolor.ConvertImagePlanar(mapped, gocolor.ImageConversion{Destination: gocolor.CIELab})
+	assert.NoError(t, err)
+	lm, _, _, _ := lab.Values(0, 0)
+	assert.InDelta(t, l, lm, 0.5)
+}
+
+func TestConvertImageDithering(t *testing.T) {
+	// A flat color between two 16 bits levels.
+	src := gocolor.NewPlanar64(image.Rect(0, 0, 16, 16), gocolor.SRGB)
+	v := 1000.25 / 0xffff
+	r := src.Bounds()
+	for y := r.Min.Y; y < r.Max.Y; y++ {
+		for x := r.Min.X; x < r.Max.X; x++ {
+			src.SetValues(x, y, v, v, v, 1)
+		}
+	}
+
+	mean := func(img *image.NRGBA64) float64 {
+		var sum float64
+		for y := r.Min.Y; y < r.Max.Y; y++ {
+			for x := r.Min.X; x < r.Max.X; x++ {
+				sum += float64(img.NRGBA64At(x, y).G)
+			}
+		}
+		return sum / float64(r.Dx()*r.Dy())
+	}
+
+	dst, err := gocolor.ConvertImage(src, gocolor.ImageConversion{Destination: gocolor.SRGB})
+	assert.NoError(t, err)
+	assert.Equal(t, 1000.0, mean(dst))
+
+	for _, d := range []string{gocolor.DitherOrdered, gocolor.DitherFloydSteinberg} {
+		dst, err = gocolor.ConvertImage(src, gocolor.ImageConversion{Destination: gocolor.SRGB, Dithering: d, Workers: 2})
+		assert.NoError(t, err)
+		assert.InDeltaf(t, 1000.25, mean(dst), 0.02, "mean is wrong for %v", d)
+	}
+}
+
+func TestConvertImageErrors(t *testing.T) {
+	src := gradientImage(4, 4)
+	lab := gocolor.NewPlanar64(image.Rect(0, 0, 4, 4), gocolor.CIELab)
+
+	tests := []gocolor.ImageConversion{
+		{Source: "unknown", Destination: gocolor.SRGB},
+		{Source: gocolor.SRGB, Destination: "unknown"},
+		{Source: gocolor.SRGB, Destination: gocolor.CIELab},
+		{Source: gocolor.CIELab, Destination: gocolor.SRGB},
+		{Source: gocolor.SRGB, Destination: gocolor.SRGB, GamutMapping: gocolor.GamutNone},
+		{Source: gocolor.SRGB, Destination: gocolor.SRGB, GamutMapping: "unknown"},
+		{Source: gocolor.SRGB, Destination: gocolor.SRGB, Dithering: "unknown"},
+		{Source: gocolor.SRGB, Destination: gocolor.SRGB, Adaptation: "unknown"},
+	}
+	for _, conv := range tests {
+		_, err := gocolor.ConvertImage(src, conv)
+		assert.Errorf(t, err, "no error for %+v", conv)
+	}
+
+	_, err := gocolor.ConvertImagePlanar(lab, gocolor.ImageConversion{Source: gocolor.SRGB, Destination: gocolor.SRGB})
+	assert.Error(t, err)
+}
@@ -0,0 +1,57 @@
+// Copyright © 2019 Xavier Basty <xavier@hexbee.net>
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package gocolor
+
+// Rendering intents, following the ICC semantics for color spaces described
+// by matrices and transfer curves.
+const (
+	// Colors are adapted to the destination white point, the black point is
+	// compensated and out of gamut colors are brought into the gamut by
+	// reducing their chroma.
+	IntentPerceptual = "perceptual"
+
+	// Colors are adapted to the destination white point, and out of gamut
+	// colors are clipped.
+	IntentRelativeColorimetric = "relative colorimetric"
+
+	// Same as the relative colorimetric intent, clipping out of gamut colors
+	// keeps them as saturated as the destination allows.
+	IntentSaturation = "saturation"
+
+	// Colors keep their XYZ coordinates, without adaptation to the destination
+	// white point nor black point compensation, and out of gamut colors are
+	// clipped.
+	IntentAbsoluteColorimetric = "absolute colorimetric"
+)
+
+// blackPointCompensation returns the scale and offset mapping the source
+// black point to the destination one while keeping the white point, following
+// the Adobe algorithm: each XYZ coordinate is scaled linearly between the
+// black and the white point.
+func blackPointCompensation(srcBlack, dstBlack, white vector) (scale, offset vector) {
+	f := func(bs, bd, w float64) (float64, float64) {
+		if bs == w {
+			return 1, 0
+		}
+		s := (w - bd) / (w - bs)
+		return s, bd - s*bs
+	}
+
+	scale.v0, offset.v0 = f(srcBlack.v0, dstBlack.v0, white.v0)
+	scale.v1, offset.v1 = f(srcBlack.v1, dstBlack.v1, white.v1)
+	scale.v2, offset.v2 = f(srcBlack.v2, dstBlack.v2, white.v2)
+
+	return scale, offset
+}
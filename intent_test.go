@@ -0,0 +1,133 @@
+// Copyright © 2019 Xavier Basty <xavier@hexbee.net>
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package gocolor_test
+
+import (
+	"image"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/Hexbee-net/gocolor"
+)
+
+func TestIntentColorimetric(t *testing.T) {
+	relative, err := gocolor.NewTransformWithIntent(gocolor.SRGB, gocolor.CIEXYZ, gocolor.Observer2, gocolor.RefIlluminantD50, "", gocolor.IntentRelativeColorimetric, false)
+	require.NoError(t, err)
+	absolute, err := gocolor.NewTransformWithIntent(gocolor.SRGB, gocolor.CIEXYZ, gocolor.Observer2, gocolor.RefIlluminantD50, "", gocolor.IntentAbsoluteColorimetric, true)
+	require.NoError(t, err)
+
+	// The white of sRGB is adapted to D50, or kept as is.
+	x, y, z := relative.Apply(1, 1, 1)
+	assert.InDelta(t, 0.96422, x, 1e-3)
+	assert.InDelta(t, 1, y, 1e-3)
+	assert.InDelta(t, 0.82521, z, 1e-3)
+
+	x, y, z = absolute.Apply(1, 1, 1)
+	assert.InDelta(t, 0.95047, x, 1e-3)
+	assert.InDelta(t, 1, y, 1e-3)
+	assert.InDelta(t, 1.08883, z, 1e-3)
+
+	_, err = gocolor.NewTransformWithIntent(gocolor.SRGB, gocolor.CIEXYZ, gocolor.Observer2, gocolor.RefIlluminantD50, "", "unknown", false)
+	assert.Error(t, err)
+}
+
+func TestIntentGamutMapping(t *testing.T) {
+	// A saturated ProPhoto green, out of the sRGB gamut.
+	perceptual, err := gocolor.NewTransformWithIntent(gocolor.ProPhotoRGB, gocolor.SRGB, gocolor.Observer2, gocolor.RefIlluminantD65, "", gocolor.IntentPerceptual, false)
+	require.NoError(t, err)
+	relative, err := gocolor.NewTransformWithIntent(gocolor.ProPhotoRGB, gocolor.SRGB, gocolor.Observer2, gocolor.RefIlluminantD65, "", gocolor.IntentRelativeColorimetric, false)
+	require.NoError(t, err)
+	unmapped, err := gocolor.NewTransform(gocolor.ProPhotoRGB, gocolor.SRGB, gocolor.Observer2, gocolor.RefIlluminantD65, "")
+	require.NoError(t, err)
+
+	r, g, b := unmapped.Apply(0.2, 0.8, 0.1)
+	assert.True(t, r < 0 || g > 1 || b < 0)
+
+	for _, tr := range []*gocolor.Transform{perceptual, relative} {
+		r, g, b := tr.Apply(0.2, 0.8, 0.1)
+		for _, v := range []float64{r, g, b} {
+			assert.True(t, v >= 0 && v <= 1, "%v", v)
+		}
+	}
+
+	pr, pg, pb := perceptual.Apply(0.2, 0.8, 0.1)
+	rr, rg, rb := relative.Apply(0.2, 0.8, 0.1)
+	assert.NotEqual(t, []float64{pr, pg, pb}, []float64{rr, rg, rb})
+
+	// The perceptual intent of image conversions reduces chroma by default.
+	src := gocolor.NewPlanar64(image.Rect(0, 0, 1, 1), gocolor.ProPhotoRGB)
+	src.SetValues(0, 0, 0.2, 0.8, 0.1, 1)
+	a, err := gocolor.ConvertImagePlanar(src, gocolor.ImageConversion{Destination: gocolor.SRGB, Intent: gocolor.IntentPerceptual})
+	require.NoError(t, err)
+	b2, err := gocolor.ConvertImagePlanar(src, gocolor.ImageConversion{Destination: gocolor.SRGB, GamutMapping: gocolor.GamutChroma})
+	require.NoError(t, err)
+	assert.Equal(t, b2.Pix, a.Pix)
+
+	_, err = gocolor.ConvertImagePlanar(src, gocolor.ImageConversion{Destination: gocolor.SRGB, Intent: "unknown"})
+	assert.Error(t, err)
+}
+
+func TestBlackPointCompensation(t *testing.T) {
+	// An sRGB display unable to show a black darker than 5% of its white.
+	def, err := gocolor.LookupRGBSpace(gocolor.SRGB)
+	require.NoError(t, err)
+	curve := make([]float64, 256)
+	for i := range curve {
+		curve[i] = 0.05 + 0.95*def.Transfer[0].ToLinear(float64(i)/255)
+	}
+	def.Transfer = [3]gocolor.TransferCurve{{Table: curve}, {Table: curve}, {Table: curve}}
+	require.NoError(t, gocolor.RegisterRGBSpace("test grayish sRGB", def))
+	defer func() { assert.NoError(t, gocolor.UnregisterRGBSpace("test grayish sRGB")) }()
+
+	plain, err := gocolor.NewTransformWithIntent("test grayish sRGB", gocolor.SRGB, gocolor.Observer2, gocolor.RefIlluminantD65, "", gocolor.IntentRelativeColorimetric, false)
+	require.NoError(t, err)
+	bpc, err := gocolor.NewTransformWithIntent("test grayish sRGB", gocolor.SRGB, gocolor.Observer2, gocolor.RefIlluminantD65, "", gocolor.IntentRelativeColorimetric, true)
+	require.NoError(t, err)
+	perceptual, err := gocolor.NewTransformWithIntent("test grayish sRGB", gocolor.SRGB, gocolor.Observer2, gocolor.RefIlluminantD65, "", gocolor.IntentPerceptual, false)
+	require.NoError(t, err)
+
+	r, g, b := plain.Apply(0, 0, 0)
+	assert.Greater(t, r, 0.2)
+	assert.InDelta(t, r, g, 1e-6)
+	assert.InDelta(t, r, b, 1e-6)
+
+	for _, tr := range []*gocolor.Transform{bpc, perceptual} {
+		r, g, b = tr.Apply(0, 0, 0)
+		assert.InDelta(t, 0, r, 1e-6)
+		assert.InDelta(t, 0, g, 1e-6)
+		assert.InDelta(t, 0, b, 1e-6)
+
+		r, g, b = tr.Apply(1, 1, 1)
+		assert.InDelta(t, 1, r, 1e-6)
+		assert.InDelta(t, 1, g, 1e-6)
+		assert.InDelta(t, 1, b, 1e-6)
+
+		// Midtones get darker.
+		r, _, _ = tr.Apply(0.5, 0.5, 0.5)
+		pr, _, _ := plain.Apply(0.5, 0.5, 0.5)
+		assert.Less(t, r, pr)
+	}
+
+	// The black points of the built-in spaces are all the XYZ origin.
+	plain, err = gocolor.NewTransformWithIntent(gocolor.AdobeRGB, gocolor.SRGB, gocolor.Observer2, gocolor.RefIlluminantD65, "", gocolor.IntentRelativeColorimetric, false)
+	require.NoError(t, err)
+	bpc, err = gocolor.NewTransformWithIntent(gocolor.AdobeRGB, gocolor.SRGB, gocolor.Observer2, gocolor.RefIlluminantD65, "", gocolor.IntentRelativeColorimetric, true)
+	require.NoError(t, err)
+	r0, g0, b0 := plain.Apply(0.2, 0.4, 0.3)
+	r, g, b = bpc.Apply(0.2, 0.4, 0.3)
+	assert.InDeltaSlice(t, []float64{r0, g0, b0}, []float64{r, g, b}, 1e-12)
+}
@@ -0,0 +1,123 @@
+// Copyright © 2019 Xavier Basty <xavier@hexbee.net>
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package colormath implements the color conversions shared by the gocolor
+// packages: sRGB, CIELab and Oklab.
+package colormath
+
+import "math"
+
+// Constants of the CIE 1976 lightness function, as exact fractions.
+const (
+	E = 216.0 / 24389.0
+	K = 24389.0 / 27.0
+)
+
+// WhiteD65 is the white point of illuminant D65 for the 2° standard observer.
+var WhiteD65 = [3]float64{0.95047, 1, 1.08883}
+
+// SRGBToXYZ converts linear sRGB coordinates to XYZ relative to WhiteD65.
+var SRGBToXYZ = [3][3]float64{
+	{0.4124564, 0.3575761, 0.1804375},
+	{0.2126729, 0.7151522, 0.0721750},
+	{0.0193339, 0.1191920, 0.9503041},
+}
+
+// Oklab matrices, from XYZ coordinates relative to illuminant D65.
+// https://bottosson.github.io/posts/oklab/
+var (
+	XYZToOklabLMS = [3][3]float64{
+		{0.8189330101, 0.3618667424, -0.1288597137},
+		{0.0329845436, 0.9293118715, 0.0361456387},
+		{0.0482003018, 0.2643662691, 0.6338517070},
+	}
+	OklabLMSToLab = [3][3]float64{
+		{0.2104542553, 0.7936177850, -0.0040720468},
+		{1.9779984951, -2.4285922050, 0.4505937099},
+		{0.0259040371, 0.7827717662, -0.8086757660},
+	}
+)
+
+// Apply multiplies a vector by a matrix.
+func Apply(m [3][3]float64, v [3]float64) [3]float64 {
+	return [3]float64{
+		m[0][0]*v[0] + m[0][1]*v[1] + m[0][2]*v[2],
+		m[1][0]*v[0] + m[1][1]*v[1] + m[1][2]*v[2],
+		m[2][0]*v[0] + m[2][1]*v[1] + m[2][2]*v[2],
+	}
+}
+
+// SRGBToLinear converts a companded sRGB value to a linear one.
+func SRGBToLinear(v float64) float64 {
+	if v <= 0.04045 {
+		return v / 12.92
+	}
+	return math.Pow((v+0.055)/1.055, 2.4)
+}
+
+////////////////////////////////////////
+
+// LabF is the CIE 1976 lightness function, with a linear segment below E.
+func LabF(v float64) float64 {
+	if v > E {
+		return math.Cbrt(v)
+	}
+	return (K*v + 16) / 116
+}
+
+// LabFInv is the inverse of LabF.
+func LabFInv(v float64) float64 {
+	if p := v * v * v; p > E {
+		return p
+	}
+	return (116*v - 16) / K
+}
+
+// XYZToLab converts XYZ coordinates to Lab relative to the given white point,
+// without any range checking.
+func XYZToLab(xyz, white [3]float64) [3]float64 {
+	x := LabF(xyz[0] / white[0])
+	y := LabF(xyz[1] / white[1])
+	z := LabF(xyz[2] / white[2])
+
+	return [3]float64{
+		116*y - 16,
+		500 * (x - y),
+		200 * (y - z),
+	}
+}
+
+// LabToXYZ converts Lab coordinates relative to the given white point to XYZ,
+// without any range checking.
+func LabToXYZ(lab, white [3]float64) [3]float64 {
+	y := (lab[0] + 16) / 116
+	x := lab[1]/500 + y
+	z := y - lab[2]/200
+
+	return [3]float64{
+		LabFInv(x) * white[0],
+		LabFInv(y) * white[1],
+		LabFInv(z) * white[2],
+	}
+}
+
+// XYZToOklab converts XYZ coordinates relative to illuminant D65 to Oklab,
+// without any range checking.
+func XYZToOklab(xyz [3]float64) [3]float64 {
+	lms := Apply(XYZToOklabLMS, xyz)
+	for i, v := range lms {
+		lms[i] = math.Cbrt(v)
+	}
+	return Apply(OklabLMSToLab, lms)
+}
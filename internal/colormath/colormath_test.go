@@ -0,0 +1,52 @@
+// Copyright © 2019 Xavier Basty <xavier@hexbee.net>
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package colormath_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/Hexbee-net/gocolor/internal/colormath"
+)
+
+func TestXYZToLab(t *testing.T) {
+	red := colormath.Apply(colormath.SRGBToXYZ, [3]float64{1, 0, 0})
+	lab := colormath.XYZToLab(red, colormath.WhiteD65)
+	assert.InDeltaSlice(t, []float64{53.2408, 80.0925, 67.2032}, lab[:], 1e-3)
+
+	xyz := colormath.LabToXYZ(lab, colormath.WhiteD65)
+	assert.InDeltaSlice(t, red[:], xyz[:], 1e-12)
+
+	// Dark colors go through the linear segment of the lightness function.
+	xyz = colormath.LabToXYZ([3]float64{5, 2, -3}, colormath.WhiteD65)
+	lab = colormath.XYZToLab(xyz, colormath.WhiteD65)
+	assert.InDeltaSlice(t, []float64{5, 2, -3}, lab[:], 1e-12)
+}
+
+func TestXYZToOklab(t *testing.T) {
+	white := colormath.XYZToOklab(colormath.WhiteD65)
+	assert.InDeltaSlice(t, []float64{1, 0, 0}, white[:], 1e-4)
+
+	red := colormath.XYZToOklab(colormath.Apply(colormath.SRGBToXYZ, [3]float64{1, 0, 0}))
+	assert.InDeltaSlice(t, []float64{0.62796, 0.22486, 0.12585}, red[:], 1e-4)
+}
+
+func TestSRGBToLinear(t *testing.T) {
+	assert.Equal(t, 0.0, colormath.SRGBToLinear(0))
+	assert.InDelta(t, 0.04045/12.92, colormath.SRGBToLinear(0.04045), 1e-15)
+	assert.InDelta(t, 0.21404, colormath.SRGBToLinear(0.5), 1e-5)
+	assert.InDelta(t, 1, colormath.SRGBToLinear(1), 1e-15)
+}
@@ -0,0 +1,83 @@
+// Copyright © 2019 Xavier Basty <xavier@hexbee.net>
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package interp implements the interpolation in regular grids shared by the
+// lookup tables of the gocolor packages.
+package interp
+
+import "math"
+
+// Clamp01 clamps a value to the [0, 1] range. NaN values map to 0.
+func Clamp01(v float64) float64 {
+	if math.IsNaN(v) {
+		return 0
+	}
+	return math.Min(math.Max(v, 0), 1)
+}
+
+// GridCell returns the index of the grid cell containing a value of the
+// [0, 1] range, and the position of the value within the cell. Values out of
+// the range are clamped, and a grid with a single point has a single cell.
+func GridCell(v float64, points int) (int, float64) {
+	if points < 2 {
+		return 0, 0
+	}
+
+	p := Clamp01(v) * float64(points-1)
+	i := int(p)
+	if i >= points-1 {
+		i = points - 2
+	}
+	return i, p - float64(i)
+}
+
+// Trilinear returns the weights of the 8 corners of a 3D grid cell for a
+// position f within the cell. Bit c of the index of a corner is set for the
+// upper corner along axis c.
+func Trilinear(f [3]float64) [8]float64 {
+	var w [8]float64
+	for corner := range w {
+		w[corner] = 1
+		for c := uint(0); c < 3; c++ {
+			if corner>>c&1 == 1 {
+				w[corner] *= f[c]
+			} else {
+				w[corner] *= 1 - f[c]
+			}
+		}
+	}
+	return w
+}
+
+// Tetrahedral returns the 4 corners of the tetrahedron of a 3D grid cell
+// containing a position f within the cell, and their weights. The corners are
+// indexed as with Trilinear, and go from the lower to the upper corner of the
+// cell along the axes sorted by decreasing position.
+func Tetrahedral(f [3]float64) ([4]int, [4]float64) {
+	x, y, z := f[0], f[1], f[2]
+	switch {
+	case x >= y && y >= z:
+		return [4]int{0, 1, 3, 7}, [4]float64{1 - x, x - y, y - z, z}
+	case x >= z && z >= y:
+		return [4]int{0, 1, 5, 7}, [4]float64{1 - x, x - z, z - y, y}
+	case z >= x && x >= y:
+		return [4]int{0, 4, 5, 7}, [4]float64{1 - z, z - x, x - y, y}
+	case y >= x && x >= z:
+		return [4]int{0, 2, 3, 7}, [4]float64{1 - y, y - x, x - z, z}
+	case y >= z && z >= x:
+		return [4]int{0, 2, 6, 7}, [4]float64{1 - y, y - z, z - x, x}
+	default:
+		return [4]int{0, 4, 6, 7}, [4]float64{1 - z, z - y, y - x, x}
+	}
+}
@@ -0,0 +1,79 @@
+// Copyright © 2019 Xavier Basty <xavier@hexbee.net>
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package interp_test
+
+import (
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/Hexbee-net/gocolor/internal/interp"
+)
+
+func TestClamp01(t *testing.T) {
+	assert.Equal(t, 0.0, interp.Clamp01(-1))
+	assert.Equal(t, 0.25, interp.Clamp01(0.25))
+	assert.Equal(t, 1.0, interp.Clamp01(2))
+	assert.Equal(t, 0.0, interp.Clamp01(math.NaN()))
+}
+
+func TestGridCell(t *testing.T) {
+	i, f := interp.GridCell(0.3, 5)
+	assert.Equal(t, 1, i)
+	assert.InDelta(t, 0.2, f, 1e-12)
+
+	// The last grid point is the upper bound of the last cell.
+	i, f = interp.GridCell(1, 5)
+	assert.Equal(t, 3, i)
+	assert.Equal(t, 1.0, f)
+
+	i, f = interp.GridCell(-1, 5)
+	assert.Equal(t, 0, i)
+	assert.Equal(t, 0.0, f)
+
+	i, f = interp.GridCell(0.7, 1)
+	assert.Equal(t, 0, i)
+	assert.Equal(t, 0.0, f)
+}
+
+func TestWeights(t *testing.T) {
+	// Both methods are exact for linear functions of the corners.
+	linear := func(corner int) float64 {
+		return 0.2*float64(corner&1) + 0.3*float64(corner>>1&1) + 0.5*float64(corner>>2&1)
+	}
+
+	for _, f := range [][3]float64{{0.1, 0.5, 0.9}, {0.9, 0.5, 0.1}, {0.5, 0.9, 0.1}, {0.3, 0.3, 0.3}, {1, 0, 0.7}, {0.2, 0.8, 0.5}} {
+		expected := 0.2*f[0] + 0.3*f[1] + 0.5*f[2]
+
+		sum, v := 0.0, 0.0
+		for corner, w := range interp.Trilinear(f) {
+			sum += w
+			v += w * linear(corner)
+		}
+		assert.InDelta(t, 1, sum, 1e-12, "%v", f)
+		assert.InDelta(t, expected, v, 1e-12, "%v", f)
+
+		sum, v = 0, 0
+		corners, weights := interp.Tetrahedral(f)
+		for i, w := range weights {
+			assert.GreaterOrEqual(t, w, 0.0, "%v", f)
+			sum += w
+			v += w * linear(corners[i])
+		}
+		assert.InDelta(t, 1, sum, 1e-12, "%v", f)
+		assert.InDelta(t, expected, v, 1e-12, "%v", f)
+	}
+}
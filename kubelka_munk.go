@@ -0,0 +1,234 @@
+// Copyright © 2019 Xavier Basty <xavier@hexbee.net>
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package gocolor
+
+import (
+	"errors"
+	"fmt"
+	"math"
+)
+
+// Reflectances are clamped to this minimum before computing K/S ratios,
+// which are infinite for perfectly absorbing samples.
+const minKMReflectance = 1e-4
+
+// Pigment stores the spectral absorption (K) and scattering (S) coefficients
+// of a colorant, as used by the Kubelka-Munk model.
+//
+// The coefficients are relative: only their ratios matter for opaque layers,
+// and the thickness of translucent layers is expressed in the same unit.
+// A typical workflow is:
+//
+//  1. remove the surface reflection from measured spectra with Saunderson.Internal,
+//  2. derive pigments with NewPigment or NewPigmentFromTint,
+//  3. blend them with MixPigments,
+//  4. compute the layer reflectance with Pigment.Reflectance,
+//  5. add the surface reflection back with Saunderson.Measured,
+//  6. convert the result with SpectralToXYZ.
+//
+// See https://en.wikipedia.org/wiki/Kubelka-Munk_theory for more information.
+type Pigment struct {
+	K SpectralColor // Absorption coefficients
+	S SpectralColor // Scattering coefficients
+}
+
+// Saunderson stores the surface reflection coefficients used to correct
+// Kubelka-Munk reflectances, which only account for the light scattered
+// inside of the layer.
+type Saunderson struct {
+	K1 float64 // Fraction of the incident light reflected by the surface
+	K2 float64 // Fraction of the internal diffuse light reflected back by the surface
+}
+
+// SaundersonDefault holds typical coefficients for a glossy paint layer
+// with a refractive index of 1.5, measured with a d/8° geometry.
+var SaundersonDefault = Saunderson{K1: 0.04, K2: 0.6}
+
+// KSRatio computes the Kubelka-Munk absorption/scattering ratio of an opaque
+// layer from its internal reflectance.
+func KSRatio(reflectance SpectralColor) SpectralColor {
+	ks := make(SpectralColor, len(reflectance))
+	for i, r := range reflectance {
+		r = math.Min(math.Max(r, minKMReflectance), 1)
+		ks[i] = (1 - r) * (1 - r) / (2 * r)
+	}
+	return ks
+}
+
+// KSRatioToReflectance computes the internal reflectance of an opaque layer
+// from its Kubelka-Munk absorption/scattering ratio.
+func KSRatioToReflectance(ks SpectralColor) (SpectralColor, error) {
+	r := make(SpectralColor, len(ks))
+	for i, v := range ks {
+		if v < 0 {
+			return nil, fmt.Errorf("K/S ratio is negative at sample %v (%v)", i, v)
+		}
+		r[i] = 1 + v - math.Sqrt(v*v+2*v)
+	}
+	return r, nil
+}
+
+// NewPigment creates a pigment from the internal reflectance of its masstone,
+// using the single-constant Kubelka-Munk model: the scattering is set to 1 and
+// the absorption to the K/S ratio of the masstone.
+func NewPigment(masstone SpectralColor) Pigment {
+	s := make(SpectralColor, len(masstone))
+	for i := range s {
+		s[i] = 1
+	}
+	return Pigment{K: KSRatio(masstone), S: s}
+}
+
+// NewPigmentFromTint creates a pigment using the two-constant Kubelka-Munk model,
+// from the internal reflectances of its masstone and of a tint with a white pigment.
+// The concentration is the fraction of the pigment in the tint, in the ]0, 1[ range.
+func NewPigmentFromTint(masstone, tint SpectralColor, white Pigment, concentration float64) (Pigment, error) {
+	if concentration <= 0 || concentration >= 1 {
+		return Pigment{}, fmt.Errorf("concentration is out of the ]0, 1[ range (%v)", concentration)
+	}
+	if len(masstone) != len(tint) || len(masstone) != len(white.K) || len(masstone) != len(white.S) {
+		return Pigment{}, errors.New("mismatching spectral sampling length")
+	}
+
+	ksMasstone := KSRatio(masstone)
+	ksTint := KSRatio(tint)
+
+	p := Pigment{
+		K: make(SpectralColor, len(masstone)),
+		S: make(SpectralColor, len(masstone)),
+	}
+	for i := range masstone {
+		// The tint K/S ratio is (c·K + (1-c)·Kw) / (c·S + (1-c)·Sw) with K = S·(K/S)masstone.
+		d := concentration * (ksTint[i] - ksMasstone[i])
+		if d == 0 {
+			return Pigment{}, fmt.Errorf("tint and masstone cannot be told apart at sample %v", i)
+		}
+		s := (1 - concentration) * (white.K[i] - ksTint[i]*white.S[i]) / d
+		if s < 0 {
+			return Pigment{}, fmt.Errorf("inconsistent tint and masstone at sample %v", i)
+		}
+		p.S[i] = s
+		p.K[i] = s * ksMasstone[i]
+	}
+
+	return p, nil
+}
+
+// MixPigments blends pigments according to their concentrations. The absorption
+// and scattering coefficients of the mixture are the concentration-weighted sums
+// of the ones of its components.
+func MixPigments(pigments []Pigment, concentrations []float64) (Pigment, error) {
+	if len(pigments) == 0 {
+		return Pigment{}, errors.New("no pigment to mix")
+	}
+	if len(pigments) != len(concentrations) {
+		return Pigment{}, errors.New("mismatching number of pigments and concentrations")
+	}
+
+	n := len(pigments[0].K)
+	mix := Pigment{
+		K: make(SpectralColor, n),
+		S: make(SpectralColor, n),
+	}
+	for j, p := range pigments {
+		c := concentrations[j]
+		if c < 0 {
+			return Pigment{}, fmt.Errorf("concentration of pigment %v is negative (%v)", j, c)
+		}
+		if len(p.K) != n || len(p.S) != n {
+			return Pigment{}, errors.New("mismatching spectral sampling length")
+		}
+		for i := 0; i < n; i++ {
+			mix.K[i] += c * p.K[i]
+			mix.S[i] += c * p.S[i]
+		}
+	}
+
+	return mix, nil
+}
+
+// Reflectance computes the internal reflectance of a layer of pigment with the
+// given thickness, applied on a substrate. An infinite thickness gives the
+// reflectance of an opaque layer, in which case the substrate can be nil.
+func (p Pigment) Reflectance(thickness float64, substrate SpectralColor) (SpectralColor, error) {
+	if thickness < 0 {
+		return nil, fmt.Errorf("thickness is negative (%v)", thickness)
+	}
+	if len(p.K) != len(p.S) {
+		return nil, errors.New("mismatching spectral sampling length")
+	}
+
+	opaque := math.IsInf(thickness, 1)
+	if !opaque && len(substrate) != len(p.K) {
+		return nil, errors.New("mismatching spectral sampling length")
+	}
+
+	r := make(SpectralColor, len(p.K))
+	for i := range r {
+		k, s := p.K[i], p.S[i]
+		if k < 0 || s < 0 {
+			return nil, fmt.Errorf("negative absorption or scattering at sample %v", i)
+		}
+
+		if opaque {
+			if s == 0 {
+				r[i] = 0
+				continue
+			}
+			ks := k / s
+			r[i] = 1 + ks - math.Sqrt(ks*ks+2*ks)
+			continue
+		}
+
+		rg := substrate[i]
+		switch {
+		case thickness == 0:
+			r[i] = rg
+		case s == 0:
+			// Pure absorber: Beer-Lambert attenuation, going through the layer twice.
+			r[i] = rg * math.Exp(-2*k*thickness)
+		case k == 0:
+			// Pure scatterer.
+			r[i] = (s*thickness*(1-rg) + rg) / (s*thickness*(1-rg) + 1)
+		default:
+			a := 1 + k/s
+			b := math.Sqrt(a*a - 1)
+			coth := 1 / math.Tanh(b*s*thickness)
+			r[i] = (1 - rg*(a-b*coth)) / (a - rg + b*coth)
+		}
+	}
+
+	return r, nil
+}
+
+// Measured adds the surface reflection to an internal reflectance, giving the
+// reflectance as measured by a spectrophotometer.
+func (c Saunderson) Measured(internal SpectralColor) SpectralColor {
+	r := make(SpectralColor, len(internal))
+	for i, v := range internal {
+		r[i] = c.K1 + (1-c.K1)*(1-c.K2)*v/(1-c.K2*v)
+	}
+	return r
+}
+
+// Internal removes the surface reflection from a measured reflectance, giving
+// the internal reflectance used by the Kubelka-Munk model.
+func (c Saunderson) Internal(measured SpectralColor) SpectralColor {
+	r := make(SpectralColor, len(measured))
+	for i, v := range measured {
+		r[i] = math.Max((v-c.K1)/(1-c.K1-c.K2+c.K2*v), 0)
+	}
+	return r
+}
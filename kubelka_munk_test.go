@@ -0,0 +1,150 @@
+// Copyright © 2019 Xavier Basty <xavier@hexbee.net>
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package gocolor_test
+
+import (
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/Hexbee-net/gocolor"
+)
+
+func flatSpectrum(v float64) gocolor.SpectralColor {
+	s := make(gocolor.SpectralColor, 50)
+	for i := range s {
+		s[i] = v
+	}
+	return s
+}
+
+func TestKSRatio(t *testing.T) {
+	tests := []ConversionTest{
+		{from: []float64{1.00}, to: []float64{0.00000000}},
+		{from: []float64{0.50}, to: []float64{0.25000000}},
+		{from: []float64{0.25}, to: []float64{1.12500000}},
+		{from: []float64{0.10}, to: []float64{4.05000000}},
+	}
+
+	for n := 0; n < len(tests); n++ {
+		ks := gocolor.KSRatio(gocolor.SpectralColor(tests[n].from))
+		assert.InDeltaf(t, tests[n].to[0], ks[0], precision, "K/S is wrong for test #%v", n+1)
+
+		r, err := gocolor.KSRatioToReflectance(ks)
+		assert.NoError(t, err)
+		assert.InDeltaf(t, tests[n].from[0], r[0], precision, "reflectance is wrong for test #%v", n+1)
+	}
+
+	_, err := gocolor.KSRatioToReflectance(gocolor.SpectralColor{-1})
+	assert.Errorf(t, err, "negative K/S ratio should return an error")
+}
+
+func TestMixPigments_InvalidParameters(t *testing.T) {
+	p := gocolor.NewPigment(flatSpectrum(0.5))
+
+	_, err := gocolor.MixPigments(nil, nil)
+	assert.Errorf(t, err, "empty mixture should return an error")
+
+	_, err = gocolor.MixPigments([]gocolor.Pigment{p, p}, []float64{1})
+	assert.Errorf(t, err, "mismatching concentrations should return an error")
+
+	_, err = gocolor.MixPigments([]gocolor.Pigment{p, p}, []float64{1, -1})
+	assert.Errorf(t, err, "negative concentration should return an error")
+}
+
+func TestMixPigments(t *testing.T) {
+	white := gocolor.NewPigment(flatSpectrum(0.9))
+	black := gocolor.NewPigment(flatSpectrum(0.05))
+
+	// A pigment mixed with itself keeps its reflectance.
+	mix, err := gocolor.MixPigments([]gocolor.Pigment{white, white}, []float64{0.5, 0.5})
+	assert.NoError(t, err)
+	r, err := mix.Reflectance(math.Inf(1), nil)
+	assert.NoError(t, err)
+	assert.InDelta(t, 0.9, r[25], precision)
+
+	// K/S ratios of single-constant pigments mix linearly.
+	mix, err = gocolor.MixPigments([]gocolor.Pigment{white, black}, []float64{0.75, 0.25})
+	assert.NoError(t, err)
+	r, err = mix.Reflectance(math.Inf(1), nil)
+	assert.NoError(t, err)
+	ks := 0.75*gocolor.KSRatio(flatSpectrum(0.9))[0] + 0.25*gocolor.KSRatio(flatSpectrum(0.05))[0]
+	assert.InDelta(t, 1+ks-math.Sqrt(ks*ks+2*ks), r[25], precision)
+}
+
+func TestNewPigmentFromTint(t *testing.T) {
+	white := gocolor.NewPigment(flatSpectrum(0.9))
+	pigment := gocolor.Pigment{K: flatSpectrum(2), S: flatSpectrum(0.5)}
+
+	masstone, err := pigment.Reflectance(math.Inf(1), nil)
+	assert.NoError(t, err)
+	mix, err := gocolor.MixPigments([]gocolor.Pigment{pigment, white}, []float64{0.2, 0.8})
+	assert.NoError(t, err)
+	tint, err := mix.Reflectance(math.Inf(1), nil)
+	assert.NoError(t, err)
+
+	p, err := gocolor.NewPigmentFromTint(masstone, tint, white, 0.2)
+	assert.NoError(t, err)
+	assert.InDelta(t, 2, p.K[10], 1e-6)
+	assert.InDelta(t, 0.5, p.S[10], 1e-6)
+
+	_, err = gocolor.NewPigmentFromTint(masstone, tint, white, 1)
+	assert.Errorf(t, err, "invalid concentration should return an error")
+}
+
+func TestPigment_Reflectance(t *testing.T) {
+	p := gocolor.Pigment{K: flatSpectrum(0.3), S: flatSpectrum(2)}
+	substrate := flatSpectrum(0.2)
+
+	opaque, err := p.Reflectance(math.Inf(1), nil)
+	assert.NoError(t, err)
+
+	r, err := p.Reflectance(0, substrate)
+	assert.NoError(t, err)
+	assert.InDelta(t, 0.2, r[0], precision, "a layer without thickness should show the substrate")
+
+	r, err = p.Reflectance(100, substrate)
+	assert.NoError(t, err)
+	assert.InDelta(t, opaque[0], r[0], precision, "a thick layer should be opaque")
+
+	r, err = p.Reflectance(0.5, substrate)
+	assert.NoError(t, err)
+	assert.True(t, r[0] > 0.2 && r[0] < opaque[0], "a thin layer should be between the substrate and the masstone")
+
+	absorber := gocolor.Pigment{K: flatSpectrum(1), S: flatSpectrum(0)}
+	r, err = absorber.Reflectance(0.5, substrate)
+	assert.NoError(t, err)
+	assert.InDelta(t, 0.2*math.Exp(-1), r[0], precision)
+
+	_, err = p.Reflectance(-1, substrate)
+	assert.Errorf(t, err, "negative thickness should return an error")
+
+	_, err = p.Reflectance(1, substrate[1:])
+	assert.Errorf(t, err, "mismatching substrate should return an error")
+}
+
+func TestSaunderson(t *testing.T) {
+	internal := gocolor.SpectralColor{0, 0.1, 0.5, 0.9, 1}
+
+	measured := gocolor.SaundersonDefault.Measured(internal)
+	assert.InDelta(t, gocolor.SaundersonDefault.K1, measured[0], precision)
+	assert.InDelta(t, 1, measured[4], precision)
+
+	r := gocolor.SaundersonDefault.Internal(measured)
+	for i := range internal {
+		assert.InDeltaf(t, internal[i], r[i], precision, "reflectance is wrong for sample #%v", i)
+	}
+}
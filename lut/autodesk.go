@@ -0,0 +1,208 @@
+// Copyright © 2019 Xavier Basty <xavier@hexbee.net>
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package lut
+
+import (
+	"bufio"
+	"errors"
+	"fmt"
+	"io"
+	"math"
+	"strconv"
+	"strings"
+)
+
+// Bit depths of the .3dl files written by Encode3DL.
+const (
+	autodeskInputBits  = 10
+	autodeskOutputBits = 12
+)
+
+// Decode3DL reads an Autodesk .3dl LUT, as written by Lustre, Flame or Nuke.
+//
+// The output bit depth is given by the Lustre "Mesh" line when present.
+// Otherwise, it is the smallest of 8, 10, 12, 14 or 16 bits holding the
+// largest value of the table. Input grid positions which are not evenly
+// spaced are turned into a shaper.
+func Decode3DL(r io.Reader) (*LUT, error) {
+	var header []int
+	var values [][3]int
+	outBits := 0
+
+	scanner := bufio.NewScanner(r)
+	line := 0
+	for scanner.Scan() {
+		line++
+		text := strings.TrimSpace(scanner.Text())
+		if text == "" || text[0] == '#' {
+			continue
+		}
+
+		fields := strings.Fields(text)
+		switch {
+		case fields[0] == "3DMESH":
+			continue
+		case fields[0] == "Mesh":
+			if len(fields) != 3 {
+				return nil, fmt.Errorf("line %v: invalid Mesh", line)
+			}
+			b, err := strconv.Atoi(fields[2])
+			if err != nil || b < 1 || b > 32 {
+				return nil, fmt.Errorf("line %v: invalid output bit depth: %v", line, fields[2])
+			}
+			outBits = b
+			continue
+		case fields[0] == "LUT8" || fields[0] == "gamma":
+			// Lustre trailers.
+			continue
+		}
+
+		ints := make([]int, len(fields))
+		for i, f := range fields {
+			v, err := strconv.Atoi(f)
+			if err != nil || v < 0 {
+				return nil, fmt.Errorf("line %v: invalid value: %v", line, f)
+			}
+			ints[i] = v
+		}
+
+		switch {
+		case len(ints) == 3 && (header != nil || len(values) > 0):
+			values = append(values, [3]int{ints[0], ints[1], ints[2]})
+		case header == nil && len(values) == 0:
+			header = ints
+		default:
+			return nil, fmt.Errorf("line %v: expected 3 values, got %v", line, len(ints))
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+
+	// A three value first line without any other line is a table entry.
+	if len(header) == 3 && len(values) == 0 {
+		values, header = [][3]int{{header[0], header[1], header[2]}}, nil
+	}
+
+	size := len(header)
+	if header == nil {
+		size = int(math.Round(math.Cbrt(float64(len(values)))))
+	}
+	if size < 2 {
+		return nil, fmt.Errorf("3D table size must be at least 2 (%v)", size)
+	}
+	if len(values) != size*size*size {
+		return nil, fmt.Errorf("LUT has %v values, expected %v", len(values), size*size*size)
+	}
+
+	if outBits == 0 {
+		max := 0
+		for _, v := range values {
+			for _, c := range v {
+				if c > max {
+					max = c
+				}
+			}
+		}
+		for outBits = 8; outBits < 16 && max > 1<<uint(outBits)-1; outBits += 2 {
+		}
+	}
+	scale := float64(int(1)<<uint(outBits) - 1)
+
+	l := &LUT{
+		Size:      size,
+		DomainMax: [3]float64{1, 1, 1},
+		Table:     make([][3]float64, len(values)),
+	}
+
+	// The blue coordinate varies the fastest in .3dl files.
+	for i, v := range values {
+		r, g, b := i/(size*size), i/size%size, i%size
+		l.Table[r+size*(g+size*b)] = [3]float64{float64(v[0]) / scale, float64(v[1]) / scale, float64(v[2]) / scale}
+	}
+
+	if header != nil {
+		in := make([]float64, size)
+		max := float64(header[size-1])
+		for i, v := range header {
+			if i > 0 && v <= header[i-1] {
+				return nil, errors.New("input grid positions are not increasing")
+			}
+			in[i] = float64(v) / max
+		}
+		if c := (Curve{In: in}); !c.isUniform() || in[0] != 0 {
+			out := make([]float64, size)
+			for i := range out {
+				out[i] = float64(i) / float64(size-1)
+			}
+			for ch := range l.Shaper {
+				l.Shaper[ch] = Curve{In: in, Out: out}
+			}
+		}
+	}
+
+	return l, nil
+}
+
+// Encode3DL writes a 3D LUT in the .3dl format, with 10 bits input grid
+// positions and 12 bits output values. Output values are clamped to the
+// [0, 1] range.
+//
+// The LUT must not have a shaper, and its domain must be the [0, 1] range.
+func Encode3DL(w io.Writer, l *LUT) error {
+	if err := l.check(); err != nil {
+		return err
+	}
+	if l.Size == 0 {
+		return errors.New("the .3dl format only supports 3D LUTs")
+	}
+	for c := range l.Shaper {
+		if len(l.Shaper[c].In) != 0 {
+			return errors.New("the .3dl format does not support shapers")
+		}
+		if l.DomainMin[c] != 0 || l.DomainMax[c] != 1 {
+			return errors.New("the .3dl format only supports the [0, 1] domain")
+		}
+	}
+
+	b := bufio.NewWriter(w)
+	if l.Title != "" {
+		fmt.Fprintf(b, "# %v\n", l.Title)
+	}
+
+	inMax := float64(int(1)<<autodeskInputBits - 1)
+	for i := 0; i < l.Size; i++ {
+		if i > 0 {
+			b.WriteByte(' ')
+		}
+		b.WriteString(strconv.Itoa(int(math.Round(float64(i) * inMax / float64(l.Size-1)))))
+	}
+	b.WriteByte('\n')
+
+	outMax := float64(int(1)<<autodeskOutputBits - 1)
+	quantize := func(v float64) int {
+		return int(math.Round(math.Max(0, math.Min(1, v)) * outMax))
+	}
+	for r := 0; r < l.Size; r++ {
+		for g := 0; g < l.Size; g++ {
+			for bl := 0; bl < l.Size; bl++ {
+				v := l.at(r, g, bl)
+				fmt.Fprintf(b, "%v %v %v\n", quantize(v[0]), quantize(v[1]), quantize(v[2]))
+			}
+		}
+	}
+
+	return b.Flush()
+}
@@ -0,0 +1,92 @@
+// Copyright © 2019 Xavier Basty <xavier@hexbee.net>
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package lut_test
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/Hexbee-net/gocolor/lut"
+)
+
+func TestDecode3DL(t *testing.T) {
+	// Swap red and blue, with 12 bits outputs.
+	l, err := lut.Decode3DL(strings.NewReader(`# swap
+0 1023
+0 0 0
+4095 0 0
+0 4095 0
+4095 4095 0
+0 0 4095
+4095 0 4095
+0 4095 4095
+4095 4095 4095
+`))
+	require.NoError(t, err)
+	assert.Equal(t, 2, l.Size)
+
+	r, g, b, err := l.Apply(0.2, 0.5, 0.8, lut.Tetrahedral)
+
+	require.NoError(t, err)
+	assert.InDelta(t, 0.8, r, 1e-12)
+	assert.InDelta(t, 0.5, g, 1e-12)
+	assert.InDelta(t, 0.2, b, 1e-12)
+
+	// Lustre files give the output bit depth.
+	l, err = lut.Decode3DL(strings.NewReader("3DMESH\nMesh 1 10\n0 1023\n" + strings.Repeat("1023 0 0\n", 8)))
+	require.NoError(t, err)
+	assert.Equal(t, [3]float64{1, 0, 0}, l.Table[0])
+
+	// Non uniform grids become shapers.
+	l, err = lut.Decode3DL(strings.NewReader("0 256 1023\n" + strings.Repeat("0 0 0\n", 27)))
+	require.NoError(t, err)
+	assert.InDelta(t, 0.5, l.Shaper[0].Eval(256.0/1023), 1e-12)
+}
+
+func TestDecode3DLErrors(t *testing.T) {
+	tests := map[string]string{
+		"bad value":     "0 1023\n0 0 x\n",
+		"short line":    "0 1023\n0 0\n",
+		"missing lines": "0 1023\n0 0 0\n",
+		"bad mesh":      "Mesh 4\n",
+	}
+	for name, data := range tests {
+		_, err := lut.Decode3DL(strings.NewReader(data))
+		assert.Error(t, err, name)
+	}
+}
+
+func TestEncode3DL(t *testing.T) {
+	l := invert(t, 17)
+
+	var buf bytes.Buffer
+	require.NoError(t, lut.Encode3DL(&buf, l))
+	assert.True(t, strings.HasPrefix(buf.String(), "0 64 128 192 256 320 384 448 512 575 639 703 767 831 895 959 1023\n4095 4095 4095\n4095 4095 3839\n"))
+
+	decoded, err := lut.Decode3DL(&buf)
+	require.NoError(t, err)
+	assert.Equal(t, 17, decoded.Size)
+	for i := range l.Table {
+		assert.InDeltaSlice(t, l.Table[i][:], decoded.Table[i][:], 0.5/4095)
+	}
+
+	l.DomainMax[0] = 2
+	assert.Error(t, lut.Encode3DL(&buf, l))
+	assert.Error(t, lut.Encode3DL(&buf, &lut.LUT{}))
+}
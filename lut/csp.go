@@ -0,0 +1,227 @@
+// Copyright © 2019 Xavier Basty <xavier@hexbee.net>
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package lut
+
+import (
+	"bufio"
+	"errors"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+)
+
+// DecodeCSP reads a Rising Sun Research .csp LUT, holding per channel
+// pre-LUTs and an optional 3D table. The 3D table must have the same size
+// along each axis.
+func DecodeCSP(r io.Reader) (*LUT, error) {
+	p := cspParser{scanner: bufio.NewScanner(r)}
+
+	if fields := p.next(); len(fields) != 1 || fields[0] != "CSPLUTV100" {
+		return nil, p.errorf("missing CSPLUTV100 header")
+	}
+	kind := p.next()
+	if len(kind) != 1 || (kind[0] != "1D" && kind[0] != "3D") {
+		return nil, p.errorf("invalid LUT type, expected 1D or 3D")
+	}
+
+	l := &LUT{DomainMax: [3]float64{1, 1, 1}}
+
+	fields := p.next()
+	if len(fields) == 2 && fields[0] == "BEGIN" && fields[1] == "METADATA" {
+		for {
+			line, ok := p.rawLine()
+			if !ok {
+				return nil, p.errorf("missing END METADATA")
+			}
+			if line == "END METADATA" {
+				break
+			}
+			if l.Title == "" && line != "" {
+				l.Title = line
+			}
+		}
+		fields = p.next()
+	}
+
+	for c := range l.Shaper {
+		if c > 0 {
+			fields = p.next()
+		}
+		n, err := parseCSPCount(fields)
+		if err != nil {
+			return nil, p.errorf("%v", err)
+		}
+		in, err := p.floats(n)
+		if err != nil {
+			return nil, err
+		}
+		out, err := p.floats(n)
+		if err != nil {
+			return nil, err
+		}
+		for i := 1; i < n; i++ {
+			if in[i] <= in[i-1] {
+				return nil, p.errorf("pre-LUT input values are not increasing")
+			}
+		}
+		l.Shaper[c] = Curve{In: in, Out: out}
+	}
+
+	if kind[0] == "1D" {
+		return l, p.scanner.Err()
+	}
+
+	fields = p.next()
+	if len(fields) != 3 || fields[0] != fields[1] || fields[1] != fields[2] {
+		return nil, p.errorf("3D table sizes must be identical")
+	}
+	size, err := strconv.Atoi(fields[0])
+	if err != nil || size < 2 || size > 256 {
+		return nil, p.errorf("3D table size is out of the [2, 256] range (%v)", fields[0])
+	}
+
+	l.Size = size
+	l.Table = make([][3]float64, size*size*size)
+	for i := range l.Table {
+		fields := p.next()
+		if fields == nil {
+			return nil, p.errorf("LUT has %v values, expected %v", i, len(l.Table))
+		}
+		if l.Table[i], err = parseFloats(fields); err != nil {
+			return nil, p.errorf("%v", err)
+		}
+	}
+
+	return l, p.scanner.Err()
+}
+
+// EncodeCSP writes a LUT in the .csp format. The domain of the 3D table is
+// folded into the pre-LUTs.
+func EncodeCSP(w io.Writer, l *LUT) error {
+	if err := l.check(); err != nil {
+		return err
+	}
+
+	b := bufio.NewWriter(w)
+	b.WriteString("CSPLUTV100\n")
+	if l.Size == 0 {
+		b.WriteString("1D\n\n")
+	} else {
+		b.WriteString("3D\n\n")
+	}
+	if l.Title != "" {
+		fmt.Fprintf(b, "BEGIN METADATA\n%v\nEND METADATA\n\n", l.Title)
+	}
+
+	for c, s := range l.Shaper {
+		if len(s.In) == 0 {
+			s = Curve{In: []float64{0, 1}, Out: []float64{0, 1}}
+			if l.Size > 0 {
+				s.In = []float64{l.DomainMin[c], l.DomainMax[c]}
+			}
+		}
+		out := s.Out
+		if l.Size > 0 && len(l.Shaper[c].In) != 0 {
+			out = make([]float64, len(s.Out))
+			for i, v := range s.Out {
+				out[i] = (v - l.DomainMin[c]) / (l.DomainMax[c] - l.DomainMin[c])
+			}
+		}
+
+		fmt.Fprintf(b, "%v\n", len(s.In))
+		writeFloats(b, s.In)
+		writeFloats(b, out)
+	}
+
+	if l.Size > 0 {
+		fmt.Fprintf(b, "\n%v %v %v\n", l.Size, l.Size, l.Size)
+		for _, v := range l.Table {
+			writeTriplet(b, "", v)
+		}
+	}
+
+	return b.Flush()
+}
+
+func writeFloats(w *bufio.Writer, values []float64) {
+	for i, v := range values {
+		if i > 0 {
+			w.WriteByte(' ')
+		}
+		w.WriteString(formatFloat(v))
+	}
+	w.WriteByte('\n')
+}
+
+func parseCSPCount(fields []string) (int, error) {
+	if len(fields) != 1 {
+		return 0, errors.New("missing pre-LUT size")
+	}
+	n, err := strconv.Atoi(fields[0])
+	if err != nil || n < 2 {
+		return 0, fmt.Errorf("invalid pre-LUT size: %v", fields[0])
+	}
+	return n, nil
+}
+
+////////////////////////////////////////
+
+// cspParser reads the non empty lines of a .csp file.
+type cspParser struct {
+	scanner *bufio.Scanner
+	line    int
+}
+
+func (p *cspParser) rawLine() (string, bool) {
+	if !p.scanner.Scan() {
+		return "", false
+	}
+	p.line++
+	return strings.TrimSpace(p.scanner.Text()), true
+}
+
+// next returns the fields of the next non empty line, or nil at the end of the
+// file.
+func (p *cspParser) next() []string {
+	for {
+		line, ok := p.rawLine()
+		if !ok {
+			return nil
+		}
+		if line != "" {
+			return strings.Fields(line)
+		}
+	}
+}
+
+func (p *cspParser) floats(n int) ([]float64, error) {
+	fields := p.next()
+	if len(fields) != n {
+		return nil, p.errorf("expected %v values, got %v", n, len(fields))
+	}
+	values := make([]float64, n)
+	for i, f := range fields {
+		var err error
+		if values[i], err = strconv.ParseFloat(f, 64); err != nil {
+			return nil, p.errorf("invalid number: %v", f)
+		}
+	}
+	return values, nil
+}
+
+func (p *cspParser) errorf(format string, args ...interface{}) error {
+	return fmt.Errorf("line %v: %v", p.line, fmt.Sprintf(format, args...))
+}
@@ -0,0 +1,125 @@
+// Copyright © 2019 Xavier Basty <xavier@hexbee.net>
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package lut_test
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/Hexbee-net/gocolor/lut"
+)
+
+func TestDecodeCSP(t *testing.T) {
+	l, err := lut.DecodeCSP(strings.NewReader(`CSPLUTV100
+3D
+
+BEGIN METADATA
+invert
+END METADATA
+
+2
+0.0 4.0
+0.0 1.0
+2
+0.0 1.0
+0.0 1.0
+3
+0.0 0.5 1.0
+0.0 0.25 1.0
+
+2 2 2
+1 1 1
+0 1 1
+1 0 1
+0 0 1
+1 1 0
+0 1 0
+1 0 0
+0 0 0
+`))
+	require.NoError(t, err)
+	assert.Equal(t, "invert", l.Title)
+	assert.Equal(t, 2, l.Size)
+
+	r, g, b, err := l.Apply(1, 0.25, 0.5, lut.Trilinear)
+
+	require.NoError(t, err)
+	assert.InDelta(t, 0.75, r, 1e-12)
+	assert.InDelta(t, 0.75, g, 1e-12)
+	assert.InDelta(t, 0.75, b, 1e-12)
+
+	l, err = lut.DecodeCSP(strings.NewReader("CSPLUTV100\n1D\n2\n0 1\n1 0\n2\n0 1\n0 1\n2\n0 1\n0 1\n"))
+	require.NoError(t, err)
+	assert.Equal(t, 0, l.Size)
+	r, _, _, err = l.Apply(0.25, 0, 0, lut.Trilinear)
+	require.NoError(t, err)
+	assert.InDelta(t, 0.75, r, 1e-12)
+}
+
+func TestDecodeCSPErrors(t *testing.T) {
+	tests := map[string]string{
+		"no header":       "3D\n",
+		"bad type":        "CSPLUTV100\n2D\n",
+		"short pre-LUT":   "CSPLUTV100\n1D\n3\n0 1\n0 1\n",
+		"decreasing":      "CSPLUTV100\n1D\n2\n1 0\n0 1\n2\n0 1\n0 1\n2\n0 1\n0 1\n",
+		"uneven sizes":    "CSPLUTV100\n3D\n2\n0 1\n0 1\n2\n0 1\n0 1\n2\n0 1\n0 1\n2 2 3\n",
+		"missing values":  "CSPLUTV100\n3D\n2\n0 1\n0 1\n2\n0 1\n0 1\n2\n0 1\n0 1\n2 2 2\n0 0 0\n",
+		"no metadata end": "CSPLUTV100\n3D\nBEGIN METADATA\n",
+	}
+	for name, data := range tests {
+		_, err := lut.DecodeCSP(strings.NewReader(data))
+		assert.Error(t, err, name)
+	}
+
+	_, err := lut.DecodeCSP(strings.NewReader("CSPLUTV100\n1D\n\n2\n0 1\n0 x\n"))
+	assert.EqualError(t, err, "line 6: invalid number: x")
+}
+
+func TestEncodeCSP(t *testing.T) {
+	l := invert(t, 9)
+	l.Title = "invert"
+	l.DomainMax = [3]float64{2, 2, 2}
+	l.Shaper[1] = lut.Curve{In: []float64{0, 0.5, 1}, Out: []float64{0, 1, 2}}
+
+	var buf bytes.Buffer
+	require.NoError(t, lut.EncodeCSP(&buf, l))
+	decoded, err := lut.DecodeCSP(&buf)
+	require.NoError(t, err)
+	assert.Equal(t, "invert", decoded.Title)
+
+	for _, v := range [][3]float64{{0, 0, 0}, {0.3, 0.2, 0.9}, {1.5, 0.7, 2}} {
+		er, eg, eb, err := l.Apply(v[0], v[1], v[2], lut.Tetrahedral)
+		require.NoError(t, err)
+		ar, ag, ab, err := decoded.Apply(v[0], v[1], v[2], lut.Tetrahedral)
+		require.NoError(t, err)
+		assert.InDeltaSlice(t, []float64{er, eg, eb}, []float64{ar, ag, ab}, 1e-6)
+	}
+
+	// All formats carry the same 3D table.
+	var cube bytes.Buffer
+	l = invert(t, 9)
+	require.NoError(t, lut.EncodeCube(&cube, l))
+	fromCube, err := lut.DecodeCube(&cube)
+	require.NoError(t, err)
+	buf.Reset()
+	require.NoError(t, lut.EncodeCSP(&buf, fromCube))
+	decoded, err = lut.DecodeCSP(&buf)
+	require.NoError(t, err)
+	assert.Equal(t, l.Table, decoded.Table)
+}
@@ -0,0 +1,247 @@
+// Copyright © 2019 Xavier Basty <xavier@hexbee.net>
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package lut
+
+import (
+	"bufio"
+	"errors"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+)
+
+// DecodeCube reads a Resolve or Adobe .cube LUT.
+//
+// The file holds a 1D LUT, a 3D LUT, or a 1D shaper LUT followed by a 3D LUT.
+// The input domain is given by the DOMAIN_MIN and DOMAIN_MAX keywords, or by
+// the LUT_1D_INPUT_RANGE and LUT_3D_INPUT_RANGE keywords of Resolve.
+func DecodeCube(r io.Reader) (*LUT, error) {
+	l := &LUT{DomainMax: [3]float64{1, 1, 1}}
+	size1D := 0
+	min1D, max1D := [3]float64{}, [3]float64{1, 1, 1}
+	var values [][3]float64
+
+	scanner := bufio.NewScanner(r)
+	line := 0
+	for scanner.Scan() {
+		line++
+		text := strings.TrimSpace(scanner.Text())
+		if text == "" || text[0] == '#' {
+			continue
+		}
+
+		fields := strings.Fields(text)
+		keyword := fields[0]
+		var err error
+		switch keyword {
+		case "TITLE":
+			l.Title = strings.Trim(strings.TrimSpace(text[len(keyword):]), `"`)
+		case "LUT_1D_SIZE":
+			size1D, err = parseCubeSize(fields, 2, 65536)
+		case "LUT_3D_SIZE":
+			l.Size, err = parseCubeSize(fields, 2, 256)
+		case "DOMAIN_MIN", "DOMAIN_MAX":
+			var v [3]float64
+			if v, err = parseFloats(fields[1:]); err == nil {
+				if keyword == "DOMAIN_MIN" {
+					min1D, l.DomainMin = v, v
+				} else {
+					max1D, l.DomainMax = v, v
+				}
+			}
+		case "LUT_1D_INPUT_RANGE", "LUT_3D_INPUT_RANGE":
+			var min, max float64
+			if min, max, err = parseRange(fields[1:]); err == nil {
+				if keyword == "LUT_1D_INPUT_RANGE" {
+					min1D, max1D = [3]float64{min, min, min}, [3]float64{max, max, max}
+				} else {
+					l.DomainMin, l.DomainMax = [3]float64{min, min, min}, [3]float64{max, max, max}
+				}
+			}
+		default:
+			if !isNumber(keyword) {
+				err = fmt.Errorf("unrecognized keyword: %v", keyword)
+				break
+			}
+			var v [3]float64
+			if v, err = parseFloats(fields); err == nil {
+				values = append(values, v)
+			}
+		}
+		if err != nil {
+			return nil, fmt.Errorf("line %v: %v", line, err)
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+
+	if size1D == 0 && l.Size == 0 {
+		return nil, errors.New("missing LUT_1D_SIZE or LUT_3D_SIZE")
+	}
+	if expected := size1D + l.Size*l.Size*l.Size; len(values) != expected {
+		return nil, fmt.Errorf("LUT has %v values, expected %v", len(values), expected)
+	}
+	for c := range min1D {
+		if min1D[c] >= max1D[c] || l.DomainMin[c] >= l.DomainMax[c] {
+			return nil, fmt.Errorf("empty input domain for channel %v", c)
+		}
+	}
+
+	if size1D > 0 {
+		for c := range l.Shaper {
+			out := make([]float64, size1D)
+			for i := range out {
+				out[i] = values[i][c]
+			}
+			l.Shaper[c] = uniformCurve(min1D[c], max1D[c], out)
+		}
+	}
+	if l.Size > 0 {
+		l.Table = values[size1D:]
+	}
+
+	return l, nil
+}
+
+// EncodeCube writes a LUT in the .cube format.
+//
+// The curves of the shaper must have evenly spaced input values and the same
+// number of points. A LUT with both a shaper and a 3D table is written in the
+// Resolve format, which is not supported by all applications.
+func EncodeCube(w io.Writer, l *LUT) error {
+	if err := l.check(); err != nil {
+		return err
+	}
+
+	size1D := len(l.Shaper[0].In)
+	for _, c := range l.Shaper {
+		if len(c.In) != size1D {
+			return errors.New("shaper curves have different sizes")
+		}
+		if size1D > 0 && !c.isUniform() {
+			return errors.New("shaper curve input values are not evenly spaced")
+		}
+	}
+	if size1D == 0 && l.Size == 0 {
+		return errors.New("empty LUT")
+	}
+
+	b := bufio.NewWriter(w)
+	if l.Title != "" {
+		fmt.Fprintf(b, "TITLE \"%v\"\n", l.Title)
+	}
+
+	switch {
+	case l.Size == 0:
+		fmt.Fprintf(b, "LUT_1D_SIZE %v\n", size1D)
+		writeTriplet(b, "DOMAIN_MIN", shaperBound(l, 0))
+		writeTriplet(b, "DOMAIN_MAX", shaperBound(l, size1D-1))
+	case size1D == 0:
+		fmt.Fprintf(b, "LUT_3D_SIZE %v\n", l.Size)
+		writeTriplet(b, "DOMAIN_MIN", l.DomainMin)
+		writeTriplet(b, "DOMAIN_MAX", l.DomainMax)
+	default:
+		min, max := shaperBound(l, 0), shaperBound(l, size1D-1)
+		if !sameValues(min) || !sameValues(max) || !sameValues(l.DomainMin) || !sameValues(l.DomainMax) {
+			return errors.New("input ranges must be the same for all channels")
+		}
+		fmt.Fprintf(b, "LUT_1D_SIZE %v\n", size1D)
+		fmt.Fprintf(b, "LUT_1D_INPUT_RANGE %v %v\n", formatFloat(min[0]), formatFloat(max[0]))
+		fmt.Fprintf(b, "LUT_3D_SIZE %v\n", l.Size)
+		fmt.Fprintf(b, "LUT_3D_INPUT_RANGE %v %v\n", formatFloat(l.DomainMin[0]), formatFloat(l.DomainMax[0]))
+	}
+	b.WriteString("\n")
+
+	for i := 0; i < size1D; i++ {
+		writeTriplet(b, "", [3]float64{l.Shaper[0].Out[i], l.Shaper[1].Out[i], l.Shaper[2].Out[i]})
+	}
+	for _, v := range l.Table {
+		writeTriplet(b, "", v)
+	}
+
+	return b.Flush()
+}
+
+func shaperBound(l *LUT, i int) [3]float64 {
+	return [3]float64{l.Shaper[0].In[i], l.Shaper[1].In[i], l.Shaper[2].In[i]}
+}
+
+func sameValues(v [3]float64) bool {
+	return v[0] == v[1] && v[1] == v[2]
+}
+
+////////////////////////////////////////
+
+func parseCubeSize(fields []string, min, max int) (int, error) {
+	if len(fields) != 2 {
+		return 0, fmt.Errorf("invalid %v", fields[0])
+	}
+	n, err := strconv.Atoi(fields[1])
+	if err != nil {
+		return 0, fmt.Errorf("invalid %v: %v", fields[0], fields[1])
+	}
+	if n < min || n > max {
+		return 0, fmt.Errorf("%v is out of the [%v, %v] range (%v)", fields[0], min, max, n)
+	}
+	return n, nil
+}
+
+func parseRange(fields []string) (float64, float64, error) {
+	if len(fields) != 2 {
+		return 0, 0, fmt.Errorf("expected 2 values, got %v", len(fields))
+	}
+	min, err := strconv.ParseFloat(fields[0], 64)
+	if err != nil {
+		return 0, 0, fmt.Errorf("invalid number: %v", fields[0])
+	}
+	max, err := strconv.ParseFloat(fields[1], 64)
+	if err != nil {
+		return 0, 0, fmt.Errorf("invalid number: %v", fields[1])
+	}
+	return min, max, nil
+}
+
+func parseFloats(fields []string) ([3]float64, error) {
+	var v [3]float64
+	if len(fields) != 3 {
+		return v, fmt.Errorf("expected 3 values, got %v", len(fields))
+	}
+	for i, f := range fields {
+		var err error
+		if v[i], err = strconv.ParseFloat(f, 64); err != nil {
+			return v, fmt.Errorf("invalid number: %v", f)
+		}
+	}
+	return v, nil
+}
+
+func isNumber(s string) bool {
+	_, err := strconv.ParseFloat(s, 64)
+	return err == nil
+}
+
+func formatFloat(v float64) string {
+	return strconv.FormatFloat(v, 'f', 6, 64)
+}
+
+func writeTriplet(w *bufio.Writer, keyword string, v [3]float64) {
+	if keyword != "" {
+		w.WriteString(keyword)
+		w.WriteByte(' ')
+	}
+	fmt.Fprintf(w, "%v %v %v\n", formatFloat(v[0]), formatFloat(v[1]), formatFloat(v[2]))
+}
@@ -0,0 +1,154 @@
+// Copyright © 2019 Xavier Basty <xavier@hexbee.net>
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package lut_test
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/Hexbee-net/gocolor/lut"
+)
+
+// invert returns a 3D LUT inverting the colors.
+func invert(t *testing.T, size int) *lut.LUT {
+	l, err := lut.Bake(size, func(r, g, b float64) (float64, float64, float64, error) {
+		return 1 - r, 1 - g, 1 - b, nil
+	})
+	require.NoError(t, err)
+	return l
+}
+
+func TestDecodeCube3D(t *testing.T) {
+	l, err := lut.DecodeCube(strings.NewReader(`# Swap red and blue, over a doubled domain.
+TITLE "swap"
+LUT_3D_SIZE 2
+DOMAIN_MIN 0 0 0
+DOMAIN_MAX 2 2 2
+
+0 0 0
+0 0 1
+0 1 0
+0 1 1
+1 0 0
+1 0 1
+1 1 0
+1 1 1
+`))
+	require.NoError(t, err)
+	assert.Equal(t, "swap", l.Title)
+	assert.Equal(t, 2, l.Size)
+
+	r, g, b, err := l.Apply(0.2, 1, 1.6, lut.Tetrahedral)
+
+	require.NoError(t, err)
+	assert.InDelta(t, 0.8, r, 1e-12)
+	assert.InDelta(t, 0.5, g, 1e-12)
+	assert.InDelta(t, 0.1, b, 1e-12)
+}
+
+func TestDecodeCube1D(t *testing.T) {
+	l, err := lut.DecodeCube(strings.NewReader(`LUT_1D_SIZE 3
+0 0 1
+0.25 0.5 0.5
+1 1 0
+`))
+	require.NoError(t, err)
+	assert.Equal(t, 0, l.Size)
+
+	r, g, b, err := l.Apply(0.25, 0.5, 0.75, lut.Trilinear)
+
+	require.NoError(t, err)
+	assert.InDelta(t, 0.125, r, 1e-12)
+	assert.InDelta(t, 0.5, g, 1e-12)
+	assert.InDelta(t, 0.25, b, 1e-12)
+}
+
+func TestDecodeCubeShaper(t *testing.T) {
+	l, err := lut.DecodeCube(strings.NewReader(`LUT_1D_SIZE 2
+LUT_1D_INPUT_RANGE 0 4
+LUT_3D_SIZE 2
+0 0 0
+1 1 1
+1 1 1
+0 1 1
+1 0 1
+0 0 1
+1 1 0
+0 1 0
+1 0 0
+0 0 0
+`))
+	require.NoError(t, err)
+
+	// The shaper maps [0, 4] to [0, 1], then the table inverts the colors.
+	r, g, b, err := l.Apply(1, 2, 3, lut.Trilinear)
+	require.NoError(t, err)
+	assert.InDelta(t, 0.75, r, 1e-12)
+	assert.InDelta(t, 0.5, g, 1e-12)
+	assert.InDelta(t, 0.25, b, 1e-12)
+}
+
+func TestDecodeCubeErrors(t *testing.T) {
+	tests := map[string]string{
+		"no size":       "0 0 0\n",
+		"bad size":      "LUT_3D_SIZE 1\n",
+		"bad keyword":   "LUT_3D_SIZE 2\nFOO 1\n",
+		"bad value":     "LUT_1D_SIZE 2\n0 0 0\n0 x 0\n",
+		"short line":    "LUT_1D_SIZE 2\n0 0 0\n0 0\n",
+		"missing lines": "LUT_1D_SIZE 3\n0 0 0\n1 1 1\n",
+		"empty domain":  "LUT_1D_SIZE 2\nDOMAIN_MIN 1 0 0\nDOMAIN_MAX 1 1 1\n0 0 0\n1 1 1\n",
+	}
+	for name, data := range tests {
+		_, err := lut.DecodeCube(strings.NewReader(data))
+		assert.Error(t, err, name)
+	}
+
+	_, err := lut.DecodeCube(strings.NewReader("LUT_1D_SIZE 2\n0 0 0\n0 x 0\n"))
+	assert.EqualError(t, err, "line 3: invalid number: x")
+}
+
+func TestEncodeCube(t *testing.T) {
+	l := invert(t, 5)
+	l.Title = "invert"
+	l.DomainMax = [3]float64{2, 2, 2}
+
+	var buf bytes.Buffer
+	require.NoError(t, lut.EncodeCube(&buf, l))
+	assert.True(t, strings.HasPrefix(buf.String(), "TITLE \"invert\"\nLUT_3D_SIZE 5\nDOMAIN_MIN 0.000000 0.000000 0.000000\nDOMAIN_MAX 2.000000 2.000000 2.000000\n"))
+
+	decoded, err := lut.DecodeCube(&buf)
+	require.NoError(t, err)
+	assert.Equal(t, l, decoded)
+
+	// 1D LUTs.
+	l1 := &lut.LUT{}
+	for c := range l1.Shaper {
+		l1.Shaper[c] = lut.Curve{In: []float64{0, 0.5, 1}, Out: []float64{1, 0.25, 0}}
+	}
+	buf.Reset()
+	require.NoError(t, lut.EncodeCube(&buf, l1))
+	decoded, err = lut.DecodeCube(&buf)
+	require.NoError(t, err)
+	assert.Equal(t, l1.Shaper, decoded.Shaper)
+
+	// Shapers which can't be represented.
+	l1.Shaper[1] = lut.Curve{In: []float64{0, 0.1, 1}, Out: []float64{1, 0.25, 0}}
+	assert.Error(t, lut.EncodeCube(&buf, l1))
+	assert.Error(t, lut.EncodeCube(&buf, &lut.LUT{}))
+}
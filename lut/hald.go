@@ -0,0 +1,153 @@
+// Copyright © 2019 Xavier Basty <xavier@hexbee.net>
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package lut
+
+import (
+	"fmt"
+	"image"
+	"image/color"
+	"math"
+)
+
+// Hald CLUT images hold the grid points of a 3D table as pixels, with the red
+// coordinate varying the fastest, then green, then blue. The table of a Hald
+// image of level L has L² points along each axis, and the image is a square
+// of L³ pixels wide.
+
+// NewHald returns the identity Hald CLUT image of a level in the [2, 16] range.
+// Converting the colors of the image, and decoding it with DecodeHald, gives
+// a LUT of the conversion.
+func NewHald(level int) (*image.NRGBA64, error) {
+	l, err := New(2)
+	if err != nil {
+		return nil, err
+	}
+	return l.Hald(level, Trilinear)
+}
+
+// Hald renders the LUT as a Hald CLUT image of a level in the [2, 16] range,
+// sampling the LUT with the given interpolation. Output values are clamped
+// to the [0, 1] range.
+func (l *LUT) Hald(level int, interpolation string) (*image.NRGBA64, error) {
+	if level < 2 || level > 16 {
+		return nil, fmt.Errorf("Hald level is out of the [2, 16] range (%v)", level)
+	}
+	if err := l.checkApply(interpolation); err != nil {
+		return nil, err
+	}
+	tetrahedral := interpolation == Tetrahedral
+
+	size := level * level
+	side := size * level
+	img := image.NewNRGBA64(image.Rect(0, 0, side, side))
+
+	step := 1 / float64(size-1)
+	for i := 0; i < size*size*size; i++ {
+		r, g, b := i%size, i/size%size, i/(size*size)
+		o0, o1, o2 := l.apply(float64(r)*step, float64(g)*step, float64(b)*step, tetrahedral)
+		img.SetNRGBA64(i%side, i/side, color.NRGBA64{
+			R: quantize16(o0),
+			G: quantize16(o1),
+			B: quantize16(o2),
+			A: 0xffff,
+		})
+	}
+
+	return img, nil
+}
+
+// DecodeHald returns the LUT held by a Hald CLUT image.
+func DecodeHald(img image.Image) (*LUT, error) {
+	bounds := img.Bounds()
+	side := bounds.Dx()
+	level := int(math.Round(math.Cbrt(float64(side))))
+	if bounds.Dy() != side || level*level*level != side || level < 2 {
+		return nil, fmt.Errorf("invalid Hald image size (%vx%v)", bounds.Dx(), bounds.Dy())
+	}
+
+	size := level * level
+	l := &LUT{
+		Size:      size,
+		DomainMax: [3]float64{1, 1, 1},
+		Table:     make([][3]float64, size*size*size),
+	}
+
+	read := pixelReader(img)
+	for i := range l.Table {
+		v, _ := read(bounds.Min.X+i%side, bounds.Min.Y+i/side)
+		l.Table[i] = v
+	}
+
+	return l, nil
+}
+
+// ApplyImage converts the colors of an image with the LUT, and quantizes the
+// result to 16 bits per channel. Alpha is preserved.
+func (l *LUT) ApplyImage(img image.Image, interpolation string) (*image.NRGBA64, error) {
+	if err := l.checkApply(interpolation); err != nil {
+		return nil, err
+	}
+	tetrahedral := interpolation == Tetrahedral
+
+	bounds := img.Bounds()
+	dst := image.NewNRGBA64(bounds)
+
+	read := pixelReader(img)
+	for y := bounds.Min.Y; y < bounds.Max.Y; y++ {
+		for x := bounds.Min.X; x < bounds.Max.X; x++ {
+			v, alpha := read(x, y)
+			r, g, b := l.apply(v[0], v[1], v[2], tetrahedral)
+			dst.SetNRGBA64(x, y, color.NRGBA64{
+				R: quantize16(r),
+				G: quantize16(g),
+				B: quantize16(b),
+				A: quantize16(alpha),
+			})
+		}
+	}
+
+	return dst, nil
+}
+
+// pixelReader returns a function reading the samples of the pixels of an
+// image in the [0, 1] range, with straight alpha.
+func pixelReader(img image.Image) func(x, y int) ([3]float64, float64) {
+	switch img := img.(type) {
+	case *image.NRGBA64:
+		return func(x, y int) ([3]float64, float64) {
+			c := img.NRGBA64At(x, y)
+			return [3]float64{float64(c.R) / 0xffff, float64(c.G) / 0xffff, float64(c.B) / 0xffff}, float64(c.A) / 0xffff
+		}
+
+	case *image.NRGBA:
+		return func(x, y int) ([3]float64, float64) {
+			c := img.NRGBAAt(x, y)
+			return [3]float64{float64(c.R) / 0xff, float64(c.G) / 0xff, float64(c.B) / 0xff}, float64(c.A) / 0xff
+		}
+	}
+
+	return func(x, y int) ([3]float64, float64) {
+		r, g, b, a := img.At(x, y).RGBA()
+		if a == 0 {
+			return [3]float64{}, 0
+		}
+		return [3]float64{float64(r) / float64(a), float64(g) / float64(a), float64(b) / float64(a)}, float64(a) / 0xffff
+	}
+}
+
+// quantize16 converts a value in the [0, 1] range to a 16 bits sample.
+func quantize16(v float64) uint16 {
+	return uint16(math.Round(math.Max(0, math.Min(1, v)) * 0xffff))
+}
@@ -0,0 +1,107 @@
+// Copyright © 2019 Xavier Basty <xavier@hexbee.net>
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package lut_test
+
+import (
+	"bytes"
+	"image"
+	"image/color"
+	"image/png"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/Hexbee-net/gocolor"
+	"github.com/Hexbee-net/gocolor/lut"
+)
+
+func TestNewHald(t *testing.T) {
+	img, err := lut.NewHald(4)
+	require.NoError(t, err)
+	assert.Equal(t, image.Rect(0, 0, 64, 64), img.Bounds())
+
+	// Red varies the fastest.
+	assert.Equal(t, color.NRGBA64{0, 0, 0, 0xffff}, img.NRGBA64At(0, 0))
+	assert.Equal(t, color.NRGBA64{0x1111, 0, 0, 0xffff}, img.NRGBA64At(1, 0))
+	assert.Equal(t, color.NRGBA64{0xffff, 0x1111, 0, 0xffff}, img.NRGBA64At(31, 0))
+	assert.Equal(t, color.NRGBA64{0, 0, 0x1111, 0xffff}, img.NRGBA64At(0, 4))
+	assert.Equal(t, color.NRGBA64{0xffff, 0xffff, 0xffff, 0xffff}, img.NRGBA64At(63, 63))
+
+	_, err = lut.NewHald(1)
+	assert.Error(t, err)
+	_, err = lut.NewHald(17)
+	assert.Error(t, err)
+}
+
+func TestHaldConversion(t *testing.T) {
+	// Convert the identity Hald image from ProPhoto RGB to sRGB, through PNG.
+	hald, err := lut.NewHald(5)
+	require.NoError(t, err)
+	converted, err := gocolor.ConvertImage(hald, gocolor.ImageConversion{Source: gocolor.ProPhotoRGB, Destination: gocolor.SRGB})
+	require.NoError(t, err)
+
+	var buf bytes.Buffer
+	require.NoError(t, png.Encode(&buf, converted))
+	decoded, err := png.Decode(&buf)
+	require.NoError(t, err)
+
+	l, err := lut.DecodeHald(decoded)
+	require.NoError(t, err)
+	assert.Equal(t, 25, l.Size)
+
+	tr, err := gocolor.NewTransform(gocolor.ProPhotoRGB, gocolor.SRGB, gocolor.Observer2, gocolor.RefIlluminantD65, "")
+	require.NoError(t, err)
+	for _, v := range [][3]float64{{0.5, 0.5, 0.5}, {0.35, 0.3, 0.3}, {0.7, 0.65, 0.6}} {
+		er, eg, eb := tr.Apply(v[0], v[1], v[2])
+		ar, ag, ab, err := l.Apply(v[0], v[1], v[2], lut.Tetrahedral)
+		require.NoError(t, err)
+		assert.InDeltaSlice(t, []float64{er, eg, eb}, []float64{ar, ag, ab}, 1e-2, "%v", v)
+	}
+
+	// Applying the Hald image to an image gives the same result as the
+	// conversion.
+	img := image.NewNRGBA(image.Rect(0, 0, 2, 1))
+	img.SetNRGBA(0, 0, color.NRGBA{128, 128, 128, 255})
+	img.SetNRGBA(1, 0, color.NRGBA{51, 76, 102, 128})
+	out, err := l.ApplyImage(img, lut.Tetrahedral)
+	require.NoError(t, err)
+	expected, err := gocolor.ConvertImage(img, gocolor.ImageConversion{Source: gocolor.ProPhotoRGB, Destination: gocolor.SRGB})
+	require.NoError(t, err)
+	for x := 0; x < 2; x++ {
+		a, e := out.NRGBA64At(x, 0), expected.NRGBA64At(x, 0)
+		assert.InDelta(t, e.R, a.R, 0x300)
+		assert.InDelta(t, e.G, a.G, 0x300)
+		assert.InDelta(t, e.B, a.B, 0x300)
+		assert.Equal(t, e.A, a.A)
+	}
+}
+
+func TestHaldRoundTrip(t *testing.T) {
+	l := invert(t, 9)
+	img, err := l.Hald(3, lut.Trilinear)
+	require.NoError(t, err)
+	decoded, err := lut.DecodeHald(img)
+	require.NoError(t, err)
+	assert.Equal(t, 9, decoded.Size)
+	for i := range l.Table {
+		assert.InDeltaSlice(t, l.Table[i][:], decoded.Table[i][:], 0.5/0xffff)
+	}
+
+	_, err = lut.DecodeHald(image.NewNRGBA(image.Rect(0, 0, 27, 26)))
+	assert.Error(t, err)
+	_, err = lut.DecodeHald(image.NewNRGBA(image.Rect(0, 0, 26, 26)))
+	assert.Error(t, err)
+}
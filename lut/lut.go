@@ -0,0 +1,257 @@
+// Copyright © 2019 Xavier Basty <xavier@hexbee.net>
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package lut reads, writes and applies the 1D and 3D lookup tables used by
+// color grading applications: Resolve/Adobe .cube, Autodesk .3dl and Rising
+// Sun .csp files, as well as Hald CLUT images.
+package lut
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"sort"
+
+	"github.com/Hexbee-net/gocolor/internal/interp"
+)
+
+// Interpolation methods of the 3D tables.
+const (
+	Trilinear   = "trilinear"   // Interpolation between the 8 corners of the grid cell
+	Tetrahedral = "tetrahedral" // Interpolation in the tetrahedron of the grid cell containing the input
+)
+
+// Curve is a piecewise linear curve, mapping the In values to the Out values.
+// The In values are increasing. An empty curve is the identity.
+type Curve struct {
+	In, Out []float64
+}
+
+// Eval returns the value of the curve. Values outside of the input range map
+// to the first or last output value.
+func (c Curve) Eval(v float64) float64 {
+	n := len(c.In)
+	if n == 0 {
+		return v
+	}
+	if v <= c.In[0] {
+		return c.Out[0]
+	}
+	if v >= c.In[n-1] {
+		return c.Out[n-1]
+	}
+
+	i := sort.SearchFloat64s(c.In, v)
+	if c.In[i] == v {
+		return c.Out[i]
+	}
+	f := (v - c.In[i-1]) / (c.In[i] - c.In[i-1])
+	return c.Out[i-1] + f*(c.Out[i]-c.Out[i-1])
+}
+
+// uniformCurve returns a curve whose input values are evenly spaced.
+func uniformCurve(min, max float64, out []float64) Curve {
+	c := Curve{In: make([]float64, len(out)), Out: out}
+	for i := range c.In {
+		c.In[i] = min + (max-min)*float64(i)/float64(len(out)-1)
+	}
+	return c
+}
+
+// isUniform reports whether the input values of the curve are evenly spaced.
+func (c Curve) isUniform() bool {
+	n := len(c.In)
+	if n < 2 {
+		return false
+	}
+	step := (c.In[n-1] - c.In[0]) / float64(n-1)
+	for i, v := range c.In {
+		if math.Abs(v-(c.In[0]+step*float64(i))) > 1e-6*math.Max(1, math.Abs(step)) {
+			return false
+		}
+	}
+	return true
+}
+
+////////////////////////////////////////
+
+// LUT is a color lookup table, made of optional per channel curves followed
+// by an optional 3D table.
+type LUT struct {
+	Title string
+
+	// Shaper holds the curves applied to the red, green and blue channels
+	// before the 3D table. They hold the whole LUT for 1D LUTs.
+	Shaper [3]Curve
+
+	// Size is the number of grid points along each axis of the 3D table,
+	// or 0 for 1D LUTs.
+	Size int
+
+	// DomainMin and DomainMax are the input values mapped to the first and
+	// the last grid points of the 3D table.
+	DomainMin, DomainMax [3]float64
+
+	// Table holds the output values of the grid points of the 3D table, with
+	// the red coordinate varying the fastest, then green, then blue.
+	Table [][3]float64
+}
+
+// New returns an identity 3D LUT of the given size over the [0, 1] domain.
+func New(size int) (*LUT, error) {
+	return Bake(size, func(r, g, b float64) (float64, float64, float64, error) {
+		return r, g, b, nil
+	})
+}
+
+// Bake samples a color conversion into a 3D LUT of the given size, over the
+// [0, 1] domain.
+//
+// For example, a conversion from ProPhoto RGB to sRGB:
+//
+//	t, err := gocolor.NewTransform(gocolor.ProPhotoRGB, gocolor.SRGB, gocolor.Observer2, gocolor.RefIlluminantD65, "")
+//	...
+//	lut.Bake(33, func(r, g, b float64) (float64, float64, float64, error) {
+//		r, g, b = t.Apply(r, g, b)
+//		return r, g, b, nil
+//	})
+func Bake(size int, f func(r, g, b float64) (float64, float64, float64, error)) (*LUT, error) {
+	if size < 2 || size > 256 {
+		return nil, fmt.Errorf("LUT size is out of the [2, 256] range (%v)", size)
+	}
+
+	l := &LUT{
+		Size:      size,
+		DomainMax: [3]float64{1, 1, 1},
+		Table:     make([][3]float64, size*size*size),
+	}
+
+	step := 1 / float64(size-1)
+	for i := range l.Table {
+		r, g, b := i%size, i/size%size, i/(size*size)
+		o0, o1, o2, err := f(float64(r)*step, float64(g)*step, float64(b)*step)
+		if err != nil {
+			return nil, fmt.Errorf("could not convert grid point (%v, %v, %v): %v", r, g, b, err)
+		}
+		l.Table[i] = [3]float64{o0, o1, o2}
+	}
+
+	return l, nil
+}
+
+// Apply converts a color with the LUT, using the Trilinear or Tetrahedral
+// interpolation for 3D tables.
+func (l *LUT) Apply(r, g, b float64, interpolation string) (float64, float64, float64, error) {
+	if err := l.checkApply(interpolation); err != nil {
+		return 0, 0, 0, err
+	}
+
+	r, g, b = l.apply(r, g, b, interpolation == Tetrahedral)
+	return r, g, b, nil
+}
+
+// ApplySlice converts interleaved RGB colors with the LUT. The destination can
+// be the source itself.
+func (l *LUT) ApplySlice(dst, src []float64, interpolation string) error {
+	if len(src)%3 != 0 {
+		return fmt.Errorf("source length is not a multiple of 3 (%v)", len(src))
+	}
+	if len(dst) < len(src) {
+		return fmt.Errorf("destination is shorter than the source (%v < %v)", len(dst), len(src))
+	}
+	if err := l.checkApply(interpolation); err != nil {
+		return err
+	}
+
+	tetrahedral := interpolation == Tetrahedral
+	for i := 0; i < len(src); i += 3 {
+		dst[i], dst[i+1], dst[i+2] = l.apply(src[i], src[i+1], src[i+2], tetrahedral)
+	}
+	return nil
+}
+
+// checkApply validates the LUT and the interpolation method before applying it.
+func (l *LUT) checkApply(interpolation string) error {
+	if interpolation != Trilinear && interpolation != Tetrahedral {
+		return fmt.Errorf("unknown interpolation method: %v", interpolation)
+	}
+	return l.check()
+}
+
+// apply converts a color with a valid LUT.
+func (l *LUT) apply(r, g, b float64, tetrahedral bool) (float64, float64, float64) {
+	v := [3]float64{l.Shaper[0].Eval(r), l.Shaper[1].Eval(g), l.Shaper[2].Eval(b)}
+	if l.Size == 0 {
+		return v[0], v[1], v[2]
+	}
+
+	var i [3]int
+	var f [3]float64
+	for c := range v {
+		d := l.DomainMax[c] - l.DomainMin[c]
+		p := 0.0
+		if d != 0 {
+			p = (v[c] - l.DomainMin[c]) / d
+		}
+		i[c], f[c] = interp.GridCell(p, l.Size)
+	}
+
+	var o [3]float64
+	add := func(corner int, w float64) {
+		v := l.at(i[0]+(corner&1), i[1]+(corner>>1&1), i[2]+(corner>>2&1))
+		for c := range o {
+			o[c] += w * v[c]
+		}
+	}
+
+	if tetrahedral {
+		corners, weights := interp.Tetrahedral(f)
+		for j, w := range weights {
+			add(corners[j], w)
+		}
+	} else {
+		for corner, w := range interp.Trilinear(f) {
+			if w != 0 {
+				add(corner, w)
+			}
+		}
+	}
+	return o[0], o[1], o[2]
+}
+
+func (l *LUT) at(r, g, b int) [3]float64 {
+	return l.Table[r+l.Size*(g+l.Size*b)]
+}
+
+// check validates the consistency of the LUT.
+func (l *LUT) check() error {
+	for c, s := range l.Shaper {
+		if len(s.In) != len(s.Out) {
+			return fmt.Errorf("mismatching input and output values for curve %v (%v, %v)", c, len(s.In), len(s.Out))
+		}
+	}
+	if l.Size == 0 {
+		if len(l.Table) != 0 {
+			return errors.New("1D LUT with a 3D table")
+		}
+		return nil
+	}
+	if l.Size < 2 {
+		return fmt.Errorf("3D table size must be at least 2 (%v)", l.Size)
+	}
+	if len(l.Table) != l.Size*l.Size*l.Size {
+		return fmt.Errorf("3D table has %v values, expected %v", len(l.Table), l.Size*l.Size*l.Size)
+	}
+	return nil
+}
@@ -0,0 +1,139 @@
+// Copyright © 2019 Xavier Basty <xavier@hexbee.net>
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package lut_test
+
+import (
+	"image"
+	"math/rand"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/Hexbee-net/gocolor"
+	"github.com/Hexbee-net/gocolor/lut"
+)
+
+func TestCurveEval(t *testing.T) {
+	c := lut.Curve{In: []float64{0, 0.5, 1}, Out: []float64{0, 0.25, 1}}
+	assert.InDelta(t, 0.125, c.Eval(0.25), 1e-12)
+	assert.InDelta(t, 0.625, c.Eval(0.75), 1e-12)
+	assert.Equal(t, 0.25, c.Eval(0.5))
+	assert.Equal(t, 0.0, c.Eval(-1))
+	assert.Equal(t, 1.0, c.Eval(2))
+	assert.Equal(t, 0.3, lut.Curve{}.Eval(0.3))
+}
+
+func TestIdentity(t *testing.T) {
+	l, err := lut.New(5)
+	require.NoError(t, err)
+	assert.Len(t, l.Table, 125)
+
+	for _, interpolation := range []string{lut.Trilinear, lut.Tetrahedral} {
+		r, g, b, err := l.Apply(0.1, 0.6, 0.93, interpolation)
+		require.NoError(t, err)
+		assert.InDelta(t, 0.1, r, 1e-12)
+		assert.InDelta(t, 0.6, g, 1e-12)
+		assert.InDelta(t, 0.93, b, 1e-12)
+	}
+
+	_, err = lut.New(1)
+	assert.Error(t, err)
+}
+
+func TestBake(t *testing.T) {
+	// Adobe RGB contains the sRGB gamut, which keeps the conversion smooth.
+	adobe, err := gocolor.NewTransform(gocolor.SRGB, gocolor.AdobeRGB, gocolor.Observer2, gocolor.RefIlluminantD65, "")
+	require.NoError(t, err)
+	l, err := lut.Bake(33, func(r, g, b float64) (float64, float64, float64, error) {
+		r, g, b = adobe.Apply(r, g, b)
+		return r, g, b, nil
+	})
+	require.NoError(t, err)
+
+	rnd := rand.New(rand.NewSource(1))
+	for i := 0; i < 200; i++ {
+		// The curves are steep near 0.
+		r, g, b := 0.1+0.9*rnd.Float64(), 0.1+0.9*rnd.Float64(), 0.1+0.9*rnd.Float64()
+		er, eg, eb := adobe.Apply(r, g, b)
+
+		for _, interpolation := range []string{lut.Trilinear, lut.Tetrahedral} {
+			ar, ag, ab, err := l.Apply(r, g, b, interpolation)
+			require.NoError(t, err)
+			assert.InDelta(t, er, ar, 2e-3, "%v %v %v", r, g, b)
+			assert.InDelta(t, eg, ag, 2e-3, "%v %v %v", r, g, b)
+			assert.InDelta(t, eb, ab, 2e-3, "%v %v %v", r, g, b)
+		}
+	}
+
+	// Grid points are exact.
+	tr, err := gocolor.NewTransform(gocolor.ProPhotoRGB, gocolor.SRGB, gocolor.Observer2, gocolor.RefIlluminantD65, "")
+	require.NoError(t, err)
+	l, err = lut.Bake(17, func(r, g, b float64) (float64, float64, float64, error) {
+		r, g, b = tr.Apply(r, g, b)
+		return r, g, b, nil
+	})
+	require.NoError(t, err)
+	er, eg, eb := tr.Apply(0.5, 0.25, 0.75)
+	for _, interpolation := range []string{lut.Trilinear, lut.Tetrahedral} {
+		ar, ag, ab, err := l.Apply(0.5, 0.25, 0.75, interpolation)
+		require.NoError(t, err)
+		assert.InDelta(t, er, ar, 1e-12)
+		assert.InDelta(t, eg, ag, 1e-12)
+		assert.InDelta(t, eb, ab, 1e-12)
+	}
+
+	_, err = lut.Bake(2, func(r, g, b float64) (float64, float64, float64, error) {
+		return gocolor.XYZtoRGB(r, g, b*2, gocolor.SRGB)
+	})
+	assert.Error(t, err)
+}
+
+func TestApplySlice(t *testing.T) {
+	l, err := lut.Bake(17, func(r, g, b float64) (float64, float64, float64, error) {
+		return 1 - r, 1 - g, 1 - b, nil
+	})
+	require.NoError(t, err)
+
+	pixels := []float64{0, 0.5, 1, 0.25, 0.75, 0.1}
+	require.NoError(t, l.ApplySlice(pixels, pixels, lut.Trilinear))
+	assert.InDeltaSlice(t, []float64{1, 0.5, 0, 0.75, 0.25, 0.9}, pixels, 1e-12)
+
+	assert.Error(t, l.ApplySlice(pixels, pixels[:4], lut.Trilinear))
+	assert.Error(t, l.ApplySlice(pixels[:3], pixels, lut.Trilinear))
+}
+
+func TestApplyErrors(t *testing.T) {
+	l, err := lut.New(3)
+	require.NoError(t, err)
+
+	_, _, _, err = l.Apply(0.5, 0.5, 0.5, "tetrahedal")
+	assert.Error(t, err)
+	_, _, _, err = l.Apply(0.5, 0.5, 0.5, "")
+	assert.Error(t, err)
+	assert.Error(t, l.ApplySlice(make([]float64, 3), make([]float64, 3), "cubic"))
+	_, err = l.ApplyImage(image.NewNRGBA(image.Rect(0, 0, 1, 1)), "cubic")
+	assert.Error(t, err)
+	_, err = l.Hald(2, "cubic")
+	assert.Error(t, err)
+
+	// A hand-built table too short for its size.
+	l.Table = l.Table[:20]
+	_, _, _, err = l.Apply(0.9, 0.9, 0.9, lut.Trilinear)
+	assert.Error(t, err)
+	assert.Error(t, l.ApplySlice(make([]float64, 3), make([]float64, 3), lut.Tetrahedral))
+	_, err = l.ApplyImage(image.NewNRGBA(image.Rect(0, 0, 1, 1)), lut.Trilinear)
+	assert.Error(t, err)
+}
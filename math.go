@@ -15,6 +15,7 @@
 package gocolor
 
 import (
+	"errors"
 	"math"
 )
 
@@ -30,6 +31,34 @@ func (v vector) vdiv(b vector) vector {
 	}
 }
 
+func (v vector) vmul(b vector) vector {
+	return vector{
+		v.v0 * b.v0,
+		v.v1 * b.v1,
+		v.v2 * b.v2,
+	}
+}
+
+func (v vector) vscale(f float64) vector {
+	return vector{
+		v.v0 * f,
+		v.v1 * f,
+		v.v2 * f,
+	}
+}
+
+func (v vector) vsub(b vector) vector {
+	return vector{
+		v.v0 - b.v0,
+		v.v1 - b.v1,
+		v.v2 - b.v2,
+	}
+}
+
+func (v vector) norm() float64 {
+	return math.Sqrt(v.v0*v.v0 + v.v1*v.v1 + v.v2*v.v2)
+}
+
 func (v vector) diag() matrix {
 	return matrix{
 		v.v0, 0, 0,
@@ -42,6 +71,14 @@ func (v vector) mapfunc(f func(v float64) float64) vector {
 	return vector{f(v.v0), f(v.v1), f(v.v2)}
 }
 
+func (v vector) array() [3]float64 {
+	return [3]float64{v.v0, v.v1, v.v2}
+}
+
+func vectorOf(a [3]float64) vector {
+	return vector{a[0], a[1], a[2]}
+}
+
 ////////////////////////////////////////
 
 type matrix struct {
@@ -50,6 +87,14 @@ type matrix struct {
 	m20, m21, m22 float64
 }
 
+func matrixOf(m [3][3]float64) matrix {
+	return matrix{
+		m[0][0], m[0][1], m[0][2],
+		m[1][0], m[1][1], m[1][2],
+		m[2][0], m[2][1], m[2][2],
+	}
+}
+
 func (a matrix) vdot(b vector) vector {
 	return vector{
 		a.m00*b.v0 + a.m01*b.v1 + a.m02*b.v2,
@@ -117,3 +162,82 @@ func degrees(v float64) float64 {
 
 	return 360 - math.Abs(v)*(180/math.Pi)
 }
+
+////////////////////////////////////////
+
+func (a matrix) transpose() matrix {
+	return matrix{
+		a.m00, a.m10, a.m20,
+		a.m01, a.m11, a.m21,
+		a.m02, a.m12, a.m22,
+	}
+}
+
+func (a matrix) det() float64 {
+	return a.m00*(a.m11*a.m22-a.m12*a.m21) -
+		a.m01*(a.m10*a.m22-a.m12*a.m20) +
+		a.m02*(a.m10*a.m21-a.m11*a.m20)
+}
+
+func (a matrix) inverse() (matrix, error) {
+	d := a.det()
+	if d == 0 {
+		return matrix{}, errors.New("matrix is not invertible")
+	}
+
+	return matrix{
+		(a.m11*a.m22 - a.m12*a.m21) / d,
+		(a.m02*a.m21 - a.m01*a.m22) / d,
+		(a.m01*a.m12 - a.m02*a.m11) / d,
+
+		(a.m12*a.m20 - a.m10*a.m22) / d,
+		(a.m00*a.m22 - a.m02*a.m20) / d,
+		(a.m02*a.m10 - a.m00*a.m12) / d,
+
+		(a.m10*a.m21 - a.m11*a.m20) / d,
+		(a.m01*a.m20 - a.m00*a.m21) / d,
+		(a.m00*a.m11 - a.m01*a.m10) / d,
+	}, nil
+}
+
+// solveLinear solves the square linear system a·x = b using Gaussian
+// elimination with partial pivoting. Both a and b are modified in place.
+func solveLinear(a [][]float64, b []float64) ([]float64, error) {
+	n := len(b)
+
+	for col := 0; col < n; col++ {
+		pivot := col
+		for row := col + 1; row < n; row++ {
+			if math.Abs(a[row][col]) > math.Abs(a[pivot][col]) {
+				pivot = row
+			}
+		}
+		if math.Abs(a[pivot][col]) < 1e-12 {
+			return nil, errors.New("linear system is singular")
+		}
+		a[col], a[pivot] = a[pivot], a[col]
+		b[col], b[pivot] = b[pivot], b[col]
+
+		for row := col + 1; row < n; row++ {
+			f := a[row][col] / a[col][col]
+			if f == 0 {
+				continue
+			}
+			for k := col; k < n; k++ {
+				a[row][k] -= f * a[col][k]
+			}
+			b[row] -= f * b[col]
+		}
+	}
+
+	x := make([]float64, n)
+	for row := n - 1; row >= 0; row-- {
+		s := b[row]
+		for k := row + 1; k < n; k++ {
+			s -= a[row][k] * x[k]
+		}
+		x[row] = s / a[row][row]
+	}
+
+	return x, nil
+}
@@ -0,0 +1,91 @@
+// Copyright © 2019 Xavier Basty <xavier@hexbee.net>
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package gocolor
+
+import (
+	"fmt"
+)
+
+// Metamerism holds the color differences (ΔE*ab) between two samples under
+// various viewing conditions.
+type Metamerism struct {
+	// Color difference under the reference illuminant, for the requested observer.
+	Reference float64
+
+	// CIE special metamerism index for a change in illuminant, for each test
+	// illuminant. The difference under the reference illuminant is removed
+	// with an additive correction, so that imperfect matches can be assessed.
+	Illuminants map[string]float64
+}
+
+// MetamerismIndex computes the metamerism indices of two samples matching
+// under a reference illuminant, when they are viewed under test illuminants.
+// The illuminants are the names of the spectral distributions in IlluminantsSpectres.
+//
+// See CIE 15:2004 and ISO 23603 for more information.
+func MetamerismIndex(a, b SpectralColor, observer int, reference string, tests []string) (Metamerism, error) {
+	m := Metamerism{Illuminants: make(map[string]float64, len(tests))}
+
+	if observer != Observer2 && observer != Observer10 {
+		return m, fmt.Errorf("invalid observer (%v)", observer)
+	}
+
+	refLAB, err := metamerismDifference(a, b, observer, reference)
+	if err != nil {
+		return m, err
+	}
+	m.Reference = refLAB.norm()
+
+	for _, test := range tests {
+		d, err := metamerismDifference(a, b, observer, test)
+		if err != nil {
+			return m, err
+		}
+		m.Illuminants[test] = d.vsub(refLAB).norm()
+	}
+
+	return m, nil
+}
+
+////////////////////////////////////////
+
+// metamerismDifference computes the difference between the L*a*b* coordinates
+// of two samples. The white point is computed from the spectral distribution
+// of the illuminant, so that it is consistent with the coordinates of the samples.
+func metamerismDifference(a, b SpectralColor, observer int, illuminant string) (vector, error) {
+	spd, ok := IlluminantsSpectres[illuminant]
+	if !ok {
+		return vector{}, fmt.Errorf("unknown illuminant spectral distribution (%v)", illuminant)
+	}
+
+	white := make(SpectralColor, len(spd))
+	for i := range white {
+		white[i] = 1
+	}
+
+	var xyz [3]vector
+	for i, s := range []SpectralColor{a, b, white} {
+		x, y, z, err := SpectralToXYZ(s, observer, spd)
+		if err != nil {
+			return vector{}, err
+		}
+		xyz[i] = vector{x, y, z}
+	}
+
+	labA := xyzToLab(xyz[0], xyz[2])
+	labB := xyzToLab(xyz[1], xyz[2])
+
+	return labB.vsub(labA), nil
+}
@@ -0,0 +1,73 @@
+// Copyright © 2019 Xavier Basty <xavier@hexbee.net>
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package gocolor_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/Hexbee-net/gocolor"
+)
+
+func TestMetamerismIndex(t *testing.T) {
+	d65 := gocolor.IlluminantsSpectres[gocolor.RefIlluminantD65]
+	tests := []string{gocolor.RefIlluminantA, gocolor.RefIlluminantF11}
+
+	// Two different reflectances with the same color under D65.
+	a, err := gocolor.XYZtoSpectral(0.3, 0.25, 0.2, gocolor.Observer10, d65, gocolor.SpectralMeng)
+	assert.NoError(t, err)
+	b, err := gocolor.XYZtoSpectral(0.3, 0.25, 0.2, gocolor.Observer10, d65, gocolor.SpectralSmits)
+	assert.NoError(t, err)
+
+	m, err := gocolor.MetamerismIndex(a, b, gocolor.Observer10, gocolor.RefIlluminantD65, tests)
+	assert.NoError(t, err)
+	assert.InDelta(t, 0, m.Reference, 1e-3)
+	assert.Len(t, m.Illuminants, 2)
+	assert.Greater(t, m.Illuminants[gocolor.RefIlluminantF11], 0.5)
+
+	// Identical samples are never metameric.
+	m, err = gocolor.MetamerismIndex(a, a, gocolor.Observer2, gocolor.RefIlluminantD65, tests)
+	assert.NoError(t, err)
+	assert.Equal(t, 0.0, m.Reference)
+	assert.Equal(t, 0.0, m.Illuminants[gocolor.RefIlluminantA])
+	assert.Equal(t, 0.0, m.Illuminants[gocolor.RefIlluminantF11])
+
+	// The additive correction removes the difference under the reference illuminant.
+	c := make(gocolor.SpectralColor, len(a))
+	for i := range a {
+		c[i] = 0.9 * a[i]
+	}
+	m, err = gocolor.MetamerismIndex(a, c, gocolor.Observer10, gocolor.RefIlluminantD65, tests)
+	assert.NoError(t, err)
+	assert.Greater(t, m.Reference, 1.0)
+	assert.InDelta(t, 0, m.Illuminants[gocolor.RefIlluminantA], 1)
+}
+
+func TestMetamerismIndexErrors(t *testing.T) {
+	s := flatSpectrum(0.5)
+
+	_, err := gocolor.MetamerismIndex(s, s, 5, gocolor.RefIlluminantD65, nil)
+	assert.Error(t, err)
+
+	_, err = gocolor.MetamerismIndex(s, s, gocolor.Observer2, "D93", nil)
+	assert.Error(t, err)
+
+	_, err = gocolor.MetamerismIndex(s, s, gocolor.Observer2, gocolor.RefIlluminantD65, []string{"D93"})
+	assert.Error(t, err)
+
+	_, err = gocolor.MetamerismIndex(s, gocolor.SpectralColor{1, 2}, gocolor.Observer2, gocolor.RefIlluminantD65, nil)
+	assert.Error(t, err)
+}
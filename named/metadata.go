@@ -0,0 +1,139 @@
+// Copyright © 2019 Xavier Basty <xavier@hexbee.net>
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package named
+
+import (
+	"math"
+	"strconv"
+
+	"github.com/Hexbee-net/gocolor/internal/colormath"
+)
+
+// Hue families of the colors.
+//
+// The family of a color is derived from its Oklch coordinates: colors with a
+// low chroma, including white and black, are gray, dark and moderately
+// saturated oranges and yellows are brown, and the other colors are binned by
+// hue. The families are approximate, and can differ from the name of a color.
+const (
+	FamilyRed    = "red"
+	FamilyOrange = "orange"
+	FamilyYellow = "yellow"
+	FamilyGreen  = "green"
+	FamilyCyan   = "cyan"
+	FamilyBlue   = "blue"
+	FamilyPurple = "purple"
+	FamilyPink   = "pink"
+	FamilyBrown  = "brown"
+	FamilyGray   = "gray"
+)
+
+// Families lists the hue families, in hue order.
+var Families = []string{
+	FamilyRed,
+	FamilyOrange,
+	FamilyYellow,
+	FamilyGreen,
+	FamilyCyan,
+	FamilyBlue,
+	FamilyPurple,
+	FamilyPink,
+	FamilyBrown,
+	FamilyGray,
+}
+
+// familyHues are the upper hue bounds of the families, in degrees.
+var familyHues = []struct {
+	hue    float64
+	family string
+}{
+	{15, FamilyPink},
+	{45, FamilyRed},
+	{85, FamilyOrange},
+	{120, FamilyYellow},
+	{175, FamilyGreen},
+	{230, FamilyCyan},
+	{285, FamilyBlue},
+	{340, FamilyPurple},
+	{360, FamilyPink},
+}
+
+////////////////////////////////////////
+
+// withMetadata fills the sRGB, Lab and family of a color from its hex value,
+// and its source with a default one.
+func withMetadata(c Color, source string) Color {
+	if c.Source == "" {
+		c.Source = source
+	}
+
+	rgb, ok := parseHex(c.Hex)
+	if !ok {
+		return c
+	}
+
+	var linear [3]float64
+	for i, v := range rgb {
+		linear[i] = colormath.SRGBToLinear(v)
+	}
+	xyz := colormath.Apply(colormath.SRGBToXYZ, linear)
+
+	c.RGB = rgb
+	c.Lab = colormath.XYZToLab(xyz, colormath.WhiteD65)
+	c.Family = family(oklch(colormath.XYZToOklab(xyz)))
+	return c
+}
+
+// parseHex parses a #RRGGBB value to sRGB coordinates in the [0, 1] range.
+func parseHex(hex string) ([3]float64, bool) {
+	var rgb [3]float64
+	if len(hex) != 7 || hex[0] != '#' {
+		return rgb, false
+	}
+
+	for i := range rgb {
+		v, err := strconv.ParseUint(hex[1+2*i:3+2*i], 16, 8)
+		if err != nil {
+			return rgb, false
+		}
+		rgb[i] = float64(v) / 0xff
+	}
+	return rgb, true
+}
+
+// oklch returns the lightness, chroma and hue in degrees of Oklab
+// coordinates.
+func oklch(lab [3]float64) (l, c, h float64) {
+	h = math.Atan2(lab[2], lab[1]) * 180 / math.Pi
+	if h < 0 {
+		h += 360
+	}
+	return lab[0], math.Hypot(lab[1], lab[2]), h
+}
+
+func family(l, c, h float64) string {
+	if c < 0.03 {
+		return FamilyGray
+	}
+	if h >= 15 && h < 95 && l < 0.6 && c < 0.15 {
+		return FamilyBrown
+	}
+	for _, f := range familyHues {
+		if h < f.hue {
+			return f.family
+		}
+	}
+	return FamilyPink
+}
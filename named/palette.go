@@ -0,0 +1,190 @@
+// Copyright © 2019 Xavier Basty <xavier@hexbee.net>
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package named
+
+import (
+	"strings"
+	"unicode"
+)
+
+// Color is a color of a palette.
+type Color struct {
+	// Name of the color, as written by the palette.
+	Name string
+
+	// Code of the color in the palette, such as "RAL 1000" or the number
+	// of the ISCC–NBS centroids, if any.
+	Code string
+
+	// Hex is the #RRGGBB sRGB value of the color.
+	Hex string
+
+	// Source is the standard or vendor defining the color, such as "Crayola"
+	// or "X11". It defaults to the name of the palette.
+	Source string
+
+	// Family is the hue family of the color, one of the Family constants.
+	Family string
+
+	// RGB holds the sRGB coordinates of the color, in the [0, 1] range.
+	RGB [3]float64
+
+	// Lab holds the CIE L*a*b* coordinates of the color, for illuminant D65
+	// and the 2° standard observer.
+	Lab [3]float64
+}
+
+// Palette is a dictionary of named colors.
+//
+// The colors are looked up by name or code, ignoring case, spaces and
+// punctuation, or by exact name, ignoring case, when several names only differ
+// by spaces or punctuation. A Palette is immutable, and can be used concurrently from
+// multiple goroutines.
+type Palette struct {
+	// Name of the palette.
+	Name string
+
+	colors []Color
+	names  map[string]int
+	index  map[string]int
+	hexes  map[string][]int
+}
+
+// Palettes of the package.
+var (
+	// CSS holds the named colors of the CSS Color Module Level 4.
+	CSS = NewPalette("CSS", cssColors)
+
+	// X11 holds the colors of the rgb.txt file of the X Window System, which
+	// differ from the CSS ones for gray, green, maroon and purple.
+	X11 = NewPalette("X11", x11Colors)
+
+	// RAL holds the RAL Classic colors, with approximate sRGB values.
+	RAL = NewPalette("RAL Classic", ralColors)
+
+	// XKCD holds the colors of the xkcd color survey.
+	XKCD = NewPalette("xkcd", xkcdColors)
+
+	// Crayola holds the colors of the standard Crayola crayons.
+	Crayola = NewPalette("Crayola", crayolaColors)
+
+	// ISCCNBS holds the centroid colors of the ISCC–NBS color designations.
+	ISCCNBS = NewPalette("ISCC–NBS", isccNBSColors)
+
+	// Wikipedia holds the colors of NamedColors, merged from various sources
+	// following the Wikipedia lists of colors.
+	Wikipedia = NewPalette("Wikipedia", wikipediaColors)
+)
+
+// Palettes lists the palettes of the package.
+var Palettes = []*Palette{CSS, X11, RAL, XKCD, Crayola, ISCCNBS, Wikipedia}
+
+// NewPalette creates a palette from a list of colors. When several colors have
+// the same name once normalized, the first one is found by Lookup.
+//
+// The RGB, Lab and Family fields of the colors are computed from their Hex
+// value, and left empty when it is not in #RRGGBB format. Colors without a
+// Source get the name of the palette.
+func NewPalette(name string, colors []Color) *Palette {
+	p := &Palette{
+		Name:   name,
+		colors: make([]Color, len(colors)),
+		names:  make(map[string]int, len(colors)),
+		index:  make(map[string]int, len(colors)),
+		hexes:  make(map[string][]int, len(colors)),
+	}
+
+	for i, c := range colors {
+		c = withMetadata(c, name)
+		p.colors[i] = c
+
+		hex := strings.ToUpper(c.Hex)
+		p.hexes[hex] = append(p.hexes[hex], i)
+
+		if _, ok := p.names[strings.ToLower(c.Name)]; !ok {
+			p.names[strings.ToLower(c.Name)] = i
+		}
+		for _, key := range []string{normalize(c.Name), normalize(c.Code)} {
+			if _, ok := p.index[key]; !ok && key != "" {
+				p.index[key] = i
+			}
+		}
+	}
+
+	return p
+}
+
+// Lookup returns the color of the palette with a name or code.
+func (p *Palette) Lookup(name string) (Color, bool) {
+	i, ok := p.names[strings.ToLower(name)]
+	if !ok {
+		i, ok = p.index[normalize(name)]
+	}
+	if !ok {
+		return Color{}, false
+	}
+	return p.colors[i], true
+}
+
+// ByHex returns the colors of the palette with a #RRGGBB value, ignoring case,
+// in the palette order. It can be used to find the names of a constant such
+// as AliceBlue.
+func (p *Palette) ByHex(hex string) []Color {
+	indices := p.hexes[strings.ToUpper(hex)]
+	if len(indices) == 0 {
+		return nil
+	}
+
+	colors := make([]Color, len(indices))
+	for i, index := range indices {
+		colors[i] = p.colors[index]
+	}
+	return colors
+}
+
+// ByFamily returns the colors of the palette in a hue family, in the palette
+// order.
+func (p *Palette) ByFamily(family string) []Color {
+	var colors []Color
+	for _, c := range p.colors {
+		if c.Family == family {
+			colors = append(colors, c)
+		}
+	}
+	return colors
+}
+
+// Colors returns the colors of the palette, in the palette order.
+func (p *Palette) Colors() []Color {
+	return append([]Color(nil), p.colors...)
+}
+
+// Len returns the number of colors of the palette.
+func (p *Palette) Len() int {
+	return len(p.colors)
+}
+
+////////////////////////////////////////
+
+// normalize returns the lowercase letters and digits of a name.
+func normalize(name string) string {
+	var b strings.Builder
+	for _, r := range name {
+		if unicode.IsLetter(r) || unicode.IsDigit(r) {
+			b.WriteRune(unicode.ToLower(r))
+		}
+	}
+	return b.String()
+}
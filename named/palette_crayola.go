@@ -0,0 +1,152 @@
+// Copyright © 2019 Xavier Basty <xavier@hexbee.net>
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package named
+
+// crayolaColors are the colors of the standard Crayola crayons.
+var crayolaColors = []Color{
+	{Name: "Almond", Hex: "#EFDECD"},
+	{Name: "Antique Brass", Hex: "#CD9575"},
+	{Name: "Apricot", Hex: "#FDD9B5"},
+	{Name: "Aquamarine", Hex: "#78DBE2"},
+	{Name: "Asparagus", Hex: "#87A96B"},
+	{Name: "Atomic Tangerine", Hex: "#FFA474"},
+	{Name: "Banana Mania", Hex: "#FAE7B5"},
+	{Name: "Beaver", Hex: "#9F8170"},
+	{Name: "Bittersweet", Hex: "#FD7C6E"},
+	{Name: "Black", Hex: "#000000"},
+	{Name: "Blizzard Blue", Hex: "#ACE5EE"},
+	{Name: "Blue", Hex: "#1F75FE"},
+	{Name: "Blue Bell", Hex: "#A2A2D0"},
+	{Name: "Blue Gray", Hex: "#6699CC"},
+	{Name: "Blue Green", Hex: "#0D98BA"},
+	{Name: "Blue Violet", Hex: "#7366BD"},
+	{Name: "Blush", Hex: "#DE5D83"},
+	{Name: "Brick Red", Hex: "#CB4154"},
+	{Name: "Brown", Hex: "#B4674D"},
+	{Name: "Burnt Orange", Hex: "#FF7F49"},
+	{Name: "Burnt Sienna", Hex: "#EA7E5D"},
+	{Name: "Cadet Blue", Hex: "#B0B7C6"},
+	{Name: "Canary", Hex: "#FFFF99"},
+	{Name: "Caribbean Green", Hex: "#1CD3A2"},
+	{Name: "Carnation Pink", Hex: "#FFAACC"},
+	{Name: "Cerise", Hex: "#DD4492"},
+	{Name: "Cerulean", Hex: "#1DACD6"},
+	{Name: "Chestnut", Hex: "#BC5D58"},
+	{Name: "Copper", Hex: "#DD9475"},
+	{Name: "Cornflower", Hex: "#9ACEEB"},
+	{Name: "Cotton Candy", Hex: "#FFBCD9"},
+	{Name: "Dandelion", Hex: "#FDDB6D"},
+	{Name: "Denim", Hex: "#2B6CC4"},
+	{Name: "Desert Sand", Hex: "#EFCDB8"},
+	{Name: "Eggplant", Hex: "#6E5160"},
+	{Name: "Electric Lime", Hex: "#CEFF1D"},
+	{Name: "Fern", Hex: "#71BC78"},
+	{Name: "Forest Green", Hex: "#6DAE81"},
+	{Name: "Fuchsia", Hex: "#C364C5"},
+	{Name: "Fuzzy Wuzzy", Hex: "#CC6666"},
+	{Name: "Gold", Hex: "#E7C697"},
+	{Name: "Goldenrod", Hex: "#FCD975"},
+	{Name: "Granny Smith Apple", Hex: "#A8E4A0"},
+	{Name: "Gray", Hex: "#95918C"},
+	{Name: "Green", Hex: "#1CAC78"},
+	{Name: "Green Blue", Hex: "#1164B4"},
+	{Name: "Green Yellow", Hex: "#F0E891"},
+	{Name: "Hot Magenta", Hex: "#FF1DCE"},
+	{Name: "Inchworm", Hex: "#B2EC5D"},
+	{Name: "Indigo", Hex: "#5D76CB"},
+	{Name: "Jazzberry Jam", Hex: "#CA3767"},
+	{Name: "Jungle Green", Hex: "#3BB08F"},
+	{Name: "Laser Lemon", Hex: "#FEFE22"},
+	{Name: "Lavender", Hex: "#FCB4D5"},
+	{Name: "Lemon Yellow", Hex: "#FFF44F"},
+	{Name: "Macaroni and Cheese", Hex: "#FFBD88"},
+	{Name: "Magenta", Hex: "#F664AF"},
+	{Name: "Magic Mint", Hex: "#AAF0D1"},
+	{Name: "Mahogany", Hex: "#CD4A4C"},
+	{Name: "Maize", Hex: "#EDD19C"},
+	{Name: "Manatee", Hex: "#979AAA"},
+	{Name: "Mango Tango", Hex: "#FF8243"},
+	{Name: "Maroon", Hex: "#C8385A"},
+	{Name: "Mauvelous", Hex: "#EF98AA"},
+	{Name: "Melon", Hex: "#FDBCB4"},
+	{Name: "Midnight Blue", Hex: "#1A4876"},
+	{Name: "Mountain Meadow", Hex: "#30BA8F"},
+	{Name: "Mulberry", Hex: "#C54B8C"},
+	{Name: "Navy Blue", Hex: "#1974D2"},
+	{Name: "Neon Carrot", Hex: "#FFA343"},
+	{Name: "Olive Green", Hex: "#BAB86C"},
+	{Name: "Orange", Hex: "#FF7538"},
+	{Name: "Orange Red", Hex: "#FF2B2B"},
+	{Name: "Orange Yellow", Hex: "#F8D568"},
+	{Name: "Orchid", Hex: "#E6A8D7"},
+	{Name: "Outer Space", Hex: "#414A4C"},
+	{Name: "Outrageous Orange", Hex: "#FF6E4A"},
+	{Name: "Pacific Blue", Hex: "#1CA9C9"},
+	{Name: "Peach", Hex: "#FFCFAB"},
+	{Name: "Periwinkle", Hex: "#C5D0E6"},
+	{Name: "Piggy Pink", Hex: "#FDDDE6"},
+	{Name: "Pine Green", Hex: "#158078"},
+	{Name: "Pink Flamingo", Hex: "#FC74FD"},
+	{Name: "Pink Sherbert", Hex: "#F78FA7"},
+	{Name: "Plum", Hex: "#8E4585"},
+	{Name: "Purple Heart", Hex: "#7442C8"},
+	{Name: "Purple Mountains' Majesty", Hex: "#9D81BA"},
+	{Name: "Purple Pizzazz", Hex: "#FE4EDA"},
+	{Name: "Radical Red", Hex: "#FF496C"},
+	{Name: "Raw Sienna", Hex: "#D68A59"},
+	{Name: "Raw Umber", Hex: "#714B23"},
+	{Name: "Razzle Dazzle Rose", Hex: "#FF48D0"},
+	{Name: "Razzmatazz", Hex: "#E3256B"},
+	{Name: "Red", Hex: "#EE204D"},
+	{Name: "Red Orange", Hex: "#FF5349"},
+	{Name: "Red Violet", Hex: "#C0448F"},
+	{Name: "Robin's Egg Blue", Hex: "#1FCECB"},
+	{Name: "Royal Purple", Hex: "#7851A9"},
+	{Name: "Salmon", Hex: "#FF9BAA"},
+	{Name: "Scarlet", Hex: "#FC2847"},
+	{Name: "Screamin' Green", Hex: "#76FF7A"},
+	{Name: "Sea Green", Hex: "#9FE2BF"},
+	{Name: "Sepia", Hex: "#A5694F"},
+	{Name: "Shadow", Hex: "#8A795D"},
+	{Name: "Shamrock", Hex: "#45CEA2"},
+	{Name: "Shocking Pink", Hex: "#FB7EFD"},
+	{Name: "Silver", Hex: "#CDC5C2"},
+	{Name: "Sky Blue", Hex: "#80DAEB"},
+	{Name: "Spring Green", Hex: "#ECEABE"},
+	{Name: "Sunglow", Hex: "#FFCF48"},
+	{Name: "Sunset Orange", Hex: "#FD5E53"},
+	{Name: "Tan", Hex: "#FAA76C"},
+	{Name: "Teal Blue", Hex: "#18A7B5"},
+	{Name: "Thistle", Hex: "#EBC7DF"},
+	{Name: "Tickle Me Pink", Hex: "#FC89AC"},
+	{Name: "Timberwolf", Hex: "#DBD7D2"},
+	{Name: "Tropical Rain Forest", Hex: "#17806D"},
+	{Name: "Tumbleweed", Hex: "#DEAA88"},
+	{Name: "Turquoise Blue", Hex: "#77DDE7"},
+	{Name: "Unmellow Yellow", Hex: "#FFFF66"},
+	{Name: "Violet (Purple)", Hex: "#926EAE"},
+	{Name: "Violet Blue", Hex: "#324AB2"},
+	{Name: "Violet Red", Hex: "#F75394"},
+	{Name: "Vivid Tangerine", Hex: "#FFA089"},
+	{Name: "Vivid Violet", Hex: "#8F509D"},
+	{Name: "White", Hex: "#FFFFFF"},
+	{Name: "Wild Blue Yonder", Hex: "#A2ADD0"},
+	{Name: "Wild Strawberry", Hex: "#FF43A4"},
+	{Name: "Wild Watermelon", Hex: "#FC6C85"},
+	{Name: "Wisteria", Hex: "#CDA4DE"},
+	{Name: "Yellow", Hex: "#FCE883"},
+	{Name: "Yellow Green", Hex: "#C5E384"},
+	{Name: "Yellow Orange", Hex: "#FFAE42"},
+}
@@ -0,0 +1,169 @@
+// Copyright © 2019 Xavier Basty <xavier@hexbee.net>
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package named
+
+// cssColors are the named colors of the CSS Color Module Level 4.
+//
+// See https://www.w3.org/TR/css-color-4/#named-colors
+var cssColors = []Color{
+	{Name: "aliceblue", Hex: "#F0F8FF"},
+	{Name: "antiquewhite", Hex: "#FAEBD7"},
+	{Name: "aqua", Hex: "#00FFFF"},
+	{Name: "aquamarine", Hex: "#7FFFD4"},
+	{Name: "azure", Hex: "#F0FFFF"},
+	{Name: "beige", Hex: "#F5F5DC"},
+	{Name: "bisque", Hex: "#FFE4C4"},
+	{Name: "black", Hex: "#000000"},
+	{Name: "blanchedalmond", Hex: "#FFEBCD"},
+	{Name: "blue", Hex: "#0000FF"},
+	{Name: "blueviolet", Hex: "#8A2BE2"},
+	{Name: "brown", Hex: "#A52A2A"},
+	{Name: "burlywood", Hex: "#DEB887"},
+	{Name: "cadetblue", Hex: "#5F9EA0"},
+	{Name: "chartreuse", Hex: "#7FFF00"},
+	{Name: "chocolate", Hex: "#D2691E"},
+	{Name: "coral", Hex: "#FF7F50"},
+	{Name: "cornflowerblue", Hex: "#6495ED"},
+	{Name: "cornsilk", Hex: "#FFF8DC"},
+	{Name: "crimson", Hex: "#DC143C"},
+	{Name: "cyan", Hex: "#00FFFF"},
+	{Name: "darkblue", Hex: "#00008B"},
+	{Name: "darkcyan", Hex: "#008B8B"},
+	{Name: "darkgoldenrod", Hex: "#B8860B"},
+	{Name: "darkgray", Hex: "#A9A9A9"},
+	{Name: "darkgreen", Hex: "#006400"},
+	{Name: "darkgrey", Hex: "#A9A9A9"},
+	{Name: "darkkhaki", Hex: "#BDB76B"},
+	{Name: "darkmagenta", Hex: "#8B008B"},
+	{Name: "darkolivegreen", Hex: "#556B2F"},
+	{Name: "darkorange", Hex: "#FF8C00"},
+	{Name: "darkorchid", Hex: "#9932CC"},
+	{Name: "darkred", Hex: "#8B0000"},
+	{Name: "darksalmon", Hex: "#E9967A"},
+	{Name: "darkseagreen", Hex: "#8FBC8F"},
+	{Name: "darkslateblue", Hex: "#483D8B"},
+	{Name: "darkslategray", Hex: "#2F4F4F"},
+	{Name: "darkslategrey", Hex: "#2F4F4F"},
+	{Name: "darkturquoise", Hex: "#00CED1"},
+	{Name: "darkviolet", Hex: "#9400D3"},
+	{Name: "deeppink", Hex: "#FF1493"},
+	{Name: "deepskyblue", Hex: "#00BFFF"},
+	{Name: "dimgray", Hex: "#696969"},
+	{Name: "dimgrey", Hex: "#696969"},
+	{Name: "dodgerblue", Hex: "#1E90FF"},
+	{Name: "firebrick", Hex: "#B22222"},
+	{Name: "floralwhite", Hex: "#FFFAF0"},
+	{Name: "forestgreen", Hex: "#228B22"},
+	{Name: "fuchsia", Hex: "#FF00FF"},
+	{Name: "gainsboro", Hex: "#DCDCDC"},
+	{Name: "ghostwhite", Hex: "#F8F8FF"},
+	{Name: "gold", Hex: "#FFD700"},
+	{Name: "goldenrod", Hex: "#DAA520"},
+	{Name: "gray", Hex: "#808080"},
+	{Name: "green", Hex: "#008000"},
+	{Name: "greenyellow", Hex: "#ADFF2F"},
+	{Name: "grey", Hex: "#808080"},
+	{Name: "honeydew", Hex: "#F0FFF0"},
+	{Name: "hotpink", Hex: "#FF69B4"},
+	{Name: "indianred", Hex: "#CD5C5C"},
+	{Name: "indigo", Hex: "#4B0082"},
+	{Name: "ivory", Hex: "#FFFFF0"},
+	{Name: "khaki", Hex: "#F0E68C"},
+	{Name: "lavender", Hex: "#E6E6FA"},
+	{Name: "lavenderblush", Hex: "#FFF0F5"},
+	{Name: "lawngreen", Hex: "#7CFC00"},
+	{Name: "lemonchiffon", Hex: "#FFFACD"},
+	{Name: "lightblue", Hex: "#ADD8E6"},
+	{Name: "lightcoral", Hex: "#F08080"},
+	{Name: "lightcyan", Hex: "#E0FFFF"},
+	{Name: "lightgoldenrodyellow", Hex: "#FAFAD2"},
+	{Name: "lightgray", Hex: "#D3D3D3"},
+	{Name: "lightgreen", Hex: "#90EE90"},
+	{Name: "lightgrey", Hex: "#D3D3D3"},
+	{Name: "lightpink", Hex: "#FFB6C1"},
+	{Name: "lightsalmon", Hex: "#FFA07A"},
+	{Name: "lightseagreen", Hex: "#20B2AA"},
+	{Name: "lightskyblue", Hex: "#87CEFA"},
+	{Name: "lightslategray", Hex: "#778899"},
+	{Name: "lightslategrey", Hex: "#778899"},
+	{Name: "lightsteelblue", Hex: "#B0C4DE"},
+	{Name: "lightyellow", Hex: "#FFFFE0"},
+	{Name: "lime", Hex: "#00FF00"},
+	{Name: "limegreen", Hex: "#32CD32"},
+	{Name: "linen", Hex: "#FAF0E6"},
+	{Name: "magenta", Hex: "#FF00FF"},
+	{Name: "maroon", Hex: "#800000"},
+	{Name: "mediumaquamarine", Hex: "#66CDAA"},
+	{Name: "mediumblue", Hex: "#0000CD"},
+	{Name: "mediumorchid", Hex: "#BA55D3"},
+	{Name: "mediumpurple", Hex: "#9370DB"},
+	{Name: "mediumseagreen", Hex: "#3CB371"},
+	{Name: "mediumslateblue", Hex: "#7B68EE"},
+	{Name: "mediumspringgreen", Hex: "#00FA9A"},
+	{Name: "mediumturquoise", Hex: "#48D1CC"},
+	{Name: "mediumvioletred", Hex: "#C71585"},
+	{Name: "midnightblue", Hex: "#191970"},
+	{Name: "mintcream", Hex: "#F5FFFA"},
+	{Name: "mistyrose", Hex: "#FFE4E1"},
+	{Name: "moccasin", Hex: "#FFE4B5"},
+	{Name: "navajowhite", Hex: "#FFDEAD"},
+	{Name: "navy", Hex: "#000080"},
+	{Name: "oldlace", Hex: "#FDF5E6"},
+	{Name: "olive", Hex: "#808000"},
+	{Name: "olivedrab", Hex: "#6B8E23"},
+	{Name: "orange", Hex: "#FFA500"},
+	{Name: "orangered", Hex: "#FF4500"},
+	{Name: "orchid", Hex: "#DA70D6"},
+	{Name: "palegoldenrod", Hex: "#EEE8AA"},
+	{Name: "palegreen", Hex: "#98FB98"},
+	{Name: "paleturquoise", Hex: "#AFEEEE"},
+	{Name: "palevioletred", Hex: "#DB7093"},
+	{Name: "papayawhip", Hex: "#FFEFD5"},
+	{Name: "peachpuff", Hex: "#FFDAB9"},
+	{Name: "peru", Hex: "#CD853F"},
+	{Name: "pink", Hex: "#FFC0CB"},
+	{Name: "plum", Hex: "#DDA0DD"},
+	{Name: "powderblue", Hex: "#B0E0E6"},
+	{Name: "purple", Hex: "#800080"},
+	{Name: "rebeccapurple", Hex: "#663399"},
+	{Name: "red", Hex: "#FF0000"},
+	{Name: "rosybrown", Hex: "#BC8F8F"},
+	{Name: "royalblue", Hex: "#4169E1"},
+	{Name: "saddlebrown", Hex: "#8B4513"},
+	{Name: "salmon", Hex: "#FA8072"},
+	{Name: "sandybrown", Hex: "#F4A460"},
+	{Name: "seagreen", Hex: "#2E8B57"},
+	{Name: "seashell", Hex: "#FFF5EE"},
+	{Name: "sienna", Hex: "#A0522D"},
+	{Name: "silver", Hex: "#C0C0C0"},
+	{Name: "skyblue", Hex: "#87CEEB"},
+	{Name: "slateblue", Hex: "#6A5ACD"},
+	{Name: "slategray", Hex: "#708090"},
+	{Name: "slategrey", Hex: "#708090"},
+	{Name: "snow", Hex: "#FFFAFA"},
+	{Name: "springgreen", Hex: "#00FF7F"},
+	{Name: "steelblue", Hex: "#4682B4"},
+	{Name: "tan", Hex: "#D2B48C"},
+	{Name: "teal", Hex: "#008080"},
+	{Name: "thistle", Hex: "#D8BFD8"},
+	{Name: "tomato", Hex: "#FF6347"},
+	{Name: "turquoise", Hex: "#40E0D0"},
+	{Name: "violet", Hex: "#EE82EE"},
+	{Name: "wheat", Hex: "#F5DEB3"},
+	{Name: "white", Hex: "#FFFFFF"},
+	{Name: "whitesmoke", Hex: "#F5F5F5"},
+	{Name: "yellow", Hex: "#FFFF00"},
+	{Name: "yellowgreen", Hex: "#9ACD32"},
+}
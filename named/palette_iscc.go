@@ -0,0 +1,287 @@
+// Copyright © 2019 Xavier Basty <xavier@hexbee.net>
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package named
+
+// isccNBSColors are the centroid colors of the 267 color designations of the
+// ISCC–NBS system of color designation, in the order of their numbers.
+var isccNBSColors = []Color{
+	{Name: "vivid pink", Code: "1", Hex: "#FFB5BA"},
+	{Name: "strong pink", Code: "2", Hex: "#EA9399"},
+	{Name: "deep pink", Code: "3", Hex: "#E4717A"},
+	{Name: "light pink", Code: "4", Hex: "#F9CCCA"},
+	{Name: "moderate pink", Code: "5", Hex: "#DEA5A4"},
+	{Name: "dark pink", Code: "6", Hex: "#C08081"},
+	{Name: "pale pink", Code: "7", Hex: "#EAD8D7"},
+	{Name: "grayish pink", Code: "8", Hex: "#C4AEAD"},
+	{Name: "pinkish white", Code: "9", Hex: "#EAE3E1"},
+	{Name: "pinkish gray", Code: "10", Hex: "#C1B6B3"},
+	{Name: "vivid red", Code: "11", Hex: "#BE0032"},
+	{Name: "strong red", Code: "12", Hex: "#BC3F4A"},
+	{Name: "deep red", Code: "13", Hex: "#841B2D"},
+	{Name: "very deep red", Code: "14", Hex: "#5C0923"},
+	{Name: "moderate red", Code: "15", Hex: "#AB4E52"},
+	{Name: "dark red", Code: "16", Hex: "#722F37"},
+	{Name: "very dark red", Code: "17", Hex: "#3F1720"},
+	{Name: "light grayish red", Code: "18", Hex: "#AD8884"},
+	{Name: "grayish red", Code: "19", Hex: "#905D5D"},
+	{Name: "dark grayish red", Code: "20", Hex: "#543D3F"},
+	{Name: "blackish red", Code: "21", Hex: "#2E1D21"},
+	{Name: "reddish gray", Code: "22", Hex: "#8F817F"},
+	{Name: "dark reddish gray", Code: "23", Hex: "#5C504F"},
+	{Name: "reddish black", Code: "24", Hex: "#282022"},
+	{Name: "vivid yellowish pink", Code: "25", Hex: "#FFB7A5"},
+	{Name: "strong yellowish pink", Code: "26", Hex: "#F99379"},
+	{Name: "deep yellowish pink", Code: "27", Hex: "#E66761"},
+	{Name: "light yellowish pink", Code: "28", Hex: "#F4C2C2"},
+	{Name: "moderate yellowish pink", Code: "29", Hex: "#D9A6A9"},
+	{Name: "dark yellowish pink", Code: "30", Hex: "#C48379"},
+	{Name: "pale yellowish pink", Code: "31", Hex: "#ECD5C5"},
+	{Name: "grayish yellowish pink", Code: "32", Hex: "#C7ADA3"},
+	{Name: "brownish pink", Code: "33", Hex: "#C2AC99"},
+	{Name: "vivid reddish orange", Code: "34", Hex: "#E25822"},
+	{Name: "strong reddish orange", Code: "35", Hex: "#D9603B"},
+	{Name: "deep reddish orange", Code: "36", Hex: "#AA381E"},
+	{Name: "moderate reddish orange", Code: "37", Hex: "#CB6D51"},
+	{Name: "dark reddish orange", Code: "38", Hex: "#9E4732"},
+	{Name: "grayish reddish orange", Code: "39", Hex: "#B4745E"},
+	{Name: "strong reddish brown", Code: "40", Hex: "#882D17"},
+	{Name: "deep reddish brown", Code: "41", Hex: "#56070C"},
+	{Name: "light reddish brown", Code: "42", Hex: "#A87C6D"},
+	{Name: "moderate reddish brown", Code: "43", Hex: "#79443B"},
+	{Name: "dark reddish brown", Code: "44", Hex: "#3E1D1E"},
+	{Name: "light grayish reddish brown", Code: "45", Hex: "#977F73"},
+	{Name: "grayish reddish brown", Code: "46", Hex: "#674C47"},
+	{Name: "dark grayish reddish brown", Code: "47", Hex: "#43302E"},
+	{Name: "vivid orange", Code: "48", Hex: "#F38400"},
+	{Name: "brilliant orange", Code: "49", Hex: "#FD943F"},
+	{Name: "strong orange", Code: "50", Hex: "#ED872D"},
+	{Name: "deep orange", Code: "51", Hex: "#BE6516"},
+	{Name: "light orange", Code: "52", Hex: "#FAB57F"},
+	{Name: "moderate orange", Code: "53", Hex: "#D99058"},
+	{Name: "brownish orange", Code: "54", Hex: "#AE6938"},
+	{Name: "strong brown", Code: "55", Hex: "#80461B"},
+	{Name: "deep brown", Code: "56", Hex: "#593319"},
+	{Name: "light brown", Code: "57", Hex: "#A67B5B"},
+	{Name: "moderate brown", Code: "58", Hex: "#6F4E37"},
+	{Name: "dark brown", Code: "59", Hex: "#422518"},
+	{Name: "light grayish brown", Code: "60", Hex: "#958070"},
+	{Name: "grayish brown", Code: "61", Hex: "#635147"},
+	{Name: "dark grayish brown", Code: "62", Hex: "#3E322C"},
+	{Name: "light brownish gray", Code: "63", Hex: "#8E8279"},
+	{Name: "brownish gray", Code: "64", Hex: "#5B504F"},
+	{Name: "brownish black", Code: "65", Hex: "#28201C"},
+	{Name: "vivid orange yellow", Code: "66", Hex: "#F6A600"},
+	{Name: "brilliant orange yellow", Code: "67", Hex: "#FFC14F"},
+	{Name: "strong orange yellow", Code: "68", Hex: "#EAA221"},
+	{Name: "deep orange yellow", Code: "69", Hex: "#C98500"},
+	{Name: "light orange yellow", Code: "70", Hex: "#FBC97F"},
+	{Name: "moderate orange yellow", Code: "71", Hex: "#E3A857"},
+	{Name: "dark orange yellow", Code: "72", Hex: "#BE8A3D"},
+	{Name: "pale orange yellow", Code: "73", Hex: "#FAD6A5"},
+	{Name: "strong yellowish brown", Code: "74", Hex: "#996515"},
+	{Name: "deep yellowish brown", Code: "75", Hex: "#654522"},
+	{Name: "light yellowish brown", Code: "76", Hex: "#C19A6B"},
+	{Name: "moderate yellowish brown", Code: "77", Hex: "#826644"},
+	{Name: "dark yellowish brown", Code: "78", Hex: "#4B3621"},
+	{Name: "light grayish yellowish brown", Code: "79", Hex: "#AE9B82"},
+	{Name: "grayish yellowish brown", Code: "80", Hex: "#7E6D5A"},
+	{Name: "dark grayish yellowish brown", Code: "81", Hex: "#483C32"},
+	{Name: "vivid yellow", Code: "82", Hex: "#F3C300"},
+	{Name: "brilliant yellow", Code: "83", Hex: "#FADA5E"},
+	{Name: "strong yellow", Code: "84", Hex: "#D4AF37"},
+	{Name: "deep yellow", Code: "85", Hex: "#AF8D13"},
+	{Name: "light yellow", Code: "86", Hex: "#F8DE7E"},
+	{Name: "moderate yellow", Code: "87", Hex: "#C9AE5D"},
+	{Name: "dark yellow", Code: "88", Hex: "#AB9144"},
+	{Name: "pale yellow", Code: "89", Hex: "#F3E5AB"},
+	{Name: "grayish yellow", Code: "90", Hex: "#C2B280"},
+	{Name: "dark grayish yellow", Code: "91", Hex: "#A18F60"},
+	{Name: "yellowish white", Code: "92", Hex: "#F0EAD6"},
+	{Name: "yellowish gray", Code: "93", Hex: "#BFB8A5"},
+	{Name: "light olive brown", Code: "94", Hex: "#967117"},
+	{Name: "moderate olive brown", Code: "95", Hex: "#6C541E"},
+	{Name: "dark olive brown", Code: "96", Hex: "#3B3121"},
+	{Name: "vivid greenish yellow", Code: "97", Hex: "#DCD300"},
+	{Name: "brilliant greenish yellow", Code: "98", Hex: "#E9E450"},
+	{Name: "strong greenish yellow", Code: "99", Hex: "#BEB72E"},
+	{Name: "deep greenish yellow", Code: "100", Hex: "#9B9400"},
+	{Name: "light greenish yellow", Code: "101", Hex: "#EAE679"},
+	{Name: "moderate greenish yellow", Code: "102", Hex: "#B9B459"},
+	{Name: "dark greenish yellow", Code: "103", Hex: "#98943E"},
+	{Name: "pale greenish yellow", Code: "104", Hex: "#EBE8A4"},
+	{Name: "grayish greenish yellow", Code: "105", Hex: "#B9B57D"},
+	{Name: "light olive", Code: "106", Hex: "#867E36"},
+	{Name: "moderate olive", Code: "107", Hex: "#665D1E"},
+	{Name: "dark olive", Code: "108", Hex: "#403D21"},
+	{Name: "light grayish olive", Code: "109", Hex: "#8C8767"},
+	{Name: "grayish olive", Code: "110", Hex: "#5B5842"},
+	{Name: "dark grayish olive", Code: "111", Hex: "#363527"},
+	{Name: "light olive gray", Code: "112", Hex: "#8A8776"},
+	{Name: "olive gray", Code: "113", Hex: "#57554C"},
+	{Name: "olive black", Code: "114", Hex: "#25241D"},
+	{Name: "vivid yellow green", Code: "115", Hex: "#8DB600"},
+	{Name: "brilliant yellow green", Code: "116", Hex: "#BDDA57"},
+	{Name: "strong yellow green", Code: "117", Hex: "#7E9F2E"},
+	{Name: "deep yellow green", Code: "118", Hex: "#467129"},
+	{Name: "light yellow green", Code: "119", Hex: "#C9DC89"},
+	{Name: "moderate yellow green", Code: "120", Hex: "#8A9A5B"},
+	{Name: "pale yellow green", Code: "121", Hex: "#DADFB7"},
+	{Name: "grayish yellow green", Code: "122", Hex: "#8F9779"},
+	{Name: "strong olive green", Code: "123", Hex: "#404F00"},
+	{Name: "deep olive green", Code: "124", Hex: "#232F00"},
+	{Name: "moderate olive green", Code: "125", Hex: "#4A5D23"},
+	{Name: "dark olive green", Code: "126", Hex: "#2B3D26"},
+	{Name: "grayish olive green", Code: "127", Hex: "#515744"},
+	{Name: "dark grayish olive green", Code: "128", Hex: "#31362B"},
+	{Name: "vivid yellowish green", Code: "129", Hex: "#27A64C"},
+	{Name: "brilliant yellowish green", Code: "130", Hex: "#83D37D"},
+	{Name: "strong yellowish green", Code: "131", Hex: "#44944A"},
+	{Name: "deep yellowish green", Code: "132", Hex: "#00622D"},
+	{Name: "very deep yellowish green", Code: "133", Hex: "#003118"},
+	{Name: "very light yellowish green", Code: "134", Hex: "#B6E5AF"},
+	{Name: "light yellowish green", Code: "135", Hex: "#93C592"},
+	{Name: "moderate yellowish green", Code: "136", Hex: "#679267"},
+	{Name: "dark yellowish green", Code: "137", Hex: "#355E3B"},
+	{Name: "very dark yellowish green", Code: "138", Hex: "#173620"},
+	{Name: "vivid green", Code: "139", Hex: "#008856"},
+	{Name: "brilliant green", Code: "140", Hex: "#3EB489"},
+	{Name: "strong green", Code: "141", Hex: "#007959"},
+	{Name: "deep green", Code: "142", Hex: "#00543D"},
+	{Name: "very light green", Code: "143", Hex: "#8ED1B2"},
+	{Name: "light green", Code: "144", Hex: "#6AAB8E"},
+	{Name: "moderate green", Code: "145", Hex: "#3B7861"},
+	{Name: "dark green", Code: "146", Hex: "#1B4D3E"},
+	{Name: "very dark green", Code: "147", Hex: "#1C352D"},
+	{Name: "very pale green", Code: "148", Hex: "#C7E6D7"},
+	{Name: "pale green", Code: "149", Hex: "#8DA399"},
+	{Name: "grayish green", Code: "150", Hex: "#5E716A"},
+	{Name: "dark grayish green", Code: "151", Hex: "#3A4B47"},
+	{Name: "blackish green", Code: "152", Hex: "#1A2421"},
+	{Name: "greenish white", Code: "153", Hex: "#DFEDE8"},
+	{Name: "light greenish gray", Code: "154", Hex: "#B2BEB5"},
+	{Name: "greenish gray", Code: "155", Hex: "#7D8984"},
+	{Name: "dark greenish gray", Code: "156", Hex: "#4E5755"},
+	{Name: "greenish black", Code: "157", Hex: "#1E2321"},
+	{Name: "vivid bluish green", Code: "158", Hex: "#008882"},
+	{Name: "brilliant bluish green", Code: "159", Hex: "#00A693"},
+	{Name: "strong bluish green", Code: "160", Hex: "#007A74"},
+	{Name: "deep bluish green", Code: "161", Hex: "#00443F"},
+	{Name: "very light bluish green", Code: "162", Hex: "#96DED1"},
+	{Name: "light bluish green", Code: "163", Hex: "#66ADA4"},
+	{Name: "moderate bluish green", Code: "164", Hex: "#317873"},
+	{Name: "dark bluish green", Code: "165", Hex: "#004B49"},
+	{Name: "very dark bluish green", Code: "166", Hex: "#002A29"},
+	{Name: "vivid greenish blue", Code: "167", Hex: "#0085A1"},
+	{Name: "brilliant greenish blue", Code: "168", Hex: "#239EBA"},
+	{Name: "strong greenish blue", Code: "169", Hex: "#007791"},
+	{Name: "deep greenish blue", Code: "170", Hex: "#2E8495"},
+	{Name: "very light greenish blue", Code: "171", Hex: "#9CD1DC"},
+	{Name: "light greenish blue", Code: "172", Hex: "#66AABC"},
+	{Name: "moderate greenish blue", Code: "173", Hex: "#367588"},
+	{Name: "dark greenish blue", Code: "174", Hex: "#004958"},
+	{Name: "very dark greenish blue", Code: "175", Hex: "#002E3B"},
+	{Name: "vivid blue", Code: "176", Hex: "#00A1C2"},
+	{Name: "brilliant blue", Code: "177", Hex: "#4997D0"},
+	{Name: "strong blue", Code: "178", Hex: "#0067A5"},
+	{Name: "deep blue", Code: "179", Hex: "#00416A"},
+	{Name: "very light blue", Code: "180", Hex: "#A1CAF1"},
+	{Name: "light blue", Code: "181", Hex: "#70A3CC"},
+	{Name: "moderate blue", Code: "182", Hex: "#436B95"},
+	{Name: "dark blue", Code: "183", Hex: "#00304E"},
+	{Name: "very pale blue", Code: "184", Hex: "#BCD4E6"},
+	{Name: "pale blue", Code: "185", Hex: "#91A3B0"},
+	{Name: "grayish blue", Code: "186", Hex: "#536878"},
+	{Name: "dark grayish blue", Code: "187", Hex: "#36454F"},
+	{Name: "blackish blue", Code: "188", Hex: "#202830"},
+	{Name: "bluish white", Code: "189", Hex: "#E9E9ED"},
+	{Name: "light bluish gray", Code: "190", Hex: "#B4BCC0"},
+	{Name: "bluish gray", Code: "191", Hex: "#81878B"},
+	{Name: "dark bluish gray", Code: "192", Hex: "#51585E"},
+	{Name: "bluish black", Code: "193", Hex: "#202428"},
+	{Name: "vivid purplish blue", Code: "194", Hex: "#30267A"},
+	{Name: "brilliant purplish blue", Code: "195", Hex: "#6C79B8"},
+	{Name: "strong purplish blue", Code: "196", Hex: "#545AA7"},
+	{Name: "deep purplish blue", Code: "197", Hex: "#272458"},
+	{Name: "very light purplish blue", Code: "198", Hex: "#B3BCE2"},
+	{Name: "light purplish blue", Code: "199", Hex: "#8791BF"},
+	{Name: "moderate purplish blue", Code: "200", Hex: "#4E5180"},
+	{Name: "dark purplish blue", Code: "201", Hex: "#252440"},
+	{Name: "very pale purplish blue", Code: "202", Hex: "#C0C8E1"},
+	{Name: "pale purplish blue", Code: "203", Hex: "#8C92AC"},
+	{Name: "grayish purplish blue", Code: "204", Hex: "#4C516D"},
+	{Name: "vivid violet", Code: "205", Hex: "#9065CA"},
+	{Name: "brilliant violet", Code: "206", Hex: "#7E73B8"},
+	{Name: "strong violet", Code: "207", Hex: "#604E97"},
+	{Name: "deep violet", Code: "208", Hex: "#32174D"},
+	{Name: "very light violet", Code: "209", Hex: "#DCD0FF"},
+	{Name: "light violet", Code: "210", Hex: "#8C82B5"},
+	{Name: "moderate violet", Code: "211", Hex: "#604E81"},
+	{Name: "dark violet", Code: "212", Hex: "#2F2140"},
+	{Name: "very pale violet", Code: "213", Hex: "#C4C3DD"},
+	{Name: "pale violet", Code: "214", Hex: "#9690AB"},
+	{Name: "grayish violet", Code: "215", Hex: "#554C69"},
+	{Name: "vivid purple", Code: "216", Hex: "#9A4EAE"},
+	{Name: "brilliant purple", Code: "217", Hex: "#D399E6"},
+	{Name: "strong purple", Code: "218", Hex: "#875692"},
+	{Name: "deep purple", Code: "219", Hex: "#602F6B"},
+	{Name: "very deep purple", Code: "220", Hex: "#401A4C"},
+	{Name: "very light purple", Code: "221", Hex: "#D5BADB"},
+	{Name: "light purple", Code: "222", Hex: "#B687C2"},
+	{Name: "moderate purple", Code: "223", Hex: "#86608E"},
+	{Name: "dark purple", Code: "224", Hex: "#563C5C"},
+	{Name: "very dark purple", Code: "225", Hex: "#301934"},
+	{Name: "very pale purple", Code: "226", Hex: "#D6CADD"},
+	{Name: "pale purple", Code: "227", Hex: "#AA98A9"},
+	{Name: "grayish purple", Code: "228", Hex: "#796878"},
+	{Name: "dark grayish purple", Code: "229", Hex: "#50404D"},
+	{Name: "blackish purple", Code: "230", Hex: "#291E29"},
+	{Name: "purplish white", Code: "231", Hex: "#E8E3E5"},
+	{Name: "light purplish gray", Code: "232", Hex: "#BFB9BD"},
+	{Name: "purplish gray", Code: "233", Hex: "#8B8589"},
+	{Name: "dark purplish gray", Code: "234", Hex: "#5D555B"},
+	{Name: "purplish black", Code: "235", Hex: "#242124"},
+	{Name: "vivid reddish purple", Code: "236", Hex: "#870074"},
+	{Name: "strong reddish purple", Code: "237", Hex: "#9E4F88"},
+	{Name: "deep reddish purple", Code: "238", Hex: "#702963"},
+	{Name: "very deep reddish purple", Code: "239", Hex: "#54194E"},
+	{Name: "light reddish purple", Code: "240", Hex: "#B784A7"},
+	{Name: "moderate reddish purple", Code: "241", Hex: "#915C83"},
+	{Name: "dark reddish purple", Code: "242", Hex: "#5D3954"},
+	{Name: "very dark reddish purple", Code: "243", Hex: "#341731"},
+	{Name: "pale reddish purple", Code: "244", Hex: "#AA8A9E"},
+	{Name: "grayish reddish purple", Code: "245", Hex: "#836479"},
+	{Name: "brilliant purplish pink", Code: "246", Hex: "#FFC8D6"},
+	{Name: "strong purplish pink", Code: "247", Hex: "#E68FAC"},
+	{Name: "deep purplish pink", Code: "248", Hex: "#DE6FA1"},
+	{Name: "light purplish pink", Code: "249", Hex: "#EFBBCC"},
+	{Name: "moderate purplish pink", Code: "250", Hex: "#D597AE"},
+	{Name: "dark purplish pink", Code: "251", Hex: "#C17E91"},
+	{Name: "pale purplish pink", Code: "252", Hex: "#E8CCD7"},
+	{Name: "grayish purplish pink", Code: "253", Hex: "#C3A6B1"},
+	{Name: "vivid purplish red", Code: "254", Hex: "#CE4676"},
+	{Name: "strong purplish red", Code: "255", Hex: "#B3446C"},
+	{Name: "deep purplish red", Code: "256", Hex: "#78184A"},
+	{Name: "very deep purplish red", Code: "257", Hex: "#54133B"},
+	{Name: "moderate purplish red", Code: "258", Hex: "#A8516E"},
+	{Name: "dark purplish red", Code: "259", Hex: "#673147"},
+	{Name: "very dark purplish red", Code: "260", Hex: "#38152C"},
+	{Name: "light grayish purplish red", Code: "261", Hex: "#AF868E"},
+	{Name: "grayish purplish red", Code: "262", Hex: "#915F6D"},
+	{Name: "white", Code: "263", Hex: "#F2F3F4"},
+	{Name: "light gray", Code: "264", Hex: "#B9B8B5"},
+	{Name: "medium gray", Code: "265", Hex: "#848482"},
+	{Name: "dark gray", Code: "266", Hex: "#555555"},
+	{Name: "black", Code: "267", Hex: "#222222"},
+}
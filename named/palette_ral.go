@@ -0,0 +1,233 @@
+// Copyright © 2019 Xavier Basty <xavier@hexbee.net>
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package named
+
+// ralColors are the colors of the RAL Classic collection. RAL does not publish
+// sRGB values, the ones below are commonly used approximations.
+var ralColors = []Color{
+	{Name: "Green beige", Code: "RAL 1000", Hex: "#BEBD7F"},
+	{Name: "Beige", Code: "RAL 1001", Hex: "#C2B078"},
+	{Name: "Sand yellow", Code: "RAL 1002", Hex: "#C6A664"},
+	{Name: "Signal yellow", Code: "RAL 1003", Hex: "#E5BE01"},
+	{Name: "Golden yellow", Code: "RAL 1004", Hex: "#CDA434"},
+	{Name: "Honey yellow", Code: "RAL 1005", Hex: "#A98307"},
+	{Name: "Maize yellow", Code: "RAL 1006", Hex: "#E4A010"},
+	{Name: "Daffodil yellow", Code: "RAL 1007", Hex: "#DC9D00"},
+	{Name: "Brown beige", Code: "RAL 1011", Hex: "#8A6642"},
+	{Name: "Lemon yellow", Code: "RAL 1012", Hex: "#C7B446"},
+	{Name: "Oyster white", Code: "RAL 1013", Hex: "#EAE6CA"},
+	{Name: "Ivory", Code: "RAL 1014", Hex: "#E1CC4F"},
+	{Name: "Light ivory", Code: "RAL 1015", Hex: "#E6D690"},
+	{Name: "Sulfur yellow", Code: "RAL 1016", Hex: "#EDFF21"},
+	{Name: "Saffron yellow", Code: "RAL 1017", Hex: "#F5D033"},
+	{Name: "Zinc yellow", Code: "RAL 1018", Hex: "#F8F32B"},
+	{Name: "Grey beige", Code: "RAL 1019", Hex: "#9E9764"},
+	{Name: "Olive yellow", Code: "RAL 1020", Hex: "#999950"},
+	{Name: "Rape yellow", Code: "RAL 1021", Hex: "#F3DA0B"},
+	{Name: "Traffic yellow", Code: "RAL 1023", Hex: "#FAD201"},
+	{Name: "Ochre yellow", Code: "RAL 1024", Hex: "#AEA04B"},
+	{Name: "Luminous yellow", Code: "RAL 1026", Hex: "#FFFF00"},
+	{Name: "Curry", Code: "RAL 1027", Hex: "#9D9101"},
+	{Name: "Melon yellow", Code: "RAL 1028", Hex: "#F4A900"},
+	{Name: "Broom yellow", Code: "RAL 1032", Hex: "#D6AE01"},
+	{Name: "Dahlia yellow", Code: "RAL 1033", Hex: "#F3A505"},
+	{Name: "Pastel yellow", Code: "RAL 1034", Hex: "#EFA94A"},
+	{Name: "Pearl beige", Code: "RAL 1035", Hex: "#6A5D4D"},
+	{Name: "Pearl gold", Code: "RAL 1036", Hex: "#705335"},
+	{Name: "Sun yellow", Code: "RAL 1037", Hex: "#F39F18"},
+	{Name: "Yellow orange", Code: "RAL 2000", Hex: "#ED760E"},
+	{Name: "Red orange", Code: "RAL 2001", Hex: "#C93C20"},
+	{Name: "Vermilion", Code: "RAL 2002", Hex: "#CB2821"},
+	{Name: "Pastel orange", Code: "RAL 2003", Hex: "#FF7514"},
+	{Name: "Pure orange", Code: "RAL 2004", Hex: "#F44611"},
+	{Name: "Luminous orange", Code: "RAL 2005", Hex: "#FF2301"},
+	{Name: "Luminous bright orange", Code: "RAL 2007", Hex: "#FFA420"},
+	{Name: "Bright red orange", Code: "RAL 2008", Hex: "#F75E25"},
+	{Name: "Traffic orange", Code: "RAL 2009", Hex: "#F54021"},
+	{Name: "Signal orange", Code: "RAL 2010", Hex: "#D84B20"},
+	{Name: "Deep orange", Code: "RAL 2011", Hex: "#EC7C26"},
+	{Name: "Salmon orange", Code: "RAL 2012", Hex: "#E55137"},
+	{Name: "Pearl orange", Code: "RAL 2013", Hex: "#C35831"},
+	{Name: "Flame red", Code: "RAL 3000", Hex: "#AF2B1E"},
+	{Name: "Signal red", Code: "RAL 3001", Hex: "#A52019"},
+	{Name: "Carmine red", Code: "RAL 3002", Hex: "#A2231D"},
+	{Name: "Ruby red", Code: "RAL 3003", Hex: "#9B111E"},
+	{Name: "Purple red", Code: "RAL 3004", Hex: "#75151E"},
+	{Name: "Wine red", Code: "RAL 3005", Hex: "#5E2129"},
+	{Name: "Black red", Code: "RAL 3007", Hex: "#412227"},
+	{Name: "Oxide red", Code: "RAL 3009", Hex: "#642424"},
+	{Name: "Brown red", Code: "RAL 3011", Hex: "#781F19"},
+	{Name: "Beige red", Code: "RAL 3012", Hex: "#C1876B"},
+	{Name: "Tomato red", Code: "RAL 3013", Hex: "#A12312"},
+	{Name: "Antique pink", Code: "RAL 3014", Hex: "#D36E70"},
+	{Name: "Light pink", Code: "RAL 3015", Hex: "#EA899A"},
+	{Name: "Coral red", Code: "RAL 3016", Hex: "#B32821"},
+	{Name: "Rose", Code: "RAL 3017", Hex: "#E63244"},
+	{Name: "Strawberry red", Code: "RAL 3018", Hex: "#D53032"},
+	{Name: "Traffic red", Code: "RAL 3020", Hex: "#CC0605"},
+	{Name: "Salmon pink", Code: "RAL 3022", Hex: "#D95030"},
+	{Name: "Luminous red", Code: "RAL 3024", Hex: "#F80000"},
+	{Name: "Luminous bright red", Code: "RAL 3026", Hex: "#FE0000"},
+	{Name: "Raspberry red", Code: "RAL 3027", Hex: "#C51D34"},
+	{Name: "Pure red", Code: "RAL 3028", Hex: "#CB3234"},
+	{Name: "Orient red", Code: "RAL 3031", Hex: "#B32428"},
+	{Name: "Pearl ruby red", Code: "RAL 3032", Hex: "#721422"},
+	{Name: "Pearl pink", Code: "RAL 3033", Hex: "#B44C43"},
+	{Name: "Red lilac", Code: "RAL 4001", Hex: "#6D3F5B"},
+	{Name: "Red violet", Code: "RAL 4002", Hex: "#922B3E"},
+	{Name: "Heather violet", Code: "RAL 4003", Hex: "#DE4C8A"},
+	{Name: "Claret violet", Code: "RAL 4004", Hex: "#641C34"},
+	{Name: "Blue lilac", Code: "RAL 4005", Hex: "#6C4675"},
+	{Name: "Traffic purple", Code: "RAL 4006", Hex: "#A03472"},
+	{Name: "Purple violet", Code: "RAL 4007", Hex: "#4A192C"},
+	{Name: "Signal violet", Code: "RAL 4008", Hex: "#924E7D"},
+	{Name: "Pastel violet", Code: "RAL 4009", Hex: "#A18594"},
+	{Name: "Telemagenta", Code: "RAL 4010", Hex: "#CF3476"},
+	{Name: "Pearl violet", Code: "RAL 4011", Hex: "#8673A1"},
+	{Name: "Pearl blackberry", Code: "RAL 4012", Hex: "#6C6874"},
+	{Name: "Violet blue", Code: "RAL 5000", Hex: "#354D73"},
+	{Name: "Green blue", Code: "RAL 5001", Hex: "#1F3438"},
+	{Name: "Ultramarine blue", Code: "RAL 5002", Hex: "#20214F"},
+	{Name: "Sapphire blue", Code: "RAL 5003", Hex: "#1D1E33"},
+	{Name: "Black blue", Code: "RAL 5004", Hex: "#18171C"},
+	{Name: "Signal blue", Code: "RAL 5005", Hex: "#1E2460"},
+	{Name: "Brilliant blue", Code: "RAL 5007", Hex: "#3E5F8A"},
+	{Name: "Grey blue", Code: "RAL 5008", Hex: "#26252D"},
+	{Name: "Azure blue", Code: "RAL 5009", Hex: "#025669"},
+	{Name: "Gentian blue", Code: "RAL 5010", Hex: "#0E294B"},
+	{Name: "Steel blue", Code: "RAL 5011", Hex: "#231A24"},
+	{Name: "Light blue", Code: "RAL 5012", Hex: "#3B83BD"},
+	{Name: "Cobalt blue", Code: "RAL 5013", Hex: "#1E213D"},
+	{Name: "Pigeon blue", Code: "RAL 5014", Hex: "#606E8C"},
+	{Name: "Sky blue", Code: "RAL 5015", Hex: "#2271B3"},
+	{Name: "Traffic blue", Code: "RAL 5017", Hex: "#063971"},
+	{Name: "Turquoise blue", Code: "RAL 5018", Hex: "#3F888F"},
+	{Name: "Capri blue", Code: "RAL 5019", Hex: "#1B5583"},
+	{Name: "Ocean blue", Code: "RAL 5020", Hex: "#1D334A"},
+	{Name: "Water blue", Code: "RAL 5021", Hex: "#256D7B"},
+	{Name: "Night blue", Code: "RAL 5022", Hex: "#252850"},
+	{Name: "Distant blue", Code: "RAL 5023", Hex: "#49678D"},
+	{Name: "Pastel blue", Code: "RAL 5024", Hex: "#5D9B9B"},
+	{Name: "Pearl gentian blue", Code: "RAL 5025", Hex: "#2A6478"},
+	{Name: "Pearl night blue", Code: "RAL 5026", Hex: "#102C54"},
+	{Name: "Patina green", Code: "RAL 6000", Hex: "#316650"},
+	{Name: "Emerald green", Code: "RAL 6001", Hex: "#287233"},
+	{Name: "Leaf green", Code: "RAL 6002", Hex: "#2D572C"},
+	{Name: "Olive green", Code: "RAL 6003", Hex: "#424632"},
+	{Name: "Blue green", Code: "RAL 6004", Hex: "#1F3A3D"},
+	{Name: "Moss green", Code: "RAL 6005", Hex: "#2F4538"},
+	{Name: "Grey olive", Code: "RAL 6006", Hex: "#3E3B32"},
+	{Name: "Bottle green", Code: "RAL 6007", Hex: "#343B29"},
+	{Name: "Brown green", Code: "RAL 6008", Hex: "#39352A"},
+	{Name: "Fir green", Code: "RAL 6009", Hex: "#31372B"},
+	{Name: "Grass green", Code: "RAL 6010", Hex: "#35682D"},
+	{Name: "Reseda green", Code: "RAL 6011", Hex: "#587246"},
+	{Name: "Black green", Code: "RAL 6012", Hex: "#343E40"},
+	{Name: "Reed green", Code: "RAL 6013", Hex: "#6C7156"},
+	{Name: "Yellow olive", Code: "RAL 6014", Hex: "#47402E"},
+	{Name: "Black olive", Code: "RAL 6015", Hex: "#3B3C36"},
+	{Name: "Turquoise green", Code: "RAL 6016", Hex: "#1E5945"},
+	{Name: "May green", Code: "RAL 6017", Hex: "#4C9141"},
+	{Name: "Yellow green", Code: "RAL 6018", Hex: "#57A639"},
+	{Name: "Pastel green", Code: "RAL 6019", Hex: "#BDECB6"},
+	{Name: "Chrome green", Code: "RAL 6020", Hex: "#2E3A23"},
+	{Name: "Pale green", Code: "RAL 6021", Hex: "#89AC76"},
+	{Name: "Olive drab", Code: "RAL 6022", Hex: "#25221B"},
+	{Name: "Traffic green", Code: "RAL 6024", Hex: "#308446"},
+	{Name: "Fern green", Code: "RAL 6025", Hex: "#3D642D"},
+	{Name: "Opal green", Code: "RAL 6026", Hex: "#015D52"},
+	{Name: "Light green", Code: "RAL 6027", Hex: "#84C3BE"},
+	{Name: "Pine green", Code: "RAL 6028", Hex: "#2C5545"},
+	{Name: "Mint green", Code: "RAL 6029", Hex: "#20603D"},
+	{Name: "Signal green", Code: "RAL 6032", Hex: "#317F43"},
+	{Name: "Mint turquoise", Code: "RAL 6033", Hex: "#497E76"},
+	{Name: "Pastel turquoise", Code: "RAL 6034", Hex: "#7FB5B5"},
+	{Name: "Pearl green", Code: "RAL 6035", Hex: "#1C542D"},
+	{Name: "Pearl opal green", Code: "RAL 6036", Hex: "#193737"},
+	{Name: "Pure green", Code: "RAL 6037", Hex: "#008F39"},
+	{Name: "Luminous green", Code: "RAL 6038", Hex: "#00BB2D"},
+	{Name: "Squirrel grey", Code: "RAL 7000", Hex: "#78858B"},
+	{Name: "Silver grey", Code: "RAL 7001", Hex: "#8A9597"},
+	{Name: "Olive grey", Code: "RAL 7002", Hex: "#7E7B52"},
+	{Name: "Moss grey", Code: "RAL 7003", Hex: "#6C7059"},
+	{Name: "Signal grey", Code: "RAL 7004", Hex: "#969992"},
+	{Name: "Mouse grey", Code: "RAL 7005", Hex: "#646B63"},
+	{Name: "Beige grey", Code: "RAL 7006", Hex: "#6D6552"},
+	{Name: "Khaki grey", Code: "RAL 7008", Hex: "#6A5F31"},
+	{Name: "Green grey", Code: "RAL 7009", Hex: "#4D5645"},
+	{Name: "Tarpaulin grey", Code: "RAL 7010", Hex: "#4C514A"},
+	{Name: "Iron grey", Code: "RAL 7011", Hex: "#434B4D"},
+	{Name: "Basalt grey", Code: "RAL 7012", Hex: "#4E5754"},
+	{Name: "Brown grey", Code: "RAL 7013", Hex: "#464531"},
+	{Name: "Slate grey", Code: "RAL 7015", Hex: "#434750"},
+	{Name: "Anthracite grey", Code: "RAL 7016", Hex: "#293133"},
+	{Name: "Black grey", Code: "RAL 7021", Hex: "#23282B"},
+	{Name: "Umbra grey", Code: "RAL 7022", Hex: "#332F2C"},
+	{Name: "Concrete grey", Code: "RAL 7023", Hex: "#686C5E"},
+	{Name: "Graphite grey", Code: "RAL 7024", Hex: "#474A51"},
+	{Name: "Granite grey", Code: "RAL 7026", Hex: "#2F353B"},
+	{Name: "Stone grey", Code: "RAL 7030", Hex: "#8B8C7A"},
+	{Name: "Blue grey", Code: "RAL 7031", Hex: "#474B4E"},
+	{Name: "Pebble grey", Code: "RAL 7032", Hex: "#B8B799"},
+	{Name: "Cement grey", Code: "RAL 7033", Hex: "#7D8471"},
+	{Name: "Yellow grey", Code: "RAL 7034", Hex: "#8F8B66"},
+	{Name: "Light grey", Code: "RAL 7035", Hex: "#D7D7D7"},
+	{Name: "Platinum grey", Code: "RAL 7036", Hex: "#7F7679"},
+	{Name: "Dusty grey", Code: "RAL 7037", Hex: "#7D7F7D"},
+	{Name: "Agate grey", Code: "RAL 7038", Hex: "#B5B8B1"},
+	{Name: "Quartz grey", Code: "RAL 7039", Hex: "#6C6960"},
+	{Name: "Window grey", Code: "RAL 7040", Hex: "#9DA1AA"},
+	{Name: "Traffic grey A", Code: "RAL 7042", Hex: "#8D948D"},
+	{Name: "Traffic grey B", Code: "RAL 7043", Hex: "#4E5452"},
+	{Name: "Silk grey", Code: "RAL 7044", Hex: "#CAC4B0"},
+	{Name: "Telegrey 1", Code: "RAL 7045", Hex: "#909090"},
+	{Name: "Telegrey 2", Code: "RAL 7046", Hex: "#82898F"},
+	{Name: "Telegrey 4", Code: "RAL 7047", Hex: "#D0D0D0"},
+	{Name: "Pearl mouse grey", Code: "RAL 7048", Hex: "#898176"},
+	{Name: "Green brown", Code: "RAL 8000", Hex: "#826C34"},
+	{Name: "Ochre brown", Code: "RAL 8001", Hex: "#955F20"},
+	{Name: "Signal brown", Code: "RAL 8002", Hex: "#6C3B2A"},
+	{Name: "Clay brown", Code: "RAL 8003", Hex: "#734222"},
+	{Name: "Copper brown", Code: "RAL 8004", Hex: "#8E402A"},
+	{Name: "Fawn brown", Code: "RAL 8007", Hex: "#59351F"},
+	{Name: "Olive brown", Code: "RAL 8008", Hex: "#6F4F28"},
+	{Name: "Nut brown", Code: "RAL 8011", Hex: "#5B3A29"},
+	{Name: "Red brown", Code: "RAL 8012", Hex: "#592321"},
+	{Name: "Sepia brown", Code: "RAL 8014", Hex: "#382C1E"},
+	{Name: "Chestnut brown", Code: "RAL 8015", Hex: "#633A34"},
+	{Name: "Mahogany brown", Code: "RAL 8016", Hex: "#4C2F27"},
+	{Name: "Chocolate brown", Code: "RAL 8017", Hex: "#45322E"},
+	{Name: "Grey brown", Code: "RAL 8019", Hex: "#403A3A"},
+	{Name: "Black brown", Code: "RAL 8022", Hex: "#212121"},
+	{Name: "Orange brown", Code: "RAL 8023", Hex: "#A65E2E"},
+	{Name: "Beige brown", Code: "RAL 8024", Hex: "#79553D"},
+	{Name: "Pale brown", Code: "RAL 8025", Hex: "#755C48"},
+	{Name: "Terra brown", Code: "RAL 8028", Hex: "#4E3B31"},
+	{Name: "Pearl copper", Code: "RAL 8029", Hex: "#763C28"},
+	{Name: "Cream", Code: "RAL 9001", Hex: "#FDF4E3"},
+	{Name: "Grey white", Code: "RAL 9002", Hex: "#E7EBDA"},
+	{Name: "Signal white", Code: "RAL 9003", Hex: "#F4F4F4"},
+	{Name: "Signal black", Code: "RAL 9004", Hex: "#282828"},
+	{Name: "Jet black", Code: "RAL 9005", Hex: "#0A0A0A"},
+	{Name: "White aluminium", Code: "RAL 9006", Hex: "#A5A5A5"},
+	{Name: "Grey aluminium", Code: "RAL 9007", Hex: "#8F8F8F"},
+	{Name: "Pure white", Code: "RAL 9010", Hex: "#FFFFFF"},
+	{Name: "Graphite black", Code: "RAL 9011", Hex: "#1C1C1C"},
+	{Name: "Traffic white", Code: "RAL 9016", Hex: "#F6F6F6"},
+	{Name: "Traffic black", Code: "RAL 9017", Hex: "#1E1E1E"},
+	{Name: "Papyrus white", Code: "RAL 9018", Hex: "#D7D7D7"},
+	{Name: "Pearl light grey", Code: "RAL 9022", Hex: "#9C9C9C"},
+	{Name: "Pearl dark grey", Code: "RAL 9023", Hex: "#828282"},
+}
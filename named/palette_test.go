@@ -0,0 +1,201 @@
+// Copyright © 2019 Xavier Basty <xavier@hexbee.net>
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package named_test
+
+import (
+	"regexp"
+	"sort"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/Hexbee-net/gocolor/named"
+)
+
+func TestPalettes(t *testing.T) {
+	sizes := map[*named.Palette]int{
+		named.CSS:       148,
+		named.RAL:       213,
+		named.XKCD:      949,
+		named.Crayola:   133,
+		named.ISCCNBS:   267,
+		named.Wikipedia: len(named.NamedColors),
+	}
+
+	hex := regexp.MustCompile("^#[0-9A-Fa-f]{6}$")
+	for _, p := range named.Palettes {
+		if size, ok := sizes[p]; ok {
+			assert.Equal(t, size, p.Len(), p.Name)
+		}
+
+		names := map[string]bool{}
+		for _, c := range p.Colors() {
+			assert.Regexp(t, hex, c.Hex, "%v: %v", p.Name, c.Name)
+			assert.False(t, names[c.Name], "%v: duplicate color %v", p.Name, c.Name)
+			names[c.Name] = true
+
+			found, ok := p.Lookup(c.Name)
+			require.True(t, ok, "%v: %v", p.Name, c.Name)
+			assert.Equal(t, c, found, p.Name)
+		}
+	}
+
+	names := make([]string, 0, named.XKCD.Len())
+	for _, c := range named.XKCD.Colors() {
+		names = append(names, c.Name)
+	}
+	assert.True(t, sort.StringsAreSorted(names))
+}
+
+func TestPaletteLookup(t *testing.T) {
+	tests := []struct {
+		palette *named.Palette
+		name    string
+		hex     string
+	}{
+		{named.CSS, "aliceblue", "#F0F8FF"},
+		{named.CSS, "Alice Blue", "#F0F8FF"},
+		{named.CSS, "gray", "#808080"},
+		{named.X11, "gray", "#BEBEBE"},
+		{named.X11, "Ghost White", "#F8F8FF"},
+		{named.X11, "GhostWhite", "#F8F8FF"},
+		{named.X11, "gray50", "#7F7F7F"},
+		{named.RAL, "RAL 1000", "#BEBD7F"},
+		{named.RAL, "ral1000", "#BEBD7F"},
+		{named.RAL, "Green beige", "#BEBD7F"},
+		{named.XKCD, "dark green", "#033500"},
+		{named.XKCD, "darkgreen", "#054907"},
+		{named.XKCD, "Dark-Green", "#033500"},
+		{named.Crayola, "robins egg blue", "#1FCECB"},
+		{named.ISCCNBS, "1", "#FFB5BA"},
+		{named.ISCCNBS, "vivid pink", "#FFB5BA"},
+		{named.Wikipedia, "azurex11", "#F0FFFF"},
+	}
+
+	for _, test := range tests {
+		c, ok := test.palette.Lookup(test.name)
+		require.True(t, ok, "%v: %v", test.palette.Name, test.name)
+		assert.Equal(t, test.hex, c.Hex, "%v: %v", test.palette.Name, test.name)
+	}
+
+	_, ok := named.CSS.Lookup("azurex11")
+	assert.False(t, ok)
+	_, ok = named.CSS.Lookup("")
+	assert.False(t, ok)
+}
+
+func TestNewPalette(t *testing.T) {
+	colors := []named.Color{{Name: "Red", Hex: "#FF0000"}, {Name: "red!", Hex: "#EE0000"}}
+	p := named.NewPalette("test", colors)
+	colors[0].Hex = "#000000"
+
+	c, ok := p.Lookup("RED")
+	require.True(t, ok)
+	assert.Equal(t, "#FF0000", c.Hex)
+
+	c, ok = p.Lookup("Red!")
+	require.True(t, ok)
+	assert.Equal(t, "#EE0000", c.Hex)
+}
+
+func TestColorMetadata(t *testing.T) {
+	c, ok := named.Wikipedia.Lookup("azurex11")
+	require.True(t, ok)
+	assert.Equal(t, "Azure (X11)", c.Name)
+	assert.Equal(t, "X11", c.Source)
+
+	c, ok = named.Wikipedia.Lookup("ambersaeece")
+	require.True(t, ok)
+	assert.Equal(t, "Amber (SAE/ECE)", c.Name)
+	assert.Equal(t, "SAE/ECE", c.Source)
+
+	c, ok = named.Wikipedia.Lookup("Gray (X11)")
+	require.True(t, ok)
+	assert.Equal(t, named.GrayX11Gray, c.Hex)
+	assert.Equal(t, "X11", c.Source)
+
+	c, ok = named.Wikipedia.Lookup("Alice Blue")
+	require.True(t, ok)
+	assert.Equal(t, "Wikipedia", c.Source)
+	assert.InDeltaSlice(t, []float64{240.0 / 255, 248.0 / 255, 1}, c.RGB[:], 1e-12)
+
+	c, ok = named.CSS.Lookup("red")
+	require.True(t, ok)
+	assert.Equal(t, "CSS", c.Source)
+	assert.Equal(t, named.FamilyRed, c.Family)
+	assert.InDeltaSlice(t, []float64{53.2408, 80.0925, 67.2032}, c.Lab[:], 1e-3)
+
+	families := map[string]string{
+		"orange":      named.FamilyOrange,
+		"yellow":      named.FamilyYellow,
+		"green":       named.FamilyGreen,
+		"cyan":        named.FamilyCyan,
+		"blue":        named.FamilyBlue,
+		"purple":      named.FamilyPurple,
+		"pink":        named.FamilyPink,
+		"saddlebrown": named.FamilyBrown,
+		"white":       named.FamilyGray,
+		"black":       named.FamilyGray,
+	}
+	for name, family := range families {
+		c, ok := named.CSS.Lookup(name)
+		require.True(t, ok, name)
+		assert.Equal(t, family, c.Family, name)
+	}
+
+	for _, p := range named.Palettes {
+		for _, c := range p.Colors() {
+			assert.Contains(t, named.Families, c.Family, "%v: %v", p.Name, c.Name)
+			assert.NotEmpty(t, c.Source, "%v: %v", p.Name, c.Name)
+		}
+	}
+
+	p := named.NewPalette("test", []named.Color{{Name: "invalid", Hex: "red"}})
+	c, ok = p.Lookup("invalid")
+	require.True(t, ok)
+	assert.Equal(t, "test", c.Source)
+	assert.Empty(t, c.Family)
+	assert.Equal(t, [3]float64{}, c.RGB)
+}
+
+func TestPaletteByHex(t *testing.T) {
+	var names []string
+	for _, c := range named.Wikipedia.ByHex(named.AzureX11) {
+		names = append(names, c.Name)
+	}
+	assert.Equal(t, []string{"Azure (X11)"}, names)
+
+	names = nil
+	for _, c := range named.CSS.ByHex("#00ffff") {
+		names = append(names, c.Name)
+	}
+	assert.Equal(t, []string{"aqua", "cyan"}, names)
+
+	assert.Empty(t, named.CSS.ByHex("#123456"))
+}
+
+func TestPaletteByFamily(t *testing.T) {
+	total := 0
+	for _, family := range named.Families {
+		colors := named.CSS.ByFamily(family)
+		for _, c := range colors {
+			assert.Equal(t, family, c.Family, c.Name)
+		}
+		total += len(colors)
+	}
+	assert.Equal(t, named.CSS.Len(), total)
+	assert.Empty(t, named.CSS.ByFamily("unknown"))
+}
@@ -0,0 +1,978 @@
+// Copyright © 2019 Xavier Basty <xavier@hexbee.net>
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package named
+
+// wikipediaColors are the colors of NamedColors, sorted by name, with the
+// source of the colors whose name is disambiguated by it.
+var wikipediaColors = []Color{
+	{Name: "Absolute Zero", Hex: AbsoluteZero},
+	{Name: "Acid Green", Hex: AcidGreen},
+	{Name: "Aero", Hex: Aero},
+	{Name: "Aero Blue", Hex: AeroBlue},
+	{Name: "African Violet", Hex: AfricanViolet},
+	{Name: "Air Superiority Blue", Hex: AirSuperiorityBlue},
+	{Name: "Alabaster", Hex: Alabaster},
+	{Name: "Alice Blue", Hex: AliceBlue},
+	{Name: "Alloy Orange", Hex: AlloyOrange},
+	{Name: "Almond", Hex: Almond},
+	{Name: "Amaranth", Hex: Amaranth},
+	{Name: "Amaranth (M&P)", Source: "Maerz and Paul", Hex: AmaranthMaerzPaul},
+	{Name: "Amaranth Pink", Hex: AmaranthPink},
+	{Name: "Amaranth Purple", Hex: AmaranthPurple},
+	{Name: "Amaranth Red", Hex: AmaranthRed},
+	{Name: "Amazon", Hex: Amazon},
+	{Name: "Amber", Hex: Amber},
+	{Name: "Amber (SAE/ECE)", Source: "SAE/ECE", Hex: AmberSaeEce},
+	{Name: "Amethyst", Hex: Amethyst},
+	{Name: "Android Green", Hex: AndroidGreen},
+	{Name: "Antique Brass", Hex: AntiqueBrass},
+	{Name: "Antique Bronze", Hex: AntiqueBronze},
+	{Name: "Antique Fuchsia", Hex: AntiqueFuchsia},
+	{Name: "Antique Ruby", Hex: AntiqueRuby},
+	{Name: "Antique White", Hex: AntiqueWhite},
+	{Name: "Ao", Hex: Ao},
+	{Name: "Apple Green", Hex: AppleGreen},
+	{Name: "Apricot", Hex: Apricot},
+	{Name: "Aqua", Hex: Aqua},
+	{Name: "Aquamarine", Hex: Aquamarine},
+	{Name: "Arctic Lime", Hex: ArcticLime},
+	{Name: "Army Green", Hex: ArmyGreen},
+	{Name: "Artichoke", Hex: Artichoke},
+	{Name: "Arylide Yellow", Hex: ArylideYellow},
+	{Name: "Ash Gray", Hex: AshGray},
+	{Name: "Asparagus", Hex: Asparagus},
+	{Name: "Atomic Tangerine", Hex: AtomicTangerine},
+	{Name: "Auburn", Hex: Auburn},
+	{Name: "Aureolin", Hex: Aureolin},
+	{Name: "Avocado", Hex: Avocado},
+	{Name: "Azure", Hex: Azure},
+	{Name: "Azure (X11)", Source: "X11", Hex: AzureX11},
+	{Name: "Baby Blue", Hex: BabyBlue},
+	{Name: "Baby Blue Eyes", Hex: BabyBlueEyes},
+	{Name: "Baby Pink", Hex: BabyPink},
+	{Name: "Baby Powder", Hex: BabyPowder},
+	{Name: "Baker Miller Pink", Hex: BakerMillerPink},
+	{Name: "Banana Mania", Hex: BananaMania},
+	{Name: "Barbie Pink", Hex: BarbiePink},
+	{Name: "Barn Red", Hex: BarnRed},
+	{Name: "Battleship Grey", Hex: BattleshipGrey},
+	{Name: "Bdazzled Blue", Hex: BdazzledBlue},
+	{Name: "Beau Blue", Hex: BeauBlue},
+	{Name: "Beaver", Hex: Beaver},
+	{Name: "Beige", Hex: Beige},
+	{Name: "Big Dip O Ruby", Hex: BigDipORuby},
+	{Name: "Bisque", Hex: Bisque},
+	{Name: "Bistre", Hex: Bistre},
+	{Name: "Bistre Brown", Hex: BistreBrown},
+	{Name: "Bitter Lemon", Hex: BitterLemon},
+	{Name: "Bitter Lime", Hex: BitterLime},
+	{Name: "Bittersweet", Hex: Bittersweet},
+	{Name: "Bittersweet Shimmer", Hex: BittersweetShimmer},
+	{Name: "Black", Hex: Black},
+	{Name: "Black Bean", Hex: BlackBean},
+	{Name: "Black Chocolate", Hex: BlackChocolate},
+	{Name: "Black Coffee", Hex: BlackCoffee},
+	{Name: "Black Coral", Hex: BlackCoral},
+	{Name: "Black Olive", Hex: BlackOlive},
+	{Name: "Black Shadows", Hex: BlackShadows},
+	{Name: "Blanched Almond", Hex: BlanchedAlmond},
+	{Name: "Blast Off Bronze", Hex: BlastOffBronze},
+	{Name: "Bleu De France", Hex: BleuDeFrance},
+	{Name: "Blizzard Blue", Hex: BlizzardBlue},
+	{Name: "Blond", Hex: Blond},
+	{Name: "Blood Red", Hex: BloodRed},
+	{Name: "Blue", Hex: Blue},
+	{Name: "Blue Bell", Hex: BlueBell},
+	{Name: "Blue (Crayola)", Source: "Crayola", Hex: BlueCrayola},
+	{Name: "Blue Gray", Hex: BlueGray},
+	{Name: "Blue Green", Hex: BlueGreen},
+	{Name: "Blue Green (color wheel)", Source: "Color wheel", Hex: BlueGreenColorWheel},
+	{Name: "Blue Jeans", Hex: BlueJeans},
+	{Name: "Blue (Munsell)", Source: "Munsell", Hex: BlueMunsell},
+	{Name: "Blue (NCS)", Source: "NCS", Hex: BlueNCS},
+	{Name: "Blue (Pantone)", Source: "Pantone", Hex: BluePantone},
+	{Name: "Blue (pigment)", Source: "Pigment", Hex: Bluepigment},
+	{Name: "Blue (RYB)", Source: "RYB", Hex: BlueRYB},
+	{Name: "Blue Sapphire", Hex: BlueSapphire},
+	{Name: "Bluetiful", Hex: Bluetiful},
+	{Name: "Blue Violet", Hex: BlueViolet},
+	{Name: "Blue Violet (color wheel)", Source: "Color wheel", Hex: BlueVioletColorWheel},
+	{Name: "Blue Violet (Crayola)", Source: "Crayola", Hex: BlueVioletCrayola},
+	{Name: "Blue Yonder", Hex: BlueYonder},
+	{Name: "Blush", Hex: Blush},
+	{Name: "Bole", Hex: Bole},
+	{Name: "Bone", Hex: Bone},
+	{Name: "Bottle Green", Hex: BottleGreen},
+	{Name: "Brandy", Hex: Brandy},
+	{Name: "Brick Red", Hex: BrickRed},
+	{Name: "Bright Green", Hex: BrightGreen},
+	{Name: "Bright Lilac", Hex: BrightLilac},
+	{Name: "Bright Maroon", Hex: BrightMaroon},
+	{Name: "Bright Navy Blue", Hex: BrightNavyBlue},
+	{Name: "Bright Yellow (Crayola)", Source: "Crayola", Hex: BrightYellowCrayola},
+	{Name: "Brilliant Rose", Hex: BrilliantRose},
+	{Name: "Brink Pink", Hex: BrinkPink},
+	{Name: "British Racing Green", Hex: BritishRacingGreen},
+	{Name: "Bronze", Hex: Bronze},
+	{Name: "Brown", Hex: Brown},
+	{Name: "Brown Sugar", Hex: BrownSugar},
+	{Name: "Brunswick Green", Hex: BrunswickGreen},
+	{Name: "Bud Green", Hex: BudGreen},
+	{Name: "Buff", Hex: Buff},
+	{Name: "Burgundy", Hex: Burgundy},
+	{Name: "Burlywood", Hex: Burlywood},
+	{Name: "Burnished Brown", Hex: BurnishedBrown},
+	{Name: "Burnt Orange", Hex: BurntOrange},
+	{Name: "Burnt Sienna", Hex: BurntSienna},
+	{Name: "Burnt Umber", Hex: BurntUmber},
+	{Name: "Byzantine", Hex: Byzantine},
+	{Name: "Byzantium", Hex: Byzantium},
+	{Name: "Cadet", Hex: Cadet},
+	{Name: "Cadet Blue", Hex: CadetBlue},
+	{Name: "Cadet Blue (Crayola)", Source: "Crayola", Hex: CadetBlueCrayola},
+	{Name: "Cadet Grey", Hex: CadetGrey},
+	{Name: "Cadmium Green", Hex: CadmiumGreen},
+	{Name: "Cadmium Orange", Hex: CadmiumOrange},
+	{Name: "Cadmium Red", Hex: CadmiumRed},
+	{Name: "Cadmium Yellow", Hex: CadmiumYellow},
+	{Name: "Cafe Au Lait", Hex: CafeAuLait},
+	{Name: "Cafe Noir", Hex: CafeNoir},
+	{Name: "Cambridge Blue", Hex: CambridgeBlue},
+	{Name: "Camel", Hex: Camel},
+	{Name: "Cameo Pink", Hex: CameoPink},
+	{Name: "Canary", Hex: Canary},
+	{Name: "Canary Yellow", Hex: CanaryYellow},
+	{Name: "Candy Apple Red", Hex: CandyAppleRed},
+	{Name: "Candy Pink", Hex: CandyPink},
+	{Name: "Capri", Hex: Capri},
+	{Name: "Caput Mortuum", Hex: CaputMortuum},
+	{Name: "Cardinal", Hex: Cardinal},
+	{Name: "Caribbean Green", Hex: CaribbeanGreen},
+	{Name: "Carmine", Hex: Carmine},
+	{Name: "Carmine (M&P)", Source: "Maerz and Paul", Hex: CarmineMaerzPaul},
+	{Name: "Carnation Pink", Hex: CarnationPink},
+	{Name: "Carnelian", Hex: Carnelian},
+	{Name: "Carolina Blue", Hex: CarolinaBlue},
+	{Name: "Carrot Orange", Hex: CarrotOrange},
+	{Name: "Castleton Green", Hex: CastletonGreen},
+	{Name: "Catawba", Hex: Catawba},
+	{Name: "Cedar Chest", Hex: CedarChest},
+	{Name: "Celadon", Hex: Celadon},
+	{Name: "Celadon Blue", Hex: CeladonBlue},
+	{Name: "Celadon Green", Hex: CeladonGreen},
+	{Name: "Celeste", Hex: Celeste},
+	{Name: "Celtic Blue", Hex: CelticBlue},
+	{Name: "Cerise", Hex: Cerise},
+	{Name: "Cerulean", Hex: Cerulean},
+	{Name: "Cerulean Blue", Hex: CeruleanBlue},
+	{Name: "Cerulean (Crayola)", Source: "Crayola", Hex: CeruleanCrayola},
+	{Name: "Cerulean Frost", Hex: CeruleanFrost},
+	{Name: "CG Blue", Hex: CGBlue},
+	{Name: "CG Red", Hex: CGRed},
+	{Name: "Champagne", Hex: Champagne},
+	{Name: "Champagne Pink", Hex: ChampagnePink},
+	{Name: "Charcoal", Hex: Charcoal},
+	{Name: "Charleston Green", Hex: CharlestonGreen},
+	{Name: "Charm Pink", Hex: CharmPink},
+	{Name: "Chartreuse (traditional)", Source: "Traditional", Hex: ChartreuseTraditional},
+	{Name: "Chartreuse (web)", Source: "CSS", Hex: ChartreuseWeb},
+	{Name: "Cherry Blossom Pink", Hex: CherryBlossomPink},
+	{Name: "Chestnut", Hex: Chestnut},
+	{Name: "China Pink", Hex: ChinaPink},
+	{Name: "China Rose", Hex: ChinaRose},
+	{Name: "Chinese Red", Hex: ChineseRed},
+	{Name: "Chinese Violet", Hex: ChineseViolet},
+	{Name: "Chinese Yellow", Hex: ChineseYellow},
+	{Name: "Chocolate (traditional)", Source: "Traditional", Hex: ChocolateTraditional},
+	{Name: "Chocolate (web)", Source: "CSS", Hex: ChocolateWeb},
+	{Name: "Chrome Yellow", Hex: ChromeYellow},
+	{Name: "Cinereous", Hex: Cinereous},
+	{Name: "Cinnabar", Hex: Cinnabar},
+	{Name: "Cinnamon Satin", Hex: CinnamonSatin},
+	{Name: "Citrine", Hex: Citrine},
+	{Name: "Citron", Hex: Citron},
+	{Name: "Claret", Hex: Claret},
+	{Name: "Cobalt Blue", Hex: CobaltBlue},
+	{Name: "Cocoa Brown", Hex: CocoaBrown},
+	{Name: "Coffee", Hex: Coffee},
+	{Name: "Columbia Blue", Hex: ColumbiaBlue},
+	{Name: "Congo Pink", Hex: CongoPink},
+	{Name: "Cool Grey", Hex: CoolGrey},
+	{Name: "Copper", Hex: Copper},
+	{Name: "Copper (Crayola)", Source: "Crayola", Hex: CopperCrayola},
+	{Name: "Copper Penny", Hex: CopperPenny},
+	{Name: "Copper Red", Hex: CopperRed},
+	{Name: "Copper Rose", Hex: CopperRose},
+	{Name: "Coquelicot", Hex: Coquelicot},
+	{Name: "Coral", Hex: Coral},
+	{Name: "Coral Pink", Hex: CoralPink},
+	{Name: "Cordovan", Hex: Cordovan},
+	{Name: "Corn", Hex: Corn},
+	{Name: "Cornflower Blue", Hex: CornflowerBlue},
+	{Name: "Cornsilk", Hex: Cornsilk},
+	{Name: "Cosmic Cobalt", Hex: CosmicCobalt},
+	{Name: "Cosmic Latte", Hex: CosmicLatte},
+	{Name: "Cotton Candy", Hex: CottonCandy},
+	{Name: "Coyote Brown", Hex: CoyoteBrown},
+	{Name: "Cream", Hex: Cream},
+	{Name: "Crimson", Hex: Crimson},
+	{Name: "Crimson (UA)", Source: "University of Alabama", Hex: CrimsonUA},
+	{Name: "Cultured", Hex: Cultured},
+	{Name: "Cyan", Hex: Cyan},
+	{Name: "Cyan (process)", Source: "Process", Hex: CyanProcess},
+	{Name: "Cyber Grape", Hex: CyberGrape},
+	{Name: "Cyber Yellow", Hex: CyberYellow},
+	{Name: "Cyclamen", Hex: Cyclamen},
+	{Name: "Dark Blue Gray", Hex: DarkBlueGray},
+	{Name: "Dark Brown", Hex: DarkBrown},
+	{Name: "Dark Byzantium", Hex: DarkByzantium},
+	{Name: "Dark Cornflower Blue", Hex: DarkCornflowerBlue},
+	{Name: "Dark Cyan", Hex: DarkCyan},
+	{Name: "Dark Electric Blue", Hex: DarkElectricBlue},
+	{Name: "Dark Goldenrod", Hex: DarkGoldenrod},
+	{Name: "Dark Green", Hex: DarkGreen},
+	{Name: "Dark Green (X11)", Source: "X11", Hex: DarkGreenX11},
+	{Name: "Dark Jungle Green", Hex: DarkJungleGreen},
+	{Name: "Dark Khaki", Hex: DarkKhaki},
+	{Name: "Dark Lava", Hex: DarkLava},
+	{Name: "Dark Liver", Hex: DarkLiver},
+	{Name: "Dark Liver (horses)", Hex: DarkLiverHorses},
+	{Name: "Dark Magenta", Hex: DarkMagenta},
+	{Name: "Dark Moss Green", Hex: DarkMossGreen},
+	{Name: "Dark Olive Green", Hex: DarkOliveGreen},
+	{Name: "Dark Orange", Hex: DarkOrange},
+	{Name: "Dark Orchid", Hex: DarkOrchid},
+	{Name: "Dark Pastel Green", Hex: DarkPastelGreen},
+	{Name: "Dark Purple", Hex: DarkPurple},
+	{Name: "Dark Red", Hex: DarkRed},
+	{Name: "Dark Salmon", Hex: DarkSalmon},
+	{Name: "Dark Sea Green", Hex: DarkSeaGreen},
+	{Name: "Dark Sienna", Hex: DarkSienna},
+	{Name: "Dark Sky Blue", Hex: DarkSkyBlue},
+	{Name: "Dark Slate Blue", Hex: DarkSlateBlue},
+	{Name: "Dark Slate Gray", Hex: DarkSlateGray},
+	{Name: "Dark Spring Green", Hex: DarkSpringGreen},
+	{Name: "Dark Turquoise", Hex: DarkTurquoise},
+	{Name: "Dark Violet", Hex: DarkViolet},
+	{Name: "Dartmouth Green", Hex: DartmouthGreen},
+	{Name: "Davys Grey", Hex: DavysGrey},
+	{Name: "Deep Cerise", Hex: DeepCerise},
+	{Name: "Deep Champagne", Hex: DeepChampagne},
+	{Name: "Deep Chestnut", Hex: DeepChestnut},
+	{Name: "Deep Jungle Green", Hex: DeepJungleGreen},
+	{Name: "Deep Pink", Hex: DeepPink},
+	{Name: "Deep Saffron", Hex: DeepSaffron},
+	{Name: "Deep Sky Blue", Hex: DeepSkyBlue},
+	{Name: "Deep Space Sparkle", Hex: DeepSpaceSparkle},
+	{Name: "Deep Taupe", Hex: DeepTaupe},
+	{Name: "Denim", Hex: Denim},
+	{Name: "Denim Blue", Hex: DenimBlue},
+	{Name: "Desert", Hex: Desert},
+	{Name: "Desert Sand", Hex: DesertSand},
+	{Name: "Dim Gray", Hex: DimGray},
+	{Name: "Dodger Blue", Hex: DodgerBlue},
+	{Name: "Dogwood Rose", Hex: DogwoodRose},
+	{Name: "Drab", Hex: Drab},
+	{Name: "Duke Blue", Hex: DukeBlue},
+	{Name: "Dutch White", Hex: DutchWhite},
+	{Name: "Earth Yellow", Hex: EarthYellow},
+	{Name: "Ebony", Hex: Ebony},
+	{Name: "Ecru", Hex: Ecru},
+	{Name: "Eerie Black", Hex: EerieBlack},
+	{Name: "Eggplant", Hex: Eggplant},
+	{Name: "Eggshell", Hex: Eggshell},
+	{Name: "Egyptian Blue", Hex: EgyptianBlue},
+	{Name: "Electric Blue", Hex: ElectricBlue},
+	{Name: "Electric Green", Hex: ElectricGreen},
+	{Name: "Electric Indigo", Hex: ElectricIndigo},
+	{Name: "Electric Lime", Hex: ElectricLime},
+	{Name: "Electric Purple", Hex: ElectricPurple},
+	{Name: "Electric Violet", Hex: ElectricViolet},
+	{Name: "Emerald", Hex: Emerald},
+	{Name: "Eminence", Hex: Eminence},
+	{Name: "English Green", Hex: EnglishGreen},
+	{Name: "English Lavender", Hex: EnglishLavender},
+	{Name: "English Red", Hex: EnglishRed},
+	{Name: "English Vermillion", Hex: EnglishVermillion},
+	{Name: "English Violet", Hex: EnglishViolet},
+	{Name: "Erin", Hex: Erin},
+	{Name: "Eton Blue", Hex: EtonBlue},
+	{Name: "Fallow", Hex: Fallow},
+	{Name: "Falu Red", Hex: FaluRed},
+	{Name: "Fandango", Hex: Fandango},
+	{Name: "Fandango Pink", Hex: FandangoPink},
+	{Name: "Fashion Fuchsia", Hex: FashionFuchsia},
+	{Name: "Fawn", Hex: Fawn},
+	{Name: "Feldgrau", Hex: Feldgrau},
+	{Name: "Fern Green", Hex: FernGreen},
+	{Name: "Field Drab", Hex: FieldDrab},
+	{Name: "Fiery Rose", Hex: FieryRose},
+	{Name: "Firebrick", Hex: Firebrick},
+	{Name: "Fire Engine Red", Hex: FireEngineRed},
+	{Name: "Fire Opal", Hex: FireOpal},
+	{Name: "Flame", Hex: Flame},
+	{Name: "Flax", Hex: Flax},
+	{Name: "Flickr Blue", Hex: FlickrBlue},
+	{Name: "Flickr Pink", Hex: FlickrPink},
+	{Name: "Flirt", Hex: Flirt},
+	{Name: "Floral White", Hex: FloralWhite},
+	{Name: "Fluorescent Blue", Hex: FluorescentBlue},
+	{Name: "Forest Green (Crayola)", Source: "Crayola", Hex: ForestGreenCrayola},
+	{Name: "Forest Green (traditional)", Source: "Traditional", Hex: ForestGreenTraditional},
+	{Name: "Forest Green (web)", Source: "CSS", Hex: ForestGreenWeb},
+	{Name: "French Beige", Hex: FrenchBeige},
+	{Name: "French Bistre", Hex: FrenchBistre},
+	{Name: "French Blue", Hex: FrenchBlue},
+	{Name: "French Fuchsia", Hex: FrenchFuchsia},
+	{Name: "French Lilac", Hex: FrenchLilac},
+	{Name: "French Lime", Hex: FrenchLime},
+	{Name: "French Mauve", Hex: FrenchMauve},
+	{Name: "French Pink", Hex: FrenchPink},
+	{Name: "French Raspberry", Hex: FrenchRaspberry},
+	{Name: "French Rose", Hex: FrenchRose},
+	{Name: "French Sky Blue", Hex: FrenchSkyBlue},
+	{Name: "French Violet", Hex: FrenchViolet},
+	{Name: "Frostbite", Hex: Frostbite},
+	{Name: "Fuchsia", Hex: Fuchsia},
+	{Name: "Fuchsia (Crayola)", Source: "Crayola", Hex: FuchsiaCrayola},
+	{Name: "Fuchsia Purple", Hex: FuchsiaPurple},
+	{Name: "Fuchsia Rose", Hex: FuchsiaRose},
+	{Name: "Fulvous", Hex: Fulvous},
+	{Name: "Fuzzy Wuzzy", Hex: FuzzyWuzzy},
+	{Name: "Gainsboro", Hex: Gainsboro},
+	{Name: "Gamboge", Hex: Gamboge},
+	{Name: "Generic Viridian", Hex: GenericViridian},
+	{Name: "Ghost White", Hex: GhostWhite},
+	{Name: "Glaucous", Hex: Glaucous},
+	{Name: "Glossy Grape", Hex: GlossyGrape},
+	{Name: "GO Green", Hex: GOGreen},
+	{Name: "Gold", Hex: Gold},
+	{Name: "Gold (Crayola)", Source: "Crayola", Hex: GoldCrayola},
+	{Name: "Golden Brown", Hex: GoldenBrown},
+	{Name: "Golden Poppy", Hex: GoldenPoppy},
+	{Name: "Goldenrod", Hex: Goldenrod},
+	{Name: "Golden Yellow", Hex: GoldenYellow},
+	{Name: "Gold Fusion", Hex: GoldFusion},
+	{Name: "Gold (metallic)", Source: "Metallic", Hex: GoldMetallic},
+	{Name: "Gold (web)", Source: "CSS", Hex: GoldWeb},
+	{Name: "Granite Gray", Hex: GraniteGray},
+	{Name: "Granny Smith Apple", Hex: GrannySmithApple},
+	{Name: "Gray (web)", Source: "CSS", Hex: GrayWeb},
+	{Name: "Gray (X11)", Source: "X11", Hex: GrayX11Gray},
+	{Name: "Green", Hex: Green},
+	{Name: "Green Blue", Hex: GreenBlue},
+	{Name: "Green Blue (Crayola)", Source: "Crayola", Hex: GreenBlueCrayola},
+	{Name: "Green (Crayola)", Source: "Crayola", Hex: GreenCrayola},
+	{Name: "Green Cyan", Hex: GreenCyan},
+	{Name: "Green Lizard", Hex: GreenLizard},
+	{Name: "Green (Munsell)", Source: "Munsell", Hex: GreenMunsell},
+	{Name: "Green (NCS)", Source: "NCS", Hex: GreenNCS},
+	{Name: "Green (Pantone)", Source: "Pantone", Hex: GreenPantone},
+	{Name: "Green (pigment)", Source: "Pigment", Hex: Greenpigment},
+	{Name: "Green (RYB)", Source: "RYB", Hex: GreenRYB},
+	{Name: "Green Sheen", Hex: GreenSheen},
+	{Name: "Green (web)", Source: "CSS", Hex: GreenWeb},
+	{Name: "Green Yellow", Hex: GreenYellow},
+	{Name: "Green Yellow (Crayola)", Source: "Crayola", Hex: GreenYellowCrayola},
+	{Name: "Grullo", Hex: Grullo},
+	{Name: "Gunmetal", Hex: Gunmetal},
+	{Name: "Han Blue", Hex: HanBlue},
+	{Name: "Han Purple", Hex: HanPurple},
+	{Name: "Hansa Yellow", Hex: HansaYellow},
+	{Name: "Harlequin", Hex: Harlequin},
+	{Name: "Harvest Gold", Hex: HarvestGold},
+	{Name: "Heat Wave", Hex: HeatWave},
+	{Name: "Heliotrope", Hex: Heliotrope},
+	{Name: "Heliotrope Gray", Hex: HeliotropeGray},
+	{Name: "Hollywood Cerise", Hex: HollywoodCerise},
+	{Name: "Honeydew", Hex: Honeydew},
+	{Name: "Honolulu Blue", Hex: HonoluluBlue},
+	{Name: "Hookers Green", Hex: HookersGreen},
+	{Name: "Hot Magenta", Hex: HotMagenta},
+	{Name: "Hot Pink", Hex: HotPink},
+	{Name: "Hunter Green", Hex: HunterGreen},
+	{Name: "Iceberg", Hex: Iceberg},
+	{Name: "Icterine", Hex: Icterine},
+	{Name: "Illuminating Emerald", Hex: IlluminatingEmerald},
+	{Name: "Imperial Red", Hex: ImperialRed},
+	{Name: "Inchworm", Hex: Inchworm},
+	{Name: "Independence", Hex: Independence},
+	{Name: "India Green", Hex: IndiaGreen},
+	{Name: "Indian Red", Hex: IndianRed},
+	{Name: "Indian Yellow", Hex: IndianYellow},
+	{Name: "Indigo", Hex: Indigo},
+	{Name: "Indigo (dye)", Source: "Dye", Hex: IndigoDye},
+	{Name: "International Klein Blue", Hex: InternationalKleinBlue},
+	{Name: "International Orange Aerospace", Hex: InternationalOrangeAerospace},
+	{Name: "International Orange Engineering", Hex: InternationalOrangeEngineering},
+	{Name: "International Orange Golden Gate Bridge", Hex: InternationalOrangeGoldenGateBridge},
+	{Name: "Iris", Hex: Iris},
+	{Name: "Irresistible", Hex: Irresistible},
+	{Name: "Isabelline", Hex: Isabelline},
+	{Name: "Italian Sky Blue", Hex: ItalianSkyBlue},
+	{Name: "Ivory", Hex: Ivory},
+	{Name: "Jade", Hex: Jade},
+	{Name: "Jasmine", Hex: Jasmine},
+	{Name: "Jazzberry Jam", Hex: JazzberryJam},
+	{Name: "Jet", Hex: Jet},
+	{Name: "Jonquil", Hex: Jonquil},
+	{Name: "June Bud", Hex: JuneBud},
+	{Name: "Jungle Green", Hex: JungleGreen},
+	{Name: "Kelly Green", Hex: KellyGreen},
+	{Name: "Keppel", Hex: Keppel},
+	{Name: "Key Lime", Hex: KeyLime},
+	{Name: "Khaki (web)", Source: "CSS", Hex: KhakiWeb},
+	{Name: "Khaki (X11)", Source: "X11", Hex: KhakiX11},
+	{Name: "Kobe", Hex: Kobe},
+	{Name: "Kobi", Hex: Kobi},
+	{Name: "Kobicha", Hex: Kobicha},
+	{Name: "Kombu Green", Hex: KombuGreen},
+	{Name: "KSU Purple", Hex: KSUPurple},
+	{Name: "Lapis Lazuli", Hex: LapisLazuli},
+	{Name: "Laser Lemon", Hex: LaserLemon},
+	{Name: "Laurel Green", Hex: LaurelGreen},
+	{Name: "Lava", Hex: Lava},
+	{Name: "Lavender", Hex: Lavender},
+	{Name: "Lavender Blue", Hex: LavenderBlue},
+	{Name: "Lavender Blush", Hex: LavenderBlush},
+	{Name: "Lavender Floral", Hex: LavenderFloral},
+	{Name: "Lavender Gray", Hex: LavenderGray},
+	{Name: "Lavender (web)", Source: "CSS", Hex: LavenderWeb},
+	{Name: "Lawn Green", Hex: LawnGreen},
+	{Name: "Lemon", Hex: Lemon},
+	{Name: "Lemon Chiffon", Hex: LemonChiffon},
+	{Name: "Lemon Curry", Hex: LemonCurry},
+	{Name: "Lemon Glacier", Hex: LemonGlacier},
+	{Name: "Lemon Meringue", Hex: LemonMeringue},
+	{Name: "Lemon Yellow", Hex: LemonYellow},
+	{Name: "Lemon Yellow (Crayola)", Source: "Crayola", Hex: LemonYellowCrayola},
+	{Name: "Liberty", Hex: Liberty},
+	{Name: "Light Blue", Hex: LightBlue},
+	{Name: "Light Coral", Hex: LightCoral},
+	{Name: "Light Cornflower Blue", Hex: LightCornflowerBlue},
+	{Name: "Light Cyan", Hex: LightCyan},
+	{Name: "Light French Beige", Hex: LightFrenchBeige},
+	{Name: "Light Goldenrod Yellow", Hex: LightGoldenrodYellow},
+	{Name: "Light Gray", Hex: LightGray},
+	{Name: "Light Green", Hex: LightGreen},
+	{Name: "Light Orange", Hex: LightOrange},
+	{Name: "Light Periwinkle", Hex: LightPeriwinkle},
+	{Name: "Light Pink", Hex: LightPink},
+	{Name: "Light Salmon", Hex: LightSalmon},
+	{Name: "Light Sea Green", Hex: LightSeaGreen},
+	{Name: "Light Sky Blue", Hex: LightSkyBlue},
+	{Name: "Light Slate Gray", Hex: LightSlateGray},
+	{Name: "Light Steel Blue", Hex: LightSteelBlue},
+	{Name: "Light Yellow", Hex: LightYellow},
+	{Name: "Lilac", Hex: Lilac},
+	{Name: "Lilac Luster", Hex: LilacLuster},
+	{Name: "Lime (color wheel)", Source: "Color wheel", Hex: LimeColorWheel},
+	{Name: "Lime Green", Hex: LimeGreen},
+	{Name: "Lime (web)", Source: "CSS", Hex: LimeWeb},
+	{Name: "Lime (X11)", Source: "X11", Hex: LimeX11},
+	{Name: "Lincoln Green", Hex: LincolnGreen},
+	{Name: "Linen", Hex: Linen},
+	{Name: "Lion", Hex: Lion},
+	{Name: "Liseran Purple", Hex: LiseranPurple},
+	{Name: "Little Boy Blue", Hex: LittleBoyBlue},
+	{Name: "Liver", Hex: Liver},
+	{Name: "Liver Chestnut", Hex: LiverChestnut},
+	{Name: "Liver (dogs)", Hex: LiverDogs},
+	{Name: "Liver (organ)", Hex: LiverOrgan},
+	{Name: "Livid", Hex: Livid},
+	{Name: "Macaroni And Cheese", Hex: MacaroniAndCheese},
+	{Name: "Madder Lake", Hex: MadderLake},
+	{Name: "Magenta", Hex: Magenta},
+	{Name: "Magenta (Crayola)", Source: "Crayola", Hex: MagentaCrayola},
+	{Name: "Magenta (dye)", Source: "Dye", Hex: MagentaDye},
+	{Name: "Magenta Haze", Hex: MagentaHaze},
+	{Name: "Magenta (Pantone)", Source: "Pantone", Hex: MagentaPantone},
+	{Name: "Magenta (process)", Source: "Process", Hex: MagentaProcess},
+	{Name: "Magic Mint", Hex: MagicMint},
+	{Name: "Magnolia", Hex: Magnolia},
+	{Name: "Mahogany", Hex: Mahogany},
+	{Name: "Maize", Hex: Maize},
+	{Name: "Maize (Crayola)", Source: "Crayola", Hex: MaizeCrayola},
+	{Name: "Majorelle Blue", Hex: MajorelleBlue},
+	{Name: "Malachite", Hex: Malachite},
+	{Name: "Manatee", Hex: Manatee},
+	{Name: "Mandarin", Hex: Mandarin},
+	{Name: "Mango", Hex: Mango},
+	{Name: "Mango Tango", Hex: MangoTango},
+	{Name: "Mantis", Hex: Mantis},
+	{Name: "Mardi Gras", Hex: MardiGras},
+	{Name: "Marigold", Hex: Marigold},
+	{Name: "Maroon (Crayola)", Source: "Crayola", Hex: MaroonCrayola},
+	{Name: "Maroon (web)", Source: "CSS", Hex: MaroonWeb},
+	{Name: "Maroon (X11)", Source: "X11", Hex: MaroonX11},
+	{Name: "Mauve", Hex: Mauve},
+	{Name: "Mauvelous", Hex: Mauvelous},
+	{Name: "Mauve Taupe", Hex: MauveTaupe},
+	{Name: "Maximum Blue", Hex: MaximumBlue},
+	{Name: "Maximum Blue Green", Hex: MaximumBlueGreen},
+	{Name: "Maximum Blue Purple", Hex: MaximumBluePurple},
+	{Name: "Maximum Green", Hex: MaximumGreen},
+	{Name: "Maximum Green Yellow", Hex: MaximumGreenYellow},
+	{Name: "Maximum Purple", Hex: MaximumPurple},
+	{Name: "Maximum Red", Hex: MaximumRed},
+	{Name: "Maximum Red Purple", Hex: MaximumRedPurple},
+	{Name: "Maximum Yellow", Hex: MaximumYellow},
+	{Name: "Maximum Yellow Red", Hex: MaximumYellowRed},
+	{Name: "Maya Blue", Hex: MayaBlue},
+	{Name: "May Green", Hex: MayGreen},
+	{Name: "Medium Aquamarine", Hex: MediumAquamarine},
+	{Name: "Medium Blue", Hex: MediumBlue},
+	{Name: "Medium Candy Apple Red", Hex: MediumCandyAppleRed},
+	{Name: "Medium Carmine", Hex: MediumCarmine},
+	{Name: "Medium Champagne", Hex: MediumChampagne},
+	{Name: "Medium Orchid", Hex: MediumOrchid},
+	{Name: "Medium Purple", Hex: MediumPurple},
+	{Name: "Medium Sea Green", Hex: MediumSeaGreen},
+	{Name: "Medium Slate Blue", Hex: MediumSlateBlue},
+	{Name: "Medium Spring Green", Hex: MediumSpringGreen},
+	{Name: "Medium Turquoise", Hex: MediumTurquoise},
+	{Name: "Medium Violet Red", Hex: MediumVioletRed},
+	{Name: "Mellow Apricot", Hex: MellowApricot},
+	{Name: "Mellow Yellow", Hex: MellowYellow},
+	{Name: "Melon", Hex: Melon},
+	{Name: "Metallic Gold", Hex: MetallicGold},
+	{Name: "Metallic Seaweed", Hex: MetallicSeaweed},
+	{Name: "Metallic Sunburst", Hex: MetallicSunburst},
+	{Name: "Mexican Pink", Hex: MexicanPink},
+	{Name: "Middle Blue", Hex: MiddleBlue},
+	{Name: "Middle Blue Green", Hex: MiddleBlueGreen},
+	{Name: "Middle Blue Purple", Hex: MiddleBluePurple},
+	{Name: "Middle Green", Hex: MiddleGreen},
+	{Name: "Middle Green Yellow", Hex: MiddleGreenYellow},
+	{Name: "Middle Grey", Hex: MiddleGrey},
+	{Name: "Middle Purple", Hex: MiddlePurple},
+	{Name: "Middle Red", Hex: MiddleRed},
+	{Name: "Middle Red Purple", Hex: MiddleRedPurple},
+	{Name: "Middle Yellow", Hex: MiddleYellow},
+	{Name: "Middle Yellow Red", Hex: MiddleYellowRed},
+	{Name: "Midnight", Hex: Midnight},
+	{Name: "Midnight Blue", Hex: MidnightBlue},
+	{Name: "Midnight Green", Hex: MidnightGreen},
+	{Name: "Mikado Yellow", Hex: MikadoYellow},
+	{Name: "Mimi Pink", Hex: MimiPink},
+	{Name: "Mindaro", Hex: Mindaro},
+	{Name: "Ming", Hex: Ming},
+	{Name: "Minion Yellow", Hex: MinionYellow},
+	{Name: "Mint", Hex: Mint},
+	{Name: "Mint Cream", Hex: MintCream},
+	{Name: "Mint Green", Hex: MintGreen},
+	{Name: "Misty Moss", Hex: MistyMoss},
+	{Name: "Misty Rose", Hex: MistyRose},
+	{Name: "Mode Beige", Hex: ModeBeige},
+	{Name: "Morning Blue", Hex: MorningBlue},
+	{Name: "Moss Green", Hex: MossGreen},
+	{Name: "Mountain Meadow", Hex: MountainMeadow},
+	{Name: "Mountbatten Pink", Hex: MountbattenPink},
+	{Name: "MSU Green", Hex: MSUGreen},
+	{Name: "Mulberry", Hex: Mulberry},
+	{Name: "Mulberry (Crayola)", Source: "Crayola", Hex: MulberryCrayola},
+	{Name: "Mustard", Hex: Mustard},
+	{Name: "Myrtle Green", Hex: MyrtleGreen},
+	{Name: "Mystic", Hex: Mystic},
+	{Name: "Mystic Maroon", Hex: MysticMaroon},
+	{Name: "Nadeshiko Pink", Hex: NadeshikoPink},
+	{Name: "Naples Yellow", Hex: NaplesYellow},
+	{Name: "Navajo White", Hex: NavajoWhite},
+	{Name: "Navy Blue", Hex: NavyBlue},
+	{Name: "Navy Blue (Crayola)", Source: "Crayola", Hex: NavyBlueCrayola},
+	{Name: "Neon Blue", Hex: NeonBlue},
+	{Name: "Neon Green", Hex: NeonGreen},
+	{Name: "New York Pink", Hex: NewYorkPink},
+	{Name: "Nickel", Hex: Nickel},
+	{Name: "Non Photo Blue", Hex: NonPhotoBlue},
+	{Name: "Nyanza", Hex: Nyanza},
+	{Name: "Ocean Blue", Hex: OceanBlue},
+	{Name: "Ocean Green", Hex: OceanGreen},
+	{Name: "Ochre", Hex: Ochre},
+	{Name: "Old Burgundy", Hex: OldBurgundy},
+	{Name: "Old Gold", Hex: OldGold},
+	{Name: "Old Lace", Hex: OldLace},
+	{Name: "Old Lavender", Hex: OldLavender},
+	{Name: "Old Mauve", Hex: OldMauve},
+	{Name: "Old Rose", Hex: OldRose},
+	{Name: "Old Silver", Hex: OldSilver},
+	{Name: "Olive", Hex: Olive},
+	{Name: "Olive Drab #3", Hex: OliveDrab3},
+	{Name: "Olive Drab #7", Hex: OliveDrab7},
+	{Name: "Olive Green", Hex: OliveGreen},
+	{Name: "Olivine", Hex: Olivine},
+	{Name: "Onyx", Hex: Onyx},
+	{Name: "Opal", Hex: Opal},
+	{Name: "Opera Mauve", Hex: OperaMauve},
+	{Name: "Orange", Hex: Orange},
+	{Name: "Orange (Crayola)", Source: "Crayola", Hex: OrangeCrayola},
+	{Name: "Orange (Pantone)", Source: "Pantone", Hex: OrangePantone},
+	{Name: "Orange Peel", Hex: OrangePeel},
+	{Name: "Orange Red", Hex: OrangeRed},
+	{Name: "Orange Red (Crayola)", Source: "Crayola", Hex: OrangeRedCrayola},
+	{Name: "Orange Soda", Hex: OrangeSoda},
+	{Name: "Orange (web)", Source: "CSS", Hex: OrangeWeb},
+	{Name: "Orange Yellow", Hex: OrangeYellow},
+	{Name: "Orange Yellow (Crayola)", Source: "Crayola", Hex: OrangeYellowCrayola},
+	{Name: "Orchid", Hex: Orchid},
+	{Name: "Orchid (Crayola)", Source: "Crayola", Hex: OrchidCrayola},
+	{Name: "Orchid Pink", Hex: OrchidPink},
+	{Name: "OU Crimson Red", Hex: OUCrimsonRed},
+	{Name: "Outer Space (Crayola)", Source: "Crayola", Hex: OuterSpaceCrayola},
+	{Name: "Outrageous Orange", Hex: OutrageousOrange},
+	{Name: "Oxblood", Hex: Oxblood},
+	{Name: "Oxford Blue", Hex: OxfordBlue},
+	{Name: "Pacific Blue", Hex: PacificBlue},
+	{Name: "Pakistan Green", Hex: PakistanGreen},
+	{Name: "Palatinate Purple", Hex: PalatinatePurple},
+	{Name: "Pale Aqua", Hex: PaleAqua},
+	{Name: "Pale Cerulean", Hex: PaleCerulean},
+	{Name: "Pale Pink", Hex: PalePink},
+	{Name: "Pale Purple (Pantone)", Source: "Pantone", Hex: PalePurplePantone},
+	{Name: "Pale Silver", Hex: PaleSilver},
+	{Name: "Pale Spring Bud", Hex: PaleSpringBud},
+	{Name: "Pansy Purple", Hex: PansyPurple},
+	{Name: "Paolo Veronese Green", Hex: PaoloVeroneseGreen},
+	{Name: "Papaya Whip", Hex: PapayaWhip},
+	{Name: "Paradise Pink", Hex: ParadisePink},
+	{Name: "Paris Green", Hex: ParisGreen},
+	{Name: "Pastel Pink", Hex: PastelPink},
+	{Name: "Patriarch", Hex: Patriarch},
+	{Name: "Paynes Grey", Hex: PaynesGrey},
+	{Name: "Peach", Hex: Peach},
+	{Name: "Peach (Crayola)", Source: "Crayola", Hex: PeachCrayola},
+	{Name: "Peach Puff", Hex: PeachPuff},
+	{Name: "Pear", Hex: Pear},
+	{Name: "Pearly Purple", Hex: PearlyPurple},
+	{Name: "Periwinkle", Hex: Periwinkle},
+	{Name: "Periwinkle (Crayola)", Source: "Crayola", Hex: PeriwinkleCrayola},
+	{Name: "Permanent Geranium Lake", Hex: PermanentGeraniumLake},
+	{Name: "Persian Blue", Hex: PersianBlue},
+	{Name: "Persian Green", Hex: PersianGreen},
+	{Name: "Persian Indigo", Hex: PersianIndigo},
+	{Name: "Persian Orange", Hex: PersianOrange},
+	{Name: "Persian Pink", Hex: PersianPink},
+	{Name: "Persian Plum", Hex: PersianPlum},
+	{Name: "Persian Red", Hex: PersianRed},
+	{Name: "Persian Rose", Hex: PersianRose},
+	{Name: "Persimmon", Hex: Persimmon},
+	{Name: "Pewter Blue", Hex: PewterBlue},
+	{Name: "Phlox", Hex: Phlox},
+	{Name: "Phthalo Blue", Hex: PhthaloBlue},
+	{Name: "Phthalo Green", Hex: PhthaloGreen},
+	{Name: "Picotee Blue", Hex: PicoteeBlue},
+	{Name: "Pictorial Carmine", Hex: PictorialCarmine},
+	{Name: "Piggy Pink", Hex: PiggyPink},
+	{Name: "Pine Green", Hex: PineGreen},
+	{Name: "Pine Tree", Hex: PineTree},
+	{Name: "Pink", Hex: Pink},
+	{Name: "Pink Flamingo", Hex: PinkFlamingo},
+	{Name: "Pink Lace", Hex: PinkLace},
+	{Name: "Pink Lavender", Hex: PinkLavender},
+	{Name: "Pink (Pantone)", Source: "Pantone", Hex: PinkPantone},
+	{Name: "Pink Sherbet", Hex: PinkSherbet},
+	{Name: "Pistachio", Hex: Pistachio},
+	{Name: "Platinum", Hex: Platinum},
+	{Name: "Plum", Hex: Plum},
+	{Name: "Plump Purple", Hex: PlumpPurple},
+	{Name: "Plum (web)", Source: "CSS", Hex: PlumWeb},
+	{Name: "Polished Pine", Hex: PolishedPine},
+	{Name: "Pomp And Power", Hex: PompAndPower},
+	{Name: "Popstar", Hex: Popstar},
+	{Name: "Portland Orange", Hex: PortlandOrange},
+	{Name: "Powder Blue", Hex: PowderBlue},
+	{Name: "Princeton Orange", Hex: PrincetonOrange},
+	{Name: "Prune", Hex: Prune},
+	{Name: "Prussian Blue", Hex: PrussianBlue},
+	{Name: "Psychedelic Purple", Hex: PsychedelicPurple},
+	{Name: "Puce", Hex: Puce},
+	{Name: "Pullman Brown", Hex: PullmanBrown},
+	{Name: "Pumpkin", Hex: Pumpkin},
+	{Name: "Purple", Hex: Purple},
+	{Name: "Purple Mountain Majesty", Hex: PurpleMountainMajesty},
+	{Name: "Purple (Munsell)", Source: "Munsell", Hex: PurpleMunsell},
+	{Name: "Purple Navy", Hex: PurpleNavy},
+	{Name: "Purple Pizzazz", Hex: PurplePizzazz},
+	{Name: "Purple Plum", Hex: PurplePlum},
+	{Name: "Purple (web)", Source: "CSS", Hex: PurpleWeb},
+	{Name: "Purple (X11)", Source: "X11", Hex: PurpleX11},
+	{Name: "Purpureus", Hex: Purpureus},
+	{Name: "Queen Blue", Hex: QueenBlue},
+	{Name: "Queen Pink", Hex: QueenPink},
+	{Name: "Quick Silver", Hex: QuickSilver},
+	{Name: "Quinacridone Magenta", Hex: QuinacridoneMagenta},
+	{Name: "Radical Red", Hex: RadicalRed},
+	{Name: "Raisin Black", Hex: RaisinBlack},
+	{Name: "Rajah", Hex: Rajah},
+	{Name: "Raspberry", Hex: Raspberry},
+	{Name: "Raspberry Glace", Hex: RaspberryGlace},
+	{Name: "Raspberry Rose", Hex: RaspberryRose},
+	{Name: "Raw Sienna", Hex: RawSienna},
+	{Name: "Raw Umber", Hex: RawUmber},
+	{Name: "Razzle Dazzle Rose", Hex: RazzleDazzleRose},
+	{Name: "Razzmatazz", Hex: Razzmatazz},
+	{Name: "Razzmic Berry", Hex: RazzmicBerry},
+	{Name: "Rebecca Purple", Hex: RebeccaPurple},
+	{Name: "Red", Hex: Red},
+	{Name: "Red (Crayola)", Source: "Crayola", Hex: RedCrayola},
+	{Name: "Red (Munsell)", Source: "Munsell", Hex: RedMunsell},
+	{Name: "Red (NCS)", Source: "NCS", Hex: RedNCS},
+	{Name: "Red Orange", Hex: RedOrange},
+	{Name: "Red Orange (color wheel)", Source: "Color wheel", Hex: RedOrangeColorWheel},
+	{Name: "Red Orange (Crayola)", Source: "Crayola", Hex: RedOrangeCrayola},
+	{Name: "Red (Pantone)", Source: "Pantone", Hex: RedPantone},
+	{Name: "Red (pigment)", Source: "Pigment", Hex: RedPigment},
+	{Name: "Red Purple", Hex: RedPurple},
+	{Name: "Red (RYB)", Source: "RYB", Hex: RedRYB},
+	{Name: "Red Salsa", Hex: RedSalsa},
+	{Name: "Red Violet", Hex: RedViolet},
+	{Name: "Red Violet (color wheel)", Source: "Color wheel", Hex: RedVioletColorWheel},
+	{Name: "Red Violet (Crayola)", Source: "Crayola", Hex: RedVioletCrayola},
+	{Name: "Redwood", Hex: Redwood},
+	{Name: "Resolution Blue", Hex: ResolutionBlue},
+	{Name: "Rhythm", Hex: Rhythm},
+	{Name: "Rich Black", Hex: RichBlack},
+	{Name: "Rich Black (FOGRA29)", Source: "FOGRA", Hex: RichBlackFOGRA29},
+	{Name: "Rich Black (FOGRA39)", Source: "FOGRA", Hex: RichBlackFOGRA39},
+	{Name: "Rifle Green", Hex: RifleGreen},
+	{Name: "Robin Egg Blue", Hex: RobinEggBlue},
+	{Name: "Rocket Metallic", Hex: RocketMetallic},
+	{Name: "Roman Silver", Hex: RomanSilver},
+	{Name: "Rose", Hex: Rose},
+	{Name: "Rose Bonbon", Hex: RoseBonbon},
+	{Name: "Rose Dust", Hex: RoseDust},
+	{Name: "Rose Ebony", Hex: RoseEbony},
+	{Name: "Rose Madder", Hex: RoseMadder},
+	{Name: "Rose Pink", Hex: RosePink},
+	{Name: "Rose Quartz", Hex: RoseQuartz},
+	{Name: "Rose Red", Hex: RoseRed},
+	{Name: "Rose Taupe", Hex: RoseTaupe},
+	{Name: "Rose Vale", Hex: RoseVale},
+	{Name: "Rosewood", Hex: Rosewood},
+	{Name: "Rosso Corsa", Hex: RossoCorsa},
+	{Name: "Rosy Brown", Hex: RosyBrown},
+	{Name: "Royal Blue (dark)", Hex: RoyalBlueDark},
+	{Name: "Royal Blue (light)", Hex: RoyalBlueLight},
+	{Name: "Royal Purple", Hex: RoyalPurple},
+	{Name: "Royal Yellow", Hex: RoyalYellow},
+	{Name: "Ruber", Hex: Ruber},
+	{Name: "Rubine Red", Hex: RubineRed},
+	{Name: "Ruby", Hex: Ruby},
+	{Name: "Ruby Red", Hex: RubyRed},
+	{Name: "Rufous", Hex: Rufous},
+	{Name: "Russet", Hex: Russet},
+	{Name: "Russian Green", Hex: RussianGreen},
+	{Name: "Russian Violet", Hex: RussianViolet},
+	{Name: "Rust", Hex: Rust},
+	{Name: "Rusty Red", Hex: RustyRed},
+	{Name: "Sacramento State Green", Hex: SacramentoStateGreen},
+	{Name: "Saddle Brown", Hex: SaddleBrown},
+	{Name: "Safety Orange", Hex: SafetyOrange},
+	{Name: "Safety Orange (blaze orange)", Hex: SafetyOrangeBlaze},
+	{Name: "Safety Yellow", Hex: SafetyYellow},
+	{Name: "Saffron", Hex: Saffron},
+	{Name: "Sage", Hex: Sage},
+	{Name: "Salmon", Hex: Salmon},
+	{Name: "Salmon Pink", Hex: SalmonPink},
+	{Name: "Sand", Hex: Sand},
+	{Name: "Sand Dune", Hex: SandDune},
+	{Name: "Sandy Brown", Hex: SandyBrown},
+	{Name: "Sap Green", Hex: SapGreen},
+	{Name: "Sapphire", Hex: Sapphire},
+	{Name: "Sapphire Blue", Hex: SapphireBlue},
+	{Name: "Sapphire (Crayola)", Source: "Crayola", Hex: SapphireCrayola},
+	{Name: "Satin Sheen Gold", Hex: SatinSheenGold},
+	{Name: "Scarlet", Hex: Scarlet},
+	{Name: "Schauss Pink", Hex: SchaussPink},
+	{Name: "School Bus Yellow", Hex: SchoolBusYellow},
+	{Name: "Screamin Green", Hex: ScreaminGreen},
+	{Name: "Sea Green", Hex: SeaGreen},
+	{Name: "Sea Green (Crayola)", Source: "Crayola", Hex: SeaGreenCrayola},
+	{Name: "Seal Brown", Hex: SealBrown},
+	{Name: "Seashell", Hex: Seashell},
+	{Name: "Selective Yellow", Hex: SelectiveYellow},
+	{Name: "Sepia", Hex: Sepia},
+	{Name: "Shadow", Hex: Shadow},
+	{Name: "Shadow Blue", Hex: ShadowBlue},
+	{Name: "Shamrock Green", Hex: ShamrockGreen},
+	{Name: "Sheen Green", Hex: SheenGreen},
+	{Name: "Shimmering Blush", Hex: ShimmeringBlush},
+	{Name: "Shiny Shamrock", Hex: ShinyShamrock},
+	{Name: "Shocking Pink", Hex: ShockingPink},
+	{Name: "Shocking Pink (Crayola)", Source: "Crayola", Hex: ShockingPinkCrayola},
+	{Name: "Sienna", Hex: Sienna},
+	{Name: "Silver", Hex: Silver},
+	{Name: "Silver Chalice", Hex: SilverChalice},
+	{Name: "Silver (Crayola)", Source: "Crayola", Hex: SilverCrayola},
+	{Name: "Silver (metallic)", Source: "Metallic", Hex: SilverMetallic},
+	{Name: "Silver Pink", Hex: SilverPink},
+	{Name: "Silver Sand", Hex: SilverSand},
+	{Name: "Sinopia", Hex: Sinopia},
+	{Name: "Sizzling Red", Hex: SizzlingRed},
+	{Name: "Sizzling Sunrise", Hex: SizzlingSunrise},
+	{Name: "Skobeloff", Hex: Skobeloff},
+	{Name: "Sky Blue", Hex: SkyBlue},
+	{Name: "Sky Blue (Crayola)", Source: "Crayola", Hex: SkyBlueCrayola},
+	{Name: "Sky Magenta", Hex: SkyMagenta},
+	{Name: "Slate Blue", Hex: SlateBlue},
+	{Name: "Slate Gray", Hex: SlateGray},
+	{Name: "Slimy Green", Hex: SlimyGreen},
+	{Name: "Smitten", Hex: Smitten},
+	{Name: "Smoky Black", Hex: SmokyBlack},
+	{Name: "Snow", Hex: Snow},
+	{Name: "Solid Pink", Hex: SolidPink},
+	{Name: "Sonic Silver", Hex: SonicSilver},
+	{Name: "Space Cadet", Hex: SpaceCadet},
+	{Name: "Spanish Bistre", Hex: SpanishBistre},
+	{Name: "Spanish Blue", Hex: SpanishBlue},
+	{Name: "Spanish Carmine", Hex: SpanishCarmine},
+	{Name: "Spanish Gray", Hex: SpanishGray},
+	{Name: "Spanish Green", Hex: SpanishGreen},
+	{Name: "Spanish Orange", Hex: SpanishOrange},
+	{Name: "Spanish Pink", Hex: SpanishPink},
+	{Name: "Spanish Red", Hex: SpanishRed},
+	{Name: "Spanish Sky Blue", Hex: SpanishSkyBlue},
+	{Name: "Spanish Violet", Hex: SpanishViolet},
+	{Name: "Spanish Viridian", Hex: SpanishViridian},
+	{Name: "Spring Bud", Hex: SpringBud},
+	{Name: "Spring Frost", Hex: SpringFrost},
+	{Name: "Spring Green", Hex: SpringGreen},
+	{Name: "Spring Green (Crayola)", Source: "Crayola", Hex: SpringGreenCrayola},
+	{Name: "Star Command Blue", Hex: StarCommandBlue},
+	{Name: "Steel Blue", Hex: SteelBlue},
+	{Name: "Steel Pink", Hex: SteelPink},
+	{Name: "Steel Teal", Hex: SteelTeal},
+	{Name: "Stil De Grain Yellow", Hex: StilDeGrainYellow},
+	{Name: "St Patricks Blue", Hex: StPatricksBlue},
+	{Name: "Straw", Hex: Straw},
+	{Name: "Sugar Plum", Hex: SugarPlum},
+	{Name: "Sunglow", Hex: Sunglow},
+	{Name: "Sunray", Hex: Sunray},
+	{Name: "Sunset", Hex: Sunset},
+	{Name: "Super Pink", Hex: SuperPink},
+	{Name: "Sweet Brown", Hex: SweetBrown},
+	{Name: "Tan", Hex: Tan},
+	{Name: "Tan (Crayola)", Source: "Crayola", Hex: TanCrayola},
+	{Name: "Tangerine", Hex: Tangerine},
+	{Name: "Tango Pink", Hex: TangoPink},
+	{Name: "Tart Orange", Hex: TartOrange},
+	{Name: "Taupe", Hex: Taupe},
+	{Name: "Taupe Gray", Hex: TaupeGray},
+	{Name: "Tawny", Hex: Tawny},
+	{Name: "Tea Green", Hex: TeaGreen},
+	{Name: "Teal", Hex: Teal},
+	{Name: "Teal Blue", Hex: TealBlue},
+	{Name: "Tea Rose", Hex: TeaRose},
+	{Name: "Tea Rose (rose)", Hex: TeaRoseLight},
+	{Name: "Telemagenta", Hex: Telemagenta},
+	{Name: "Terra Cotta", Hex: TerraCotta},
+	{Name: "Thistle", Hex: Thistle},
+	{Name: "Thulian Pink", Hex: ThulianPink},
+	{Name: "Tickle Me Pink", Hex: TickleMePink},
+	{Name: "Tiffany Blue", Hex: TiffanyBlue},
+	{Name: "Timberwolf", Hex: Timberwolf},
+	{Name: "Titanium Yellow", Hex: TitaniumYellow},
+	{Name: "Tomato", Hex: Tomato},
+	{Name: "Tropical Rain Forest", Hex: TropicalRainForest},
+	{Name: "True Blue", Hex: TrueBlue},
+	{Name: "Trypan Blue", Hex: TrypanBlue},
+	{Name: "Tufts Blue", Hex: TuftsBlue},
+	{Name: "Tumbleweed", Hex: Tumbleweed},
+	{Name: "Turquoise", Hex: Turquoise},
+	{Name: "Turquoise Blue", Hex: TurquoiseBlue},
+	{Name: "Turquoise Green", Hex: TurquoiseGreen},
+	{Name: "Turtle Green", Hex: TurtleGreen},
+	{Name: "Tuscan", Hex: Tuscan},
+	{Name: "Tuscan Brown", Hex: TuscanBrown},
+	{Name: "Tuscan Red", Hex: TuscanRed},
+	{Name: "Tuscan Tan", Hex: TuscanTan},
+	{Name: "Tuscany", Hex: Tuscany},
+	{Name: "Twilight Lavender", Hex: TwilightLavender},
+	{Name: "Tyrian Purple", Hex: TyrianPurple},
+	{Name: "UA Blue", Hex: UABlue},
+	{Name: "UA Red", Hex: UARed},
+	{Name: "Ultramarine", Hex: Ultramarine},
+	{Name: "Ultramarine Blue", Hex: UltramarineBlue},
+	{Name: "Ultra Pink", Hex: UltraPink},
+	{Name: "Ultra Red", Hex: UltraRed},
+	{Name: "Umber", Hex: Umber},
+	{Name: "Unbleached Silk", Hex: UnbleachedSilk},
+	{Name: "United Nations Blue", Hex: UnitedNationsBlue},
+	{Name: "Unmellow Yellow", Hex: UnmellowYellow},
+	{Name: "UP Forest Green", Hex: UPForestGreen},
+	{Name: "UP Maroon", Hex: UPMaroon},
+	{Name: "UPS Brown", Hex: UPSBrown},
+	{Name: "Upsdell Red", Hex: UpsdellRed},
+	{Name: "Uranian Blue", Hex: UranianBlue},
+	{Name: "USAFA Blue", Hex: USAFABlue},
+	{Name: "Van Dyke Brown", Hex: VanDykeBrown},
+	{Name: "Vanilla", Hex: Vanilla},
+	{Name: "Vanilla Ice", Hex: VanillaIce},
+	{Name: "Vegas Gold", Hex: VegasGold},
+	{Name: "Venetian Red", Hex: VenetianRed},
+	{Name: "Verdigris", Hex: Verdigris},
+	{Name: "Vermilion", Hex: Vermilion},
+	{Name: "Vermilion (cinnabar)", Hex: VermilionLight},
+	{Name: "Veronica", Hex: Veronica},
+	{Name: "Violet", Hex: Violet},
+	{Name: "Violet Blue", Hex: VioletBlue},
+	{Name: "Violet Blue (Crayola)", Source: "Crayola", Hex: VioletBlueCrayola},
+	{Name: "Violet (color wheel)", Source: "Color wheel", Hex: VioletColorWheel},
+	{Name: "Violetcrayola", Hex: Violetcrayola},
+	{Name: "Violet Red", Hex: VioletRed},
+	{Name: "Violet (RYB)", Source: "RYB", Hex: VioletRYB},
+	{Name: "Violet (web)", Source: "CSS", Hex: VioletWeb},
+	{Name: "Viridian", Hex: Viridian},
+	{Name: "Viridian Green", Hex: ViridianGreen},
+	{Name: "Vivid Burgundy", Hex: VividBurgundy},
+	{Name: "Vivid Sky Blue", Hex: VividSkyBlue},
+	{Name: "Vivid Tangerine", Hex: VividTangerine},
+	{Name: "Vivid Violet", Hex: VividViolet},
+	{Name: "Volt", Hex: Volt},
+	{Name: "Warm Black", Hex: WarmBlack},
+	{Name: "Wheat", Hex: Wheat},
+	{Name: "White", Hex: White},
+	{Name: "Wild Blue Yonder", Hex: WildBlueYonder},
+	{Name: "Wild Orchid", Hex: WildOrchid},
+	{Name: "Wild Strawberry", Hex: WildStrawberry},
+	{Name: "Wild Watermelon", Hex: WildWatermelon},
+	{Name: "Windsor Tan", Hex: WindsorTan},
+	{Name: "Wine", Hex: Wine},
+	{Name: "Wine Dregs", Hex: WineDregs},
+	{Name: "Wintergreen Dream", Hex: WintergreenDream},
+	{Name: "Winter Sky", Hex: WinterSky},
+	{Name: "Wisteria", Hex: Wisteria},
+	{Name: "Wood Brown", Hex: WoodBrown},
+	{Name: "Xanadu", Hex: Xanadu},
+	{Name: "Xanthic", Hex: Xanthic},
+	{Name: "Xiketic", Hex: Xiketic},
+	{Name: "Yale Blue", Hex: YaleBlue},
+	{Name: "Yellow", Hex: Yellow},
+	{Name: "Yellow (Crayola)", Source: "Crayola", Hex: YellowCrayola},
+	{Name: "Yellow Green", Hex: YellowGreen},
+	{Name: "Yellow Green (color wheel)", Source: "Color wheel", Hex: YellowGreenColorWheel},
+	{Name: "Yellow Green (Crayola)", Source: "Crayola", Hex: YellowGreenCrayola},
+	{Name: "Yellow (Munsell)", Source: "Munsell", Hex: YellowMunsell},
+	{Name: "Yellow (NCS)", Source: "NCS", Hex: YellowNCS},
+	{Name: "Yellow Orange", Hex: YellowOrange},
+	{Name: "Yellow Orange (color wheel)", Source: "Color wheel", Hex: YellowOrangeColorWheel},
+	{Name: "Yellow (Pantone)", Source: "Pantone", Hex: YellowPantone},
+	{Name: "Yellow (process)", Source: "Process", Hex: YellowProcess},
+	{Name: "Yellow (RYB)", Source: "RYB", Hex: YellowRYB},
+	{Name: "Yellow Sunshine", Hex: YellowSunshine},
+	{Name: "Y In Mn Blue", Hex: YInMnBlue},
+	{Name: "Zaffre", Hex: Zaffre},
+	{Name: "Zomp", Hex: Zomp},
+}
@@ -0,0 +1,677 @@
+// Copyright © 2019 Xavier Basty <xavier@hexbee.net>
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package named
+
+// x11Colors are the colors of the rgb.txt file of the X Window System. The
+// names written with spaces and in camel case are merged.
+var x11Colors = []Color{
+	{Name: "snow", Hex: "#FFFAFA"},
+	{Name: "ghost white", Hex: "#F8F8FF"},
+	{Name: "white smoke", Hex: "#F5F5F5"},
+	{Name: "gainsboro", Hex: "#DCDCDC"},
+	{Name: "floral white", Hex: "#FFFAF0"},
+	{Name: "old lace", Hex: "#FDF5E6"},
+	{Name: "linen", Hex: "#FAF0E6"},
+	{Name: "antique white", Hex: "#FAEBD7"},
+	{Name: "papaya whip", Hex: "#FFEFD5"},
+	{Name: "blanched almond", Hex: "#FFEBCD"},
+	{Name: "bisque", Hex: "#FFE4C4"},
+	{Name: "peach puff", Hex: "#FFDAB9"},
+	{Name: "navajo white", Hex: "#FFDEAD"},
+	{Name: "moccasin", Hex: "#FFE4B5"},
+	{Name: "cornsilk", Hex: "#FFF8DC"},
+	{Name: "ivory", Hex: "#FFFFF0"},
+	{Name: "lemon chiffon", Hex: "#FFFACD"},
+	{Name: "seashell", Hex: "#FFF5EE"},
+	{Name: "honeydew", Hex: "#F0FFF0"},
+	{Name: "mint cream", Hex: "#F5FFFA"},
+	{Name: "azure", Hex: "#F0FFFF"},
+	{Name: "alice blue", Hex: "#F0F8FF"},
+	{Name: "lavender", Hex: "#E6E6FA"},
+	{Name: "lavender blush", Hex: "#FFF0F5"},
+	{Name: "misty rose", Hex: "#FFE4E1"},
+	{Name: "white", Hex: "#FFFFFF"},
+	{Name: "black", Hex: "#000000"},
+	{Name: "dark slate gray", Hex: "#2F4F4F"},
+	{Name: "dark slate grey", Hex: "#2F4F4F"},
+	{Name: "dim gray", Hex: "#696969"},
+	{Name: "dim grey", Hex: "#696969"},
+	{Name: "slate gray", Hex: "#708090"},
+	{Name: "slate grey", Hex: "#708090"},
+	{Name: "light slate gray", Hex: "#778899"},
+	{Name: "light slate grey", Hex: "#778899"},
+	{Name: "gray", Hex: "#BEBEBE"},
+	{Name: "grey", Hex: "#BEBEBE"},
+	{Name: "light grey", Hex: "#D3D3D3"},
+	{Name: "light gray", Hex: "#D3D3D3"},
+	{Name: "midnight blue", Hex: "#191970"},
+	{Name: "navy", Hex: "#000080"},
+	{Name: "navy blue", Hex: "#000080"},
+	{Name: "cornflower blue", Hex: "#6495ED"},
+	{Name: "dark slate blue", Hex: "#483D8B"},
+	{Name: "slate blue", Hex: "#6A5ACD"},
+	{Name: "medium slate blue", Hex: "#7B68EE"},
+	{Name: "light slate blue", Hex: "#8470FF"},
+	{Name: "medium blue", Hex: "#0000CD"},
+	{Name: "royal blue", Hex: "#4169E1"},
+	{Name: "blue", Hex: "#0000FF"},
+	{Name: "dodger blue", Hex: "#1E90FF"},
+	{Name: "deep sky blue", Hex: "#00BFFF"},
+	{Name: "sky blue", Hex: "#87CEEB"},
+	{Name: "light sky blue", Hex: "#87CEFA"},
+	{Name: "steel blue", Hex: "#4682B4"},
+	{Name: "light steel blue", Hex: "#B0C4DE"},
+	{Name: "light blue", Hex: "#ADD8E6"},
+	{Name: "powder blue", Hex: "#B0E0E6"},
+	{Name: "pale turquoise", Hex: "#AFEEEE"},
+	{Name: "dark turquoise", Hex: "#00CED1"},
+	{Name: "medium turquoise", Hex: "#48D1CC"},
+	{Name: "turquoise", Hex: "#40E0D0"},
+	{Name: "cyan", Hex: "#00FFFF"},
+	{Name: "light cyan", Hex: "#E0FFFF"},
+	{Name: "cadet blue", Hex: "#5F9EA0"},
+	{Name: "medium aquamarine", Hex: "#66CDAA"},
+	{Name: "aquamarine", Hex: "#7FFFD4"},
+	{Name: "dark green", Hex: "#006400"},
+	{Name: "dark olive green", Hex: "#556B2F"},
+	{Name: "dark sea green", Hex: "#8FBC8F"},
+	{Name: "sea green", Hex: "#2E8B57"},
+	{Name: "medium sea green", Hex: "#3CB371"},
+	{Name: "light sea green", Hex: "#20B2AA"},
+	{Name: "pale green", Hex: "#98FB98"},
+	{Name: "spring green", Hex: "#00FF7F"},
+	{Name: "lawn green", Hex: "#7CFC00"},
+	{Name: "green", Hex: "#00FF00"},
+	{Name: "chartreuse", Hex: "#7FFF00"},
+	{Name: "medium spring green", Hex: "#00FA9A"},
+	{Name: "green yellow", Hex: "#ADFF2F"},
+	{Name: "lime green", Hex: "#32CD32"},
+	{Name: "yellow green", Hex: "#9ACD32"},
+	{Name: "forest green", Hex: "#228B22"},
+	{Name: "olive drab", Hex: "#6B8E23"},
+	{Name: "dark khaki", Hex: "#BDB76B"},
+	{Name: "khaki", Hex: "#F0E68C"},
+	{Name: "pale goldenrod", Hex: "#EEE8AA"},
+	{Name: "light goldenrod yellow", Hex: "#FAFAD2"},
+	{Name: "light yellow", Hex: "#FFFFE0"},
+	{Name: "yellow", Hex: "#FFFF00"},
+	{Name: "gold", Hex: "#FFD700"},
+	{Name: "light goldenrod", Hex: "#EEDD82"},
+	{Name: "goldenrod", Hex: "#DAA520"},
+	{Name: "dark goldenrod", Hex: "#B8860B"},
+	{Name: "rosy brown", Hex: "#BC8F8F"},
+	{Name: "indian red", Hex: "#CD5C5C"},
+	{Name: "saddle brown", Hex: "#8B4513"},
+	{Name: "sienna", Hex: "#A0522D"},
+	{Name: "peru", Hex: "#CD853F"},
+	{Name: "burlywood", Hex: "#DEB887"},
+	{Name: "beige", Hex: "#F5F5DC"},
+	{Name: "wheat", Hex: "#F5DEB3"},
+	{Name: "sandy brown", Hex: "#F4A460"},
+	{Name: "tan", Hex: "#D2B48C"},
+	{Name: "chocolate", Hex: "#D2691E"},
+	{Name: "firebrick", Hex: "#B22222"},
+	{Name: "brown", Hex: "#A52A2A"},
+	{Name: "dark salmon", Hex: "#E9967A"},
+	{Name: "salmon", Hex: "#FA8072"},
+	{Name: "light salmon", Hex: "#FFA07A"},
+	{Name: "orange", Hex: "#FFA500"},
+	{Name: "dark orange", Hex: "#FF8C00"},
+	{Name: "coral", Hex: "#FF7F50"},
+	{Name: "light coral", Hex: "#F08080"},
+	{Name: "tomato", Hex: "#FF6347"},
+	{Name: "orange red", Hex: "#FF4500"},
+	{Name: "red", Hex: "#FF0000"},
+	{Name: "hot pink", Hex: "#FF69B4"},
+	{Name: "deep pink", Hex: "#FF1493"},
+	{Name: "pink", Hex: "#FFC0CB"},
+	{Name: "light pink", Hex: "#FFB6C1"},
+	{Name: "pale violet red", Hex: "#DB7093"},
+	{Name: "maroon", Hex: "#B03060"},
+	{Name: "medium violet red", Hex: "#C71585"},
+	{Name: "violet red", Hex: "#D02090"},
+	{Name: "magenta", Hex: "#FF00FF"},
+	{Name: "violet", Hex: "#EE82EE"},
+	{Name: "plum", Hex: "#DDA0DD"},
+	{Name: "orchid", Hex: "#DA70D6"},
+	{Name: "medium orchid", Hex: "#BA55D3"},
+	{Name: "dark orchid", Hex: "#9932CC"},
+	{Name: "dark violet", Hex: "#9400D3"},
+	{Name: "blue violet", Hex: "#8A2BE2"},
+	{Name: "purple", Hex: "#A020F0"},
+	{Name: "medium purple", Hex: "#9370DB"},
+	{Name: "thistle", Hex: "#D8BFD8"},
+	{Name: "snow1", Hex: "#FFFAFA"},
+	{Name: "snow2", Hex: "#EEE9E9"},
+	{Name: "snow3", Hex: "#CDC9C9"},
+	{Name: "snow4", Hex: "#8B8989"},
+	{Name: "seashell1", Hex: "#FFF5EE"},
+	{Name: "seashell2", Hex: "#EEE5DE"},
+	{Name: "seashell3", Hex: "#CDC5BF"},
+	{Name: "seashell4", Hex: "#8B8682"},
+	{Name: "AntiqueWhite1", Hex: "#FFEFDB"},
+	{Name: "AntiqueWhite2", Hex: "#EEDFCC"},
+	{Name: "AntiqueWhite3", Hex: "#CDC0B0"},
+	{Name: "AntiqueWhite4", Hex: "#8B8378"},
+	{Name: "bisque1", Hex: "#FFE4C4"},
+	{Name: "bisque2", Hex: "#EED5B7"},
+	{Name: "bisque3", Hex: "#CDB79E"},
+	{Name: "bisque4", Hex: "#8B7D6B"},
+	{Name: "PeachPuff1", Hex: "#FFDAB9"},
+	{Name: "PeachPuff2", Hex: "#EECBAD"},
+	{Name: "PeachPuff3", Hex: "#CDAF95"},
+	{Name: "PeachPuff4", Hex: "#8B7765"},
+	{Name: "NavajoWhite1", Hex: "#FFDEAD"},
+	{Name: "NavajoWhite2", Hex: "#EECFA1"},
+	{Name: "NavajoWhite3", Hex: "#CDB38B"},
+	{Name: "NavajoWhite4", Hex: "#8B795E"},
+	{Name: "LemonChiffon1", Hex: "#FFFACD"},
+	{Name: "LemonChiffon2", Hex: "#EEE9BF"},
+	{Name: "LemonChiffon3", Hex: "#CDC9A5"},
+	{Name: "LemonChiffon4", Hex: "#8B8970"},
+	{Name: "cornsilk1", Hex: "#FFF8DC"},
+	{Name: "cornsilk2", Hex: "#EEE8CD"},
+	{Name: "cornsilk3", Hex: "#CDC8B1"},
+	{Name: "cornsilk4", Hex: "#8B8878"},
+	{Name: "ivory1", Hex: "#FFFFF0"},
+	{Name: "ivory2", Hex: "#EEEEE0"},
+	{Name: "ivory3", Hex: "#CDCDC1"},
+	{Name: "ivory4", Hex: "#8B8B83"},
+	{Name: "honeydew1", Hex: "#F0FFF0"},
+	{Name: "honeydew2", Hex: "#E0EEE0"},
+	{Name: "honeydew3", Hex: "#C1CDC1"},
+	{Name: "honeydew4", Hex: "#838B83"},
+	{Name: "LavenderBlush1", Hex: "#FFF0F5"},
+	{Name: "LavenderBlush2", Hex: "#EEE0E5"},
+	{Name: "LavenderBlush3", Hex: "#CDC1C5"},
+	{Name: "LavenderBlush4", Hex: "#8B8386"},
+	{Name: "MistyRose1", Hex: "#FFE4E1"},
+	{Name: "MistyRose2", Hex: "#EED5D2"},
+	{Name: "MistyRose3", Hex: "#CDB7B5"},
+	{Name: "MistyRose4", Hex: "#8B7D7B"},
+	{Name: "azure1", Hex: "#F0FFFF"},
+	{Name: "azure2", Hex: "#E0EEEE"},
+	{Name: "azure3", Hex: "#C1CDCD"},
+	{Name: "azure4", Hex: "#838B8B"},
+	{Name: "SlateBlue1", Hex: "#836FFF"},
+	{Name: "SlateBlue2", Hex: "#7A67EE"},
+	{Name: "SlateBlue3", Hex: "#6959CD"},
+	{Name: "SlateBlue4", Hex: "#473C8B"},
+	{Name: "RoyalBlue1", Hex: "#4876FF"},
+	{Name: "RoyalBlue2", Hex: "#436EEE"},
+	{Name: "RoyalBlue3", Hex: "#3A5FCD"},
+	{Name: "RoyalBlue4", Hex: "#27408B"},
+	{Name: "blue1", Hex: "#0000FF"},
+	{Name: "blue2", Hex: "#0000EE"},
+	{Name: "blue3", Hex: "#0000CD"},
+	{Name: "blue4", Hex: "#00008B"},
+	{Name: "DodgerBlue1", Hex: "#1E90FF"},
+	{Name: "DodgerBlue2", Hex: "#1C86EE"},
+	{Name: "DodgerBlue3", Hex: "#1874CD"},
+	{Name: "DodgerBlue4", Hex: "#104E8B"},
+	{Name: "SteelBlue1", Hex: "#63B8FF"},
+	{Name: "SteelBlue2", Hex: "#5CACEE"},
+	{Name: "SteelBlue3", Hex: "#4F94CD"},
+	{Name: "SteelBlue4", Hex: "#36648B"},
+	{Name: "DeepSkyBlue1", Hex: "#00BFFF"},
+	{Name: "DeepSkyBlue2", Hex: "#00B2EE"},
+	{Name: "DeepSkyBlue3", Hex: "#009ACD"},
+	{Name: "DeepSkyBlue4", Hex: "#00688B"},
+	{Name: "SkyBlue1", Hex: "#87CEFF"},
+	{Name: "SkyBlue2", Hex: "#7EC0EE"},
+	{Name: "SkyBlue3", Hex: "#6CA6CD"},
+	{Name: "SkyBlue4", Hex: "#4A708B"},
+	{Name: "LightSkyBlue1", Hex: "#B0E2FF"},
+	{Name: "LightSkyBlue2", Hex: "#A4D3EE"},
+	{Name: "LightSkyBlue3", Hex: "#8DB6CD"},
+	{Name: "LightSkyBlue4", Hex: "#607B8B"},
+	{Name: "SlateGray1", Hex: "#C6E2FF"},
+	{Name: "SlateGray2", Hex: "#B9D3EE"},
+	{Name: "SlateGray3", Hex: "#9FB6CD"},
+	{Name: "SlateGray4", Hex: "#6C7B8B"},
+	{Name: "LightSteelBlue1", Hex: "#CAE1FF"},
+	{Name: "LightSteelBlue2", Hex: "#BCD2EE"},
+	{Name: "LightSteelBlue3", Hex: "#A2B5CD"},
+	{Name: "LightSteelBlue4", Hex: "#6E7B8B"},
+	{Name: "LightBlue1", Hex: "#BFEFFF"},
+	{Name: "LightBlue2", Hex: "#B2DFEE"},
+	{Name: "LightBlue3", Hex: "#9AC0CD"},
+	{Name: "LightBlue4", Hex: "#68838B"},
+	{Name: "LightCyan1", Hex: "#E0FFFF"},
+	{Name: "LightCyan2", Hex: "#D1EEEE"},
+	{Name: "LightCyan3", Hex: "#B4CDCD"},
+	{Name: "LightCyan4", Hex: "#7A8B8B"},
+	{Name: "PaleTurquoise1", Hex: "#BBFFFF"},
+	{Name: "PaleTurquoise2", Hex: "#AEEEEE"},
+	{Name: "PaleTurquoise3", Hex: "#96CDCD"},
+	{Name: "PaleTurquoise4", Hex: "#668B8B"},
+	{Name: "CadetBlue1", Hex: "#98F5FF"},
+	{Name: "CadetBlue2", Hex: "#8EE5EE"},
+	{Name: "CadetBlue3", Hex: "#7AC5CD"},
+	{Name: "CadetBlue4", Hex: "#53868B"},
+	{Name: "turquoise1", Hex: "#00F5FF"},
+	{Name: "turquoise2", Hex: "#00E5EE"},
+	{Name: "turquoise3", Hex: "#00C5CD"},
+	{Name: "turquoise4", Hex: "#00868B"},
+	{Name: "cyan1", Hex: "#00FFFF"},
+	{Name: "cyan2", Hex: "#00EEEE"},
+	{Name: "cyan3", Hex: "#00CDCD"},
+	{Name: "cyan4", Hex: "#008B8B"},
+	{Name: "DarkSlateGray1", Hex: "#97FFFF"},
+	{Name: "DarkSlateGray2", Hex: "#8DEEEE"},
+	{Name: "DarkSlateGray3", Hex: "#79CDCD"},
+	{Name: "DarkSlateGray4", Hex: "#528B8B"},
+	{Name: "aquamarine1", Hex: "#7FFFD4"},
+	{Name: "aquamarine2", Hex: "#76EEC6"},
+	{Name: "aquamarine3", Hex: "#66CDAA"},
+	{Name: "aquamarine4", Hex: "#458B74"},
+	{Name: "DarkSeaGreen1", Hex: "#C1FFC1"},
+	{Name: "DarkSeaGreen2", Hex: "#B4EEB4"},
+	{Name: "DarkSeaGreen3", Hex: "#9BCD9B"},
+	{Name: "DarkSeaGreen4", Hex: "#698B69"},
+	{Name: "SeaGreen1", Hex: "#54FF9F"},
+	{Name: "SeaGreen2", Hex: "#4EEE94"},
+	{Name: "SeaGreen3", Hex: "#43CD80"},
+	{Name: "SeaGreen4", Hex: "#2E8B57"},
+	{Name: "PaleGreen1", Hex: "#9AFF9A"},
+	{Name: "PaleGreen2", Hex: "#90EE90"},
+	{Name: "PaleGreen3", Hex: "#7CCD7C"},
+	{Name: "PaleGreen4", Hex: "#548B54"},
+	{Name: "SpringGreen1", Hex: "#00FF7F"},
+	{Name: "SpringGreen2", Hex: "#00EE76"},
+	{Name: "SpringGreen3", Hex: "#00CD66"},
+	{Name: "SpringGreen4", Hex: "#008B45"},
+	{Name: "green1", Hex: "#00FF00"},
+	{Name: "green2", Hex: "#00EE00"},
+	{Name: "green3", Hex: "#00CD00"},
+	{Name: "green4", Hex: "#008B00"},
+	{Name: "chartreuse1", Hex: "#7FFF00"},
+	{Name: "chartreuse2", Hex: "#76EE00"},
+	{Name: "chartreuse3", Hex: "#66CD00"},
+	{Name: "chartreuse4", Hex: "#458B00"},
+	{Name: "OliveDrab1", Hex: "#C0FF3E"},
+	{Name: "OliveDrab2", Hex: "#B3EE3A"},
+	{Name: "OliveDrab3", Hex: "#9ACD32"},
+	{Name: "OliveDrab4", Hex: "#698B22"},
+	{Name: "DarkOliveGreen1", Hex: "#CAFF70"},
+	{Name: "DarkOliveGreen2", Hex: "#BCEE68"},
+	{Name: "DarkOliveGreen3", Hex: "#A2CD5A"},
+	{Name: "DarkOliveGreen4", Hex: "#6E8B3D"},
+	{Name: "khaki1", Hex: "#FFF68F"},
+	{Name: "khaki2", Hex: "#EEE685"},
+	{Name: "khaki3", Hex: "#CDC673"},
+	{Name: "khaki4", Hex: "#8B864E"},
+	{Name: "LightGoldenrod1", Hex: "#FFEC8B"},
+	{Name: "LightGoldenrod2", Hex: "#EEDC82"},
+	{Name: "LightGoldenrod3", Hex: "#CDBE70"},
+	{Name: "LightGoldenrod4", Hex: "#8B814C"},
+	{Name: "LightYellow1", Hex: "#FFFFE0"},
+	{Name: "LightYellow2", Hex: "#EEEED1"},
+	{Name: "LightYellow3", Hex: "#CDCDB4"},
+	{Name: "LightYellow4", Hex: "#8B8B7A"},
+	{Name: "yellow1", Hex: "#FFFF00"},
+	{Name: "yellow2", Hex: "#EEEE00"},
+	{Name: "yellow3", Hex: "#CDCD00"},
+	{Name: "yellow4", Hex: "#8B8B00"},
+	{Name: "gold1", Hex: "#FFD700"},
+	{Name: "gold2", Hex: "#EEC900"},
+	{Name: "gold3", Hex: "#CDAD00"},
+	{Name: "gold4", Hex: "#8B7500"},
+	{Name: "goldenrod1", Hex: "#FFC125"},
+	{Name: "goldenrod2", Hex: "#EEB422"},
+	{Name: "goldenrod3", Hex: "#CD9B1D"},
+	{Name: "goldenrod4", Hex: "#8B6914"},
+	{Name: "DarkGoldenrod1", Hex: "#FFB90F"},
+	{Name: "DarkGoldenrod2", Hex: "#EEAD0E"},
+	{Name: "DarkGoldenrod3", Hex: "#CD950C"},
+	{Name: "DarkGoldenrod4", Hex: "#8B6508"},
+	{Name: "RosyBrown1", Hex: "#FFC1C1"},
+	{Name: "RosyBrown2", Hex: "#EEB4B4"},
+	{Name: "RosyBrown3", Hex: "#CD9B9B"},
+	{Name: "RosyBrown4", Hex: "#8B6969"},
+	{Name: "IndianRed1", Hex: "#FF6A6A"},
+	{Name: "IndianRed2", Hex: "#EE6363"},
+	{Name: "IndianRed3", Hex: "#CD5555"},
+	{Name: "IndianRed4", Hex: "#8B3A3A"},
+	{Name: "sienna1", Hex: "#FF8247"},
+	{Name: "sienna2", Hex: "#EE7942"},
+	{Name: "sienna3", Hex: "#CD6839"},
+	{Name: "sienna4", Hex: "#8B4726"},
+	{Name: "burlywood1", Hex: "#FFD39B"},
+	{Name: "burlywood2", Hex: "#EEC591"},
+	{Name: "burlywood3", Hex: "#CDAA7D"},
+	{Name: "burlywood4", Hex: "#8B7355"},
+	{Name: "wheat1", Hex: "#FFE7BA"},
+	{Name: "wheat2", Hex: "#EED8AE"},
+	{Name: "wheat3", Hex: "#CDBA96"},
+	{Name: "wheat4", Hex: "#8B7E66"},
+	{Name: "tan1", Hex: "#FFA54F"},
+	{Name: "tan2", Hex: "#EE9A49"},
+	{Name: "tan3", Hex: "#CD853F"},
+	{Name: "tan4", Hex: "#8B5A2B"},
+	{Name: "chocolate1", Hex: "#FF7F24"},
+	{Name: "chocolate2", Hex: "#EE7621"},
+	{Name: "chocolate3", Hex: "#CD661D"},
+	{Name: "chocolate4", Hex: "#8B4513"},
+	{Name: "firebrick1", Hex: "#FF3030"},
+	{Name: "firebrick2", Hex: "#EE2C2C"},
+	{Name: "firebrick3", Hex: "#CD2626"},
+	{Name: "firebrick4", Hex: "#8B1A1A"},
+	{Name: "brown1", Hex: "#FF4040"},
+	{Name: "brown2", Hex: "#EE3B3B"},
+	{Name: "brown3", Hex: "#CD3333"},
+	{Name: "brown4", Hex: "#8B2323"},
+	{Name: "salmon1", Hex: "#FF8C69"},
+	{Name: "salmon2", Hex: "#EE8262"},
+	{Name: "salmon3", Hex: "#CD7054"},
+	{Name: "salmon4", Hex: "#8B4C39"},
+	{Name: "LightSalmon1", Hex: "#FFA07A"},
+	{Name: "LightSalmon2", Hex: "#EE9572"},
+	{Name: "LightSalmon3", Hex: "#CD8162"},
+	{Name: "LightSalmon4", Hex: "#8B5742"},
+	{Name: "orange1", Hex: "#FFA500"},
+	{Name: "orange2", Hex: "#EE9A00"},
+	{Name: "orange3", Hex: "#CD8500"},
+	{Name: "orange4", Hex: "#8B5A00"},
+	{Name: "DarkOrange1", Hex: "#FF7F00"},
+	{Name: "DarkOrange2", Hex: "#EE7600"},
+	{Name: "DarkOrange3", Hex: "#CD6600"},
+	{Name: "DarkOrange4", Hex: "#8B4500"},
+	{Name: "coral1", Hex: "#FF7256"},
+	{Name: "coral2", Hex: "#EE6A50"},
+	{Name: "coral3", Hex: "#CD5B45"},
+	{Name: "coral4", Hex: "#8B3E2F"},
+	{Name: "tomato1", Hex: "#FF6347"},
+	{Name: "tomato2", Hex: "#EE5C42"},
+	{Name: "tomato3", Hex: "#CD4F39"},
+	{Name: "tomato4", Hex: "#8B3626"},
+	{Name: "OrangeRed1", Hex: "#FF4500"},
+	{Name: "OrangeRed2", Hex: "#EE4000"},
+	{Name: "OrangeRed3", Hex: "#CD3700"},
+	{Name: "OrangeRed4", Hex: "#8B2500"},
+	{Name: "red1", Hex: "#FF0000"},
+	{Name: "red2", Hex: "#EE0000"},
+	{Name: "red3", Hex: "#CD0000"},
+	{Name: "red4", Hex: "#8B0000"},
+	{Name: "DeepPink1", Hex: "#FF1493"},
+	{Name: "DeepPink2", Hex: "#EE1289"},
+	{Name: "DeepPink3", Hex: "#CD1076"},
+	{Name: "DeepPink4", Hex: "#8B0A50"},
+	{Name: "HotPink1", Hex: "#FF6EB4"},
+	{Name: "HotPink2", Hex: "#EE6AA7"},
+	{Name: "HotPink3", Hex: "#CD6090"},
+	{Name: "HotPink4", Hex: "#8B3A62"},
+	{Name: "pink1", Hex: "#FFB5C5"},
+	{Name: "pink2", Hex: "#EEA9B8"},
+	{Name: "pink3", Hex: "#CD919E"},
+	{Name: "pink4", Hex: "#8B636C"},
+	{Name: "LightPink1", Hex: "#FFAEB9"},
+	{Name: "LightPink2", Hex: "#EEA2AD"},
+	{Name: "LightPink3", Hex: "#CD8C95"},
+	{Name: "LightPink4", Hex: "#8B5F65"},
+	{Name: "PaleVioletRed1", Hex: "#FF82AB"},
+	{Name: "PaleVioletRed2", Hex: "#EE799F"},
+	{Name: "PaleVioletRed3", Hex: "#CD6889"},
+	{Name: "PaleVioletRed4", Hex: "#8B475D"},
+	{Name: "maroon1", Hex: "#FF34B3"},
+	{Name: "maroon2", Hex: "#EE30A7"},
+	{Name: "maroon3", Hex: "#CD2990"},
+	{Name: "maroon4", Hex: "#8B1C62"},
+	{Name: "VioletRed1", Hex: "#FF3E96"},
+	{Name: "VioletRed2", Hex: "#EE3A8C"},
+	{Name: "VioletRed3", Hex: "#CD3278"},
+	{Name: "VioletRed4", Hex: "#8B2252"},
+	{Name: "magenta1", Hex: "#FF00FF"},
+	{Name: "magenta2", Hex: "#EE00EE"},
+	{Name: "magenta3", Hex: "#CD00CD"},
+	{Name: "magenta4", Hex: "#8B008B"},
+	{Name: "orchid1", Hex: "#FF83FA"},
+	{Name: "orchid2", Hex: "#EE7AE9"},
+	{Name: "orchid3", Hex: "#CD69C9"},
+	{Name: "orchid4", Hex: "#8B4789"},
+	{Name: "plum1", Hex: "#FFBBFF"},
+	{Name: "plum2", Hex: "#EEAEEE"},
+	{Name: "plum3", Hex: "#CD96CD"},
+	{Name: "plum4", Hex: "#8B668B"},
+	{Name: "MediumOrchid1", Hex: "#E066FF"},
+	{Name: "MediumOrchid2", Hex: "#D15FEE"},
+	{Name: "MediumOrchid3", Hex: "#B452CD"},
+	{Name: "MediumOrchid4", Hex: "#7A378B"},
+	{Name: "DarkOrchid1", Hex: "#BF3EFF"},
+	{Name: "DarkOrchid2", Hex: "#B23AEE"},
+	{Name: "DarkOrchid3", Hex: "#9A32CD"},
+	{Name: "DarkOrchid4", Hex: "#68228B"},
+	{Name: "purple1", Hex: "#9B30FF"},
+	{Name: "purple2", Hex: "#912CEE"},
+	{Name: "purple3", Hex: "#7D26CD"},
+	{Name: "purple4", Hex: "#551A8B"},
+	{Name: "MediumPurple1", Hex: "#AB82FF"},
+	{Name: "MediumPurple2", Hex: "#9F79EE"},
+	{Name: "MediumPurple3", Hex: "#8968CD"},
+	{Name: "MediumPurple4", Hex: "#5D478B"},
+	{Name: "thistle1", Hex: "#FFE1FF"},
+	{Name: "thistle2", Hex: "#EED2EE"},
+	{Name: "thistle3", Hex: "#CDB5CD"},
+	{Name: "thistle4", Hex: "#8B7B8B"},
+	{Name: "gray0", Hex: "#000000"},
+	{Name: "grey0", Hex: "#000000"},
+	{Name: "gray1", Hex: "#030303"},
+	{Name: "grey1", Hex: "#030303"},
+	{Name: "gray2", Hex: "#050505"},
+	{Name: "grey2", Hex: "#050505"},
+	{Name: "gray3", Hex: "#080808"},
+	{Name: "grey3", Hex: "#080808"},
+	{Name: "gray4", Hex: "#0A0A0A"},
+	{Name: "grey4", Hex: "#0A0A0A"},
+	{Name: "gray5", Hex: "#0D0D0D"},
+	{Name: "grey5", Hex: "#0D0D0D"},
+	{Name: "gray6", Hex: "#0F0F0F"},
+	{Name: "grey6", Hex: "#0F0F0F"},
+	{Name: "gray7", Hex: "#121212"},
+	{Name: "grey7", Hex: "#121212"},
+	{Name: "gray8", Hex: "#141414"},
+	{Name: "grey8", Hex: "#141414"},
+	{Name: "gray9", Hex: "#171717"},
+	{Name: "grey9", Hex: "#171717"},
+	{Name: "gray10", Hex: "#1A1A1A"},
+	{Name: "grey10", Hex: "#1A1A1A"},
+	{Name: "gray11", Hex: "#1C1C1C"},
+	{Name: "grey11", Hex: "#1C1C1C"},
+	{Name: "gray12", Hex: "#1F1F1F"},
+	{Name: "grey12", Hex: "#1F1F1F"},
+	{Name: "gray13", Hex: "#212121"},
+	{Name: "grey13", Hex: "#212121"},
+	{Name: "gray14", Hex: "#242424"},
+	{Name: "grey14", Hex: "#242424"},
+	{Name: "gray15", Hex: "#262626"},
+	{Name: "grey15", Hex: "#262626"},
+	{Name: "gray16", Hex: "#292929"},
+	{Name: "grey16", Hex: "#292929"},
+	{Name: "gray17", Hex: "#2B2B2B"},
+	{Name: "grey17", Hex: "#2B2B2B"},
+	{Name: "gray18", Hex: "#2E2E2E"},
+	{Name: "grey18", Hex: "#2E2E2E"},
+	{Name: "gray19", Hex: "#303030"},
+	{Name: "grey19", Hex: "#303030"},
+	{Name: "gray20", Hex: "#333333"},
+	{Name: "grey20", Hex: "#333333"},
+	{Name: "gray21", Hex: "#363636"},
+	{Name: "grey21", Hex: "#363636"},
+	{Name: "gray22", Hex: "#383838"},
+	{Name: "grey22", Hex: "#383838"},
+	{Name: "gray23", Hex: "#3B3B3B"},
+	{Name: "grey23", Hex: "#3B3B3B"},
+	{Name: "gray24", Hex: "#3D3D3D"},
+	{Name: "grey24", Hex: "#3D3D3D"},
+	{Name: "gray25", Hex: "#404040"},
+	{Name: "grey25", Hex: "#404040"},
+	{Name: "gray26", Hex: "#424242"},
+	{Name: "grey26", Hex: "#424242"},
+	{Name: "gray27", Hex: "#454545"},
+	{Name: "grey27", Hex: "#454545"},
+	{Name: "gray28", Hex: "#474747"},
+	{Name: "grey28", Hex: "#474747"},
+	{Name: "gray29", Hex: "#4A4A4A"},
+	{Name: "grey29", Hex: "#4A4A4A"},
+	{Name: "gray30", Hex: "#4D4D4D"},
+	{Name: "grey30", Hex: "#4D4D4D"},
+	{Name: "gray31", Hex: "#4F4F4F"},
+	{Name: "grey31", Hex: "#4F4F4F"},
+	{Name: "gray32", Hex: "#525252"},
+	{Name: "grey32", Hex: "#525252"},
+	{Name: "gray33", Hex: "#545454"},
+	{Name: "grey33", Hex: "#545454"},
+	{Name: "gray34", Hex: "#575757"},
+	{Name: "grey34", Hex: "#575757"},
+	{Name: "gray35", Hex: "#595959"},
+	{Name: "grey35", Hex: "#595959"},
+	{Name: "gray36", Hex: "#5C5C5C"},
+	{Name: "grey36", Hex: "#5C5C5C"},
+	{Name: "gray37", Hex: "#5E5E5E"},
+	{Name: "grey37", Hex: "#5E5E5E"},
+	{Name: "gray38", Hex: "#616161"},
+	{Name: "grey38", Hex: "#616161"},
+	{Name: "gray39", Hex: "#636363"},
+	{Name: "grey39", Hex: "#636363"},
+	{Name: "gray40", Hex: "#666666"},
+	{Name: "grey40", Hex: "#666666"},
+	{Name: "gray41", Hex: "#696969"},
+	{Name: "grey41", Hex: "#696969"},
+	{Name: "gray42", Hex: "#6B6B6B"},
+	{Name: "grey42", Hex: "#6B6B6B"},
+	{Name: "gray43", Hex: "#6E6E6E"},
+	{Name: "grey43", Hex: "#6E6E6E"},
+	{Name: "gray44", Hex: "#707070"},
+	{Name: "grey44", Hex: "#707070"},
+	{Name: "gray45", Hex: "#737373"},
+	{Name: "grey45", Hex: "#737373"},
+	{Name: "gray46", Hex: "#757575"},
+	{Name: "grey46", Hex: "#757575"},
+	{Name: "gray47", Hex: "#787878"},
+	{Name: "grey47", Hex: "#787878"},
+	{Name: "gray48", Hex: "#7A7A7A"},
+	{Name: "grey48", Hex: "#7A7A7A"},
+	{Name: "gray49", Hex: "#7D7D7D"},
+	{Name: "grey49", Hex: "#7D7D7D"},
+	{Name: "gray50", Hex: "#7F7F7F"},
+	{Name: "grey50", Hex: "#7F7F7F"},
+	{Name: "gray51", Hex: "#828282"},
+	{Name: "grey51", Hex: "#828282"},
+	{Name: "gray52", Hex: "#858585"},
+	{Name: "grey52", Hex: "#858585"},
+	{Name: "gray53", Hex: "#878787"},
+	{Name: "grey53", Hex: "#878787"},
+	{Name: "gray54", Hex: "#8A8A8A"},
+	{Name: "grey54", Hex: "#8A8A8A"},
+	{Name: "gray55", Hex: "#8C8C8C"},
+	{Name: "grey55", Hex: "#8C8C8C"},
+	{Name: "gray56", Hex: "#8F8F8F"},
+	{Name: "grey56", Hex: "#8F8F8F"},
+	{Name: "gray57", Hex: "#919191"},
+	{Name: "grey57", Hex: "#919191"},
+	{Name: "gray58", Hex: "#949494"},
+	{Name: "grey58", Hex: "#949494"},
+	{Name: "gray59", Hex: "#969696"},
+	{Name: "grey59", Hex: "#969696"},
+	{Name: "gray60", Hex: "#999999"},
+	{Name: "grey60", Hex: "#999999"},
+	{Name: "gray61", Hex: "#9C9C9C"},
+	{Name: "grey61", Hex: "#9C9C9C"},
+	{Name: "gray62", Hex: "#9E9E9E"},
+	{Name: "grey62", Hex: "#9E9E9E"},
+	{Name: "gray63", Hex: "#A1A1A1"},
+	{Name: "grey63", Hex: "#A1A1A1"},
+	{Name: "gray64", Hex: "#A3A3A3"},
+	{Name: "grey64", Hex: "#A3A3A3"},
+	{Name: "gray65", Hex: "#A6A6A6"},
+	{Name: "grey65", Hex: "#A6A6A6"},
+	{Name: "gray66", Hex: "#A8A8A8"},
+	{Name: "grey66", Hex: "#A8A8A8"},
+	{Name: "gray67", Hex: "#ABABAB"},
+	{Name: "grey67", Hex: "#ABABAB"},
+	{Name: "gray68", Hex: "#ADADAD"},
+	{Name: "grey68", Hex: "#ADADAD"},
+	{Name: "gray69", Hex: "#B0B0B0"},
+	{Name: "grey69", Hex: "#B0B0B0"},
+	{Name: "gray70", Hex: "#B3B3B3"},
+	{Name: "grey70", Hex: "#B3B3B3"},
+	{Name: "gray71", Hex: "#B5B5B5"},
+	{Name: "grey71", Hex: "#B5B5B5"},
+	{Name: "gray72", Hex: "#B8B8B8"},
+	{Name: "grey72", Hex: "#B8B8B8"},
+	{Name: "gray73", Hex: "#BABABA"},
+	{Name: "grey73", Hex: "#BABABA"},
+	{Name: "gray74", Hex: "#BDBDBD"},
+	{Name: "grey74", Hex: "#BDBDBD"},
+	{Name: "gray75", Hex: "#BFBFBF"},
+	{Name: "grey75", Hex: "#BFBFBF"},
+	{Name: "gray76", Hex: "#C2C2C2"},
+	{Name: "grey76", Hex: "#C2C2C2"},
+	{Name: "gray77", Hex: "#C4C4C4"},
+	{Name: "grey77", Hex: "#C4C4C4"},
+	{Name: "gray78", Hex: "#C7C7C7"},
+	{Name: "grey78", Hex: "#C7C7C7"},
+	{Name: "gray79", Hex: "#C9C9C9"},
+	{Name: "grey79", Hex: "#C9C9C9"},
+	{Name: "gray80", Hex: "#CCCCCC"},
+	{Name: "grey80", Hex: "#CCCCCC"},
+	{Name: "gray81", Hex: "#CFCFCF"},
+	{Name: "grey81", Hex: "#CFCFCF"},
+	{Name: "gray82", Hex: "#D1D1D1"},
+	{Name: "grey82", Hex: "#D1D1D1"},
+	{Name: "gray83", Hex: "#D4D4D4"},
+	{Name: "grey83", Hex: "#D4D4D4"},
+	{Name: "gray84", Hex: "#D6D6D6"},
+	{Name: "grey84", Hex: "#D6D6D6"},
+	{Name: "gray85", Hex: "#D9D9D9"},
+	{Name: "grey85", Hex: "#D9D9D9"},
+	{Name: "gray86", Hex: "#DBDBDB"},
+	{Name: "grey86", Hex: "#DBDBDB"},
+	{Name: "gray87", Hex: "#DEDEDE"},
+	{Name: "grey87", Hex: "#DEDEDE"},
+	{Name: "gray88", Hex: "#E0E0E0"},
+	{Name: "grey88", Hex: "#E0E0E0"},
+	{Name: "gray89", Hex: "#E3E3E3"},
+	{Name: "grey89", Hex: "#E3E3E3"},
+	{Name: "gray90", Hex: "#E5E5E5"},
+	{Name: "grey90", Hex: "#E5E5E5"},
+	{Name: "gray91", Hex: "#E8E8E8"},
+	{Name: "grey91", Hex: "#E8E8E8"},
+	{Name: "gray92", Hex: "#EBEBEB"},
+	{Name: "grey92", Hex: "#EBEBEB"},
+	{Name: "gray93", Hex: "#EDEDED"},
+	{Name: "grey93", Hex: "#EDEDED"},
+	{Name: "gray94", Hex: "#F0F0F0"},
+	{Name: "grey94", Hex: "#F0F0F0"},
+	{Name: "gray95", Hex: "#F2F2F2"},
+	{Name: "grey95", Hex: "#F2F2F2"},
+	{Name: "gray96", Hex: "#F5F5F5"},
+	{Name: "grey96", Hex: "#F5F5F5"},
+	{Name: "gray97", Hex: "#F7F7F7"},
+	{Name: "grey97", Hex: "#F7F7F7"},
+	{Name: "gray98", Hex: "#FAFAFA"},
+	{Name: "grey98", Hex: "#FAFAFA"},
+	{Name: "gray99", Hex: "#FCFCFC"},
+	{Name: "grey99", Hex: "#FCFCFC"},
+	{Name: "gray100", Hex: "#FFFFFF"},
+	{Name: "grey100", Hex: "#FFFFFF"},
+	{Name: "dark grey", Hex: "#A9A9A9"},
+	{Name: "dark gray", Hex: "#A9A9A9"},
+	{Name: "dark blue", Hex: "#00008B"},
+	{Name: "dark cyan", Hex: "#008B8B"},
+	{Name: "dark magenta", Hex: "#8B008B"},
+	{Name: "dark red", Hex: "#8B0000"},
+	{Name: "light green", Hex: "#90EE90"},
+}
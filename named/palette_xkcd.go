@@ -0,0 +1,971 @@
+// Copyright © 2019 Xavier Basty <xavier@hexbee.net>
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package named
+
+// xkcdColors are the 949 most common colors named by the participants of the
+// xkcd color survey, sorted by name.
+//
+// See https://xkcd.com/color/rgb/
+var xkcdColors = []Color{
+	{Name: "acid green", Hex: "#8FFE09"},
+	{Name: "adobe", Hex: "#BD6C48"},
+	{Name: "algae", Hex: "#54AC68"},
+	{Name: "algae green", Hex: "#21C36F"},
+	{Name: "almost black", Hex: "#070D0D"},
+	{Name: "amber", Hex: "#FEB308"},
+	{Name: "amethyst", Hex: "#9B5FC0"},
+	{Name: "apple", Hex: "#6ECB3C"},
+	{Name: "apple green", Hex: "#76CD26"},
+	{Name: "apricot", Hex: "#FFB16D"},
+	{Name: "aqua", Hex: "#13EAC9"},
+	{Name: "aqua blue", Hex: "#02D8E9"},
+	{Name: "aqua green", Hex: "#12E193"},
+	{Name: "aqua marine", Hex: "#2EE8BB"},
+	{Name: "aquamarine", Hex: "#04D8B2"},
+	{Name: "army green", Hex: "#4B5D16"},
+	{Name: "asparagus", Hex: "#77AB56"},
+	{Name: "aubergine", Hex: "#3D0734"},
+	{Name: "auburn", Hex: "#9A3001"},
+	{Name: "avocado", Hex: "#90B134"},
+	{Name: "avocado green", Hex: "#87A922"},
+	{Name: "azul", Hex: "#1D5DEC"},
+	{Name: "azure", Hex: "#069AF3"},
+	{Name: "baby blue", Hex: "#A2CFFE"},
+	{Name: "baby green", Hex: "#8CFF9E"},
+	{Name: "baby pink", Hex: "#FFB7CE"},
+	{Name: "baby poo", Hex: "#AB9004"},
+	{Name: "baby poop", Hex: "#937C00"},
+	{Name: "baby poop green", Hex: "#8F9805"},
+	{Name: "baby puke green", Hex: "#B6C406"},
+	{Name: "baby purple", Hex: "#CA9BF7"},
+	{Name: "baby shit brown", Hex: "#AD900D"},
+	{Name: "baby shit green", Hex: "#889717"},
+	{Name: "banana", Hex: "#FFFF7E"},
+	{Name: "banana yellow", Hex: "#FAFE4B"},
+	{Name: "barbie pink", Hex: "#FE46A5"},
+	{Name: "barf green", Hex: "#94AC02"},
+	{Name: "barney", Hex: "#AC1DB8"},
+	{Name: "barney purple", Hex: "#A00498"},
+	{Name: "battleship grey", Hex: "#6B7C85"},
+	{Name: "beige", Hex: "#E6DAA6"},
+	{Name: "berry", Hex: "#990F4B"},
+	{Name: "bile", Hex: "#B5C306"},
+	{Name: "black", Hex: "#000000"},
+	{Name: "bland", Hex: "#AFA88B"},
+	{Name: "blood", Hex: "#770001"},
+	{Name: "blood orange", Hex: "#FE4B03"},
+	{Name: "blood red", Hex: "#980002"},
+	{Name: "blue", Hex: "#0343DF"},
+	{Name: "blue blue", Hex: "#2242C7"},
+	{Name: "blue green", Hex: "#137E6D"},
+	{Name: "blue grey", Hex: "#607C8E"},
+	{Name: "blue purple", Hex: "#5729CE"},
+	{Name: "blue violet", Hex: "#5D06E9"},
+	{Name: "blue with a hint of purple", Hex: "#533CC6"},
+	{Name: "blue/green", Hex: "#0F9B8E"},
+	{Name: "blue/grey", Hex: "#758DA3"},
+	{Name: "blue/purple", Hex: "#5A06EF"},
+	{Name: "blueberry", Hex: "#464196"},
+	{Name: "bluegreen", Hex: "#017A79"},
+	{Name: "bluegrey", Hex: "#85A3B2"},
+	{Name: "bluey green", Hex: "#2BB179"},
+	{Name: "bluey grey", Hex: "#89A0B0"},
+	{Name: "bluey purple", Hex: "#6241C7"},
+	{Name: "bluish", Hex: "#2976BB"},
+	{Name: "bluish green", Hex: "#10A674"},
+	{Name: "bluish grey", Hex: "#748B97"},
+	{Name: "bluish purple", Hex: "#703BE7"},
+	{Name: "blurple", Hex: "#5539CC"},
+	{Name: "blush", Hex: "#F29E8E"},
+	{Name: "blush pink", Hex: "#FE828C"},
+	{Name: "booger", Hex: "#9BB53C"},
+	{Name: "booger green", Hex: "#96B403"},
+	{Name: "bordeaux", Hex: "#7B002C"},
+	{Name: "boring green", Hex: "#63B365"},
+	{Name: "bottle green", Hex: "#044A05"},
+	{Name: "brick", Hex: "#A03623"},
+	{Name: "brick orange", Hex: "#C14A09"},
+	{Name: "brick red", Hex: "#8F1402"},
+	{Name: "bright aqua", Hex: "#0BF9EA"},
+	{Name: "bright blue", Hex: "#0165FC"},
+	{Name: "bright cyan", Hex: "#41FDFE"},
+	{Name: "bright green", Hex: "#01FF07"},
+	{Name: "bright lavender", Hex: "#C760FF"},
+	{Name: "bright light blue", Hex: "#26F7FD"},
+	{Name: "bright light green", Hex: "#2DFE54"},
+	{Name: "bright lilac", Hex: "#C95EFB"},
+	{Name: "bright lime", Hex: "#87FD05"},
+	{Name: "bright lime green", Hex: "#65FE08"},
+	{Name: "bright magenta", Hex: "#FF08E8"},
+	{Name: "bright olive", Hex: "#9CBB04"},
+	{Name: "bright orange", Hex: "#FF5B00"},
+	{Name: "bright pink", Hex: "#FE01B1"},
+	{Name: "bright purple", Hex: "#BE03FD"},
+	{Name: "bright red", Hex: "#FF000D"},
+	{Name: "bright sea green", Hex: "#05FFA6"},
+	{Name: "bright sky blue", Hex: "#02CCFE"},
+	{Name: "bright teal", Hex: "#01F9C6"},
+	{Name: "bright turquoise", Hex: "#0FFEF9"},
+	{Name: "bright violet", Hex: "#AD0AFD"},
+	{Name: "bright yellow", Hex: "#FFFD01"},
+	{Name: "bright yellow green", Hex: "#9DFF00"},
+	{Name: "british racing green", Hex: "#05480D"},
+	{Name: "bronze", Hex: "#A87900"},
+	{Name: "brown", Hex: "#653700"},
+	{Name: "brown green", Hex: "#706C11"},
+	{Name: "brown grey", Hex: "#8D8468"},
+	{Name: "brown orange", Hex: "#B96902"},
+	{Name: "brown red", Hex: "#922B05"},
+	{Name: "brown yellow", Hex: "#B29705"},
+	{Name: "brownish", Hex: "#9C6D57"},
+	{Name: "brownish green", Hex: "#6A6E09"},
+	{Name: "brownish grey", Hex: "#86775F"},
+	{Name: "brownish orange", Hex: "#CB7723"},
+	{Name: "brownish pink", Hex: "#C27E79"},
+	{Name: "brownish purple", Hex: "#76424E"},
+	{Name: "brownish red", Hex: "#9E3623"},
+	{Name: "brownish yellow", Hex: "#C9B003"},
+	{Name: "browny green", Hex: "#6F6C0A"},
+	{Name: "browny orange", Hex: "#CA6B02"},
+	{Name: "bruise", Hex: "#7E4071"},
+	{Name: "bubble gum pink", Hex: "#FF69AF"},
+	{Name: "bubblegum", Hex: "#FF6CB5"},
+	{Name: "bubblegum pink", Hex: "#FE83CC"},
+	{Name: "buff", Hex: "#FEF69E"},
+	{Name: "burgundy", Hex: "#610023"},
+	{Name: "burnt orange", Hex: "#C04E01"},
+	{Name: "burnt red", Hex: "#9F2305"},
+	{Name: "burnt siena", Hex: "#B75203"},
+	{Name: "burnt sienna", Hex: "#B04E0F"},
+	{Name: "burnt umber", Hex: "#A0450E"},
+	{Name: "burnt yellow", Hex: "#D5AB09"},
+	{Name: "burple", Hex: "#6832E3"},
+	{Name: "butter", Hex: "#FFFF81"},
+	{Name: "butter yellow", Hex: "#FFFD74"},
+	{Name: "butterscotch", Hex: "#FDB147"},
+	{Name: "cadet blue", Hex: "#4E7496"},
+	{Name: "camel", Hex: "#C69F59"},
+	{Name: "camo", Hex: "#7F8F4E"},
+	{Name: "camo green", Hex: "#526525"},
+	{Name: "camouflage green", Hex: "#4B6113"},
+	{Name: "canary", Hex: "#FDFF63"},
+	{Name: "canary yellow", Hex: "#FFFE40"},
+	{Name: "candy pink", Hex: "#FF63E9"},
+	{Name: "caramel", Hex: "#AF6F09"},
+	{Name: "carmine", Hex: "#9D0216"},
+	{Name: "carnation", Hex: "#FD798F"},
+	{Name: "carnation pink", Hex: "#FF7FA7"},
+	{Name: "carolina blue", Hex: "#8AB8FE"},
+	{Name: "celadon", Hex: "#BEFDB7"},
+	{Name: "celery", Hex: "#C1FD95"},
+	{Name: "cement", Hex: "#A5A391"},
+	{Name: "cerise", Hex: "#DE0C62"},
+	{Name: "cerulean", Hex: "#0485D1"},
+	{Name: "cerulean blue", Hex: "#056EEE"},
+	{Name: "charcoal", Hex: "#343837"},
+	{Name: "charcoal grey", Hex: "#3C4142"},
+	{Name: "chartreuse", Hex: "#C1F80A"},
+	{Name: "cherry", Hex: "#CF0234"},
+	{Name: "cherry red", Hex: "#F7022A"},
+	{Name: "chestnut", Hex: "#742802"},
+	{Name: "chocolate", Hex: "#3D1C02"},
+	{Name: "chocolate brown", Hex: "#411900"},
+	{Name: "cinnamon", Hex: "#AC4F06"},
+	{Name: "claret", Hex: "#680018"},
+	{Name: "clay", Hex: "#B66A50"},
+	{Name: "clay brown", Hex: "#B2713D"},
+	{Name: "clear blue", Hex: "#247AFD"},
+	{Name: "cloudy blue", Hex: "#ACC2D9"},
+	{Name: "cobalt", Hex: "#1E488F"},
+	{Name: "cobalt blue", Hex: "#030AA7"},
+	{Name: "cocoa", Hex: "#875F42"},
+	{Name: "coffee", Hex: "#A6814C"},
+	{Name: "cool blue", Hex: "#4984B8"},
+	{Name: "cool green", Hex: "#33B864"},
+	{Name: "cool grey", Hex: "#95A3A6"},
+	{Name: "copper", Hex: "#B66325"},
+	{Name: "coral", Hex: "#FC5A50"},
+	{Name: "coral pink", Hex: "#FF6163"},
+	{Name: "cornflower", Hex: "#6A79F7"},
+	{Name: "cornflower blue", Hex: "#5170D7"},
+	{Name: "cranberry", Hex: "#9E003A"},
+	{Name: "cream", Hex: "#FFFFC2"},
+	{Name: "creme", Hex: "#FFFFB6"},
+	{Name: "crimson", Hex: "#8C000F"},
+	{Name: "custard", Hex: "#FFFD78"},
+	{Name: "cyan", Hex: "#00FFFF"},
+	{Name: "dandelion", Hex: "#FEDF08"},
+	{Name: "dark", Hex: "#1B2431"},
+	{Name: "dark aqua", Hex: "#05696B"},
+	{Name: "dark aquamarine", Hex: "#017371"},
+	{Name: "dark beige", Hex: "#AC9362"},
+	{Name: "dark blue", Hex: "#00035B"},
+	{Name: "dark blue green", Hex: "#005249"},
+	{Name: "dark blue grey", Hex: "#1F3B4D"},
+	{Name: "dark brown", Hex: "#341C02"},
+	{Name: "dark coral", Hex: "#CF524E"},
+	{Name: "dark cream", Hex: "#FFF39A"},
+	{Name: "dark cyan", Hex: "#0A888A"},
+	{Name: "dark forest green", Hex: "#002D04"},
+	{Name: "dark fuchsia", Hex: "#9D0759"},
+	{Name: "dark gold", Hex: "#B59410"},
+	{Name: "dark grass green", Hex: "#388004"},
+	{Name: "dark green", Hex: "#033500"},
+	{Name: "dark green blue", Hex: "#1F6357"},
+	{Name: "dark grey", Hex: "#363737"},
+	{Name: "dark grey blue", Hex: "#29465B"},
+	{Name: "dark hot pink", Hex: "#D90166"},
+	{Name: "dark indigo", Hex: "#1F0954"},
+	{Name: "dark khaki", Hex: "#9B8F55"},
+	{Name: "dark lavender", Hex: "#856798"},
+	{Name: "dark lilac", Hex: "#9C6DA5"},
+	{Name: "dark lime", Hex: "#84B701"},
+	{Name: "dark lime green", Hex: "#7EBD01"},
+	{Name: "dark magenta", Hex: "#960056"},
+	{Name: "dark maroon", Hex: "#3C0008"},
+	{Name: "dark mauve", Hex: "#874C62"},
+	{Name: "dark mint", Hex: "#48C072"},
+	{Name: "dark mint green", Hex: "#20C073"},
+	{Name: "dark mustard", Hex: "#A88905"},
+	{Name: "dark navy", Hex: "#000435"},
+	{Name: "dark navy blue", Hex: "#00022E"},
+	{Name: "dark olive", Hex: "#373E02"},
+	{Name: "dark olive green", Hex: "#3C4D03"},
+	{Name: "dark orange", Hex: "#C65102"},
+	{Name: "dark pastel green", Hex: "#56AE57"},
+	{Name: "dark peach", Hex: "#DE7E5D"},
+	{Name: "dark periwinkle", Hex: "#665FD1"},
+	{Name: "dark pink", Hex: "#CB416B"},
+	{Name: "dark plum", Hex: "#3F012C"},
+	{Name: "dark purple", Hex: "#35063E"},
+	{Name: "dark red", Hex: "#840000"},
+	{Name: "dark rose", Hex: "#B5485D"},
+	{Name: "dark royal blue", Hex: "#02066F"},
+	{Name: "dark sage", Hex: "#598556"},
+	{Name: "dark salmon", Hex: "#C85A53"},
+	{Name: "dark sand", Hex: "#A88F59"},
+	{Name: "dark sea green", Hex: "#11875D"},
+	{Name: "dark seafoam", Hex: "#1FB57A"},
+	{Name: "dark seafoam green", Hex: "#3EAF76"},
+	{Name: "dark sky blue", Hex: "#448EE4"},
+	{Name: "dark slate blue", Hex: "#214761"},
+	{Name: "dark tan", Hex: "#AF884A"},
+	{Name: "dark taupe", Hex: "#7F684E"},
+	{Name: "dark teal", Hex: "#014D4E"},
+	{Name: "dark turquoise", Hex: "#045C5A"},
+	{Name: "dark violet", Hex: "#34013F"},
+	{Name: "dark yellow", Hex: "#D5B60A"},
+	{Name: "dark yellow green", Hex: "#728F02"},
+	{Name: "darkblue", Hex: "#030764"},
+	{Name: "darkgreen", Hex: "#054907"},
+	{Name: "darkish blue", Hex: "#014182"},
+	{Name: "darkish green", Hex: "#287C37"},
+	{Name: "darkish pink", Hex: "#DA467D"},
+	{Name: "darkish purple", Hex: "#751973"},
+	{Name: "darkish red", Hex: "#A90308"},
+	{Name: "deep aqua", Hex: "#08787F"},
+	{Name: "deep blue", Hex: "#040273"},
+	{Name: "deep brown", Hex: "#410200"},
+	{Name: "deep green", Hex: "#02590F"},
+	{Name: "deep lavender", Hex: "#8D5EB7"},
+	{Name: "deep lilac", Hex: "#966EBD"},
+	{Name: "deep magenta", Hex: "#A0025C"},
+	{Name: "deep orange", Hex: "#DC4D01"},
+	{Name: "deep pink", Hex: "#CB0162"},
+	{Name: "deep purple", Hex: "#36013F"},
+	{Name: "deep red", Hex: "#9A0200"},
+	{Name: "deep rose", Hex: "#C74767"},
+	{Name: "deep sea blue", Hex: "#015482"},
+	{Name: "deep sky blue", Hex: "#0D75F8"},
+	{Name: "deep teal", Hex: "#00555A"},
+	{Name: "deep turquoise", Hex: "#017374"},
+	{Name: "deep violet", Hex: "#490648"},
+	{Name: "denim", Hex: "#3B638C"},
+	{Name: "denim blue", Hex: "#3B5B92"},
+	{Name: "desert", Hex: "#CCAD60"},
+	{Name: "diarrhea", Hex: "#9F8303"},
+	{Name: "dirt", Hex: "#8A6E45"},
+	{Name: "dirt brown", Hex: "#836539"},
+	{Name: "dirty blue", Hex: "#3F829D"},
+	{Name: "dirty green", Hex: "#667E2C"},
+	{Name: "dirty orange", Hex: "#C87606"},
+	{Name: "dirty pink", Hex: "#CA7B80"},
+	{Name: "dirty purple", Hex: "#734A65"},
+	{Name: "dirty yellow", Hex: "#CDC50A"},
+	{Name: "dodger blue", Hex: "#3E82FC"},
+	{Name: "drab", Hex: "#828344"},
+	{Name: "drab green", Hex: "#749551"},
+	{Name: "dried blood", Hex: "#4B0101"},
+	{Name: "duck egg blue", Hex: "#C3FBF4"},
+	{Name: "dull blue", Hex: "#49759C"},
+	{Name: "dull brown", Hex: "#876E4B"},
+	{Name: "dull green", Hex: "#74A662"},
+	{Name: "dull orange", Hex: "#D8863B"},
+	{Name: "dull pink", Hex: "#D5869D"},
+	{Name: "dull purple", Hex: "#84597E"},
+	{Name: "dull red", Hex: "#BB3F3F"},
+	{Name: "dull teal", Hex: "#5F9E8F"},
+	{Name: "dull yellow", Hex: "#EEDC5B"},
+	{Name: "dusk", Hex: "#4E5481"},
+	{Name: "dusk blue", Hex: "#26538D"},
+	{Name: "dusky blue", Hex: "#475F94"},
+	{Name: "dusky pink", Hex: "#CC7A8B"},
+	{Name: "dusky purple", Hex: "#895B7B"},
+	{Name: "dusky rose", Hex: "#BA6873"},
+	{Name: "dust", Hex: "#B2996E"},
+	{Name: "dusty blue", Hex: "#5A86AD"},
+	{Name: "dusty green", Hex: "#76A973"},
+	{Name: "dusty lavender", Hex: "#AC86A8"},
+	{Name: "dusty orange", Hex: "#F0833A"},
+	{Name: "dusty pink", Hex: "#D58A94"},
+	{Name: "dusty purple", Hex: "#825F87"},
+	{Name: "dusty red", Hex: "#B9484E"},
+	{Name: "dusty rose", Hex: "#C0737A"},
+	{Name: "dusty teal", Hex: "#4C9085"},
+	{Name: "earth", Hex: "#A2653E"},
+	{Name: "easter green", Hex: "#8CFD7E"},
+	{Name: "easter purple", Hex: "#C071FE"},
+	{Name: "ecru", Hex: "#FEFFCA"},
+	{Name: "egg shell", Hex: "#FFFCC4"},
+	{Name: "eggplant", Hex: "#380835"},
+	{Name: "eggplant purple", Hex: "#430541"},
+	{Name: "eggshell", Hex: "#FFFFD4"},
+	{Name: "eggshell blue", Hex: "#C4FFF7"},
+	{Name: "electric blue", Hex: "#0652FF"},
+	{Name: "electric green", Hex: "#21FC0D"},
+	{Name: "electric lime", Hex: "#A8FF04"},
+	{Name: "electric pink", Hex: "#FF0490"},
+	{Name: "electric purple", Hex: "#AA23FF"},
+	{Name: "emerald", Hex: "#01A049"},
+	{Name: "emerald green", Hex: "#028F1E"},
+	{Name: "evergreen", Hex: "#05472A"},
+	{Name: "faded blue", Hex: "#658CBB"},
+	{Name: "faded green", Hex: "#7BB274"},
+	{Name: "faded orange", Hex: "#F0944D"},
+	{Name: "faded pink", Hex: "#DE9DAC"},
+	{Name: "faded purple", Hex: "#916E99"},
+	{Name: "faded red", Hex: "#D3494E"},
+	{Name: "faded yellow", Hex: "#FEFF7F"},
+	{Name: "fawn", Hex: "#CFAF7B"},
+	{Name: "fern", Hex: "#63A950"},
+	{Name: "fern green", Hex: "#548D44"},
+	{Name: "fire engine red", Hex: "#FE0002"},
+	{Name: "flat blue", Hex: "#3C73A8"},
+	{Name: "flat green", Hex: "#699D4C"},
+	{Name: "fluorescent green", Hex: "#08FF08"},
+	{Name: "fluro green", Hex: "#0AFF02"},
+	{Name: "foam green", Hex: "#90FDA9"},
+	{Name: "forest", Hex: "#0B5509"},
+	{Name: "forest green", Hex: "#06470C"},
+	{Name: "forrest green", Hex: "#154406"},
+	{Name: "french blue", Hex: "#436BAD"},
+	{Name: "fresh green", Hex: "#69D84F"},
+	{Name: "frog green", Hex: "#58BC08"},
+	{Name: "fuchsia", Hex: "#ED0DD9"},
+	{Name: "gold", Hex: "#DBB40C"},
+	{Name: "golden", Hex: "#F5BF03"},
+	{Name: "golden brown", Hex: "#B27A01"},
+	{Name: "golden rod", Hex: "#F9BC08"},
+	{Name: "golden yellow", Hex: "#FEC615"},
+	{Name: "goldenrod", Hex: "#FAC205"},
+	{Name: "grape", Hex: "#6C3461"},
+	{Name: "grape purple", Hex: "#5D1451"},
+	{Name: "grapefruit", Hex: "#FD5956"},
+	{Name: "grass", Hex: "#5CAC2D"},
+	{Name: "grass green", Hex: "#3F9B0B"},
+	{Name: "grassy green", Hex: "#419C03"},
+	{Name: "green", Hex: "#15B01A"},
+	{Name: "green apple", Hex: "#5EDC1F"},
+	{Name: "green blue", Hex: "#06B48B"},
+	{Name: "green brown", Hex: "#544E03"},
+	{Name: "green grey", Hex: "#77926F"},
+	{Name: "green teal", Hex: "#0CB577"},
+	{Name: "green yellow", Hex: "#C9FF27"},
+	{Name: "green/blue", Hex: "#01C08D"},
+	{Name: "green/yellow", Hex: "#B5CE08"},
+	{Name: "greenblue", Hex: "#23C48B"},
+	{Name: "greenish", Hex: "#40A368"},
+	{Name: "greenish beige", Hex: "#C9D179"},
+	{Name: "greenish blue", Hex: "#0B8B87"},
+	{Name: "greenish brown", Hex: "#696112"},
+	{Name: "greenish cyan", Hex: "#2AFEB7"},
+	{Name: "greenish grey", Hex: "#96AE8D"},
+	{Name: "greenish tan", Hex: "#BCCB7A"},
+	{Name: "greenish teal", Hex: "#32BF84"},
+	{Name: "greenish turquoise", Hex: "#00FBB0"},
+	{Name: "greenish yellow", Hex: "#CDFD02"},
+	{Name: "greeny blue", Hex: "#42B395"},
+	{Name: "greeny brown", Hex: "#696006"},
+	{Name: "greeny grey", Hex: "#7EA07A"},
+	{Name: "greeny yellow", Hex: "#C6F808"},
+	{Name: "grey", Hex: "#929591"},
+	{Name: "grey blue", Hex: "#6B8BA4"},
+	{Name: "grey brown", Hex: "#7F7053"},
+	{Name: "grey green", Hex: "#789B73"},
+	{Name: "grey pink", Hex: "#C3909B"},
+	{Name: "grey purple", Hex: "#826D8C"},
+	{Name: "grey teal", Hex: "#5E9B8A"},
+	{Name: "grey/blue", Hex: "#647D8E"},
+	{Name: "grey/green", Hex: "#86A17D"},
+	{Name: "greyblue", Hex: "#77A1B5"},
+	{Name: "greyish", Hex: "#A8A495"},
+	{Name: "greyish blue", Hex: "#5E819D"},
+	{Name: "greyish brown", Hex: "#7A6A4F"},
+	{Name: "greyish green", Hex: "#82A67D"},
+	{Name: "greyish pink", Hex: "#C88D94"},
+	{Name: "greyish purple", Hex: "#887191"},
+	{Name: "greyish teal", Hex: "#719F91"},
+	{Name: "gross green", Hex: "#A0BF16"},
+	{Name: "gunmetal", Hex: "#536267"},
+	{Name: "hazel", Hex: "#8E7618"},
+	{Name: "heather", Hex: "#A484AC"},
+	{Name: "heliotrope", Hex: "#D94FF5"},
+	{Name: "highlighter green", Hex: "#1BFC06"},
+	{Name: "hospital green", Hex: "#9BE5AA"},
+	{Name: "hot green", Hex: "#25FF29"},
+	{Name: "hot magenta", Hex: "#F504C9"},
+	{Name: "hot pink", Hex: "#FF028D"},
+	{Name: "hot purple", Hex: "#CB00F5"},
+	{Name: "hunter green", Hex: "#0B4008"},
+	{Name: "ice", Hex: "#D6FFFA"},
+	{Name: "ice blue", Hex: "#D7FFFE"},
+	{Name: "icky green", Hex: "#8FAE22"},
+	{Name: "indian red", Hex: "#850E04"},
+	{Name: "indigo", Hex: "#380282"},
+	{Name: "indigo blue", Hex: "#3A18B1"},
+	{Name: "iris", Hex: "#6258C4"},
+	{Name: "irish green", Hex: "#019529"},
+	{Name: "ivory", Hex: "#FFFFCB"},
+	{Name: "jade", Hex: "#1FA774"},
+	{Name: "jade green", Hex: "#2BAF6A"},
+	{Name: "jungle green", Hex: "#048243"},
+	{Name: "kelley green", Hex: "#009337"},
+	{Name: "kelly green", Hex: "#02AB2E"},
+	{Name: "kermit green", Hex: "#5CB200"},
+	{Name: "key lime", Hex: "#AEFF6E"},
+	{Name: "khaki", Hex: "#AAA662"},
+	{Name: "khaki green", Hex: "#728639"},
+	{Name: "kiwi", Hex: "#9CEF43"},
+	{Name: "kiwi green", Hex: "#8EE53F"},
+	{Name: "lavender", Hex: "#C79FEF"},
+	{Name: "lavender blue", Hex: "#8B88F8"},
+	{Name: "lavender pink", Hex: "#DD85D7"},
+	{Name: "lawn green", Hex: "#4DA409"},
+	{Name: "leaf", Hex: "#71AA34"},
+	{Name: "leaf green", Hex: "#5CA904"},
+	{Name: "leafy green", Hex: "#51B73B"},
+	{Name: "leather", Hex: "#AC7434"},
+	{Name: "lemon", Hex: "#FDFF52"},
+	{Name: "lemon green", Hex: "#ADF802"},
+	{Name: "lemon lime", Hex: "#BFFE28"},
+	{Name: "lemon yellow", Hex: "#FDFF38"},
+	{Name: "lichen", Hex: "#8FB67B"},
+	{Name: "light aqua", Hex: "#8CFFDB"},
+	{Name: "light aquamarine", Hex: "#7BFDC7"},
+	{Name: "light beige", Hex: "#FFFEB6"},
+	{Name: "light blue", Hex: "#95D0FC"},
+	{Name: "light blue green", Hex: "#7EFBB3"},
+	{Name: "light blue grey", Hex: "#B7C9E2"},
+	{Name: "light bluish green", Hex: "#76FDA8"},
+	{Name: "light bright green", Hex: "#53FE5C"},
+	{Name: "light brown", Hex: "#AD8150"},
+	{Name: "light burgundy", Hex: "#A8415B"},
+	{Name: "light cyan", Hex: "#ACFFFC"},
+	{Name: "light eggplant", Hex: "#894585"},
+	{Name: "light forest green", Hex: "#4F9153"},
+	{Name: "light gold", Hex: "#FDDC5C"},
+	{Name: "light grass green", Hex: "#9AF764"},
+	{Name: "light green", Hex: "#96F97B"},
+	{Name: "light green blue", Hex: "#56FCA2"},
+	{Name: "light greenish blue", Hex: "#63F7B4"},
+	{Name: "light grey", Hex: "#D8DCD6"},
+	{Name: "light grey blue", Hex: "#9DBCD4"},
+	{Name: "light grey green", Hex: "#B7E1A1"},
+	{Name: "light indigo", Hex: "#6D5ACF"},
+	{Name: "light khaki", Hex: "#E6F2A2"},
+	{Name: "light lavendar", Hex: "#EFC0FE"},
+	{Name: "light lavender", Hex: "#DFC5FE"},
+	{Name: "light light blue", Hex: "#CAFFFB"},
+	{Name: "light light green", Hex: "#C8FFB0"},
+	{Name: "light lilac", Hex: "#EDC8FF"},
+	{Name: "light lime", Hex: "#AEFD6C"},
+	{Name: "light lime green", Hex: "#B9FF66"},
+	{Name: "light magenta", Hex: "#FA5FF7"},
+	{Name: "light maroon", Hex: "#A24857"},
+	{Name: "light mauve", Hex: "#C292A1"},
+	{Name: "light mint", Hex: "#B6FFBB"},
+	{Name: "light mint green", Hex: "#A6FBB2"},
+	{Name: "light moss green", Hex: "#A6C875"},
+	{Name: "light mustard", Hex: "#F7D560"},
+	{Name: "light navy", Hex: "#155084"},
+	{Name: "light navy blue", Hex: "#2E5A88"},
+	{Name: "light neon green", Hex: "#4EFD54"},
+	{Name: "light olive", Hex: "#ACBF69"},
+	{Name: "light olive green", Hex: "#A4BE5C"},
+	{Name: "light orange", Hex: "#FDAA48"},
+	{Name: "light pastel green", Hex: "#B2FBA5"},
+	{Name: "light pea green", Hex: "#C4FE82"},
+	{Name: "light peach", Hex: "#FFD8B1"},
+	{Name: "light periwinkle", Hex: "#C1C6FC"},
+	{Name: "light pink", Hex: "#FFD1DF"},
+	{Name: "light plum", Hex: "#9D5783"},
+	{Name: "light purple", Hex: "#BF77F6"},
+	{Name: "light red", Hex: "#FF474C"},
+	{Name: "light rose", Hex: "#FFC5CB"},
+	{Name: "light royal blue", Hex: "#3A2EFE"},
+	{Name: "light sage", Hex: "#BCECAC"},
+	{Name: "light salmon", Hex: "#FEA993"},
+	{Name: "light sea green", Hex: "#98F6B0"},
+	{Name: "light seafoam", Hex: "#A0FEBF"},
+	{Name: "light seafoam green", Hex: "#A7FFB5"},
+	{Name: "light sky blue", Hex: "#C6FCFF"},
+	{Name: "light tan", Hex: "#FBEEAC"},
+	{Name: "light teal", Hex: "#90E4C1"},
+	{Name: "light turquoise", Hex: "#7EF4CC"},
+	{Name: "light urple", Hex: "#B36FF6"},
+	{Name: "light violet", Hex: "#D6B4FC"},
+	{Name: "light yellow", Hex: "#FFFE7A"},
+	{Name: "light yellow green", Hex: "#CCFD7F"},
+	{Name: "light yellowish green", Hex: "#C2FF89"},
+	{Name: "lightblue", Hex: "#7BC8F6"},
+	{Name: "lighter green", Hex: "#75FD63"},
+	{Name: "lighter purple", Hex: "#A55AF4"},
+	{Name: "lightgreen", Hex: "#76FF7B"},
+	{Name: "lightish blue", Hex: "#3D7AFD"},
+	{Name: "lightish green", Hex: "#61E160"},
+	{Name: "lightish purple", Hex: "#A552E6"},
+	{Name: "lightish red", Hex: "#FE2F4A"},
+	{Name: "lilac", Hex: "#CEA2FD"},
+	{Name: "liliac", Hex: "#C48EFD"},
+	{Name: "lime", Hex: "#AAFF32"},
+	{Name: "lime green", Hex: "#89FE05"},
+	{Name: "lime yellow", Hex: "#D0FE1D"},
+	{Name: "lipstick", Hex: "#D5174E"},
+	{Name: "lipstick red", Hex: "#C0022F"},
+	{Name: "macaroni and cheese", Hex: "#EFB435"},
+	{Name: "magenta", Hex: "#C20078"},
+	{Name: "mahogany", Hex: "#4A0100"},
+	{Name: "maize", Hex: "#F4D054"},
+	{Name: "mango", Hex: "#FFA62B"},
+	{Name: "manilla", Hex: "#FFFA86"},
+	{Name: "marigold", Hex: "#FCC006"},
+	{Name: "marine", Hex: "#042E60"},
+	{Name: "marine blue", Hex: "#01386A"},
+	{Name: "maroon", Hex: "#650021"},
+	{Name: "mauve", Hex: "#AE7181"},
+	{Name: "medium blue", Hex: "#2C6FBB"},
+	{Name: "medium brown", Hex: "#7F5112"},
+	{Name: "medium green", Hex: "#39AD48"},
+	{Name: "medium grey", Hex: "#7D7F7C"},
+	{Name: "medium pink", Hex: "#F36196"},
+	{Name: "medium purple", Hex: "#9E43A2"},
+	{Name: "melon", Hex: "#FF7855"},
+	{Name: "merlot", Hex: "#730039"},
+	{Name: "metallic blue", Hex: "#4F738E"},
+	{Name: "mid blue", Hex: "#276AB3"},
+	{Name: "mid green", Hex: "#50A747"},
+	{Name: "midnight", Hex: "#03012D"},
+	{Name: "midnight blue", Hex: "#020035"},
+	{Name: "midnight purple", Hex: "#280137"},
+	{Name: "military green", Hex: "#667C3E"},
+	{Name: "milk chocolate", Hex: "#7F4E1E"},
+	{Name: "mint", Hex: "#9FFEB0"},
+	{Name: "mint green", Hex: "#8FFF9F"},
+	{Name: "minty green", Hex: "#0BF77D"},
+	{Name: "mocha", Hex: "#9D7651"},
+	{Name: "moss", Hex: "#769958"},
+	{Name: "moss green", Hex: "#658B38"},
+	{Name: "mossy green", Hex: "#638B27"},
+	{Name: "mud", Hex: "#735C12"},
+	{Name: "mud brown", Hex: "#60460F"},
+	{Name: "mud green", Hex: "#606602"},
+	{Name: "muddy brown", Hex: "#886806"},
+	{Name: "muddy green", Hex: "#657432"},
+	{Name: "muddy yellow", Hex: "#BFAC05"},
+	{Name: "mulberry", Hex: "#920A4E"},
+	{Name: "murky green", Hex: "#6C7A0E"},
+	{Name: "mushroom", Hex: "#BA9E88"},
+	{Name: "mustard", Hex: "#CEB301"},
+	{Name: "mustard brown", Hex: "#AC7E04"},
+	{Name: "mustard green", Hex: "#A8B504"},
+	{Name: "mustard yellow", Hex: "#D2BD0A"},
+	{Name: "muted blue", Hex: "#3B719F"},
+	{Name: "muted green", Hex: "#5FA052"},
+	{Name: "muted pink", Hex: "#D1768F"},
+	{Name: "muted purple", Hex: "#805B87"},
+	{Name: "nasty green", Hex: "#70B23F"},
+	{Name: "navy", Hex: "#01153E"},
+	{Name: "navy blue", Hex: "#001146"},
+	{Name: "navy green", Hex: "#35530A"},
+	{Name: "neon blue", Hex: "#04D9FF"},
+	{Name: "neon green", Hex: "#0CFF0C"},
+	{Name: "neon pink", Hex: "#FE019A"},
+	{Name: "neon purple", Hex: "#BC13FE"},
+	{Name: "neon red", Hex: "#FF073A"},
+	{Name: "neon yellow", Hex: "#CFFF04"},
+	{Name: "nice blue", Hex: "#107AB0"},
+	{Name: "night blue", Hex: "#040348"},
+	{Name: "ocean", Hex: "#017B92"},
+	{Name: "ocean blue", Hex: "#03719C"},
+	{Name: "ocean green", Hex: "#3D9973"},
+	{Name: "ocher", Hex: "#BF9B0C"},
+	{Name: "ochre", Hex: "#BF9005"},
+	{Name: "ocre", Hex: "#C69C04"},
+	{Name: "off blue", Hex: "#5684AE"},
+	{Name: "off green", Hex: "#6BA353"},
+	{Name: "off white", Hex: "#FFFFE4"},
+	{Name: "off yellow", Hex: "#F1F33F"},
+	{Name: "old pink", Hex: "#C77986"},
+	{Name: "old rose", Hex: "#C87F89"},
+	{Name: "olive", Hex: "#6E750E"},
+	{Name: "olive brown", Hex: "#645403"},
+	{Name: "olive drab", Hex: "#6F7632"},
+	{Name: "olive green", Hex: "#677A04"},
+	{Name: "olive yellow", Hex: "#C2B709"},
+	{Name: "orange", Hex: "#F97306"},
+	{Name: "orange brown", Hex: "#BE6400"},
+	{Name: "orange pink", Hex: "#FF6F52"},
+	{Name: "orange red", Hex: "#FD411E"},
+	{Name: "orange yellow", Hex: "#FFAD01"},
+	{Name: "orangeish", Hex: "#FD8D49"},
+	{Name: "orangered", Hex: "#FE420F"},
+	{Name: "orangey brown", Hex: "#B16002"},
+	{Name: "orangey red", Hex: "#FA4224"},
+	{Name: "orangey yellow", Hex: "#FDB915"},
+	{Name: "orangish", Hex: "#FC824A"},
+	{Name: "orangish brown", Hex: "#B25F03"},
+	{Name: "orangish red", Hex: "#F43605"},
+	{Name: "orchid", Hex: "#C875C4"},
+	{Name: "pale", Hex: "#FFF9D0"},
+	{Name: "pale aqua", Hex: "#B8FFEB"},
+	{Name: "pale blue", Hex: "#D0FEFE"},
+	{Name: "pale brown", Hex: "#B1916E"},
+	{Name: "pale cyan", Hex: "#B7FFFA"},
+	{Name: "pale gold", Hex: "#FDDE6C"},
+	{Name: "pale green", Hex: "#C7FDB5"},
+	{Name: "pale grey", Hex: "#FDFDFE"},
+	{Name: "pale lavender", Hex: "#EECFFE"},
+	{Name: "pale light green", Hex: "#B1FC99"},
+	{Name: "pale lilac", Hex: "#E4CBFF"},
+	{Name: "pale lime", Hex: "#BEFD73"},
+	{Name: "pale lime green", Hex: "#B1FF65"},
+	{Name: "pale magenta", Hex: "#D767AD"},
+	{Name: "pale mauve", Hex: "#FED0FC"},
+	{Name: "pale olive", Hex: "#B9CC81"},
+	{Name: "pale olive green", Hex: "#B1D27B"},
+	{Name: "pale orange", Hex: "#FFA756"},
+	{Name: "pale peach", Hex: "#FFE5AD"},
+	{Name: "pale pink", Hex: "#FFCFDC"},
+	{Name: "pale purple", Hex: "#B790D4"},
+	{Name: "pale red", Hex: "#D9544D"},
+	{Name: "pale rose", Hex: "#FDC1C5"},
+	{Name: "pale salmon", Hex: "#FFB19A"},
+	{Name: "pale sky blue", Hex: "#BDF6FE"},
+	{Name: "pale teal", Hex: "#82CBB2"},
+	{Name: "pale turquoise", Hex: "#A5FBD5"},
+	{Name: "pale violet", Hex: "#CEAEFA"},
+	{Name: "pale yellow", Hex: "#FFFF84"},
+	{Name: "parchment", Hex: "#FEFCAF"},
+	{Name: "pastel blue", Hex: "#A2BFFE"},
+	{Name: "pastel green", Hex: "#B0FF9D"},
+	{Name: "pastel orange", Hex: "#FF964F"},
+	{Name: "pastel pink", Hex: "#FFBACD"},
+	{Name: "pastel purple", Hex: "#CAA0FF"},
+	{Name: "pastel red", Hex: "#DB5856"},
+	{Name: "pastel yellow", Hex: "#FFFE71"},
+	{Name: "pea", Hex: "#A4BF20"},
+	{Name: "pea green", Hex: "#8EAB12"},
+	{Name: "pea soup", Hex: "#929901"},
+	{Name: "pea soup green", Hex: "#94A617"},
+	{Name: "peach", Hex: "#FFB07C"},
+	{Name: "peachy pink", Hex: "#FF9A8A"},
+	{Name: "peacock blue", Hex: "#016795"},
+	{Name: "pear", Hex: "#CBF85F"},
+	{Name: "periwinkle", Hex: "#8E82FE"},
+	{Name: "periwinkle blue", Hex: "#8F99FB"},
+	{Name: "perrywinkle", Hex: "#8F8CE7"},
+	{Name: "petrol", Hex: "#005F6A"},
+	{Name: "pig pink", Hex: "#E78EA5"},
+	{Name: "pine", Hex: "#2B5D34"},
+	{Name: "pine green", Hex: "#0A481E"},
+	{Name: "pink", Hex: "#FF81C0"},
+	{Name: "pink purple", Hex: "#DB4BDA"},
+	{Name: "pink red", Hex: "#F5054F"},
+	{Name: "pink/purple", Hex: "#EF1DE7"},
+	{Name: "pinkish", Hex: "#D46A7E"},
+	{Name: "pinkish brown", Hex: "#B17261"},
+	{Name: "pinkish grey", Hex: "#C8ACA9"},
+	{Name: "pinkish orange", Hex: "#FF724C"},
+	{Name: "pinkish purple", Hex: "#D648D7"},
+	{Name: "pinkish red", Hex: "#F10C45"},
+	{Name: "pinkish tan", Hex: "#D99B82"},
+	{Name: "pinky", Hex: "#FC86AA"},
+	{Name: "pinky purple", Hex: "#C94CBE"},
+	{Name: "pinky red", Hex: "#FC2647"},
+	{Name: "piss yellow", Hex: "#DDD618"},
+	{Name: "pistachio", Hex: "#C0FA8B"},
+	{Name: "plum", Hex: "#580F41"},
+	{Name: "plum purple", Hex: "#4E0550"},
+	{Name: "poison green", Hex: "#40FD14"},
+	{Name: "poo", Hex: "#8F7303"},
+	{Name: "poo brown", Hex: "#885F01"},
+	{Name: "poop", Hex: "#7F5E00"},
+	{Name: "poop brown", Hex: "#7A5901"},
+	{Name: "poop green", Hex: "#6F7C00"},
+	{Name: "powder blue", Hex: "#B1D1FC"},
+	{Name: "powder pink", Hex: "#FFB2D0"},
+	{Name: "primary blue", Hex: "#0804F9"},
+	{Name: "prussian blue", Hex: "#004577"},
+	{Name: "puce", Hex: "#A57E52"},
+	{Name: "puke", Hex: "#A5A502"},
+	{Name: "puke brown", Hex: "#947706"},
+	{Name: "puke green", Hex: "#9AAE07"},
+	{Name: "puke yellow", Hex: "#C2BE0E"},
+	{Name: "pumpkin", Hex: "#E17701"},
+	{Name: "pumpkin orange", Hex: "#FB7D07"},
+	{Name: "pure blue", Hex: "#0203E2"},
+	{Name: "purple", Hex: "#7E1E9C"},
+	{Name: "purple blue", Hex: "#632DE9"},
+	{Name: "purple brown", Hex: "#673A3F"},
+	{Name: "purple grey", Hex: "#866F85"},
+	{Name: "purple pink", Hex: "#E03FD8"},
+	{Name: "purple red", Hex: "#990147"},
+	{Name: "purple/blue", Hex: "#5D21D0"},
+	{Name: "purple/pink", Hex: "#D725DE"},
+	{Name: "purpleish", Hex: "#98568D"},
+	{Name: "purpleish blue", Hex: "#6140EF"},
+	{Name: "purpleish pink", Hex: "#DF4EC8"},
+	{Name: "purpley", Hex: "#8756E4"},
+	{Name: "purpley blue", Hex: "#5F34E7"},
+	{Name: "purpley grey", Hex: "#947E94"},
+	{Name: "purpley pink", Hex: "#C83CB9"},
+	{Name: "purplish", Hex: "#94568C"},
+	{Name: "purplish blue", Hex: "#601EF9"},
+	{Name: "purplish brown", Hex: "#6B4247"},
+	{Name: "purplish grey", Hex: "#7A687F"},
+	{Name: "purplish pink", Hex: "#CE5DAE"},
+	{Name: "purplish red", Hex: "#B0054B"},
+	{Name: "purply", Hex: "#983FB2"},
+	{Name: "purply blue", Hex: "#661AEE"},
+	{Name: "purply pink", Hex: "#F075E6"},
+	{Name: "putty", Hex: "#BEAE8A"},
+	{Name: "racing green", Hex: "#014600"},
+	{Name: "radioactive green", Hex: "#2CFA1F"},
+	{Name: "raspberry", Hex: "#B00149"},
+	{Name: "raw sienna", Hex: "#9A6200"},
+	{Name: "raw umber", Hex: "#A75E09"},
+	{Name: "really light blue", Hex: "#D4FFFF"},
+	{Name: "red", Hex: "#E50000"},
+	{Name: "red brown", Hex: "#8B2E16"},
+	{Name: "red orange", Hex: "#FD3C06"},
+	{Name: "red pink", Hex: "#FA2A55"},
+	{Name: "red purple", Hex: "#820747"},
+	{Name: "red violet", Hex: "#9E0168"},
+	{Name: "red wine", Hex: "#8C0034"},
+	{Name: "reddish", Hex: "#C44240"},
+	{Name: "reddish brown", Hex: "#7F2B0A"},
+	{Name: "reddish grey", Hex: "#997570"},
+	{Name: "reddish orange", Hex: "#F8481C"},
+	{Name: "reddish pink", Hex: "#FE2C54"},
+	{Name: "reddish purple", Hex: "#910951"},
+	{Name: "reddy brown", Hex: "#6E1005"},
+	{Name: "rich blue", Hex: "#021BF9"},
+	{Name: "rich purple", Hex: "#720058"},
+	{Name: "robin egg blue", Hex: "#8AF1FE"},
+	{Name: "robin's egg", Hex: "#6DEDFD"},
+	{Name: "robin's egg blue", Hex: "#98EFF9"},
+	{Name: "rosa", Hex: "#FE86A4"},
+	{Name: "rose", Hex: "#CF6275"},
+	{Name: "rose pink", Hex: "#F7879A"},
+	{Name: "rose red", Hex: "#BE013C"},
+	{Name: "rosy pink", Hex: "#F6688E"},
+	{Name: "rouge", Hex: "#AB1239"},
+	{Name: "royal", Hex: "#0C1793"},
+	{Name: "royal blue", Hex: "#0504AA"},
+	{Name: "royal purple", Hex: "#4B006E"},
+	{Name: "ruby", Hex: "#CA0147"},
+	{Name: "russet", Hex: "#A13905"},
+	{Name: "rust", Hex: "#A83C09"},
+	{Name: "rust brown", Hex: "#8B3103"},
+	{Name: "rust orange", Hex: "#C45508"},
+	{Name: "rust red", Hex: "#AA2704"},
+	{Name: "rusty orange", Hex: "#CD5909"},
+	{Name: "rusty red", Hex: "#AF2F0D"},
+	{Name: "saffron", Hex: "#FEB209"},
+	{Name: "sage", Hex: "#87AE73"},
+	{Name: "sage green", Hex: "#88B378"},
+	{Name: "salmon", Hex: "#FF796C"},
+	{Name: "salmon pink", Hex: "#FE7B7C"},
+	{Name: "sand", Hex: "#E2CA76"},
+	{Name: "sand brown", Hex: "#CBA560"},
+	{Name: "sand yellow", Hex: "#FCE166"},
+	{Name: "sandstone", Hex: "#C9AE74"},
+	{Name: "sandy", Hex: "#F1DA7A"},
+	{Name: "sandy brown", Hex: "#C4A661"},
+	{Name: "sandy yellow", Hex: "#FDEE73"},
+	{Name: "sap green", Hex: "#5C8B15"},
+	{Name: "sapphire", Hex: "#2138AB"},
+	{Name: "scarlet", Hex: "#BE0119"},
+	{Name: "sea", Hex: "#3C9992"},
+	{Name: "sea blue", Hex: "#047495"},
+	{Name: "sea green", Hex: "#53FCA1"},
+	{Name: "seafoam", Hex: "#80F9AD"},
+	{Name: "seafoam blue", Hex: "#78D1B6"},
+	{Name: "seafoam green", Hex: "#7AF9AB"},
+	{Name: "seaweed", Hex: "#18D17B"},
+	{Name: "seaweed green", Hex: "#35AD6B"},
+	{Name: "sepia", Hex: "#985E2B"},
+	{Name: "shamrock", Hex: "#01B44C"},
+	{Name: "shamrock green", Hex: "#02C14D"},
+	{Name: "shit", Hex: "#7F5F00"},
+	{Name: "shit brown", Hex: "#7B5804"},
+	{Name: "shit green", Hex: "#758000"},
+	{Name: "shocking pink", Hex: "#FE02A2"},
+	{Name: "sick green", Hex: "#9DB92C"},
+	{Name: "sickly green", Hex: "#94B21C"},
+	{Name: "sickly yellow", Hex: "#D0E429"},
+	{Name: "sienna", Hex: "#A9561E"},
+	{Name: "silver", Hex: "#C5C9C7"},
+	{Name: "sky", Hex: "#82CAFC"},
+	{Name: "sky blue", Hex: "#75BBFD"},
+	{Name: "slate", Hex: "#516572"},
+	{Name: "slate blue", Hex: "#5B7C99"},
+	{Name: "slate green", Hex: "#658D6D"},
+	{Name: "slate grey", Hex: "#59656D"},
+	{Name: "slime green", Hex: "#99CC04"},
+	{Name: "snot", Hex: "#ACBB0D"},
+	{Name: "snot green", Hex: "#9DC100"},
+	{Name: "soft blue", Hex: "#6488EA"},
+	{Name: "soft green", Hex: "#6FC276"},
+	{Name: "soft pink", Hex: "#FDB0C0"},
+	{Name: "soft purple", Hex: "#A66FB5"},
+	{Name: "spearmint", Hex: "#1EF876"},
+	{Name: "spring green", Hex: "#A9F971"},
+	{Name: "spruce", Hex: "#0A5F38"},
+	{Name: "squash", Hex: "#F2AB15"},
+	{Name: "steel", Hex: "#738595"},
+	{Name: "steel blue", Hex: "#5A7D9A"},
+	{Name: "steel grey", Hex: "#6F828A"},
+	{Name: "stone", Hex: "#ADA587"},
+	{Name: "stormy blue", Hex: "#507B9C"},
+	{Name: "straw", Hex: "#FCF679"},
+	{Name: "strawberry", Hex: "#FB2943"},
+	{Name: "strong blue", Hex: "#0C06F7"},
+	{Name: "strong pink", Hex: "#FF0789"},
+	{Name: "sun yellow", Hex: "#FFDF22"},
+	{Name: "sunflower", Hex: "#FFC512"},
+	{Name: "sunflower yellow", Hex: "#FFDA03"},
+	{Name: "sunny yellow", Hex: "#FFF917"},
+	{Name: "sunshine yellow", Hex: "#FFFD37"},
+	{Name: "swamp", Hex: "#698339"},
+	{Name: "swamp green", Hex: "#748500"},
+	{Name: "tan", Hex: "#D1B26F"},
+	{Name: "tan brown", Hex: "#AB7E4C"},
+	{Name: "tan green", Hex: "#A9BE70"},
+	{Name: "tangerine", Hex: "#FF9408"},
+	{Name: "taupe", Hex: "#B9A281"},
+	{Name: "tea", Hex: "#65AB7C"},
+	{Name: "tea green", Hex: "#BDF8A3"},
+	{Name: "teal", Hex: "#029386"},
+	{Name: "teal blue", Hex: "#01889F"},
+	{Name: "teal green", Hex: "#25A36F"},
+	{Name: "tealish", Hex: "#24BCA8"},
+	{Name: "tealish green", Hex: "#0CDC73"},
+	{Name: "terra cotta", Hex: "#C9643B"},
+	{Name: "terracota", Hex: "#CB6843"},
+	{Name: "terracotta", Hex: "#CA6641"},
+	{Name: "tiffany blue", Hex: "#7BF2DA"},
+	{Name: "tomato", Hex: "#EF4026"},
+	{Name: "tomato red", Hex: "#EC2D01"},
+	{Name: "topaz", Hex: "#13BBAF"},
+	{Name: "toupe", Hex: "#C7AC7D"},
+	{Name: "toxic green", Hex: "#61DE2A"},
+	{Name: "tree green", Hex: "#2A7E19"},
+	{Name: "true blue", Hex: "#010FCC"},
+	{Name: "true green", Hex: "#089404"},
+	{Name: "turquoise", Hex: "#06C2AC"},
+	{Name: "turquoise blue", Hex: "#06B1C4"},
+	{Name: "turquoise green", Hex: "#04F489"},
+	{Name: "turtle green", Hex: "#75B84F"},
+	{Name: "twilight", Hex: "#4E518B"},
+	{Name: "twilight blue", Hex: "#0A437A"},
+	{Name: "ugly blue", Hex: "#31668A"},
+	{Name: "ugly brown", Hex: "#7D7103"},
+	{Name: "ugly green", Hex: "#7A9703"},
+	{Name: "ugly pink", Hex: "#CD7584"},
+	{Name: "ugly purple", Hex: "#A442A0"},
+	{Name: "ugly yellow", Hex: "#D0C101"},
+	{Name: "ultramarine", Hex: "#2000B1"},
+	{Name: "ultramarine blue", Hex: "#1805DB"},
+	{Name: "umber", Hex: "#B26400"},
+	{Name: "velvet", Hex: "#750851"},
+	{Name: "vermillion", Hex: "#F4320C"},
+	{Name: "very dark blue", Hex: "#000133"},
+	{Name: "very dark brown", Hex: "#1D0200"},
+	{Name: "very dark green", Hex: "#062E03"},
+	{Name: "very dark purple", Hex: "#2A0134"},
+	{Name: "very light blue", Hex: "#D5FFFF"},
+	{Name: "very light brown", Hex: "#D3B683"},
+	{Name: "very light green", Hex: "#D1FFBD"},
+	{Name: "very light pink", Hex: "#FFF4F2"},
+	{Name: "very light purple", Hex: "#F6CEFC"},
+	{Name: "very pale blue", Hex: "#D6FFFE"},
+	{Name: "very pale green", Hex: "#CFFDBC"},
+	{Name: "vibrant blue", Hex: "#0339F8"},
+	{Name: "vibrant green", Hex: "#0ADD08"},
+	{Name: "vibrant purple", Hex: "#AD03DE"},
+	{Name: "violet", Hex: "#9A0EEA"},
+	{Name: "violet blue", Hex: "#510AC9"},
+	{Name: "violet pink", Hex: "#FB5FFC"},
+	{Name: "violet red", Hex: "#A50055"},
+	{Name: "viridian", Hex: "#1E9167"},
+	{Name: "vivid blue", Hex: "#152EFF"},
+	{Name: "vivid green", Hex: "#2FEF10"},
+	{Name: "vivid purple", Hex: "#9900FA"},
+	{Name: "vomit", Hex: "#A2A415"},
+	{Name: "vomit green", Hex: "#89A203"},
+	{Name: "vomit yellow", Hex: "#C7C10C"},
+	{Name: "warm blue", Hex: "#4B57DB"},
+	{Name: "warm brown", Hex: "#964E02"},
+	{Name: "warm grey", Hex: "#978A84"},
+	{Name: "warm pink", Hex: "#FB5581"},
+	{Name: "warm purple", Hex: "#952E8F"},
+	{Name: "washed out green", Hex: "#BCF5A6"},
+	{Name: "water blue", Hex: "#0E87CC"},
+	{Name: "watermelon", Hex: "#FD4659"},
+	{Name: "weird green", Hex: "#3AE57F"},
+	{Name: "wheat", Hex: "#FBDD7E"},
+	{Name: "white", Hex: "#FFFFFF"},
+	{Name: "windows blue", Hex: "#3778BF"},
+	{Name: "wine", Hex: "#80013F"},
+	{Name: "wine red", Hex: "#7B0323"},
+	{Name: "wintergreen", Hex: "#20F986"},
+	{Name: "wisteria", Hex: "#A87DC2"},
+	{Name: "yellow", Hex: "#FFFF14"},
+	{Name: "yellow brown", Hex: "#B79400"},
+	{Name: "yellow green", Hex: "#C0FB2D"},
+	{Name: "yellow ochre", Hex: "#CB9D06"},
+	{Name: "yellow orange", Hex: "#FCB001"},
+	{Name: "yellow tan", Hex: "#FFE36E"},
+	{Name: "yellow/green", Hex: "#C8FD3D"},
+	{Name: "yellowgreen", Hex: "#BBF90F"},
+	{Name: "yellowish", Hex: "#FAEE66"},
+	{Name: "yellowish brown", Hex: "#9B7A01"},
+	{Name: "yellowish green", Hex: "#B0DD16"},
+	{Name: "yellowish orange", Hex: "#FFAB0F"},
+	{Name: "yellowish tan", Hex: "#FCFC81"},
+	{Name: "yellowy brown", Hex: "#AE8B0C"},
+	{Name: "yellowy green", Hex: "#BFF128"},
+}
@@ -0,0 +1,170 @@
+// Copyright © 2019 Xavier Basty <xavier@hexbee.net>
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package named
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+)
+
+// spellingVariants are the spellings tried in turn when a name is not found,
+// as pairs of the normalized spelling and its replacement.
+var spellingVariants = [][2]string{
+	{"grey", "gray"},
+	{"gray", "grey"},
+}
+
+// UnknownColorError is returned when a color name is not found in a palette.
+type UnknownColorError struct {
+	Name    string
+	Palette string
+
+	// Suggestions are the names of the palette closest to the name.
+	Suggestions []string
+}
+
+func (e *UnknownColorError) Error() string {
+	msg := fmt.Sprintf("unrecognized %v color name: %v", e.Palette, e.Name)
+	switch len(e.Suggestions) {
+	case 0:
+		return msg
+	case 1:
+		return fmt.Sprintf("%v, did you mean %q?", msg, e.Suggestions[0])
+	default:
+		quoted := make([]string, len(e.Suggestions))
+		for i, s := range e.Suggestions {
+			quoted[i] = fmt.Sprintf("%q", s)
+		}
+		return fmt.Sprintf("%v, did you mean %v or %v?", msg, strings.Join(quoted[:len(quoted)-1], ", "), quoted[len(quoted)-1])
+	}
+}
+
+// MaxSuggestions is the maximal number of suggestions of UnknownColorError.
+const MaxSuggestions = 3
+
+// Resolve returns the color of the palette with a name or code. The name is
+// looked up as with Lookup, then with the spelling variants of its words, such
+// as grey and gray.
+//
+// When the name is not found, the error is an *UnknownColorError suggesting
+// the closest names of the palette.
+func (p *Palette) Resolve(name string) (Color, error) {
+	if c, ok := p.Lookup(name); ok {
+		return c, nil
+	}
+
+	key := normalize(name)
+	for _, v := range spellingVariants {
+		if strings.Contains(key, v[0]) {
+			if i, ok := p.index[strings.Replace(key, v[0], v[1], -1)]; ok {
+				return p.colors[i], nil
+			}
+		}
+	}
+
+	return Color{}, &UnknownColorError{Name: name, Palette: p.Name, Suggestions: p.Suggest(name, MaxSuggestions)}
+}
+
+// Suggest returns at most n names of the palette close to a name, sorted by
+// increasing edit distance, then in the palette order. The edit distance
+// ignores case, spaces and punctuation, and names further than a third of
+// their length are not suggested.
+func (p *Palette) Suggest(name string, n int) []string {
+	key := normalize(name)
+	if key == "" || n <= 0 {
+		return nil
+	}
+
+	maxDistance := len([]rune(key)) / 3
+	if maxDistance < 1 {
+		maxDistance = 1
+	}
+
+	type candidate struct {
+		color, distance int
+	}
+	var candidates []candidate
+	for k, i := range p.index {
+		if d := editDistance(key, k); d <= maxDistance {
+			candidates = append(candidates, candidate{i, d})
+		}
+	}
+	sort.Slice(candidates, func(i, j int) bool {
+		if candidates[i].distance != candidates[j].distance {
+			return candidates[i].distance < candidates[j].distance
+		}
+		return candidates[i].color < candidates[j].color
+	})
+
+	var names []string
+	seen := map[int]bool{}
+	for _, c := range candidates {
+		if len(names) == n {
+			break
+		}
+		if !seen[c.color] {
+			seen[c.color] = true
+			names = append(names, p.colors[c.color].Name)
+		}
+	}
+	return names
+}
+
+////////////////////////////////////////
+
+// editDistance returns the optimal string alignment distance between two
+// strings: the number of insertions, deletions, substitutions and
+// transpositions of adjacent characters changing one into the other.
+func editDistance(a, b string) int {
+	s, t := []rune(a), []rune(b)
+
+	// Rows i-2, i-1 and i of the distance matrix.
+	prev2 := make([]int, len(t)+1)
+	prev := make([]int, len(t)+1)
+	cur := make([]int, len(t)+1)
+	for j := range prev {
+		prev[j] = j
+	}
+
+	for i := 1; i <= len(s); i++ {
+		cur[0] = i
+		for j := 1; j <= len(t); j++ {
+			cost := 1
+			if s[i-1] == t[j-1] {
+				cost = 0
+			}
+			d := minInt(prev[j]+1, cur[j-1]+1, prev[j-1]+cost)
+			if i > 1 && j > 1 && s[i-1] == t[j-2] && s[i-2] == t[j-1] {
+				d = minInt(d, prev2[j-2]+1, d)
+			}
+			cur[j] = d
+		}
+		prev2, prev, cur = prev, cur, prev2
+	}
+
+	return prev[len(t)]
+}
+
+func minInt(a, b, c int) int {
+	m := a
+	if b < m {
+		m = b
+	}
+	if c < m {
+		m = c
+	}
+	return m
+}
@@ -0,0 +1,75 @@
+// Copyright © 2019 Xavier Basty <xavier@hexbee.net>
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package named_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/Hexbee-net/gocolor/named"
+)
+
+func TestResolve(t *testing.T) {
+	tests := []struct {
+		palette *named.Palette
+		name    string
+		to      string
+	}{
+		{named.CSS, "aliceblue", "aliceblue"},
+		{named.CSS, "  Alice   Blue ", "aliceblue"},
+		{named.CSS, "alice-blue", "aliceblue"},
+		{named.CSS, "ALICE_BLUE", "aliceblue"},
+		{named.CSS, "dark slate grey", "darkslategrey"},
+		{named.Crayola, "Blue Grey", "Blue Gray"},
+		{named.XKCD, "greyish blue", "greyish blue"},
+		{named.XKCD, "grayish blue", "greyish blue"},
+		{named.ISCCNBS, "light greyish red", "light grayish red"},
+		{named.RAL, "RAL-7035", "Light grey"},
+		{named.RAL, "Light gray", "Light grey"},
+	}
+
+	for _, test := range tests {
+		c, err := test.palette.Resolve(test.name)
+		require.NoError(t, err, "%v: %v", test.palette.Name, test.name)
+		assert.Equal(t, test.to, c.Name, "%v: %v", test.palette.Name, test.name)
+	}
+}
+
+func TestResolveSuggestions(t *testing.T) {
+	_, err := named.CSS.Resolve("cornflour blue")
+	require.Error(t, err)
+	unknown, ok := err.(*named.UnknownColorError)
+	require.True(t, ok)
+	assert.Equal(t, "cornflour blue", unknown.Name)
+	assert.Equal(t, "CSS", unknown.Palette)
+	assert.Equal(t, []string{"cornflowerblue"}, unknown.Suggestions)
+	assert.EqualError(t, err, `unrecognized CSS color name: cornflour blue, did you mean "cornflowerblue"?`)
+
+	// Transpositions count as a single edit.
+	assert.Equal(t, []string{"orange"}, named.CSS.Suggest("ornage", 1))
+	assert.Equal(t, []string{"salmon"}, named.CSS.Suggest("slamon", 1))
+
+	// Names are suggested in the order of their distance.
+	assert.Equal(t, []string{"tan", "teal"}, named.CSS.Suggest("tal", 2))
+	assert.EqualError(t, &named.UnknownColorError{Name: "x", Palette: "CSS", Suggestions: []string{"a", "b", "c"}},
+		`unrecognized CSS color name: x, did you mean "a", "b" or "c"?`)
+
+	_, err = named.CSS.Resolve("qwertyuiop")
+	assert.EqualError(t, err, "unrecognized CSS color name: qwertyuiop")
+	assert.Empty(t, named.CSS.Suggest("", 3))
+	assert.Empty(t, named.CSS.Suggest("red", 0))
+}
@@ -0,0 +1,341 @@
+// Copyright © 2019 Xavier Basty <xavier@hexbee.net>
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package gocolor
+
+import (
+	"fmt"
+	"image/color"
+	"math"
+	"sort"
+	"sync"
+
+	"github.com/Hexbee-net/gocolor/named"
+)
+
+// NamedColorMatch is a named color found by a nearest color lookup.
+type NamedColorMatch struct {
+	named.Color
+	Distance float64
+}
+
+// NamedColorIndex finds the named colors nearest to a color. The colors are
+// stored in a vantage point tree, so that lookups do not go through all the
+// colors. An index is immutable, and can be used concurrently from multiple
+// goroutines.
+type NamedColorIndex struct {
+	// distance is the metric of the tree. When set, rank is the distance of
+	// the results, and radius returns the tree distance within which are all
+	// the colors at most tau away from the query in rank distance.
+	distance func(x, y vector) float64
+	rank     func(x, y vector) float64
+	radius   func(query vector, tau float64) float64
+
+	toSpace func(xyz vector) vector
+	colors  []indexedColor
+	root    *vpNode
+}
+
+type indexedColor struct {
+	named.Color
+	v vector
+}
+
+// vpNode is a node of a vantage point tree. The colors of the inside subtree
+// are at most radius away from the color of the node, the ones of the outside
+// subtree at least radius away.
+type vpNode struct {
+	color           int
+	radius          float64
+	inside, outside *vpNode
+}
+
+type paletteIndexKey struct {
+	palette  *named.Palette
+	distance string
+}
+
+var (
+	paletteIndexesMutex sync.Mutex
+	paletteIndexes      = map[paletteIndexKey]*NamedColorIndex{}
+)
+
+// NewNamedColorIndex creates an index of the colors of a palette, for one of
+// the Distance formulas.
+//
+// The color differences are computed from CIELab coordinates for illuminant
+// D65 and the 2° standard observer. CIEDE2000 is not a true distance, as it
+// does not always satisfy the triangle inequality, so the tree is built with
+// the CIE76 distance: lookups gather the colors close enough in CIE76 to be
+// among the nearest ones in CIEDE2000, and sort them by CIEDE2000.
+func NewNamedColorIndex(palette *named.Palette, distance string) (*NamedColorIndex, error) {
+	idx := &NamedColorIndex{}
+
+	wp := observerWhitePoints[Observer2][RefIlluminantD65]
+	switch distance {
+	case DistanceCIE76, DistanceCIEDE2000:
+		idx.toSpace = func(xyz vector) vector { return xyzToLab(xyz, wp) }
+		idx.distance = deltaE76
+	case DistanceOklab:
+		idx.toSpace = xyzToOklab
+		idx.distance = deltaE76
+	default:
+		return nil, fmt.Errorf("unrecognized color distance: %v", distance)
+	}
+
+	colors := palette.Colors()
+	idx.colors = make([]indexedColor, len(colors))
+	for i, c := range colors {
+		v, err := paletteColorVector(c, distance, wp)
+		if err != nil {
+			return nil, err
+		}
+		idx.colors[i] = indexedColor{Color: c, v: v}
+	}
+
+	if distance == DistanceCIEDE2000 {
+		var maxChroma, maxDL float64
+		for _, c := range idx.colors {
+			maxChroma = math.Max(maxChroma, math.Hypot(c.v.v1, c.v.v2))
+			maxDL = math.Max(maxDL, math.Abs(c.v.v0-50))
+		}
+		idx.rank = deltaE2000
+		idx.radius = func(query vector, tau float64) float64 { return deltaE2000Radius(query, tau, maxChroma, maxDL) }
+	}
+
+	items := make([]int, len(idx.colors))
+	for i := range items {
+		items[i] = i
+	}
+	idx.root = idx.build(items)
+
+	return idx, nil
+}
+
+// paletteColorVector returns the coordinates of a palette color in the space
+// of a distance. The Lab values computed by the palette are used when they are
+// available, the hex value is parsed otherwise.
+func paletteColorVector(c named.Color, distance string, wp vector) (vector, error) {
+	var lab vector
+	if c.Family != "" {
+		lab = vectorOf(c.Lab)
+	} else {
+		r, g, b, err := HEXtoRGB(c.Hex)
+		if err != nil {
+			return vector{}, fmt.Errorf("invalid value for color %v: %v", c.Name, err)
+		}
+		xyz, err := rgbToXYZ(vector{r, g, b}, SRGB)
+		if err != nil {
+			return vector{}, err
+		}
+		lab = xyzToLab(xyz, wp)
+	}
+
+	if distance == DistanceOklab {
+		return xyzToOklab(labToXYZ(lab, wp)), nil
+	}
+	return lab, nil
+}
+
+// NearestNamedColors returns the k colors of a palette nearest to a color,
+// for one of the Distance formulas, sorted by increasing distance. The palette
+// defaults to named.Wikipedia.
+//
+// The index of the palette is created on the first lookup, and kept for the
+// following ones.
+func NearestNamedColors(c color.Color, palette *named.Palette, k int, distance string) ([]NamedColorMatch, error) {
+	if palette == nil {
+		palette = named.Wikipedia
+	}
+
+	key := paletteIndexKey{palette, distance}
+	paletteIndexesMutex.Lock()
+	idx, ok := paletteIndexes[key]
+	if !ok {
+		var err error
+		if idx, err = NewNamedColorIndex(palette, distance); err != nil {
+			paletteIndexesMutex.Unlock()
+			return nil, err
+		}
+		paletteIndexes[key] = idx
+	}
+	paletteIndexesMutex.Unlock()
+
+	return idx.Nearest(c, k), nil
+}
+
+// Len returns the number of colors of the index.
+func (idx *NamedColorIndex) Len() int {
+	return len(idx.colors)
+}
+
+// Nearest returns the k colors of the index nearest to a color, sorted by
+// increasing distance, then by name. Colors of the package types are looked
+// up with their exact coordinates, the other ones are converted from sRGB.
+func (idx *NamedColorIndex) Nearest(c color.Color, k int) []NamedColorMatch {
+	if k > len(idx.colors) {
+		k = len(idx.colors)
+	}
+	if k <= 0 {
+		return nil
+	}
+
+	s := &vpSearch{idx: idx, query: idx.toSpace(colorToXYZ(c)), k: k}
+	s.search(idx.root)
+
+	matches := make([]NamedColorMatch, len(s.results))
+	for i, r := range s.results {
+		matches[i] = NamedColorMatch{Color: idx.colors[r.color].Color, Distance: r.distance}
+	}
+	return matches
+}
+
+////////////////////////////////////////
+
+// build creates the vantage point tree of colors. The first color is the
+// vantage point, the other ones are split at the median of their distances
+// to it.
+func (idx *NamedColorIndex) build(items []int) *vpNode {
+	if len(items) == 0 {
+		return nil
+	}
+
+	node := &vpNode{color: items[0]}
+	rest := items[1:]
+	if len(rest) == 0 {
+		return node
+	}
+
+	vp := idx.colors[node.color].v
+	distances := make(map[int]float64, len(rest))
+	for _, i := range rest {
+		distances[i] = idx.distance(vp, idx.colors[i].v)
+	}
+	sort.Slice(rest, func(i, j int) bool {
+		return distances[rest[i]] < distances[rest[j]]
+	})
+
+	mid := len(rest) / 2
+	node.radius = distances[rest[mid]]
+	node.inside = idx.build(rest[:mid])
+	node.outside = idx.build(rest[mid:])
+
+	return node
+}
+
+type vpResult struct {
+	color    int
+	distance float64
+}
+
+// vpSearch holds the state of a k nearest neighbors search.
+type vpSearch struct {
+	idx     *NamedColorIndex
+	query   vector
+	k       int
+	results []vpResult
+}
+
+func (s *vpSearch) search(node *vpNode) {
+	if node == nil {
+		return
+	}
+
+	v := s.idx.colors[node.color].v
+	d := s.idx.distance(s.query, v)
+	r := d
+	if s.idx.rank != nil {
+		r = s.idx.rank(s.query, v)
+	}
+	s.add(vpResult{node.color, r})
+
+	if d < node.radius {
+		s.search(node.inside)
+		if d+s.radius() >= node.radius {
+			s.search(node.outside)
+		}
+	} else {
+		s.search(node.outside)
+		if d-s.radius() <= node.radius {
+			s.search(node.inside)
+		}
+	}
+}
+
+// tau returns the distance of the farthest result, or +Inf while there are
+// less than k results.
+func (s *vpSearch) tau() float64 {
+	if len(s.results) < s.k {
+		return math.Inf(1)
+	}
+	return s.results[len(s.results)-1].distance
+}
+
+// radius returns the distance of the tree within which colors may be among
+// the results.
+func (s *vpSearch) radius() float64 {
+	tau := s.tau()
+	if s.idx.radius == nil || math.IsInf(tau, 1) {
+		return tau
+	}
+	return s.idx.radius(s.query, tau)
+}
+
+// add inserts a color in the sorted results, keeping the k nearest ones.
+func (s *vpSearch) add(r vpResult) {
+	less := func(i int) bool {
+		o := s.results[i]
+		if r.distance != o.distance {
+			return r.distance < o.distance
+		}
+		return s.idx.colors[r.color].Name < s.idx.colors[o.color].Name
+	}
+
+	i := sort.Search(len(s.results), less)
+	if i >= s.k {
+		return
+	}
+	if len(s.results) < s.k {
+		s.results = append(s.results, vpResult{})
+	}
+	copy(s.results[i+1:], s.results[i:])
+	s.results[i] = r
+}
+
+// deltaE2000Radius returns a CIE76 distance from a Lab color within which
+// are all the colors at most tau away from it in CIEDE2000, among the colors
+// whose chroma is at most maxChroma and whose lightness is at most maxDL away
+// from 50.
+//
+// CIEDE2000 scales the a* axis up, which does not reduce the differences, and
+// divides the lightness, chroma and hue differences by SL, SC and SH. These
+// grow with the mean lightness and chroma of the colors, the scaled chroma
+// being at most 6.4 more than the actual one, and SH growing slower than SC.
+// The rotation term reduces the difference by at most a factor of
+// sqrt(1 - sin(60°)) = 1 / (1 + √3).
+func deltaE2000Radius(query vector, tau, maxChroma, maxDL float64) float64 {
+	k := (1 + math.Sqrt(3)) * tau
+	c := math.Hypot(query.v1, query.v2)
+	dl := math.Max(math.Abs(query.v0-50), maxDL)
+	sl := 1 + 0.015*dl*dl/math.Sqrt(20+dl*dl)
+
+	// The mean chroma is bounded by the palette, and by the chroma of colors
+	// within the radius.
+	r := k * (1 + 0.045*((c+maxChroma)/2+6.4))
+	if b := 0.045 / 2 * k; b < 1 {
+		r = math.Min(r, k*(1+0.045*(c+6.4))/(1-b))
+	}
+
+	return math.Max(r, k*sl)
+}
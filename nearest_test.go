@@ -0,0 +1,132 @@
+// Copyright © 2019 Xavier Basty <xavier@hexbee.net>
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package gocolor_test
+
+import (
+	"math/rand"
+	"sort"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/Hexbee-net/gocolor"
+	"github.com/Hexbee-net/gocolor/named"
+)
+
+func TestDeltaE2000(t *testing.T) {
+	// Test data from Sharma, Wu and Dalal.
+	tests := []struct {
+		lab1, lab2 [3]float64
+		de         float64
+	}{
+		{[3]float64{50, 2.6772, -79.7751}, [3]float64{50, 0, -82.7485}, 2.0425},
+		{[3]float64{50, 3.1571, -77.2803}, [3]float64{50, 0, -82.7485}, 2.8615},
+		{[3]float64{50, 0, 0}, [3]float64{50, -1, 2}, 2.3669},
+		{[3]float64{50, -1, 2}, [3]float64{50, 0, 0}, 2.3669},
+		{[3]float64{50, 2.49, -0.001}, [3]float64{50, -2.49, 0.0009}, 7.1792},
+		{[3]float64{50, 2.49, -0.001}, [3]float64{50, -2.49, 0.0011}, 7.2195},
+		{[3]float64{50, 2.5, 0}, [3]float64{73, 25, -18}, 27.1492},
+		{[3]float64{50, 2.5, 0}, [3]float64{56, -27, -3}, 31.9030},
+		{[3]float64{60.2574, -34.0099, 36.2677}, [3]float64{60.4626, -34.1751, 39.4387}, 1.2644},
+		{[3]float64{22.7233, 20.0904, -46.6940}, [3]float64{23.0331, 14.9730, -42.5619}, 2.0373},
+		{[3]float64{90.9257, -0.5406, -0.9208}, [3]float64{88.6381, -0.8985, -0.7239}, 1.5381},
+		{[3]float64{2.0776, 0.0795, -1.1350}, [3]float64{0.9033, -0.0636, -0.5514}, 0.9082},
+	}
+
+	for _, test := range tests {
+		de := gocolor.DeltaE2000(test.lab1[0], test.lab1[1], test.lab1[2], test.lab2[0], test.lab2[1], test.lab2[2])
+		assert.InDelta(t, test.de, de, 1e-4, "%v %v", test.lab1, test.lab2)
+	}
+}
+
+func TestNearestNamedColors(t *testing.T) {
+	for _, distance := range []string{gocolor.DistanceCIE76, gocolor.DistanceCIEDE2000, gocolor.DistanceOklab} {
+		matches, err := gocolor.NearestNamedColors(gocolor.NRGBA{R: 1, G: 1, B: 1, A: 1}, nil, 1, distance)
+		require.NoError(t, err, distance)
+		require.Len(t, matches, 1)
+		c, ok := named.Wikipedia.Lookup(matches[0].Name)
+		require.True(t, ok, matches[0].Name)
+		assert.Equal(t, c.Hex, matches[0].Hex)
+		assert.Equal(t, "#FFFFFF", matches[0].Hex, distance)
+		assert.InDelta(t, 0, matches[0].Distance, 1e-6, distance)
+
+		matches, err = gocolor.NearestNamedColors(gocolor.Lab{L: 50, A: 60, B: 40}, named.XKCD, 5, distance)
+		require.NoError(t, err, distance)
+		require.Len(t, matches, 5)
+		for i := 1; i < len(matches); i++ {
+			assert.True(t, matches[i-1].Distance <= matches[i].Distance, distance)
+		}
+	}
+
+	// The red of the xkcd survey is #E50000.
+	matches, err := gocolor.NearestNamedColors(gocolor.NRGBA{R: 0xe5 / 255.0, A: 1}, named.XKCD, 1, gocolor.DistanceCIEDE2000)
+	require.NoError(t, err)
+	assert.Equal(t, "red", matches[0].Name)
+
+	_, err = gocolor.NearestNamedColors(gocolor.Lab{}, nil, 1, "unknown")
+	assert.Error(t, err)
+}
+
+func TestNamedColorIndex(t *testing.T) {
+	palette := named.NewPalette("test", []named.Color{
+		{Name: "black", Hex: "#000000"},
+		{Name: "white", Hex: "#FFFFFF"},
+		{Name: "rouge", Hex: "#FF0000"},
+		{Name: "red", Hex: "#FF0000"},
+		{Name: "gray", Hex: "#808080"},
+	})
+	idx, err := gocolor.NewNamedColorIndex(palette, gocolor.DistanceOklab)
+	require.NoError(t, err)
+	assert.Equal(t, 5, idx.Len())
+
+	// Colors at the same distance are sorted by name.
+	matches := idx.Nearest(gocolor.NRGBA{R: 0.9, A: 1}, 3)
+	require.Len(t, matches, 3)
+	assert.Equal(t, "red", matches[0].Name)
+	assert.Equal(t, "rouge", matches[1].Name)
+	assert.Equal(t, matches[0].Distance, matches[1].Distance)
+
+	assert.Len(t, idx.Nearest(gocolor.Lab{}, 10), 5)
+	assert.Empty(t, idx.Nearest(gocolor.Lab{}, 0))
+
+	_, err = gocolor.NewNamedColorIndex(named.NewPalette("bad", []named.Color{{Name: "bad", Hex: "#12"}}), gocolor.DistanceOklab)
+	assert.Error(t, err)
+	_, err = gocolor.NewNamedColorIndex(palette, "unknown")
+	assert.Error(t, err)
+}
+
+func TestNamedColorIndexExhaustive(t *testing.T) {
+	rnd := rand.New(rand.NewSource(1))
+
+	for _, distance := range []string{gocolor.DistanceCIE76, gocolor.DistanceCIEDE2000, gocolor.DistanceOklab} {
+		idx, err := gocolor.NewNamedColorIndex(named.Wikipedia, distance)
+		require.NoError(t, err)
+
+		for n := 0; n < 200; n++ {
+			c := gocolor.NRGBA{R: rnd.Float64(), G: rnd.Float64(), B: rnd.Float64(), A: 1}
+
+			all := idx.Nearest(c, idx.Len())
+			require.Len(t, all, idx.Len())
+			distances := make([]float64, len(all))
+			for i, m := range all {
+				distances[i] = m.Distance
+			}
+			assert.True(t, sort.Float64sAreSorted(distances))
+
+			assert.Equal(t, all[:4], idx.Nearest(c, 4), distance)
+		}
+	}
+}
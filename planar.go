@@ -0,0 +1,422 @@
+// Copyright © 2019 Xavier Basty <xavier@hexbee.net>
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package gocolor
+
+import (
+	"fmt"
+	"image"
+	"image/color"
+)
+
+// Color spaces of planar images, in addition to the RGB color spaces.
+// They are all relative to illuminant D65 and the 2° standard observer.
+const (
+	CIEXYZ = "CIE XYZ"    // XYZ coordinates, with a luminance of 1 for the white
+	CIELab = "CIE L*a*b*" // L*a*b* coordinates, with a lightness of 100 for the white
+	Oklab  = "Oklab"      // Oklab coordinates, with a lightness of 1 for the white
+)
+
+// Planar is implemented by the planar floating point images of the package.
+//
+// The channels of planar images are the coordinates of their color space,
+// and are followed by a straight (not premultiplied) alpha channel.
+// Samples are not limited to the [0, 1] range, so that colors out of the
+// gamut of an RGB color space are preserved.
+type Planar interface {
+	image.Image
+
+	// ColorSpace returns the color space of the samples.
+	ColorSpace() string
+
+	// Values returns the samples of the pixel at (x, y).
+	Values(x, y int) (c0, c1, c2, alpha float64)
+
+	// SetValues sets the samples of the pixel at (x, y).
+	SetValues(x, y int, c0, c1, c2, alpha float64)
+}
+
+////////////////////////////////////////
+
+// Planar64 is an in-memory planar image storing float64 samples.
+type Planar64 struct {
+	// Pix holds the planes of the image. The sample of channel c at (x, y)
+	// is at Pix[c][(y-Rect.Min.Y)*Stride + (x-Rect.Min.X)].
+	Pix [4][]float64
+	// Stride is the sample stride between vertically adjacent pixels.
+	Stride int
+	// Rect is the image's bounds.
+	Rect image.Rectangle
+	// Space is the color space of the samples.
+	Space string
+
+	conv *planarConverters
+}
+
+// NewPlanar64 returns a new, transparent Planar64 image with the given bounds and color space.
+func NewPlanar64(r image.Rectangle, space string) *Planar64 {
+	w, h := r.Dx(), r.Dy()
+	p := &Planar64{Stride: w, Rect: r, Space: space, conv: newPlanarConverters(space)}
+	for c := range p.Pix {
+		p.Pix[c] = make([]float64, w*h)
+	}
+	return p
+}
+
+// ColorModel returns the model of the colors returned by At.
+func (p *Planar64) ColorModel() color.Model {
+	return color.NRGBA64Model
+}
+
+// Bounds returns the domain for which At can return non-zero color.
+func (p *Planar64) Bounds() image.Rectangle {
+	return p.Rect
+}
+
+// At returns the color of the pixel at (x, y), converted to sRGB.
+// Use Values to access the samples without loss of precision.
+func (p *Planar64) At(x, y int) color.Color {
+	return planarAt(p, p.conv.forSpace(p.Space), x, y)
+}
+
+// Set sets the color of the pixel at (x, y), converted from sRGB
+// (or from the exact coordinates of the color types of the package).
+func (p *Planar64) Set(x, y int, c color.Color) {
+	planarSet(p, p.conv.forSpace(p.Space), x, y, c)
+}
+
+// ColorSpace returns the color space of the samples.
+func (p *Planar64) ColorSpace() string {
+	return p.Space
+}
+
+// PixOffset returns the index of the samples of the pixel at (x, y) in the planes.
+func (p *Planar64) PixOffset(x, y int) int {
+	return (y-p.Rect.Min.Y)*p.Stride + (x - p.Rect.Min.X)
+}
+
+// Values returns the samples of the pixel at (x, y).
+func (p *Planar64) Values(x, y int) (c0, c1, c2, alpha float64) {
+	if !(image.Point{X: x, Y: y}.In(p.Rect)) {
+		return 0, 0, 0, 0
+	}
+	i := p.PixOffset(x, y)
+	return p.Pix[0][i], p.Pix[1][i], p.Pix[2][i], p.Pix[3][i]
+}
+
+// SetValues sets the samples of the pixel at (x, y).
+func (p *Planar64) SetValues(x, y int, c0, c1, c2, alpha float64) {
+	if !(image.Point{X: x, Y: y}.In(p.Rect)) {
+		return
+	}
+	i := p.PixOffset(x, y)
+	p.Pix[0][i], p.Pix[1][i], p.Pix[2][i], p.Pix[3][i] = c0, c1, c2, alpha
+}
+
+// SubImage returns an image representing the portion of the image p visible
+// through r. The returned value shares pixels with the original image.
+func (p *Planar64) SubImage(r image.Rectangle) image.Image {
+	r = r.Intersect(p.Rect)
+	// If r1 and r2 are Rectangles, r1.Intersect(r2) is not guaranteed to be inside
+	// either r1 or r2 if the intersection is empty. Without explicitly checking for
+	// this, the Pix[i:] expression below can panic.
+	if r.Empty() {
+		return &Planar64{Space: p.Space, conv: p.conv}
+	}
+	i := p.PixOffset(r.Min.X, r.Min.Y)
+	sub := &Planar64{Stride: p.Stride, Rect: r, Space: p.Space, conv: p.conv}
+	for c := range p.Pix {
+		sub.Pix[c] = p.Pix[c][i:]
+	}
+	return sub
+}
+
+// Convert converts the samples of the image to another color space, in place.
+// The source space of the conversion is the one of the image.
+//
+// The pixels are shared with the images created by SubImage, but their color
+// space is not updated.
+func (p *Planar64) Convert(conv ImageConversion) error {
+	if err := convertPlanar(p, conv); err != nil {
+		return err
+	}
+	p.Space = conv.Destination
+	p.conv = newPlanarConverters(p.Space)
+	return nil
+}
+
+////////////////////////////////////////
+
+// Planar32 is an in-memory planar image storing float32 samples.
+type Planar32 struct {
+	// Pix holds the planes of the image. The sample of channel c at (x, y)
+	// is at Pix[c][(y-Rect.Min.Y)*Stride + (x-Rect.Min.X)].
+	Pix [4][]float32
+	// Stride is the sample stride between vertically adjacent pixels.
+	Stride int
+	// Rect is the image's bounds.
+	Rect image.Rectangle
+	// Space is the color space of the samples.
+	Space string
+
+	conv *planarConverters
+}
+
+// NewPlanar32 returns a new, transparent Planar32 image with the given bounds and color space.
+func NewPlanar32(r image.Rectangle, space string) *Planar32 {
+	w, h := r.Dx(), r.Dy()
+	p := &Planar32{Stride: w, Rect: r, Space: space, conv: newPlanarConverters(space)}
+	for c := range p.Pix {
+		p.Pix[c] = make([]float32, w*h)
+	}
+	return p
+}
+
+// ColorModel returns the model of the colors returned by At.
+func (p *Planar32) ColorModel() color.Model {
+	return color.NRGBA64Model
+}
+
+// Bounds returns the domain for which At can return non-zero color.
+func (p *Planar32) Bounds() image.Rectangle {
+	return p.Rect
+}
+
+// At returns the color of the pixel at (x, y), converted to sRGB.
+// Use Values to access the samples without loss of precision.
+func (p *Planar32) At(x, y int) color.Color {
+	return planarAt(p, p.conv.forSpace(p.Space), x, y)
+}
+
+// Set sets the color of the pixel at (x, y), converted from sRGB
+// (or from the exact coordinates of the color types of the package).
+func (p *Planar32) Set(x, y int, c color.Color) {
+	planarSet(p, p.conv.forSpace(p.Space), x, y, c)
+}
+
+// ColorSpace returns the color space of the samples.
+func (p *Planar32) ColorSpace() string {
+	return p.Space
+}
+
+// PixOffset returns the index of the samples of the pixel at (x, y) in the planes.
+func (p *Planar32) PixOffset(x, y int) int {
+	return (y-p.Rect.Min.Y)*p.Stride + (x - p.Rect.Min.X)
+}
+
+// Values returns the samples of the pixel at (x, y).
+func (p *Planar32) Values(x, y int) (c0, c1, c2, alpha float64) {
+	if !(image.Point{X: x, Y: y}.In(p.Rect)) {
+		return 0, 0, 0, 0
+	}
+	i := p.PixOffset(x, y)
+	return float64(p.Pix[0][i]), float64(p.Pix[1][i]), float64(p.Pix[2][i]), float64(p.Pix[3][i])
+}
+
+// SetValues sets the samples of the pixel at (x, y).
+func (p *Planar32) SetValues(x, y int, c0, c1, c2, alpha float64) {
+	if !(image.Point{X: x, Y: y}.In(p.Rect)) {
+		return
+	}
+	i := p.PixOffset(x, y)
+	p.Pix[0][i], p.Pix[1][i], p.Pix[2][i], p.Pix[3][i] = float32(c0), float32(c1), float32(c2), float32(alpha)
+}
+
+// SubImage returns an image representing the portion of the image p visible
+// through r. The returned value shares pixels with the original image.
+func (p *Planar32) SubImage(r image.Rectangle) image.Image {
+	r = r.Intersect(p.Rect)
+	if r.Empty() {
+		return &Planar32{Space: p.Space, conv: p.conv}
+	}
+	i := p.PixOffset(r.Min.X, r.Min.Y)
+	sub := &Planar32{Stride: p.Stride, Rect: r, Space: p.Space, conv: p.conv}
+	for c := range p.Pix {
+		sub.Pix[c] = p.Pix[c][i:]
+	}
+	return sub
+}
+
+// Convert converts the samples of the image to another color space, in place.
+// The source space of the conversion is the one of the image.
+//
+// The pixels are shared with the images created by SubImage, but their color
+// space is not updated.
+func (p *Planar32) Convert(conv ImageConversion) error {
+	if err := convertPlanar(p, conv); err != nil {
+		return err
+	}
+	p.Space = conv.Destination
+	p.conv = newPlanarConverters(p.Space)
+	return nil
+}
+
+////////////////////////////////////////
+
+// imageSpace describes how to convert the samples of a color space to and
+// from linear coordinates, which are linear RGB for RGB color spaces and XYZ
+// for the other ones.
+type imageSpace struct {
+	toXYZ   matrix                // Linear coordinates to XYZ
+	fromXYZ matrix                // XYZ to linear coordinates
+	decode  func(v vector) vector // Samples to linear coordinates
+	encode  func(v vector) vector // Linear coordinates to samples
+	white   vector                // White point, for the 2° observer
+	rgb     bool                  // Whether the samples are bounded by the RGB cube
+}
+
+var identityMatrix = matrix{
+	1, 0, 0,
+	0, 1, 0,
+	0, 0, 1,
+}
+
+// getImageSpace resolves a color space, with CIEXYZ and CIELab coordinates
+// relative to the given white point.
+func getImageSpace(space string, white vector) (imageSpace, error) {
+	d65 := observerWhitePoints[Observer2][RefIlluminantD65]
+	noop := func(v vector) vector { return v }
+
+	switch space {
+	case CIEXYZ:
+		return imageSpace{identityMatrix, identityMatrix, noop, noop, white, false}, nil
+
+	case CIELab:
+		return imageSpace{
+			identityMatrix, identityMatrix,
+			func(v vector) vector { return labToXYZ(v, white) },
+			func(v vector) vector { return xyzToLab(v, white) },
+			white, false,
+		}, nil
+
+	case Oklab:
+		return imageSpace{identityMatrix, identityMatrix, oklabToXYZ, xyzToOklab, d65, false}, nil
+	}
+
+	s, err := getRGBSpace(space)
+	if err != nil {
+		return imageSpace{}, err
+	}
+
+	return imageSpace{
+		s.toXYZ, s.fromXYZ,
+		s.decode, s.encode,
+		s.white, true,
+	}, nil
+}
+
+func isRGBSpace(space string) bool {
+	switch space {
+	case CIEXYZ, CIELab, Oklab:
+		return false
+	}
+	return true
+}
+
+// planarConverters holds the converters used by the At and Set methods of
+// planar images, for a color space. They are created with the image, so that
+// At and Set do not resolve the color space for each pixel.
+type planarConverters struct {
+	space    string
+	toSRGB   *pixelConverter
+	fromSRGB *pixelConverter
+	fromXYZ  *pixelConverter
+}
+
+// newPlanarConverters creates the converters of a color space. The converters
+// are nil if the space is not recognized.
+func newPlanarConverters(space string) *planarConverters {
+	pc := &planarConverters{space: space}
+	pc.toSRGB, _ = newPixelConverter(space, SRGB, "", GamutClip)
+	pc.fromSRGB, _ = newPixelConverter(SRGB, space, "", GamutNone)
+	pc.fromXYZ, _ = newPixelConverter(CIEXYZ, space, "", GamutNone)
+	return pc
+}
+
+// forSpace returns the converters if they match a color space, or new ones
+// otherwise, such as for images created without a constructor or whose
+// Space field was changed.
+func (pc *planarConverters) forSpace(space string) *planarConverters {
+	if pc != nil && pc.space == space {
+		return pc
+	}
+	return newPlanarConverters(space)
+}
+
+func planarAt(p Planar, pc *planarConverters, x, y int) color.Color {
+	if !(image.Point{X: x, Y: y}.In(p.Bounds())) || pc.toSRGB == nil {
+		return color.NRGBA64{}
+	}
+
+	c0, c1, c2, alpha := p.Values(x, y)
+	v := pc.toSRGB.convert(vector{c0, c1, c2})
+
+	return color.NRGBA64{
+		R: uint16(quantize(v.v0, 0.5)),
+		G: uint16(quantize(v.v1, 0.5)),
+		B: uint16(quantize(v.v2, 0.5)),
+		A: uint16(quantize(alpha, 0.5)),
+	}
+}
+
+func planarSet(p Planar, pc *planarConverters, x, y int, c color.Color) {
+	if !(image.Point{X: x, Y: y}.In(p.Bounds())) {
+		return
+	}
+
+	var (
+		conv  *pixelConverter
+		v     vector
+		alpha = 1.0
+	)
+	if xc, ok := c.(xyzColor); ok {
+		conv = pc.fromXYZ
+		v = xc.xyz()
+	} else {
+		conv = pc.fromSRGB
+		v = colorToRGB(c)
+		_, _, _, a := c.RGBA()
+		alpha = float64(a) / 0xffff
+	}
+	if conv == nil {
+		return
+	}
+
+	v = conv.convert(v)
+	p.SetValues(x, y, v.v0, v.v1, v.v2, alpha)
+}
+
+func convertPlanar(p Planar, conv ImageConversion) error {
+	if conv.Source != "" && conv.Source != p.ColorSpace() {
+		return fmt.Errorf("mismatching source color space: %v (image is %v)", conv.Source, p.ColorSpace())
+	}
+
+	pc, err := conv.pixelConverter(p.ColorSpace())
+	if err != nil {
+		return err
+	}
+
+	r := p.Bounds()
+	parallelRows(r, conv.Workers, func(y0, y1 int) {
+		for y := y0; y < y1; y++ {
+			for x := r.Min.X; x < r.Max.X; x++ {
+				c0, c1, c2, alpha := p.Values(x, y)
+				v := pc.convert(vector{c0, c1, c2})
+				p.SetValues(x, y, v.v0, v.v1, v.v2, alpha)
+			}
+		}
+	})
+
+	return nil
+}
@@ -0,0 +1,176 @@
+// Copyright © 2019 Xavier Basty <xavier@hexbee.net>
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package gocolor_test
+
+import (
+	"image"
+	"image/color"
+	"image/draw"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/Hexbee-net/gocolor"
+)
+
+var (
+	_ draw.Image     = &gocolor.Planar64{}
+	_ draw.Image     = &gocolor.Planar32{}
+	_ gocolor.Planar = &gocolor.Planar64{}
+	_ gocolor.Planar = &gocolor.Planar32{}
+)
+
+func TestPlanarOklab(t *testing.T) {
+	p := gocolor.NewPlanar64(image.Rect(0, 0, 2, 1), gocolor.SRGB)
+	p.SetValues(0, 0, 1, 1, 1, 1)
+	p.SetValues(1, 0, 1, 0, 0, 1)
+
+	assert.NoError(t, p.Convert(gocolor.ImageConversion{Destination: gocolor.Oklab}))
+	assert.Equal(t, gocolor.Oklab, p.Space)
+
+	l, a, b, _ := p.Values(0, 0)
+	assert.InDelta(t, 1, l, 1e-4)
+	assert.InDelta(t, 0, a, 1e-4)
+	assert.InDelta(t, 0, b, 1e-4)
+
+	l, a, b, _ = p.Values(1, 0)
+	assert.InDelta(t, 0.6280, l, 1e-3)
+	assert.InDelta(t, 0.2249, a, 1e-3)
+	assert.InDelta(t, 0.1258, b, 1e-3)
+}
+
+func TestPlanarConvert(t *testing.T) {
+	src := gradientImage(16, 8)
+
+	p, err := gocolor.ConvertImagePlanar32(src, gocolor.ImageConversion{Source: gocolor.SRGB, Destination: gocolor.SRGB})
+	assert.NoError(t, err)
+
+	ref, err := gocolor.ConvertImagePlanar(src, gocolor.ImageConversion{Source: gocolor.SRGB, Destination: gocolor.SRGB})
+	assert.NoError(t, err)
+
+	// Chain of in place conversions, without clipping.
+	for _, space := range []string{gocolor.Oklab, gocolor.CIEXYZ, gocolor.CIELab, gocolor.WideGamutRGB, gocolor.SRGB} {
+		err = p.Convert(gocolor.ImageConversion{Destination: space, GamutMapping: gocolor.GamutNone})
+		assert.NoError(t, err)
+		assert.Equal(t, space, p.ColorSpace())
+	}
+
+	r := src.Bounds()
+	for y := r.Min.Y; y < r.Max.Y; y++ {
+		for x := r.Min.X; x < r.Max.X; x++ {
+			r0, g0, b0, a0 := ref.Values(x, y)
+			r1, g1, b1, a1 := p.Values(x, y)
+			assert.InDeltaf(t, r0, r1, 1e-3, "red is wrong at (%v, %v)", x, y)
+			assert.InDeltaf(t, g0, g1, 1e-3, "green is wrong at (%v, %v)", x, y)
+			assert.InDeltaf(t, b0, b1, 1e-3, "blue is wrong at (%v, %v)", x, y)
+			assert.InDeltaf(t, a0, a1, 1e-6, "alpha is wrong at (%v, %v)", x, y)
+		}
+	}
+
+	err = p.Convert(gocolor.ImageConversion{Destination: "unknown"})
+	assert.Error(t, err)
+	err = p.Convert(gocolor.ImageConversion{Source: gocolor.CIELab, Destination: gocolor.SRGB})
+	assert.Error(t, err)
+	assert.Equal(t, gocolor.SRGB, p.ColorSpace())
+}
+
+func TestPlanarSubImage(t *testing.T) {
+	p := gocolor.NewPlanar64(image.Rect(0, 0, 4, 4), gocolor.SRGB)
+	draw.Draw(p, p.Bounds(), image.NewUniform(color.White), image.Point{}, draw.Src)
+
+	sub := p.SubImage(image.Rect(1, 1, 3, 3)).(*gocolor.Planar64)
+	assert.Equal(t, image.Rect(1, 1, 3, 3), sub.Bounds())
+
+	// Sub-images share their pixels.
+	sub.SetValues(2, 2, 0.5, 0.25, 0, 1)
+	r, g, b, a := p.Values(2, 2)
+	assert.Equal(t, [4]float64{0.5, 0.25, 0, 1}, [4]float64{r, g, b, a})
+
+	assert.NoError(t, sub.Convert(gocolor.ImageConversion{Destination: gocolor.CIELab}))
+	l, _, _, _ := p.Values(1, 1)
+	assert.InDelta(t, 100, l, 1e-2)
+	r, _, _, _ = p.Values(0, 0)
+	assert.InDelta(t, 1, r, 1e-12)
+
+	empty := p.SubImage(image.Rect(10, 10, 12, 12))
+	assert.True(t, empty.Bounds().Empty())
+
+	p32 := gocolor.NewPlanar32(image.Rect(0, 0, 4, 4), gocolor.SRGB)
+	sub32 := p32.SubImage(image.Rect(2, 0, 4, 2)).(*gocolor.Planar32)
+	sub32.SetValues(3, 1, 1, 1, 1, 1)
+	r, _, _, _ = p32.Values(3, 1)
+	assert.Equal(t, 1.0, r)
+	assert.True(t, p32.SubImage(image.Rect(5, 5, 6, 6)).Bounds().Empty())
+}
+
+func TestPlanarImage(t *testing.T) {
+	// Round trip from and to image.Image.
+	src := gradientImage(8, 8)
+	for _, space := range []string{gocolor.CIELab, gocolor.Oklab, gocolor.AdobeRGB} {
+		p := gocolor.NewPlanar32(src.Bounds(), space)
+		draw.Draw(p, p.Bounds(), src, src.Bounds().Min, draw.Src)
+
+		dst := image.NewNRGBA64(src.Bounds())
+		draw.Draw(dst, dst.Bounds(), p, p.Bounds().Min, draw.Src)
+
+		r := src.Bounds()
+		for y := r.Min.Y; y < r.Max.Y; y++ {
+			for x := r.Min.X; x < r.Max.X; x++ {
+				a, b := src.NRGBA64At(x, y), dst.NRGBA64At(x, y)
+				assert.InDeltaf(t, a.R, b.R, 16, "red is wrong at (%v, %v) for %v", x, y, space)
+				assert.InDeltaf(t, a.G, b.G, 16, "green is wrong at (%v, %v) for %v", x, y, space)
+				assert.InDeltaf(t, a.B, b.B, 16, "blue is wrong at (%v, %v) for %v", x, y, space)
+				assert.InDeltaf(t, a.A, b.A, 1, "alpha is wrong at (%v, %v) for %v", x, y, space)
+			}
+		}
+	}
+
+	// Colors of the package are set from their exact coordinates.
+	p := gocolor.NewPlanar64(image.Rect(0, 0, 1, 1), gocolor.CIELab)
+	p.Set(0, 0, gocolor.Lab{L: 50, A: 100, B: -20})
+	l, a, b, alpha := p.Values(0, 0)
+	assert.InDelta(t, 50, l, 1e-9)
+	assert.InDelta(t, 100, a, 1e-9)
+	assert.InDelta(t, -20, b, 1e-9)
+	assert.Equal(t, 1.0, alpha)
+
+	assert.Equal(t, color.NRGBA64{}, p.At(5, 5))
+}
+
+func TestPlanarSpaceChange(t *testing.T) {
+	// Images created without a constructor, or whose space is changed
+	// directly, use the converters of their current space.
+	p := &gocolor.Planar64{Stride: 1, Rect: image.Rect(0, 0, 1, 1), Space: gocolor.SRGB}
+	for c := range p.Pix {
+		p.Pix[c] = make([]float64, 1)
+	}
+	p.SetValues(0, 0, 1, 1, 1, 1)
+	assert.Equal(t, color.NRGBA64{R: 0xffff, G: 0xffff, B: 0xffff, A: 0xffff}, p.At(0, 0))
+
+	p.Space = gocolor.CIELab
+	p.SetValues(0, 0, 0, 0, 0, 1)
+	assert.Equal(t, color.NRGBA64{A: 0xffff}, p.At(0, 0))
+
+	p.Space = "unknown"
+	assert.Equal(t, color.NRGBA64{}, p.At(0, 0))
+}
+
+func BenchmarkPlanarAt(b *testing.B) {
+	p := gocolor.NewPlanar32(image.Rect(0, 0, 64, 64), gocolor.CIELab)
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		_ = p.At(i%64, (i/64)%64)
+	}
+}
@@ -14,6 +14,13 @@
 
 package gocolor
 
+import (
+	"fmt"
+	"math"
+
+	"github.com/Hexbee-net/gocolor/internal/colormath"
+)
+
 const (
 	AdobeRGB      = "Adobe RGB"
 	AppleRGB      = "AppleRGB"
@@ -24,6 +31,7 @@ const (
 	BT202012b     = "ITU-R BT.2020 12 bits"
 	CieRGB        = "CIE RGB"
 	ColorMatchRGB = "ColorMatch RGB"
+	DisplayP3     = "Display P3"
 	DonRGB4       = "Don RGB 4"
 	EciRGB        = "ECI RGB"
 	EktaSpacePS5  = "Ekta Space PS5"
@@ -43,6 +51,7 @@ var RGBGamma = map[string]float64{
 	BruceRGB:      2.2,
 	CieRGB:        2.2,
 	ColorMatchRGB: 1.8,
+	DisplayP3:     2.2,
 	DonRGB4:       2.2,
 	EktaSpacePS5:  2.2,
 	NtscRGB:       2.2,
@@ -60,8 +69,10 @@ var RGBIlluminants = map[string]string{
 	BetaRGB:       RefIlluminantD50,
 	BruceRGB:      RefIlluminantD65,
 	BT2020:        RefIlluminantD65,
+	BT202012b:     RefIlluminantD65,
 	CieRGB:        RefIlluminantE,
 	ColorMatchRGB: RefIlluminantD50,
+	DisplayP3:     RefIlluminantD65,
 	DonRGB4:       RefIlluminantD50,
 	EktaSpacePS5:  RefIlluminantD50,
 	NtscRGB:       RefIlluminantD50,
@@ -71,3 +82,168 @@ var RGBIlluminants = map[string]string{
 	SRGB:          RefIlluminantD65,
 	WideGamutRGB:  RefIlluminantD50,
 }
+
+////////////////////////////////////////
+
+// rgbLinearization returns the transfer function converting the companded
+// values of an RGB color space to linear ones. Pure gamma curves are extended
+// to negative values by symmetry, so that out of gamut colors survive.
+func rgbLinearization(space string) (func(v float64) float64, bool) {
+	switch space {
+	case SRGB, DisplayP3:
+		return colormath.SRGBToLinear, true
+
+	case BT2020:
+		return func(v float64) float64 {
+			if v <= 0.08124794403514049 {
+				return v / 4.5
+			}
+			return math.Pow((v+0.099)/1.099, 1/0.45)
+		}, true
+
+	case BT202012b:
+		return func(v float64) float64 {
+			if v <= 0.081697877417347 {
+				return v / 4.5
+			}
+			return math.Pow((v+0.0993)/1.0993, 1/0.45)
+		}, true
+	}
+
+	gamma, ok := RGBGamma[space]
+	if !ok {
+		return nil, false
+	}
+	return func(v float64) float64 {
+		return math.Copysign(math.Pow(math.Abs(v), gamma), v)
+	}, true
+}
+
+// rgbCompanding returns the transfer function converting linear values to the
+// companded values of an RGB color space.
+func rgbCompanding(space string) (func(v float64) float64, bool) {
+	switch space {
+	case SRGB, DisplayP3:
+		return func(v float64) float64 {
+			if v <= 0.0031308 {
+				return v * 12.92
+			}
+			return 1.055*math.Pow(v, 1/2.4) - 0.055
+		}, true
+
+	case BT2020:
+		return func(v float64) float64 {
+			if v < 0.018 {
+				return v * 4.5
+			}
+			return 1.099*math.Pow(v, 0.45) - 0.099
+		}, true
+
+	case BT202012b:
+		return func(v float64) float64 {
+			if v < 0.0181 {
+				return v * 4.5
+			}
+			return 1.0993*math.Pow(v, 0.45) - 0.0993
+		}, true
+	}
+
+	gamma, ok := RGBGamma[space]
+	if !ok {
+		return nil, false
+	}
+	return func(v float64) float64 {
+		return math.Copysign(math.Pow(math.Abs(v), 1/gamma), v)
+	}, true
+}
+
+// rgbToXYZ converts companded RGB coordinates to XYZ, without range checks.
+func rgbToXYZ(rgb vector, space string) (vector, error) {
+	m, ok := conversionRgbXyz[space]
+	if !ok {
+		if c, ok := lookupCustomRGBSpace(space); ok {
+			return c.toXYZ.vdot(c.decode(rgb)), nil
+		}
+		return vector{}, fmt.Errorf("could not find conversion matrix for RGB color space: %v", space)
+	}
+
+	linearize, ok := rgbLinearization(space)
+	if !ok {
+		return vector{}, fmt.Errorf("could not find gamma for RGB color space: %v", space)
+	}
+
+	return m.vdot(rgb.mapfunc(linearize)), nil
+}
+
+// xyzToRGB converts XYZ coordinates to companded RGB, without range checks.
+func xyzToRGB(xyz vector, space string) (vector, error) {
+	m, ok := conversionXyzRgb[space]
+	if !ok {
+		if c, ok := lookupCustomRGBSpace(space); ok {
+			return c.encode(c.fromXYZ.vdot(xyz)), nil
+		}
+		return vector{}, fmt.Errorf("unrecognized RGB color space: %v", space)
+	}
+
+	compand, ok := rgbCompanding(space)
+	if !ok {
+		return vector{}, fmt.Errorf("unrecognized RGB color space: %v", space)
+	}
+
+	return m.vdot(xyz).mapfunc(compand), nil
+}
+
+// rgbSpace holds everything needed to convert colors of an RGB color space,
+// resolved once so that it can be applied to many pixels.
+type rgbSpace struct {
+	toXYZ     matrix                     // Linear RGB to XYZ, relative to the white point of the space
+	fromXYZ   matrix                     // XYZ to linear RGB
+	linearize [3]func(v float64) float64 // Companded to linear values, for each channel
+	compand   [3]func(v float64) float64 // Linear to companded values, for each channel
+	white     vector                     // White point of the space, for the 2° observer
+}
+
+func getRGBSpace(space string) (rgbSpace, error) {
+	var s rgbSpace
+	var ok bool
+
+	// Built-in spaces are looked up first, as they cannot be registered,
+	// to avoid locking the registry.
+	if s.toXYZ, ok = conversionRgbXyz[space]; !ok {
+		if c, ok := lookupCustomRGBSpace(space); ok {
+			return c, nil
+		}
+		return s, fmt.Errorf("unrecognized RGB color space: %v", space)
+	}
+	if s.fromXYZ, ok = conversionXyzRgb[space]; !ok {
+		return s, fmt.Errorf("unrecognized RGB color space: %v", space)
+	}
+
+	linearize, ok := rgbLinearization(space)
+	if !ok {
+		return s, fmt.Errorf("could not find gamma for RGB color space: %v", space)
+	}
+	compand, ok := rgbCompanding(space)
+	if !ok {
+		return s, fmt.Errorf("could not find gamma for RGB color space: %v", space)
+	}
+	for c := 0; c < 3; c++ {
+		s.linearize[c], s.compand[c] = linearize, compand
+	}
+
+	if s.white, ok = observerWhitePoints[Observer2][RGBIlluminants[space]]; !ok {
+		return s, fmt.Errorf("could not find white point for RGB color space: %v", space)
+	}
+
+	return s, nil
+}
+
+// decode converts companded RGB coordinates to linear ones.
+func (s rgbSpace) decode(v vector) vector {
+	return vector{s.linearize[0](v.v0), s.linearize[1](v.v1), s.linearize[2](v.v2)}
+}
+
+// encode converts linear RGB coordinates to companded ones.
+func (s rgbSpace) encode(v vector) vector {
+	return vector{s.compand[0](v.v0), s.compand[1](v.v1), s.compand[2](v.v2)}
+}
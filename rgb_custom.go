@@ -0,0 +1,273 @@
+// Copyright © 2019 Xavier Basty <xavier@hexbee.net>
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package gocolor
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"sort"
+	"sync"
+
+	"github.com/Hexbee-net/gocolor/internal/interp"
+)
+
+// TransferCurve is the transfer function of an RGB channel, converting
+// companded values to linear ones.
+//
+// When Table is empty, the curve is the ICC parametric function
+//
+//	Y = (A·X + B)^Gamma + E   for X >= D
+//	Y = C·X + F               for X < D
+//
+// which covers pure gamma curves as well as the sRGB and BT.2020 ones.
+// Otherwise, Table holds the linear values of evenly spaced companded values
+// over the [0, 1] range, and is interpolated linearly.
+type TransferCurve struct {
+	Gamma, A, B, C, D, E, F float64
+	Table                   []float64
+}
+
+// GammaCurve returns the transfer curve of a pure power function.
+func GammaCurve(gamma float64) TransferCurve {
+	return TransferCurve{Gamma: gamma, A: 1}
+}
+
+// ToLinear converts a companded value to a linear one.
+// Parametric curves are extended to negative values by symmetry.
+func (t TransferCurve) ToLinear(v float64) float64 {
+	if len(t.Table) > 0 {
+		return t.tableToLinear(v)
+	}
+
+	x := math.Abs(v)
+	var y float64
+	if x >= t.D {
+		y = math.Pow(math.Max(t.A*x+t.B, 0), t.Gamma) + t.E
+	} else {
+		y = t.C*x + t.F
+	}
+
+	return math.Copysign(y, v)
+}
+
+// FromLinear converts a linear value to a companded one.
+// It is the inverse of ToLinear.
+func (t TransferCurve) FromLinear(v float64) float64 {
+	if len(t.Table) > 0 {
+		return t.tableFromLinear(v)
+	}
+
+	y := math.Abs(v)
+	var x float64
+	if y >= math.Pow(math.Max(t.A*t.D+t.B, 0), t.Gamma)+t.E || t.C == 0 {
+		if t.A != 0 && t.Gamma != 0 {
+			x = (math.Pow(math.Max(y-t.E, 0), 1/t.Gamma) - t.B) / t.A
+		}
+	} else {
+		x = (y - t.F) / t.C
+	}
+
+	return math.Copysign(x, v)
+}
+
+func (t TransferCurve) tableToLinear(v float64) float64 {
+	n := len(t.Table)
+	if n == 1 {
+		return t.Table[0]
+	}
+
+	p := interp.Clamp01(v) * float64(n-1)
+	i := int(p)
+	if i >= n-1 {
+		return t.Table[n-1]
+	}
+	f := p - float64(i)
+
+	return t.Table[i] + f*(t.Table[i+1]-t.Table[i])
+}
+
+func (t TransferCurve) tableFromLinear(v float64) float64 {
+	n := len(t.Table)
+	if n == 1 {
+		return 0
+	}
+
+	// The table is expected to be non-decreasing.
+	if v <= t.Table[0] {
+		return 0
+	}
+	if v >= t.Table[n-1] {
+		return 1
+	}
+
+	i := sort.SearchFloat64s(t.Table, v)
+	if t.Table[i] == v {
+		return float64(i) / float64(n-1)
+	}
+	lo, hi := t.Table[i-1], t.Table[i]
+
+	return (float64(i-1) + (v-lo)/(hi-lo)) / float64(n-1)
+}
+
+////////////////////////////////////////
+
+// RGBSpaceDefinition describes an RGB color space by its primaries, white point
+// and transfer curves.
+type RGBSpaceDefinition struct {
+	// ToXYZ converts linear RGB coordinates to XYZ, the columns being the
+	// coordinates of the red, green and blue primaries.
+	ToXYZ [3][3]float64
+	// White is the XYZ white point of the space, normalized to Y = 1.
+	White [3]float64
+	// Transfer holds the transfer curve of the red, green and blue channels.
+	Transfer [3]TransferCurve
+}
+
+var customRGBSpaces = struct {
+	sync.RWMutex
+	spaces map[string]rgbSpace
+	defs   map[string]RGBSpaceDefinition
+}{
+	spaces: map[string]rgbSpace{},
+	defs:   map[string]RGBSpaceDefinition{},
+}
+
+// RegisterRGBSpace makes a custom RGB color space available under the given name
+// to all the functions taking an RGB color space, such as RGBtoXYZ and XYZtoRGB.
+//
+// Names of the built-in spaces, including CIEXYZ, CIELab and Oklab, and of
+// already registered spaces are rejected, registered spaces can be removed
+// with UnregisterRGBSpace.
+func RegisterRGBSpace(name string, def RGBSpaceDefinition) error {
+	if name == "" {
+		return errors.New("RGB color space name is empty")
+	}
+	if _, ok := conversionRgbXyz[name]; ok {
+		return fmt.Errorf("RGB color space is already defined: %v", name)
+	}
+	if !isRGBSpace(name) {
+		return fmt.Errorf("color space is already defined: %v", name)
+	}
+
+	m := matrix{
+		def.ToXYZ[0][0], def.ToXYZ[0][1], def.ToXYZ[0][2],
+		def.ToXYZ[1][0], def.ToXYZ[1][1], def.ToXYZ[1][2],
+		def.ToXYZ[2][0], def.ToXYZ[2][1], def.ToXYZ[2][2],
+	}
+	inv, err := m.inverse()
+	if err != nil {
+		return fmt.Errorf("invalid primaries for RGB color space %v: %v", name, err)
+	}
+	if def.White[1] <= 0 {
+		return fmt.Errorf("invalid white point for RGB color space %v: %v", name, def.White)
+	}
+
+	s := rgbSpace{
+		toXYZ:   m,
+		fromXYZ: inv,
+		white:   vector{def.White[0], def.White[1], def.White[2]},
+	}
+	for c, t := range def.Transfer {
+		s.linearize[c], s.compand[c] = t.ToLinear, t.FromLinear
+	}
+
+	customRGBSpaces.Lock()
+	defer customRGBSpaces.Unlock()
+
+	if _, ok := customRGBSpaces.spaces[name]; ok {
+		return fmt.Errorf("RGB color space is already defined: %v", name)
+	}
+	customRGBSpaces.spaces[name] = s
+	customRGBSpaces.defs[name] = def
+
+	return nil
+}
+
+// UnregisterRGBSpace removes a custom RGB color space registered with
+// RegisterRGBSpace, so that its name can be registered again.
+func UnregisterRGBSpace(name string) error {
+	customRGBSpaces.Lock()
+	defer customRGBSpaces.Unlock()
+
+	if _, ok := customRGBSpaces.spaces[name]; !ok {
+		return fmt.Errorf("RGB color space is not registered: %v", name)
+	}
+	delete(customRGBSpaces.spaces, name)
+	delete(customRGBSpaces.defs, name)
+	dropTransferLUTs(name)
+
+	return nil
+}
+
+// LookupRGBSpace returns the definition of a built-in or registered RGB color space.
+func LookupRGBSpace(name string) (RGBSpaceDefinition, error) {
+	customRGBSpaces.RLock()
+	def, ok := customRGBSpaces.defs[name]
+	customRGBSpaces.RUnlock()
+	if ok {
+		return def, nil
+	}
+
+	m, ok := conversionRgbXyz[name]
+	if !ok {
+		return def, fmt.Errorf("unrecognized RGB color space: %v", name)
+	}
+	wp, err := getWhitePoint(Observer2, RGBIlluminants[name])
+	if err != nil {
+		return def, fmt.Errorf("could not find white point for RGB color space: %v", name)
+	}
+	t, ok := builtinTransferCurve(name)
+	if !ok {
+		return def, fmt.Errorf("could not find gamma for RGB color space: %v", name)
+	}
+
+	def.ToXYZ = [3][3]float64{
+		{m.m00, m.m01, m.m02},
+		{m.m10, m.m11, m.m12},
+		{m.m20, m.m21, m.m22},
+	}
+	def.White = [3]float64{wp.v0, wp.v1, wp.v2}
+	def.Transfer = [3]TransferCurve{t, t, t}
+
+	return def, nil
+}
+
+// builtinTransferCurve returns the parametric form of the transfer function of
+// a built-in RGB color space.
+func builtinTransferCurve(space string) (TransferCurve, bool) {
+	switch space {
+	case SRGB, DisplayP3:
+		return TransferCurve{Gamma: 2.4, A: 1 / 1.055, B: 0.055 / 1.055, C: 1 / 12.92, D: 0.04045}, true
+	case BT2020:
+		return TransferCurve{Gamma: 1 / 0.45, A: 1 / 1.099, B: 0.099 / 1.099, C: 1 / 4.5, D: 0.08124794403514049}, true
+	case BT202012b:
+		return TransferCurve{Gamma: 1 / 0.45, A: 1 / 1.0993, B: 0.0993 / 1.0993, C: 1 / 4.5, D: 0.081697877417347}, true
+	}
+
+	gamma, ok := RGBGamma[space]
+	if !ok {
+		return TransferCurve{}, false
+	}
+	return GammaCurve(gamma), true
+}
+
+func lookupCustomRGBSpace(space string) (rgbSpace, bool) {
+	customRGBSpaces.RLock()
+	defer customRGBSpaces.RUnlock()
+
+	s, ok := customRGBSpaces.spaces[space]
+	return s, ok
+}
@@ -0,0 +1,117 @@
+// Copyright © 2019 Xavier Basty <xavier@hexbee.net>
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package gocolor_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/Hexbee-net/gocolor"
+)
+
+func TestTransferCurve(t *testing.T) {
+	for _, space := range []string{gocolor.SRGB, gocolor.BT2020, gocolor.AdobeRGB, gocolor.ProPhotoRGB} {
+		def, err := gocolor.LookupRGBSpace(space)
+		require.NoError(t, err)
+
+		for _, v := range []float64{-0.5, 0, 0.001, 0.01, 0.05, 0.2, 0.5, 0.8, 1} {
+			c := def.Transfer[0]
+			assert.InDelta(t, v, c.FromLinear(c.ToLinear(v)), 1e-12, "%v: %v", space, v)
+		}
+
+		// The parametric curves match the built-in transfer functions.
+		x, y, z, err := gocolor.RGBtoXYZ(0.5, 0.5, 0.5, space)
+		require.NoError(t, err)
+		l := def.Transfer[0].ToLinear(0.5)
+		assert.InDelta(t, l*def.White[0], x, 1e-3, space)
+		assert.InDelta(t, l*def.White[1], y, 1e-3, space)
+		assert.InDelta(t, l*def.White[2], z, 1e-3, space)
+	}
+
+	table := gocolor.TransferCurve{Table: []float64{0, 0.2, 1}}
+	assert.InDelta(t, 0.1, table.ToLinear(0.25), 1e-12)
+	assert.InDelta(t, 0.6, table.ToLinear(0.75), 1e-12)
+	assert.InDelta(t, 1, table.ToLinear(2), 1e-12)
+	assert.InDelta(t, 0.75, table.FromLinear(0.6), 1e-12)
+	assert.InDelta(t, 0, table.FromLinear(-1), 1e-12)
+}
+
+func TestRegisterRGBSpace(t *testing.T) {
+	def, err := gocolor.LookupRGBSpace(gocolor.AdobeRGB)
+	require.NoError(t, err)
+	def.Transfer[2] = gocolor.GammaCurve(1)
+
+	require.NoError(t, gocolor.RegisterRGBSpace("test linear blue", def))
+	defer func() { assert.NoError(t, gocolor.UnregisterRGBSpace("test linear blue")) }()
+	assert.Error(t, gocolor.RegisterRGBSpace("test linear blue", def))
+	assert.Error(t, gocolor.RegisterRGBSpace(gocolor.SRGB, def))
+	for _, name := range []string{gocolor.CIEXYZ, gocolor.CIELab, gocolor.Oklab} {
+		assert.Error(t, gocolor.RegisterRGBSpace(name, def), name)
+	}
+	assert.Error(t, gocolor.RegisterRGBSpace("", def))
+	assert.Error(t, gocolor.RegisterRGBSpace("test singular", gocolor.RGBSpaceDefinition{White: def.White}))
+
+	got, err := gocolor.LookupRGBSpace("test linear blue")
+	require.NoError(t, err)
+	assert.Equal(t, def, got)
+
+	x, y, z, err := gocolor.RGBtoXYZ(0, 0, 0.5, "test linear blue")
+	require.NoError(t, err)
+	assert.InDelta(t, 0.5*def.ToXYZ[0][2], x, 1e-12)
+	assert.InDelta(t, 0.5*def.ToXYZ[1][2], y, 1e-12)
+	assert.InDelta(t, 0.5*def.ToXYZ[2][2], z, 1e-12)
+
+	r, g, b, err := gocolor.XYZtoRGB(x, y, z, "test linear blue")
+	require.NoError(t, err)
+	assert.InDelta(t, 0, r, 1e-6)
+	assert.InDelta(t, 0, g, 1e-6)
+	assert.InDelta(t, 0.5, b, 1e-9)
+
+	// Registered spaces are available to the bulk conversions.
+	xyz := make([]float64, 3)
+	require.NoError(t, gocolor.RGB8toXYZSlice(xyz, []uint8{0, 0, 0xff}, "test linear blue"))
+	assert.InDelta(t, def.ToXYZ[2][2], xyz[2], 1e-12)
+
+	_, err = gocolor.LookupRGBSpace("unknown")
+	assert.Error(t, err)
+}
+
+func TestUnregisterRGBSpace(t *testing.T) {
+	def, err := gocolor.LookupRGBSpace(gocolor.SRGB)
+	require.NoError(t, err)
+
+	require.NoError(t, gocolor.RegisterRGBSpace("test unregistered", def))
+	rgb8 := make([]uint8, 3)
+	require.NoError(t, gocolor.XYZtoRGB8Slice(rgb8, []float64{0.2, 0.2, 0.2}, "test unregistered"))
+	require.NoError(t, gocolor.UnregisterRGBSpace("test unregistered"))
+
+	_, _, _, err = gocolor.RGBtoXYZ(0.5, 0.5, 0.5, "test unregistered")
+	assert.Error(t, err)
+	_, err = gocolor.LookupRGBSpace("test unregistered")
+	assert.Error(t, err)
+	assert.Error(t, gocolor.UnregisterRGBSpace("test unregistered"))
+	assert.Error(t, gocolor.UnregisterRGBSpace(gocolor.SRGB))
+
+	// The name can be registered again, with another definition, and the
+	// lookup tables of the previous one are not reused.
+	def.Transfer = [3]gocolor.TransferCurve{gocolor.GammaCurve(1), gocolor.GammaCurve(1), gocolor.GammaCurve(1)}
+	require.NoError(t, gocolor.RegisterRGBSpace("test unregistered", def))
+	defer func() { assert.NoError(t, gocolor.UnregisterRGBSpace("test unregistered")) }()
+	linear := make([]uint8, 3)
+	require.NoError(t, gocolor.XYZtoRGB8Slice(linear, []float64{0.2, 0.2, 0.2}, "test unregistered"))
+	assert.NotEqual(t, rgb8, linear)
+}
@@ -0,0 +1,479 @@
+// Copyright © 2019 Xavier Basty <xavier@hexbee.net>
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package gocolor
+
+import (
+	"errors"
+	"fmt"
+	"math"
+)
+
+// Spectral reconstruction methods
+const (
+	SpectralSmits       = "smits"        // Smits (1999) - sums of smooth RGB basis spectra
+	SpectralMeng        = "meng"         // Meng et al. (2015) - least-slope spectrum
+	SpectralJakobHanika = "jakob-hanika" // Jakob & Hanika (2019) - sigmoid of a quadratic polynomial
+)
+
+const (
+	spectralSamples = int(spec830nm) + 1
+
+	// Maximum number of iterations of the iterative reconstruction methods.
+	spectralMaxIterations = 100
+
+	// CIE 1976 colour difference under which a Jakob-Hanika fit is considered exact.
+	spectralFitTolerance = 1e-6
+)
+
+// nanometers returns the wavelength, in nanometers, of a spectral sample.
+func (w Wavelength) nanometers() float64 {
+	return 340 + 10*float64(w)
+}
+
+// Smits basis spectra, sampled at 10 evenly spaced wavelengths from 380nm to 720nm.
+//
+// See "An RGB to Spectrum Conversion for Reflectances", Brian Smits, 1999.
+var (
+	smitsWhite   = []float64{1.0000, 1.0000, 0.9999, 0.9993, 0.9992, 0.9998, 1.0000, 1.0000, 1.0000, 1.0000}
+	smitsCyan    = []float64{0.9710, 0.9426, 1.0007, 1.0007, 1.0007, 1.0007, 0.1564, 0.0000, 0.0000, 0.0000}
+	smitsMagenta = []float64{1.0000, 1.0000, 0.9685, 0.2229, 0.0000, 0.0458, 0.8369, 1.0000, 1.0000, 0.9959}
+	smitsYellow  = []float64{0.0001, 0.0000, 0.1088, 0.6651, 1.0000, 1.0000, 0.9996, 0.9586, 0.9685, 0.9840}
+	smitsRed     = []float64{0.1012, 0.0515, 0.0000, 0.0000, 0.0000, 0.0000, 0.8325, 1.0149, 1.0149, 1.0149}
+	smitsGreen   = []float64{0.0000, 0.0000, 0.0273, 0.7937, 1.0000, 0.9418, 0.1719, 0.0000, 0.0000, 0.0025}
+	smitsBlue    = []float64{1.0000, 1.0000, 0.8916, 0.3323, 0.0000, 0.0000, 0.0003, 0.0369, 0.0483, 0.0496}
+)
+
+// XYZtoSpectral reconstructs a smooth reflectance spectrum from XYZ coordinates.
+//
+// The XYZ coordinates are expected to be relative to the reference illuminant,
+// as returned by SpectralToXYZ for the same observer and illuminant. Converting
+// the resulting spectrum back with SpectralToXYZ yields the input coordinates.
+//
+// The method is one of SpectralSmits, SpectralMeng or SpectralJakobHanika.
+// The Jakob-Hanika method only produces reflectances in the [0, 1] range and
+// will return an error for colors that cannot be reached by such a spectrum.
+// Its coefficient table is precomputed for the D65 illuminant and the 2°
+// observer, and computed on first use, in about 0.3s, for the others.
+func XYZtoSpectral(x, y, z float64, observer int, refIlluminant []float64, method string) (SpectralColor, error) {
+	w, err := spectralWeights(observer, refIlluminant)
+	if err != nil {
+		return nil, err
+	}
+
+	return reconstructSpectrum(vector{x, y, z}, w, method)
+}
+
+// RGBtoSpectral reconstructs a smooth reflectance spectrum from RGB coordinates.
+//
+// The spectrum is computed under the reference illuminant of the RGB color space
+// and the 2° standard observer, so that white (1, 1, 1) maps to a perfect reflector.
+func RGBtoSpectral(r, g, b float64, space string, method string) (SpectralColor, error) {
+	x, y, z, err := RGBtoXYZ(r, g, b, space)
+	if err != nil {
+		return nil, err
+	}
+
+	illuminant, ok := RGBIlluminants[space]
+	if !ok {
+		return nil, fmt.Errorf("could not find illuminant for RGB color space: %v", space)
+	}
+	spd, ok := IlluminantsSpectres[illuminant]
+	if !ok {
+		return nil, fmt.Errorf("could not find spectral distribution for illuminant: %v", illuminant)
+	}
+	wp, err := getWhitePoint(Observer2, illuminant)
+	if err != nil {
+		return nil, err
+	}
+
+	w, err := spectralWeights(Observer2, spd)
+	if err != nil {
+		return nil, err
+	}
+
+	// The tabulated white point and the one integrated from the sampled
+	// illuminant differ slightly, rescale so that white stays white.
+	specWP := w.white()
+	xyz := vector{x, y, z}.vmul(specWP.vdiv(*wp))
+
+	return reconstructSpectrum(xyz, w, method)
+}
+
+func reconstructSpectrum(target vector, w spectralWeighting, method string) (SpectralColor, error) {
+	switch method {
+	case SpectralSmits:
+		return smitsSpectrum(target, w)
+	case SpectralMeng:
+		return mengSpectrum(target, w)
+	case SpectralJakobHanika:
+		return jakobHanikaSpectrum(target, w)
+	default:
+		return nil, fmt.Errorf("unrecognized spectral reconstruction method: %v", method)
+	}
+}
+
+////////////////////////////////////////
+
+// spectralWeighting holds the color matching functions of an observer,
+// weighted by a reference illuminant and normalized so that a perfect
+// reflector has a luminance of 1.
+type spectralWeighting [3][]float64
+
+func spectralWeights(observer int, refIlluminant []float64) (spectralWeighting, error) {
+	var (
+		stdObserverX = stdObs10X
+		stdObserverY = stdObs10Y
+		stdObserverZ = stdObs10Z
+	)
+
+	if observer == Observer2 {
+		stdObserverX = stdObs2X
+		stdObserverY = stdObs2Y
+		stdObserverZ = stdObs2Z
+	}
+
+	if len(refIlluminant) != len(stdObserverX) {
+		return spectralWeighting{}, errors.New("mismatching spectral sampling length")
+	}
+
+	var denom float64
+	for i := range refIlluminant {
+		denom += stdObserverY[i] * refIlluminant[i]
+	}
+	if denom == 0 {
+		return spectralWeighting{}, errors.New("reference illuminant has no luminance")
+	}
+
+	var w spectralWeighting
+	for k, cmf := range [3][]float64{stdObserverX, stdObserverY, stdObserverZ} {
+		w[k] = make([]float64, len(cmf))
+		for i := range cmf {
+			w[k][i] = cmf[i] * refIlluminant[i] / denom
+		}
+	}
+
+	return w, nil
+}
+
+func (w spectralWeighting) xyz(s []float64) vector {
+	var v vector
+	for i := range s {
+		v.v0 += w[0][i] * s[i]
+		v.v1 += w[1][i] * s[i]
+		v.v2 += w[2][i] * s[i]
+	}
+	return v
+}
+
+func (w spectralWeighting) white() vector {
+	var v vector
+	for i := range w[0] {
+		v.v0 += w[0][i]
+		v.v1 += w[1][i]
+		v.v2 += w[2][i]
+	}
+	return v
+}
+
+// project applies the smallest correction to a spectrum so that its XYZ
+// coordinates match the target. The correction is a combination of the
+// weighted color matching functions, and is therefore smooth.
+func (w spectralWeighting) project(s []float64, target vector) error {
+	dot := func(a, b []float64) float64 {
+		var d float64
+		for i := range a {
+			d += a[i] * b[i]
+		}
+		return d
+	}
+	g := matrix{
+		dot(w[0], w[0]), dot(w[0], w[1]), dot(w[0], w[2]),
+		dot(w[1], w[0]), dot(w[1], w[1]), dot(w[1], w[2]),
+		dot(w[2], w[0]), dot(w[2], w[1]), dot(w[2], w[2]),
+	}
+	gInv, err := g.inverse()
+	if err != nil {
+		return err
+	}
+
+	cur := w.xyz(s)
+	c := gInv.vdot(vector{target.v0 - cur.v0, target.v1 - cur.v1, target.v2 - cur.v2})
+	for i := range s {
+		s[i] += c.v0*w[0][i] + c.v1*w[1][i] + c.v2*w[2][i]
+	}
+
+	return nil
+}
+
+////////////////////////////////////////
+
+func smitsSpectrum(target vector, w spectralWeighting) (SpectralColor, error) {
+	// Smits' basis spectra were designed for linear RGB coordinates with
+	// primaries close to the sRGB ones, relative to an equal energy white.
+	wp := w.white()
+	rgb := conversionXyzRgb[SRGB].vdot(target.vdiv(wp).vmul(observerWhitePoints[Observer2][RefIlluminantD65]))
+	r, g, b := rgb.v0, rgb.v1, rgb.v2
+
+	s := make(SpectralColor, spectralSamples)
+	add := func(f float64, basis []float64) {
+		for i := range s {
+			s[i] += f * smitsSample(basis, Wavelength(i).nanometers())
+		}
+	}
+
+	switch {
+	case r <= g && r <= b:
+		add(r, smitsWhite)
+		if g <= b {
+			add(g-r, smitsCyan)
+			add(b-g, smitsBlue)
+		} else {
+			add(b-r, smitsCyan)
+			add(g-b, smitsGreen)
+		}
+	case g <= r && g <= b:
+		add(g, smitsWhite)
+		if r <= b {
+			add(r-g, smitsMagenta)
+			add(b-r, smitsBlue)
+		} else {
+			add(b-g, smitsMagenta)
+			add(r-b, smitsRed)
+		}
+	default:
+		add(b, smitsWhite)
+		if r <= g {
+			add(r-b, smitsYellow)
+			add(g-r, smitsGreen)
+		} else {
+			add(g-b, smitsYellow)
+			add(r-g, smitsRed)
+		}
+	}
+
+	if err := w.project(s, target); err != nil {
+		return nil, err
+	}
+
+	return s, nil
+}
+
+// smitsSample linearly interpolates a Smits basis spectrum at the given wavelength.
+func smitsSample(basis []float64, nm float64) float64 {
+	const (
+		first = 380.0
+		last  = 720.0
+	)
+	step := (last - first) / float64(len(basis)-1)
+
+	switch {
+	case nm <= first:
+		return basis[0]
+	case nm >= last:
+		return basis[len(basis)-1]
+	}
+
+	t := (nm - first) / step
+	i := int(t)
+	f := t - float64(i)
+	return basis[i]*(1-f) + basis[i+1]*f
+}
+
+////////////////////////////////////////
+
+// mengSpectrum finds the spectrum with the least squared slope that matches
+// the target XYZ coordinates. Samples are kept in the [0, 1] range by fixing
+// the ones that overshoot at the bound and solving again.
+//
+// See "Physically Meaningful Rendering using Tristimulus Colours", Meng et al., 2015.
+func mengSpectrum(target vector, w spectralWeighting) (SpectralColor, error) {
+	n := spectralSamples
+	fixed := make(map[int]float64)
+
+	for iter := 0; iter < n; iter++ {
+		// Lagrangian system:
+		//   minimize  sum((s[i+1] - s[i])^2)
+		//   subject to  W·s = target  and  s[i] = fixed[i]
+		size := n + 3 + len(fixed)
+		a := make([][]float64, size)
+		for i := range a {
+			a[i] = make([]float64, size)
+		}
+		rhs := make([]float64, size)
+
+		for i := 0; i < n-1; i++ {
+			a[i][i] += 2
+			a[i+1][i+1] += 2
+			a[i][i+1] -= 2
+			a[i+1][i] -= 2
+		}
+		for k := 0; k < 3; k++ {
+			for i := 0; i < n; i++ {
+				a[n+k][i] = w[k][i]
+				a[i][n+k] = w[k][i]
+			}
+		}
+		rhs[n], rhs[n+1], rhs[n+2] = target.v0, target.v1, target.v2
+
+		row := n + 3
+		for i := 0; i < n; i++ {
+			v, ok := fixed[i]
+			if !ok {
+				continue
+			}
+			a[row][i] = 1
+			a[i][row] = 1
+			rhs[row] = v
+			row++
+		}
+
+		sol, err := solveLinear(a, rhs)
+		if err != nil {
+			return nil, errors.New("color cannot be reconstructed with a reflectance spectrum")
+		}
+
+		s := SpectralColor(sol[:n])
+		clamped := false
+		for i, v := range s {
+			if _, ok := fixed[i]; ok {
+				continue
+			}
+			if v < 0 {
+				fixed[i] = 0
+				clamped = true
+			} else if v > 1 {
+				fixed[i] = 1
+				clamped = true
+			}
+		}
+
+		if !clamped {
+			return s, nil
+		}
+	}
+
+	return nil, errors.New("color cannot be reconstructed with a reflectance spectrum")
+}
+
+////////////////////////////////////////
+
+// jakobHanikaSpectrum finds a spectrum of the form sigmoid(c0·λ² + c1·λ + c2)
+// matching the target XYZ coordinates.
+//
+// The coefficients are looked up with trilinear interpolation in a table
+// precomputed for the reference illuminant and observer, then refined with
+// a few iterations of the Levenberg-Marquardt algorithm so that the spectrum
+// matches the target exactly.
+//
+// See "A Low-Dimensional Function Space for Efficient Spectral Upsampling",
+// Jakob & Hanika, 2019.
+func jakobHanikaSpectrum(target vector, w spectralWeighting) (SpectralColor, error) {
+	table := jakobHanikaTableFor(w)
+	c := table.lookup(table.rgb(target))
+
+	c, res := fitSigmoid(target, w, c)
+	if res > 1e-3 {
+		return nil, errors.New("color cannot be reconstructed with a reflectance spectrum")
+	}
+
+	return sigmoidSpectrum(c), nil
+}
+
+// fitSigmoid refines the coefficients of a sigmoid spectrum, minimizing the
+// CIE 1976 color difference to the target with the Levenberg-Marquardt
+// algorithm. It returns the coefficients and the remaining color difference.
+func fitSigmoid(target vector, w spectralWeighting, c [3]float64) ([3]float64, float64) {
+	wp := w.white()
+	targetLab := xyzToLab(target, wp)
+
+	residual := func(c [3]float64) vector {
+		return xyzToLab(w.xyz(sigmoidSpectrum(c)), wp).vsub(targetLab)
+	}
+
+	res := residual(c)
+	lambda := 1e-3
+
+	for iter := 0; iter < spectralMaxIterations && res.norm() > spectralFitTolerance; iter++ {
+		// Numerical Jacobian of the residual.
+		var jac [3][3]float64
+		for k := 0; k < 3; k++ {
+			const h = 1e-6
+			ck := c
+			ck[k] += h
+			rk := residual(ck)
+			jac[0][k] = (rk.v0 - res.v0) / h
+			jac[1][k] = (rk.v1 - res.v1) / h
+			jac[2][k] = (rk.v2 - res.v2) / h
+		}
+
+		j := matrix{
+			jac[0][0], jac[0][1], jac[0][2],
+			jac[1][0], jac[1][1], jac[1][2],
+			jac[2][0], jac[2][1], jac[2][2],
+		}
+		jt := j.transpose()
+		jtj := jt.mdot(j)
+		jtr := jt.vdot(res)
+
+		for {
+			damped := jtj
+			damped.m00 *= 1 + lambda
+			damped.m11 *= 1 + lambda
+			damped.m22 *= 1 + lambda
+
+			inv, err := damped.inverse()
+			if err != nil {
+				lambda *= 10
+				if lambda > 1e10 {
+					break
+				}
+				continue
+			}
+
+			step := inv.vdot(jtr)
+			next := [3]float64{c[0] - step.v0, c[1] - step.v1, c[2] - step.v2}
+			nextRes := residual(next)
+			if nextRes.norm() < res.norm() {
+				c, res = next, nextRes
+				lambda = math.Max(lambda/10, 1e-12)
+				break
+			}
+
+			lambda *= 10
+			if lambda > 1e10 {
+				break
+			}
+		}
+
+		if lambda > 1e10 {
+			break
+		}
+	}
+
+	return c, res.norm()
+}
+
+// sigmoidSpectrum evaluates the Jakob-Hanika spectral model. Wavelengths are
+// normalized to the [0, 1] range for better conditioning of the coefficients.
+func sigmoidSpectrum(c [3]float64) SpectralColor {
+	s := make(SpectralColor, spectralSamples)
+	for i := range s {
+		l := (Wavelength(i).nanometers() - spec340nm.nanometers()) /
+			(spec830nm.nanometers() - spec340nm.nanometers())
+		x := c[0]*l*l + c[1]*l + c[2]
+		s[i] = 0.5 + x/(2*math.Sqrt(1+x*x))
+	}
+	return s
+}
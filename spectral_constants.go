@@ -408,3 +408,155 @@ var IlluminantsSpectres = map[string][]float64{
 		87.91, 86.67, 85.42, 84.15, 82.86, 81.56, 80.26, 78.95, 77.64, 76.33,
 	},
 }
+
+////////////////////////////////////////
+// CIE daylight components
+
+// Components of the CIE daylight model, from 340nm to 830nm.
+// See CIE 15:2004, table T.2.
+var (
+	daylightS0 = []float64{
+		57.30, 61.80, 61.50, 68.80, 63.40, 65.80, 94.80, 104.80, 105.90, 96.80,
+		113.90, 125.60, 125.50, 121.30, 121.30, 113.50, 113.10, 110.80, 106.50, 108.80,
+		105.30, 104.40, 100.00, 96.00, 95.10, 89.10, 90.50, 90.30, 88.40, 84.00,
+		85.10, 81.90, 82.60, 84.90, 81.30, 71.90, 74.30, 76.40, 63.30, 71.70,
+		77.00, 65.20, 47.70, 68.60, 65.00, 66.00, 61.00, 53.30, 58.90, 61.90,
+	}
+	daylightS1 = []float64{
+		40.60, 41.60, 38.00, 42.40, 38.50, 35.00, 43.40, 46.30, 43.90, 37.10,
+		36.70, 35.90, 32.60, 27.90, 24.30, 20.10, 16.20, 13.20, 8.60, 6.10,
+		4.20, 1.90, 0.00, -1.60, -3.50, -3.50, -5.80, -7.20, -8.60, -9.50,
+		-10.90, -10.70, -12.00, -14.00, -13.60, -12.00, -13.30, -12.90, -10.60, -11.60,
+		-12.20, -10.20, -7.80, -11.20, -10.40, -10.60, -9.70, -8.30, -9.30, -9.80,
+	}
+	daylightS2 = []float64{
+		7.80, 6.70, 5.30, 6.10, 3.00, 1.20, -1.10, -0.50, -0.70, -1.20,
+		-2.60, -2.90, -2.80, -2.60, -2.60, -1.80, -1.50, -1.30, -1.20, -1.00,
+		-0.50, -0.30, 0.00, 0.20, 0.50, 2.10, 3.20, 4.10, 4.70, 5.10,
+		6.70, 7.30, 8.60, 9.80, 10.20, 8.30, 9.60, 8.50, 7.00, 7.60,
+		8.00, 6.70, 5.20, 7.40, 6.80, 7.00, 6.40, 5.50, 6.10, 6.50,
+	}
+)
+
+////////////////////////////////////////
+// Test color samples
+
+// TestColorSamples holds the spectral reflectances of the 14 test color samples
+// used to compute the CIE color rendering index, from 340nm to 830nm.
+// The samples are only defined from 360nm, lower wavelengths repeat the 360nm value.
+//
+// See CIE 13.3-1995, "Method of Measuring and Specifying Colour Rendering Properties of Light Sources".
+var TestColorSamples = []SpectralColor{
+	// TCS01 - 7.5 R 6/4, light greyish red
+	{
+		0.116, 0.116, 0.116, 0.159, 0.219, 0.252, 0.256, 0.252, 0.244, 0.237,
+		0.230, 0.225, 0.220, 0.216, 0.214, 0.216, 0.223, 0.226, 0.225, 0.227,
+		0.236, 0.253, 0.272, 0.298, 0.341, 0.390, 0.424, 0.442, 0.450, 0.451,
+		0.451, 0.450, 0.451, 0.453, 0.455, 0.458, 0.462, 0.464, 0.466, 0.466,
+		0.467, 0.467, 0.467, 0.467, 0.467, 0.467, 0.467, 0.467, 0.467, 0.467,
+	},
+	// TCS02 - 5 Y 6/4, dark greyish yellow
+	{
+		0.053, 0.053, 0.053, 0.059, 0.070, 0.089, 0.111, 0.118, 0.121, 0.122,
+		0.123, 0.127, 0.131, 0.138, 0.150, 0.174, 0.207, 0.242, 0.260, 0.267,
+		0.272, 0.282, 0.299, 0.322, 0.335, 0.341, 0.342, 0.342, 0.341, 0.339,
+		0.338, 0.336, 0.334, 0.332, 0.331, 0.329, 0.328, 0.326, 0.324, 0.324,
+		0.322, 0.320, 0.316, 0.315, 0.314, 0.313, 0.312, 0.311, 0.311, 0.310,
+	},
+	// TCS03 - 5 GY 6/8, strong yellow green
+	{
+		0.058, 0.058, 0.058, 0.061, 0.065, 0.070, 0.073, 0.074, 0.074, 0.073,
+		0.073, 0.074, 0.077, 0.085, 0.109, 0.148, 0.198, 0.241, 0.278, 0.339,
+		0.392, 0.400, 0.380, 0.349, 0.315, 0.285, 0.264, 0.252, 0.241, 0.229,
+		0.220, 0.216, 0.219, 0.230, 0.251, 0.288, 0.340, 0.390, 0.431, 0.460,
+		0.481, 0.493, 0.500, 0.505, 0.516, 0.524, 0.531, 0.539, 0.548, 0.555,
+	},
+	// TCS04 - 2.5 G 6/6, moderate yellowish green
+	{
+		0.057, 0.057, 0.057, 0.062, 0.074, 0.093, 0.116, 0.124, 0.128, 0.135,
+		0.144, 0.161, 0.186, 0.229, 0.281, 0.332, 0.370, 0.390, 0.395, 0.385,
+		0.367, 0.341, 0.312, 0.280, 0.247, 0.214, 0.185, 0.169, 0.160, 0.154,
+		0.151, 0.148, 0.148, 0.151, 0.158, 0.165, 0.170, 0.170, 0.166, 0.164,
+		0.168, 0.177, 0.185, 0.194, 0.202, 0.210, 0.216, 0.221, 0.224, 0.227,
+	},
+	// TCS05 - 10 BG 6/4, light bluish green
+	{
+		0.143, 0.143, 0.143, 0.233, 0.295, 0.310, 0.313, 0.319, 0.326, 0.334,
+		0.346, 0.360, 0.381, 0.403, 0.415, 0.419, 0.413, 0.403, 0.389, 0.372,
+		0.353, 0.331, 0.308, 0.284, 0.260, 0.232, 0.209, 0.187, 0.170, 0.157,
+		0.146, 0.139, 0.135, 0.133, 0.133, 0.133, 0.132, 0.130, 0.130, 0.131,
+		0.132, 0.133, 0.136, 0.140, 0.145, 0.151, 0.157, 0.163, 0.169, 0.175,
+	},
+	// TCS06 - 5 PB 6/8, light blue
+	{
+		0.079, 0.079, 0.079, 0.089, 0.151, 0.265, 0.410, 0.492, 0.517, 0.531,
+		0.544, 0.556, 0.554, 0.541, 0.519, 0.488, 0.450, 0.414, 0.377, 0.341,
+		0.309, 0.279, 0.253, 0.234, 0.218, 0.206, 0.197, 0.190, 0.185, 0.181,
+		0.178, 0.176, 0.174, 0.173, 0.174, 0.176, 0.182, 0.188, 0.197, 0.207,
+		0.218, 0.229, 0.240, 0.250, 0.259, 0.267, 0.273, 0.279, 0.285, 0.290,
+	},
+	// TCS07 - 2.5 P 6/8, light violet
+	{
+		0.150, 0.150, 0.150, 0.218, 0.378, 0.524, 0.551, 0.559, 0.561, 0.556,
+		0.544, 0.522, 0.488, 0.448, 0.408, 0.363, 0.324, 0.301, 0.283, 0.265,
+		0.257, 0.259, 0.260, 0.256, 0.254, 0.270, 0.302, 0.344, 0.377, 0.400,
+		0.420, 0.438, 0.452, 0.462, 0.468, 0.473, 0.483, 0.496, 0.511, 0.528,
+		0.545, 0.559, 0.567, 0.574, 0.580, 0.584, 0.588, 0.591, 0.594, 0.596,
+	},
+	// TCS08 - 10 P 6/8, light reddish purple
+	{
+		0.218, 0.218, 0.218, 0.297, 0.359, 0.372, 0.375, 0.375, 0.371, 0.364,
+		0.354, 0.339, 0.323, 0.307, 0.289, 0.276, 0.265, 0.257, 0.251, 0.251,
+		0.258, 0.269, 0.274, 0.284, 0.316, 0.384, 0.482, 0.568, 0.629, 0.663,
+		0.685, 0.700, 0.709, 0.715, 0.719, 0.720, 0.722, 0.727, 0.730, 0.730,
+		0.730, 0.730, 0.730, 0.730, 0.730, 0.730, 0.730, 0.730, 0.730, 0.730,
+	},
+	// TCS09 - 4.5 R 4/13, strong red
+	{
+		0.069, 0.069, 0.069, 0.073, 0.066, 0.058, 0.052, 0.051, 0.050, 0.048,
+		0.046, 0.042, 0.038, 0.033, 0.030, 0.028, 0.028, 0.030, 0.031, 0.032,
+		0.033, 0.035, 0.041, 0.048, 0.060, 0.102, 0.190, 0.336, 0.505, 0.641,
+		0.717, 0.758, 0.781, 0.797, 0.809, 0.819, 0.828, 0.831, 0.835, 0.836,
+		0.838, 0.839, 0.839, 0.839, 0.839, 0.839, 0.839, 0.839, 0.839, 0.839,
+	},
+	// TCS10 - 5 Y 8/10, strong yellow
+	{
+		0.042, 0.042, 0.042, 0.045, 0.050, 0.059, 0.066, 0.068, 0.069, 0.072,
+		0.076, 0.083, 0.095, 0.113, 0.142, 0.189, 0.262, 0.365, 0.465, 0.546,
+		0.610, 0.653, 0.678, 0.693, 0.701, 0.705, 0.706, 0.707, 0.708, 0.710,
+		0.712, 0.716, 0.720, 0.725, 0.731, 0.739, 0.746, 0.749, 0.753, 0.755,
+		0.755, 0.756, 0.758, 0.759, 0.759, 0.759, 0.759, 0.759, 0.759, 0.759,
+	},
+	// TCS11 - 4.5 G 5/8, strong green
+	{
+		0.074, 0.074, 0.074, 0.086, 0.111, 0.127, 0.127, 0.116, 0.108, 0.104,
+		0.105, 0.110, 0.123, 0.148, 0.192, 0.252, 0.325, 0.356, 0.346, 0.314,
+		0.271, 0.227, 0.188, 0.153, 0.125, 0.106, 0.096, 0.090, 0.085, 0.082,
+		0.079, 0.078, 0.078, 0.083, 0.093, 0.112, 0.141, 0.182, 0.223, 0.257,
+		0.282, 0.302, 0.314, 0.323, 0.334, 0.343, 0.353, 0.365, 0.375, 0.385,
+	},
+	// TCS12 - 3 PB 3/11, strong blue
+	{
+		0.189, 0.189, 0.189, 0.158, 0.118, 0.090, 0.076, 0.064, 0.075, 0.123,
+		0.207, 0.300, 0.346, 0.341, 0.307, 0.257, 0.204, 0.154, 0.109, 0.075,
+		0.051, 0.035, 0.025, 0.019, 0.017, 0.016, 0.016, 0.016, 0.016, 0.018,
+		0.018, 0.019, 0.023, 0.026, 0.035, 0.056, 0.097, 0.166, 0.257, 0.354,
+		0.446, 0.520, 0.577, 0.618, 0.645, 0.666, 0.680, 0.691, 0.697, 0.702,
+	},
+	// TCS13 - 5 YR 8/4, light yellowish pink (skin)
+	{
+		0.071, 0.071, 0.071, 0.082, 0.104, 0.161, 0.264, 0.341, 0.359, 0.364,
+		0.367, 0.372, 0.376, 0.384, 0.397, 0.416, 0.443, 0.461, 0.469, 0.474,
+		0.483, 0.506, 0.553, 0.618, 0.680, 0.717, 0.736, 0.745, 0.748, 0.748,
+		0.748, 0.748, 0.747, 0.747, 0.747, 0.747, 0.746, 0.745, 0.743, 0.745,
+		0.750, 0.749, 0.748, 0.747, 0.747, 0.746, 0.746, 0.745, 0.745, 0.745,
+	},
+	// TCS14 - 5 GY 4/4, moderate olive green (leaf)
+	{
+		0.036, 0.036, 0.036, 0.036, 0.036, 0.037, 0.039, 0.040, 0.042, 0.043,
+		0.044, 0.045, 0.047, 0.050, 0.055, 0.062, 0.075, 0.092, 0.108, 0.133,
+		0.150, 0.155, 0.147, 0.133, 0.118, 0.106, 0.098, 0.093, 0.089, 0.086,
+		0.084, 0.084, 0.085, 0.092, 0.102, 0.123, 0.152, 0.188, 0.226, 0.261,
+		0.288, 0.306, 0.320, 0.333, 0.346, 0.356, 0.364, 0.371, 0.376, 0.381,
+	},
+}
@@ -0,0 +1,207 @@
+// Copyright © 2019 Xavier Basty <xavier@hexbee.net>
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package gocolor
+
+import (
+	"encoding/binary"
+	"hash/fnv"
+	"math"
+	"sync"
+
+	"github.com/Hexbee-net/gocolor/internal/interp"
+)
+
+//go:generate go test -run TestJakobHanikaD65Coefficients -update
+
+// Resolution of the Jakob-Hanika coefficient tables, along each axis.
+const jakobHanikaResolution = 16
+
+// jakobHanikaTable holds the sigmoid coefficients of the spectra of a grid of
+// RGB colors, for a reference illuminant and observer.
+//
+// As in the original paper, the colors are indexed by their largest
+// component l, its value z, remapped with a double smoothstep to have more
+// samples near black and white, and the ratios x and y of the two other
+// components to it. RGB coordinates are linear sRGB, adapted so that the
+// reference white is (1, 1, 1).
+type jakobHanikaTable struct {
+	scale []float64
+
+	// coefficients of color (l, z, y, x), at ((l·res + z)·res + y)·res + x.
+	coefficients [][3]float32
+
+	fromXYZ matrix
+	toXYZ   matrix
+}
+
+// Coefficient tables, by weighting hash, built on first use.
+var jakobHanikaTables sync.Map
+
+func init() {
+	w, _ := spectralWeights(Observer2, IlluminantsSpectres[RefIlluminantD65])
+	table := newJakobHanikaTable(w)
+	table.coefficients = jakobHanikaD65Coefficients
+	jakobHanikaTables.Store(w.hash(), table)
+}
+
+// jakobHanikaTableFor returns the coefficient table of a weighting. The table
+// of the D65 illuminant and 2° observer is precomputed, the others are
+// computed on first use.
+func jakobHanikaTableFor(w spectralWeighting) *jakobHanikaTable {
+	key := w.hash()
+	if table, ok := jakobHanikaTables.Load(key); ok {
+		return table.(*jakobHanikaTable)
+	}
+
+	table := newJakobHanikaTable(w)
+	table.optimize(w)
+
+	actual, _ := jakobHanikaTables.LoadOrStore(key, table)
+	return actual.(*jakobHanikaTable)
+}
+
+////////////////////////////////////////
+
+// newJakobHanikaTable creates an empty coefficient table for a weighting.
+func newJakobHanikaTable(w spectralWeighting) *jakobHanikaTable {
+	const res = jakobHanikaResolution
+
+	t := &jakobHanikaTable{scale: make([]float64, res)}
+	for i := range t.scale {
+		t.scale[i] = smoothstep(smoothstep(float64(i) / (res - 1)))
+	}
+
+	// Scale the sRGB matrices so that the white of the weighting maps to
+	// (1, 1, 1).
+	white := w.white()
+	adapt := observerWhitePoints[Observer2][RefIlluminantD65].vdiv(white).diag()
+	t.fromXYZ = conversionXyzRgb[SRGB].mdot(adapt)
+	t.toXYZ, _ = t.fromXYZ.inverse()
+
+	return t
+}
+
+// optimize fills the table by fitting the spectrum of each color.
+func (t *jakobHanikaTable) optimize(w spectralWeighting) {
+	const res = jakobHanikaResolution
+
+	t.coefficients = make([][3]float32, 3*res*res*res)
+	for l := 0; l < 3; l++ {
+		for j := 0; j < res; j++ {
+			for i := 0; i < res; i++ {
+				t.optimizeColumn(w, l, j, i)
+			}
+		}
+	}
+}
+
+// optimizeColumn fits the spectra of the colors with the largest component l
+// and the ratios x = i/(res-1) and y = j/(res-1), for all the values z of the
+// largest component. The fit starts from a medium value, and uses the
+// coefficients of the previous value as a starting point, going up to white
+// then down to black.
+func (t *jakobHanikaTable) optimizeColumn(w spectralWeighting, l, j, i int) {
+	const res = jakobHanikaResolution
+
+	x := float64(i) / (res - 1)
+	y := float64(j) / (res - 1)
+
+	fit := func(k int, c [3]float64) [3]float64 {
+		var rgb [3]float64
+		rgb[l] = t.scale[k]
+		rgb[(l+1)%3] = x * t.scale[k]
+		rgb[(l+2)%3] = y * t.scale[k]
+
+		target := t.toXYZ.vdot(vector{rgb[0], rgb[1], rgb[2]})
+		c, _ = fitSigmoid(target, w, c)
+		t.coefficients[((l*res+k)*res+j)*res+i] = [3]float32{float32(c[0]), float32(c[1]), float32(c[2])}
+		return c
+	}
+
+	start := res / 5
+	var c [3]float64
+	for k := start; k < res; k++ {
+		c = fit(k, c)
+	}
+	c = [3]float64{}
+	for k := start; k >= 0; k-- {
+		c = fit(k, c)
+	}
+}
+
+// rgb converts XYZ coordinates to the RGB coordinates of the table.
+func (t *jakobHanikaTable) rgb(xyz vector) [3]float64 {
+	v := t.fromXYZ.vdot(xyz).mapfunc(interp.Clamp01)
+	return [3]float64{v.v0, v.v1, v.v2}
+}
+
+// lookup interpolates the coefficients of an RGB color in the [0, 1] range.
+func (t *jakobHanikaTable) lookup(rgb [3]float64) [3]float64 {
+	const res = jakobHanikaResolution
+
+	l := 0
+	for i := 1; i < 3; i++ {
+		if rgb[i] >= rgb[l] {
+			l = i
+		}
+	}
+
+	z := rgb[l]
+	if z == 0 {
+		z = 1e-9
+	}
+	x := rgb[(l+1)%3] / z * (res - 1)
+	y := rgb[(l+2)%3] / z * (res - 1)
+
+	// Find the z interval, the scale is increasing.
+	zi := 0
+	for zi < res-2 && t.scale[zi+1] <= z {
+		zi++
+	}
+	zf := (z - t.scale[zi]) / (t.scale[zi+1] - t.scale[zi])
+
+	xi := int(math.Min(x, res-2))
+	yi := int(math.Min(y, res-2))
+	xf := x - float64(xi)
+	yf := y - float64(yi)
+
+	var c [3]float64
+	for corner, f := range interp.Trilinear([3]float64{xf, yf, zf}) {
+		dx, dy, dz := corner&1, corner>>1&1, corner>>2&1
+		v := t.coefficients[((l*res+zi+dz)*res+yi+dy)*res+xi+dx]
+		c[0] += f * float64(v[0])
+		c[1] += f * float64(v[1])
+		c[2] += f * float64(v[2])
+	}
+
+	return c
+}
+
+// hash returns an identifier of the weighting, used as a cache key.
+func (w spectralWeighting) hash() uint64 {
+	h := fnv.New64a()
+	var b [8]byte
+	for _, cmf := range w {
+		for _, v := range cmf {
+			binary.LittleEndian.PutUint64(b[:], math.Float64bits(v))
+			_, _ = h.Write(b[:])
+		}
+	}
+	return h.Sum64()
+}
+
+func smoothstep(x float64) float64 {
+	return x * x * (3 - 2*x)
+}
@@ -0,0 +1,12314 @@
+// Copyright © 2019 Xavier Basty <xavier@hexbee.net>
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Code generated by "go test -run TestJakobHanikaD65Coefficients -update"; DO NOT EDIT.
+
+package gocolor
+
+// jakobHanikaD65Coefficients are the sigmoid coefficients of the
+// Jakob-Hanika table of the D65 illuminant and the 2° standard observer,
+// computed by (*jakobHanikaTable).optimize.
+//
+// Spectra close to 0 at all wavelengths need steep sigmoids, so the
+// coefficients of the darkest colors reach magnitudes of 10⁴.
+var jakobHanikaD65Coefficients = [][3]float32{
+	{-7697.537, 14950.792, -12669.333},
+	{-6424.8193, -765.0463, -4823.723},
+	{-14870.256, 17984.178, -10361.85},
+	{3503.0728, -3458.273, -7291.0854},
+	{-288.8499, 4370.2837, -8087.8267},
+	{406.9468, 5279.632, -11677.533},
+	{-7041.19, 16378.253, -13182.813},
+	{-204.6764, 8305.665, -10089.2705},
+	{-8684.405, 24189.023, -16428.86},
+	{-9991.414, 20084.816, -12356.114},
+	{-8620.118, 21295.355, -15971.159},
+	{-2997.8374, 8056.4233, -9587.133},
+	{75579.69, -24.284075, -94380.164},
+	{-6434.882, 6453.11, -5246.1533},
+	{-9252.602, 9926.222, -9830.164},
+	{-23920.018, 21391.041, -14623.951},
+	{2104.9783, -643.93976, -4687.3975},
+	{-3454.5098, 7721.963, -7938.8413},
+	{-592.84595, -4426.6973, -2544.6196},
+	{-1636.5812, 7855.715, -10507.652},
+	{-1723.0677, 2489.7507, -6283.548},
+	{2215.7717, 3335.3586, -6220.407},
+	{2272.114, 4187.3696, -7875.822},
+	{-4083.2593, 8952.684, -8358.126},
+	{-3215.1523, 12751.731, -11734.205},
+	{-8077.616, -1426.1348, -4000.2078},
+	{-4402.665, 8145.0137, -6756.391},
+	{-12735.195, 10124.615, -12485.226},
+	{-6970.8804, 10660.865, -11140.391},
+	{-29513.408, 25160.314, -11014.794},
+	{2755.5156, 2652.4255, -5494.135},
+	{-12399.02, 8198.253, -5077.487},
+	{6195.1694, 588.15265, -8991.6},
+	{4252.1714, -10367.821, -7268.6313},
+	{-9262.183, 739.02985, -4562.476},
+	{1559.5365, -1853.1776, -2806.9985},
+	{-8036.0913, 4328.278, -4624.961},
+	{4559.66, -676.8317, -8592.515},
+	{2477.2976, -500.85425, -4322.1616},
+	{3085.711, 2611.7168, -8671.572},
+	{5097.895, -7440.3584, -2987.729},
+	{-14741.248, 11587.249, -6784.1543},
+	{-9749.477, 9342.902, -5395.1875},
+	{-10536.4375, 11767.389, -6219.3506},
+	{-16554.008, 11575.678, -6879.6514},
+	{-5401.649, 10161.381, -12109.353},
+	{-2505.5457, 1433.1096, -5079.3936},
+	{-14286.586, 13156.18, -5641.1406},
+	{-423.06833, -2407.9473, -4407.2207},
+	{5116.507, -2160.1157, -6215.555},
+	{-10869.504, 2802.4065, -5034.4727},
+	{931.5948, 1048.7706, -5519.7656},
+	{215.00708, 2139.0552, -5443.9556},
+	{9998.62, -7396.2783, -4791.217},
+	{5155.3286, -3714.978, -3573.6108},
+	{6088.5815, -919.1187, -11292.654},
+	{1815.0521, -3628.3672, -7456.489},
+	{1846.4047, 3016.6743, -5145.064},
+	{-10761.846, 6186.3213, -5330.3237},
+	{-125187.445, 57714.74, -27948.08},
+	{5286.909, 5401.076, -12746.471},
+	{5078.545, 1949.0466, -7077.3223},
+	{-655.95984, -235.19531, -6250.398},
+	{-6626.024, 7131.681, -6976.4204},
+	{5784.5415, -3345.817, -8455.099},
+	{-29247.375, 13923.087, -11095.042},
+	{-22293.34, 21929.566, -8477.879},
+	{3085.9275, 284.53555, -8027.48},
+	{-360.43915, 5232.022, -7454.896},
+	{3103.6917, -2373.7427, -6557.0747},
+	{-652.95496, 5331.619, -7050.248},
+	{-5538.1406, 3850.2893, -7180.463},
+	{1788.3381, 1740.9536, -6679.729},
+	{4473.8027, -4167.3833, -7061.018},
+	{-2422.8025, -3.514221, -6646.627},
+	{-1893.2806, -2299.7566, -9349.905},
+	{-18264.525, 12183.591, -10078.925},
+	{-5681.4824, 5197.8594, -6566.1157},
+	{-5449.8726, 5142.024, -4943.1455},
+	{-1901.2125, 13456.257, -12015.337},
+	{3105.404, -13397.343, -3461.8545},
+	{156.39944, -7070.4175, -1185.838},
+	{10284.286, -4253.4844, -6182.263},
+	{8332.449, -22429.064, -8024.5205},
+	{228.14552, 86.80614, -4251.014},
+	{11654.736, -15528.491, -3497.4133},
+	{1236.3606, -1400.3287, -4668.0425},
+	{-4060.1587, 12407.229, -9433.542},
+	{-4641.7544, 7542.333, -10746.606},
+	{-173.95844, 2947.6274, -5909.916},
+	{-8328.962, 5660.1675, -7325.5093},
+	{32498.39, -27816.93, -13211.783},
+	{-6321.1826, 7889.5635, -9782.927},
+	{4095.5698, 3452.7905, -9525.008},
+	{1489.6079, 8331.224, -11516.922},
+	{-12724.569, 11486.291, -8687.925},
+	{11169.214, -14637.85, -3054.7976},
+	{3860.5874, -5045.118, -3949.9048},
+	{1705.8115, 5273.566, -7230.9272},
+	{-2030.7535, 4902.8477, -5129.212},
+	{5019.507, -8359.975, -9708.267},
+	{1623.6581, 2302.038, -6927.889},
+	{5221.905, 37431.113, -47852.465},
+	{7901.3784, -7703.473, -6062.3276},
+	{-3232.3428, -843.74426, -4801.851},
+	{11529.365, -5674.0166, -6009.838},
+	{-541.5372, 2340.5317, -4724.195},
+	{-18449.77, 10183.441, -12481.162},
+	{-6601.1846, 10517.25, -8423.939},
+	{-2775.0059, -2744.5085, -4042.123},
+	{-3506.0479, 4580.54, -7271.024},
+	{-6931.4165, 5366.982, -7208.096},
+	{3725.8328, -5688.8047, -3662.5603},
+	{10800.528, -19333.828, -12828.856},
+	{11948.891, -13525.834, -2937.0256},
+	{4207.633, 1797.5955, -7128.339},
+	{2773.6287, -4040.1658, -4368.2656},
+	{5743.9395, 1869.8201, -10882.1875},
+	{1233.2576, -4508.4883, -3422.8503},
+	{1147.6044, -15857.972, -1661.1589},
+	{3368.146, -2966.791, -10001.388},
+	{-95.45765, 4756.6875, -8830.498},
+	{3715.6497, 6438.564, -11109.44},
+	{-724.2817, -15361.863, -3202.3647},
+	{2065.8696, 947.76556, -10042.012},
+	{-18894.184, 52728.434, -35652.24},
+	{-5971.404, 8674.771, -7525.2207},
+	{803.6864, 556.6083, -5674.921},
+	{-14271.286, 12540.524, -7629.388},
+	{-4573.798, 5593.9624, -11330.645},
+	{-4933.7466, -1766.1016, -5907.098},
+	{6946.292, -12606.311, -3753.9072},
+	{8205.391, -11588.079, -4780.3535},
+	{3973.0579, -2272.2405, -6955.32},
+	{-718.9843, 7995.557, -10859.614},
+	{-1502.0535, -389.2706, -5195.436},
+	{-23.835451, 166.79156, -6155.6807},
+	{-1680.5715, 2962.2283, -6396.5054},
+	{521.7877, -1500.6926, -5650.8726},
+	{3338.8896, -1488.8824, -2405.0725},
+	{-4247.857, -977.3095, -5858.9653},
+	{-361.31046, 1600.4985, -3889.1328},
+	{-9546.496, 5226.4653, -4960.307},
+	{-6445.823, 2675.788, -3307.0583},
+	{11614.331, -8319.802, -5410.4443},
+	{5851.088, -7453.257, -4990.9844},
+	{5034.7837, -7365.1436, -6199.4004},
+	{4270.4097, -6412.716, -8051.067},
+	{10396.054, -3345.2324, -9381.799},
+	{7228.839, -3637.3337, -5153.438},
+	{7651.236, -8880.147, -6745.813},
+	{6865.116, -11114.335, -2036.1033},
+	{7298.3955, -4613.85, -4590.0493},
+	{7821.748, -5009.9727, -3240.843},
+	{5278.0117, -3790.252, -2871.6448},
+	{4257.9224, -1393.5544, -5912.987},
+	{-21457.195, 14603.226, -11230.699},
+	{-1595.4535, -3407.3428, -3612.9705},
+	{-3492.1135, 2909.507, -7986.736},
+	{4729.295, 944.3128, -5777.6074},
+	{9730.935, -10801.186, -2807.2031},
+	{-16463.219, 10937.479, -8469.713},
+	{-10199.418, 3577.6443, -4150.075},
+	{7383.7993, -5169.007, -4879.4688},
+	{10250.182, -10599.293, -4677.0396},
+	{-9350.697, -8281.168, -6156.273},
+	{1730.2778, -4211.0225, -3193.8188},
+	{7573.1255, -6529.031, -3915.3164},
+	{8470.948, -2285.5781, -6643.968},
+	{-16911.086, 15039.789, -6648.0596},
+	{5858.228, -2477.547, -7619.585},
+	{1822.2026, -3912.7415, -7142.1206},
+	{-5407.7837, -6690.104, -5564.9727},
+	{-2935.4365, 6245.028, -10671.069},
+	{-1286.227, 1023.7183, -5746.307},
+	{-5670.2974, 626.1356, -4374.1157},
+	{2628.2617, -8848.637, -5433.2603},
+	{2925.402, -11907.572, -2599.7778},
+	{3483.1536, -4019.9465, -4259.1885},
+	{11149.417, -8802.511, -2381.5498},
+	{-8586.11, 5033.0474, -4313.1943},
+	{2962.4946, -2928.5745, -2673.4504},
+	{2859.754, 1235.2576, -8300.138},
+	{4948.0537, 552.22424, -5943.977},
+	{349.92303, -3085.8557, -3681.2837},
+	{2414.1907, -1245.5352, -4491.6143},
+	{-6674.5586, 85.73534, -4349.7314},
+	{7758.9893, -401.59305, -7898.3364},
+	{-468.64597, 1331.8387, -4691.935},
+	{-74.00389, -306.6025, -4500.582},
+	{1319.4198, -70.04333, -4857.073},
+	{-13920.924, 9177.732, -3972.0737},
+	{-20010.867, 9941.7, -13466.754},
+	{1840.2384, -3337.729, -2730.4883},
+	{-7952.732, -3998.198, -3335.8435},
+	{16400.559, -12326.838, -5642.3535},
+	{9774.376, -8679.328, -5507.4214},
+	{9547.049, -8798.445, -7309.8604},
+	{4426.9424, -4959.425, -5134.2725},
+	{1940.1072, -536.3886, -3537.6401},
+	{2392.5159, -5050.505, -1652.1523},
+	{5493.842, -9798.111, -2047.3744},
+	{-504.92734, 4838.7524, -8418.535},
+	{7125.926, -5149.51, -6493.141},
+	{5303.504, -3633.3503, -5870.153},
+	{6132.833, -2661.0288, -7698.5557},
+	{-767.8498, -2733.3215, -3347.4436},
+	{2947.0173, 1046.9813, -4972.7603},
+	{8828.751, -5877.0776, -3350.4966},
+	{-2263.6677, -11832.558, -1734.3821},
+	{9864.707, -13639.188, -3271.1323},
+	{19519, -16818.346, -3045.0046},
+	{15956.603, -14625.251, -4109.348},
+	{-15359.893, 15425.474, -19562.38},
+	{735.2484, -3627.5032, -2198.7307},
+	{7526.5327, -9323.607, -5821.913},
+	{7046.7964, -6478.126, -5331.4326},
+	{1288.6484, -2701.9104, -4913.853},
+	{4982.655, -1659.3287, -7722.321},
+	{8625.116, -3787.4282, -7097.1597},
+	{3083.0264, 461.53958, -5861.8965},
+	{2242.2207, 478.84183, -4425.623},
+	{2625.97, 1122.9641, -5482.0234},
+	{7753.5996, -6117.2905, -10555.608},
+	{-7130.1133, 2038.5846, -5047.576},
+	{6860.4404, -5677.549, -4814.2817},
+	{2384.8674, 7688.0576, -21659.123},
+	{5852.296, -3507.8523, -10200.15},
+	{12431.195, -22617.3, -8832.169},
+	{9043.554, -8813.438, -4493.438},
+	{2930.689, -1890.4288, -9839.512},
+	{4909.2026, -3728.044, -8859.536},
+	{6001.8535, -1466.803, -5522.6904},
+	{7971.8115, -5758.9805, -4028.3623},
+	{-1527.6572, -2332.3684, -5874.288},
+	{-3319.0303, -334.46024, -7915.672},
+	{7976.2705, -7623.407, -4715.3345},
+	{3225.3098, 681.89966, -6635.0073},
+	{-3584.4583, 3325.4072, -7322.3145},
+	{-798.5483, 1800.9153, -4742.5015},
+	{15804.442, -16532.31, -4564.7627},
+	{11900.447, -15266.192, -2678.1067},
+	{8733.991, -10176.136, -4542.3853},
+	{7797.7974, -6501.352, -4151.7856},
+	{3990.554, -3203.048, -7796.224},
+	{2714.739, -8000.218, -2994.8767},
+	{11271.692, -14080.054, -1149.9332},
+	{8682.083, -8809.742, -4000.2866},
+	{3692.7673, -4059.2278, -4234.502},
+	{-13129.2, -15534.034, -3016.372},
+	{7597.5356, -3733.3557, -5524.377},
+	{1558.7676, 8425.41, -11638.911},
+	{-2422.207, 6871.4233, -9195.603},
+	{-2174.6377, 340.8903, -4527.817},
+	{-4234.442, 4025.9392, -11601.594},
+	{2323.3647, -1996.7037, -3848.9011},
+	{-484.97662, 814.87946, -342.40833},
+	{-509.74054, 778.3466, -305.3413},
+	{-580.0084, 791.82074, -287.49625},
+	{-647.23444, 812.3193, -276.53543},
+	{-703.9203, 830.9076, -268.68472},
+	{-750.4226, 846.046, -262.50916},
+	{-788.31757, 857.8103, -257.34195},
+	{-819.1716, 866.64825, -252.83488},
+	{-844.30084, 873.0444, -248.78995},
+	{-864.76154, 877.4281, -245.08624},
+	{-881.3916, 880.158, -241.64581},
+	{-894.8617, 881.5324, -238.41713},
+	{-905.70355, 881.7895, -235.36311},
+	{-914.35004, 881.12756, -232.45793},
+	{-921.15295, 879.7087, -229.68225},
+	{-926.3981, 877.6639, -227.0209},
+	{281.01868, -141.11417, -65.57572},
+	{96.26936, 41.10152, -94.516335},
+	{-4.306666, 136.22969, -108.39404},
+	{-66.7581, 192.9618, -115.70884},
+	{-137.68875, 250.51595, -123.05517},
+	{-225.95958, 317.81586, -132.04245},
+	{-302.9125, 375.1808, -139.53337},
+	{-368.6987, 423.3349, -145.64406},
+	{-424.82928, 463.75217, -150.60585},
+	{-472.82593, 497.77887, -154.62817},
+	{-514.0037, 526.52936, -157.88306},
+	{-549.45386, 550.9043, -160.50856},
+	{-580.0716, 571.6284, -162.615},
+	{-606.5907, 589.2869, -164.2909},
+	{-629.61475, 604.3553, -165.6076},
+	{-649.6424, 617.2221, -166.62308},
+	{441.67712, -340.8145, -8.255018},
+	{256.64902, -156.14583, -38.60089},
+	{147.46649, -49.212055, -55.97674},
+	{75.25451, 20.366928, -66.983986},
+	{21.963247, 70.39246, -74.613174},
+	{-29.844584, 115.66647, -81.31929},
+	{-101.74406, 172.91377, -89.84885},
+	{-169.11363, 225.39882, -97.60935},
+	{-228.51143, 271.0989, -104.29124},
+	{-280.6392, 310.7931, -110.021866},
+	{-326.4142, 345.33243, -114.9392},
+	{-366.696, 375.47064, -119.16459},
+	{-402.23648, 401.84787, -122.80078},
+	{-433.6776, 425.00006, -125.93368},
+	{-461.56335, 445.37488, -128.63492},
+	{-486.35397, 463.34695, -130.96432},
+	{499.14563, -414.9955, 14.402377},
+	{331.66083, -249.22972, -11.897974},
+	{227.03421, -145.51279, -28.936646},
+	{149.70534, -71.28427, -41.023235},
+	{98.110054, -20.878899, -49.16346},
+	{52.569153, 21.49738, -55.857777},
+	{5.912326, 62.17405, -62.163197},
+	{-54.964172, 111.14673, -69.7008},
+	{-112.78697, 156.78304, -76.68984},
+	{-164.48502, 197.20778, -82.843636},
+	{-210.54337, 232.9569, -88.24932},
+	{-251.60068, 264.62308, -93.00246},
+	{-288.26068, 292.73642, -97.18767},
+	{-321.0701, 317.76425, -100.880165},
+	{-350.5027, 340.1039, -104.14377},
+	{-376.96677, 360.09354, -107.03286},
+	{493.35638, -413.51813, 16.825497},
+	{372.7146, -301.0792, 3.411035},
+	{273.40372, -202.61917, -12.626626},
+	{197.86116, -129.89249, -24.507612},
+	{145.38666, -78.55296, -32.920845},
+	{101.35962, -36.69809, -39.70307},
+	{61.989494, -0.5334708, -45.48489},
+	{17.66876, 37.33299, -51.443623},
+	{-36.37613, 80.72551, -58.213177},
+	{-86.37313, 120.384514, -64.38057},
+	{-131.42387, 155.87386, -69.881065},
+	{-171.94513, 187.62103, -74.78272},
+	{-208.42328, 216.06868, -79.15577},
+	{-241.32219, 241.62099, -83.06461},
+	{-271.05228, 264.62634, -86.56448},
+	{-297.97836, 285.39017, -89.70428},
+	{499.67078, -425.83658, 22.585766},
+	{392.23172, -327.01334, 11.686691},
+	{303.04398, -239.67052, -1.817892},
+	{231.75957, -170.62071, -13.050329},
+	{177.7239, -118.435715, -21.589956},
+	{136.98622, -78.74109, -28.099827},
+	{99.43947, -43.64539, -33.790447},
+	{61.617302, -10.069965, -39.164},
+	{17.48637, 26.608881, -44.957897},
+	{-30.328733, 64.90745, -50.97217},
+	{-73.939125, 99.58335, -56.40953},
+	{-113.45386, 130.84286, -61.30266},
+	{-149.24506, 159.04245, -65.707275},
+	{-181.70338, 184.52937, -69.677795},
+	{-211.1928, 207.6166, -73.263466},
+	{-238.04039, 228.58005, -76.508},
+	{490.82275, -419.8829, 23.385788},
+	{391.31888, -328.64453, 13.507174},
+	{322.5694, -264.57874, 5.675222},
+	{255.56047, -199.52087, -4.790622},
+	{201.32552, -147.67542, -13.219703},
+	{162.3021, -109.27448, -19.536156},
+	{125.31762, -74.5777, -25.186892},
+	{91.56681, -43.736034, -30.178135},
+	{55.006947, -12.208716, -35.215298},
+	{11.640396, 23.056002, -40.78682},
+	{-30.386234, 56.693512, -46.090984},
+	{-68.725395, 87.22428, -50.903526},
+	{-103.62749, 114.9146, -55.265316},
+	{-135.41951, 140.06271, -59.222347},
+	{-164.42216, 162.94766, -62.81791},
+	{-190.9289, 183.81857, -66.090866},
+	{480.31363, -411.64517, 23.418127},
+	{396.91736, -337.77393, 17.191608},
+	{332.2942, -277.8296, 10.045296},
+	{272.41092, -220.42943, 1.3409446},
+	{219.11641, -169.8315, -6.8112617},
+	{181.08751, -132.36069, -12.959955},
+	{145.48871, -98.728516, -18.444414},
+	{114.021965, -69.479836, -23.203882},
+	{81.12936, -40.471268, -27.871096},
+	{43.793518, -9.353391, -32.81957},
+	{3.6134162, 23.000122, -37.93463},
+	{-33.504333, 52.702446, -42.632412},
+	{-67.44552, 79.765915, -46.91444},
+	{-98.476006, 104.44214, -50.818825},
+	{-126.87819, 126.980064, -54.383595},
+	{-152.91786, 147.60683, -57.643692},
+	{470.70328, -404.17755, 23.495575},
+	{395.21982, -338.1906, 18.54072},
+	{332.7869, -280.19693, 11.542367},
+	{284.27847, -235.57414, 5.9378095},
+	{232.75519, -186.9572, -1.7858511},
+	{195.62514, -150.43001, -7.7475224},
+	{162.18335, -118.43592, -12.967242},
+	{131.86069, -90.06684, -17.587019},
+	{100.43189, -62.1202, -22.092445},
+	{68.039734, -34.411263, -26.526594},
+	{30.745607, -4.078378, -31.332369},
+	{-5.152854, 24.758022, -35.899002},
+	{-38.120625, 51.145973, -40.082882},
+	{-68.35763, 75.287865, -43.91399},
+	{-96.111244, 97.405205, -47.42559},
+	{-121.622765, 117.70558, -50.649174},
+	{464.04874, -399.9291, 24.268053},
+	{390.53287, -334.99265, 18.893513},
+	{336.82407, -286.6247, 14.049505},
+	{290.3266, -244.04854, 8.791483},
+	{243.25331, -200.31459, 2.212288},
+	{207.11024, -164.85223, -3.5303502},
+	{175.50208, -134.31236, -8.504679},
+	{145.31018, -106.18388, -13.069709},
+	{116.42018, -80.04262, -17.296944},
+	{86.40971, -54.018337, -21.47209},
+	{52.660088, -26.157438, -25.898197},
+	{18.058794, 1.7381865, -30.316322},
+	{-13.9503565, 27.43651, -34.39338},
+	{-43.393307, 51.01859, -38.140553},
+	{-70.484085, 72.67999, -41.58668},
+	{-95.44188, 92.61064, -44.760143},
+	{463.64685, -402.78262, 26.745764},
+	{385.72604, -331.5614, 19.13122},
+	{337.63116, -289.23276, 15.534748},
+	{290.8118, -246.2529, 10.03416},
+	{251.1573, -210.5962, 5.3808713},
+	{216.2796, -176.49843, -0.070272736},
+	{186.04166, -147.13225, -4.8338156},
+	{156.15134, -119.386765, -9.3167305},
+	{128.79767, -94.39147, -13.359876},
+	{100.90979, -69.88344, -17.298979},
+	{70.260445, -44.19221, -21.390545},
+	{37.315235, -17.489822, -25.619293},
+	{6.2387204, 7.521794, -29.586031},
+	{-22.425276, 30.538534, -33.244232},
+	{-48.8568, 51.729958, -36.61836},
+	{-73.25479, 71.26978, -39.733955},
+	{459.69138, -401.25763, 27.981693},
+	{381.82425, -329.10638, 19.570427},
+	{335.3391, -288.22113, 16.063145},
+	{293.06238, -250.4125, 11.750234},
+	{255.93945, -217.24129, 7.5901146},
+	{223.60884, -185.93893, 2.789745},
+	{194.51787, -157.63382, -1.7708611},
+	{165.18535, -130.46095, -6.139567},
+	{139.43791, -106.66708, -9.988105},
+	{112.42626, -82.85155, -13.810323},
+	{84.43929, -59.03144, -17.612293},
+	{53.45104, -33.72642, -21.62033},
+	{23.283936, -9.3946, -25.475454},
+	{-4.6237116, 13.062158, -29.042868},
+	{-30.409023, 33.781715, -32.341885},
+	{-54.251965, 52.92266, -35.39542},
+	{452.80615, -396.12582, 28.19365},
+	{380.5371, -329.62473, 20.727028},
+	{332.34933, -286.40756, 16.36611},
+	{294.39474, -253.53763, 13.1878605},
+	{257.192, -219.79639, 8.732936},
+	{229.33345, -193.46208, 5.1312013},
+	{201.41098, -166.32141, 0.81128144},
+	{172.81389, -139.85545, -3.4197166},
+	{148.46088, -117.12855, -7.091692},
+	{122.108, -93.869675, -10.814803},
+	{95.93196, -71.350365, -14.411522},
+	{66.97398, -47.48913, -18.192295},
+	{37.80002, -23.89761, -21.925571},
+	{10.623055, -1.9897064, -25.402256},
+	{-14.533904, 18.263103, -28.625128},
+	{-37.832558, 37.004917, -31.614607},
+	{445.2553, -390.06262, 28.080427},
+	{380.45087, -331.46268, 22.17562},
+	{329.47504, -284.7554, 16.695166},
+	{292.9542, -253.6243, 13.85659},
+	{258.80386, -222.70663, 9.947668},
+	{233.10387, -198.67444, 6.8582788},
+	{207.01929, -173.52011, 2.9968498},
+	{179.27135, -147.86122, -1.0756065},
+	{155.85126, -125.90403, -4.612349},
+	{130.66222, -103.53908, -8.18636},
+	{105.45642, -81.76422, -11.6588125},
+	{78.32391, -59.20649, -15.233715},
+	{50.248875, -36.427067, -18.83409},
+	{23.776644, -15.054252, -22.221159},
+	{-0.7720351, 4.7409005, -25.36811},
+	{-23.540354, 23.088001, -28.292912},
+	{437.69336, -383.85278, 27.8522},
+	{378.26938, -330.80133, 22.942982},
+	{327.44388, -284.1093, 17.26772},
+	{290.45923, -252.50117, 14.204394},
+	{260.8543, -226.07182, 11.260926},
+	{234.59909, -201.21745, 7.8845286},
+	{211.46915, -179.37233, 4.8245444},
+	{184.70915, -154.68024, 0.95141226},
+	{161.94966, -133.32411, -2.4719112},
+	{137.7405, -111.73342, -5.9137025},
+	{113.438705, -90.66004, -9.267887},
+	{87.906845, -69.252815, -12.6601095},
+	{60.983025, -47.319386, -16.12269},
+	{35.192486, -26.468195, -19.421644},
+	{11.231312, -7.119457, -22.493675},
+	{-11.022188, 10.840114, -25.354052},
+	{430.3198, -377.7467, 27.58086},
+	{374.61038, -328.3873, 23.22845},
+	{326.72928, -284.9905, 18.213186},
+	{287.9845, -251.24426, 14.483623},
+	{261.41666, -227.68024, 12.109143},
+	{235.9429, -203.56335, 8.849724},
+	{214.52847, -183.62262, 6.2327375},
+	{189.23073, -160.45412, 2.7029936},
+	{167.05396, -139.66554, -0.60729814},
+	{143.59937, -118.704414, -3.9368992},
+	{120.41331, -98.47102, -7.1528316},
+	{96.019066, -77.90636, -10.407694},
+	{70.25463, -56.822205, -13.732423},
+	{45.149002, -36.495388, -16.942677},
+	{21.754745, -17.58079, -19.94124},
+	{-1.9675597e-05, 1.4160513e-05, -22.738},
+	{-121.08172, 210.47888, -89.89764},
+	{-134.53621, 206.10376, -80.95984},
+	{-153.57777, 209.99232, -76.27611},
+	{-171.59955, 215.5785, -73.39125},
+	{-186.7787, 220.62181, -71.32589},
+	{-199.23514, 224.73323, -69.70237},
+	{-209.39655, 227.94041, -68.345055},
+	{-217.68298, 230.36588, -67.16218},
+	{-224.44498, 232.13899, -66.10124},
+	{-229.96399, 233.3747, -65.13033},
+	{-234.46353, 234.16916, -64.22896},
+	{-238.12138, 234.6013, -63.383343},
+	{-241.07988, 234.73541, -62.583885},
+	{-243.45374, 234.62396, -61.823658},
+	{-245.33626, 234.30988, -61.09751},
+	{-246.80379, 233.82838, -60.40151},
+	{117.83224, -67.2933, -12.874905},
+	{49.64184, -6.09342, -22.42015},
+	{12.5878935, 26.365324, -27.202686},
+	{-12.7425585, 47.687527, -30.132675},
+	{-36.28566, 66.387146, -32.63218},
+	{-59.861782, 84.366615, -35.03663},
+	{-80.39382, 99.67937, -37.03919},
+	{-97.94988, 112.53811, -38.67396},
+	{-112.93692, 123.33843, -40.003048},
+	{-125.76106, 132.43935, -41.082287},
+	{-136.77245, 140.13747, -41.957474},
+	{-146.26132, 146.67232, -42.665314},
+	{-154.46565, 152.23656, -43.235146},
+	{-161.58047, 156.98572, -43.69048},
+	{-167.7661, 161.0461, -44.050278},
+	{-173.15508, 164.52101, -44.32994},
+	{164.8852, -122.00028, 2.3341122},
+	{98.23631, -61.982063, -7.1561856},
+	{59.588013, -27.221716, -12.673316},
+	{32.915615, -3.631367, -16.357655},
+	{12.07101, 14.32769, -19.102013},
+	{-7.1362667, 30.21147, -21.488077},
+	{-26.89592, 45.89594, -23.829735},
+	{-44.876076, 59.90606, -25.902487},
+	{-60.727654, 72.105576, -27.687355},
+	{-74.645134, 82.70781, -29.21936},
+	{-86.87315, 91.93924, -30.535128},
+	{-97.640816, 100.000656, -31.66697},
+	{-107.14819, 107.06233, -32.642212},
+	{-115.56601, 113.26677, -33.483692},
+	{-123.038826, 118.73294, -34.210445},
+	{-129.68895, 123.56038, -34.83836},
+	{178.26054, -138.90337, 7.577685},
+	{120.25043, -87.60151, -0.033830896},
+	{83.06193, -54.08988, -5.3558855},
+	{56.68383, -30.544075, -9.104847},
+	{36.372337, -12.670442, -11.932214},
+	{19.043976, 2.2012892, -14.25967},
+	{2.3399675, 16.021576, -16.401037},
+	{-14.500162, 29.507193, -18.477972},
+	{-29.936491, 41.69132, -20.344526},
+	{-43.738533, 52.48589, -21.98846},
+	{-56.03956, 62.036377, -23.433401},
+	{-67.00961, 70.50022, -24.704643},
+	{-76.81126, 78.02019, -25.82509},
+	{-85.589386, 84.71999, -26.814596},
+	{-93.46998, 90.705284, -27.690128},
+	{-100.56158, 96.06593, -28.466135},
+	{181.7254, -144.69865, 9.898924},
+	{131.20927, -100.79559, 3.8084135},
+	{96.63794, -69.839134, -0.9906731},
+	{71.22738, -47.122818, -4.6007032},
+	{51.538784, -29.676125, -7.3812847},
+	{34.988514, -15.272691, -9.668164},
+	{19.995516, -2.5494196, -11.675201},
+	{5.026631, 9.727427, -13.59856},
+	{-9.593033, 21.444492, -15.426565},
+	{-22.943636, 32.035297, -17.073893},
+	{-34.975468, 41.515076, -18.543594},
+	{-45.801918, 49.99925, -19.854027},
+	{-55.553177, 57.60608, -21.023945},
+	{-64.352325, 64.44268, -22.070366},
+	{-72.30955, 70.60266, -23.0082},
+	{-79.521484, 76.16684, -23.85035},
+	{181.16872, -145.94797, 11.003304},
+	{136.25012, -107.40034, 5.932484},
+	{104.977905, -79.731094, 1.8327118},
+	{80.8242, -58.178658, -1.5538068},
+	{61.809452, -41.30443, -4.2367115},
+	{45.911816, -27.379837, -6.4546566},
+	{31.783443, -15.244869, -8.382684},
+	{18.275291, -3.9548903, -10.167694},
+	{4.7664537, 7.0231547, -11.895415},
+	{-8.01535, 17.259542, -13.503026},
+	{-19.664143, 26.522495, -14.955732},
+	{-30.221859, 34.875744, -16.263577},
+	{-39.788895, 42.415035, -17.441517},
+	{-48.469692, 49.23309, -18.50408},
+	{-56.3612, 55.41321, -19.464367},
+	{-63.550064, 61.028442, -20.333918},
+	{180.03313, -146.42892, 11.850724},
+	{138.59508, -110.991, 7.2649274},
+	{109.937256, -85.86176, 3.6749687},
+	{87.38419, -65.86411, 0.61103356},
+	{69.097084, -49.653713, -1.9468122},
+	{53.807503, -36.223545, -4.0813713},
+	{40.29927, -24.549355, -5.9385653},
+	{27.73142, -13.918624, -7.6261377},
+	{15.286903, -3.6762753, -9.24563},
+	{3.1714556, 6.105901, -10.788939},
+	{-8.05751, 15.0932865, -12.206225},
+	{-18.302164, 23.25208, -13.49245},
+	{-27.631775, 30.65491, -14.658753},
+	{-36.134106, 37.38162, -15.717445},
+	{-43.894733, 43.5066, -16.680061},
+	{-50.991856, 49.096203, -17.556952},
+	{177.9533, -145.77399, 12.361807},
+	{139.38177, -112.82663, 8.131351},
+	{112.86312, -89.73265, 4.9287505},
+	{91.87328, -71.26281, 2.1815698},
+	{74.41344, -55.834984, -0.22048563},
+	{59.70905, -42.908634, -2.2639446},
+	{46.72518, -31.65172, -4.051067},
+	{34.79773, -21.49291, -5.664528},
+	{23.211699, -11.859163, -7.1909904},
+	{11.800276, -2.5725818, -8.659234},
+	{1.0086962, 6.109594, -10.031659},
+	{-8.911226, 14.047881, -11.287273},
+	{-17.984688, 21.283325, -12.432197},
+	{-26.283579, 27.883638, -13.476682},
+	{-33.883446, 33.915314, -14.4308815},
+	{-40.855152, 39.438877, -15.30411},
+	{175.12347, -144.21274, 12.60253},
+	{139.33682, -113.70543, 8.735861},
+	{114.566414, -92.24128, 5.8260355},
+	{94.85579, -75.00532, 3.324283},
+	{78.33361, -60.48278, 1.1085836},
+	{64.20897, -48.075493, -0.83674264},
+	{51.700935, -37.215538, -2.5536},
+	{40.271317, -27.442364, -4.103029},
+	{29.35086, -18.293617, -5.5528364},
+	{18.583836, -9.469647, -6.94879},
+	{8.248985, -1.115242, -8.270319},
+	{-1.3475674, 6.593187, -9.491078},
+	{-10.1618395, 13.648576, -10.609792},
+	{-18.249058, 20.106136, -11.634639},
+	{-25.675613, 26.025179, -12.574528},
+	{-32.505917, 31.46107, -13.437857},
+	{172.10123, -142.35265, 12.727772},
+	{138.95653, -114.16963, 9.215862},
+	{115.502754, -93.890434, 6.4960823},
+	{96.84228, -77.65268, 4.1838007},
+	{81.19294, -63.96906, 2.1381576},
+	{67.67611, -52.12422, 0.30372494},
+	{55.615185, -41.65059, -1.3422444},
+	{44.611843, -32.221127, -2.8322005},
+	{34.21086, -23.463148, -4.2181273},
+	{24.005827, -15.049838, -5.548451},
+	{14.125502, -7.0265684, -6.8172097},
+	{4.848868, 0.44842455, -8.000958},
+	{-3.7104154, 7.3202424, -9.091206},
+	{-11.586008, 13.628464, -10.093625},
+	{-18.835642, 19.42565, -11.01597},
+	{-25.517931, 24.762522, -11.86582},
+	{169.14836, -140.47807, 12.810964},
+	{138.19925, -114.18138, 9.564857},
+	{115.85317, -94.88281, 6.9919806},
+	{98.133705, -79.53514, 4.8449974},
+	{83.25063, -66.579666, 2.9425886},
+	{70.34923, -55.3142, 1.2245022},
+	{58.7251, -45.228973, -0.3479576},
+	{48.105087, -36.118626, -1.780982},
+	{38.129227, -27.690786, -3.1112356},
+	{28.407782, -19.637281, -4.3829136},
+	{18.952442, -11.927648, -5.6008263},
+	{9.993273, -4.687758, -6.7464066},
+	{1.6817235, 2.0015476, -7.807313},
+	{-5.9864235, 8.159118, -8.785999},
+	{-13.060244, 13.830856, -9.689097},
+	{-19.593048, 19.063238, -10.52344},
+	{166.33727, -138.67004, 12.875051},
+	{137.13643, -113.823586, 9.805669},
+	{115.880684, -95.5056, 7.386365},
+	{98.95999, -80.90298, 5.3714924},
+	{84.730736, -68.558945, 3.584444},
+	{72.388054, -57.819443, 1.9706179},
+	{61.20657, -48.13745, 0.4766917},
+	{50.944134, -39.332638, -0.90045804},
+	{41.332397, -31.19595, -2.180284},
+	{32.027634, -23.45836, -3.3995085},
+	{22.957449, -16.036743, -4.5700502},
+	{14.308933, -9.029118, -5.677407},
+	{6.237338, -2.5193777, -6.708802},
+	{-1.2295238, 3.4891033, -7.6633034},
+	{-8.131124, 9.035015, -8.546353},
+	{-14.51583, 14.160882, -9.364102},
+	{163.64407, -136.90817, 12.918149},
+	{135.89986, -113.24274, 9.976163},
+	{115.682976, -95.867, 7.7071366},
+	{99.40748, -81.8521, 5.788292},
+	{85.77242, -70.05882, 4.10264},
+	{73.93124, -59.790028, 2.580477},
+	{63.181904, -50.50656, 1.1649427},
+	{53.26415, -42.00296, -0.15584403},
+	{43.97665, -34.13224, -1.3885546},
+	{35.03288, -26.673864, -2.5605729},
+	{26.31017, -19.515476, -3.687273},
+	{17.958551, -12.731693, -4.757429},
+	{10.119996, -6.3981614, -5.759473},
+	{2.8474357, -0.53566766, -6.689835},
+	{-3.886808, 4.885886, -7.552587},
+	{-10.126383, 9.905271, -8.35323},
+	{161.02448, -135.15154, 12.933327},
+	{134.58366, -112.5441, 10.103669},
+	{115.278786, -95.99036, 7.961182},
+	{99.58291, -82.49835, 6.1247463},
+	{86.497826, -71.21136, 4.5305815},
+	{75.09534, -61.35143, 3.085889},
+	{64.74083, -52.432453, 1.7413138},
+	{55.16229, -44.230923, 0.47819957},
+	{46.17335, -36.61056, -0.7092848},
+	{37.54654, -29.40236, -1.8382591},
+	{29.138266, -22.4852, -2.924327},
+	{21.065435, -15.913413, -3.9589574},
+	{13.452688, -9.751885, -4.932102},
+	{6.367306, -4.03154, -5.8386555},
+	{-0.2050205, 1.2681693, -6.6811905},
+	{-6.3032703, 6.182335, -7.464584},
+	{158.45518, -133.38246, 12.91918},
+	{133.2365, -111.783585, 10.203188},
+	{114.70116, -95.91378, 8.1588},
+	{99.57331, -82.93547, 6.4043894},
+	{86.96958, -72.08499, 4.885798},
+	{75.9569, -62.586403, 3.5079026},
+	{65.962395, -53.999462, 2.227128},
+	{56.710175, -46.0917, 1.020537},
+	{48.003853, -38.712917, -0.12251324},
+	{39.660954, -31.73321, -1.2115259},
+	{31.538507, -25.038092, -2.2597985},
+	{23.72489, -18.665201, -3.2608378},
+	{16.329704, -12.670388, -4.205806},
+	{9.424684, -7.088124, -5.0890274},
+	{3.0086515, -1.9071771, -5.9116306},
+	{-2.9524837, 2.9037597, -6.67786},
+	{155.93416, -131.60602, 12.879624},
+	{131.87598, -110.98355, 10.281354},
+	{113.99527, -95.68751, 8.313012},
+	{99.4205, -83.20887, 6.638798},
+	{87.23553, -72.73201, 5.181676},
+	{76.58356, -63.567516, 3.864795},
+	{66.9147, -55.279884, 2.6405237},
+	{57.963478, -47.6439, 1.4858913},
+	{49.529167, -40.50139, 0.38696587},
+	{41.44615, -33.73463, -0.6642322},
+	{33.58507, -27.245104, -1.6771568},
+	{26.011696, -21.05827, -2.6465402},
+	{18.824661, -15.223856, -3.5642707},
+	{12.093685, -9.775649, -4.4247074},
+	{5.828371, -4.710109, -5.2277856},
+	{-5.3900603e-06, 3.8933763e-06, -5.977081},
+	{-50.222775, 94.65185, -41.875286},
+	{-62.69432, 97.29302, -38.41772},
+	{-72.50322, 99.731964, -36.285027},
+	{-81.41308, 102.656265, -34.954525},
+	{-88.8826, 105.25358, -34.002975},
+	{-95.020256, 107.3789, -33.257294},
+	{-100.046104, 109.05838, -32.635986},
+	{-104.16673, 110.35553, -32.096165},
+	{-107.55279, 111.334946, -31.613327},
+	{-110.34032, 112.05302, -31.172533},
+	{-112.63707, 112.55659, -30.764175},
+	{-114.52858, 112.88395, -30.381813},
+	{-116.08326, 113.06625, -30.02095},
+	{-117.356224, 113.128876, -29.678347},
+	{-118.39226, 113.092545, -29.351595},
+	{-119.228004, 112.97427, -29.038853},
+	{74.38123, -44.88605, -4.158265},
+	{34.05775, -10.275646, -9.504854},
+	{11.373263, 8.78729, -12.338568},
+	{-4.1153326, 21.431665, -14.128356},
+	{-16.814245, 31.434275, -15.495004},
+	{-28.313162, 40.2111, -16.674942},
+	{-38.28752, 47.6624, -17.654678},
+	{-46.821804, 53.927574, -18.456558},
+	{-54.120884, 59.203255, -19.111456},
+	{-60.38249, 63.66366, -19.64647},
+	{-65.77548, 67.45167, -20.083656},
+	{-70.43926, 70.68227, -20.440638},
+	{-74.48791, 73.44779, -20.73147},
+	{-78.01477, 75.82271, -20.967405},
+	{-81.09656, 77.86745, -21.157518},
+	{-83.79665, 79.63142, -21.309174},
+	{96.91952, -70.48437, 2.8976946},
+	{59.06496, -37.98554, -2.1080441},
+	{36.097965, -18.30879, -5.186461},
+	{20.095846, -4.7612333, -7.2895684},
+	{7.72313, 5.5322714, -8.865579},
+	{-2.8764093, 14.144326, -10.166527},
+	{-12.61266, 21.86276, -11.32149},
+	{-21.355795, 28.679562, -12.33208},
+	{-29.063993, 34.618397, -13.203198},
+	{-35.841034, 39.788902, -13.952769},
+	{-41.807346, 44.301792, -14.598672},
+	{-47.073883, 48.25407, -15.156499},
+	{-51.736946, 51.727577, -15.639375},
+	{-55.87845, 54.790653, -16.05824},
+	{-59.56764, 57.50026, -16.422203},
+	{-62.863026, 59.90402, -16.738865},
+	{103.3605, -78.60137, 5.4301567},
+	{69.91383, -50.256256, 1.2577797},
+	{48.176815, -31.64669, -1.6332623},
+	{32.61705, -18.388403, -3.7139745},
+	{20.557417, -8.216307, -5.308764},
+	{10.511933, 0.1306905, -6.610586},
+	{1.5448545, 7.4374957, -7.743059},
+	{-6.745263, 14.066, -8.76487},
+	{-14.259092, 19.998634, -9.674768},
+	{-20.97852, 25.257776, -10.476915},
+	{-26.975245, 29.918554, -11.183453},
+	{-32.333218, 34.05808, -11.806747},
+	{-37.131298, 37.745388, -12.3578615},
+	{-41.43928, 41.03999, -12.846333},
+	{-45.317657, 43.99256, -13.2802925},
+	{-48.818436, 46.64615, -13.666655},
+	{105.064606, -81.564, 6.623674},
+	{75.11294, -56.42453, 3.0526526},
+	{55.00934, -39.325222, 0.45905495},
+	{40.16012, -26.67757, -1.5134044},
+	{28.52978, -16.829971, -3.0612469},
+	{18.888062, -8.749422, -4.3327236},
+	{10.442217, -1.7724638, -5.428292},
+	{2.702817, 4.5140586, -6.412347},
+	{-4.4557266, 10.246537, -7.307081},
+	{-10.960537, 15.407967, -8.110521},
+	{-16.826317, 20.0323, -8.8282175},
+	{-22.112192, 24.17812, -9.469456},
+	{-26.8822, 27.903198, -10.043385},
+	{-31.196001, 31.259304, -10.558217},
+	{-35.106674, 34.291462, -11.021114},
+	{-38.660618, 37.038437, -11.438262},
+	{104.94116, -82.49818, 7.274293},
+	{77.66771, -59.743473, 4.116343},
+	{59.077717, -44.039474, 1.7928215},
+	{45.044205, -32.127815, -0.038619086},
+	{33.891422, -22.683245, -1.5153787},
+	{24.621819, -14.889353, -2.741647},
+	{16.56066, -8.185339, -3.798195},
+	{9.259573, -2.196867, -4.7413807},
+	{2.4879057, 3.280268, -5.602842},
+	{-3.7539124, 8.2775755, -6.3879075},
+	{-9.436057, 12.797098, -7.0971074},
+	{-14.591245, 16.878021, -7.7365637},
+	{-19.270294, 20.56806, -8.313715},
+	{-23.524302, 23.912336, -8.83562},
+	{-27.400173, 26.95106, -9.308598},
+	{-30.939627, 29.719341, -9.738185},
+	{104.018036, -82.50717, 7.6627507},
+	{78.870155, -61.599064, 4.8018737},
+	{61.542183, -47.038933, 2.6896155},
+	{48.31579, -35.8627, 1.0001303},
+	{37.660534, -26.85527, -0.3950797},
+	{28.749525, -19.357424, -1.5696816},
+	{21.013712, -12.903126, -2.5858386},
+	{14.054826, -7.1626043, -3.491221},
+	{7.628057, -1.927231, -4.3171086},
+	{1.6601806, 2.8827577, -5.075808},
+	{-3.8228607, 7.271038, -5.768008},
+	{-8.827115, 11.257536, -6.396763},
+	{-13.390549, 14.880295, -6.9678946},
+	{-17.55674, 18.1786, -7.487452},
+	{-21.367346, 21.188524, -7.9610233},
+	{-24.860126, 23.942043, -8.393591},
+	{102.7069, -82.03655, 7.9039507},
+	{79.3157, -62.627514, 5.270344},
+	{63.042465, -49.007027, 3.3232493},
+	{50.536633, -38.48278, 1.7565664},
+	{40.376305, -29.917467, 0.4453275},
+	{31.814531, -22.718292, -0.67485034},
+	{24.37167, -16.500202, -1.6503121},
+	{17.697838, -10.976732, -2.5202827},
+	{11.5620985, -5.9539933, -3.3128314},
+	{5.853956, -1.3295118, -4.043214},
+	{0.5715654, 2.918152, -4.7146664},
+	{-4.2770543, 6.7985144, -5.3285728},
+	{-8.716519, 10.339758, -5.8891463},
+	{-12.783497, 13.575831, -6.4015236},
+	{-16.51506, 16.53918, -6.870656},
+	{-19.945566, 19.259068, -7.3010416},
+	{101.18531, -81.282646, 8.050167},
+	{79.30147, -63.146343, 5.6021605},
+	{63.930885, -50.318546, 3.7884312},
+	{52.055584, -40.358707, 2.3246858},
+	{42.356766, -32.206318, 1.0912588},
+	{34.134457, -25.303102, 0.026103335},
+	{26.963749, -19.311398, -0.90899086},
+	{20.53993, -13.985382, -1.7453346},
+	{14.652147, -9.15006, -2.507202},
+	{9.178249, -4.697888, -3.2101007},
+	{4.090338, -0.59114456, -3.859511},
+	{-0.60448676, 3.1795344, -4.4567094},
+	{-4.919032, 6.633659, -5.0045133},
+	{-8.8832855, 9.800074, -5.5072007},
+	{-12.53021, 12.707978, -5.9691563},
+	{-15.891183, 15.384245, -6.394455},
+	{99.57123, -80.376686, 8.136644},
+	{79.00314, -63.34316, 5.84482},
+	{64.41473, -51.193375, 4.1404967},
+	{53.09217, -41.722713, 2.76299},
+	{43.810047, -33.941284, 1.5980861},
+	{35.909904, -27.3212, 0.5857247},
+	{28.99605, -21.547474, -0.30974516},
+	{22.798647, -16.40506, -1.1139576},
+	{17.127687, -11.738215, -1.8473862},
+	{11.86237, -7.4431696, -2.5244682},
+	{6.9571724, -3.471826, -3.151991},
+	{2.411439, 0.18961175, -3.7317986},
+	{-1.7806681, 3.55535, -4.26584},
+	{-5.6425724, 6.649284, -4.757575},
+	{-9.20346, 9.497636, -5.2108755},
+	{-12.492055, 12.125147, -5.629439},
+	{97.93483, -79.398346, 8.185387},
+	{78.52011, -63.324715, 6.0255737},
+	{64.61954, -51.76461, 4.4129105},
+	{53.786827, -42.72295, 3.1086452},
+	{44.879047, -35.272453, 2.00345},
+	{37.276657, -28.914173, 1.0394449},
+	{30.605488, -23.348873, 0.18218195},
+	{24.617231, -18.379318, -0.5912533},
+	{19.140617, -13.866825, -1.2980614},
+	{14.061733, -9.715085, -1.9510787},
+	{9.325312, -5.8710337, -2.5575705},
+	{4.9221964, -2.3160162, -3.1199894},
+	{0.8485037, 0.96229607, -3.639939},
+	{-2.9133565, 3.9834037, -4.1201587},
+	{-6.38901, 6.770742, -4.5640445},
+	{-9.604788, 9.347123, -4.974956},
+	{96.31197, -78.39051, 8.209024},
+	{77.91322, -63.156853, 6.1614175},
+	{64.627426, -52.119247, 4.6275353},
+	{54.23301, -43.45782, 3.3862612},
+	{45.662235, -36.302986, 2.3331106},
+	{38.332005, -30.183268, 1.4124901},
+	{31.887661, -24.813816, 0.59107995},
+	{26.094635, -20.00778, -0.15294887},
+	{20.795525, -15.638681, -0.8346578},
+	{15.884951, -11.618546, -1.4652123},
+	{11.303679, -7.893254, -2.0517697},
+	{7.0354857, -4.440308, -2.597225},
+	{3.0755308, -1.2472974, -3.103127},
+	{-0.589516, 1.7019895, -3.5716708},
+	{-3.9818947, 4.428355, -4.005809},
+	{-7.1257463, 6.9528475, -4.408593},
+	{94.71886, -77.37483, 8.214727},
+	{77.223816, -62.88455, 6.2640724},
+	{64.49378, -52.315994, 4.7991443},
+	{54.494404, -43.994305, 3.6125655},
+	{46.22865, -37.10506, 2.6050375},
+	{39.146908, -31.202164, 1.7231483},
+	{32.91244, -26.014097, 0.93466944},
+	{27.301954, -21.362242, 0.21846338},
+	{22.167053, -17.127525, -0.43949553},
+	{17.41015, -13.229274, -1.0489949},
+	{12.971703, -9.614701, -1.6167456},
+	{8.83028, -6.25875, -2.1458864},
+	{4.9790726, -3.1482508, -2.6380079},
+	{1.4072161, -0.26905036, -3.0949507},
+	{-1.9044267, 2.3972433, -3.5192637},
+	{-4.977968, 4.870045, -3.913717},
+	{93.161934, -76.362236, 8.206802},
+	{76.48059, -62.539272, 6.341832},
+	{64.25626, -52.39489, 4.937857},
+	{54.615723, -44.379417, 3.7992973},
+	{46.62784, -37.730415, 2.8320816},
+	{39.7735, -32.024906, 1.9847941},
+	{33.732525, -27.003813, 1.2262679},
+	{28.292074, -22.496206, 0.5360284},
+	{23.310167, -18.387882, -0.09947558},
+	{18.695005, -14.603362, -0.6891606},
+	{14.388567, -11.092248, -1.2391803},
+	{10.365955, -7.8280783, -1.7527463},
+	{6.618341, -4.7969003, -2.231492},
+	{3.1359377, -1.9856725, -2.6770468},
+	{-0.0977682, 0.6219338, -3.0916138},
+	{-3.1029992, 3.0438154, -3.477703},
+	{91.643456, -75.35871, 8.188148},
+	{75.7032, -62.14281, 6.400546},
+	{63.94139, -52.3842, 5.050857},
+	{54.62938, -44.647243, 3.954967},
+	{46.896084, -38.21689, 3.02363},
+	{40.250595, -32.69168, 2.2073517},
+	{34.388107, -27.824194, 1.4760001},
+	{29.105526, -23.450708, 0.80974144},
+	{24.266485, -19.461151, 0.19535999},
+	{19.78308, -15.783323, -0.3756366},
+	{15.599257, -12.3692045, -0.9089241},
+	{11.688032, -9.191795, -1.4076705},
+	{8.038848, -6.236555, -1.8735238},
+	{4.642182, -3.4909983, -2.3079848},
+	{1.4835348, -0.9404318, -2.71298},
+	{-1.4555643, 1.4316332, -3.0907786},
+	{90.1641, -74.36814, 8.160953},
+	{74.90494, -61.71018, 6.4443502},
+	{63.56846, -52.304607, 5.143436},
+	{54.559235, -44.82289, 4.085855},
+	{47.0604, -38.59265, 3.186664},
+	{40.607544, -33.232853, 2.3983066},
+	{34.9101, -28.50691, 1.6916282},
+	{29.774, -24.25783, 1.047405},
+	{25.068308, -20.379532, 0.45275703},
+	{20.707977, -16.802055, -0.10061749},
+	{16.638597, -13.479167, -0.61809623},
+	{12.8319845, -10.383826, -1.1027583},
+	{9.276215, -7.5011144, -1.5562379},
+	{5.961648, -4.8188424, -1.9799482},
+	{2.8752034, -2.3235395, -2.375602},
+	{-2.6640603e-06, 1.9246584e-06, -2.7452514},
+	{-23.218002, 52.139965, -24.563467},
+	{-36.00152, 57.807518, -23.144413},
+	{-43.139004, 60.21434, -22.001503},
+	{-49.03834, 62.379177, -21.256},
+	{-53.92285, 64.23625, -20.722946},
+	{-57.944187, 65.76413, -20.308268},
+	{-61.262074, 66.99901, -19.965769},
+	{-64.01291, 67.98799, -19.670753},
+	{-66.30583, 68.77464, -19.409004},
+	{-68.22664, 69.3957, -19.17182},
+	{-69.84277, 69.881195, -18.953606},
+	{-71.20757, 70.25548, -18.7506},
+	{-72.36353, 70.53823, -18.56019},
+	{-73.34489, 70.74546, -18.380486},
+	{-74.17937, 70.89019, -18.21009},
+	{-74.88969, 70.98312, -18.047937},
+	{57.855278, -36.20836, -1.1502235},
+	{27.610134, -11.090773, -4.9994006},
+	{10.620675, 2.824052, -7.0809865},
+	{-0.7884555, 11.980289, -8.401502},
+	{-9.526231, 18.825294, -9.354977},
+	{-16.912384, 24.472473, -10.1228695},
+	{-23.25763, 29.229664, -10.755745},
+	{-28.692604, 33.23949, -11.276432},
+	{-33.359665, 36.63464, -11.705781},
+	{-37.385883, 39.52616, -12.0610695},
+	{-40.877247, 42.00346, -12.356097},
+	{-43.92038, 44.13787, -12.601795},
+	{-46.585785, 45.986435, -12.806842},
+	{-48.930977, 47.595024, -12.978169},
+	{-51.00314, 49.000828, -13.121358},
+	{-52.84121, 50.234207, -13.240932},
+	{71.32777, -51.33039, 3.013396},
+	{43.633434, -28.424263, -0.44334674},
+	{26.776747, -14.436832, -2.6080453},
+	{15.092271, -4.8027916, -4.097671},
+	{6.209858, 2.4422364, -5.2085576},
+	{-1.0602467, 8.288959, -6.0956535},
+	{-7.367376, 13.281174, -6.8457766},
+	{-12.941692, 17.632788, -7.4937954},
+	{-17.854889, 21.427284, -8.053505},
+	{-22.187487, 24.74383, -8.537743},
+	{-26.018896, 27.65424, -8.958066},
+	{-29.4195, 30.219631, -9.324278},
+	{-32.449448, 32.490963, -9.644534},
+	{-35.159508, 34.510513, -9.925591},
+	{-37.592422, 36.313404, -10.173057},
+	{-39.7842, 37.928925, -10.391596},
+	{75.02591, -56.1074, 4.5416265},
+	{50.341248, -35.89227, 1.5922618},
+	{34.550068, -22.828661, -0.40475553},
+	{23.276484, -13.5007515, -1.8539099},
+	{14.610786, -6.3672504, -2.9660537},
+	{7.5484304, -0.6018867, -3.86354},
+	{1.5043533, 4.2791157, -4.620566},
+	{-3.8547683, 8.556162, -5.28109},
+	{-8.65574, 12.349207, -5.864302},
+	{-12.9501295, 15.715769, -6.3795037},
+	{-16.794085, 18.710411, -6.8354354},
+	{-20.243288, 21.383337, -7.2401156},
+	{-23.347937, 23.778154, -7.600511},
+	{-26.151737, 25.931974, -7.922555},
+	{-28.69224, 27.876188, -8.211273},
+	{-31.001583, 29.63735, -8.4709215},
+	{75.880615, -57.851254, 5.2879333},
+	{53.517227, -39.650032, 2.6902306},
+	{38.872387, -27.601894, 0.88404155},
+	{28.169214, -18.761572, -0.47729102},
+	{19.832703, -11.886842, -1.5484161},
+	{13.012806, -6.290866, -2.4241886},
+	{7.200162, -1.5565677, -3.1657743},
+	{2.0765448, 2.5789316, -3.8129728},
+	{-2.5335038, 6.2660956, -4.389039},
+	{-6.698471, 9.572603, -4.9046044},
+	{-10.459213, 12.541305, -5.3664303},
+	{-13.85942, 15.213257, -5.780983},
+	{-16.941202, 17.625847, -6.1541605},
+	{-19.742474, 19.81173, -6.491129},
+	{-22.296461, 21.798973, -6.7963414},
+	{-24.631979, 23.6116, -7.0736213},
+	{75.639626, -58.392857, 5.7118773},
+	{55.0853, -41.717876, 3.3606045},
+	{41.431694, -30.535614, 1.7110405},
+	{31.313454, -22.20549, 0.44425774},
+	{23.33654, -15.632987, -0.57305723},
+	{16.770876, -10.237884, -1.415734},
+	{11.171953, -5.6606326, -2.1338356},
+	{6.252576, -1.6663327, -2.7616377},
+	{1.832699, 1.8950881, -3.3216443},
+	{-2.1802151, 5.1060715, -3.8264322},
+	{-5.8278623, 8.008997, -4.282533},
+	{-9.144925, 10.637939, -4.695217},
+	{-12.166871, 13.025114, -5.0694857},
+	{-14.926873, 15.199454, -5.4098525},
+	{-17.454617, 17.186245, -5.720279},
+	{-19.77617, 19.007355, -6.004207},
+	{74.908966, -58.368885, 5.974019},
+	{55.823544, -42.90711, 3.806211},
+	{43.00162, -32.43805, 2.2794037},
+	{33.41631, -24.570787, 1.0968633},
+	{25.795036, -18.303009, 0.13512269},
+	{19.481958, -13.116528, -0.6708452},
+	{14.08495, -8.697874, -1.362523},
+	{9.346557, -4.8384566, -1.9691564},
+	{5.096612, -1.3983338, -2.5110962},
+	{1.2332039, 1.7094582, -3.0012672},
+	{-2.2944548, 4.5324664, -3.446822},
+	{-5.517311, 7.1014996, -3.852427},
+	{-8.465433, 9.444531, -4.222349},
+	{-11.168044, 11.587369, -4.5605516},
+	{-13.6518755, 13.552948, -4.8705797},
+	{-15.940712, 15.361311, -5.1555486},
+	{73.94767, -58.054234, 6.144739},
+	{56.093533, -43.593643, 4.1206574},
+	{43.976593, -33.71879, 2.6912258},
+	{34.859737, -26.253899, 1.5796214},
+	{27.569517, -20.270298, 0.66936135},
+	{21.499483, -15.288242, -0.09989979},
+	{16.293716, -11.024875, -0.7645578},
+	{11.720194, -7.2938294, -1.3497518},
+	{7.6215696, -3.9669433, -1.8735399},
+	{3.8960001, -0.95912665, -2.348248},
+	{0.485791, 1.7808008, -2.781406},
+	{-2.641179, 4.283781, -3.1776032},
+	{-5.5112276, 6.5747423, -3.5405707},
+	{-8.150256, 8.676844, -3.8738084},
+	{-10.582496, 10.611014, -4.1805053},
+	{-12.82979, 12.395737, -4.463497},
+	{72.874306, -57.577515, 6.2580166},
+	{56.08198, -43.97163, 4.352323},
+	{44.57302, -34.60172, 3.0020347},
+	{35.866844, -27.485245, 1.9497297},
+	{28.875893, -21.757133, 1.0848353},
+	{23.03338, -16.967512, 0.35011196},
+	{18.00793, -12.853062, -0.28818637},
+	{13.586838, -9.24384, -0.85239536},
+	{9.62503, -6.0225883, -1.3585476},
+	{6.024659, -3.1086, -1.8180395},
+	{2.7251425, -0.44977355, -2.238374},
+	{-0.30836383, 1.9860317, -2.624228},
+	{-3.100485, 4.2221475, -2.979028},
+	{-5.6744113, 6.2795453, -3.3058903},
+	{-8.05223, 8.177425, -3.6076994},
+	{-10.254117, 9.932918, -3.8870502},
+	{71.74982, -57.006943, 6.3326335},
+	{55.893345, -44.15002, 4.5284863},
+	{44.91771, -35.217793, 3.2442229},
+	{36.574055, -28.405241, 2.2418737},
+	{29.851645, -22.904531, 1.4163562},
+	{24.218105, -18.291431, 0.71294045},
+	{19.361145, -14.31719, 0.099529326},
+	{15.081839, -10.823053, -0.44456655},
+	{11.245386, -7.7006273, -0.9338368},
+	{7.7590985, -4.8741407, -1.3787286},
+	{4.562247, -2.2923589, -1.7864695},
+	{1.6178709, 0.077667795, -2.1617682},
+	{-1.0985069, 2.2587516, -2.5079165},
+	{-3.6080875, 4.2702036, -2.8277369},
+	{-5.9311223, 6.129732, -3.1238513},
+	{-8.086335, 7.853285, -3.3986454},
+	{70.60963, -56.383533, 6.3803678},
+	{55.58962, -44.193867, 4.66558},
+	{45.089, -35.647865, 3.4377897},
+	{37.068493, -29.103334, 2.4780774},
+	{30.587456, -23.80523, 1.6867185},
+	{25.144371, -19.352272, 1.0112094},
+	{20.44337, -15.50804, 0.42072818},
+	{16.29601, -12.121915, -0.10441057},
+	{12.575389, -9.092066, -0.5776746},
+	{9.193807, -6.347234, -1.0087047},
+	{6.091832, -3.8379116, -1.4043525},
+	{3.2314384, -1.5310133, -1.769268},
+	{0.58767617, 0.59619683, -2.10668},
+	{-1.8594322, 2.5619164, -2.419206},
+	{-4.128595, 4.3825855, -2.7092457},
+	{-6.2372675, 6.073115, -2.979002},
+	{69.47527, -55.73326, 6.409043},
+	{55.209393, -44.144016, 4.7740574},
+	{45.137424, -35.944153, 3.5956721},
+	{37.407906, -29.638742, 2.6728942},
+	{31.144968, -24.52212, 1.9113317},
+	{25.87535, -20.214142, 1.2605803},
+	{21.317913, -16.48938, 0.6909193},
+	{17.293032, -13.20389, 0.18339258},
+	{13.6799, -10.26069, -0.27482083},
+	{10.395049, -7.5921826, -0.6927661},
+	{7.380901, -5.15082, -1.0769231},
+	{4.5992002, -2.9038837, -1.4318205},
+	{2.0245135, -0.8286799, -1.7606359},
+	{-0.36252302, 1.092309, -2.0658524},
+	{-2.5793812, 2.8744855, -2.3496876},
+	{-4.642408, 4.5318475, -2.6141849},
+	{68.35965, -55.072697, 6.423969},
+	{54.777855, -44.02744, 4.860932},
+	{45.09679, -36.141674, 3.7265801},
+	{37.631836, -30.051983, 2.8362768},
+	{31.567259, -25.099083, 2.100921},
+	{26.456066, -20.922644, 1.4721618},
+	{22.030615, -17.307318, 0.92128634},
+	{18.11915, -14.11506, 0.42994866},
+	{14.605902, -11.25276, -0.014224097},
+	{11.410828, -8.65566, -0.4198741},
+	{8.47824, -6.278023, -0.7931724},
+	{5.770169, -4.087772, -1.1385047},
+	{3.26089, -2.0623524, -1.4589897},
+	{0.9313031, -0.18466724, -1.7570207},
+	{-1.2351389, 1.5598763, -2.0346715},
+	{-3.2538218, 3.1844797, -2.293847},
+	{67.27023, -54.412373, 6.42881},
+	{54.312153, -43.86274, 4.931129},
+	{44.99043, -36.264694, 3.8365834},
+	{37.7681, -30.37157, 2.9752355},
+	{31.885166, -25.567501, 2.263148},
+	{26.919283, -21.510916, 1.6540076},
+	{22.615355, -17.995886, 1.1200632},
+	{18.808836, -14.889755, 0.64351666},
+	{15.388414, -12.102784, 0.21235308},
+	{12.2768955, -9.572476, -0.18179397},
+	{9.420284, -7.254619, -0.54486823},
+	{6.781141, -5.117852, -0.8811218},
+	{4.3336535, -3.1398544, -1.1936107},
+	{2.058832, -1.303825, -1.4846612},
+	{-0.059217863, 0.40422013, -1.7562383},
+	{-2.0350647, 1.996797, -2.0101283},
+	{66.21111, -53.75898, 6.4261546},
+	{53.824314, -43.66316, 4.988227},
+	{44.834908, -36.33052, 3.9300456},
+	{37.83674, -30.618082, 3.0948384},
+	{32.121258, -25.950329, 2.4036126},
+	{27.289309, -22.003515, 1.8120757},
+	{23.097597, -18.58063, 1.2934186},
+	{19.388285, -15.554044, 0.8303561},
+	{16.054153, -12.837089, 0.41118848},
+	{13.020515, -10.369207, 0.02775867},
+	{10.234851, -8.107438, -0.3257181},
+	{7.660318, -6.021098, -0.6533872},
+	{5.271155, -4.0881453, -0.95824623},
+	{3.0484407, -2.292021, -1.2425685},
+	{0.9767251, -0.6191936, -1.5082371},
+	{-0.9578836, 0.9422752, -1.7569388},
+	{65.18432, -53.11679, 6.417878},
+	{53.322937, -43.43835, 5.0348926},
+	{44.642292, -36.35185, 4.0101933},
+	{37.852524, -30.806704, 3.198837},
+	{32.292362, -26.264683, 2.5264916},
+	{27.58449, -22.418959, 1.9508549},
+	{23.496778, -19.081028, 1.4460489},
+	{19.8777, -16.128014, 0.9952828},
+	{16.623863, -13.476113, 0.58715},
+	{13.662893, -11.066532, 0.21367186},
+	{10.943592, -8.857381, -0.13082021},
+	{8.429708, -6.8185725, -0.45039454},
+	{6.0955977, -4.928341, -0.74800205},
+	{3.9224072, -3.1703405, -1.025874},
+	{1.8949896, -1.5313807, -1.2858318},
+	{-1.7599256e-06, 1.2715252e-06, -1.5294815},
+	{-7.8531494, 30.260933, -16.132269},
+	{-22.04538, 38.319946, -15.826999},
+	{-28.736605, 41.335663, -15.251916},
+	{-33.528095, 43.384083, -14.820373},
+	{-37.38538, 45.04297, -14.508203},
+	{-40.56126, 46.410587, -14.268706},
+	{-43.20919, 47.544697, -14.075023},
+	{-45.44104, 48.491142, -13.912041},
+	{-47.34109, 49.286682, -13.770864},
+	{-48.973774, 49.96054, -13.645992},
+	{-50.388992, 50.535927, -13.533874},
+	{-51.625854, 51.03134, -13.432123},
+	{-52.71541, 51.461628, -13.339086},
+	{-53.68255, 51.838768, -13.253573},
+	{-54.54745, 52.172527, -13.174713},
+	{-55.326626, 52.4709, -13.101845},
+	{51.738228, -33.267426, 0.42937493},
+	{25.574795, -12.120183, -2.781183},
+	{11.0144205, -0.43280447, -4.5368204},
+	{1.4153554, 7.172139, -5.6496515},
+	{-5.654478, 12.684181, -6.4327617},
+	{-11.334091, 17.03598, -7.035339},
+	{-16.129122, 20.651196, -7.525525},
+	{-20.238213, 23.707378, -7.9316573},
+	{-23.789013, 26.317827, -8.271562},
+	{-26.881025, 28.56805, -8.558553},
+	{-29.59342, 30.524328, -8.802866},
+	{-31.989534, 32.238632, -9.012466},
+	{-34.120365, 33.752155, -9.193624},
+	{-36.02727, 35.097847, -9.351317},
+	{-37.744053, 36.30236, -9.48955},
+	{-39.29856, 37.38743, -9.611561},
+	{60.68415, -43.321983, 3.22418},
+	{37.18924, -24.466568, 0.4283222},
+	{23.02003, -13.006519, -1.3265573},
+	{13.280993, -5.1396027, -2.538073},
+	{5.993043, 0.71665776, -3.4368422},
+	{0.18381615, 5.348707, -4.14273},
+	{-4.6873097, 9.196027, -4.7243466},
+	{-8.915477, 12.502666, -5.2203727},
+	{-12.637335, 15.388294, -5.6499596},
+	{-15.935079, 17.926706, -6.024939},
+	{-18.87377, 20.174963, -6.3544426},
+	{-21.507097, 22.179043, -6.645816},
+	{-23.879578, 23.976377, -6.9050336},
+	{-26.028204, 25.59764, -7.1369853},
+	{-27.983849, 27.06813, -7.3457003},
+	{-29.772402, 28.40888, -7.534524},
+	{62.963642, -46.461723, 4.2787714},
+	{41.902714, -29.679205, 1.8491979},
+	{28.720259, -19.06079, 0.24654685},
+	{19.38407, -11.510829, -0.9159004},
+	{12.278681, -5.7684116, -1.8067473},
+	{6.577751, -1.1762878, -2.5204148},
+	{1.8081399, 2.6457071, -3.1137478},
+	{-2.3190782, 5.9314613, -3.6225777},
+	{-5.9727, 8.820474, -4.0686235},
+	{-9.241137, 11.389553, -4.463977},
+	{-12.181491, 13.689398, -4.8166623},
+	{-14.839733, 15.760097, -5.133041},
+	{-17.254433, 17.634623, -5.4183555},
+	{-19.458103, 19.340342, -5.676965},
+	{-21.478235, 20.900108, -5.912518},
+	{-23.338139, 22.333115, -6.1280804},
+	{63.362816, -47.615433, 4.8209305},
+	{44.128773, -32.334137, 2.632986},
+	{31.864632, -22.495989, 1.1694607},
+	{23.022116, -15.36173, 0.081299655},
+	{16.196823, -9.844009, -0.7722299},
+	{10.676464, -5.384698, -1.4670035},
+	{6.0454226, -1.6538124, -2.050225},
+	{2.0429883, 1.5575233, -2.552796},
+	{-1.4982234, 4.3848324, -2.995215},
+	{-4.6788845, 6.911806, -3.390332},
+	{-7.557299, 9.189023, -3.7459915},
+	{-10.174991, 11.252853, -4.0678716},
+	{-12.566197, 13.132819, -4.3606033},
+	{-14.759951, 14.853552, -4.628066},
+	{-16.780989, 16.435764, -4.873535},
+	{-18.650444, 17.896976, -5.099789},
+	{63.044506, -47.977077, 5.1469927},
+	{45.245556, -33.843143, 3.1293995},
+	{33.744106, -24.639217, 1.7729062},
+	{25.365385, -17.895573, 0.7540654},
+	{18.83482, -12.622683, -0.05624008},
+	{13.5139675, -8.323085, -0.72407633},
+	{9.03251, -4.7055955, -1.2895693},
+	{5.1554403, -1.5835835, -1.7794265},
+	{1.7270347, 1.1677563, -2.2120059},
+	{-1.3548542, 3.6315248, -2.5997665},
+	{-4.1531916, 5.8605566, -2.9507327},
+	{-6.7085366, 7.8899426, -3.2702825},
+	{-9.052267, 9.746795, -3.5626023},
+	{-11.210776, 11.453602, -3.8311846},
+	{-13.206632, 13.029338, -4.0789924},
+	{-15.059207, 14.490123, -4.308555},
+	{62.404522, -47.956905, 5.360164},
+	{45.793396, -34.756836, 3.4738922},
+	{34.926765, -26.069632, 2.1996605},
+	{26.953634, -19.663105, 1.2385758},
+	{20.700087, -14.620948, 0.46888375},
+	{15.576392, -10.482931, -0.17073545},
+	{11.243939, -6.9838505, -0.71620315},
+	{7.4885535, -3.954731, -1.1910704},
+	{4.1665816, -1.2812691, -1.6117327},
+	{1.1800536, 1.1153457, -1.9897473},
+	{-1.5358082, 3.2882848, -2.3330345},
+	{-4.022736, 5.272862, -2.6469133},
+	{-6.3106446, 7.094785, -2.9352896},
+	{-8.4239855, 8.774913, -3.201357},
+	{-10.383608, 10.330813, -3.4478235},
+	{-12.207457, 11.77745, -3.6770098},
+	{61.612507, -47.73402, 5.506652},
+	{46.019688, -35.32712, 3.7283928},
+	{35.693687, -27.072355, 2.5195572},
+	{28.071707, -20.953176, 1.6056733},
+	{22.067068, -16.116716, 0.87134075},
+	{17.128061, -12.130686, 0.25823677},
+	{12.938184, -8.746942, -0.26728177},
+	{9.298739, -5.8091536, -0.7267237},
+	{6.0764284, -3.2117696, -1.1349418},
+	{3.178767, -0.88091224, -1.5025649},
+	{0.5419427, 1.2351261, -1.8371626},
+	{-1.8768115, 3.1718304, -2.1439965},
+	{-4.1070833, 4.9543195, -2.426827},
+	{-6.1719975, 6.6022763, -2.6886268},
+	{-8.091048, 8.132139, -2.9318986},
+	{-9.881011, 9.55791, -3.1587887},
+	{60.753056, -47.400032, 5.610883},
+	{46.05147, -35.68375, 3.9249432},
+	{36.196934, -27.801245, 2.7701607},
+	{28.881569, -21.929304, 1.8952338},
+	{23.097946, -17.273455, 1.190994},
+	{18.327263, -13.425351, 0.6015594},
+	{14.270364, -10.149706, 0.094737455},
+	{10.739874, -7.2989855, -0.34976897},
+	{7.610629, -4.774283, -0.7457432},
+	{4.7953377, -2.506167, -1.1030344},
+	{2.2323718, -0.44508952, -1.4287921},
+	{-0.121097535, 1.4440007, -1.7281433},
+	{-2.2948143, 3.1859975, -2.004768},
+	{-4.311124, 4.7998137, -2.261487},
+	{-6.188474, 6.300992, -2.5006397},
+	{-7.9426746, 7.7027316, -2.7242277},
+	{59.871365, -47.00568, 5.6869907},
+	{45.960354, -35.900288, 4.081865},
+	{36.524834, -28.34563, 2.9732718},
+	{29.480825, -22.68975, 2.1311324},
+	{23.893862, -18.192722, 1.4524896},
+	{19.275045, -14.468082, 0.88374627},
+	{15.340224, -11.291489, 0.3938544},
+	{11.910961, -8.52208, -0.036699798},
+	{8.868322, -6.0658197, -0.42101544},
+	{6.1293826, -3.856872, -0.7683649},
+	{3.634957, -1.8478744, -1.0855123},
+	{1.342885, -0.004630022, -1.3774056},
+	{-0.7766627, 1.69752, -1.6476517},
+	{-2.745658, 3.2770216, -1.8989736},
+	{-4.581772, 4.748728, -2.1335835},
+	{-6.300022, 6.1251717, -2.353364},
+	{58.99279, -46.58084, 5.7437506},
+	{45.789776, -36.021736, 4.210498},
+	{36.731827, -28.76064, 3.1423721},
+	{29.931232, -23.296589, 2.3284228},
+	{24.520544, -18.940344, 1.6717461},
+	{20.038681, -15.326019, 1.1210016},
+	{16.215113, -12.239209, 0.64617646},
+	{12.879112, -9.544691, 0.22835974},
+	{9.916726, -7.1521773, -0.14509438},
+	{7.2485495, -4.9986067, -0.4830644},
+	{4.8176565, -3.038528, -0.7920087},
+	{2.5828857, -1.2387397, -1.076696},
+	{0.5145672, 0.42506084, -1.3406525},
+	{-1.4090985, 1.9710162, -1.5865344},
+	{-3.2052436, 3.4134712, -1.81646},
+	{-4.8882422, 4.76441, -2.0322142},
+	{58.13205, -46.14398, 5.786935},
+	{45.567562, -36.07723, 4.3182898},
+	{36.85322, -29.082113, 3.2862616},
+	{30.273518, -23.790737, 2.4970253},
+	{25.02226, -19.560566, 1.8594372},
+	{20.664394, -16.045185, 1.3243974},
+	{16.942093, -13.039517, 0.86289495},
+	{13.691619, -10.413438, 0.4565504},
+	{10.803312, -8.079805, 0.093060195},
+	{8.200649, -5.977748, -0.23618478},
+	{5.8286476, -4.0633783, -0.53742105},
+	{3.647207, -2.3044503, -0.81526476},
+	{1.6270063, -0.67709637, -1.0731612},
+	{-0.2536243, 0.8365794, -1.3137153},
+	{-2.011463, 2.2505589, -1.538978},
+	{-3.6603746, 3.5763836, -1.7506564},
+	{57.29775, -45.70684, 5.8204937},
+	{45.312263, -36.08644, 4.41036},
+	{36.912907, -29.334402, 3.4109526},
+	{30.535538, -24.2002, 2.6437356},
+	{25.429747, -20.084082, 2.0229585},
+	{21.18482, -16.658012, 1.501718},
+	{17.5549, -13.725794, 1.0519955},
+	{14.38278, -11.162071, 0.655917},
+	{11.562688, -8.882537, 0.30147415},
+	{9.020593, -6.8281603, -0.019740522},
+	{6.7031693, -4.9563303, -0.31380624},
+	{4.571315, -3.235637, -0.5852221},
+	{2.5961463, -1.6426622, -0.83736247},
+	{0.756148, -0.15974258, -1.0727894},
+	{-0.9652274, 1.2268429, -1.293507},
+	{-2.5814538, 2.5282874, -1.5011622},
+	{56.49474, -45.27697, 5.8472013},
+	{45.036633, -36.06312, 4.490356},
+	{36.92763, -29.534664, 3.5207124},
+	{30.736965, -24.54478, 2.773371},
+	{25.764948, -20.532795, 2.1675858},
+	{21.623558, -17.187927, 1.658576},
+	{18.078259, -14.322454, 1.2193063},
+	{14.97802, -11.815572, 0.83239967},
+	{12.220703, -9.585619, 0.48612434},
+	{9.734547, -7.575218, 0.17224355},
+	{7.4676824, -5.7428465, -0.11520381},
+	{5.3819103, -4.05781, -0.3806309},
+	{3.448806, -2.4971082, -0.6273558},
+	{1.6470363, -1.0433019, -0.85790634},
+	{-0.039782938, 0.3171349, -1.0742539},
+	{-1.6248358, 1.5951289, -1.278001},
+	{55.725536, -44.859173, 5.8690414},
+	{44.749664, -36.017143, 4.5609555},
+	{36.909485, -29.695358, 3.6186652},
+	{30.892092, -24.838896, 2.8894486},
+	{26.04392, -20.922731, 2.2971828},
+	{21.998045, -17.652222, 1.7991118},
+	{18.530632, -14.847714, 1.3691678},
+	{15.496527, -12.392768, 0.9904669},
+	{12.797041, -10.20825, 0.6515475},
+	{10.362539, -8.238331, 0.34433115},
+	{8.142495, -6.442467, 0.06295558},
+	{6.099533, -4.790594, -0.19692719},
+	{4.205676, -3.2600858, -0.43859333},
+	{2.4397898, -1.8337102, -0.6645449},
+	{0.7856187, -0.4980955, -0.8767311},
+	{-0.76981103, 0.7574855, -1.0767232},
+	{54.991154, -44.456467, 5.887458},
+	{44.457825, -35.955734, 4.6241727},
+	{36.867397, -29.825756, 3.7071598},
+	{31.011555, -25.093306, 2.9946046},
+	{26.27868, -21.2659, 2.4146454},
+	{22.321426, -18.063929, 1.9264439},
+	{18.926056, -15.315406, 1.5048723},
+	{15.953019, -12.908084, 1.1335362},
+	{13.306922, -10.765264, 0.8012424},
+	{10.92016, -8.832594, 0.50006646},
+	{8.743478, -7.0704584, 0.22423719},
+	{6.740276, -5.4493647, -0.030538822},
+	{4.8830094, -3.9470296, -0.26750597},
+	{3.1507494, -2.5464096, -0.48914826},
+	{1.5273536, -1.2342596, -0.6974007},
+	{-1.4241919e-06, 1.0289297e-06, -0.89381415},
+	{4.296743, 15.587163, -11.091275},
+	{-12.531168, 26.369553, -11.622247},
+	{-19.930038, 30.444283, -11.476927},
+	{-24.556883, 32.770332, -11.2734165},
+	{-28.085072, 34.505486, -11.112628},
+	{-30.96785, 35.922955, -10.991934},
+	{-33.395283, 37.12243, -10.900034},
+	{-35.48046, 38.160114, -10.828873},
+	{-37.300835, 39.07417, -10.773299},
+	{-38.912888, 39.89255, -10.730031},
+	{-40.359306, 40.63658, -10.696938},
+	{-41.673172, 41.322914, -10.672609},
+	{-42.880634, 41.964836, -10.656103},
+	{-44.002724, 42.573128, -10.646783},
+	{-45.05662, 43.15667, -10.644225},
+	{-46.05656, 43.722908, -10.648152},
+	{51.26678, -33.620205, 1.5735812},
+	{26.206621, -13.856297, -1.3876392},
+	{12.461481, -3.0154054, -3.0182452},
+	{3.5272267, 3.9821744, -4.0538454},
+	{-2.9117005, 8.9776325, -4.7771297},
+	{-7.919131, 12.820984, -5.3215218},
+	{-12.052431, 15.95872, -5.7578287},
+	{-15.585704, 18.614676, -6.1215787},
+	{-18.662048, 20.908434, -6.431609},
+	{-21.375551, 22.918518, -6.700175},
+	{-23.795477, 24.702057, -6.9361205},
+	{-25.97523, 26.302528, -7.146129},
+	{-27.957043, 27.753893, -7.335389},
+	{-29.774902, 29.08319, -7.508008},
+	{-31.456556, 30.312296, -7.6672916},
+	{-33.024956, 31.459148, -7.8159423},
+	{57.453823, -40.73191, 3.6048927},
+	{35.262985, -23.372587, 1.0708079},
+	{22.156164, -13.00429, -0.49890268},
+	{13.221951, -5.9142275, -1.5853599},
+	{6.601862, -0.6643625, -2.3910484},
+	{1.3982836, 3.4506733, -3.020692},
+	{-2.8845284, 6.823342, -3.5342448},
+	{-6.54442, 9.690575, -3.9685607},
+	{-9.756312, 12.19348, -4.345845},
+	{-12.61941, 14.414231, -4.6791162},
+	{-15.19897, 16.407534, -4.9770665},
+	{-17.543743, 18.214108, -5.2461753},
+	{-19.692228, 19.865856, -5.491522},
+	{-21.675661, 21.388437, -5.7171926},
+	{-23.519833, 22.802872, -5.926538},
+	{-25.246326, 24.126629, -6.122343},
+	{58.85447, -42.942924, 4.4098687},
+	{38.853317, -27.3538, 2.1639009},
+	{26.7028, -17.779465, 0.7338524},
+	{18.195436, -11.031552, -0.29613233},
+	{11.766386, -5.9170833, -1.0854495},
+	{6.6508317, -1.8450832, -1.7170168},
+	{2.4169848, 1.5219105, -2.2401497},
+	{-1.2011251, 4.393237, -2.686276},
+	{-4.3757863, 6.9053264, -3.0762646},
+	{-7.2161183, 9.145791, -3.4236956},
+	{-9.78952, 11.170019, -3.7372327},
+	{-12.142367, 13.016603, -4.0229535},
+	{-14.310115, 14.715038, -4.2855244},
+	{-16.32129, 16.288927, -4.528694},
+	{-18.199451, 17.757639, -4.7555513},
+	{-19.964386, 19.13735, -4.9686813},
+	{58.94292, -43.761208, 4.850087},
+	{40.570312, -29.439531, 2.7896442},
+	{29.220581, -20.515629, 1.4680872},
+	{21.16597, -14.1395035, 0.5026614},
+	{15.000884, -9.23744, -0.2507552},
+	{10.046943, -5.2889743, -0.8632673},
+	{5.9212236, -1.9978199, -1.3766556},
+	{2.3855298, 0.8218427, -1.8179303},
+	{-0.71766824, 3.2936707, -2.2054873},
+	{-3.4953237, 5.502229, -2.5521257},
+	{-6.018132, 7.504257, -2.8665433},
+	{-8.332802, 9.337997, -3.1546588},
+	{-10.473229, 11.031475, -3.4208436},
+	{-12.466073, 12.606729, -3.6685572},
+	{-14.333186, 14.081754, -3.9006395},
+	{-16.092894, 15.47157, -4.119474},
+	{58.533, -44.012344, 5.129681},
+	{41.468037, -30.687014, 3.2056334},
+	{30.765217, -22.274172, 1.9633219},
+	{23.106176, -16.21815, 1.0514759},
+	{17.19983, -11.52633, 0.3341638},
+	{12.420955, -7.718013, -0.25460127},
+	{8.419747, -4.5234227, -0.7523339},
+	{4.9792666, -1.7743047, -1.1829487},
+	{1.9552535, 0.64189607, -1.5628115},
+	{-0.7523769, 2.8037202, -1.9035623},
+	{-3.2135854, 4.766548, -2.2135332},
+	{-5.476136, 6.568794, -2.498571},
+	{-7.5735292, 8.237834, -2.762882},
+	{-9.5312805, 9.794652, -3.0097172},
+	{-11.3700075, 11.256196, -3.2417197},
+	{-13.106913, 12.636561, -3.4611032},
+	{57.91286, -43.99375, 5.323903},
+	{41.947742, -31.4977, 3.509394},
+	{31.78334, -23.5009, 2.3275888},
+	{24.45942, -17.710514, 1.4578918},
+	{18.783852, -13.204247, 0.77156514},
+	{14.171263, -9.529708, 0.20556217},
+	{10.293954, -6.4335885, -0.27552205},
+	{6.949988, -3.7594526, -0.6937662},
+	{4.0054812, -1.4031776, -1.0640996},
+	{1.3670257, 0.7082213, -1.3971692},
+	{-1.0322778, 2.6273286, -1.7007653},
+	{-3.2400854, 4.391997, -1.9805675},
+	{-5.2900414, 6.029364, -2.240691},
+	{-7.207073, 7.5597706, -2.4842474},
+	{-9.010939, 8.999393, -2.7137327},
+	{-10.7179785, 10.361601, -2.9312239},
+	{57.209152, -43.839787, 5.4679484},
+	{42.192677, -32.05536, 3.7457263},
+	{32.490784, -24.410484, 2.6126852},
+	{25.453577, -18.842995, 1.7764968},
+	{19.97898, -14.49573, 1.1156331},
+	{15.516224, -10.940761, 0.56951326},
+	{11.75468, -7.936898, 0.104006976},
+	{8.503003, -5.335553, -0.30197036},
+	{5.634916, -3.0385094, -0.66245973},
+	{3.0624492, -0.9771481, -0.98738724},
+	{0.72207224, 0.898294, -1.2840474},
+	{-1.432649, 2.6244404, -1.5578758},
+	{-3.4353492, 4.2281356, -1.8128995},
+	{-5.3107257, 5.729353, -2.0521436},
+	{-7.0779686, 7.1437254, -2.2779958},
+	{-8.752771, 8.484054, -2.4924276},
+	{56.484715, -43.61973, 5.580791},
+	{42.29864, -32.456627, 3.9383805},
+	{33.003162, -25.11782, 2.846312},
+	{26.215763, -19.741428, 2.0375},
+	{20.916464, -15.530554, 1.3975011},
+	{16.586315, -12.080028, 0.868158},
+	{12.929574, -9.15914, 0.4163855},
+	{9.763256, -6.625218, 0.021721233},
+	{6.966684, -4.384162, -0.32936302},
+	{4.4560323, -2.3705344, -0.64632994},
+	{2.1706996, -0.53690964, -0.93609947},
+	{0.06586173, 1.1519486, -1.2038661},
+	{-1.8916992, 2.7223701, -1.4535508},
+	{-3.7265778, 4.1940837, -1.6881183},
+	{-5.45762, 5.5823545, -1.909883},
+	{-7.100041, 6.899553, -2.120735},
+	{55.77275, -43.37215, 5.6736054},
+	{42.320778, -32.75761, 4.1013064},
+	{33.387844, -25.689936, 3.044659},
+	{26.821787, -20.48072, 2.2588453},
+	{21.676931, -16.38816, 1.6361505},
+	{17.463943, -13.028574, 1.1208442},
+	{13.90083, -10.180997, 0.6808478},
+	{10.811923, -7.7078753, 0.2962017},
+	{8.081038, -5.518275, -0.046293214},
+	{5.6275144, -3.5490627, -0.35581917},
+	{3.3930898, -1.7545943, -0.6390458},
+	{1.3344814, -0.10087031, -0.9009757},
+	{-0.5808461, 1.4378068, -1.1454211},
+	{-2.3773353, 2.8809247, -1.3752971},
+	{-4.07364, 4.243508, -1.592869},
+	{-5.684634, 5.537609, -1.7999688},
+	{55.091164, -43.11948, 5.7533627},
+	{42.29308, -32.993282, 4.243329},
+	{33.686855, -26.168756, 3.2178926},
+	{27.319519, -21.108147, 2.4518523},
+	{22.312302, -17.119602, 1.8437458},
+	{18.203373, -13.839611, 1.340224},
+	{14.723659, -11.056486, 0.91021764},
+	{11.704265, -8.637435, 0.53424424},
+	{9.032954, -6.4942617, 0.1993676},
+	{6.631644, -4.565638, -0.103411086},
+	{4.443927, -2.8072581, -0.3806042},
+	{2.4278316, -1.1861166, -0.6370812},
+	{0.5515685, 0.32286972, -0.8765633},
+	{-1.2090578, 1.7389201, -1.1019207},
+	{-2.8725832, 3.0768952, -1.3153886},
+	{-4.453657, 4.3486266, -1.518759},
+	{54.44962, -42.875214, 5.8246346},
+	{42.23759, -33.186638, 4.3703547},
+	{33.92791, -26.58203, 3.3727787},
+	{27.74072, -21.655085, 2.6240413},
+	{22.85743, -17.759098, 2.0284314},
+	{18.841618, -14.549354, 1.534885},
+	{15.43637, -11.8229885, 1.1133387},
+	{12.479194, -9.4517565, 0.7447871},
+	{9.861511, -7.3500104, 0.4165407},
+	{7.5075164, -5.4579854, 0.119745776},
+	{5.3623414, -3.732445, -0.15200567},
+	{3.3851042, -2.141164, -0.40349677},
+	{1.5447072, -0.6595863, -0.6383836},
+	{-0.18273811, 0.7312367, -0.8595002},
+	{-1.8156695, 2.0460396, -1.0690627},
+	{-3.3686006, 3.2965088, -1.2688398},
+	{53.85328, -42.64777, 5.8905635},
+	{42.169193, -33.35345, 4.486512},
+	{34.13009, -26.948963, 3.5140324},
+	{28.107378, -22.143282, 2.7806346},
+	{23.336607, -18.330488, 2.1958766},
+	{19.404749, -15.183271, 1.710855},
+	{16.066303, -12.50712, 1.296491},
+	{13.164852, -10.178152, 0.93425876},
+	{10.595287, -8.113158, 0.61171466},
+	{8.283916, -6.25383, 0.32014003},
+	{6.177244, -4.557864, 0.053209044},
+	{4.23532, -2.993674, -0.1938034},
+	{2.4276583, -1.5371388, -0.42451057},
+	{0.7306891, -0.16955808, -0.64172125},
+	{-0.8739223, 1.1236964, -0.8476412},
+	{-2.400613, 2.3542244, -1.0440282},
+	{53.3048, -42.442574, 5.953401},
+	{42.09827, -33.50493, 4.594802},
+	{34.307045, -27.283377, 3.645072},
+	{28.435287, -22.58839, 2.9254014},
+	{23.767342, -18.850954, 2.3501685},
+	{19.911684, -15.759818, 1.8724966},
+	{16.63348, -13.128336, 1.4642557},
+	{13.782066, -10.836792, 1.1073946},
+	{11.25565, -8.804331, 0.78971845},
+	{8.98255, -6.974042, 0.5026432},
+	{6.9105506, -5.304492, 0.23991935},
+	{5.000546, -3.7646613, -0.0031323894},
+	{3.222598, -2.3307998, -0.23009644},
+	{1.5534452, -0.98442465, -0.44376603},
+	{-0.0251341, 0.28899905, -0.6463431},
+	{-1.5275359, 1.5010223, -0.83958375},
+	{52.805386, -42.2632, 6.0148153},
+	{42.032307, -33.6493, 4.6974735},
+	{34.46886, -27.595531, 3.7684581},
+	{28.736168, -23.00205, 3.0611532},
+	{24.16265, -19.333267, 2.494348},
+	{20.37653, -16.292747, 2.0230584},
+	{17.152916, -13.701209, 1.6200588},
+	{14.346585, -11.442901, 1.2677612},
+	{11.858901, -9.439231, 0.9542194},
+	{9.6201105, -7.634643, 0.6709861},
+	{7.5792265, -5.988543, 0.41188845},
+	{5.6979365, -4.470446, 0.17229004},
+	{3.9468172, -3.0569398, -0.05137035},
+	{2.3029032, -1.7297385, -0.2618761},
+	{0.74809575, -0.47439277, -0.46142972},
+	{-0.7319741, 0.7206228, -0.65179205},
+	{52.355415, -42.112053, 6.076077},
+	{41.97685, -33.79279, 4.796269},
+	{34.6232, -27.893288, 3.886168},
+	{29.018978, -23.393173, 3.1900504},
+	{24.53248, -19.787197, 2.6307435},
+	{20.810081, -16.79258, 2.165025},
+	{17.636147, -14.236919, 1.7665248},
+	{14.870566, -12.008214, 1.4181026},
+	{12.4177065, -10.030041, 1.1080565},
+	{10.209666, -8.248152, 0.82807726},
+	{8.196637, -6.6227727, 0.57207024},
+	{6.341078, -5.1239367, 0.33544323},
+	{4.61406, -3.7285595, 0.1146575},
+	{2.9929135, -2.4185448, -0.09306201},
+	{1.4596736, -1.1795275, -0.28992},
+	{-1.3752957e-06, 9.852218e-07, -0.4776855},
+	{16.792532, 3.0155897, -7.4728227},
+	{-4.3045044, 17.44075, -8.822615},
+	{-13.2182, 22.970274, -9.06779},
+	{-18.380608, 25.945208, -9.080547},
+	{-22.037813, 27.980433, -9.052909},
+	{-24.955275, 29.59728, -9.032129},
+	{-27.420074, 30.976524, -9.025466},
+	{-29.573746, 32.201267, -9.032513},
+	{-31.502308, 33.320053, -9.051957},
+	{-33.264656, 34.36559, -9.082771},
+	{-34.904118, 35.36194, -9.124346},
+	{-36.454205, 36.327908, -9.17643},
+	{-37.941906, 37.278934, -9.23906},
+	{-39.38978, 38.228237, -9.312507},
+	{-40.817326, 39.187634, -9.397249},
+	{-42.242058, 40.168106, -9.49396},
+	{55.023884, -36.567833, 2.640618},
+	{28.963272, -16.501627, -0.3117407},
+	{14.993492, -5.66623, -1.9374176},
+	{6.0194507, 1.2841992, -2.9737794},
+	{-0.37799352, 6.219458, -3.6998513},
+	{-5.277387, 9.981076, -4.2450233},
+	{-9.248889, 13.01522, -4.6789384},
+	{-12.620561, 15.579164, -5.042063},
+	{-15.575039, 17.817804, -5.357203},
+	{-18.220114, 19.817715, -5.6379247},
+	{-20.628305, 21.637304, -5.893341},
+	{-22.852995, 23.319448, -6.1301165},
+	{-24.93575, 24.897367, -6.353409},
+	{-26.910257, 26.397852, -6.567383},
+	{-28.804749, 27.843275, -6.77553},
+	{-30.643635, 29.252943, -6.9808855},
+	{59.219086, -41.715515, 4.2003417},
+	{36.31862, -24.19636, 1.6768177},
+	{23.260662, -14.073204, 0.163024},
+	{14.45095, -7.195328, -0.8839544},
+	{7.9586964, -2.1105335, -1.6630994},
+	{2.8819735, 1.8708133, -2.2740571},
+	{-1.2689621, 5.1270504, -2.7733517},
+	{-4.7892113, 7.887426, -3.195981},
+	{-7.869673, 10.30075, -3.5650144},
+	{-10.632951, 12.4634495, -3.895563},
+	{-13.156951, 14.437746, -4.197453},
+	{-15.495413, 16.267015, -4.477577},
+	{-17.68878, 17.984028, -4.741171},
+	{-19.769371, 19.614971, -4.9924436},
+	{-21.764156, 21.18167, -5.2349257},
+	{-23.696459, 22.702974, -5.47169},
+	{59.92226, -43.293697, 4.861268},
+	{39.197697, -27.436378, 2.581227},
+	{27.099588, -18.080793, 1.1955062},
+	{18.760965, -11.578689, 0.21140368},
+	{12.495549, -6.665077, -0.5424981},
+	{7.5237455, -2.7511327, -1.1478702},
+	{3.4179683, 0.48931852, -1.6514646},
+	{-0.08207591, 3.2564478, -2.0827773},
+	{-3.147201, 5.6823125, -2.46168},
+	{-5.895456, 7.8585415, -2.8021889},
+	{-8.408165, 9.848786, -3.114168},
+	{-10.740696, 11.697003, -3.4045026},
+	{-12.933033, 13.435306, -3.6782727},
+	{-15.016274, 15.088812, -3.9394894},
+	{-17.016014, 16.67825, -4.191493},
+	{-18.95425, 18.221441, -4.437184},
+	{59.691948, -43.84998, 5.243922},
+	{40.615322, -29.212347, 3.1261785},
+	{29.264326, -20.433182, 1.8283734},
+	{21.35809, -14.273732, 0.90076447},
+	{15.357435, -9.570458, 0.18207522},
+	{10.55041, -5.784766, -0.40258154},
+	{6.5503063, -2.6230145, -0.89457226},
+	{3.1221182, 0.09431936, -1.3197169},
+	{0.111337095, 2.4859452, -1.6954526},
+	{-2.589824, 4.6350336, -2.0342216},
+	{-5.0593824, 6.6020384, -2.3452096},
+	{-7.3534355, 8.430814, -2.6351693},
+	{-9.5122795, 10.15328, -2.9090748},
+	{-11.566463, 11.793895, -3.1707826},
+	{-13.540675, 13.372544, -3.4234626},
+	{-15.455918, 14.906199, -3.6698523},
+	{59.13993, -44.003326, 5.502231},
+	{41.405266, -30.345795, 3.5091283},
+	{30.651773, -22.013807, 2.2738466},
+	{23.10128, -16.128689, 1.3884907},
+	{17.337976, -11.611922, 0.7002252},
+	{12.695865, -7.956396, 0.13723943},
+	{8.813043, -4.886412, -0.33959627},
+	{5.471174, -2.2352912, -0.7541291},
+	{2.527443, 0.10639185, -1.1222304},
+	{-0.11760927, 2.2151363, -1.4551723},
+	{-2.536671, 4.147038, -1.7613488},
+	{-4.784147, 5.9442787, -2.0471618},
+	{-6.9004254, 7.6384764, -2.3174725},
+	{-8.915915, 9.253726, -2.5760326},
+	{-10.854777, 10.80935, -2.825889},
+	{-12.737329, 12.321689, -3.0696504},
+	{58.487682, -43.9848, 5.695867},
+	{41.882496, -31.147419, 3.804891},
+	{31.628311, -23.181578, 2.617581},
+	{24.372013, -17.517244, 1.7637618},
+	{18.80939, -13.154578, 1.0992248},
+	{14.313962, -9.613419, 0.5547246},
+	{10.54195, -6.6303377, 0.09227633},
+	{7.285854, -4.0463, -0.31109005},
+	{4.4106865, -1.757726, -0.67041445},
+	{1.8229755, 0.30726305, -0.9962333},
+	{-0.5454475, 2.201216, -1.2963432},
+	{-2.7462516, 3.964074, -1.576747},
+	{-4.81908, 5.6266537, -1.8421353},
+	{-6.7942543, 7.212769, -2.0961795},
+	{-8.695665, 8.741384, -2.3418412},
+	{-10.543173, 10.228384, -2.581634},
+	{57.83123, -43.899395, 5.8534083},
+	{42.193703, -31.76275, 4.0487857},
+	{32.369003, -24.10769, 2.900362},
+	{25.361855, -18.626146, 2.0708926},
+	{19.968403, -14.390168, 1.4245673},
+	{15.598624, -10.944935, 0.8947719},
+	{11.924622, -8.03772, 0.44448903},
+	{8.747187, -5.5150123, 0.051229477},
+	{5.9366736, -3.2769022, -0.29966283},
+	{3.4036944, -1.2544662, -0.6183373},
+	{1.0833902, 0.6023613, -0.9122183},
+	{-1.0733956, 2.331584, -1.1869956},
+	{-3.1049953, 3.9629228, -1.4471681},
+	{-5.0414343, 5.5198183, -1.6963258},
+	{-6.9064574, 7.0209966, -1.9373724},
+	{-8.71964, 8.482035, -2.1727552},
+	{57.21594, -43.800232, 5.990342},
+	{42.41728, -32.270283, 4.2600904},
+	{32.968426, -24.884712, 3.144271},
+	{26.176697, -19.558361, 2.334246},
+	{20.927555, -15.427904, 1.7020323},
+	{16.664694, -12.062337, 1.1836649},
+	{13.075028, -9.219084, 0.7431215},
+	{9.9667225, -6.7495265, 0.3583256},
+	{7.214345, -4.5565066, 0.014838373},
+	{4.7313914, -2.5730262, -0.29730892},
+	{2.4553108, -0.75063574, -0.5853471},
+	{0.33886686, 0.9472672, -0.85476965},
+	{-1.6549231, 2.549373, -1.1099164},
+	{-3.5555415, 4.07864, -1.3542943},
+	{-5.3866076, 5.5536065, -1.5907667},
+	{-7.167536, 6.9896526, -1.8217378},
+	{56.66478, -43.716797, 6.115963},
+	{42.59932, -32.716724, 4.4504504},
+	{33.482834, -25.567825, 3.3625166},
+	{26.880062, -20.375586, 2.5684044},
+	{21.755646, -16.334347, 1.9472789},
+	{17.584152, -13.035155, 1.4377328},
+	{14.066403, -10.245116, 1.0047634},
+	{11.017494, -7.8202877, 0.6267225},
+	{8.315785, -5.665978, 0.289361},
+	{5.877094, -3.7166693, -0.017187016},
+	{3.6405435, -1.924996, -0.30006313},
+	{1.5602769, -0.25529, -0.5646598},
+	{-0.3995734, 1.3203471, -0.8152146},
+	{-2.2678492, 2.8243825, -1.0551641},
+	{-4.0679965, 4.2751737, -1.2873416},
+	{-5.8193545, 5.687986, -1.5141283},
+	{56.19006, -43.66678, 6.2363195},
+	{42.769054, -33.132076, 4.627472},
+	{33.94836, -26.19259, 3.5636504},
+	{27.512846, -21.11753, 2.7827556},
+	{22.497318, -17.152607, 2.1704273},
+	{18.40442, -13.909056, 1.6676618},
+	{14.947914, -11.163096, 1.2404612},
+	{11.949462, -8.775283, 0.86762196},
+	{9.291008, -6.6532984, 0.5350953},
+	{6.8904777, -4.732968, 0.23310661},
+	{4.6883645, -2.9677734, -0.0454355},
+	{2.6398406, -1.3226922, -0.30587807},
+	{0.70988303, 0.2296225, -0.55240667},
+	{-1.1297554, 1.7112267, -0.7884117},
+	{-2.902297, 3.140299, -1.0167056},
+	{-4.6270494, 4.5320487, -1.2396619},
+	{55.798676, -43.661507, 6.3556046},
+	{42.946297, -33.53696, 4.7964644},
+	{34.389786, -26.783476, 3.7535841},
+	{28.103075, -21.811415, 2.9837368},
+	{23.183208, -17.91222, 2.3784},
+	{19.158262, -14.715511, 1.8807954},
+	{15.753939, -12.005972, 1.4578849},
+	{12.798067, -9.648431, 1.0889087},
+	{10.176006, -7.55287, 0.7600288},
+	{7.8076844, -5.65641, 0.46156946},
+	{5.6348457, -3.9133132, 0.1864851},
+	{3.6135194, -2.2890701, -0.07054636},
+	{1.7093389, -0.7567314, -0.3136878},
+	{-0.10544823, 0.7054498, -0.5463069},
+	{-1.8538208, 2.1154914, -0.7712056},
+	{-3.55507, 3.4885793, -0.9907603},
+	{55.49477, -43.708805, 6.4769025},
+	{43.145386, -33.94645, 4.961362},
+	{34.82514, -27.358345, 3.9366517},
+	{28.67108, -22.47698, 3.1760192},
+	{23.835478, -18.634521, 2.57619},
+	{19.869402, -15.477254, 2.082425},
+	{16.509506, -12.797663, 1.6625847},
+	{13.589355, -10.464577, 1.2963363},
+	{10.997532, -8.390171, 0.9700537},
+	{8.655876, -6.512828, 0.67416716},
+	{6.507325, -4.787565, 0.40168226},
+	{4.508697, -3.1803513, 0.14730093},
+	{2.6261857, -1.6645639, -0.093127176},
+	{0.8324539, -0.21869624, -0.32296064},
+	{-0.89523864, 1.1751329, -0.544999},
+	{-2.5761578, 2.5321004, -0.7616287},
+	{55.28132, -43.81466, 6.6026454},
+	{43.37741, -34.372276, 5.1252484},
+	{35.26836, -27.931004, 4.11621},
+	{29.23244, -23.129349, 3.3631794},
+	{24.471, -19.335726, 2.767586},
+	{20.555843, -16.211496, 2.2765465},
+	{17.233616, -13.556278, 1.858749},
+	{14.34318, -11.242601, 1.4942616},
+	{11.776121, -9.1847, 1.1696622},
+	{9.456089, -7.3221626, 0.8754877},
+	{7.3271613, -5.6107407, 0.6047993},
+	{5.3469076, -4.0168705, 0.35232708},
+	{3.4820464, -2.5142386, 0.113934234},
+	{1.7056147, -1.08155, -0.11373203},
+	{-0.004894454, 0.29895148, -0.33347228},
+	{-1.6686984, 1.6424316, -0.547685},
+	{55.161053, -43.984253, 6.734891},
+	{43.65156, -34.824154, 5.2906775},
+	{35.73083, -28.512737, 4.295},
+	{29.799772, -23.780733, 3.5481014},
+	{25.103306, -20.028807, 2.9556108},
+	{21.231916, -16.931908, 2.466323},
+	{17.94134, -14.296139, 2.04968},
+	{15.07528, -11.99743, 1.6861174},
+	{12.528103, -9.951913, 1.3624023},
+	{10.22514, -8.100305, 1.0691735},
+	{8.111541, -6.3990564, 0.7995481},
+	{6.1455975, -4.8150578, 0.5482872},
+	{4.294529, -3.3223004, 0.31127083},
+	{2.5317335, -1.8996989, 0.08515443},
+	{0.83494174, -0.52962506, -0.13286182},
+	{-0.814979, 0.8030548, -0.34518597},
+	{55.136986, -44.222576, 6.875496},
+	{43.976013, -35.310658, 5.459883},
+	{36.22245, -29.113302, 4.4753823},
+	{30.383854, -24.441542, 3.7332325},
+	{25.743818, -20.724682, 3.1427996},
+	{21.909613, -17.64988, 2.6543827},
+	{18.645195, -15.029105, 2.2381022},
+	{15.798679, -12.741368, 1.8747239},
+	{13.266968, -10.704537, 1.5511858},
+	{10.976939, -8.860361, 1.258219},
+	{8.874739, -7.1659403, 0.98899245},
+	{6.919329, -5.588591, 0.7382977},
+	{5.0784087, -4.1026015, 0.50203353},
+	{3.325726, -2.6870954, 0.2768667},
+	{1.6392602, -1.3245866, 0.06000242},
+	{-1.6060721e-06, 1.1627377e-06, -0.15097181},
+	{31.936869, -10.152951, -4.3723545},
+	{4.2474027, 9.434816, -6.6799483},
+	{-6.936258, 16.854155, -7.3262362},
+	{-13.199754, 20.809969, -7.5687413},
+	{-17.406887, 23.392096, -7.684589},
+	{-20.630074, 25.358992, -7.764931},
+	{-23.325539, 27.022202, -7.8417244},
+	{-25.703497, 28.519417, -7.9256053},
+	{-27.87958, 29.923952, -8.0207405},
+	{-29.92848, 31.28258, -8.129433},
+	{-31.904669, 32.62972, -8.253564},
+	{-33.851906, 33.993786, -8.395116},
+	{-35.808422, 35.400616, -8.556416},
+	{-37.8103, 36.875706, -8.74031},
+	{-39.894085, 38.44609, -8.950358},
+	{-42.099274, 40.14216, -9.191064},
+	{62.78009, -42.055103, 3.8089879},
+	{33.72053, -20.198824, 0.66375667},
+	{18.722818, -8.760212, -1.0396962},
+	{9.206487, -1.4741595, -2.129241},
+	{2.4620292, 3.694309, -2.8982017},
+	{-2.6818166, 7.637658, -3.4806411},
+	{-6.827196, 10.817883, -3.947401},
+	{-10.326708, 13.506195, -4.340598},
+	{-13.403494, 15.874504, -4.6871595},
+	{-16.197563, 18.03155, -5.004205},
+	{-18.800495, 20.04926, -5.3031125},
+	{-21.278484, 21.980103, -5.592245},
+	{-23.684254, 23.866188, -5.8783946},
+	{-26.0635, 25.744282, -6.1675906},
+	{-28.459005, 27.649078, -6.465638},
+	{-30.913795, 29.615784, -6.77855},
+	{65.24932, -45.695705, 5.056718},
+	{39.788258, -26.587982, 2.3322933},
+	{26.003353, -16.097034, 0.78315693},
+	{16.849468, -9.060274, -0.27886638},
+	{10.132211, -3.8619494, -1.0725245},
+	{4.8805118, 0.22219585, -1.699836},
+	{0.5792428, 3.5811262, -2.2176192},
+	{-3.076835, 6.447332, -2.660714},
+	{-6.285522, 8.972325, -3.052268},
+	{-9.185285, 11.262533, -3.4088082},
+	{-11.8750105, 13.394492, -3.742393},
+	{-14.425511, 15.423739, -4.061901},
+	{-16.890913, 17.393335, -4.3743286},
+	{-19.31654, 19.339771, -4.6857095},
+	{-21.743807, 21.296732, -5.0017147},
+	{-24.213665, 23.29781, -5.3280997},
+	{65.192825, -46.697113, 5.6194034},
+	{42.160355, -29.346231, 3.1249814},
+	{29.370434, -19.613277, 1.6922364},
+	{20.741068, -12.985278, 0.6969367},
+	{14.304399, -8.0024605, -0.062658},
+	{9.198051, -4.023688, -0.6757285},
+	{4.9674344, -0.708867, -1.1904703},
+	{1.3416677, 2.1463435, -1.6365528},
+	{-1.8550808, 4.6756024, -2.0338235},
+	{-4.745617, 6.972824, -2.396508},
+	{-7.4217806, 9.108553, -2.7354915},
+	{-9.954274, 11.137496, -3.0593662},
+	{-12.397957, 13.102682, -3.3750165},
+	{-14.797881, 15.03999, -3.6882973},
+	{-17.194347, 16.98195, -4.0046234},
+	{-19.626604, 18.960587, -4.3294253},
+	{64.53374, -46.967026, 5.963022},
+	{43.375217, -30.945797, 3.6316295},
+	{31.33296, -21.758165, 2.273107},
+	{23.129173, -15.451915, 1.3266772},
+	{16.965168, -10.67967, 0.6009742},
+	{12.038371, -6.8401465, 0.010595353},
+	{7.9270096, -3.6169288, -0.4895458},
+	{4.3819227, -0.8224301, -0.92644095},
+	{1.2420425, 1.6653049, -1.3179183},
+	{-1.6045797, 3.9315753, -1.6766775},
+	{-4.241187, 6.0401683, -2.012395},
+	{-6.73374, 8.041879, -2.3329232},
+	{-9.136045, 9.978496, -2.6448479},
+	{-11.492877, 11.885287, -2.9538414},
+	{-13.843868, 13.793948, -3.2651098},
+	{-16.227133, 15.735353, -3.5838227},
+	{63.747383, -46.994236, 6.2122445},
+	{44.11085, -32.04557, 4.009079},
+	{32.667126, -23.282112, 2.70316},
+	{24.798527, -17.219782, 1.7900802},
+	{18.85781, -12.614879, 1.0894232},
+	{14.091326, -8.898147, 0.51854295},
+	{10.098325, -5.7667303, 0.03344219},
+	{6.6420097, -3.0414734, -0.39196426},
+	{3.5703068, -0.6070183, -0.7745759},
+	{0.77827066, 1.6165905, -1.1262385},
+	{-1.8114682, 3.6886141, -1.4558659},
+	{-4.2599072, 5.656014, -1.7706453},
+	{-6.618088, 7.558302, -2.0767367},
+	{-8.929929, 9.429818, -2.3795996},
+	{-11.234561, 11.3015995, -2.6842716},
+	{-13.569354, 13.203702, -2.9957275},
+	{62.999023, -46.95974, 6.416482},
+	{44.62862, -32.900955, 4.3185716},
+	{33.683865, -24.484718, 3.0529597},
+	{26.087229, -18.613855, 2.1634212},
+	{20.325626, -14.138284, 1.4802148},
+	{15.690506, -10.519088, 0.9236791},
+	{11.799321, -7.4651074, 0.4507257},
+	{8.424036, -4.802631, 0.035614487},
+	{5.4179506, -2.41975, -0.33827496},
+	{2.68029, -0.23939899, -0.68244576},
+	{0.13734011, 1.795011, -1.0054224},
+	{-2.2684302, 3.727853, -1.3139781},
+	{-4.5851173, 5.596394, -1.6138922},
+	{-6.8551354, 7.433706, -1.9103845},
+	{-9.117034, 9.270186, -2.2083442},
+	{-11.407674, 11.135299, -2.5126011},
+	{62.356426, -46.945, 6.599963},
+	{45.053753, -33.635548, 4.5901213},
+	{34.53366, -25.511002, 3.356676},
+	{27.16347, -19.795185, 2.4843407},
+	{21.547157, -15.4205885, 1.813158},
+	{17.017433, -11.876595, 1.2664943},
+	{13.20886, -8.883336, 0.80228084},
+	{9.90131, -6.2720604, 0.39510867},
+	{6.9521723, -3.9332933, 0.028461086},
+	{4.2632556, -1.7915167, -0.30908898},
+	{1.7629782, 0.20847355, -0.6259368},
+	{-0.6040803, 2.1095762, -0.92866135},
+	{-2.8838248, 3.9474685, -1.2227992},
+	{-5.1169744, 5.75393, -1.5133526},
+	{-7.341323, 7.558679, -1.8050753},
+	{-9.593342, 9.390727, -2.1026871},
+	{61.851254, -46.99272, 6.776558},
+	{45.455738, -34.318756, 4.8408346},
+	{35.300613, -26.44213, 3.6334817},
+	{28.121716, -20.854046, 2.773892},
+	{22.624659, -16.559164, 2.1108568},
+	{18.179235, -13.07257, 1.5706086},
+	{14.435917, -10.125101, 1.1121368},
+	{11.182119, -7.552876, 0.7104261},
+	{8.279052, -5.2487464, 0.3490838},
+	{5.6306005, -3.1383703, 0.016704021},
+	{3.1665375, -1.1672943, -0.29509917},
+	{0.8326533, 0.7066452, -0.5928544},
+	{-1.415599, 2.5182147, -0.88198787},
+	{-3.6175332, 4.298195, -1.1673536},
+	{-5.8100805, 6.075626, -1.4535877},
+	{-8.029374, 7.879162, -1.7453287},
+	{61.499973, -47.128105, 6.9551253},
+	{45.87813, -34.994568, 5.0817013},
+	{36.037556, -27.329174, 3.8954713},
+	{29.021029, -21.846786, 3.0451963},
+	{23.62227, -17.614885, 2.3873918},
+	{19.244026, -14.171523, 1.8509002},
+	{15.551256, -11.257378, 1.395724},
+	{12.33845, -8.71322, 0.9972632},
+	{9.470504, -6.434223, 0.63928694},
+	{6.853301, -4.347225, 0.31043512},
+	{4.417756, -2.3983862, 0.0023176207},
+	{2.1104417, -0.545948, -0.29160196},
+	{-0.11240264, 1.2444023, -0.5767247},
+	{-2.2891412, 3.002826, -0.85783195},
+	{-4.4558973, 4.7577896, -1.1394743},
+	{-6.648481, 6.5376554, -1.4262316},
+	{61.313293, -47.36866, 7.1420426},
+	{46.351643, -35.694153, 5.320562},
+	{36.781395, -28.207823, 4.1510997},
+	{29.902191, -22.81223, 3.3072753},
+	{24.583977, -18.629356, 2.652352},
+	{20.258564, -15.217557, 2.1174953},
+	{16.603899, -12.326344, 1.663626},
+	{13.420925, -9.800761, 1.2665373},
+	{10.577996, -7.5382004, 0.9101715},
+	{7.982945, -5.4666924, 0.5832375},
+	{5.567808, -3.5330446, 0.2773627},
+	{3.2798448, -1.6958542, -0.013996015},
+	{1.0758171, 0.07892581, -0.2962446},
+	{-1.0820467, 1.8211167, -0.57414186},
+	{-3.229229, 3.5587716, -0.85218865},
+	{-5.401226, 5.3200164, -1.1349257},
+	{61.30087, -47.72917, 7.3425183},
+	{46.900623, -36.442173, 5.5636015},
+	{37.560753, -29.105658, 4.4068837},
+	{30.79624, -23.779823, 3.5669448},
+	{25.54279, -19.633692, 2.9128776},
+	{21.257675, -16.243382, 2.377869},
+	{17.630312, -13.366195, 1.9236405},
+	{14.4674015, -10.850979, 1.5263311},
+	{11.640432, -8.597131, 1.1700385},
+	{9.059043, -6.5338073, 0.8435446},
+	{6.656366, -4.60847, 0.5385085},
+	{4.3803453, -2.780108, 0.24839735},
+	{2.1882138, -1.014924, -0.032193586},
+	{0.042621892, 0.7166759, -0.30801588},
+	{-2.0914342, 2.4424613, -0.5835439},
+	{-4.249147, 4.19036, -0.86329204},
+	{61.473545, -48.224194, 7.56128},
+	{47.54681, -37.260353, 5.816195},
+	{38.40024, -30.046242, 4.668345},
+	{31.729225, -24.774145, 3.8298578},
+	{26.525908, -20.65339, 3.1747851},
+	{22.269648, -17.275442, 2.638031},
+	{18.65986, -14.404345, 2.181986},
+	{15.508274, -11.892224, 1.7830691},
+	{12.68911, -9.640208, 1.4255013},
+	{10.113606, -7.5784326, 1.0981216},
+	{7.7158875, -5.6549563, 0.7926186},
+	{5.4445524, -3.8291564, 0.50247777},
+	{3.257299, -2.0675087, 0.22230789},
+	{1.1171634, -0.34064364, -0.052628145},
+	{-1.0104603, 1.3789713, -0.3267816},
+	{-3.1604502, 3.119069, -0.604642},
+	{61.844776, -48.869648, 7.8030233},
+	{48.31175, -38.16982, 6.0834556},
+	{39.32318, -31.051695, 4.940608},
+	{32.725178, -25.817715, 4.10115},
+	{27.557898, -21.711355, 3.4432642},
+	{23.319616, -18.337112, 2.9032617},
+	{19.718304, -15.464717, 2.4440598},
+	{16.569956, -12.949024, 2.042282},
+	{13.751107, -10.692574, 1.6822287},
+	{11.174326, -8.626288, 1.3527684},
+	{8.774567, -6.698705, 1.0456036},
+	{6.5009885, -4.869525, 0.7542311},
+	{4.3117127, -3.105478, 0.47327536},
+	{2.1701436, -1.3774271, 0.19802196},
+	{0.042052846, 0.34191513, -0.075948864},
+	{-2.1070824, 2.0800471, -0.35309103},
+	{62.432156, -49.684425, 8.072832},
+	{49.2187, -39.192932, 6.3706574},
+	{40.35368, -32.144623, 5.228838},
+	{33.80829, -26.933027, 4.3859034},
+	{28.662996, -22.83004, 3.7233646},
+	{24.431952, -19.450935, 3.178622},
+	{20.830252, -16.57007, 2.7149684},
+	{17.6774, -14.04445, 2.3091474},
+	{14.851777, -11.77768, 1.9454855},
+	{12.266989, -9.701243, 1.6128465},
+	{9.858614, -7.7639947, 1.3029206},
+	{7.576228, -5.925848, 1.0091984},
+	{5.378272, -4.1537127, 0.72630906},
+	{3.2284558, -2.418644, 0.44955727},
+	{1.0928961, -0.69359267, 0.17455912},
+	{-1.0625365, 1.0486605, -0.1030901},
+	{63.25927, -50.692196, 8.376613},
+	{50.29452, -40.35501, 6.683629},
+	{41.518482, -33.349857, 5.538632},
+	{35.00481, -28.144312, 4.68954},
+	{29.867044, -24.033247, 4.0203996},
+	{25.632257, -20.640482, 3.4693713},
+	{22.02123, -17.743902, 2.9999576},
+	{18.85618, -15.202057, 2.5889282},
+	{16.016846, -12.919245, 2.220577},
+	{13.417555, -10.827251, 1.8837202},
+	{10.994272, -8.875066, 1.5700045},
+	{8.696808, -7.0226684, 1.2728884},
+	{6.4837766, -5.237029, 0.98698485},
+	{4.319072, -3.4893, 0.70760167},
+	{2.1690662, -1.7526059, 0.43038148},
+	{-2.1032154e-06, 1.5195495e-06, 0.15097141},
+	{51.6843, -25.759401, -1.2933403},
+	{13.987336, 1.2954072, -4.8313866},
+	{-0.26539326, 11.098724, -5.9172535},
+	{-8.04527, 16.280035, -6.4026437},
+	{-13.154492, 19.624743, -6.678241},
+	{-16.948017, 22.104612, -6.8720403},
+	{-20.05023, 24.158651, -7.0385747},
+	{-22.779959, 26.007215, -7.2033615},
+	{-25.311844, 27.76965, -7.3791485},
+	{-27.756578, 29.522036, -7.573913},
+	{-30.197123, 31.323019, -7.7943172},
+	{-32.706245, 33.22638, -8.047347},
+	{-35.357307, 35.28899, -8.341402},
+	{-38.233368, 37.577984, -8.687403},
+	{-41.437817, 40.179546, -9.100311},
+	{-45.109966, 43.212273, -9.601569},
+	{74.92773, -50.395443, 5.208891},
+	{40.372425, -24.969992, 1.6316898},
+	{23.663715, -12.442614, -0.21057329},
+	{13.240918, -4.556732, -1.3873008},
+	{5.8980193, 1.0286149, -2.2229726},
+	{0.29916668, 5.3078556, -2.8630817},
+	{-4.2261076, 8.785657, -3.383583},
+	{-8.060229, 11.752098, -3.8289351},
+	{-11.447688, 14.3937, -4.2281613},
+	{-14.561165, 16.842936, -4.6022043},
+	{-17.527205, 19.198133, -4.966893},
+	{-20.444454, 21.537523, -5.3351207},
+	{-23.399763, 23.931578, -5.718826},
+	{-26.48071, 26.452871, -6.1306453},
+	{-29.787197, 29.185595, -6.5855875},
+	{-33.44577, 32.237694, -7.1032367},
+	{75.48933, -52.594376, 6.2220206},
+	{45.360325, -30.350725, 3.0732992},
+	{30.169617, -18.97805, 1.4132491},
+	{20.334454, -11.525188, 0.2995279},
+	{13.170955, -6.0435357, -0.53253573},
+	{7.568718, -1.72117, -1.1954414},
+	{2.9576738, 1.8637378, -1.7495689},
+	{-0.9951881, 4.9605474, -2.2316883},
+	{-4.503441, 7.7309856, -2.6662524},
+	{-7.716789, 10.289692, -3.0710094},
+	{-10.751668, 12.726772, -3.4602516},
+	{-13.706236, 15.119201, -3.846503},
+	{-16.667816, 17.536882, -4.2414536},
+	{-19.721872, 20.049828, -4.657131},
+	{-22.962805, 22.736866, -5.1073885},
+	{-26.507336, 25.696812, -5.6098833},
+	{74.36476, -52.874424, 6.6928525},
+	{47.308945, -32.761654, 3.7993221},
+	{33.19564, -22.165407, 2.2458436},
+	{23.939108, -15.146447, 1.1987805},
+	{17.11664, -9.923839, 0.40779924},
+	{11.715166, -5.7521477, -0.23201911},
+	{7.2205935, -2.251778, -0.774508},
+	{3.3339295, 0.79967594, -1.2517542},
+	{-0.1374355, 3.5470097, -1.6851394},
+	{-3.3284633, 6.0929804, -2.0902288},
+	{-6.34227, 8.517235, -2.4794168},
+	{-9.266567, 10.888477, -2.8636973},
+	{-12.183648, 13.272113, -3.2538261},
+	{-15.176087, 15.735059, -3.6611004},
+	{-18.334484, 18.352205, -4.0984597},
+	{-21.769415, 21.216352, -4.582219},
+	{73.03253, -52.72835, 6.9955535},
+	{48.345142, -34.244358, 4.2912073},
+	{35.03999, -24.2065, 2.8047972},
+	{26.21551, -17.496113, 1.799401},
+	{19.674755, -12.481846, 1.0395352},
+	{14.470798, -8.459784, 0.42341864},
+	{10.117601, -5.068042, -0.10138134},
+	{6.3334603, -2.0961874, -0.5655074},
+	{2.9381907, 0.59124714, -0.98891217},
+	{-0.19405472, 3.0898802, -1.3859483},
+	{-3.1589434, 5.4734445, -1.7679579},
+	{-6.0364532, 7.8046002, -2.1448495},
+	{-8.901718, 10.143101, -2.526334},
+	{-11.83283, 12.551945, -2.9229052},
+	{-14.917104, 15.102757, -3.3467557},
+	{-18.261044, 17.884237, -3.813248},
+	{71.83385, -52.533405, 7.234025},
+	{49.04034, -35.349514, 4.6794977},
+	{36.382423, -25.748007, 3.2409198},
+	{27.889805, -19.263924, 2.2618282},
+	{21.562836, -14.400129, 1.5215749},
+	{16.515049, -10.492039, 0.92198205},
+	{12.283037, -7.191621, 0.41150412},
+	{8.5953245, -4.2944417, -0.04024163},
+	{5.2779465, -1.6689107, -0.45292473},
+	{2.2099102, 0.7771913, -0.84047645},
+	{-0.70030063, 3.114452, -1.2137474},
+	{-3.5285423, 5.402309, -1.5820882},
+	{-6.344896, 7.69659, -1.9545068},
+	{-9.222571, 10.056385, -2.3407571},
+	{-12.245488, 12.550259, -2.7523766},
+	{-15.517171, 15.263895, -3.2040122},
+	{70.8749, -52.42637, 7.4509845},
+	{49.622223, -36.299026, 5.017544},
+	{37.497578, -27.050915, 3.6150258},
+	{29.269, -20.73984, 2.6530828},
+	{23.105164, -15.984603, 1.92448},
+	{18.174374, -12.157148, 1.3347819},
+	{14.034615, -8.923086, 0.8335728},
+	{10.423641, -6.0834775, 0.390748},
+	{7.1717715, -3.5090606, -0.013355697},
+	{4.1604347, -1.1089249, -0.39267272},
+	{1.300043, 1.1862884, -0.75795734},
+	{-1.4832935, 3.4345684, -1.1183523},
+	{-4.2570653, 5.689737, -1.4825186},
+	{-7.090851, 8.008054, -1.8596919},
+	{-10.065278, 10.455332, -2.2608526},
+	{-13.281481, 13.114912, -2.7000887},
+	{70.194305, -52.46911, 7.6684604},
+	{50.20527, -37.20602, 5.3329935},
+	{38.5241, -28.247131, 3.9579031},
+	{30.508802, -22.070452, 3.006933},
+	{24.4705, -17.39358, 2.2845433},
+	{19.625648, -13.620907, 1.699764},
+	{15.5518875, -10.430853, 1.2033685},
+	{11.995906, -7.6300535, 0.7656582},
+	{8.792249, -5.091699, 0.36706117},
+	{5.8242927, -2.7258646, -0.0063981935},
+	{3.003387, -0.46359387, -0.36553648},
+	{0.25634205, 1.7527171, -0.71949977},
+	{-2.4833434, 3.9761639, -1.0768449},
+	{-5.283324, 6.2615166, -1.446538},
+	{-8.221615, 8.672494, -1.8391459},
+	{-11.397399, 11.290602, -2.26832},
+	{69.80959, -52.69635, 7.9000235},
+	{50.859077, -38.139896, 5.6429987},
+	{39.54558, -29.416185, 4.2881284},
+	{31.701942, -23.34281, 3.343369},
+	{25.759306, -18.720873, 2.6231616},
+	{20.975695, -14.982856, 2.0395648},
+	{16.946123, -11.818564, 1.5444338},
+	{13.425479, -9.039834, 1.1084899},
+	{10.252452, -6.5223875, 0.7123206},
+	{7.3125143, -4.177503, 0.34198636},
+	{4.517939, -1.9366733, -0.013362597},
+	{1.7958295, 0.2576375, -0.36292878},
+	{-0.9201615, 2.4584699, -0.7152902},
+	{-3.697075, 4.7201214, -1.0793282},
+	{-6.611374, 7.105083, -1.4653615},
+	{-9.760771, 9.693534, -1.8867168},
+	{69.736374, -53.13581, 8.156047},
+	{51.634506, -39.151253, 5.9600434},
+	{40.621376, -30.614359, 4.6188016},
+	{32.912743, -24.617023, 3.6761274},
+	{27.040186, -20.030222, 2.9547925},
+	{22.297106, -16.310268, 2.369389},
+	{18.29343, -13.15668, 1.8726661},
+	{14.791163, -10.385776, 1.4356948},
+	{11.632712, -7.8755627, 1.0392114},
+	{8.705597, -5.5385528, 0.6693454},
+	{5.923176, -3.3068805, 0.315273},
+	{3.2129755, -1.1232332, -0.032217443},
+	{0.5086324, 1.0654817, -0.38173407},
+	{-2.2569635, 3.3136044, -0.74216056},
+	{-5.15973, 5.6831746, -1.1237051},
+	{-8.296493, 8.253576, -1.5394768},
+	{69.994736, -53.815582, 8.445829},
+	{52.576042, -40.283672, 6.2946944},
+	{41.800953, -31.888168, 4.960644},
+	{34.1931, -25.941273, 4.016132},
+	{28.367176, -21.371525, 3.2906725},
+	{23.646116, -17.654943, 2.7008648},
+	{19.65227, -14.498996, 2.2001252},
+	{16.15352, -11.723606, 1.7597572},
+	{12.995334, -9.208587, 1.360584},
+	{10.066938, -6.867454, 0.98877794},
+	{7.282697, -4.632953, 0.6335501},
+	{4.570683, -2.448134, 0.28572145},
+	{1.8646758, -0.26002643, -0.06329658},
+	{-0.902614, 1.985791, -0.42238146},
+	{-3.8071744, 4.351347, -0.80169713},
+	{-6.9455123, 6.915695, -1.2142174},
+	{70.61327, -54.768776, 8.778922},
+	{53.72915, -41.580658, 6.657216},
+	{43.132103, -33.282017, 5.323744},
+	{35.591454, -27.360132, 4.373385},
+	{29.789364, -22.789782, 3.6408513},
+	{25.072708, -19.062634, 3.0441897},
+	{21.073757, -15.892283, 2.5372274},
+	{17.564962, -13.101284, 2.091358},
+	{14.394044, -10.570658, 1.6873986},
+	{11.451427, -8.214526, 1.3115008},
+	{8.652152, -5.9659915, 0.9528707},
+	{5.924688, -3.7683606, 0.6023501},
+	{3.2030075, -1.5688555, 0.2513949},
+	{0.419769, 0.6868761, -0.10882009},
+	{-2.501233, 3.0608966, -0.4884005},
+	{-5.656546, 5.632178, -0.900214},
+	{71.63467, -56.03916, 9.166549},
+	{55.146187, -43.09114, 7.0588126},
+	{44.66709, -34.843872, 5.7188253},
+	{37.15919, -28.92048, 4.758292},
+	{31.357584, -24.331266, 4.0155764},
+	{26.627594, -20.57948, 3.4095771},
+	{22.608856, -17.382921, 2.8942428},
+	{19.076982, -14.565712, 2.4408896},
+	{15.881079, -12.009402, 2.030218},
+	{12.912139, -9.628225, 1.6482728},
+	{10.085392, -7.355245, 1.2841839},
+	{7.329321, -5.133712, 0.92875683},
+	{4.577838, -2.910835, 0.5734573},
+	{1.7634854, -0.63230085, 0.20951723},
+	{-1.1901363, 1.7639039, -0.17307332},
+	{-4.379682, 4.3566427, -0.58704287},
+	{73.12248, -57.687542, 9.623076},
+	{56.89292, -44.875397, 7.51294},
+	{46.46889, -36.630886, 6.158494},
+	{38.95679, -30.677073, 5.1828914},
+	{33.130604, -26.049162, 4.42654},
+	{28.368494, -22.257742, 3.808529},
+	{24.314758, -19.022743, 3.2825959},
+	{20.746647, -16.168673, 2.8197844},
+	{17.513676, -13.57684, 2.4005458},
+	{14.506706, -11.161024, 2.0107176},
+	{11.64057, -8.853772, 1.6392641},
+	{8.843233, -6.5978293, 1.2768687},
+	{6.0480404, -4.339965, 0.914924},
+	{3.1869822, -2.0255413, 0.5446514},
+	{0.18319778, 0.40760306, 0.15611042},
+	{-3.0601454, 3.0382032, -0.26325592},
+	{75.17022, -59.80001, 10.167837},
+	{59.05734, -47.01295, 8.037018},
+	{48.619267, -38.716698, 6.6588078},
+	{41.061535, -32.699467, 5.6623645},
+	{35.182632, -28.010328, 4.888363},
+	{30.367582, -24.162535, 4.2553177},
+	{26.262339, -20.875797, 3.7163596},
+	{22.64408, -17.97365, 3.2420247},
+	{19.361624, -15.336276, 2.8123584},
+	{16.30489, -12.876343, 2.4128702},
+	{13.387643, -10.525325, 2.032254},
+	{10.536698, -8.224934, 1.6609677},
+	{7.684167, -5.9209294, 1.290219},
+	{4.7607536, -3.5577214, 0.9110929},
+	{1.6883647, -1.0723481, 0.5135928},
+	{-1.6306374, 1.6142876, 0.08525362},
+	{77.91761, -62.502495, 10.828262},
+	{61.763504, -49.614902, 8.65506},
+	{51.23115, -41.20242, 7.241658},
+	{43.579025, -35.082355, 6.217275},
+	{37.614414, -30.30526, 5.4207654},
+	{32.72235, -26.381609, 4.7691298},
+	{28.54695, -23.02809, 4.2143974},
+	{24.863274, -20.065615, 3.726304},
+	{21.518133, -17.372175, 3.2842927},
+	{18.39954, -14.858536, 2.8733976},
+	{15.4194145, -12.454453, 2.4819193},
+	{12.502675, -10.099984, 2.099969},
+	{9.579415, -7.739214, 1.7184364},
+	{6.5781174, -5.3147964, 1.3281094},
+	{3.4182749, -2.761954, 0.9187303},
+	{-3.446588e-06, 2.4901567e-06, 0.477685},
+	{77.198, -44.71838, 1.9654952},
+	{24.922417, -7.199957, -3.142716},
+	{6.8868833, 5.4232526, -4.704546},
+	{-2.6530416, 11.95818, -5.433776},
+	{-8.818573, 16.143412, -5.867999},
+	{-13.339612, 19.225454, -6.1795945},
+	{-16.979584, 21.747545, -6.4406796},
+	{-20.14903, 23.999949, -6.6889334},
+	{-23.09718, 26.15978, -6.947366},
+	{-25.991596, 28.349047, -7.2323728},
+	{-28.966166, 30.669773, -7.558817},
+	{-32.152035, 33.227448, -7.943632},
+	{-35.703495, 36.151985, -8.409356},
+	{-39.83052, 39.625744, -8.989251},
+	{-44.85493, 43.933376, -9.736757},
+	{-51.328655, 49.567944, -10.745932},
+	{91.70884, -61.788044, 6.9148407},
+	{48.425697, -30.52873, 2.5973268},
+	{29.455221, -16.53511, 0.57089055},
+	{17.926687, -7.913502, -0.7072386},
+	{9.883728, -1.8412466, -1.6161623},
+	{3.7661204, 2.8180342, -2.3175073},
+	{-1.1937094, 6.632135, -2.8951547},
+	{-5.428834, 9.925546, -3.3982983},
+	{-9.212517, 12.906008, -3.8591871},
+	{-12.739033, 15.723955, -4.3018174},
+	{-16.168352, 18.505943, -4.747034},
+	{-19.649595, 21.373213, -5.2155194},
+	{-23.340136, 24.457842, -5.7305903},
+	{-27.433466, 27.926645, -6.3225136},
+	{-32.205875, 32.022038, -7.036081},
+	{-38.109364, 37.144547, -7.9461074},
+	{89.63248, -62.18075, 7.693525},
+	{52.454155, -35.10324, 3.8796823},
+	{35.30227, -22.43599, 2.0475557},
+	{24.581598, -14.411162, 0.85990816},
+	{16.883984, -8.577488, -0.019263254},
+	{10.887378, -3.9807458, -0.7217359},
+	{5.940251, -0.14619698, -1.3145586},
+	{1.6669674, 3.203807, -1.8382002},
+	{-2.1750453, 6.251722, -2.3201404},
+	{-5.7594585, 9.13109, -2.7811985},
+	{-9.225754, 11.952181, -3.2392216},
+	{-12.703962, 14.820829, -3.7119942},
+	{-16.336666, 17.856016, -4.2201543},
+	{-20.301481, 21.209297, -4.790653},
+	{-24.848658, 25.098051, -5.4628386},
+	{-30.380402, 29.875612, -6.301377},
+	{86.87196, -61.400402, 8.045582},
+	{53.98347, -37.220917, 4.5637627},
+	{38.063145, -25.396938, 2.8345077},
+	{27.974712, -17.824213, 1.7102782},
+	{20.671541, -12.281631, 0.875503},
+	{14.933336, -7.877938, 0.20325403},
+	{10.158465, -4.172216, -0.36956093},
+	{6.00376, -0.9110887, -0.8796393},
+	{2.248024, 2.0710325, -1.3515278},
+	{-1.2683084, 4.896115, -1.8039031},
+	{-4.674435, 7.665657, -2.2529068},
+	{-8.08945, 10.476241, -2.7145064},
+	{-11.641413, 13.434786, -3.206983},
+	{-15.492222, 16.679134, -3.7544725},
+	{-19.874123, 20.409742, -4.3926287},
+	{-25.161192, 24.952765, -5.1800694},
+	{84.46512, -60.58766, 8.283154},
+	{54.815937, -38.59653, 5.051615},
+	{39.829403, -27.390785, 3.3891418},
+	{30.1961, -20.124798, 2.3008268},
+	{23.18329, -14.785665, 1.4932189},
+	{17.656721, -10.536118, 0.84385425},
+	{13.045296, -6.9532223, 0.29060924},
+	{9.020427, -3.7922843, -0.20270409},
+	{5.370927, -0.8944756, -0.65986514},
+	{1.9451538, 1.8561631, -1.0986135},
+	{-1.3798406, 4.555959, -1.5341473},
+	{-4.7179265, 7.2967987, -1.9814785},
+	{-8.190682, 10.179744, -2.4576576},
+	{-11.949472, 13.333474, -2.9849212},
+	{-16.213017, 16.946177, -3.5962615},
+	{-21.337997, 21.327002, -4.346357},
+	{82.58144, -59.999165, 8.494181},
+	{55.450916, -39.71107, 5.457714},
+	{41.208504, -28.988289, 3.8432443},
+	{31.920834, -21.943777, 2.7761788},
+	{25.11739, -16.741608, 1.9831141},
+	{19.741806, -12.595183, 1.3465731},
+	{15.251591, -9.099249, 0.805778},
+	{11.329914, -6.015843, 0.32480806},
+	{7.770949, -3.188986, -0.12012066},
+	{4.426348, -0.5046653, -0.5466595},
+	{1.1759723, 2.131358, -0.96971923},
+	{-2.0915096, 4.8086843, -1.4038638},
+	{-5.4952273, 7.6257977, -1.865537},
+	{-9.182298, 10.707142, -2.3759754},
+	{-13.363356, 14.23335, -2.9664345},
+	{-18.384558, 18.503012, -3.688966},
+	{81.24128, -59.715702, 8.715489},
+	{56.09542, -40.76767, 5.8314686},
+	{42.45466, -30.428528, 4.2521067},
+	{33.435993, -23.548227, 3.1972668},
+	{26.785217, -18.43806, 2.410699},
+	{21.513262, -14.355793, 1.77953},
+	{17.103815, -10.91283, 1.2444715},
+	{13.251593, -7.878265, 0.77010053},
+	{9.756081, -5.0991592, 0.33274966},
+	{6.47122, -2.4626672, -0.08527101},
+	{3.2778356, 0.12496366, -0.49890864},
+	{0.06516616, 2.7528098, -0.9226459},
+	{-3.2855396, 5.51861, -1.3726937},
+	{-6.9209332, 8.545607, -1.8698491},
+	{-11.049089, 12.011299, -2.444437},
+	{-16.011549, 16.20891, -3.1468818},
+	{80.436935, -59.7711, 8.966999},
+	{56.86264, -41.878883, 6.2006774},
+	{43.705803, -31.8413, 4.645777},
+	{34.895226, -25.080593, 3.5964665},
+	{28.353914, -20.029076, 2.8107264},
+	{23.149464, -15.981929, 2.179587},
+	{18.788118, -12.56497, 1.6450658},
+	{14.974994, -9.553746, 1.1722468},
+	{11.514832, -6.7985296, 0.73768705},
+	{8.264234, -4.1880803, 0.32379323},
+	{5.105038, -1.6292322, -0.08437102},
+	{1.9263599, 0.9671588, -0.50131625},
+	{-1.3914263, 3.6991992, -0.9432424},
+	{-4.9965744, 6.6906796, -1.4308406},
+	{-9.0988245, 10.119304, -1.9940968},
+	{-14.040635, 14.277504, -2.6826348},
+	{80.1734, -60.197216, 9.264103},
+	{57.83273, -43.124092, 6.584869},
+	{45.056034, -33.31472, 5.0445576},
+	{36.400192, -26.634594, 3.9949515},
+	{29.931894, -21.61396, 3.2054503},
+	{24.765398, -17.578604, 2.5701787},
+	{20.425562, -14.166061, 2.03214},
+	{16.626064, -11.157095, 1.5567781},
+	{13.176076, -8.404644, 1.120822},
+	{9.934685, -5.7990255, 0.70680267},
+	{6.7850432, -3.2479944, 0.2998923},
+	{3.616491, -0.66267836, -0.11435397},
+	{0.30831972, 2.0555594, -0.5521394},
+	{-3.290372, 5.0319476, -1.0342084},
+	{-7.393769, 8.446601, -1.5905899},
+	{-12.350173, 12.595097, -2.270779},
+	{80.47253, -61.032436, 9.620966},
+	{59.078312, -44.573524, 7.000883},
+	{46.58516, -34.922707, 5.465251},
+	{38.033665, -28.285892, 4.4096437},
+	{31.60474, -23.270521, 3.6121566},
+	{26.449865, -19.226332, 2.9691334},
+	{22.108494, -15.799736, 2.4241767},
+	{18.300783, -12.775186, 1.9428722},
+	{14.839051, -10.007343, 1.5019753},
+	{11.584053, -7.3874674, 1.0840464},
+	{8.41987, -4.8239527, 0.67433184},
+	{5.2361684, -2.2283428, 0.2585058},
+	{1.9115425, 0.49815023, -0.17953329},
+	{-1.7076018, 3.4821877, -0.6605485},
+	{-5.841369, 6.9074354, -1.2147806},
+	{-10.847921, 11.075731, -1.8920892},
+	{81.381935, -62.332336, 10.053686},
+	{60.679344, -46.302166, 7.4660535},
+	{48.375168, -36.74005, 5.924558},
+	{39.877323, -30.108376, 4.856866},
+	{33.45428, -25.072533, 4.047076},
+	{28.285566, -20.999575, 3.3928049},
+	{23.92114, -17.541782, 2.8378098},
+	{20.085396, -14.485627, 2.3475564},
+	{16.59231, -11.686347, 1.8986359},
+	{13.30315, -9.035223, 1.4734762},
+	{10.101935, -6.4405003, 1.0572555},
+	{6.87793, -3.8135517, 0.63566536},
+	{3.5088246, -1.055312, 0.19270721},
+	{-0.16136257, 1.9619731, -0.29228944},
+	{-4.358891, 5.4255853, -0.84971803},
+	{-9.45451, 9.645618, -1.5300546},
+	{82.990166, -64.18442, 10.583842},
+	{62.73688, -48.402245, 8.000992},
+	{50.52383, -38.854736, 6.441781},
+	{42.025566, -32.186626, 5.355085},
+	{35.572815, -27.10259, 4.5282226},
+	{30.363838, -22.980053, 3.8590198},
+	{25.954777, -19.4739, 3.2908714},
+	{22.07181, -16.370749, 2.788813},
+	{18.52894, -13.52512, 2.329049},
+	{15.186588, -10.82724, 1.8936766},
+	{11.927418, -8.1841345, 1.467605},
+	{8.638915, -5.5058722, 1.0363263},
+	{5.196532, -2.6920118, 0.5837417},
+	{1.4411429, 0.38686657, 0.08919687},
+	{-2.8596015, 3.9214442, -0.47772732},
+	{-8.090155, 8.230648, -1.168061},
+	{85.44316, -66.72329, 11.241943},
+	{65.391174, -50.999355, 8.633059},
+	{53.161304, -41.382652, 7.0420046},
+	{44.601463, -34.630123, 5.927992},
+	{38.078865, -29.466179, 5.078454},
+	{32.800476, -25.270927, 4.390171},
+	{28.323755, -21.698093, 3.8055434},
+	{24.373896, -18.53226, 3.288801},
+	{20.76303, -15.625738, 2.8154902},
+	{17.34921, -12.866456, 2.3671496},
+	{14.012237, -10.158987, 1.9281818},
+	{10.636069, -7.4106426, 1.4835981},
+	{7.091728, -4.5177717, 1.0168419},
+	{3.2143831, -1.347161, 0.50687283},
+	{-1.2362875, 2.2967355, -0.07697271},
+	{-6.6590824, 6.7418127, -0.78610116},
+	{88.9786, -70.16123, 12.074002},
+	{68.85123, -54.27843, 9.401883},
+	{56.47645, -44.49083, 7.761058},
+	{47.781364, -37.595024, 6.609183},
+	{41.141003, -32.31281, 5.7300377},
+	{35.75927, -28.017721, 5.0177593},
+	{31.189096, -24.357689, 4.412938},
+	{27.1513, -21.112555, 3.8785074},
+	{23.453865, -18.130568, 3.389019},
+	{19.95065, -15.295886, 2.925172},
+	{16.516817, -12.50918, 2.4705846},
+	{13.030761, -9.673304, 2.009454},
+	{9.3562975, -6.679092, 1.5243125},
+	{5.318847, -3.3861392, 0.9930676},
+	{0.66465694, 0.41053006, 0.38394257},
+	{-5.023979, 5.051325, -0.35536534},
+	{94.00198, -74.854324, 13.155329},
+	{73.450966, -58.53284, 10.369707},
+	{60.765762, -48.4404, 8.65465},
+	{51.840324, -41.324203, 7.450736},
+	{45.02155, -35.874676, 6.532981},
+	{39.49507, -31.446539, 5.790669},
+	{34.80161, -27.675714, 5.161438},
+	{30.65313, -24.333658, 4.606253},
+	{26.850473, -21.262133, 4.0982103},
+	{23.240965, -18.3396, 3.6168056},
+	{19.692928, -15.461025, 3.1445215},
+	{16.076666, -12.522746, 2.6643398},
+	{12.245407, -9.407274, 2.1573277},
+	{8.009594, -5.9626555, 1.5994799},
+	{3.0932283, -1.9670591, 0.95644444},
+	{-2.9539664, 2.9439278, 0.17283607},
+	{101.23673, -81.43229, 14.6173525},
+	{79.76769, -64.26773, 11.6431},
+	{66.53651, -53.67709, 9.817591},
+	{57.247883, -46.231644, 8.541278},
+	{50.169106, -40.548466, 7.5726533},
+	{44.44549, -35.9454, 6.7927723},
+	{39.594387, -32.03717, 6.134591},
+	{35.312424, -28.581532, 5.5560946},
+	{31.389025, -25.410244, 5.0282555},
+	{27.661686, -22.393398, 4.528848},
+	{23.988869, -19.417795, 4.038748},
+	{20.22941, -16.370518, 3.539188},
+	{16.221458, -13.122108, 3.0090592},
+	{11.753573, -9.503397, 2.421329},
+	{6.5153766, -5.265602, 1.7370743},
+	{-7.124614e-06, 5.1485117e-06, 0.89381325},
+	{106.36431, -65.36932, 5.118405},
+	{35.77091, -15.269934, -1.6788139},
+	{13.732107, 0.25571468, -3.7023177},
+	{2.4783256, 8.069242, -4.6484065},
+	{-4.664026, 13.007714, -5.2166376},
+	{-9.8473835, 16.621075, -5.626441},
+	{-13.993813, 19.568834, -5.968715},
+	{-17.582224, 22.19384, -6.2900844},
+	{-20.911592, 24.713682, -6.6208014},
+	{-24.202719, 27.295301, -6.985557},
+	{-27.649542, 30.094168, -7.4094005},
+	{-31.463322, 33.290264, -7.923796},
+	{-35.927956, 37.13651, -8.575526},
+	{-41.49843, 42.04885, -9.4440365},
+	{-49.032604, 48.821926, -10.6836605},
+	{-60.494137, 59.286705, -12.653138},
+	{111.48459, -75.15352, 8.80955},
+	{56.589767, -36.051945, 3.4756234},
+	{35.140556, -20.438566, 1.2458717},
+	{22.534506, -11.104977, -0.12609448},
+	{13.860443, -4.5978556, -1.0972669},
+	{7.3091645, 0.3776902, -1.847372},
+	{2.0101829, 4.456661, -2.4686322},
+	{-2.5248096, 8.001725, -3.0155466},
+	{-6.606653, 11.248989, -3.5247977},
+	{-10.460805, 14.375292, -4.024965},
+	{-14.278753, 17.537323, -4.542558},
+	{-18.259098, 20.904755, -5.107578},
+	{-22.652714, 24.699581, -5.7606244},
+	{-27.835915, 29.26341, -6.565623},
+	{-34.479553, 35.21489, -7.6399364},
+	{-44.04442, 43.91276, -9.243374},
+	{105.76857, -73.14457, 9.305537},
+	{59.773834, -39.98814, 4.650938},
+	{40.448765, -25.85975, 2.6202366},
+	{28.826305, -17.239237, 1.3541299},
+	{20.643665, -11.08169, 0.43235254},
+	{14.332082, -6.2632794, -0.30083692},
+	{9.147139, -2.2464378, -0.92103446},
+	{4.66798, 1.2776241, -1.4729575},
+	{0.6215143, 4.5142174, -1.9875298},
+	{-3.1942093, 7.620378, -2.4895048},
+	{-6.952479, 10.736767, -3.0021694},
+	{-10.829978, 14.013636, -3.5515695},
+	{-15.044208, 17.643482, -4.172285},
+	{-19.91927, 21.920565, -4.9183655},
+	{-26.031351, 27.375381, -5.888476},
+	{-34.62046, 35.15918, -7.298335},
+	{100.755615, -70.909164, 9.495115},
+	{60.873318, -41.83391, 5.305247},
+	{42.99829, -28.663837, 3.381694},
+	{32.063244, -20.513542, 2.1753392},
+	{24.313503, -14.664425, 1.2977777},
+	{18.305094, -10.067889, 0.5985931},
+	{13.342623, -6.2163916, 0.0045457697},
+	{9.035437, -2.821951, -0.5264241},
+	{5.1327662, 0.3038483, -1.0224997},
+	{1.4496416, 3.304398, -1.505966},
+	{-2.1733358, 6.308559, -1.9978056},
+	{-5.899844, 9.45478, -2.5215952},
+	{-9.931763, 12.92067, -3.1086528},
+	{-14.566496, 16.975319, -3.807527},
+	{-20.328072, 22.10049, -4.70627},
+	{-28.343565, 29.340647, -5.997119},
+	{96.874725, -69.16221, 9.633492},
+	{61.46933, -43.090637, 5.790941},
+	{44.701134, -30.63453, 3.9399018},
+	{34.25864, -22.801289, 2.7661054},
+	{26.808804, -17.151533, 1.9119067},
+	{21.020071, -12.707871, 1.233454},
+	{16.235712, -8.985784, 0.65901357},
+	{12.081226, -5.7063923, 0.14688635},
+	{8.315153, -2.6868849, -0.3307239},
+	{4.7604923, 0.21045384, -0.7953204},
+	{1.2658366, 3.1080456, -1.2667282},
+	{-2.3240013, 6.136878, -1.7669965},
+	{-6.201163, 9.465289, -2.3253677},
+	{-10.6502495, 13.349263, -2.9872494},
+	{-16.172354, 18.246962, -3.834935},
+	{-23.844767, 25.152357, -5.048214},
+	{94.05493, -68.00827, 9.794045},
+	{62.011395, -44.19522, 6.213363},
+	{46.117474, -32.294052, 4.41476},
+	{36.04725, -24.68849, 3.2593493},
+	{28.808216, -19.167599, 2.4157865},
+	{23.166002, -14.816981, 1.7466303},
+	{18.499794, -11.174539, 1.1820844},
+	{14.450512, -7.9706116, 0.68107015},
+	{10.783939, -5.026506, 0.21597205},
+	{7.3269944, -2.2067761, -0.23460262},
+	{3.9315457, 0.6086553, -0.6901591},
+	{0.44634992, 3.547339, -1.1720469},
+	{-3.3157456, 6.772673, -1.708322},
+	{-7.6334915, 10.533917, -2.3425374},
+	{-13.002011, 15.280266, -3.1540084},
+	{-20.493593, 21.993217, -4.3171434},
+	{92.16932, -67.43234, 10.0032},
+	{62.69441, -45.341965, 6.620692},
+	{47.492863, -33.871883, 4.8591304},
+	{37.70647, -26.430296, 3.712719},
+	{30.61576, -20.990498, 2.8717024},
+	{25.067205, -16.690777, 2.2041016},
+	{20.470861, -13.088505, 1.6418823},
+	{16.481672, -9.922651, 1.144665},
+	{12.87264, -7.0189557, 0.68518656},
+	{9.474621, -4.2444367, 0.24227071},
+	{6.141524, -1.4804025, -0.2034242},
+	{2.7232187, 1.3996413, -0.67300487},
+	{-0.96663237, 4.5577106, -1.1940302},
+	{-5.2069845, 8.241561, -1.8091931},
+	{-10.498762, 12.9014435, -2.596683},
+	{-17.9429, 19.534134, -3.7304287},
+	{91.15278, -67.437386, 10.28005},
+	{63.636417, -46.64933, 7.042523},
+	{48.972607, -35.503555, 5.30418},
+	{39.395924, -28.172825, 4.15906},
+	{32.403793, -22.776783, 3.314508},
+	{26.90826, -18.496231, 2.6428452},
+	{22.344482, -14.904166, 2.0773604},
+	{18.37876, -11.746423, 1.5781693},
+	{14.790043, -8.852356, 1.1182837},
+	{11.412728, -6.0912952, 0.67677385},
+	{8.10283, -3.3461325, 0.234555},
+	{4.7107816, -0.49109948, -0.22925156},
+	{1.048647, 2.6363444, -0.7420301},
+	{-3.167919, 6.28664, -1.3463539},
+	{-8.456069, 10.919551, -2.1207473},
+	{-15.974081, 17.570065, -3.242479},
+	{90.98718, -68.04626, 10.6414},
+	{64.9365, -48.21434, 7.5024905},
+	{50.67007, -37.293663, 5.773667},
+	{41.234753, -30.024204, 4.62244},
+	{34.296795, -24.638634, 3.7689579},
+	{28.819626, -20.350458, 3.0885828},
+	{24.257675, -16.744228, 2.5153804},
+	{20.285507, -13.570428, 2.0096443},
+	{16.685986, -10.660527, 1.5444278},
+	{13.295532, -7.885035, 1.0988674},
+	{9.971231, -5.1276917, 0.65403324},
+	{6.56358, -2.263199, 0.18928206},
+	{2.8822286, 0.87196195, -0.32260114},
+	{-1.3659296, 4.533391, -0.9244232},
+	{-6.723627, 9.19753, -1.6961694},
+	{-14.4335985, 15.958546, -2.8216977},
+	{91.70367, -69.312454, 11.106679},
+	{66.70243, -50.138428, 8.024229},
+	{52.698315, -39.344734, 6.290411},
+	{43.336323, -32.086117, 5.125183},
+	{36.409008, -26.678038, 4.257319},
+	{30.917538, -22.35697, 3.5638466},
+	{26.32972, -18.714748, 2.9789836},
+	{22.325115, -15.5040865, 2.4628224},
+	{18.688179, -12.556799, 1.9881611},
+	{15.255302, -9.742964, 1.5339174},
+	{11.882528, -6.9455132, 1.0810196},
+	{8.418339, -4.0380235, 0.6087908},
+	{4.668306, -0.8548702, 0.0900511},
+	{0.328469, 2.8659673, -0.5183132},
+	{-5.1768446, 7.6227984, -1.2983934},
+	{-13.201102, 14.588619, -2.4434056},
+	{93.41061, -71.348076, 11.704961},
+	{69.07406, -52.54868, 8.636106},
+	{55.193474, -41.77821, 6.8808875},
+	{45.83223, -34.475307, 5.692579},
+	{38.869396, -29.009165, 4.8042707},
+	{33.330093, -24.629059, 4.0931096},
+	{28.689259, -20.929453, 3.4927273},
+	{24.627918, -17.662592, 2.9625692},
+	{20.9296, -14.658681, 2.4748363},
+	{17.428345, -11.785532, 2.0078936},
+	{13.976546, -8.923173, 1.5421364},
+	{10.417292, -5.941359, 1.0563548},
+	{6.547879, -2.6690924, 0.5228325},
+	{2.0492876, 1.1650383, -0.10224924},
+	{-3.6931305, 6.0850058, -0.9033607},
+	{-12.1679125, 13.360023, -2.0851066},
+	{96.3148, -74.344154, 12.479827},
+	{72.25434, -55.62519, 9.377088},
+	{58.343075, -44.75935, 7.580569},
+	{48.899536, -37.347565, 6.3580384},
+	{41.848698, -31.782228, 5.4420547},
+	{36.224594, -27.313982, 4.708013},
+	{31.502216, -23.53446, 4.0880423},
+	{27.359982, -20.19228, 3.5403898},
+	{23.577625, -17.11377, 3.0362513},
+	{19.984276, -14.162422, 2.5530567},
+	{16.42572, -11.212978, 2.0702167},
+	{12.735554, -8.128063, 1.5653596},
+	{8.696432, -4.7261953, 1.0092804},
+	{3.9648392, -0.7192086, 0.35616812},
+	{-2.1235952, 4.450098, -0.4817785},
+	{-11.214527, 12.161854, -1.7216611},
+	{100.78539, -78.62746, 13.501632},
+	{76.563034, -59.647778, 10.306585},
+	{62.433437, -48.53856, 8.442675},
+	{52.80504, -40.936466, 7.171345},
+	{45.60238, -35.22117, 6.2185364},
+	{39.850258, -30.630474, 5.455428},
+	{35.01491, -26.746243, 4.811413},
+	{30.767073, -23.309416, 4.2428083},
+	{26.879152, -20.139614, 3.7192802},
+	{23.172297, -17.093538, 3.2168536},
+	{19.48227, -14.037878, 2.7134237},
+	{15.628401, -10.824254, 2.1847124},
+	{11.370726, -7.2542543, 1.5987939},
+	{6.326473, -3.0110807, 0.9056525},
+	{-0.24349628, 2.5152657, 0.010810897},
+	{-10.173001, 10.837304, -1.3173292},
+	{107.514885, -84.79853, 14.89591},
+	{82.54835, -65.092514, 11.524598},
+	{67.94574, -53.534843, 9.55576},
+	{57.99442, -45.631077, 8.215157},
+	{50.556896, -39.69908, 7.213312},
+	{44.623928, -34.943924, 6.413571},
+	{39.640797, -30.92785, 5.740778},
+	{35.26353, -27.378456, 5.1482596},
+	{31.25243, -24.104765, 4.603415},
+	{27.41661, -20.953415, 4.0802355},
+	{23.57747, -17.779522, 3.5544176},
+	{19.534098, -14.418878, 2.9988363},
+	{15.01393, -10.648055, 2.3772817},
+	{9.575086, -6.1055293, 1.6324545},
+	{2.3589504, -0.09229632, 0.65646565},
+	{-8.736103, 9.098772, -0.80662125},
+	{117.85508, -94.019005, 16.90218},
+	{91.2501, -72.85872, 13.21898},
+	{75.78328, -60.53302, 11.085247},
+	{65.303925, -52.160442, 9.644147},
+	{57.516056, -45.918617, 8.576208},
+	{51.337204, -40.947887, 7.7309294},
+	{46.172573, -36.775246, 7.025644},
+	{41.65264, -33.10606, 6.408996},
+	{37.518383, -29.732906, 5.8449955},
+	{33.56103, -26.487614, 5.304686},
+	{29.58142, -23.208765, 4.760601},
+	{25.35, -19.709608, 4.181462},
+	{20.547533, -15.730418, 3.5245771},
+	{14.644894, -10.842245, 2.7209635},
+	{6.5953712, -4.2011414, 1.6385807},
+	{-6.165036, 6.254591, -0.032449152},
+	{134.90392, -108.94112, 20.064207},
+	{104.959015, -84.92373, 15.801709},
+	{87.92265, -71.24862, 13.392344},
+	{76.5719, -62.1263, 11.798083},
+	{68.26567, -55.44044, 10.6401415},
+	{61.774624, -50.205864, 9.742521},
+	{56.429146, -45.885666, 9.00955},
+	{51.815506, -42.14773, 8.382272},
+	{47.643894, -38.75897, 7.8195677},
+	{43.679035, -35.529686, 7.2882886},
+	{39.692375, -32.275234, 6.7565794},
+	{35.41311, -28.776777, 6.1872406},
+	{30.452545, -24.72082, 5.527804},
+	{24.14604, -19.57321, 4.6903715},
+	{15.138216, -12.248688, 3.5001652},
+	{-1.9831561e-05, 1.4328059e-05, 1.5294791},
+	{133.51797, -83.927055, 7.6930475},
+	{44.224995, -21.403122, -0.6271849},
+	{18.912529, -3.549433, -3.006144},
+	{6.360326, 5.214431, -4.107375},
+	{-1.4776753, 10.679819, -4.7660336},
+	{-7.104341, 14.6448345, -5.238955},
+	{-11.572879, 17.86387, -5.631983},
+	{-15.422797, 20.725704, -5.999372},
+	{-18.984074, 23.474337, -6.3761554},
+	{-22.50464, 26.302862, -6.792118},
+	{-26.21635, 29.40511, -7.2800007},
+	{-30.390884, 33.024586, -7.8842545},
+	{-35.42562, 37.53579, -8.676762},
+	{-42.032032, 43.629578, -9.793864},
+	{-51.78659, 52.86565, -11.548185},
+	{-69.45083, 70.03709, -14.911538},
+	{129.60667, -87.373726, 10.496476},
+	{62.977154, -40.329346, 4.1246104},
+	{39.449917, -23.350847, 1.7221969},
+	{26.034042, -13.485489, 0.28218508},
+	{16.927866, -6.683495, -0.7293891},
+	{10.110813, -1.5139439, -1.5082488},
+	{4.63349, 2.7094903, -2.1528113},
+	{-0.031479787, 6.3767095, -2.7212784},
+	{-4.219009, 9.742928, -3.2533374},
+	{-8.1742, 13.003568, -3.7809172},
+	{-12.109935, 16.339176, -4.3352437},
+	{-16.253073, 19.95575, -4.9539223},
+	{-20.905294, 24.143812, -5.6921983},
+	{-26.560392, 29.400845, -6.6473017},
+	{-34.204765, 36.763744, -8.026239},
+	{-46.29968, 49.016693, -10.400867},
+	{119.84508, -82.71977, 10.685878},
+	{65.51547, -43.81238, 5.234064},
+	{44.38711, -28.463142, 3.0358183},
+	{32.06811, -19.37725, 1.7076533},
+	{23.550016, -12.99361, 0.75643516},
+	{17.057343, -8.045527, 0.006044998},
+	{11.770268, -3.943312, -0.6264667},
+	{7.2360325, -0.35542682, -1.1889949},
+	{3.1653204, 2.9368453, -1.7145205},
+	{-0.6541787, 6.102154, -2.2299109},
+	{-4.40482, 9.295565, -2.7614405},
+	{-8.2750845, 12.690564, -3.3402784},
+	{-12.502106, 16.52322, -4.0108886},
+	{-17.444109, 21.178467, -4.848485},
+	{-23.72688, 27.401262, -6.004156},
+	{-32.31047, 36.82, -7.8377094},
+	{112.4433, -78.92809, 10.694758},
+	{66.24982, -45.43608, 5.866947},
+	{46.7857, -31.166601, 3.7842054},
+	{35.202866, -22.569403, 2.513682},
+	{27.141264, -16.50185, 1.6052341},
+	{20.977428, -11.789405, 0.8898248},
+	{15.945044, -7.873868, 0.2865388},
+	{11.620974, -4.4427576, -0.25054306},
+	{7.739135, -1.294162, -0.75188},
+	{4.1080375, 1.7244426, -1.2415252},
+	{0.5667431, 4.750953, -1.742542},
+	{-3.0460308, 7.936764, -2.2818353},
+	{-6.9239297, 11.482787, -2.8970408},
+	{-11.337419, 15.704518, -3.6500661},
+	{-16.675678, 21.16722, -4.658721},
+	{-22.85776, 28.762941, -6.155185},
+	{107.071754, -76.22268, 10.7252655},
+	{66.6303, -46.581795, 6.349274},
+	{48.437645, -33.122616, 4.3470035},
+	{37.385094, -24.857628, 3.107821},
+	{29.632853, -18.988182, 2.2202091},
+	{23.692667, -14.42569, 1.5234635},
+	{18.844978, -10.640239, 0.9388334},
+	{14.686312, -7.330652, 0.42102054},
+	{10.961334, -4.301301, -0.0600304},
+	{7.487844, -1.4059167, -0.52758163},
+	{4.11625, 1.4848293, -1.0032288},
+	{0.7014092, 4.509604, -1.5114655},
+	{-2.923693, 7.848306, -2.0858366},
+	{-6.976044, 11.775396, -2.7803385},
+	{-11.702902, 16.753204, -3.6937656},
+	{-16.392714, 23.258701, -4.988581},
+	{103.31537, -74.48428, 10.825917},
+	{67.07395, -47.660637, 6.781835},
+	{49.876934, -34.82719, 4.837855},
+	{39.22431, -26.80051, 3.6159713},
+	{31.687607, -21.056927, 2.736673},
+	{25.891848, -16.581007, 2.0468009},
+	{21.159101, -12.868833, 1.4699455},
+	{17.104395, -9.630469, 0.96170396},
+	{13.482023, -6.6760716, 0.49246645},
+	{10.115781, -3.8630717, 0.039318234},
+	{6.861452, -1.065833, -0.41880363},
+	{3.5814574, 1.8485556, -0.90531075},
+	{0.12197088, 5.0496902, -1.4516386},
+	{-3.7075784, 8.792096, -2.1076603},
+	{-8.086412, 13.491432, -2.9629796},
+	{-11.986635, 19.441767, -4.1496863},
+	{100.8805, -73.592545, 11.012566},
+	{67.76804, -48.862915, 7.2127852},
+	{51.347015, -36.507187, 5.308951},
+	{40.99496, -28.648708, 4.094434},
+	{33.606735, -22.980917, 3.21529},
+	{27.899448, -18.54794, 2.5245185},
+	{23.229534, -14.867294, 1.9476246},
+	{19.227594, -11.658544, 1.440973},
+	{15.656342, -8.737027, 0.9754095},
+	{12.344958, -5.963676, 0.52842134},
+	{9.1531, -3.2155728, 0.07936935},
+	{5.946297, -0.36220324, -0.39464572},
+	{2.574146, 2.7631822, -0.92425454},
+	{-1.148861, 6.4111905, -1.5579461},
+	{-5.393396, 10.994373, -2.383348},
+	{-9.07365, 16.808199, -3.531775},
+	{99.634735, -73.512436, 11.302297},
+	{68.83671, -50.31425, 7.6738048},
+	{53.002525, -38.306, 5.7931213},
+	{42.865047, -30.554726, 4.577234},
+	{35.57008, -24.921923, 3.6917558},
+	{29.907497, -20.498316, 2.9942904},
+	{25.260628, -16.817816, 2.4116323},
+	{21.27181, -13.606826, 1.9005603},
+	{17.709677, -10.68411, 1.4321237},
+	{14.406908, -7.912786, 0.98402834},
+	{11.225471, -5.17165, 0.5359433},
+	{8.031831, -2.3312087, 0.06532778},
+	{4.6731606, 0.7765267, -0.45825058},
+	{0.95254743, 4.4100776, -1.0837743},
+	{-3.3427262, 9.014847, -1.9025311},
+	{-7.3055487, 15.052654, -3.07024},
+	{99.52181, -74.24565, 11.710957},
+	{70.39797, -52.129173, 8.192561},
+	{54.976597, -40.345154, 6.317687},
+	{44.972004, -32.641937, 5.0915513},
+	{37.719193, -27.006098, 4.1936193},
+	{32.062828, -22.562435, 3.484425},
+	{27.405745, -18.856054, 2.891328},
+	{23.397964, -15.617273, 2.3710823},
+	{19.811094, -12.666087, 1.8945855},
+	{16.478565, -9.865719, 1.4394068},
+	{13.261643, -7.094427, 0.9851455},
+	{10.024289, -4.2212963, 0.5092464},
+	{6.6063933, -1.0738969, -0.018937064},
+	{2.787262, 2.6225176, -0.6500141},
+	{-1.7324743, 7.376945, -1.4834023},
+	{-6.463609, 13.982086, -2.7231734},
+	{100.59072, -75.86802, 12.264677},
+	{72.59578, -54.440834, 8.799507},
+	{57.416363, -42.75632, 6.911549},
+	{47.46077, -35.03879, 5.66525},
+	{40.19819, -29.3606, 4.7483306},
+	{34.510544, -24.868002, 4.0224133},
+	{29.812601, -21.111761, 3.4145842},
+	{25.757835, -17.822966, 2.8810654},
+	{22.117727, -14.820475, 2.3921988},
+	{18.723415, -11.965254, 1.9249977},
+	{15.431734, -9.132126, 1.4584689},
+	{12.0988865, -6.1847615, 0.9693465},
+	{8.54987, -2.9407935, 0.42589685},
+	{4.527409, 0.8992749, -0.22523737},
+	{-0.39552972, 5.935291, -1.0951506},
+	{-6.40881, 13.468982, -2.4609742},
+	{103.02105, -78.5557, 13.006709},
+	{75.63553, -57.432606, 9.534772},
+	{60.516163, -45.710567, 7.611494},
+	{50.516678, -37.907764, 6.333119},
+	{43.18719, -32.143078, 5.389605},
+	{37.42852, -27.570562, 4.6415076},
+	{32.659073, -23.740383, 4.014616},
+	{28.531096, -20.380806, 3.4640133},
+	{24.812563, -17.306927, 2.9590187},
+	{21.329102, -14.374918, 2.475623},
+	{17.92906, -11.452962, 1.9916483},
+	{14.454608, -8.394415, 1.4822228},
+	{10.704933, -4.998666, 0.9130754},
+	{6.366427, -0.9272582, 0.22588474},
+	{0.838433, 4.5410256, -0.7059737},
+	{-7.0492454, 13.421045, -2.259459},
+	{107.16034, -82.621056, 14.0059805},
+	{79.83959, -61.386353, 10.458346},
+	{64.56725, -49.461582, 8.471332},
+	{54.41231, -41.486164, 7.145537},
+	{46.948227, -35.58171, 6.1659565},
+	{41.07348, -30.893744, 5.3893166},
+	{36.200153, -26.964096, 4.73877},
+	{31.973616, -23.51377, 4.167467},
+	{28.15436, -20.350935, 3.6431248},
+	{24.558867, -17.32406, 3.1401634},
+	{21.022532, -14.291121, 2.6345417},
+	{17.366467, -11.089575, 2.0987327},
+	{13.350564, -7.4900317, 1.4940048},
+	{8.575338, -3.0926821, 0.753223},
+	{2.1962657, 2.9947407, -0.27302378},
+	{-8.308007, 13.751267, -2.092815},
+	{113.70015, -88.66437, 15.388659},
+	{85.76084, -66.78007, 11.670407},
+	{70.05518, -54.42973, 9.579527},
+	{59.5979, -46.164417, 8.185006},
+	{51.91297, -40.051365, 7.156858},
+	{45.868504, -35.205376, 6.3440185},
+	{40.856903, -31.149298, 5.6650662},
+	{36.509068, -27.59079, 5.0700936},
+	{32.572838, -24.32692, 4.5244255},
+	{28.85101, -21.19477, 4.000201},
+	{25.160702, -18.037794, 3.4706755},
+	{21.293663, -14.670872, 2.9043736},
+	{16.95414, -10.822818, 2.2556448},
+	{11.616829, -6.0012684, 1.442878},
+	{4.075367, 0.9458012, 0.2791621},
+	{-10.065601, 14.324138, -1.9208826},
+	{124.03159, -97.87464, 17.39904},
+	{94.449104, -74.51613, 13.358554},
+	{77.88781, -61.398468, 11.100312},
+	{66.9133, -52.669403, 9.604808},
+	{58.889534, -46.252937, 8.510759},
+	{52.611095, -41.198284, 7.6528373},
+	{47.43018, -36.99265, 6.9419174},
+	{42.951824, -33.32106, 6.3232703},
+	{38.903698, -29.96336, 5.7586465},
+	{35.06867, -26.740156, 5.216905},
+	{31.238533, -23.474634, 4.667457},
+	{27.164793, -19.950274, 4.0731277},
+	{22.47437, -15.836641, 3.3779385},
+	{16.461212, -10.505025, 2.4775043},
+	{7.367975, -2.395625, 1.1208768},
+	{-11.986388, 14.79417, -1.6519233},
+	{141.32426, -112.94684, 20.58648},
+	{108.21257, -86.5731, 15.932622},
+	{90.04422, -72.07321, 13.390623},
+	{78.19637, -62.590473, 11.739933},
+	{69.664505, -55.735382, 10.555804},
+	{63.089977, -50.426285, 9.646213},
+	{57.748108, -46.08469, 8.908584},
+	{53.198875, -42.357285, 8.280355},
+	{49.13832, -38.997368, 7.717914},
+	{45.320847, -35.802223, 7.185513},
+	{41.50366, -32.56743, 6.647269},
+	{37.38295, -29.03308, 6.057921},
+	{32.47566, -24.783413, 5.345971},
+	{25.807112, -18.982983, 4.37046},
+	{14.747733, -9.407368, 2.7676704},
+	{-12.682455, 13.827433, -0.96651363},
+	{174.97606, -141.84282, 26.565395},
+	{133.53172, -108.498665, 20.540005},
+	{112.053665, -91.21543, 17.446661},
+	{98.6433, -80.41974, 15.537535},
+	{89.400375, -72.97421, 14.240947},
+	{82.6263, -67.51241, 13.308577},
+	{77.44474, -63.328964, 12.612788},
+	{73.3434, -60.010834, 12.0794},
+	{69.98462, -57.28476, 11.65995},
+	{67.108315, -54.938824, 11.317549},
+	{64.462006, -52.76544, 11.016756},
+	{61.7148, -50.4908, 10.711301},
+	{58.28151, -47.63062, 10.319251},
+	{52.811672, -43.073814, 9.652109},
+	{41.09497, -33.392166, 8.132109},
+	{-8.5586136e-05, 6.183518e-05, 2.7452419},
+	{150.94563, -95.627754, 9.229175},
+	{48.74336, -24.638, -0.08909787},
+	{21.6283, -5.517601, -2.6565962},
+	{8.395956, 3.7388277, -3.8365397},
+	{0.20987155, 9.465448, -4.539147},
+	{-5.625948, 13.595983, -5.0413456},
+	{-10.234463, 16.934969, -5.4567566},
+	{-14.1873, 19.89549, -5.843631},
+	{-17.830996, 22.73582, -6.2395773},
+	{-21.422928, 25.659992, -6.676648},
+	{-25.205044, 28.8765, -7.1908636},
+	{-29.465576, 32.655025, -7.8326826},
+	{-34.633335, 37.42366, -8.686528},
+	{-41.48917, 44.00273, -9.91966},
+	{-51.765766, 54.332577, -11.939695},
+	{-69.259796, 74.11504, -16.054506},
+	{140.63539, -94.80282, 11.50871},
+	{66.39238, -42.60584, 4.462492},
+	{41.701794, -24.860804, 1.962483},
+	{27.863317, -14.718723, 0.4875406},
+	{18.547724, -7.774733, -0.5428998},
+	{11.615011, -2.5203338, -1.3339386},
+	{6.075115, 1.7571919, -1.9871093},
+	{1.3830585, 5.460139, -2.5622427},
+	{-2.8036556, 8.850562, -3.100109},
+	{-6.7317944, 12.128317, -3.6335943},
+	{-10.610302, 15.477407, -4.195068},
+	{-14.652261, 19.106483, -4.8237996},
+	{-19.117716, 23.303852, -5.5774417},
+	{-24.353945, 28.534124, -6.555086},
+	{-30.568659, 35.5306, -7.939177},
+	{-29.232056, 41.12477, -9.504977},
+	{128.02884, -88.29085, 11.482126},
+	{68.5786, -45.85073, 5.5400553},
+	{46.45316, -29.82482, 3.24857},
+	{33.766308, -20.49179, 1.8872192},
+	{25.083986, -13.996671, 0.9215375},
+	{18.517193, -8.994277, 0.1642098},
+	{13.20629, -4.8671117, -0.47168437},
+	{8.684212, -1.2730386, -1.0355431},
+	{4.658677, 2.0103104, -1.5609314},
+	{0.92218447, 5.1514077, -2.0749009},
+	{-2.6926987, 8.300977, -2.6035511},
+	{-6.3389316, 11.619837, -3.1771374},
+	{-10.162244, 15.30717, -3.8367498},
+	{-14.227085, 19.614355, -4.642976},
+	{-17.739124, 24.557896, -5.654846},
+	{-4.826291, 22.23029, -5.906643},
+	{119.029144, -83.45007, 11.365496},
+	{69.104034, -47.348785, 6.16122},
+	{48.77279, -32.478497, 3.9913821},
+	{36.85081, -23.646147, 2.6869419},
+	{28.636332, -17.47013, 1.763036},
+	{22.409191, -12.708156, 1.0406586},
+	{17.367254, -8.776321, 0.43502054},
+	{13.0736, -5.3512893, -0.10152677},
+	{9.259845, -2.2275064, -0.6001482},
+	{5.741785, 0.7459076, -1.0849034},
+	{2.37886, 3.6989048, -1.578124},
+	{-0.9430252, 6.7624307, -2.1045654},
+	{-4.29783, 10.081897, -2.6955585},
+	{-7.5777826, 13.785005, -3.3900504},
+	{-9.392879, 17.48763, -4.1813498},
+	{10.647285, 10.61338, -3.7225392},
+	{112.70713, -80.1283, 11.32433},
+	{69.35731, -48.427437, 6.640771},
+	{50.39564, -34.426212, 4.5568047},
+	{39.02681, -25.936396, 3.28349},
+	{31.127645, -19.958675, 2.3792677},
+	{25.12594, -15.344882, 1.6744239},
+	{20.270853, -11.542331, 1.0866954},
+	{16.147394, -8.240454, 0.5692475},
+	{12.499334, -5.240829, 0.09141905},
+	{9.153031, -2.3994968, -0.3699986},
+	{5.9817605, 0.40349028, -0.8356884},
+	{2.8934963, 3.2827132, -1.3274673},
+	{-0.14408147, 6.3533783, -1.8712268},
+	{-2.9239175, 9.674979, -2.494151},
+	{-3.708701, 12.645216, -3.1553168},
+	{20.896786, 3.0740957, -2.3004966},
+	{108.3604, -78.014755, 11.383785},
+	{69.73896, -49.485744, 7.0776644},
+	{51.844322, -36.152836, 5.0558815},
+	{40.89228, -27.90873, 3.7996287},
+	{33.212357, -22.05634, 2.9027157},
+	{27.354622, -17.5263, 2.2035995},
+	{22.612835, -13.793735, 1.6225477},
+	{18.592045, -10.560268, 1.1137348},
+	{15.046896, -7.6340857, 0.64706576},
+	{11.811127, -4.8758388, 0.19982311},
+	{8.765071, -2.170285, -0.24799614},
+	{5.8271103, 0.5898519, -0.716926},
+	{2.9850895, 3.505799, -1.2302849},
+	{0.4947498, 6.6058726, -1.8098053},
+	{0.2731576, 9.187911, -2.3997886},
+	{27.847689, -1.9594043, -1.3322836},
+	{105.58298, -76.919754, 11.553296},
+	{70.4321, -50.712204, 7.5202174},
+	{53.362263, -37.88497, 5.540744},
+	{42.721283, -29.812647, 4.2912498},
+	{35.191055, -24.034237, 3.3934321},
+	{29.42, -19.543766, 2.692295},
+	{24.73807, -15.838803, 2.1100893},
+	{20.76658, -12.630803, 1.6017637},
+	{17.269024, -9.73333, 1.1376878},
+	{14.085045, -7.010825, 0.69558394},
+	{11.099727, -4.3512783, 0.2559558},
+	{8.235867, -1.6504517, -0.20112573},
+	{5.486816, 1.1895196, -0.6981697},
+	{3.119634, 4.1922817, -1.2557698},
+	{3.1062517, 6.641457, -1.816894},
+	{32.580524, -5.338601, -0.65453756},
+	{104.18862, -76.77523, 11.846963},
+	{71.56458, -52.23684, 8.001391},
+	{55.11, -39.7711, 6.0453053},
+	{44.687294, -31.804983, 4.793216},
+	{37.24855, -26.057821, 3.8877685},
+	{31.51889, -21.572462, 3.178734},
+	{26.856106, -17.863255, 2.589629},
+	{22.893362, -14.648413, 2.0757563},
+	{19.399904, -11.7446785, 1.6076206},
+	{16.218552, -9.018332, 1.1630864},
+	{13.236251, -6.358622, 0.7228806},
+	{10.37614, -3.6616755, 0.2673223},
+	{7.6276436, -0.8266664, -0.22619635},
+	{5.2410703, 2.1845279, -0.78002286},
+	{5.1410227, 4.709828, -1.3460183},
+	{35.714443, -7.542397, -0.17537306},
+	{104.0927, -77.56631, 12.279482},
+	{73.26562, -54.1845, 8.551221},
+	{57.232624, -41.942963, 6.5990353},
+	{46.93891, -34.018436, 5.3346157},
+	{39.536907, -28.261883, 4.4150105},
+	{33.80826, -23.750895, 3.692849},
+	{29.130358, -20.010775, 3.092089},
+	{25.143698, -16.763226, 2.5678933},
+	{21.619925, -13.825683, 2.0904953},
+	{18.401726, -11.063772, 1.6374761},
+	{15.373881, -8.365003, 1.1892765},
+	{12.454319, -5.6219077, 0.72583604},
+	{9.619514, -2.7246652, 0.22359651},
+	{7.080344, 0.3941296, -0.3435556},
+	{6.6231074, 3.1864076, -0.9447461},
+	{37.57543, -8.825708, 0.15670586},
+	{105.35898, -79.38565, 12.8817},
+	{75.701546, -56.707813, 9.204316},
+	{59.89818, -44.55, 7.2345257},
+	{49.640507, -36.597836, 5.946689},
+	{42.21874, -30.7891, 5.0057945},
+	{36.45122, -26.221798, 4.2652035},
+	{31.726404, -22.425936, 3.648357},
+	{27.687393, -19.123188, 3.109704},
+	{24.104782, -16.12904, 2.6187553},
+	{20.817682, -13.305808, 2.1523364},
+	{17.704264, -10.535846, 1.6899916},
+	{14.670366, -7.70293, 1.210386},
+	{11.66756, -4.679687, 0.6876431},
+	{8.842413, -1.3528467, 0.08957315},
+	{7.7522054, 1.9022145, -0.57794684},
+	{38.260036, -9.2793455, 0.36637038},
+	{108.210686, -82.44825, 13.705361},
+	{79.11979, -60.02597, 10.008234},
+	{63.337933, -47.794594, 7.995031},
+	{53.011524, -39.734535, 6.6701937},
+	{45.506794, -33.824707, 5.699538},
+	{39.65764, -29.167715, 4.93463},
+	{34.854057, -25.291075, 4.2971973},
+	{30.736464, -21.91234, 3.7402773},
+	{27.070604, -18.842094, 3.2321298},
+	{23.68843, -15.936515, 2.7483025},
+	{20.456886, -13.069163, 2.2667706},
+	{17.262129, -10.109191, 1.7638624},
+	{14.016046, -6.9005976, 1.2094762},
+	{10.767221, -3.260401, 0.5616391},
+	{8.733908, 0.68121165, -0.20845748},
+	{37.627213, -8.836, 0.45590746},
+	{113.09576, -87.15235, 14.837194},
+	{83.923035, -64.48883, 11.03692},
+	{67.91221, -51.98895, 8.946428},
+	{57.388134, -43.719776, 7.566675},
+	{49.72418, -37.64871, 6.5555167},
+	{43.744522, -32.863426, 5.759371},
+	{38.828987, -28.879803, 5.09665},
+	{34.608704, -25.406073, 4.518042},
+	{30.840078, -22.244316, 3.9898868},
+	{27.343815, -19.241287, 3.4858286},
+	{23.970608, -16.257711, 2.9814973},
+	{20.578682, -13.14155, 2.4496455},
+	{17.022932, -9.69478, 1.8535205},
+	{13.209092, -5.631338, 1.1357453},
+	{9.857601, -0.7263938, 0.21678467},
+	{35.202904, -7.2193403, 0.40237442},
+	{120.95106, -94.30433, 16.444939},
+	{90.83174, -70.715965, 12.419495},
+	{74.24817, -57.67353, 10.201962},
+	{63.351013, -49.054897, 8.741654},
+	{55.428623, -42.74329, 7.675559},
+	{49.260525, -37.783726, 6.8399367},
+	{44.199947, -33.66729, 6.147472},
+	{39.85968, -30.08559, 5.545134},
+	{35.980755, -26.827303, 4.996405},
+	{32.367565, -23.725586, 4.472207},
+	{28.84863, -20.623457, 3.9448512},
+	{25.244629, -17.339846, 3.3819664},
+	{21.332863, -13.618216, 2.7370017},
+	{16.817661, -9.02411, 1.9290339},
+	{11.6787195, -2.800658, 0.79941535},
+	{29.914032, -3.790355, 0.1448479},
+	{133.72568, -105.56027, 18.86658},
+	{101.28992, -79.94291, 14.41167},
+	{83.586365, -65.91449, 11.984294},
+	{72.0482, -56.729465, 10.403082},
+	{63.729183, -50.066364, 9.261847},
+	{57.307022, -44.881237, 8.378024},
+	{52.08187, -40.618824, 7.6544986},
+	{47.63399, -36.94251, 7.0323014},
+	{43.68028, -33.620354, 6.470647},
+	{40.00205, -30.466297, 5.936602},
+	{36.398266, -27.299454, 5.397878},
+	{32.640205, -23.8998, 4.814922},
+	{28.40113, -19.930462, 4.1269674},
+	{23.104263, -14.744492, 3.217222},
+	{15.609405, -6.7625947, 1.797137},
+	{19.418999, 2.836907, -0.44266582},
+	{156.37946, -125.11543, 22.952454},
+	{118.760735, -95.12593, 17.623436},
+	{98.87519, -79.24266, 14.821361},
+	{86.22536, -69.10864, 13.047897},
+	{77.315414, -61.940357, 11.804801},
+	{70.60627, -56.51062, 10.872687},
+	{65.294815, -52.17667, 10.136751},
+	{60.904995, -48.554184, 9.528359},
+	{57.11812, -45.381294, 9.000681},
+	{53.686886, -42.44827, 8.516058},
+	{50.376644, -39.54702, 8.036886},
+	{46.90033, -36.4111, 7.5147405},
+	{42.796944, -32.59789, 6.8691196},
+	{37.0918, -27.151066, 5.9272847},
+	{26.876732, -17.148296, 4.1708574},
+	{-1.3289762, 15.50117, -1.5245919},
+	{206.17691, -167.53105, 31.642036},
+	{154.678, -126.0145, 24.064102},
+	{129.79802, -105.96492, 20.445698},
+	{115.05779, -94.09388, 18.337076},
+	{105.46157, -86.37305, 16.997158},
+	{98.94973, -81.142395, 16.121895},
+	{94.524254, -77.598, 15.565406},
+	{91.66236, -75.319244, 15.25302},
+	{90.09362, -74.08903, 15.148538},
+	{89.707756, -73.819435, 15.241171},
+	{90.52085, -74.524155, 15.541657},
+	{92.673325, -76.31584, 16.084154},
+	{96.43393, -79.40533, 16.931479},
+	{102.045494, -83.95948, 18.163515},
+	{107.464424, -88.16849, 19.576487},
+	{-0.00078209594, 0.0005650549, 5.9769945},
+	{156.56923, -99.379074, 9.710774},
+	{50.064377, -25.578691, 0.065448076},
+	{22.415556, -6.0852094, -2.5569415},
+	{8.986116, 3.313339, -3.7593963},
+	{0.70129085, 9.113795, -4.4743237},
+	{-5.192049, 13.289859, -4.98453},
+	{-9.83709, 16.66057, -5.405871},
+	{-13.814474, 19.645853, -5.797716},
+	{-17.475233, 22.507938, -6.198392},
+	{-21.078693, 25.453571, -6.6405416},
+	{-24.86756, 28.694197, -7.160944},
+	{-29.130129, 32.504364, -7.8114114},
+	{-34.292484, 37.321163, -8.679113},
+	{-41.11553, 43.98092, -9.937419},
+	{-51.14679, 54.408905, -12.0046425},
+	{-61.628086, 70.80332, -15.726935},
+	{144.07034, -97.11594, 11.82228},
+	{67.39101, -43.270386, 4.560326},
+	{42.353294, -25.296352, 2.0310755},
+	{28.392456, -15.074213, 0.54607284},
+	{19.018353, -8.090657, -0.48955086},
+	{12.055224, -2.8138556, -1.2837504},
+	{6.5013657, 1.4764601, -1.938943},
+	{1.807231, 5.1858287, -2.5153751},
+	{-2.3709178, 8.577677, -3.0540364},
+	{-6.2785025, 11.851999, -3.5879161},
+	{-10.119574, 15.191475, -4.149366},
+	{-14.094587, 18.80023, -4.77735},
+	{-18.427647, 22.951456, -5.5280766},
+	{-23.3335, 28.045773, -6.493143},
+	{-28.216402, 34.37773, -7.795898},
+	{-8.2660055, 29.190035, -7.8254833},
+	{130.51909, -89.98677, 11.72378},
+	{69.472725, -46.44553, 5.62884},
+	{47.051655, -30.21884, 3.309651},
+	{34.257904, -20.813837, 1.9386083},
+	{25.52946, -14.287296, 0.96885335},
+	{18.943851, -9.270896, 0.20977323},
+	{13.629988, -5.139104, -0.42670363},
+	{9.117109, -1.5469719, -0.99037635},
+	{5.1130834, 1.7283043, -1.5148888},
+	{1.4138067, 4.8539095, -2.027163},
+	{-2.1396065, 7.976722, -2.552867},
+	{-5.6797194, 11.247742, -3.1210907},
+	{-9.296711, 14.837899, -3.7693844},
+	{-12.858766, 18.893002, -4.5443645},
+	{-14.456346, 22.796892, -5.4171906},
+	{22.180023, 6.869494, -3.765418},
+	{121.00248, -84.80546, 11.56592},
+	{69.93513, -47.905766, 6.246498},
+	{49.348263, -32.858295, 4.050966},
+	{37.328148, -23.957773, 2.7366762},
+	{29.07076, -17.75114, 1.8083918},
+	{22.827637, -12.976271, 1.0841938},
+	{17.786512, -9.041981, 0.47819707},
+	{13.507052, -5.622143, -0.057694294},
+	{9.7212515, -2.5107903, -0.5547576},
+	{6.2490687, 0.44123986, -1.0368757},
+	{2.9602516, 3.358935, -1.525785},
+	{-0.23495343, 6.360907, -2.0446966},
+	{-3.3448188, 9.557971, -2.6204636},
+	{-6.032152, 12.952252, -3.2752206},
+	{-5.6229596, 15.431597, -3.9018757},
+	{41.021004, -6.4033747, -1.4000134},
+	{114.3799, -81.28806, 11.501704},
+	{70.149605, -48.963753, 6.7250934},
+	{50.962307, -34.803455, 4.617156},
+	{39.502346, -26.248747, 3.3339818},
+	{31.562141, -20.240585, 2.425071},
+	{25.544962, -15.61338, 1.7180796},
+	{20.691174, -11.808022, 1.1297518},
+	{16.583055, -8.511593, 0.6128635},
+	{12.965223, -5.525544, 0.13667724},
+	{9.668642, -2.70787, -0.3218157},
+	{6.5776987, 0.055974353, -0.782653},
+	{3.6266584, 2.8671193, -1.265962},
+	{0.8541445, 5.8031964, -1.7927667},
+	{-1.2855014, 8.788454, -2.3722517},
+	{0.32828653, 10.442732, -2.857644},
+	{53.52976, -14.90334, 0.10107773},
+	{109.84766, -79.055786, 11.547785},
+	{70.51191, -50.015163, 7.1631064},
+	{52.413338, -36.53622, 5.118586},
+	{41.37534, -28.229599, 3.8524446},
+	{33.655605, -22.346743, 2.9505787},
+	{27.782354, -17.802559, 2.2490005},
+	{23.041424, -14.066261, 1.6670382},
+	{19.035547, -10.837383, 1.158504},
+	{15.520565, -7.9241953, 0.69325626},
+	{12.3352165, -5.189557, 0.24881461},
+	{9.371484, -2.5239305, -0.19413832},
+	{6.574959, 0.16606383, -0.6544013},
+	{4.0067987, 2.943012, -1.1503313},
+	{2.1787467, 5.69626, -1.6853757},
+	{4.449929, 6.9212546, -2.0962386},
+	{62.50207, -20.752575, 1.1301229},
+	{106.96338, -77.896645, 11.71159},
+	{71.20389, -51.24798, 7.6089168},
+	{53.944885, -38.283264, 5.607399},
+	{43.22121, -30.149622, 4.3478703},
+	{35.65167, -24.34034, 3.4448173},
+	{29.8647, -19.834852, 2.7409153},
+	{25.182877, -16.125113, 2.1574945},
+	{21.225382, -12.9205675, 1.6491416},
+	{17.757002, -10.034897, 1.1861752},
+	{14.622492, -7.33479, 0.7465563},
+	{11.718781, -4.714028, 0.31148276},
+	{8.996232, -2.0823789, -0.13723448},
+	{6.522166, 0.6193714, -0.6171949},
+	{4.822417, 3.275887, -1.1309506},
+	{7.344317, 4.3591824, -1.5142255},
+	{69.61869, -25.157227, 1.9008639},
+	{105.5219, -77.730606, 12.005981},
+	{72.3545, -52.793556, 8.095875},
+	{55.71933, -40.194626, 6.117848},
+	{45.21514, -32.167015, 4.85539},
+	{37.7367, -26.388006, 3.9443529},
+	{31.990313, -21.886517, 3.2322285},
+	{27.326662, -18.17139, 2.6416047},
+	{23.376825, -14.958807, 2.127404},
+	{19.911272, -12.065543, 1.6600581},
+	{16.777811, -9.359998, 1.2176414},
+	{13.875045, -6.737013, 0.781535},
+	{11.153357, -4.1063824, 0.33373258},
+	{8.675511, -1.4051409, -0.14362319},
+	{6.9492207, 1.2683206, -0.655405},
+	{9.389893, 2.4415903, -1.0479329},
+	{75.93491, -28.83418, 2.536012},
+	{105.42937, -78.53739, 12.445275},
+	{74.09635, -54.77986, 8.654732},
+	{57.885403, -42.405174, 6.680056},
+	{47.509148, -34.417336, 5.4046755},
+	{40.065998, -28.627193, 4.479007},
+	{34.31929, -24.098593, 3.7533755},
+	{29.639309, -20.351261, 3.1507754},
+	{25.664381, -17.104748, 2.6259553},
+	{22.167217, -14.176366, 2.1490412},
+	{18.995235, -11.433636, 1.6977787},
+	{16.044415, -8.7692795, 1.2531939},
+	{13.259043, -6.088757, 0.7967688},
+	{10.686942, -3.3184197, 0.30940092},
+	{8.791375, -0.52267534, -0.2184082},
+	{10.849636, 0.95022035, -0.65303886},
+	{82.15168, -32.225826, 3.1088185},
+	{106.75452, -80.41517, 13.061827},
+	{76.602745, -57.36555, 9.32138},
+	{60.617607, -45.06982, 7.3277507},
+	{50.273617, -37.050476, 6.0280085},
+	{42.80791, -31.205383, 5.080399},
+	{37.020332, -26.61848, 4.335872},
+	{32.291965, -22.813917, 3.716832},
+	{28.263508, -19.510979, 3.1772609},
+	{24.706366, -16.524809, 2.6865158},
+	{21.46404, -13.719333, 2.2215316},
+	{18.42531, -10.98163, 1.7623497},
+	{15.521092, -8.207399, 1.2890048},
+	{12.77118, -5.3029814, 0.7796626},
+	{10.565597, -2.2796986, 0.21753128},
+	{11.935735, -0.29261285, -0.29288247},
+	{88.82905, -35.64782, 3.669296},
+	{109.73386, -83.59074, 13.909955},
+	{80.13428, -60.78103, 10.145694},
+	{64.158775, -48.400726, 8.1062},
+	{53.738674, -40.266792, 6.768004},
+	{46.18543, -34.316437, 5.789728},
+	{40.31339, -29.637201, 5.020298},
+	{35.504555, -25.750174, 4.380284},
+	{31.396505, -22.370144, 3.822175},
+	{27.755457, -19.307053, 3.3140147},
+	{24.41733, -16.418327, 2.831392},
+	{21.258974, -13.581698, 2.3526766},
+	{18.190123, -10.677055, 1.8553624},
+	{15.186865, -7.579046, 1.3128824},
+	{12.528458, -4.219496, 0.69707245},
+	{12.8672495, -1.4730713, 0.071649104},
+	{96.554565, -39.388382, 4.2606254},
+	{114.848915, -88.49095, 15.082299},
+	{85.11949, -65.397964, 11.206082},
+	{68.89132, -52.728767, 9.085123},
+	{58.26082, -44.37474, 7.689764},
+	{50.54124, -38.25693, 6.6697},
+	{44.534866, -33.44595, 5.8683},
+	{39.612354, -29.450218, 5.2026076},
+	{35.401318, -25.974627, 4.622643},
+	{31.658127, -22.82018, 4.094442},
+	{28.206982, -19.834465, 3.5916047},
+	{24.907701, -16.88173, 3.0900338},
+	{21.640388, -13.819332, 2.5634084},
+	{18.319447, -10.47629, 1.9779043},
+	{15.06454, -6.6688166, 1.287943},
+	{13.958298, -2.8604333, 0.49748647},
+	{106.12602, -43.792435, 4.9319825},
+	{123.118996, -95.99117, 16.76044},
+	{92.334274, -71.88266, 12.641196},
+	{75.48905, -58.63388, 10.38589},
+	{64.46372, -49.912113, 8.906359},
+	{56.474728, -43.54443, 7.8294983},
+	{50.2751, -38.55492, 6.987761},
+	{45.206768, -34.425426, 6.2921057},
+	{40.877853, -30.843237, 5.6886373},
+	{37.028675, -27.595356, 5.1404076},
+	{33.466564, -24.515263, 4.618186},
+	{30.028402, -21.44882, 4.0944157},
+	{26.555042, -18.222918, 3.5373743},
+	{22.878021, -14.603882, 2.9027007},
+	{18.89472, -10.243761, 2.1186748},
+	{15.830143, -4.9871635, 1.0965804},
+	{118.85929, -49.369865, 5.7542486},
+	{136.6891, -107.91116, 19.315128},
+	{103.35878, -81.58543, 14.730161},
+	{85.30694, -67.279205, 12.251167},
+	{73.601555, -57.95874, 10.6444845},
+	{65.19902, -51.225502, 9.489799},
+	{58.741188, -46.006878, 8.5992365},
+	{53.51281, -41.73496, 7.8732834},
+	{49.087524, -38.06716, 7.2517757},
+	{45.18058, -34.769012, 6.6933165},
+	{41.575787, -31.654314, 6.1647058},
+	{38.080124, -28.544567, 5.6336617},
+	{34.483967, -25.226576, 5.061008},
+	{30.510117, -21.382181, 4.387328},
+	{25.75065, -16.431688, 3.5015945},
+	{20.129635, -9.262703, 2.1636295},
+	{137.23245, -56.935272, 6.8423953},
+	{161.20401, -129.01828, 23.711054},
+	{122.0842, -97.824104, 18.156837},
+	{101.65116, -81.499626, 15.271778},
+	{88.75611, -71.16645, 13.46082},
+	{79.740395, -63.910362, 12.201296},
+	{73.00692, -58.45732, 11.264995},
+	{67.72859, -54.14483, 10.533339},
+	{63.41979, -50.580116, 9.936017},
+	{59.7603, -47.498783, 9.425576},
+	{56.50757, -44.692623, 8.964389},
+	{53.439137, -41.95901, 8.515388},
+	{50.292988, -39.042442, 8.03117},
+	{46.66351, -35.522182, 7.4328423},
+	{41.7339, -30.496841, 6.550388},
+	{33.41583, -21.316101, 4.8786035},
+	{164.97739, -66.55607, 8.252081},
+	{217.48978, -176.85715, 33.48582},
+	{162.06671, -132.14491, 25.298407},
+	{135.97058, -111.10588, 21.492062},
+	{120.80737, -98.89434, 19.321053},
+	{111.163246, -91.14146, 17.979366},
+	{104.85426, -86.08622, 17.143875},
+	{100.85416, -82.902374, 16.664864},
+	{98.66354, -81.1896, 16.472301},
+	{98.084045, -80.791565, 16.542904},
+	{99.1487, -81.7407, 16.891155},
+	{102.1509, -84.28321, 17.576433},
+	{107.806244, -89.0135, 18.733532},
+	{117.74652, -97.28279, 20.6633},
+	{136.24622, -112.62534, 24.147781},
+	{179.40833, -148.33359, 32.128033},
+	{-0.041696273, 0.030125199, 22.733437},
+	{157.01384, -99.6753, 9.748627},
+	{50.166138, -25.651062, 0.07730302},
+	{22.47607, -6.128789, -2.5493104},
+	{9.031483, 3.2806714, -3.7534902},
+	{0.73910785, 9.086768, -4.469357},
+	{-5.1585946, 13.266286, -4.98017},
+	{-9.806365, 16.639378, -5.401956},
+	{-13.785531, 19.626488, -5.79417},
+	{-17.447462, 22.490149, -6.195193},
+	{-21.05161, 25.437296, -6.6377106},
+	{-24.840693, 28.679573, -7.1585565},
+	{-29.102884, 32.491833, -7.8096356},
+	{-34.263706, 37.311646, -8.678299},
+	{-41.081192, 43.975796, -9.938274},
+	{-51.07895, 54.402645, -12.007855},
+	{-60.503994, 70.21563, -15.650938},
+	{144.33914, -97.29693, 11.846787},
+	{67.46794, -43.32156, 4.567847},
+	{42.403355, -25.329796, 2.0363297},
+	{28.43311, -15.101503, 0.55055475},
+	{19.054548, -8.114936, -0.4854622},
+	{12.089141, -2.8364527, -1.2798978},
+	{6.534288, 1.454792, -1.935237},
+	{1.8401036, 5.164579, -2.511757},
+	{-2.3372233, 8.556428, -3.050462},
+	{-6.2429714, 11.8303175, -3.5843458},
+	{-10.080729, 15.168772, -4.14575},
+	{-14.049766, 18.775436, -4.773596},
+	{-18.37078, 22.921946, -5.5239224},
+	{-23.245638, 28.002352, -6.4875035},
+	{-27.996603, 34.26511, -7.7813044},
+	{-5.869797, 27.807337, -7.6289234},
+	{130.71281, -90.11872, 11.742568},
+	{69.54157, -46.491325, 5.6356673},
+	{47.097652, -30.249115, 3.3143358},
+	{34.295677, -20.838573, 1.942547},
+	{25.563717, -14.309633, 0.9724811},
+	{18.976711, -9.292189, 0.21327092},
+	{13.662694, -5.1600895, -0.42324334},
+	{9.150631, -1.5681778, -0.986891},
+	{5.148423, 1.7063713, -1.5113199},
+	{1.4522703, 4.830617, -2.0234385},
+	{-2.09596, 7.951082, -2.5488725},
+	{-5.6270237, 11.217868, -3.1166012},
+	{-9.226093, 14.799285, -3.7638378},
+	{-12.743177, 18.831161, -4.535853},
+	{-14.159936, 22.634912, -5.3950553},
+	{25.126436, 5.193958, -3.5324998},
+	{121.15541, -84.91051, 11.581443},
+	{69.999084, -47.948627, 6.2530537},
+	{49.392487, -32.887478, 4.0555377},
+	{37.36483, -23.981716, 2.7404907},
+	{29.104174, -17.772747, 1.8118716},
+	{22.859865, -12.996913, 1.0875373},
+	{17.81887, -9.062476, 0.48151898},
+	{13.540603, -5.643103, -0.05431249},
+	{9.757111, -2.5328074, -0.55124116},
+	{6.2887154, 0.41741464, -1.0331324},
+	{3.0060558, 3.3321092, -1.5216684},
+	{-0.1784999, 6.328789, -2.0399199},
+	{-3.2674007, 9.515148, -2.6143293},
+	{-5.9025373, 12.881708, -3.2654617},
+	{-5.286416, 15.245804, -3.8764534},
+	{44.248833, -8.200056, -1.156944},
+	{114.50923, -81.37774, 11.51541},
+	{70.21054, -49.005, 6.731572},
+	{51.005844, -34.83244, 4.6217866},
+	{39.53889, -26.272749, 3.3378553},
+	{31.595562, -20.262264, 2.4285865},
+	{25.577236, -15.634057, 1.7214338},
+	{20.723614, -11.828522, 1.1330656},
+	{16.61677, -8.532574, 0.6162289},
+	{13.001416, -5.5476646, 0.14018251},
+	{9.708912, -2.7319672, -0.318063},
+	{6.624601, 0.028588135, -0.77848715},
+	{3.6850212, 2.833947, -1.2610663},
+	{0.93504167, 5.7583847, -1.7863846},
+	{-1.1486099, 8.713776, -2.3619666},
+	{0.68676955, 10.245275, -2.8308444},
+	{56.947994, -16.766876, 0.3468683},
+	{109.962456, -79.13615, 11.560436},
+	{70.571335, -50.055866, 7.169669},
+	{52.45705, -36.565674, 5.123397},
+	{41.412464, -28.254255, 3.856497},
+	{33.689697, -22.369076, 2.954253},
+	{27.815302, -17.823835, 2.2524898},
+	{23.074503, -14.087291, 1.6704633},
+	{19.069868, -10.858827, 1.161959},
+	{15.557357, -7.946731, 0.6968336},
+	{12.376134, -5.2140613, 0.25262886},
+	{9.41918, -2.5517771, -0.18991168},
+	{6.6344323, 0.13228288, -0.6494325},
+	{4.0894804, 2.8972685, -1.1438446},
+	{2.3191736, 5.619859, -1.6749175},
+	{4.8200393, 6.7187123, -2.0690324},
+	{66.132996, -22.697739, 1.3813848},
+	{107.06982, -77.97198, 11.723788},
+	{71.2632, -51.289158, 7.6157274},
+	{53.98964, -38.313858, 5.6125126},
+	{43.259624, -30.175516, 4.352215},
+	{35.687096, -24.363882, 3.4487627},
+	{29.898954, -19.857271, 2.7446527},
+	{25.217207, -16.147207, 2.1611447},
+	{21.260887, -12.94299, 1.6527984},
+	{17.794903, -10.058321, 1.1899306},
+	{14.664445, -7.3600883, 0.7505239},
+	{11.767455, -4.7425766, 0.3158379},
+	{9.056665, -2.1167789, -0.13216266},
+	{6.605883, 0.5730868, -0.6106367},
+	{4.964288, 3.1990209, -1.1204811},
+	{7.719874, 4.155311, -1.4871242},
+	{73.566925, -27.245502, 2.166706},
+	{105.62463, -77.80422, 12.018228},
+	{72.4152, -52.836334, 8.103129},
+	{55.766136, -40.227158, 6.1234136},
+	{45.255703, -32.194836, 4.860162},
+	{37.77425, -26.413403, 3.9486985},
+	{32.02663, -21.910706, 3.2363415},
+	{27.362982, -18.195171, 2.6456075},
+	{23.41424, -14.982825, 2.1313908},
+	{19.950989, -12.090464, 1.6641195},
+	{16.821465, -9.386673, 1.2218872},
+	{13.9252615, -6.7667813, 0.7861334},
+	{11.21511, -4.1417794, 0.33900005},
+	{8.760209, -1.4520682, -0.13694358},
+	{7.091531, 1.191493, -0.64496213},
+	{9.76712, 2.2385488, -1.0211933},
+	{80.35744, -31.152887, 2.8284745},
+	{105.53233, -78.6122, 12.458038},
+	{74.1602, -54.825615, 8.662681},
+	{57.93556, -42.440685, 6.6862745},
+	{47.55298, -34.447998, 5.410054},
+	{40.106705, -28.655298, 4.483923},
+	{34.35866, -24.12538, 3.7580304},
+	{29.6786, -20.37754, 3.1552963},
+	{25.704681, -17.131176, 2.6304383},
+	{22.209726, -14.203601, 2.1535757},
+	{19.04156, -11.462506, 1.7024711},
+	{16.09712, -8.801073, 1.2582028},
+	{13.322971, -6.1258984, 0.8023907},
+	{10.773216, -3.366566, 0.31633523},
+	{8.934007, -0.59958756, -0.20792344},
+	{11.226192, 0.7492116, -0.6267633},
+	{87.2667, -34.891525, 3.4431698},
+	{106.86204, -80.49449, 13.075697},
+	{76.672035, -57.41612, 9.330373},
+	{60.672905, -45.10977, 7.334909},
+	{50.322304, -37.085278, 6.0342546},
+	{42.85326, -31.237421, 5.086133},
+	{37.064198, -26.64905, 4.34131},
+	{32.33564, -22.843872, 3.722109},
+	{28.308111, -19.540995, 3.182479},
+	{24.7531, -16.555548, 2.6917653},
+	{21.514494, -13.751606, 2.2269156},
+	{18.481981, -11.016674, 1.7680178},
+	{15.588669, -8.24751, 1.2952307},
+	{12.860385, -5.3535, 0.78709114},
+	{10.709396, -2.3575232, 0.2282517},
+	{12.310378, -0.4911394, -0.26704368},
+	{94.963715, -38.83134, 4.0672655},
+	{109.85129, -83.67882, 13.925721},
+	{80.21234, -60.839127, 10.156264},
+	{64.221924, -48.447353, 8.114745},
+	{53.794636, -40.307755, 6.775524},
+	{46.23771, -34.354313, 5.796667},
+	{40.363968, -29.673409, 5.0268965},
+	{35.55482, -25.785643, 4.3866925},
+	{31.447632, -22.405596, 3.828505},
+	{27.808681, -19.343176, 3.3203619},
+	{24.474241, -16.455936, 2.8378594},
+	{21.322021, -13.621981, 2.3594048},
+	{18.263828, -10.7221775, 1.8626024},
+	{15.281503, -7.6339927, 1.321218},
+	{12.675603, -4.300079, 0.7084121},
+	{13.239893, -1.6695262, 0.09724194},
+	{104.25083, -43.369766, 4.7573566},
+	{114.9843, -88.59432, 15.10122},
+	{85.21169, -65.46803, 11.219112},
+	{68.96676, -52.78577, 9.095802},
+	{58.328087, -44.425224, 7.6992445},
+	{50.604275, -38.303852, 6.678501},
+	{44.59592, -33.490944, 5.8767047},
+	{39.67297, -29.494347, 5.210794},
+	{35.462788, -26.01871, 4.6307406},
+	{31.721771, -22.864965, 4.102557},
+	{28.274427, -19.880795, 3.5998456},
+	{24.981394, -16.93078, 3.098538},
+	{21.72473, -13.873165, 2.572406},
+	{18.424234, -10.539512, 1.9879135},
+	{15.219696, -6.7559595, 1.3006606},
+	{14.330948, -3.0568693, 0.52334756},
+	{116.3913, -49.089954, 5.592147},
+	{123.28691, -96.12184, 16.784872},
+	{92.45039, -71.97283, 12.658325},
+	{75.584915, -58.70808, 10.400095},
+	{64.54972, -49.978374, 8.919083},
+	{56.555653, -43.606407, 7.8413997},
+	{50.353683, -38.614655, 6.9992023},
+	{45.28488, -34.48424, 6.3033156},
+	{40.95702, -30.902142, 5.699781},
+	{37.1104, -27.655237, 5.1516175},
+	{33.55262, -24.577074, 4.6295896},
+	{30.12133, -21.513796, 4.106158},
+	{26.659237, -18.293089, 3.5496767},
+	{23.002934, -14.683547, 2.9160206},
+	{19.06845, -10.346011, 2.1344616},
+	{16.21055, -5.190153, 1.1241032},
+	{133.87563, -57.10395, 6.7173095},
+	{136.91997, -108.09433, 19.350073},
+	{103.5195, -81.71305, 14.754904},
+	{85.440544, -67.3852, 12.271892},
+	{73.72222, -58.054256, 10.663238},
+	{65.31331, -51.31565, 9.507522},
+	{58.85286, -46.094543, 8.616457},
+	{53.624428, -41.822037, 7.890343},
+	{49.201164, -38.155098, 7.268924},
+	{45.298225, -34.859085, 6.710761},
+	{41.699677, -31.747828, 6.1826367},
+	{38.213257, -28.643097, 5.652284},
+	{34.631165, -25.332434, 5.080586},
+	{30.68101, -21.499708, 4.4083085},
+	{25.972055, -16.572798, 3.5251927},
+	{20.546242, -9.495266, 2.1974034},
+	{163.04044, -70.20431, 8.493376},
+	{161.58449, -129.32613, 23.770887},
+	{122.345146, -98.03602, 18.198734},
+	{101.86899, -81.67679, 15.307136},
+	{88.954834, -71.3281, 13.493258},
+	{79.93108, -64.06534, 12.232489},
+	{73.19607, -58.610775, 11.295918},
+	{67.92084, -54.300365, 10.564678},
+	{63.61905, -50.740715, 9.968329},
+	{59.97045, -47.66728, 9.45938},
+	{56.732994, -44.872066, 9.00022},
+	{53.685402, -42.153038, 8.553851},
+	{50.568195, -39.255928, 8.073014},
+	{46.981487, -35.762604, 7.479075},
+	{42.126156, -30.779222, 6.602692},
+	{34.01903, -21.70034, 4.9428844},
+	{228.98386, -99.39783, 12.330355},
+	{218.41501, -177.62015, 33.636677},
+	{162.6639, -132.6406, 25.398224},
+	{136.46883, -111.521065, 21.57659},
+	{121.272415, -99.28283, 19.40071},
+	{111.62623, -91.52889, 18.0592},
+	{105.33641, -86.49019, 17.22742},
+	{101.37485, -83.33904, 16.755417},
+	{99.24496, -81.67752, 16.573702},
+	{98.75589, -81.355675, 16.660337},
+	{99.955734, -82.4186, 17.032469},
+	{103.16806, -85.13788, 17.754797},
+	{109.174774, -90.16368, 18.973793},
+	{119.779396, -98.99164, 21.020533},
+	{139.84491, -115.650734, 24.780628},
+	{189.06784, -156.45352, 33.82724},
+	{-1841.4076, 1671.5839, 1321.0122},
+	{-9393.541, 11172.7705, -7913.5205},
+	{-49546.89, 41775.45, -13165.289},
+	{-32009.352, 31287.596, -14758.274},
+	{-19418.672, 9752.267, -8161.925},
+	{-1289.0879, 120.603546, -2985.0977},
+	{-14595.749, 13295.607, -11014.568},
+	{-167397.17, 126414.484, -28811.926},
+	{-31971.643, 12936.998, -8761.094},
+	{-21861.713, 29785.104, -14597.757},
+	{8081.5312, -5757.5386, -5295.5513},
+	{-4287.517, 10069.385, -10123.732},
+	{-68905.266, 18535.639, -14396.283},
+	{-28573.941, 22773.223, -6756.9736},
+	{-4249.8027, 5883.0854, -8261.234},
+	{-12216.334, 428.30783, -3366.115},
+	{-5363.1353, 14279.27, -14485.001},
+	{-25932.41, 28019.834, -22672.656},
+	{-25466.734, 24295.516, -10495.766},
+	{-14818.435, 4590.648, -4188.6533},
+	{-21749.436, 13737.92, -12862.415},
+	{-10776.29, 14404.948, -7084.927},
+	{-7424.889, 14744.637, -9173.409},
+	{-5903.464, 2430.0618, -4275.084},
+	{-10731.224, 11128.056, -7763.9165},
+	{-19900.178, 12355.853, -5756.045},
+	{-30513.393, 20722.408, -7408.3916},
+	{-13088.855, 8416.825, -5483.8423},
+	{-12321.748, 11505.543, -13529.546},
+	{-18742.47, 9941.679, -8871.433},
+	{-16478.783, 13840.931, -9978.166},
+	{-16671.29, 19716.938, -10224.058},
+	{-13433.048, 14096.674, -7436.6484},
+	{-27896.78, 21830.453, -8584.1455},
+	{-22507.104, 19202.822, -12699.1045},
+	{-21121.54, 29276.766, -19556.703},
+	{-11007.783, 4116.6543, -7752.8765},
+	{4536.7095, -8293.964, -4101.2104},
+	{-54508.562, 34316.758, -10699.536},
+	{-29199.145, 18823.441, -9594.828},
+	{2077.7651, -3035.9678, -8642.88},
+	{-36238.824, 25833.668, -9416.66},
+	{-16763.654, 9231.039, -10283.773},
+	{-13513.132, 13756.133, -10458.073},
+	{-18154.512, 16362.978, -10093.636},
+	{-6224.6978, 5813.355, -10921.233},
+	{-1178.4185, -8474.115, -3729.054},
+	{-9594.178, 10730.709, -7743.481},
+	{-7101.3384, 1831.1825, -9058.643},
+	{-19233.639, 11378.999, -9319.093},
+	{-28746.654, 18842.312, -7845.473},
+	{-7788.155, 3195.8574, -5216.3457},
+	{-34943.438, 33297.266, -12206.2705},
+	{-5169.9326, 9417.583, -9892.001},
+	{-28235.316, 24863.037, -16615.38},
+	{-34869.957, 25718.088, -10763.024},
+	{-13897.835, 7728.486, -4790.5205},
+	{929.74176, -8057.8657, -2675.351},
+	{-8140.5938, 12755.708, -15304.646},
+	{-4685.297, 6948.7837, -10566.868},
+	{-15628.851, 12269.566, -10027.88},
+	{-19796.111, 14892.575, -8008.711},
+	{2973.9563, -2708.7217, -7820.895},
+	{-2790.8218, 2048.929, -5041.1396},
+	{-5493.7144, 3408.9143, -2825.57},
+	{-986.3014, 1558.8864, -6307.5195},
+	{-31386.662, 26622.96, -11461.626},
+	{-9232.636, 8788.003, -4823.3687},
+	{642.30066, -4637.376, -2445.7507},
+	{-19922.764, 8345.631, -6486.0303},
+	{-20569.17, 17843.883, -10621.39},
+	{-12246.114, 14675.918, -11009.025},
+	{3516.4275, 4345.075, -8961.174},
+	{-6933.8784, 3780.6045, -7275.907},
+	{-5310.2354, 14949.745, -14470.237},
+	{-17838.83, 4240.5547, -4752.5254},
+	{-5337.98, 1314.8882, -8144.6216},
+	{-4991.1533, 7153.842, -7103.234},
+	{-14959.129, 2492.6616, -5254.396},
+	{-3080.901, 353.87317, -7551.898},
+	{2962.2454, -4689.379, -3777.0369},
+	{-39646.504, 37744.984, -14251.9},
+	{-38511.477, 21924.578, -8446.404},
+	{-26971.85, 22790.193, -8138.166},
+	{-10907.071, 6186.498, -6761.701},
+	{2388.0288, 1120.8905, -4408.917},
+	{258.8843, 17586.7, -19198.014},
+	{-21478.635, 16341.566, -6388.458},
+	{-20189.271, 17536.184, -10431.902},
+	{-12203.837, 11455.353, -9846.764},
+	{-11735.499, 9134.205, -4799.5117},
+	{-23531.656, 25115.45, -13269.761},
+	{-6471.6157, 8516.656, -10765.473},
+	{-22558.209, 16849.078, -7106.137},
+	{3418.0496, -3909.9895, -6645.329},
+	{2845.0513, -2602.8135, -6060.992},
+	{-11322.381, 10404.939, -9351.669},
+	{-63305.184, 55767.07, -18157.568},
+	{-8535.2, 10533.804, -5851.021},
+	{-161811.02, 140885.42, -38202.188},
+	{-52311.586, 29767.914, -10539.067},
+	{2342.5574, -1360.3158, -11694.33},
+	{-9882.068, 10923.429, -11396.786},
+	{-26171.508, 13455.373, -6222.7188},
+	{-6260.078, 5369.0444, -5118.1904},
+	{-9770.756, 6618.797, -7318.0938},
+	{-10029.679, 9743.506, -8137.4395},
+	{-6603.277, 1037.8793, -6216.6606},
+	{-11344.725, 5752.897, -7280.831},
+	{1684.2723, 1906.3347, -6520.834},
+	{-4001.903, 4372.7534, -8869.101},
+	{-8769.328, 11808.23, -10568.685},
+	{-6739.074, -4071.609, -5534.0483},
+	{-2928.527, 7741.648, -10101.205},
+	{-26769.236, 27651.033, -14593.021},
+	{-10644.63, 16919.227, -14461.164},
+	{-32195.646, 22210.498, -6721.2715},
+	{-33732.203, 23311.664, -7319.6665},
+	{-28629.086, 27881.771, -10940.829},
+	{-8817.106, 11510.882, -11063.983},
+	{-21808.842, 9169.253, -11212.529},
+	{2626.1533, 1056.5408, -10755.606},
+	{8320.296, -2937.6777, -6562.7427},
+	{15987.621, -5719.246, -10466.362},
+	{-6920.4663, 7189.49, -5803.7354},
+	{-11434.081, 7910.635, -4765.107},
+	{-26896.53, 25757.273, -14751.319},
+	{-18086.72, 13990.84, -8757.806},
+	{-9858.432, 10690.921, -8415.926},
+	{-7273.99, 67.70911, -5714.5405},
+	{-9600.402, 15946.149, -10033.575},
+	{-12655.459, 10155.183, -6959.012},
+	{-24064.787, 28980.775, -12655.523},
+	{-18718.73, 17552.64, -8518.572},
+	{-16312.517, 10359.425, -7460.8286},
+	{-14193.107, 11582.432, -8411.557},
+	{-9729.194, 8445.724, -8347.016},
+	{-8733.799, 7177.177, -7558.955},
+	{-22408.691, 18488.277, -6593.366},
+	{6735.531, -11245.242, -8994.56},
+	{-17097.305, 24485.262, -14520.772},
+	{486.35608, 112.17895, -8182.748},
+	{6912.4487, -5711.3315, -4330.7803},
+	{-6469.909, 2830.1443, -9438.969},
+	{-536.58514, 436.95233, -6630.3584},
+	{-4821.1855, -304.7594, -4918.3813},
+	{3248.411, -2995.6907, -4223.8994},
+	{-46844.9, 26427.834, -11097.614},
+	{-23285.29, 27072.15, -12681.328},
+	{-19536.799, 25605.195, -23813.02},
+	{-19141.219, 10583.541, -9032.258},
+	{12280.124, -2557.451, -12288.414},
+	{-11735.06, 12849.101, -7084.1353},
+	{-23944.32, 26258.941, -13660.397},
+	{2445.5017, 977.4553, -7385.1787},
+	{842.1781, -2079.553, -3577.857},
+	{-85.848724, 6364.3013, -9902.76},
+	{-9828.973, 12731.366, -11917.115},
+	{5610.5957, 389.04733, -8051.409},
+	{8483.177, -7602.7207, -3536.1614},
+	{-11596.118, 13648.123, -11839.071},
+	{-42862.56, 48301.4, -19452.297},
+	{-15148.419, 13851.782, -7935.6523},
+	{-16276.941, 17776.162, -9161.561},
+	{-18385.273, 14552.221, -8239.138},
+	{-12802.8545, 13779.853, -12287.866},
+	{-20232.09, 14989.064, -12721.358},
+	{-6708.634, 7054.8916, -9083.618},
+	{-15827.595, 1808.239, -9034.594},
+	{-10713.782, 8590.425, -4905.646},
+	{-8137.7163, 6614.03, -6038.724},
+	{-7643.3374, 7479.9077, -6278.1216},
+	{-4043.7236, -9479.864, -1440.6136},
+	{-3447.0366, 2857.694, -6541.9897},
+	{-50886.844, 65618.28, -34663.477},
+	{-7928.5684, 5754.7847, -6256.2065},
+	{-6205.41, 6952.5757, -5344.1543},
+	{-21349.836, 20730.166, -7767.1987},
+	{-34504.043, 39339.965, -16332.352},
+	{-15745.627, 16904.18, -11155.578},
+	{-16609.62, 22266.438, -11764.445},
+	{867.0884, 2883.401, -5498.7144},
+	{2163.0513, 591.1181, -5658.2617},
+	{-18272.012, 20382.37, -11385.452},
+	{-5165.1523, -140.93634, -4991.6753},
+	{-41853.16, 28702.332, -9931.155},
+	{-6829.8477, 2137.6099, -5492.6606},
+	{6628.494, -1667.4255, -5705.1724},
+	{-12561.071, 4553.2827, -10095.45},
+	{-7711.4688, 1026.3867, -7183.9316},
+	{-8677.168, 1250.0171, -4216.2534},
+	{-8997.079, 6047.858, -6653.6562},
+	{10880.293, -8767.975, -5470.1313},
+	{-13400.255, 8869.226, -8104.5},
+	{-18548.559, 26201.486, -15220.754},
+	{-23450.227, 27238.082, -15136.794},
+	{-8700.256, 9937.886, -6645.6045},
+	{14378.007, -17655.695, -2558.9639},
+	{7260.3965, -6165.8877, -3110.7173},
+	{-11051.636, 1111.6705, -5287.504},
+	{-7982.8247, 8029.1006, -5342.455},
+	{-2205.0708, 12764.325, -15221.231},
+	{871.90295, 7044.618, -9184.634},
+	{-2558.4807, 7726.683, -7023.848},
+	{1460.6409, -2955.9294, -4629.3174},
+	{-10094.981, 9036.807, -9675.196},
+	{-6171.5527, 4810.8564, -9512.025},
+	{2785.3755, -4690.7744, -4416.4365},
+	{1700.8386, 2939.377, -7468.3477},
+	{-27478.297, 15854.655, -6221.483},
+	{-39033.797, 29579.816, -10396.778},
+	{9947.625, -9444.94, -5561.8027},
+	{10897.252, -12066.501, -2128.1611},
+	{-11232.926, 11066.916, -8090.59},
+	{1400.2651, -10640.055, -7966.6997},
+	{-9855.419, 7033.0625, -6955.466},
+	{-8344.8125, 9589.244, -8092.1562},
+	{-173.63191, -161.68604, -16106.51},
+	{-10351.192, 4029.0623, -7691.9517},
+	{1651.0304, 4111.073, -7205.025},
+	{7661.829, -15982.764, -5870.813},
+	{1211.8834, 2114.3271, -14520.793},
+	{-16566.314, 8068.6924, -4807.989},
+	{-4180.802, 3230.412, -7480.582},
+	{912.8561, 1040.415, -4985.5923},
+	{-21726.992, 15905.758, -8000.5317},
+	{-17196.164, 13115.959, -9734.641},
+	{-15563.799, 15583.943, -8099.5737},
+	{7307.3613, -4941.683, -5857.2173},
+	{-7640.9717, 10771.004, -10396.364},
+	{-3744.1465, 2666.1924, -8266.74},
+	{-8848.217, 19696.637, -14158.527},
+	{-6828.9653, 4293.566, -4453.872},
+	{-6209.383, -487.0646, -3305.7576},
+	{-4492.381, 3233.7449, -4154.2476},
+	{3187.4807, 108.39468, -6342.354},
+	{-11658.335, 11586.657, -9767.219},
+	{-3946.2488, 1762.163, -3592.3113},
+	{-5433.792, 3744.5369, -6158.088},
+	{-4124.7383, 5646.6064, -5865.935},
+	{-343.9615, -3936.3977, -6713.8267},
+	{-23920.018, 21391.041, -14623.951},
+	{-12399.02, 8198.253, -5077.487},
+	{-14286.586, 13156.18, -5641.1406},
+	{-6626.024, 7131.681, -6976.4204},
+	{-1901.2125, 13456.257, -12015.337},
+	{-12724.569, 11486.291, -8687.925},
+	{-6931.4165, 5366.982, -7208.096},
+	{803.6864, 556.6083, -5674.921},
+	{-6445.823, 2675.788, -3307.0583},
+	{4729.295, 944.3128, -5777.6074},
+	{-5670.2974, 626.1356, -4374.1157},
+	{-13920.924, 9177.732, -3972.0737},
+	{2947.0173, 1046.9813, -4972.7603},
+	{7753.5996, -6117.2905, -10555.608},
+	{-798.5483, 1800.9153, -4742.5015},
+	{2323.3647, -1996.7037, -3848.9011},
+	{-2129.7954, 1763.4065, -384.70056},
+	{-1554.626, 1264.2924, -277.583},
+	{-1241.0402, 992.3214, -219.35773},
+	{-1039.2145, 817.32794, -181.95428},
+	{-896.8034, 693.8528, -155.58774},
+	{-790.2481, 601.4453, -135.86365},
+	{-707.2156, 529.40497, -120.48623},
+	{-640.5582, 471.53314, -108.127556},
+	{-585.8176, 423.96545, -97.960884},
+	{-540.05316, 384.15436, -89.44193},
+	{-501.23846, 350.3453, -82.19633},
+	{-467.92706, 321.2864, -75.95726},
+	{-439.05576, 296.05798, -70.52898},
+	{-413.82156, 273.96637, -65.76406},
+	{-391.61353, 254.48253, -61.55},
+	{-371.9467, 237.18828, -57.798157},
+	{-1963.5836, 1643.1427, -363.60153},
+	{-1431.456, 1177.5078, -262.8711},
+	{-1138.9714, 921.6722, -207.65523},
+	{-949.9819, 756.38135, -172.03296},
+	{-816.33636, 639.47595, -146.8581},
+	{-716.2148, 551.85925, -127.99481},
+	{-638.1428, 483.49365, -113.2726},
+	{-575.44904, 428.5459, -101.43192},
+	{-523.9622, 383.3704, -91.68672},
+	{-480.92606, 345.55942, -83.51851},
+	{-444.43832, 313.45218, -76.57009},
+	{-413.1375, 285.8608, -70.586395},
+	{-386.0281, 261.91614, -65.38075},
+	{-362.35345, 240.95891, -60.81198},
+	{-341.5333, 222.4835, -56.77183},
+	{-323.11322, 206.09416, -53.17567},
+	{-1820.8105, 1539.497, -345.38046},
+	{-1324.9738, 1102.2627, -250.09654},
+	{-1050.4368, 860.23236, -197.4679},
+	{-872.408, 703.2747, -163.38232},
+	{-746.26324, 592.02344, -139.23753},
+	{-651.65314, 508.53433, -121.119026},
+	{-577.83276, 443.33646, -106.963974},
+	{-518.5365, 390.90967, -95.57176},
+	{-469.8382, 347.79642, -86.19143},
+	{-429.1392, 311.70917, -78.32678},
+	{-394.642, 281.0667, -71.63531},
+	{-365.0658, 254.74194, -65.87297},
+	{-339.462, 231.90141, -60.859726},
+	{-317.11697, 211.91801, -56.460175},
+	{-297.48117, 194.30916, -52.570232},
+	{-280.12387, 178.69669, -49.10843},
+	{-1696.8958, 1449.2397, -329.476},
+	{-1232.0936, 1036.4379, -238.90192},
+	{-973.0175, 806.3688, -188.52579},
+	{-804.46063, 656.65295, -155.7817},
+	{-684.8076, 550.32227, -132.53717},
+	{-594.97095, 470.4276, -115.06995},
+	{-524.83356, 407.98892, -101.41092},
+	{-468.47995, 357.75775, -90.41088},
+	{-422.19595, 316.43994, -81.34951},
+	{-383.51758, 281.85147, -73.7499},
+	{-350.74533, 252.48567, -67.2833},
+	{-322.6565, 227.2599, -61.7139},
+	{-298.35208, 205.37813, -56.868477},
+	{-277.15335, 186.23964, -52.616436},
+	{-258.53745, 169.38187, -48.857327},
+	{-242.09427, 154.44215, -45.512478},
+	{-1588.352, 1369.9073, -315.45956},
+	{-1150.4424, 978.40265, -229.01285},
+	{-904.83203, 758.8098, -180.61897},
+	{-744.54626, 615.452, -149.05795},
+	{-630.56854, 513.4461, -126.60804},
+	{-544.90674, 436.71173, -109.71578},
+	{-477.99036, 376.6988, -96.494576},
+	{-424.20944, 328.3972, -85.840546},
+	{-380.03317, 288.65518, -77.0602},
+	{-343.12292, 255.38522, -69.69471},
+	{-311.85312, 227.13757, -63.42587},
+	{-285.06055, 202.87497, -58.026268},
+	{-261.88727, 181.83267, -53.328423},
+	{-241.68524, 163.43321, -49.205994},
+	{-223.95493, 147.23167, -45.56174},
+	{-208.30443, 132.87912, -42.319473},
+	{-1492.56, 1299.66, -303.01434},
+	{-1078.1597, 926.8767, -220.21391},
+	{-844.3945, 716.54987, -173.58171},
+	{-691.3988, 578.82574, -143.07362},
+	{-582.42737, 480.65427, -121.33121},
+	{-500.44894, 406.72226, -104.95081},
+	{-436.3738, 348.8597, -92.11925},
+	{-384.86115, 302.26788, -81.77299},
+	{-342.54404, 263.92276, -73.24274},
+	{-307.188, 231.81772, -66.08485},
+	{-277.2396, 204.55855, -59.991524},
+	{-251.58574, 181.14653, -54.742477},
+	{-229.40492, 160.84479, -50.175365},
+	{-210.07628, 143.09656, -46.167645},
+	{-193.12093, 127.472626, -42.624947},
+	{-178.16309, 113.63623, -39.47329},
+	{-1407.4104, 1237.0038, -291.88068},
+	{-1013.77246, 880.84607, -212.33482},
+	{-790.51685, 678.7842, -167.28146},
+	{-644.00024, 546.0925, -137.7181},
+	{-539.48065, 451.34784, -116.61056},
+	{-460.77774, 379.9199, -100.689354},
+	{-399.22833, 323.97806, -88.20713},
+	{-349.73083, 278.91245, -78.136604},
+	{-309.06412, 241.81325, -69.83024},
+	{-275.08652, 210.74625, -62.85804},
+	{-246.30885, 184.3671, -56.921555},
+	{-221.66272, 161.71153, -51.806915},
+	{-200.35909, 142.06764, -47.356396},
+	{-181.80127, 124.8972, -43.45087},
+	{-165.52892, 109.78509, -39.99855},
+	{-151.18054, 96.40545, -36.927444},
+	{-1331.2397, 1180.7618, -281.8548},
+	{-956.0978, 839.4951, -205.2388},
+	{-742.23895, 644.8614, -161.61107},
+	{-601.52344, 516.698, -132.90147},
+	{-500.99142, 425.03735, -112.36767},
+	{-425.22202, 355.8625, -96.86123},
+	{-365.93372, 301.64795, -84.69432},
+	{-318.2378, 257.9528, -74.87226},
+	{-279.04745, 221.97327, -66.76783},
+	{-246.30095, 191.83754, -59.962803},
+	{-218.56767, 166.24698, -54.167362},
+	{-194.81938, 144.26851, -49.173462},
+	{-174.29622, 125.21271, -44.827564},
+	{-156.42331, 108.55814, -41.01364},
+	{-140.75684, 93.902374, -37.642235},
+	{-126.94829, 80.92942, -34.643166},
+	{-1262.7189, 1129.9941, -272.77438},
+	{-904.17365, 802.1599, -198.81448},
+	{-698.77576, 614.2483, -156.48291},
+	{-563.28937, 490.1864, -128.54997},
+	{-466.35263, 401.31924, -108.53793},
+	{-393.2276, 334.18442, -93.40846},
+	{-335.97647, 281.53296, -81.527916},
+	{-289.90417, 239.07822, -71.93156},
+	{-252.04045, 204.10892, -64.0099},
+	{-220.40028, 174.8136, -57.356247},
+	{-193.60449, 149.93393, -51.688377},
+	{-170.66118, 128.56511, -46.803566},
+	{-150.83682, 110.03818, -42.552086},
+	{-133.57623, 93.84689, -38.82073},
+	{-118.450615, 79.60038, -35.522167},
+	{-105.12312, 66.991615, -32.587868},
+	{-1200.7678, 1083.9363, -264.5075},
+	{-857.2127, 768.29645, -192.97076},
+	{-659.48047, 586.505, -151.82475},
+	{-528.73615, 466.18002, -124.602455},
+	{-435.06033, 379.8579, -105.067665},
+	{-364.3331, 314.58087, -90.28275},
+	{-308.92825, 263.35184, -78.66373},
+	{-264.3261, 222.02489, -69.273254},
+	{-227.6629, 187.97342, -61.518204},
+	{-197.02284, 159.44081, -55.00237},
+	{-171.0736, 135.20558, -49.450512},
+	{-148.85622, 114.38874, -44.66479},
+	{-129.66121, 96.34003, -40.498966},
+	{-112.95125, 80.56708, -36.84242},
+	{-98.311226, 66.68959, -33.60978},
+	{-85.41491, 54.40871, -30.734018},
+	{-1144.4976, 1041.9576, -256.9454},
+	{-814.5628, 737.45355, -187.63219},
+	{-623.8148, 561.265, -147.57646},
+	{-497.3948, 444.3632, -121.00789},
+	{-406.69272, 360.37207, -101.91191},
+	{-338.15146, 296.79572, -87.44358},
+	{-284.42752, 246.86668, -76.06433},
+	{-241.1655, 206.57187, -66.862946},
+	{-205.59438, 173.35847, -59.260494},
+	{-175.86328, 145.52162, -52.870754},
+	{-150.68257, 121.873604, -47.42493},
+	{-129.12346, 101.559105, -42.729656},
+	{-110.498344, 83.945045, -38.641922},
+	{-94.28628, 68.551796, -35.053493},
+	{-80.08464, 55.008747, -31.880796},
+	{-67.57691, 43.02457, -29.05819},
+	{-1093.174, 1003.5379, -249.9984},
+	{-775.6794, 709.254, -182.73563},
+	{-591.32794, 538.22095, -143.68762},
+	{-468.87094, 424.47012, -117.723335},
+	{-380.8943, 342.6243, -99.03277},
+	{-314.3558, 280.6123, -84.856674},
+	{-262.17258, 231.8796, -73.69886},
+	{-220.13527, 192.53128, -64.67137},
+	{-185.56282, 160.08699, -57.209377},
+	{-156.66205, 132.8879, -50.93555},
+	{-132.18263, 109.77751, -45.587082},
+	{-111.22349, 89.92241, -40.97475},
+	{-93.11713, 72.70532, -36.95854},
+	{-77.35763, 57.658382, -33.43242},
+	{-63.55384, 44.419918, -30.314474},
+	{-51.398182, 32.70553, -27.54036},
+	{-1046.181, 968.23914, -243.59103},
+	{-740.1055, 683.38116, -178.2282},
+	{-561.63916, 517.1128, -140.11566},
+	{-442.83084, 406.2756, -114.71242},
+	{-357.36343, 326.41293, -96.39805},
+	{-292.66833, 265.8463, -82.49291},
+	{-241.90244, 218.2181, -71.5402},
+	{-200.99109, 179.74301, -62.673622},
+	{-167.33597, 148.00752, -55.341446},
+	{-139.19731, 121.39566, -49.174644},
+	{-115.36095, 98.7798, -43.915955},
+	{-94.951294, 79.34675, -39.380016},
+	{-77.31947, 62.493904, -35.4296},
+	{-61.9734, 47.764282, -31.960716},
+	{-48.532513, 34.8045, -28.89301},
+	{-36.69746, 23.336576, -26.163317},
+	{-1003.0024, 935.6945, -237.66019},
+	{-707.4535, 659.5657, -174.06491},
+	{-534.42505, 497.71948, -136.82439},
+	{-418.99017, 389.58743, -111.944145},
+	{-335.84274, 311.56552, -93.98026},
+	{-272.85144, 252.33983, -80.327354},
+	{-223.3949, 205.7355, -69.565384},
+	{-183.52321, 168.06938, -60.848312},
+	{-150.71452, 136.98994, -53.636616},
+	{-123.278465, 110.921104, -47.56903},
+	{-100.0344, 88.76204, -42.393467},
+	{-80.130394, 69.71842, -37.92819},
+	{-62.934727, 53.20125, -34.038544},
+	{-47.968155, 38.76369, -30.622463},
+	{-34.859924, 26.060051, -27.601034},
+	{-23.3183, 14.818311, -24.91221},
+	{-963.1999, 905.59204, -232.15219},
+	{-677.3935, 637.5783, -170.20749},
+	{-509.40915, 479.85184, -133.78282},
+	{-397.10544, 374.2408, -109.39193},
+	{-316.11127, 297.93396, -91.755806},
+	{-254.70096, 239.95694, -78.33857},
+	{-206.45888, 194.30553, -67.75465},
+	{-167.55096, 157.39166, -59.177},
+	{-135.52649, 126.92185, -52.077545},
+	{-108.74092, 101.35712, -46.10228},
+	{-86.04481, 79.621735, -41.003994},
+	{-66.60815, 60.938942, -36.604317},
+	{-49.8152, 44.732452, -32.771034},
+	{-35.19865, 30.56488, -29.40386},
+	{-22.396837, 18.097708, -26.425241},
+	{-11.12512, 7.064487, -23.77417},
+	{-926.3981, 877.6639, -227.0209},
+	{-649.6424, 617.2221, -166.62308},
+	{-486.35397, 463.34695, -130.96432},
+	{-376.96677, 360.09354, -107.03286},
+	{-297.97836, 285.39017, -89.70428},
+	{-238.04039, 228.58005, -76.508},
+	{-190.9289, 183.81857, -66.090866},
+	{-152.91786, 147.60683, -57.643692},
+	{-121.622765, 117.70558, -50.649174},
+	{-95.44188, 92.61064, -44.760143},
+	{-73.25479, 71.26978, -39.733955},
+	{-54.251965, 52.92266, -35.39542},
+	{-37.832558, 37.004917, -31.614607},
+	{-23.540354, 23.088001, -28.292912},
+	{-11.022188, 10.840114, -25.354052},
+	{-1.9675597e-05, 1.4160513e-05, -22.738},
+	{-567.5225, 469.86664, -102.41663},
+	{-414.44577, 337.02957, -73.90861},
+	{-330.9721, 264.631, -58.409115},
+	{-277.23785, 218.03801, -48.449963},
+	{-239.31404, 185.1541, -41.4276},
+	{-210.93202, 160.5378, -36.17283},
+	{-188.81032, 141.34196, -32.074825},
+	{-171.04695, 125.91719, -28.78026},
+	{-156.45554, 113.23515, -26.069145},
+	{-144.25351, 102.61791, -23.796648},
+	{-133.90166, 93.59854, -21.863138},
+	{-125.014946, 85.84386, -20.197615},
+	{-117.310425, 79.109085, -18.747976},
+	{-110.574936, 73.20995, -17.47504},
+	{-104.64457, 68.00482, -16.348732},
+	{-99.390976, 63.382843, -15.345533},
+	{-523.225, 437.8146, -96.793564},
+	{-381.6092, 313.89294, -69.98654},
+	{-303.75507, 245.7919, -55.288677},
+	{-253.4391, 201.78302, -45.803974},
+	{-217.84952, 170.64885, -39.099052},
+	{-191.18097, 147.30865, -34.073612},
+	{-170.3803, 129.09161, -30.150164},
+	{-153.67247, 114.44567, -26.9936},
+	{-139.94753, 102.40079, -24.394772},
+	{-128.47202, 92.31625, -22.215714},
+	{-118.739746, 83.75012, -20.361378},
+	{-110.388916, 76.38664, -18.763943},
+	{-103.15331, 69.993614, -17.37359},
+	{-96.832275, 64.39608, -16.152826},
+	{-91.27137, 59.45946, -15.07284},
+	{-86.34961, 55.07839, -14.111099},
+	{-485.1763, 410.1935, -91.937904},
+	{-353.2227, 293.8339, -66.58119},
+	{-280.14755, 229.40924, -52.572395},
+	{-232.75015, 187.61952, -43.496983},
+	{-199.1579, 157.99113, -37.066425},
+	{-173.95692, 135.75018, -32.23937},
+	{-154.28839, 118.376785, -28.467003},
+	{-138.48526, 104.40226, -25.429907},
+	{-125.502846, 92.90661, -22.928276},
+	{-114.649666, 83.28115, -20.830084},
+	{-105.44786, 75.10544, -19.044247},
+	{-97.55549, 68.07876, -17.505697},
+	{-90.72075, 61.979763, -16.166594},
+	{-84.753685, 56.64154, -14.990902},
+	{-79.50802, 51.935623, -13.950917},
+	{-74.86911, 47.761356, -13.024955},
+	{-452.15448, 386.14172, -87.69988},
+	{-328.46307, 276.28705, -63.59722},
+	{-259.50406, 215.04712, -50.188217},
+	{-214.62846, 175.18564, -41.470047},
+	{-182.76466, 146.86757, -35.279263},
+	{-158.83467, 125.5838, -30.625671},
+	{-140.1468, 108.9452, -26.985426},
+	{-125.12721, 95.55538, -24.05279},
+	{-112.78764, 84.53789, -21.636124},
+	{-102.47293, 75.31189, -19.60859},
+	{-93.72975, 67.47569, -17.882572},
+	{-86.23341, 60.74169, -16.395414},
+	{-79.74461, 54.898003, -15.101012},
+	{-74.08271, 49.784748, -13.964608},
+	{-69.10851, 45.278786, -12.959463},
+	{-64.712875, 41.283592, -12.06464},
+	{-423.23544, 365.00735, -83.96634},
+	{-306.6965, 260.8167, -60.961273},
+	{-241.32237, 202.36603, -48.080105},
+	{-198.64864, 164.19733, -39.676952},
+	{-168.29588, 137.03084, -33.697792},
+	{-145.47742, 116.58858, -29.197323},
+	{-127.64715, 100.59592, -25.673685},
+	{-113.312546, 87.71995, -22.833212},
+	{-101.53465, 77.12244, -20.491467},
+	{-91.69006, 68.24708, -18.526201},
+	{-83.34696, 60.708675, -16.852865},
+	{-76.19575, 54.231163, -15.410937},
+	{-70.0081, 48.611004, -14.15584},
+	{-64.61153, 43.694515, -13.053953},
+	{-59.873055, 39.36326, -12.079393},
+	{-55.68838, 35.52436, -11.211882},
+	{-397.70782, 346.28824, -80.65022},
+	{-287.42722, 247.08174, -58.615986},
+	{-225.20609, 191.09769, -46.203827},
+	{-184.47302, 154.42883, -38.081028},
+	{-155.453, 128.28323, -32.290257},
+	{-133.61514, 108.58712, -27.926104},
+	{-116.54132, 93.16706, -24.506235},
+	{-102.81063, 80.74637, -21.74773},
+	{-91.52759, 70.52073, -19.472576},
+	{-82.09685, 61.9556, -17.562614},
+	{-74.10558, 54.68043, -15.936029},
+	{-67.25756, 48.42942, -14.534201},
+	{-61.33414, 43.006454, -13.313922},
+	{-56.170067, 38.26335, -12.242583},
+	{-51.637882, 34.08587, -11.295065},
+	{-47.637558, 30.384361, -10.451677},
+	{-375.01498, 329.59122, -77.683464},
+	{-270.26215, 234.81145, -56.51588},
+	{-210.83833, 181.0274, -44.52402},
+	{-171.8299, 145.69823, -36.65275},
+	{-143.99493, 120.464905, -31.03102},
+	{-123.02906, 101.435486, -26.78914},
+	{-106.62765, 86.52683, -23.462305},
+	{-93.43347, 74.51252, -20.777235},
+	{-82.589714, 64.61856, -18.561693},
+	{-73.526024, 56.329884, -16.701195},
+	{-65.84649, 49.289062, -15.116384},
+	{-59.26677, 43.239487, -13.750352},
+	{-53.576885, 37.991688, -12.561126},
+	{-48.618023, 33.402424, -11.517001},
+	{-44.267647, 29.361195, -10.593547},
+	{-40.429546, 25.781275, -9.7716055},
+	{-354.715, 314.6038, -75.01201},
+	{-254.88571, 223.78821, -54.62445},
+	{-197.96295, 171.98138, -43.012104},
+	{-160.4986, 137.85759, -35.368122},
+	{-133.72505, 113.44527, -29.899147},
+	{-113.54009, 95.01568, -25.76771},
+	{-97.74062, 80.56692, -22.52484},
+	{-85.02641, 68.917786, -19.905998},
+	{-74.57533, 59.321632, -17.744167},
+	{-65.839424, 51.280945, -15.928194},
+	{-58.437958, 44.450115, -14.380949},
+	{-52.097294, 38.580837, -13.047075},
+	{-46.615208, 33.48963, -11.885712},
+	{-41.838684, 29.03772, -10.86598},
+	{-37.64961, 25.117989, -9.964072},
+	{-33.955204, 21.646334, -9.161308},
+	{-336.45386, 301.07562, -72.59262},
+	{-241.04169, 213.83519, -52.91205},
+	{-186.37068, 163.81746, -41.644703},
+	{-150.29807, 130.78537, -34.207474},
+	{-124.481476, 107.116646, -28.877405},
+	{-105.00041, 89.230156, -24.846336},
+	{-89.743256, 75.197525, -21.67972},
+	{-77.461174, 63.87858, -19.120966},
+	{-67.36333, 54.551453, -17.00782},
+	{-58.921993, 46.734554, -15.232163},
+	{-51.77014, 40.093033, -13.718897},
+	{-45.643806, 34.386093, -12.414077},
+	{-40.34778, 29.435715, -11.277859},
+	{-35.734287, 25.107157, -10.280114},
+	{-31.689203, 21.29639, -9.397603},
+	{-28.122862, 17.921688, -8.612084},
+	{-319.94278, 288.8021, -70.38997},
+	{-228.52, 204.8072, -51.35436},
+	{-175.88899, 156.41827, -40.402554},
+	{-141.07845, 124.3808, -33.154488},
+	{-116.12976, 101.38952, -27.951473},
+	{-97.286934, 83.997574, -24.012142},
+	{-82.52125, 70.34363, -20.91516},
+	{-70.63051, 59.324913, -18.411224},
+	{-60.852283, 50.242176, -16.34245},
+	{-52.67722, 42.628376, -14.603497},
+	{-45.750763, 36.15845, -13.121129},
+	{-39.817715, 30.598497, -11.842698},
+	{-34.689255, 25.77546, -10.729292},
+	{-30.222374, 21.558294, -9.751471},
+	{-26.30657, 17.845776, -8.886512},
+	{-22.855028, 14.558372, -8.116578},
+	{-304.94513, 277.61554, -68.37512},
+	{-217.14682, 196.584, -49.93125},
+	{-166.3744, 149.68616, -39.269627},
+	{-132.71466, 118.559715, -32.195564},
+	{-108.557434, 96.188866, -27.10936},
+	{-90.29644, 79.249626, -23.25431},
+	{-75.97855, 65.942085, -20.221247},
+	{-64.44413, 55.197784, -17.767578},
+	{-54.956676, 46.33821, -15.739447},
+	{-47.02362, 38.90967, -14.034076},
+	{-40.301785, 32.59611, -12.5799465},
+	{-34.544006, 27.169924, -11.325604},
+	{-29.56728, 22.462616, -10.232994},
+	{-25.232977, 18.346563, -9.2733135},
+	{-21.433916, 14.723107, -8.424318},
+	{-18.085842, 11.514702, -7.6685386},
+	{-291.26492, 267.3768, -66.5241},
+	{-206.7771, 189.0651, -48.625927},
+	{-157.70663, 143.53902, -38.232456},
+	{-125.10161, 113.25125, -31.319246},
+	{-101.66971, 91.45138, -26.34096},
+	{-83.94177, 74.928535, -22.56371},
+	{-70.03392, 61.939407, -19.58959},
+	{-58.825546, 51.447117, -17.182232},
+	{-49.603943, 42.79231, -15.191503},
+	{-41.89195, 35.53358, -13.517002},
+	{-35.356834, 29.36318, -12.0888},
+	{-29.758802, 24.059322, -10.856549},
+	{-24.920206, 19.45773, -9.782988},
+	{-20.706411, 15.433893, -8.8399},
+	{-17.01329, 11.891542, -8.005486},
+	{-13.758968, 8.754975, -7.2626166},
+	{-278.7384, 257.96957, -64.81687},
+	{-197.28893, 182.16594, -47.42425},
+	{-149.78442, 137.90775, -37.27973},
+	{-118.15031, 108.39535, -30.515839},
+	{-95.38618, 87.123276, -25.637688},
+	{-78.148834, 70.985146, -21.932568},
+	{-64.61817, 58.28997, -19.013044},
+	{-53.709534, 48.030167, -16.648537},
+	{-44.73214, 39.564075, -14.692377},
+	{-37.22303, 32.4617, -13.046377},
+	{-30.859116, 26.42297, -11.642084},
+	{-25.40738, 21.231485, -10.430177},
+	{-20.695152, 16.726894, -9.37414},
+	{-16.591442, 12.787559, -8.446296},
+	{-12.99496, 9.319419, -7.6252594},
+	{-9.826016, 6.2484813, -6.8942194},
+	{-267.22775, 249.29587, -63.236557},
+	{-188.57906, 175.81485, -46.31426},
+	{-142.52145, 132.73334, -36.401787},
+	{-111.78498, 103.94078, -29.777079},
+	{-89.638214, 83.15859, -24.992214},
+	{-72.8543, 67.37734, -21.354239},
+	{-59.672085, 54.95468, -18.485487},
+	{-49.040176, 44.910225, -16.160788},
+	{-40.28809, 36.618793, -14.23671},
+	{-32.96602, 29.660984, -12.617129},
+	{-26.75979, 23.74388, -11.234975},
+	{-21.442677, 18.656088, -10.041884},
+	{-16.846575, 14.240879, -9.002031},
+	{-12.843919, 10.379316, -8.08825},
+	{-9.3360195, 6.9793854, -7.2795377},
+	{-6.2452183, 3.9686804, -6.559381},
+	{-256.6161, 241.27249, -61.768845},
+	{-180.55957, 169.9507, -45.28574},
+	{-135.84407, 127.965355, -35.590363},
+	{-105.94071, 99.84361, -29.09588},
+	{-84.36692, 79.51782, -24.398247},
+	{-68.00372, 64.06889, -20.823002},
+	{-55.14468, 51.899822, -18.001644},
+	{-44.76929, 42.05562, -15.714066},
+	{-36.225937, 33.926468, -13.819874},
+	{-29.077015, 27.102865, -12.224878},
+	{-23.016647, 21.29856, -10.8633},
+	{-17.823952, 16.306837, -9.68768},
+	{-13.33507, 11.974353, -8.662837},
+	{-9.425608, 8.18468, -7.7620797},
+	{-5.9993014, 4.8477182, -6.964768},
+	{-2.9803767, 1.8925402, -6.2546644},
+	{-246.80379, 233.82838, -60.40151},
+	{-173.15508, 164.52101, -44.32994},
+	{-129.68895, 123.56038, -34.83836},
+	{-100.56158, 96.06593, -28.466135},
+	{-79.521484, 76.16684, -23.85035},
+	{-63.550064, 61.028442, -20.333918},
+	{-50.991856, 49.096203, -17.556952},
+	{-40.855152, 39.438877, -15.30411},
+	{-32.505917, 31.46107, -13.437857},
+	{-25.517931, 24.762522, -11.86582},
+	{-19.593048, 19.063238, -10.52344},
+	{-14.51583, 14.160882, -9.364102},
+	{-10.126383, 9.905271, -8.35323},
+	{-6.3032703, 6.182335, -7.464584},
+	{-2.9524837, 2.9037597, -6.67786},
+	{-5.3900603e-06, 3.8933763e-06, -5.977081},
+	{-274.38284, 227.12381, -49.349945},
+	{-200.70566, 163.1851, -35.629677},
+	{-160.50606, 128.31483, -28.16455},
+	{-134.60983, 105.85554, -23.363443},
+	{-116.318695, 89.9904, -19.974684},
+	{-102.61831, 78.102936, -17.436193},
+	{-91.93057, 68.82395, -15.454295},
+	{-83.34075, 61.36022, -13.8590975},
+	{-76.27817, 55.217102, -12.544804},
+	{-70.366325, 50.068443, -11.441746},
+	{-65.34573, 45.689556, -10.501991},
+	{-61.03111, 41.920094, -9.691374},
+	{-57.286224, 38.642216, -8.984816},
+	{-54.00845, 35.76721, -8.363455},
+	{-51.118847, 33.226852, -7.812807},
+	{-48.5556, 30.967762, -7.321547},
+	{-252.95222, 211.61697, -46.6297},
+	{-184.80255, 151.9794, -33.730312},
+	{-147.31332, 119.18267, -26.652134},
+	{-123.065895, 97.970375, -22.080101},
+	{-105.90082, 82.94976, -18.844652},
+	{-93.02698, 71.678215, -16.41693},
+	{-82.97652, 62.8717, -14.519357},
+	{-74.89587, 55.783916, -12.990832},
+	{-68.25119, 49.948326, -11.730784},
+	{-62.68968, 45.056747, -10.672865},
+	{-57.967796, 40.89655, -9.771352},
+	{-53.911427, 37.31578, -8.993614},
+	{-50.39245, 34.20269, -8.315677},
+	{-47.314243, 31.473045, -7.7194915},
+	{-44.60246, 29.06205, -7.191189},
+	{-42.198826, 26.918951, -6.719917},
+	{-234.54875, 198.25761, -44.28147},
+	{-171.05681, 142.2664, -32.081615},
+	{-135.87144, 111.24262, -25.335882},
+	{-113.03118, 91.10072, -20.96137},
+	{-96.829185, 76.80653, -17.858362},
+	{-84.66308, 66.06537, -15.526426},
+	{-75.158585, 57.665943, -13.701812},
+	{-67.5142, 50.902138, -12.230982},
+	{-61.22756, 45.33156, -11.017877},
+	{-55.96608, 40.66146, -9.999008},
+	{-51.499874, 36.689583, -9.13057},
+	{-47.664417, 33.271236, -8.381259},
+	{-44.338528, 30.299892, -7.7280545},
+	{-41.43076, 27.69521, -7.153615},
+	{-38.87069, 25.395332, -6.6446013},
+	{-36.603096, 23.351791, -6.190573},
+	{-218.5789, 186.62698, -42.232464},
+	{-159.06818, 133.77104, -30.637201},
+	{-125.86635, 104.28246, -24.180714},
+	{-104.24151, 85.070274, -19.978533},
+	{-88.87267, 71.40797, -16.991222},
+	{-77.31929, 61.128487, -14.743011},
+	{-68.28758, 53.083508, -12.982177},
+	{-61.020947, 46.60176, -11.561779},
+	{-55.04421, 41.261837, -10.389694},
+	{-50.04228, 36.784416, -9.40494},
+	{-45.797104, 32.97628, -8.565378},
+	{-42.152466, 29.69905, -7.8408713},
+	{-38.993206, 26.850773, -7.2092357},
+	{-36.23237, 24.354488, -6.653745},
+	{-33.80294, 22.150915, -6.1615286},
+	{-31.652365, 20.193573, -5.722505},
+	{-204.59428, 176.4086, -40.42772},
+	{-148.52876, 126.281555, -29.361406},
+	{-117.05388, 98.13704, -23.159365},
+	{-96.48995, 79.740776, -19.109098},
+	{-81.849236, 66.6336, -16.22386},
+	{-70.83155, 56.75988, -14.049531},
+	{-62.213203, 49.026405, -12.344972},
+	{-55.276745, 42.79248, -10.969057},
+	{-49.570736, 37.655113, -9.833137},
+	{-44.795395, 33.346794, -8.878438},
+	{-40.74302, 29.682253, -8.064303},
+	{-37.264668, 26.528683, -7.3616214},
+	{-34.250458, 23.788143, -6.748948},
+	{-31.61738, 21.386663, -6.210104},
+	{-29.301413, 19.267233, -5.732631},
+	{-27.252325, 17.385124, -5.306766},
+	{-192.24988, 167.3588, -38.824986},
+	{-139.19795, 119.63226, -28.226362},
+	{-109.24147, 92.67595, -22.25033},
+	{-89.6123, 75.00235, -18.33521},
+	{-75.613655, 62.387215, -15.540823},
+	{-65.068474, 52.873177, -13.432246},
+	{-56.81466, 45.41577, -11.777753},
+	{-50.169247, 39.401375, -10.441393},
+	{-44.70173, 34.44333, -9.337609},
+	{-40.125835, 30.284603, -8.409599},
+	{-36.243008, 26.746986, -7.618025},
+	{-32.910736, 23.702612, -6.934692},
+	{-30.023813, 21.057116, -6.3388143},
+	{-27.502699, 18.73918, -5.814701},
+	{-25.286037, 16.69381, -5.350262},
+	{-23.325655, 14.877843, -4.936019},
+	{-181.27594, 159.2869, -37.391247},
+	{-130.88503, 113.69176, -27.209993},
+	{-102.275314, 87.794914, -21.436438},
+	{-83.47663, 70.76663, -17.64253},
+	{-70.048775, 58.591064, -14.929637},
+	{-59.923668, 49.398335, -12.880028},
+	{-51.993835, 42.187447, -11.27041},
+	{-45.60695, 36.36897, -9.96948},
+	{-40.351128, 31.57084, -8.89446},
+	{-35.952118, 27.545412, -7.990318},
+	{-32.219505, 24.120783, -7.2189007},
+	{-29.016516, 21.173517, -6.5528383},
+	{-26.2421, 18.61245, -5.9719386},
+	{-23.819828, 16.368643, -5.4609485},
+	{-21.690704, 14.388906, -5.008109},
+	{-19.808401, 12.631477, -4.6041965},
+	{-171.45853, 152.04161, -36.100327},
+	{-123.43698, 108.354485, -26.294584},
+	{-96.03112, 83.40955, -20.7038},
+	{-77.975815, 66.96176, -17.019403},
+	{-65.0591, 55.18169, -14.380133},
+	{-55.310123, 46.27799, -12.383771},
+	{-47.67027, 39.288746, -10.814649},
+	{-41.514637, 33.646297, -9.545665},
+	{-36.44803, 28.99174, -8.496558},
+	{-32.206974, 25.085869, -7.6138997},
+	{-28.608364, 21.762484, -6.860607},
+	{-25.520567, 18.902136, -6.210058},
+	{-22.846273, 16.416552, -5.6425986},
+	{-20.51184, 14.238935, -5.1433706},
+	{-18.460403, 12.31773, -4.7009163},
+	{-16.647284, 10.612436, -4.306243},
+	{-162.62585, 145.50131, -34.931202},
+	{-116.72969, 103.5347, -25.465755},
+	{-90.40743, 79.45091, -20.041086},
+	{-73.022026, 63.528744, -16.456274},
+	{-60.566044, 52.106804, -13.88394},
+	{-51.156048, 43.46474, -11.935961},
+	{-43.77741, 36.676018, -10.403606},
+	{-37.82996, 31.192709, -9.163603},
+	{-32.933548, 26.667831, -8.137981},
+	{-28.834436, 22.86985, -7.2747726},
+	{-25.356117, 19.637728, -6.537873},
+	{-22.371607, 16.855644, -5.9013405},
+	{-19.786972, 14.437944, -5.3460093},
+	{-17.531092, 12.319783, -4.857384},
+	{-15.549021, 10.45108, -4.424279},
+	{-13.79758, 8.792484, -4.0379143},
+	{-154.6384, 139.56715, -33.86681},
+	{-110.661385, 99.16205, -24.711725},
+	{-85.320625, 75.86208, -19.438948},
+	{-68.54259, 60.418686, -15.9452305},
+	{-56.504402, 49.322952, -13.434106},
+	{-47.40171, 40.91909, -11.530339},
+	{-40.25976, 34.312817, -10.031556},
+	{-34.50082, 28.974186, -8.817988},
+	{-29.758392, 24.567102, -7.8137674},
+	{-25.787586, 20.867022, -6.968265},
+	{-22.417883, 17.71763, -6.2462716},
+	{-19.526522, 15.006408, -5.62247},
+	{-17.022644, 12.650095, -5.078142},
+	{-14.837419, 10.5856285, -4.5991244},
+	{-12.91765, 8.7642765, -4.1744814},
+	{-11.221517, 7.147737, -3.7956257},
+	{-147.38167, 134.15794, -32.893135},
+	{-105.1479, 95.17827, -24.022736},
+	{-80.70122, 72.59571, -18.889612},
+	{-64.4769, 57.59078, -15.479673},
+	{-52.819633, 46.793736, -13.024815},
+	{-43.99704, 38.607887, -11.16166},
+	{-37.070717, 32.16848, -9.693686},
+	{-31.483389, 26.962069, -8.504355},
+	{-26.881031, 22.662535, -7.5197353},
+	{-23.026823, 19.051752, -6.6904316},
+	{-19.755726, 15.977736, -5.9820604},
+	{-16.948832, 13.331003, -5.369879},
+	{-14.518094, 11.030493, -4.835583},
+	{-12.3967705, 9.014768, -4.365314},
+	{-10.533264, 7.2363405, -3.9483664},
+	{-8.886996, 5.6578684, -3.5763307},
+	{-140.76088, 129.20634, -31.998573},
+	{-100.11906, 91.53473, -23.390656},
+	{-76.49099, 69.612076, -18.386568},
+	{-60.774033, 55.010666, -15.054051},
+	{-49.465794, 44.488457, -12.651163},
+	{-40.899773, 36.5031, -10.82549},
+	{-34.17086, 30.217043, -9.385924},
+	{-28.740538, 25.132042, -8.218919},
+	{-24.26623, 20.931173, -7.2523355},
+	{-20.518518, 17.402235, -6.43792},
+	{-17.33739, 14.397227, -5.742056},
+	{-14.607483, 11.809469, -5.14053},
+	{-12.243322, 9.559922, -4.6154227},
+	{-10.180089, 7.5886574, -4.153156},
+	{-8.367655, 5.849329, -3.7432384},
+	{-6.7665815, 4.305481, -3.3774254},
+	{-134.69684, 124.65613, -31.17343},
+	{-95.51586, 88.19049, -22.80864},
+	{-72.64083, 66.87763, -17.924316},
+	{-57.390892, 52.64925, -14.663666},
+	{-46.403927, 42.381042, -12.308991},
+	{-38.074, 34.580883, -10.518063},
+	{-31.526644, 28.43638, -9.104804},
+	{-26.240631, 23.463354, -7.9584527},
+	{-21.883924, 19.353409, -7.0085363},
+	{-18.23394, 15.899812, -6.2078657},
+	{-15.135291, 12.958266, -5.523533},
+	{-12.475885, 10.424683, -4.9318213},
+	{-10.172623, 8.221896, -4.4151654},
+	{-8.162457, 6.2913704, -3.9602504},
+	{-6.3966136, 4.58782, -3.5567837},
+	{-4.8367066, 3.0756094, -3.196672},
+	{-129.12294, 120.45987, -30.409542},
+	{-91.2884, 85.11087, -22.270895},
+	{-69.10905, 64.363846, -17.498186},
+	{-54.2908, 50.48172, -14.304513},
+	{-43.600803, 40.449226, -11.994748},
+	{-35.489037, 32.82083, -10.236154},
+	{-29.109364, 26.807524, -8.847356},
+	{-23.956553, 21.9382, -7.7201886},
+	{-19.708323, 17.912382, -6.7857375},
+	{-16.148403, 14.528436, -5.997805},
+	{-13.125703, 11.645497, -5.3241477},
+	{-10.531157, 9.161891, -4.741512},
+	{-8.2838545, 7.0021915, -4.232662},
+	{-6.322396, 5.109159, -3.784529},
+	{-4.5992575, 3.4384925, -3.387005},
+	{-3.0770297, 1.9553187, -3.0321388},
+	{-123.9827, 116.577354, -29.699982},
+	{-87.39414, 82.266304, -21.772486},
+	{-65.859985, 62.046307, -17.104181},
+	{-51.44233, 48.4868, -13.973161},
+	{-41.0279, 38.673866, -11.705382},
+	{-33.118504, 31.205381, -9.976994},
+	{-26.894314, 25.314129, -8.611022},
+	{-21.86494, 20.541212, -7.5017405},
+	{-17.717169, 16.59354, -6.5816927},
+	{-14.240603, 13.274232, -5.8056116},
+	{-11.288135, 10.445634, -5.1418757},
+	{-8.753508, 8.008318, -4.567664},
+	{-6.5578556, 5.888484, -4.066052},
+	{-4.641303, 4.030102, -3.6241996},
+	{-2.9574902, 2.3897874, -3.232172},
+	{-1.4699129, 0.933379, -2.8821516},
+	{-119.228004, 112.97427, -29.038853},
+	{-83.79665, 79.63142, -21.309174},
+	{-62.863026, 59.90402, -16.738865},
+	{-48.818436, 46.64615, -13.666655},
+	{-38.660618, 37.038437, -11.438262},
+	{-30.939627, 29.719341, -9.738185},
+	{-24.860126, 23.942043, -8.393591},
+	{-19.945566, 19.259068, -7.3010416},
+	{-15.891183, 15.384245, -6.394455},
+	{-12.492055, 12.125147, -5.629439},
+	{-9.604788, 9.347123, -4.974956},
+	{-7.1257463, 6.9528475, -4.408593},
+	{-4.977968, 4.870045, -3.913717},
+	{-3.1029992, 3.0438154, -3.477703},
+	{-1.4555643, 1.4316332, -3.0907786},
+	{-2.6640603e-06, 1.9246584e-06, -2.7452514},
+	{-172.67162, 142.86667, -30.827616},
+	{-126.76982, 103.028145, -22.28121},
+	{-101.69649, 81.27292, -17.623972},
+	{-85.51996, 67.23672, -14.62279},
+	{-74.07475, 57.302643, -12.499802},
+	{-65.48663, 49.84396, -10.905737},
+	{-58.774292, 44.009365, -9.658089},
+	{-53.368793, 39.305527, -8.651249},
+	{-48.915073, 35.42472, -7.8194284},
+	{-45.178814, 32.163963, -7.1192884},
+	{-41.998436, 29.383362, -6.520998},
+	{-39.25852, 26.983032, -6.0032816},
+	{-36.874157, 24.889544, -5.5505176},
+	{-34.78137, 23.047579, -5.15095},
+	{-32.930904, 21.414587, -4.7955456},
+	{-31.28418, 19.957247, -4.4772396},
+	{-159.16316, 133.09131, -29.113052},
+	{-116.7222, 95.947624, -21.081322},
+	{-93.34566, 75.491646, -16.66678},
+	{-78.20143, 62.237, -13.809349},
+	{-67.4613, 52.832348, -11.782611},
+	{-59.390724, 45.75984, -10.258113},
+	{-53.07736, 40.221478, -9.0634365},
+	{-47.990543, 35.75332, -8.098481},
+	{-43.798294, 32.06525, -7.3007355},
+	{-40.281094, 28.965544, -6.628941},
+	{-37.28736, 26.321854, -6.0546536},
+	{-34.708675, 24.039574, -5.5575624},
+	{-32.465202, 22.049076, -5.1227374},
+	{-30.496727, 20.297863, -4.738934},
+	{-28.756872, 18.745523, -4.397505},
+	{-27.20928, 17.360395, -4.0916815},
+	{-147.5692, 124.67554, -27.634163},
+	{-108.04108, 89.813675, -20.04047},
+	{-86.105255, 70.46731, -15.834189},
+	{-71.84114, 57.882797, -13.100568},
+	{-61.70346, 48.933056, -11.156889},
+	{-54.075623, 42.192764, -9.692488},
+	{-48.10379, 36.909412, -8.543597},
+	{-43.289833, 32.644188, -7.614853},
+	{-39.321434, 29.122082, -6.846568},
+	{-35.991734, 26.161022, -6.199277},
+	{-33.157684, 23.63516, -5.6457343},
+	{-30.716854, 21.454428, -5.1664615},
+	{-28.593761, 19.552475, -4.7471275},
+	{-26.731422, 17.879234, -4.3769298},
+	{-25.085918, 16.396143, -4.0475545},
+	{-23.622807, 15.072964, -3.752492},
+	{-137.51205, 117.35259, -26.344532},
+	{-100.4712, 84.45063, -19.129017},
+	{-79.7746, 66.06411, -15.1037445},
+	{-66.26999, 54.061092, -12.478034},
+	{-56.653057, 45.50671, -10.606846},
+	{-49.40824, 39.055363, -9.194928},
+	{-43.731956, 33.993874, -8.086031},
+	{-39.15412, 29.905207, -7.1889167},
+	{-35.379387, 26.527456, -6.446359},
+	{-32.211823, 23.686949, -5.8204584},
+	{-29.515766, 21.263485, -5.2850156},
+	{-27.193987, 19.170944, -4.821282},
+	{-25.174765, 17.345837, -4.415449},
+	{-23.403915, 15.740214, -4.057101},
+	{-21.839663, 14.317121, -3.7382164},
+	{-20.449217, 13.0475645, -3.452511},
+	{-128.70694, 110.92119, -25.209196},
+	{-93.816574, 79.723495, -18.324215},
+	{-74.19801, 62.176586, -14.458029},
+	{-61.355846, 50.683468, -11.927363},
+	{-52.193684, 42.476154, -10.120077},
+	{-45.283524, 36.278572, -8.7544365},
+	{-39.8655, 31.41194, -7.6808033},
+	{-35.493946, 27.478306, -6.811569},
+	{-31.888323, 24.227262, -6.091679},
+	{-28.862257, 21.49251, -5.484615},
+	{-26.286549, 19.158815, -4.965102},
+	{-24.068504, 17.143534, -4.5150347},
+	{-22.1397, 15.385688, -4.121066},
+	{-20.448414, 13.839194, -3.773123},
+	{-18.954737, 12.468515, -3.4634428},
+	{-17.627335, 11.245756, -3.1859396},
+	{-120.93506, 105.22661, -24.20131},
+	{-87.92434, 75.526886, -17.608332},
+	{-69.25293, 58.721626, -13.883334},
+	{-56.994038, 47.679794, -11.437156},
+	{-48.232655, 39.77989, -9.686705},
+	{-41.617508, 33.807114, -8.362223},
+	{-36.42712, 29.113071, -7.319942},
+	{-32.237328, 25.316706, -6.4754853},
+	{-28.780636, 22.177788, -5.775728},
+	{-25.879095, 19.536558, -5.1853833},
+	{-23.409206, 17.28219, -4.6799994},
+	{-21.28229, 15.335121, -4.242043},
+	{-19.432837, 13.636603, -3.8585804},
+	{-17.811293, 12.142214, -3.5198421},
+	{-16.379406, 10.817671, -3.2182965},
+	{-15.107128, 9.636057, -2.948035},
+	{-114.02561, 100.14798, -23.299923},
+	{-82.67348, 71.7773, -16.967329},
+	{-64.841606, 55.632862, -13.368725},
+	{-53.10062, 44.99375, -10.998271},
+	{-44.695324, 37.3683, -9.298769},
+	{-38.342323, 31.596235, -8.011176},
+	{-33.354164, 27.056238, -6.9969835},
+	{-29.325764, 23.382322, -6.1747093},
+	{-26.001236, 20.343365, -5.4929643},
+	{-23.210112, 17.785448, -4.917566},
+	{-20.833975, 15.601682, -4.424802},
+	{-18.787712, 13.715267, -3.9976532},
+	{-17.00841, 12.069453, -3.6235576},
+	{-15.448451, 10.621298, -3.293018},
+	{-14.071058, 9.337647, -2.9987102},
+	{-12.84733, 8.192456, -2.7348847},
+	{-107.84329, 95.58948, -22.488453},
+	{-77.96705, 68.40775, -16.389965},
+	{-60.885197, 52.85669, -12.905378},
+	{-49.60753, 42.579624, -10.603293},
+	{-41.52094, 35.200977, -8.949793},
+	{-35.402557, 29.609362, -7.6954927},
+	{-30.59533, 25.2078, -6.706633},
+	{-26.711252, 21.643862, -5.904352},
+	{-23.504822, 18.694614, -5.2388287},
+	{-20.812286, 16.211407, -4.676879},
+	{-18.519777, 14.090897, -4.1954594},
+	{-16.545385, 12.25877, -3.7780173},
+	{-14.828526, 10.660076, -3.412325},
+	{-13.323318, 9.253211, -3.0891335},
+	{-11.9943075, 8.006037, -2.8013048},
+	{-10.813623, 6.8932934, -2.5432348},
+	{-102.279564, 91.474174, -21.753593},
+	{-73.72649, 65.36392, -15.867121},
+	{-57.31938, 50.349346, -12.486096},
+	{-46.45903, 40.399918, -10.24615},
+	{-38.659584, 33.244644, -8.634449},
+	{-32.752552, 27.816301, -7.4103847},
+	{-28.10825, 23.53993, -6.444516},
+	{-24.354053, 20.07538, -5.6603656},
+	{-21.253826, 17.207142, -5.0095387},
+	{-18.649874, 14.791336, -4.459761},
+	{-16.432432, 12.7278385, -3.9886},
+	{-14.522488, 10.944593, -3.5799263},
+	{-12.861557, 9.38828, -3.2218168},
+	{-11.405328, 8.018508, -2.9052484},
+	{-10.119542, 6.8040605, -2.6232536},
+	{-8.97726, 5.720393, -2.3703587},
+	{-97.246376, 87.739624, -21.084555},
+	{-69.88747, 62.601284, -15.391335},
+	{-54.091236, 48.07476, -12.104955},
+	{-43.609066, 38.42362, -9.921819},
+	{-36.06989, 31.4717, -8.348322},
+	{-30.354374, 26.191938, -7.151874},
+	{-25.857641, 22.029425, -6.206989},
+	{-22.221012, 18.655197, -5.4393697},
+	{-19.216846, 15.860519, -4.8019304},
+	{-16.692947, 13.505862, -4.2632313},
+	{-14.543282, 11.494038, -3.8013961},
+	{-12.691463, 9.755048, -3.4006853},
+	{-11.080918, 8.237062, -3.049455},
+	{-9.668757, 6.900796, -2.7388878},
+	{-8.421813, 5.7158737, -2.4621727},
+	{-7.313987, 4.6584015, -2.213956},
+	{-92.67153, 84.33454, -20.472483},
+	{-66.396835, 60.082993, -14.956435},
+	{-51.156807, 46.00294, -11.757029},
+	{-41.019264, 36.624844, -9.626113},
+	{-33.717274, 29.859045, -8.087719},
+	{-28.176252, 24.715206, -6.916627},
+	{-23.8139, 20.656782, -5.9909897},
+	{-20.28426, 17.365068, -5.2385216},
+	{-17.367447, 14.637529, -4.61334},
+	{-14.916281, 12.338632, -4.084773},
+	{-12.828135, 10.373879, -3.6314592},
+	{-11.02902, 8.675162, -3.238016},
+	{-9.464109, 7.1920147, -2.8930566},
+	{-8.091815, 5.8861675, -2.5879543},
+	{-6.8799596, 4.728016, -2.3160408},
+	{-5.803219, 3.6942635, -2.0720735},
+	{-88.49529, 81.21648, -19.910051},
+	{-63.210297, 57.77831, -14.55728},
+	{-48.479324, 44.108772, -11.438197},
+	{-38.657413, 34.981842, -9.355517},
+	{-31.572659, 28.387215, -7.8495307},
+	{-26.191397, 23.368317, -6.70183},
+	{-21.952015, 19.405506, -5.7939334},
+	{-18.520216, 16.189526, -5.0554156},
+	{-15.683224, 13.523557, -4.441509},
+	{-13.298465, 11.275745, -3.922252},
+	{-11.266435, 9.354075, -3.476759},
+	{-9.515347, 7.6921787, -3.0899787},
+	{-7.991973, 6.240848, -2.7507625},
+	{-6.655921, 4.962748, -2.4506586},
+	{-5.475926, 3.8289857, -2.183132},
+	{-4.42737, 2.8168135, -1.9430417},
+	{-84.66781, 78.34997, -19.391148},
+	{-60.29066, 55.661407, -14.189547},
+	{-46.027775, 42.371044, -11.144983},
+	{-36.496277, 33.476196, -9.107058},
+	{-29.611391, 27.039692, -7.6311216},
+	{-24.377062, 22.136145, -6.505093},
+	{-20.250715, 18.261549, -5.6136184},
+	{-16.908794, 15.115381, -4.8880005},
+	{-14.145074, 12.506122, -4.2845087},
+	{-11.821221, 10.305318, -3.7738428},
+	{-9.8406105, 8.423252, -3.335559},
+	{-8.133486, 6.795167, -2.9549134},
+	{-6.648095, 5.37302, -2.6209788},
+	{-5.345147, 4.1203423, -2.3254666},
+	{-4.194216, 3.0088966, -2.0619645},
+	{-3.171337, 2.0164454, -1.8254268},
+	{-81.14718, 75.70516, -18.910627},
+	{-57.606476, 53.710407, -13.84958},
+	{-43.775856, 40.77174, -10.874434},
+	{-34.512688, 32.09222, -8.878201},
+	{-27.812456, 25.802378, -7.430243},
+	{-22.713823, 21.00576, -6.3243747},
+	{-18.691813, 17.212877, -5.4481606},
+	{-15.432797, 14.131325, -4.7345185},
+	{-12.736612, 11.574505, -4.1406856},
+	{-10.468853, 9.4171295, -3.6379783},
+	{-8.535558, 7.571617, -3.2063653},
+	{-6.868849, 5.974706, -2.8313906},
+	{-5.41834, 4.5794377, -2.5023327},
+	{-4.145759, 3.3501465, -2.2110548},
+	{-3.0214572, 2.259206, -1.9512596},
+	{-2.0220745, 1.2848513, -1.7179898},
+	{-77.89789, 73.256744, -18.46413},
+	{-55.130966, 51.906666, -13.534265},
+	{-41.701096, 39.29545, -10.624028},
+	{-32.686813, 30.816467, -8.666779},
+	{-26.157831, 24.663164, -7.2449646},
+	{-21.184996, 19.966038, -6.1579185},
+	{-17.259657, 16.249123, -5.2959385},
+	{-14.077406, 13.227596, -4.5934553},
+	{-11.443709, 10.719448, -4.008612},
+	{-9.227811, 8.6023445, -3.5133045},
+	{-7.338223, 6.7906957, -3.0878873},
+	{-5.7088146, 5.2226353, -2.7181733},
+	{-4.2904673, 3.8522155, -2.393634},
+	{-3.0458546, 2.64452, -2.1062753},
+	{-1.9460512, 1.5724925, -1.8499072},
+	{-0.9682574, 0.6148077, -1.6196547},
+	{-74.88969, 70.98312, -18.047937},
+	{-52.84121, 50.234207, -13.240932},
+	{-39.7842, 37.928925, -10.391596},
+	{-31.001583, 29.63735, -8.4709215},
+	{-24.631979, 23.6116, -7.0736213},
+	{-19.77617, 19.007355, -6.004207},
+	{-15.940712, 15.361311, -5.1555486},
+	{-12.82979, 12.395737, -4.463497},
+	{-10.254117, 9.932918, -3.8870502},
+	{-8.086335, 7.853285, -3.3986454},
+	{-6.2372675, 6.073115, -2.979002},
+	{-4.642408, 4.5318475, -2.6141849},
+	{-3.2538218, 3.1844797, -2.293847},
+	{-2.0350647, 1.996797, -2.0101283},
+	{-0.9578836, 0.9422752, -1.7569388},
+	{-1.7599256e-06, 1.2715252e-06, -1.5294815},
+	{-128.02385, 105.84051, -22.57612},
+	{-94.57422, 76.80523, -16.350653},
+	{-76.27589, 60.921135, -12.950631},
+	{-64.44368, 50.645977, -10.752776},
+	{-56.05014, 43.351604, -9.192471},
+	{-49.733788, 37.8566, -8.016311},
+	{-44.78179, 33.5427, -7.091879},
+	{-40.780758, 30.051512, -6.342525},
+	{-37.472557, 27.159357, -5.720473},
+	{-34.686733, 24.718607, -5.194229},
+	{-32.30565, 22.627436, -4.742097},
+	{-30.2452, 20.813068, -4.3485894},
+	{-28.443455, 19.221954, -4.0023227},
+	{-26.853687, 17.813707, -3.6947196},
+	{-25.439888, 16.557217, -3.4191823},
+	{-24.173803, 15.42809, -3.170542},
+	{-117.973816, 98.56596, -21.300426},
+	{-87.0712, 71.51646, -15.454682},
+	{-70.02066, 56.589336, -12.233741},
+	{-58.947403, 46.889866, -10.142014},
+	{-51.072124, 39.985504, -8.652806},
+	{-45.136044, 34.774963, -7.528054},
+	{-40.47704, 30.67921, -6.642773},
+	{-36.709835, 27.361477, -5.9243703},
+	{-33.59326, 24.611053, -5.3274865},
+	{-30.967754, 22.288591, -4.822162},
+	{-28.723024, 20.297823, -4.3877244},
+	{-26.780113, 18.569864, -4.009397},
+	{-25.080824, 17.053963, -3.6763072},
+	{-23.5812, 15.711811, -3.3802555},
+	{-22.247343, 14.513878, -3.1149282},
+	{-21.052637, 13.436987, -2.8753762},
+	{-109.35821, 92.312225, -20.201885},
+	{-80.59438, 66.94009, -14.678517},
+	{-64.60105, 52.828403, -11.610888},
+	{-54.173546, 43.621494, -9.610366},
+	{-46.740253, 37.05157, -8.182385},
+	{-41.12892, 32.085285, -7.101956},
+	{-36.720314, 28.176987, -6.25045},
+	{-33.153015, 25.008362, -5.5587583},
+	{-30.200289, 22.379787, -4.983598},
+	{-27.711838, 20.159014, -4.496328},
+	{-25.583643, 18.25454, -4.0771575},
+	{-23.741142, 16.600817, -3.711924},
+	{-22.12933, 15.149504, -3.390195},
+	{-20.706617, 13.864077, -3.1040962},
+	{-19.440918, 12.716361, -2.8475602},
+	{-18.307014, 11.684231, -2.6158273},
+	{-101.89066, 86.876495, -19.245174},
+	{-74.949524, 62.942074, -13.999526},
+	{-59.863857, 49.534378, -11.064876},
+	{-49.99265, 40.75409, -9.143694},
+	{-42.94079, 34.47432, -7.7690535},
+	{-37.60998, 29.720123, -6.727265},
+	{-33.417767, 25.974669, -5.905215},
+	{-30.023254, 22.935589, -5.236819},
+	{-27.212122, 20.41287, -4.680601},
+	{-24.842083, 18.280409, -4.20907},
+	{-22.814524, 16.450851, -3.8032038},
+	{-21.058685, 14.861541, -3.4493742},
+	{-19.522318, 13.466233, -3.1375334},
+	{-18.16589, 12.229959, -2.8600914},
+	{-16.958876, 11.12572, -2.6111956},
+	{-15.87727, 10.132305, -2.3862512},
+	{-95.35599, 82.106316, -18.40378},
+	{-69.98804, 59.41981, -13.400404},
+	{-55.69069, 46.62685, -10.582416},
+	{-46.303955, 38.220043, -8.730996},
+	{-39.584732, 32.194595, -7.4033003},
+	{-34.498714, 27.626408, -6.395533},
+	{-30.495373, 24.023787, -5.5994177},
+	{-27.251656, 21.098328, -4.95153},
+	{-24.564068, 18.668423, -4.411982},
+	{-22.297298, 16.613363, -3.9542954},
+	{-20.357458, 14.849429, -3.5601249},
+	{-18.677109, 13.316508, -3.216314},
+	{-17.206408, 11.970193, -2.9131541},
+	{-15.907621, 10.776876, -2.6433055},
+	{-14.75159, 9.710603, -2.4011047},
+	{-13.715371, 8.750958, -2.1821027},
+	{-89.58939, 77.88488, -17.657408},
+	{-65.594376, 56.293434, -12.8677},
+	{-51.988663, 44.04266, -10.153084},
+	{-43.027927, 35.965927, -8.363584},
+	{-36.60149, 30.165434, -7.077582},
+	{-31.73102, 25.761818, -6.1000323},
+	{-27.89399, 22.285568, -5.3269477},
+	{-24.783031, 19.460608, -4.6972647},
+	{-22.204166, 17.112762, -4.1725073},
+	{-20.02823, 15.1261015, -3.727097},
+	{-18.165497, 13.420119, -3.3432906},
+	{-16.55145, 11.936958, -3.008351},
+	{-15.138378, 10.63384, -2.7128713},
+	{-13.890129, 9.478367, -2.4497342},
+	{-12.77875, 8.445502, -2.2134447},
+	{-11.782241, 7.5155444, -1.999684},
+	{-84.46253, 74.12115, -16.990265},
+	{-61.67735, 53.499866, -12.390799},
+	{-48.68391, 41.731525, -9.76859},
+	{-40.100975, 33.94896, -8.034512},
+	{-33.934387, 28.34907, -6.785839},
+	{-29.255259, 24.092218, -5.83534},
+	{-25.565844, 20.728643, -5.082865},
+	{-22.57268, 17.993252, -4.469464},
+	{-20.090237, 15.718505, -3.9579272},
+	{-17.994808, 13.792734, -3.523482},
+	{-16.200365, 12.138305, -3.148926},
+	{-14.644997, 10.699373, -2.8218977},
+	{-13.282879, 9.434617, -2.5332603},
+	{-12.079271, 8.31272, -2.276097},
+	{-11.007296, 7.3094654, -2.0450633},
+	{-10.045782, 6.405787, -1.8359565},
+	{-79.87402, 70.74314, -16.38988},
+	{-58.16409, 50.988632, -11.961219},
+	{-45.717, 39.652943, -9.422262},
+	{-37.471687, 32.13452, -7.738161},
+	{-31.537434, 26.714817, -6.523153},
+	{-27.029388, 22.589783, -5.5970407},
+	{-23.471935, 19.327372, -4.8631363},
+	{-20.584028, 16.672356, -4.2643967},
+	{-18.187696, 14.463166, -3.764756},
+	{-16.16412, 12.591965, -3.34017},
+	{-14.430577, 10.983703, -2.9739237},
+	{-12.927494, 9.584345, -2.653996},
+	{-11.610739, 8.353881, -2.3714943},
+	{-10.446833, 7.261967, -2.1196814},
+	{-9.409861, 6.285125, -1.8933499},
+	{-8.479402, 5.4048595, -1.6884017},
+	{-75.74266, 67.693184, -15.846261},
+	{-54.99565, 48.718876, -11.5721},
+	{-43.03957, 37.77392, -9.10867},
+	{-35.09805, 30.49428, -7.4699407},
+	{-29.372921, 25.237486, -6.2854896},
+	{-25.018856, 21.231604, -5.381503},
+	{-21.580132, 18.060593, -4.6644363},
+	{-18.786879, 15.478155, -4.0789804},
+	{-16.467937, 13.32812, -3.5901089},
+	{-14.508888, 11.506122, -3.1744404},
+	{-12.829999, 9.93945, -2.8157039},
+	{-11.3737955, 8.575714, -2.5021868},
+	{-10.097673, 7.376086, -2.2252183},
+	{-8.9692955, 6.3111067, -1.9782261},
+	{-7.9636135, 5.357964, -1.7561257},
+	{-7.060881, 4.498683, -1.5549148},
+	{-72.00269, 64.92453, -15.351322},
+	{-52.123875, 46.65718, -11.217833},
+	{-40.611897, 36.06731, -8.823354},
+	{-32.94542, 29.004847, -7.2260623},
+	{-27.409664, 23.896217, -6.0695114},
+	{-23.195007, 19.99866, -5.1857147},
+	{-19.863726, 16.9107, -4.4840007},
+	{-17.156088, 14.394166, -3.910647},
+	{-14.907097, 12.297807, -3.4315784},
+	{-13.006331, 10.520417, -3.02402},
+	{-11.376764, 8.991422, -2.6721063},
+	{-9.9628315, 7.659922, -2.3644078},
+	{-8.723313, 6.488174, -2.0924566},
+	{-7.626908, 5.4475203, -1.8498303},
+	{-6.649353, 4.5157585, -1.6315575},
+	{-5.771516, 3.6753852, -1.433723},
+	{-68.6003, 62.39888, -14.898445},
+	{-49.509037, 44.77595, -10.893791},
+	{-38.401054, 34.51061, -8.562612},
+	{-30.98499, 27.646742, -7.003373},
+	{-25.62165, 22.673586, -5.872432},
+	{-21.533884, 18.875029, -5.0071554},
+	{-18.300354, 15.862923, -4.319512},
+	{-15.670554, 13.406538, -3.7572405},
+	{-13.485119, 11.359128, -3.2871401},
+	{-11.637266, 9.622385, -2.8869936},
+	{-10.05244, 8.1276865, -2.5413096},
+	{-8.676815, 6.825506, -2.238918},
+	{-7.47044, 5.679089, -1.9715381},
+	{-6.4029527, 4.6605134, -1.7328849},
+	{-5.4508123, 3.7481349, -1.5180911},
+	{-4.5954423, 2.9248815, -1.3233215},
+	{-65.49104, 60.08457, -14.482159},
+	{-47.11808, 43.052193, -10.596126},
+	{-36.379528, 33.08497, -8.323351},
+	{-29.192598, 26.403616, -6.799229},
+	{-23.987019, 21.554928, -5.69191},
+	{-20.01531, 17.84728, -4.8437014},
+	{-16.871136, 14.904779, -4.169014},
+	{-14.312442, 12.503546, -3.6169372},
+	{-12.185021, 10.500978, -3.1550782},
+	{-10.385427, 8.801438, -2.7617364},
+	{-8.8413725, 7.338103, -2.4217656},
+	{-7.5006266, 6.0627065, -2.124236},
+	{-6.3243966, 4.9394064, -1.8610393},
+	{-5.283186, 3.940957, -1.6260177},
+	{-4.3541126, 3.0462282, -1.4144},
+	{-3.5191116, 2.2385447, -1.2224247},
+	{-62.63786, 57.95519, -14.097904},
+	{-44.923283, 41.466614, -10.321605},
+	{-34.524155, 31.774508, -8.102967},
+	{-27.54785, 25.261644, -6.6113963},
+	{-22.487265, 20.527817, -5.52596},
+	{-18.622173, 16.90401, -4.6935496},
+	{-15.560031, 14.02565, -4.0308433},
+	{-13.066569, 11.675194, -3.4881835},
+	{-10.992327, 9.713877, -3.0339298},
+	{-9.236934, 8.048527, -2.6468606},
+	{-7.730185, 6.6139865, -2.3121507},
+	{-6.421324, 5.363156, -2.019093},
+	{-5.2726235, 4.2610354, -1.7597396},
+	{-4.255385, 3.281006, -1.5280505},
+	{-3.347332, 2.4024115, -1.3193438},
+	{-2.5308716, 1.608943, -1.129926},
+	{-60.00963, 55.988525, -13.741849},
+	{-42.90123, 40.00289, -10.067496},
+	{-32.81529, 30.56575, -7.8992486},
+	{-26.033403, 24.209057, -6.4379745},
+	{-21.10662, 19.581652, -5.3728914},
+	{-17.339859, 16.035463, -4.555161},
+	{-14.353322, 13.216437, -3.903576},
+	{-11.919932, 10.9129095, -3.369648},
+	{-9.894618, 8.989677, -2.9224384},
+	{-8.179853, 7.355863, -2.5411727},
+	{-6.7073655, 5.9478545, -2.2113247},
+	{-5.4277554, 4.719634, -1.9223951},
+	{-4.304285, 3.6369867, -1.6665851},
+	{-3.3089912, 2.6738725, -1.4379649},
+	{-2.420159, 1.8100761, -1.2319353},
+	{-1.6206309, 1.029629, -1.0448663},
+	{-57.580025, 54.165775, -13.41075},
+	{-41.032005, 38.647133, -9.831478},
+	{-31.236166, 29.447174, -7.710308},
+	{-24.634422, 23.235777, -6.277336},
+	{-19.831568, 18.707323, -5.231252},
+	{-16.155819, 15.233254, -4.4272113},
+	{-13.239203, 12.469302, -3.7859855},
+	{-10.86132, 10.20929, -3.260182},
+	{-8.881171, 8.321338, -2.8195188},
+	{-7.203874, 6.7167034, -2.4436402},
+	{-5.7629466, 5.3332167, -2.1182997},
+	{-4.510252, 4.125871, -1.8331926},
+	{-3.4099708, 3.0611792, -1.5806599},
+	{-2.4348223, 2.1136427, -1.3548741},
+	{-1.563612, 1.2634567, -1.1513137},
+	{-0.7795864, 0.49496874, -0.96640795},
+	{-55.326626, 52.4709, -13.101845},
+	{-39.29856, 37.38743, -9.611561},
+	{-29.772402, 28.40888, -7.534524},
+	{-23.338139, 22.333115, -6.1280804},
+	{-18.650444, 17.896976, -5.099789},
+	{-15.059207, 14.490123, -4.308555},
+	{-12.207457, 11.77745, -3.6770098},
+	{-9.881011, 9.55791, -3.1587887},
+	{-7.9426746, 7.7027316, -2.7242277},
+	{-6.300022, 6.1251717, -2.353364},
+	{-4.8882422, 4.76441, -2.0322142},
+	{-3.6603746, 3.5763836, -1.7506564},
+	{-2.5814538, 2.5282874, -1.5011622},
+	{-1.6248358, 1.5951289, -1.278001},
+	{-0.76981103, 0.7574855, -1.0767232},
+	{-1.4241919e-06, 1.0289297e-06, -0.89381415},
+	{-107.22225, 88.53361, -18.5858},
+	{-79.89918, 64.81349, -13.50492},
+	{-64.939255, 51.819077, -10.7241535},
+	{-55.24272, 43.3881, -8.919765},
+	{-48.342976, 37.38034, -7.6327987},
+	{-43.132313, 32.835026, -6.6575375},
+	{-39.030983, 29.249533, -5.886514},
+	{-35.70269, 26.332426, -5.2575},
+	{-32.937283, 23.901684, -4.7316766},
+	{-30.595808, 21.837011, -4.2834215},
+	{-28.582224, 20.055304, -3.895054},
+	{-26.827702, 18.49703, -3.553924},
+	{-25.281416, 17.118261, -3.250706},
+	{-23.904871, 15.885736, -2.9783478},
+	{-22.668257, 14.773716, -2.7313974},
+	{-21.548037, 13.761889, -2.5055573},
+	{-98.748474, 82.39613, -17.509598},
+	{-73.54351, 60.330643, -12.745706},
+	{-59.618504, 48.131927, -10.114258},
+	{-50.550537, 40.179203, -8.398359},
+	{-44.079487, 34.49522, -7.170687},
+	{-39.182922, 30.185852, -6.238298},
+	{-35.323154, 26.781073, -5.4999113},
+	{-32.187195, 24.007406, -4.8966765},
+	{-29.578953, 21.693596, -4.3917856},
+	{-27.36851, 19.726227, -3.9608936},
+	{-25.465902, 18.026808, -3.5871701},
+	{-23.80657, 16.539036, -3.2585573},
+	{-22.342777, 15.221302, -2.966154},
+	{-21.038322, 14.0420685, -2.7032206},
+	{-19.865135, 12.976895, -2.4645402},
+	{-18.801025, 12.0064745, -2.2459962},
+	{-91.50101, 77.13451, -16.585697},
+	{-68.0673, 56.46059, -12.089724},
+	{-55.015797, 44.937172, -9.585583},
+	{-46.480747, 37.392105, -7.9454374},
+	{-40.37408, 31.984781, -6.768638},
+	{-35.74491, 27.877323, -5.873082},
+	{-32.090992, 24.627337, -5.1627584},
+	{-29.119032, 21.97661, -4.581701},
+	{-26.64485, 19.76305, -4.0948215},
+	{-24.546185, 17.87911, -3.678869},
+	{-22.738247, 16.250244, -3.3177438},
+	{-21.160105, 14.822902, -2.9998953},
+	{-19.766647, 13.557463, -2.7167866},
+	{-18.523626, 12.423861, -2.4619462},
+	{-17.404457, 11.39877, -2.2303598},
+	{-16.388102, 10.46374, -2.0180652},
+	{-85.22981, 72.57089, -15.7830715},
+	{-63.30019, 53.085262, -11.517008},
+	{-50.99617, 42.142822, -9.122892},
+	{-42.9188, 34.949673, -7.548424},
+	{-37.125698, 29.781631, -6.415803},
+	{-32.726906, 25.848995, -5.552262},
+	{-29.250446, 22.733118, -4.866341},
+	{-26.419924, 20.188932, -4.3045716},
+	{-24.061375, 18.06226, -3.8333576},
+	{-22.05912, 16.250624, -3.4303958},
+	{-20.332823, 14.682883, -3.080221},
+	{-18.824669, 13.307878, -2.7717235},
+	{-17.49182, 12.087705, -2.4966831},
+	{-16.301706, 10.993572, -2.2488613},
+	{-15.229028, 10.00312, -2.0234191},
+	{-14.253733, 9.098641, -1.8165293},
+	{-79.74813, 68.572464, -15.0785675},
+	{-59.112682, 50.114845, -11.012388},
+	{-47.455997, 39.678173, -8.714493},
+	{-39.776154, 32.79223, -7.197599},
+	{-34.25582, 27.833357, -6.103751},
+	{-30.057596, 24.053652, -5.268323},
+	{-26.735708, 21.05513, -4.6038346},
+	{-24.028399, 18.604187, -4.0590053},
+	{-21.770578, 16.553549, -3.6015456},
+	{-19.852295, 14.805169, -3.2099855},
+	{-18.197079, 13.290888, -2.8694167},
+	{-16.749844, 11.961629, -2.5691166},
+	{-15.469715, 10.780996, -2.3011425},
+	{-14.325598, 9.721314, -2.059461},
+	{-13.293304, 8.7610655, -1.8393875},
+	{-12.353645, 7.883192, -1.6372119},
+	{-74.91371, 65.03796, -14.454564},
+	{-55.404606, 47.47982, -10.5641365},
+	{-44.31443, 37.488014, -8.35126},
+	{-36.983307, 30.872877, -6.8853345},
+	{-31.702534, 26.098574, -5.825836},
+	{-27.680561, 22.453876, -5.0153193},
+	{-24.494535, 19.558971, -4.3698215},
+	{-21.895523, 17.190346, -3.8399978},
+	{-19.726227, 15.206818, -3.394718},
+	{-17.881718, 13.514248, -3.013249},
+	{-16.288929, 12.047111, -2.6811764},
+	{-14.895166, 10.75817, -2.3881207},
+	{-13.661291, 9.612361, -2.1263855},
+	{-12.557498, 8.582992, -1.890119},
+	{-11.56058, 7.649291, -1.6747727},
+	{-10.65211, 6.794776, -1.4767416},
+	{-70.61627, 61.888836, -13.897407},
+	{-52.097286, 45.125526, -10.163042},
+	{-41.50748, 35.528606, -8.025975},
+	{-34.484974, 29.154263, -6.6055603},
+	{-29.416388, 24.544191, -5.5767465},
+	{-25.550583, 21.019651, -4.788483},
+	{-22.484943, 18.216955, -4.1599455},
+	{-19.98188, 15.921569, -3.6435175},
+	{-17.890991, 13.997727, -3.209105},
+	{-16.111803, 12.354773, -2.836634},
+	{-14.574263, 10.929522, -2.5121315},
+	{-13.227797, 9.676379, -2.2255247},
+	{-12.034806, 8.561463, -1.9693377},
+	{-10.966632, 7.5589614, -1.7378806},
+	{-10.0009365, 6.6487656, -1.526727},
+	{-9.119968, 5.8149047, -1.3323646},
+	{-66.76899, 59.06324, -13.396352},
+	{-49.128086, 43.00841, -9.801772},
+	{-38.98387, 33.76485, -7.73285},
+	{-32.236618, 27.606274, -6.353387},
+	{-27.357407, 23.143427, -5.352189},
+	{-23.630999, 19.7266, -4.583948},
+	{-20.672804, 17.006548, -3.970664},
+	{-18.255354, 14.776778, -3.4662783},
+	{-16.234402, 12.906387, -3.0416281},
+	{-14.51345, 11.307837, -2.6772344},
+	{-13.025139, 9.920049, -2.3595216},
+	{-11.720792, 8.698899, -2.0786936},
+	{-10.564186, 7.611572, -1.8274726},
+	{-9.527685, 6.633038, -1.6003145},
+	{-8.58973, 5.7437873, -1.3929027},
+	{-7.7331734, 4.9283075, -1.201809},
+	{-63.302593, 56.511772, -12.942831},
+	{-46.446526, 41.09334, -9.474418},
+	{-36.702023, 32.168266, -7.4671917},
+	{-30.201996, 26.204363, -6.124832},
+	{-25.492937, 21.874376, -5.148654},
+	{-21.891775, 18.554735, -4.398546},
+	{-19.030094, 15.909222, -3.7990685},
+	{-16.689518, 13.738605, -3.3055744},
+	{-14.731334, 11.916369, -2.8897476},
+	{-13.062618, 10.3578, -2.5326483},
+	{-11.618441, 9.003717, -2.2210615},
+	{-10.351828, 7.811331, -1.9454421},
+	{-9.227794, 6.748781, -1.6986918},
+	{-8.219624, 5.791753, -1.4753989},
+	{-7.306461, 4.921273, -1.271346},
+	{-6.471698, 4.122244, -1.0831808},
+	{-60.161156, 54.19452, -12.529926},
+	{-44.011467, 39.351665, -9.176166},
+	{-34.627872, 30.71549, -7.2251554},
+	{-28.351284, 24.928316, -5.9166207},
+	{-23.796036, 20.71894, -4.963245},
+	{-20.308064, 17.487495, -4.2296543},
+	{-17.533575, 14.90959, -3.6427438},
+	{-15.262412, 12.792592, -3.1591563},
+	{-13.360869, 11.013979, -2.751348},
+	{-11.7392645, 9.491598, -2.4008718},
+	{-10.33486, 8.168001, -2.0948417},
+	{-9.102226, 7.001605, -1.8239412},
+	{-8.007502, 5.961424, -1.5812366},
+	{-7.024802, 5.0237885, -1.3614364},
+	{-6.1339064, 4.170214, -1.1604135},
+	{-5.318698, 3.3859804, -0.9748852},
+	{-57.299007, 52.078873, -12.15199},
+	{-41.789066, 37.75978, -8.90305},
+	{-32.733242, 29.387186, -7.003559},
+	{-26.659729, 23.761333, -5.7260313},
+	{-22.244263, 19.662031, -4.793549},
+	{-18.859121, 16.511036, -4.075083},
+	{-16.1638, 13.9947605, -3.4996696},
+	{-13.955621, 11.926604, -3.0251372},
+	{-12.105437, 10.187695, -2.6246512},
+	{-10.526515, 8.698224, -2.2802167},
+	{-9.158117, 7.402324, -1.9792504},
+	{-7.956221, 6.2595177, -1.7126455},
+	{-6.887983, 5.239618, -1.4736184},
+	{-5.928279, 4.319544, -1.2569882},
+	{-5.057464, 3.4812589, -1.0587108},
+	{-4.25987, 2.710385, -0.87556696},
+	{-54.678505, 50.13792, -11.804365},
+	{-39.751198, 36.298058, -8.651781},
+	{-30.994621, 28.167189, -6.7997475},
+	{-25.106594, 22.689325, -5.5507846},
+	{-20.818773, 18.69096, -4.637539},
+	{-17.52748, 15.613685, -3.932985},
+	{-14.904353, 13.153837, -3.3681386},
+	{-12.753567, 11.130361, -2.9019194},
+	{-10.950143, 9.427735, -2.5081491},
+	{-9.410046, 7.9683127, -2.1692495},
+	{-8.0743685, 6.6976743, -1.8729165},
+	{-6.9003754, 5.5763597, -1.6102369},
+	{-5.8561554, 4.5749135, -1.3745655},
+	{-4.9172797, 3.6707983, -1.1608232},
+	{-4.06463, 2.846385, -0.9650425},
+	{-3.282948, 2.0876117, -0.78406197},
+	{-52.268333, 48.349266, -11.483178},
+	{-37.874313, 34.95005, -8.419599},
+	{-29.392225, 27.04189, -6.6114845},
+	{-23.674376, 21.70038, -5.388954},
+	{-19.503603, 17.794962, -4.493496},
+	{-16.2983, 14.785515, -3.8017936},
+	{-13.741265, 12.377532, -3.2466967},
+	{-11.642963, 10.395077, -2.7881396},
+	{-9.882253, 8.725729, -2.4005508},
+	{-8.377583, 7.293831, -2.0667403},
+	{-7.071727, 6.0463047, -1.7746613},
+	{-5.9231324, 4.944627, -1.5155805},
+	{-4.900747, 3.9600184, -1.2829809},
+	{-3.9807775, 3.07044, -1.0718775},
+	{-3.144591, 2.2586405, -0.87837297},
+	{-2.3773038, 1.5108484, -0.6993602},
+	{-50.04221, 46.6941, -11.185179},
+	{-36.138523, 33.701843, -8.204173},
+	{-27.909273, 25.999727, -6.436873},
+	{-22.348166, 20.784351, -5.2388997},
+	{-18.285126, 16.964842, -4.359952},
+	{-15.158884, 14.018017, -3.6801646},
+	{-12.662542, 11.657859, -3.1340952},
+	{-10.612374, 9.713182, -2.6826234},
+	{-8.890785, 8.074441, -2.3007433},
+	{-7.4185157, 6.6678214, -1.9716249},
+	{-6.1398935, 5.4414907, -1.6834629},
+	{-5.014458, 4.357792, -1.4276901},
+	{-4.0119467, 3.388573, -1.197909},
+	{-3.1091545, 2.5122554, -0.9892216},
+	{-2.287894, 1.7119367, -0.7977961},
+	{-1.5336268, 0.9741136, -0.62057525},
+	{-47.97793, 45.1565, -10.907618},
+	{-34.526905, 32.5416, -8.003521},
+	{-26.531424, 25.030813, -6.2742915},
+	{-21.115185, 19.932522, -5.0992146},
+	{-17.151613, 16.192673, -4.235644},
+	{-14.0982685, 13.303835, -3.566938},
+	{-11.657801, 10.9879, -3.0292528},
+	{-9.651875, 9.078099, -2.5843506},
+	{-7.9661803, 7.4675655, -2.2077556},
+	{-6.5235863, 6.0842037, -1.8829744},
+	{-5.269859, 4.877338, -1.5984262},
+	{-4.1655493, 3.8101184, -1.3456997},
+	{-3.1811268, 2.8549733, -1.118508},
+	{-2.2939293, 1.990753, -0.9120348},
+	{-1.4861814, 1.2008777, -0.722509},
+	{-0.74366236, 0.4720922, -0.54691976},
+	{-46.05656, 43.722908, -10.648152},
+	{-33.024956, 31.459148, -7.8159423},
+	{-25.246326, 24.126629, -6.122343},
+	{-19.964386, 19.13735, -4.9686813},
+	{-16.092894, 15.47157, -4.119474},
+	{-13.106913, 12.636561, -3.4611032},
+	{-10.7179785, 10.361601, -2.9312239},
+	{-8.752771, 8.484054, -2.4924276},
+	{-7.100041, 6.899553, -2.120735},
+	{-5.684634, 5.537609, -1.7999688},
+	{-4.453657, 4.3486266, -1.518759},
+	{-3.3686006, 3.2965088, -1.2688398},
+	{-2.400613, 2.3542244, -1.0440282},
+	{-1.5275359, 1.5010223, -0.83958375},
+	{-0.7319741, 0.7206228, -0.65179205},
+	{-1.3752957e-06, 9.852218e-07, -0.4776855},
+	{-99.28694, 81.83977, -16.854424},
+	{-74.76704, 60.554356, -12.302771},
+	{-61.36365, 48.903496, -9.810964},
+	{-52.666626, 41.329052, -8.1887665},
+	{-46.463985, 35.9135, -7.0262},
+	{-41.76546, 31.798689, -6.14004},
+	{-38.05338, 28.536177, -5.4346695},
+	{-35.02739, 25.865892, -4.8547015},
+	{-32.499542, 23.625166, -4.365533},
+	{-30.345226, 21.706188, -3.9442546},
+	{-28.47792, 20.03413, -3.5749838},
+	{-26.835188, 18.555017, -3.2462742},
+	{-25.370445, 17.2286, -2.949597},
+	{-24.04788, 16.023928, -2.6784008},
+	{-22.839148, 14.916523, -2.4275064},
+	{-21.721165, 13.88645, -2.1926992},
+	{-91.341095, 76.07672, -15.843585},
+	{-68.78145, 56.32683, -11.586827},
+	{-56.329346, 45.410015, -9.233308},
+	{-48.2077, 38.275337, -7.6929197},
+	{-42.396023, 33.156704, -6.5851083},
+	{-37.9828, 29.25762, -5.7384877},
+	{-34.48909, 26.159744, -5.0631623},
+	{-31.635988, 23.619556, -4.506866},
+	{-29.248459, 21.484295, -4.03685},
+	{-27.210175, 19.652475, -3.6313832},
+	{-25.44018, 18.053505, -3.2753634},
+	{-23.879938, 16.636353, -2.9578862},
+	{-22.485672, 15.362876, -2.6708074},
+	{-21.223635, 14.203675, -2.4078555},
+	{-20.06704, 13.13542, -2.1640577},
+	{-18.99398, 12.139038, -1.935351},
+	{-84.57537, 71.16139, -14.980642},
+	{-63.643192, 52.693226, -10.971216},
+	{-51.988804, 42.395145, -8.734778},
+	{-44.35204, 35.63293, -7.2639685},
+	{-38.870815, 30.76649, -6.202847},
+	{-34.699215, 27.050983, -5.389999},
+	{-31.390709, 24.093391, -4.740365},
+	{-28.68439, 21.664162, -4.2043247},
+	{-26.416088, 19.61891, -3.7507057},
+	{-24.476452, 17.86151, -3.3587756},
+	{-22.789234, 16.324953, -3.014099},
+	{-21.299181, 14.960709, -2.7062304},
+	{-19.964891, 13.732423, -2.4273539},
+	{-18.754364, 12.612006, -2.1714354},
+	{-17.64212, 11.577102, -1.9336762},
+	{-16.607256, 10.609368, -1.7101426},
+	{-78.740555, 66.9154, -14.234374},
+	{-59.18185, 49.53463, -10.435783},
+	{-48.206295, 39.765728, -8.299922},
+	{-40.98381, 33.32329, -6.889094},
+	{-35.785595, 28.673851, -5.8683023},
+	{-31.82124, 25.116526, -5.0846605},
+	{-28.671759, 22.279928, -4.457261},
+	{-26.091576, 19.946459, -3.938759},
+	{-23.92579, 17.978922, -3.4993382},
+	{-22.071016, 16.285786, -3.1191282},
+	{-20.455023, 14.803128, -2.7842677},
+	{-19.025375, 13.484563, -2.4847085},
+	{-17.742699, 12.295271, -2.2129157},
+	{-16.576488, 11.208289, -1.9630598},
+	{-15.502392, 10.202096, -1.7304919},
+	{-14.500356, 9.258974, -1.5113882},
+	{-73.65244, 63.206863, -13.581727},
+	{-55.26919, 46.761524, -9.965369},
+	{-44.878666, 37.45092, -7.9169984},
+	{-38.014404, 31.286293, -6.5584917},
+	{-33.061356, 26.825687, -5.572931},
+	{-29.276749, 23.40616, -4.8148236},
+	{-26.265305, 20.675032, -4.206871},
+	{-23.794668, 18.42507, -3.7037103},
+	{-21.717953, 16.525318, -3.2767086},
+	{-19.936926, 14.888237, -2.9067466},
+	{-18.38284, 13.452583, -2.5804641},
+	{-17.005695, 12.173836, -2.2881587},
+	{-15.767874, 11.018514, -2.022541},
+	{-14.640169, 9.960628, -1.7779592},
+	{-13.599197, 8.979381, -1.5498942},
+	{-12.625647, 8.057599, -1.3346177},
+	{-69.17187, 59.93614, -13.005302},
+	{-51.80685, 44.305202, -9.548357},
+	{-41.926136, 35.39588, -7.576928},
+	{-35.374878, 29.475103, -6.2645364},
+	{-30.636358, 25.180464, -5.310058},
+	{-27.009178, 21.882133, -4.5744886},
+	{-24.118694, 19.243805, -3.9837043},
+	{-21.744087, 17.067333, -3.4940865},
+	{-19.745468, 15.227237, -3.0780444},
+	{-18.02911, 13.63948, -2.717122},
+	{-16.529308, 12.2451725, -2.3984},
+	{-15.198197, 11.001433, -2.1124806},
+	{-13.999696, 9.875951, -1.8522909},
+	{-12.905728, 8.843593, -1.6123345},
+	{-11.893764, 7.8841977, -1.3882067},
+	{-10.945137, 6.9810677, -1.1762627},
+	{-65.19173, 57.02648, -12.491719},
+	{-48.718124, 42.11204, -9.175688},
+	{-39.286003, 33.557476, -7.27258},
+	{-33.01077, 27.852697, -6.001204},
+	{-28.46164, 23.705206, -5.074389},
+	{-24.97352, 20.514376, -4.358883},
+	{-22.189919, 17.958372, -3.78338},
+	{-19.900183, 15.847096, -3.3058133},
+	{-17.970585, 14.059914, -2.8995202},
+	{-16.311378, 12.515895, -2.546633},
+	{-14.859547, 11.158236, -2.2346256},
+	{-13.569103, 9.945503, -1.9543706},
+	{-12.405323, 8.846424, -1.6989865},
+	{-11.341132, 7.8366256, -1.463116},
+	{-10.354749, 6.896506, -1.2424549},
+	{-9.428081, 6.0097885, -1.0334322},
+	{-61.62821, 54.417824, -12.030516},
+	{-45.942284, 40.139572, -8.840195},
+	{-36.908295, 31.901299, -6.998271},
+	{-30.878456, 26.389393, -5.7636704},
+	{-26.497831, 22.373373, -4.8616676},
+	{-23.133476, 19.278606, -4.1641555},
+	{-20.445011, 16.796167, -3.6023545},
+	{-18.23081, 14.743137, -3.135589},
+	{-16.362638, 13.003213, -2.7380276},
+	{-14.754284, 11.498239, -2.392332},
+	{-13.345119, 10.173273, -2.0863276},
+	{-12.090834, 8.98818, -1.8111295},
+	{-10.957907, 7.912611, -1.5600277},
+	{-9.920155, 6.9228673, -1.3277886},
+	{-8.956463, 5.9998493, -1.1101978},
+	{-8.04925, 5.1276555, -0.9037506},
+	{-58.414787, 52.062458, -11.613393},
+	{-43.430614, 38.353687, -8.53613},
+	{-34.752655, 30.399542, -6.7494116},
+	{-28.942553, 25.06109, -5.5480223},
+	{-24.712877, 21.163336, -4.668428},
+	{-21.459396, 18.154974, -3.9871616},
+	{-18.856144, 15.738687, -3.4377253},
+	{-16.709587, 13.738011, -2.980701},
+	{-14.896401, 12.040543, -2.5910087},
+	{-13.33355, 10.570623, -2.251788},
+	{-11.962545, 9.274989, -1.9511814},
+	{-10.740576, 8.114665, -1.6805233},
+	{-9.635201, 7.0601354, -1.433258},
+	{-8.621034, 6.088307, -1.2042629},
+	{-7.677557, 5.180529, -0.9894046},
+	{-6.7876453, 4.3212376, -0.7852378},
+	{-55.497955, 49.922005, -11.233679},
+	{-41.143547, 36.726673, -8.258827},
+	{-32.786118, 29.029467, -6.5222535},
+	{-27.17401, 23.847982, -5.351047},
+	{-23.080359, 20.057247, -4.491813},
+	{-19.926765, 17.127047, -3.8253005},
+	{-17.400251, 14.770568, -3.2870855},
+	{-15.314575, 12.817197, -2.8388944},
+	{-13.550852, 11.158061, -2.456331},
+	{-12.028902, 9.719767, -2.1229694},
+	{-10.692172, 8.4505625, -1.8272406},
+	{-9.4992, 7.312529, -1.5606773},
+	{-8.418519, 6.2769003, -1.316864},
+	{-7.425456, 5.3211308, -1.0907785},
+	{-6.500032, 4.4269733, -0.8783604},
+	{-5.6255345, 3.5791693, -0.6762182},
+	{-52.834156, 47.96519, -10.885946},
+	{-39.048576, 35.235744, -8.004452},
+	{-30.981411, 27.772253, -6.313698},
+	{-25.548689, 22.7336, -5.170076},
+	{-21.578243, 19.04021, -4.329441},
+	{-18.515066, 16.181057, -3.6763952},
+	{-16.057957, 13.878898, -3.1484137},
+	{-14.027302, 11.968452, -2.7082696},
+	{-12.308234, 10.344066, -2.3321936},
+	{-10.823176, 8.934403, -2.004156},
+	{-9.517322, 7.689098, -1.7128522},
+	{-8.350435, 6.5711837, -1.4499958},
+	{-7.2919273, 5.5525765, -1.2092984},
+	{-6.3177724, 4.611227, -0.9858291},
+	{-5.4084754, 3.7292538, -0.7755942},
+	{-4.5476966, 2.891674, -0.5752507},
+	{-50.387543, 46.16628, -10.565729},
+	{-37.118687, 33.861958, -7.76982},
+	{-29.315748, 26.612162, -6.1211543},
+	{-24.046314, 21.704094, -5.002867},
+	{-20.187931, 18.099636, -4.1793013},
+	{-17.206907, 15.305325, -3.538601},
+	{-14.812787, 13.052681, -3.0199902},
+	{-12.831999, 11.181315, -2.5872054},
+	{-11.153351, 9.58852, -2.2170534},
+	{-9.701632, 8.204849, -1.89387},
+	{-8.423631, 6.9811945, -1.6065919},
+	{-7.2802258, 5.881466, -1.347099},
+	{-6.2416267, 4.8781996, -1.1092191},
+	{-5.284391, 3.949795, -0.88810474},
+	{-4.3894625, 3.0787024, -0.6798222},
+	{-3.5408359, 2.2501898, -0.4810731},
+	{-48.128284, 44.503834, -10.269315},
+	{-35.3312, 32.589394, -7.552261},
+	{-27.769854, 25.535864, -5.9424334},
+	{-22.649635, 20.747673, -4.8475146},
+	{-18.893524, 17.224754, -4.0396743},
+	{-15.987355, 14.489805, -3.4103336},
+	{-13.650529, 12.282421, -2.9003317},
+	{-11.715013, 10.446713, -2.4742975},
+	{-10.072986, 8.882689, -2.1095693},
+	{-8.651403, 7.522637, -1.7908207},
+	{-7.398518, 6.3186073, -1.5072113},
+	{-6.27622, 5.2353106, -1.2507732},
+	{-5.2554455, 4.245848, -1.0154413},
+	{-4.313282, 3.3290286, -0.79644364},
+	{-3.4310687, 2.4676034, -0.5899017},
+	{-2.5931003, 1.6470648, -0.39255726},
+	{-46.031307, 42.959835, -9.993583},
+	{-33.66686, 31.404526, -7.3495064},
+	{-26.327238, 24.531958, -5.775663},
+	{-21.343798, 19.854176, -4.7023773},
+	{-17.68125, 16.406225, -3.909073},
+	{-14.843401, 13.725741, -3.2902133},
+	{-12.558734, 11.559792, -2.7881398},
+	{-10.664323, 9.756651, -2.3683093},
+	{-9.05545, 8.218835, -2.0085547},
+	{-7.6610646, 6.880234, -1.6938612},
+	{-6.4307566, 5.6939654, -1.4135956},
+	{-5.3273463, 4.625474, -1.1599299},
+	{-4.32243, 3.6483781, -0.9268969},
+	{-3.3935754, 2.741858, -0.70979434},
+	{-2.5224738, 1.8889356, -0.5047931},
+	{-1.6936882, 1.0753052, -0.3086716},
+	{-44.075325, 41.518974, -9.7358885},
+	{-32.109123, 30.295742, -7.1596107},
+	{-24.97362, 23.590557, -5.6192226},
+	{-20.115835, 19.014725, -4.566023},
+	{-16.538992, 15.635826, -3.7861886},
+	{-13.763522, 13.005372, -3.1770191},
+	{-11.526309, 10.877366, -2.6822577},
+	{-9.669154, 9.1039505, -2.2681332},
+	{-8.090205, 7.589972, -1.9129393},
+	{-6.7202516, 6.270801, -1.601952},
+	{-5.510115, 5.100541, -1.3247285},
+	{-4.4234657, 4.045311, -1.073571},
+	{-3.4325008, 3.0792022, -0.84260213},
+	{-2.5152183, 2.1817305, -0.62718254},
+	{-1.653626, 1.336161, -0.42352837},
+	{-0.832521, 0.52836704, -0.22845057},
+	{-42.242058, 40.168106, -9.49396},
+	{-30.643635, 29.252943, -6.9808855},
+	{-23.696459, 22.702974, -5.47169},
+	{-18.95425, 18.221441, -4.437184},
+	{-15.455918, 14.906199, -3.6698523},
+	{-12.737329, 12.321689, -3.0696504},
+	{-10.543173, 10.228384, -2.581634},
+	{-8.71964, 8.482035, -2.1727552},
+	{-7.167536, 6.9896526, -1.8217378},
+	{-5.8193545, 5.687986, -1.5141283},
+	{-4.6270494, 4.5320487, -1.2396619},
+	{-3.55507, 3.4885793, -0.9907603},
+	{-2.5761578, 2.5321004, -0.7616287},
+	{-1.6686984, 1.6424316, -0.547685},
+	{-0.814979, 0.8030548, -0.34518597},
+	{-1.6060721e-06, 1.1627377e-06, -0.15097181},
+	{-100.364655, 82.544075, -16.65415},
+	{-76.39734, 61.749496, -12.220196},
+	{-63.39062, 50.43667, -9.8035965},
+	{-54.97322, 43.09121, -8.22929},
+	{-48.97482, 37.83488, -7.09756},
+	{-44.42981, 33.83224, -6.230824},
+	{-40.834766, 30.64784, -5.536625},
+	{-37.897625, 28.02912, -4.9613857},
+	{-35.43513, 25.817623, -4.471521},
+	{-33.324997, 23.907633, -4.0446334},
+	{-31.481306, 22.22484, -3.6649854},
+	{-29.84078, 20.714493, -3.320979},
+	{-28.3546, 19.33431, -3.003652},
+	{-26.98317, 18.049946, -2.7057133},
+	{-25.692476, 16.831827, -2.4208722},
+	{-24.451273, 15.652704, -2.1433144},
+	{-92.14804, 76.56849, -15.604908},
+	{-70.19667, 57.35862, -11.4761305},
+	{-58.15401, 46.79325, -9.201038},
+	{-50.314182, 39.892006, -7.709974},
+	{-44.704823, 34.933502, -6.6337223},
+	{-40.441044, 31.145647, -5.8068776},
+	{-37.058918, 28.12376, -5.142842},
+	{-34.288162, 25.632133, -4.5912223},
+	{-31.958597, 23.522379, -4.120321},
+	{-29.956284, 21.695152, -3.7089298},
+	{-28.200876, 20.08039, -3.342095},
+	{-26.63295, 18.626247, -3.008751},
+	{-25.206404, 17.292461, -2.7002995},
+	{-23.883566, 16.046087, -2.4096966},
+	{-22.631765, 14.858492, -2.1308074},
+	{-21.420624, 13.702978, -1.8578955},
+	{-85.209694, 71.51907, -14.718048},
+	{-64.91143, 53.615078, -10.841894},
+	{-53.66843, 43.67261, -8.685223},
+	{-46.3105, 37.143658, -7.2641983},
+	{-41.026974, 32.43567, -6.234782},
+	{-36.9994, 28.828959, -5.441694},
+	{-33.796524, 25.94442, -4.8032265},
+	{-31.166143, 23.560404, -4.271656},
+	{-28.948944, 21.536919, -3.8168662},
+	{-27.037949, 19.779966, -3.4186468},
+	{-25.357458, 18.223028, -3.062702},
+	{-23.851261, 16.816683, -2.7384105},
+	{-22.475517, 15.522354, -2.4374776},
+	{-21.194149, 14.308256, -2.1530616},
+	{-19.975592, 13.146516, -1.879151},
+	{-18.790178, 12.01087, -1.6100665},
+	{-79.264435, 67.18979, -13.957338},
+	{-60.34668, 50.38156, -10.294122},
+	{-49.777874, 40.96663, -8.238163},
+	{-42.82821, 34.754444, -6.876962},
+	{-37.821587, 30.260227, -5.8876557},
+	{-33.995216, 26.80842, -5.1235275},
+	{-30.945318, 24.04153, -4.5070252},
+	{-28.434942, 21.749817, -3.9926934},
+	{-26.313967, 19.80042, -3.5517645},
+	{-24.481293, 18.103878, -3.1648786},
+	{-22.86516, 16.596678, -2.8182993},
+	{-21.412071, 15.231456, -2.5017824},
+	{-20.080097, 13.97106, -2.207289},
+	{-18.834503, 12.784672, -1.9281449},
+	{-17.644648, 11.645049, -1.6584392},
+	{-16.481459, 10.526277, -1.392534},
+	{-74.104935, 63.43086, -13.296479},
+	{-56.358055, 47.556343, -9.815531},
+	{-46.36567, 38.59443, -7.8464184},
+	{-39.766468, 32.655296, -6.536983},
+	{-34.998146, 28.345774, -5.5824537},
+	{-31.345251, 25.028025, -4.843469},
+	{-28.427464, 22.363087, -4.246055},
+	{-26.020868, 20.151447, -3.746714},
+	{-23.983217, 18.26638, -3.3178415},
+	{-22.218452, 16.62232, -2.9408119},
+	{-20.65819, 15.15835, -2.602371},
+	{-19.251263, 13.828868, -2.2925985},
+	{-17.957369, 12.597934, -2.0036736},
+	{-16.742922, 11.435555, -1.7290632},
+	{-15.578063, 10.315015, -1.4629382},
+	{-14.434227, 9.210687, -1.1996926},
+	{-69.57672, 60.130688, -12.715903},
+	{-52.836258, 45.0624, -9.393049},
+	{-43.342716, 36.494217, -7.499732},
+	{-37.04781, 30.79312, -6.2356},
+	{-32.48682, 26.644905, -5.3115516},
+	{-28.985085, 23.444391, -4.594627},
+	{-26.182549, 20.868696, -4.0139704},
+	{-23.866594, 18.727205, -3.5277932},
+	{-21.901796, 16.898527, -3.1095083},
+	{-20.196466, 15.300494, -2.7411308},
+	{-18.685148, 13.874434, -2.4098263},
+	{-17.318691, 12.57628, -2.105956},
+	{-16.0582, 11.371137, -1.8218863},
+	{-14.871081, 10.22972, -1.551205},
+	{-13.728145, 9.12576, -1.2881508},
+	{-12.601261, 8.03387, -1.0271415},
+	{-65.56223, 57.20434, -12.20074},
+	{-49.696934, 42.840275, -9.016602},
+	{-40.63969, 34.61789, -7.1901326},
+	{-34.61163, 29.126364, -5.9660416},
+	{-30.232744, 25.120365, -5.068964},
+	{-26.86392, 22.023302, -4.3715706},
+	{-24.16282, 19.526411, -3.8057551},
+	{-21.926706, 17.446894, -3.3312385},
+	{-20.026127, 15.668054, -2.9223297},
+	{-18.373226, 14.110701, -2.561609},
+	{-16.90511, 12.718142, -2.2366116},
+	{-15.574385, 11.447644, -1.937945},
+	{-14.34339, 10.265231, -1.658139},
+	{-13.180385, 9.142226, -1.3908846},
+	{-12.056765, 8.052742, -1.1304771},
+	{-10.944782, 6.9715986, -0.871353},
+	{-61.97034, 54.58599, -11.739477},
+	{-46.873825, 40.843246, -8.678279},
+	{-38.201706, 32.927364, -6.911314},
+	{-32.409687, 27.621943, -5.722923},
+	{-28.192055, 23.74235, -4.8499084},
+	{-24.941013, 20.737288, -4.1699457},
+	{-22.329851, 18.310505, -3.617377},
+	{-20.16456, 16.286137, -3.153266},
+	{-18.320974, 14.551645, -2.7527208},
+	{-16.71462, 13.030487, -2.3988237},
+	{-15.284862, 11.667713, -2.0794375},
+	{-13.985859, 10.421774, -1.7853875},
+	{-12.78103, 9.259491, -1.5093465},
+	{-11.6393795, 8.152721, -1.2450944},
+	{-10.532815, 7.0759077, -0.98697454},
+	{-9.433923, 6.004043, -0.7294379},
+	{-58.729176, 52.22371, -11.323051},
+	{-44.31403, 39.034016, -8.3717785},
+	{-35.984615, 31.391989, -6.65821},
+	{-30.402933, 26.253063, -5.5018897},
+	{-26.329082, 22.48661, -4.6504993},
+	{-23.18309, 19.563908, -3.986201},
+	{-20.652151, 17.199888, -3.4455342},
+	{-18.550045, 15.224885, -2.9907687},
+	{-16.757303, 13.530079, -2.597729},
+	{-15.192469, 12.041288, -2.2499478},
+	{-13.796911, 10.705119, -1.9355808},
+	{-12.526164, 9.4810705, -1.6456462},
+	{-11.344597, 8.336669, -1.3729426},
+	{-10.221871, 7.244234, -1.1113273},
+	{-9.130337, 6.1784916, -0.85518336},
+	{-8.0428705, 5.114586, -0.59897333},
+	{-55.781166, 50.075928, -10.944213},
+	{-41.974636, 37.38232, -8.091993},
+	{-33.95229, 29.986681, -6.42668},
+	{-28.559214, 24.997656, -5.299361},
+	{-24.61429, 21.333063, -4.4675245},
+	{-21.562464, 18.48449, -3.8173857},
+	{-19.103418, 16.176935, -3.2874715},
+	{-17.057905, 14.246317, -2.8411427},
+	{-15.31068, 12.587168, -2.454872},
+	{-13.782984, 11.127423, -2.1125972},
+	{-12.417978, 9.8150835, -1.8027369},
+	{-11.1724205, 8.61058, -1.5164816},
+	{-10.011517, 7.4820623, -1.2467413},
+	{-8.905502, 6.402252, -0.9874395},
+	{-7.827107, 5.3461165, -0.7329928},
+	{-6.749458, 4.2889233, -0.47787145},
+	{-53.079487, 48.108826, -10.597078},
+	{-39.820267, 35.863216, -7.83472},
+	{-32.07466, 28.69057, -6.2132854},
+	{-26.851557, 23.837214, -5.1123405},
+	{-23.022734, 20.26475, -4.298276},
+	{-20.055622, 17.483162, -3.6609964},
+	{-17.661179, 15.226584, -3.140839},
+	{-15.666466, 13.335993, -2.7021575},
+	{-13.960041, 11.70895, -2.3220122},
+	{-12.465574, 10.275308, -1.9847091},
+	{-11.127836, 8.984319, -1.6789038},
+	{-9.90467, 7.7972436, -1.3959408},
+	{-8.762022, 6.682782, -1.1288273},
+	{-7.67062, 5.6140018, -0.87154454},
+	{-6.603516, 4.5660686, -0.61853534},
+	{-5.534039, 3.5143452, -0.3642741},
+	{-50.585453, 46.294487, -10.276795},
+	{-37.821247, 34.455795, -7.596444},
+	{-30.326223, 27.485954, -6.015115},
+	{-25.256863, 22.755884, -4.9382644},
+	{-21.532866, 19.267021, -4.140419},
+	{-18.642094, 16.54612, -3.5148602},
+	{-16.305748, 14.33564, -3.0035827},
+	{-14.356613, 12.4811735, -2.571849},
+	{-12.686702, 10.883039, -2.197257},
+	{-11.221875, 9.472822, -1.864447},
+	{-9.908348, 8.200903, -1.5622885},
+	{-8.704929, 7.029284, -1.2822638},
+	{-7.578209, 5.9271407, -1.0174652},
+	{-6.4993353, 4.8678336, -0.761922},
+	{-5.4416146, 3.8266845, -0.5100965},
+	{-4.378521, 2.779114, -0.2564625},
+	{-48.266563, 44.609467, -9.979301},
+	{-35.95222, 33.14219, -7.374169},
+	{-28.684868, 26.357496, -5.8296533},
+	{-23.754852, 21.739763, -4.774893},
+	{-20.125572, 18.326883, -3.9918892},
+	{-17.303541, 15.66099, -3.3770351},
+	{-15.019322, 13.492173, -2.8738506},
+	{-13.110925, 11.670252, -2.4484327},
+	{-11.473503, 10.098059, -2.0788727},
+	{-10.034903, 8.7087555, -1.7501156},
+	{-8.742631, 7.453737, -1.4512243},
+	{-7.5563483, 6.2956576, -1.1738031},
+	{-6.4431996, 5.204106, -0.91101825},
+	{-5.3746696, 4.152676, -0.6569367},
+	{-4.32425, 3.116796, -0.40603307},
+	{-3.2654874, 2.0718994, -0.15277399},
+	{-46.09502, 43.03375, -9.701135},
+	{-34.19101, 31.906805, -7.1652846},
+	{-27.130909, 25.29156, -5.654671},
+	{-22.327196, 20.776293, -4.6202073},
+	{-18.783325, 17.432442, -3.8508031},
+	{-16.02294, 14.816301, -3.245727},
+	{-13.785191, 12.684997, -2.7499108},
+	{-11.91288, 10.892226, -2.3302226},
+	{-10.304023, 9.343126, -1.9652057},
+	{-8.888262, 7.9722896, -1.6400834},
+	{-7.6142583, 6.7320166, -1.344093},
+	{-6.442412, 5.5855355, -1.0689461},
+	{-5.3403254, 4.502774, -0.8078702},
+	{-4.279736, 3.4575005, -0.55496037},
+	{-3.2342348, 2.4251895, -0.30469388},
+	{-2.1773505, 1.3812295, -0.051521815},
+	{-44.04652, 41.549854, -9.439282},
+	{-32.517693, 30.735653, -6.9674616},
+	{-25.646265, 24.275637, -5.488131},
+	{-20.956718, 19.853727, -4.472327},
+	{-17.489412, 16.572367, -3.7153723},
+	{-14.783799, 14.00096, -3.119207},
+	{-12.586952, 11.90314, -2.6300726},
+	{-10.746069, 10.136181, -2.2155514},
+	{-9.161781, 8.607329, -1.8546028},
+	{-7.7653494, 7.252472, -1.5327038},
+	{-6.5064535, 6.0247145, -1.2392468},
+	{-5.346121, 4.8877726, -0.966036},
+	{-4.2523103, 3.8118403, -0.70634764},
+	{-3.196915, 2.7707908, -0.45429313},
+	{-2.1535268, 1.7400792, -0.2043421},
+	{-1.095554, 0.69497836, 0.04908032},
+	{-42.099274, 40.14216, -9.191064},
+	{-30.913795, 29.615784, -6.77855},
+	{-24.213665, 23.29781, -5.3280997},
+	{-19.626604, 18.960587, -4.3294253},
+	{-16.227133, 15.735353, -3.5838227},
+	{-13.569354, 13.203702, -2.9957275},
+	{-11.407674, 11.135299, -2.5126011},
+	{-9.593342, 9.390727, -2.1026871},
+	{-8.029374, 7.879162, -1.7453287},
+	{-6.648481, 6.5376554, -1.4262316},
+	{-5.401226, 5.3200164, -1.1349257},
+	{-4.249147, 4.19036, -0.86329204},
+	{-3.1604502, 3.119069, -0.604642},
+	{-2.1070824, 2.0800471, -0.35309103},
+	{-1.0625365, 1.0486605, -0.1030901},
+	{-2.1032154e-06, 1.5195495e-06, 0.15097141},
+	{-109.67247, 89.961426, -17.795202},
+	{-84.198006, 67.89286, -13.111809},
+	{-70.61158, 56.075085, -10.593186},
+	{-61.905735, 48.46196, -8.960534},
+	{-55.747913, 43.04074, -7.788513},
+	{-51.112095, 38.92613, -6.8901844},
+	{-47.466427, 35.659184, -6.168692},
+	{-44.5028, 32.973953, -5.5679154},
+	{-42.027218, 30.702847, -5.052474},
+	{-39.90878, 28.732622, -4.598425},
+	{-38.053265, 26.981567, -4.1884465},
+	{-36.388042, 25.38644, -3.8090744},
+	{-34.852345, 23.894053, -3.448913},
+	{-33.38986, 22.454777, -3.097249},
+	{-31.94142, 21.016098, -2.7426524},
+	{-30.435379, 19.514002, -2.37109},
+	{-100.33201, 83.135956, -16.593904},
+	{-77.179115, 62.89984, -12.264203},
+	{-64.67218, 51.9239, -9.9058},
+	{-56.600758, 44.802677, -8.366165},
+	{-50.862488, 39.7062, -7.255474},
+	{-46.52382, 35.821915, -6.400773},
+	{-43.0977, 32.725876, -5.711879},
+	{-40.300526, 30.171167, -5.1362743},
+	{-37.952885, 28.001455, -4.640697},
+	{-35.933056, 26.110552, -4.2025247},
+	{-34.152855, 24.421331, -3.8052847},
+	{-32.543648, 22.873558, -3.4360616},
+	{-31.04726, 21.41591, -3.0837872},
+	{-29.60884, 19.999697, -2.737884},
+	{-28.169586, 18.572363, -2.3868492},
+	{-26.656864, 17.068592, -2.016289},
+	{-92.56197, 77.462, -15.595848},
+	{-71.27313, 58.7034, -11.552553},
+	{-59.645504, 48.41588, -9.325688},
+	{-52.094894, 41.69996, -7.862972},
+	{-46.702976, 36.87248, -6.803244},
+	{-42.61079, 33.17983, -5.9849377},
+	{-39.367638, 30.226583, -5.323329},
+	{-36.709892, 27.781382, -4.7688584},
+	{-34.47003, 25.697119, -4.2900043},
+	{-32.533882, 23.873396, -3.865229},
+	{-30.818174, 22.236841, -3.4787562},
+	{-29.25755, 20.729654, -3.1181114},
+	{-27.79592, 19.302004, -2.7724795},
+	{-26.37956, 17.90586, -2.431368},
+	{-24.94984, 16.488485, -2.083171},
+	{-23.433159, 14.983257, -1.7131292},
+	{-85.982475, 72.661606, -14.751859},
+	{-66.22318, 55.120304, -10.945525},
+	{-55.325737, 45.40659, -8.828722},
+	{-48.210426, 39.030594, -7.43074},
+	{-43.109352, 34.429714, -6.414074},
+	{-39.224987, 30.899096, -5.6266165},
+	{-36.13673, 28.067001, -4.9881988},
+	{-33.597523, 25.71504, -4.451734},
+	{-31.449764, 23.703804, -3.987158},
+	{-29.585539, 21.937727, -3.5738401},
+	{-27.925694, 20.34656, -3.1965816},
+	{-26.407572, 18.874512, -2.8432693},
+	{-24.976791, 17.472939, -2.5032856},
+	{-23.580503, 16.094292, -2.166186},
+	{-22.16014, 14.68555, -1.8202413},
+	{-20.641157, 13.178824, -1.4503367},
+	{-80.32489, 68.53841, -14.027249},
+	{-61.843815, 52.018333, -10.420542},
+	{-51.562706, 42.790672, -8.397324},
+	{-44.81682, 36.7041, -7.054646},
+	{-39.963543, 32.29686, -6.0748878},
+	{-36.256863, 28.905132, -5.3139396},
+	{-33.3015, 26.177198, -4.695494},
+	{-30.864378, 23.90564, -4.1745667},
+	{-28.796276, 21.957575, -3.722332},
+	{-26.994583, 20.241528, -3.318927},
+	{-25.38361, 18.689882, -2.9496412},
+	{-23.902971, 17.248531, -2.6026602},
+	{-22.499697, 15.869788, -2.2675214},
+	{-21.121632, 14.50644, -1.9338002},
+	{-19.710173, 13.1051235, -1.5896351},
+	{-18.189917, 11.596754, -1.2195696},
+	{-75.39356, 64.94945, -13.396816},
+	{-57.99746, 49.29945, -9.960892},
+	{-48.243916, 40.489243, -8.0183525},
+	{-41.81567, 34.652298, -6.7235374},
+	{-37.176167, 30.412573, -5.7758055},
+	{-33.623226, 27.141317, -5.0379076},
+	{-30.783186, 24.503958, -4.436861},
+	{-28.434952, 22.302494, -3.9294906},
+	{-26.436472, 20.409649, -3.488037},
+	{-24.689672, 18.737452, -3.093298},
+	{-23.121826, 17.22054, -2.7309778},
+	{-21.674482, 15.806214, -2.3895123},
+	{-20.295843, 14.447578, -2.0585606},
+	{-18.934294, 13.097643, -1.7276992},
+	{-17.531155, 11.702664, -1.3849266},
+	{-16.010225, 10.192442, -1.0144644},
+	{-71.04231, 61.788013, -12.841776},
+	{-54.57956, 46.88919, -9.553901},
+	{-45.283066, 38.441814, -7.6817503},
+	{-39.131023, 32.82258, -6.428825},
+	{-34.677902, 28.729311, -5.509179},
+	{-31.259336, 25.56361, -4.791532},
+	{-28.520353, 23.005775, -4.205794},
+	{-26.250229, 20.865992, -3.7103665},
+	{-24.313126, 19.021845, -3.2784197},
+	{-22.614904, 17.388405, -2.8913243},
+	{-21.085392, 15.902246, -2.5351415},
+	{-19.667782, 14.511872, -2.198518},
+	{-18.31127, 13.171027, -1.8712084},
+	{-16.964664, 11.832858, -1.5427731},
+	{-15.569152, 10.443218, -1.2010694},
+	{-14.0478115, 8.930865, -0.8300162},
+	{-67.15931, 58.97255, -12.347803},
+	{-51.508823, 44.729702, -9.189747},
+	{-42.612404, 36.60094, -7.3796444},
+	{-36.70283, 31.173393, -6.163735},
+	{-32.413673, 27.209318, -5.26895},
+	{-29.113535, 24.136887, -4.569251},
+	{-26.463806, 21.649445, -3.9970973},
+	{-24.26283, 19.564356, -3.512279},
+	{-22.380215, 17.763472, -3.088783},
+	{-20.725246, 16.164516, -2.7084808},
+	{-19.229977, 14.70575, -2.357744},
+	{-17.83902, 13.3366995, -2.0253973},
+	{-16.502392, 12.011628, -1.7012682},
+	{-15.169223, 10.683744, -1.3748888},
+	{-13.780527, 9.298476, -1.0339729},
+	{-12.258712, 7.783608, -0.6621551},
+	{-63.657066, 56.439342, -11.903731},
+	{-48.72058, 42.775036, -8.860641},
+	{-40.17746, 34.928513, -7.105726},
+	{-34.48242, 29.671091, -5.9227986},
+	{-30.338522, 25.821823, -5.0501904},
+	{-27.143448, 22.83237, -4.366518},
+	{-24.573015, 20.407637, -3.8065054},
+	{-22.433584, 18.371338, -3.3311768},
+	{-20.599562, 16.609093, -2.9152417},
+	{-18.983255, 15.040969, -2.5410144},
+	{-17.518654, 13.606689, -2.1951363},
+	{-16.151588, 12.256656, -1.8665823},
+	{-14.832762, 10.945543, -1.5452332},
+	{-13.511523, 9.626552, -1.2205815},
+	{-12.12866, 8.244656, -0.8801952},
+	{-10.605924, 6.7267046, -0.5074383},
+	{-60.465607, 54.13751, -11.500668},
+	{-46.162132, 40.9878, -8.560266},
+	{-37.93324, 33.393127, -6.8548093},
+	{-32.42918, 28.287655, -5.701473},
+	{-28.414635, 24.540977, -4.848774},
+	{-25.313154, 21.625717, -4.179498},
+	{-22.813416, 19.257084, -3.6303983},
+	{-20.72891, 17.264471, -3.163602},
+	{-18.938303, 15.536844, -2.7544653},
+	{-17.356571, 13.996341, -2.3856938},
+	{-15.9193945, 12.5839615, -2.0441635},
+	{-14.57365, 11.250846, -1.7189758},
+	{-13.270585, 9.9519825, -1.4000468},
+	{-11.959662, 8.640486, -1.0768168},
+	{-10.581371, 7.2608337, -0.73670316},
+	{-9.056767, 5.738944, -0.36280718},
+	{-57.527588, 52.02546, -11.131364},
+	{-43.789307, 39.33674, -8.283359},
+	{-35.841408, 31.968077, -6.6225033},
+	{-30.50801, 26.998932, -5.495859},
+	{-26.60894, 23.344242, -4.6611176},
+	{-23.590948, 20.49548, -4.0048275},
+	{-21.15424, 18.177124, -3.4655719},
+	{-19.118694, 16.223654, -3.006473},
+	{-17.366777, 14.527036, -2.6034646},
+	{-15.8158245, 13.0112295, -2.2396002},
+	{-14.40299, 11.618348, -1.9019601},
+	{-13.076024, 10.300143, -1.5797479},
+	{-11.786577, 9.01181, -1.2628978},
+	{-10.484107, 7.7062926, -0.94078314},
+	{-9.108685, 6.327501, -0.60066164},
+	{-7.580563, 4.8003645, -0.22536966},
+	{-54.794678, 50.06826, -10.78976},
+	{-41.56379, 37.794834, -8.025393},
+	{-33.867947, 30.62976, -6.404945},
+	{-28.687197, 25.783173, -5.302462},
+	{-24.891094, 22.211006, -4.4839587},
+	{-21.947338, 19.421785, -3.8394024},
+	{-19.566542, 17.148382, -3.309037},
+	{-17.574327, 15.229855, -2.8568833},
+	{-15.856561, 13.560849, -2.4593947},
+	{-14.332655, 12.066943, -2.099933},
+	{-12.9410305, 10.691199, -1.7657531},
+	{-11.630134, 9.385848, -1.4461381},
+	{-10.351867, 8.106177, -1.1310197},
+	{-9.055516, 6.8048487, -0.8096864},
+	{-7.6805615, 5.425083, -0.46921894},
+	{-6.1462216, 3.890689, -0.092173114},
+	{-52.22468, 48.235558, -10.470621},
+	{-39.45084, 36.337715, -7.782306},
+	{-31.98109, 29.356245, -6.1985655},
+	{-26.9364, 24.61967, -5.1179743},
+	{-23.231464, 21.121231, -4.314147},
+	{-20.353027, 18.384983, -3.6801727},
+	{-18.021137, 16.15141, -3.1578093},
+	{-16.0666, 14.263713, -2.7118936},
+	{-14.378311, 12.618937, -2.3193448},
+	{-12.877499, 11.144067, -1.9637948},
+	{-11.5036335, 9.782955, -1.6326449},
+	{-10.205668, 8.48817, -1.3152318},
+	{-8.935557, 7.2149477, -1.0014602},
+	{-7.6421986, 5.9155073, -0.6805083},
+	{-6.264197, 4.532194, -0.33925086},
+	{-4.719341, 2.9874344, 0.040073127},
+	{-49.779076, 46.49981, -10.169227},
+	{-37.41713, 34.94213, -7.5502534},
+	{-30.149189, 28.125795, -5.999854},
+	{-25.224442, 23.487267, -4.9390397},
+	{-21.598827, 20.053923, -4.1484013},
+	{-18.776491, 17.364027, -3.5238926},
+	{-16.486073, 15.1650095, -3.0086572},
+	{-14.563055, 13.303817, -2.568271},
+	{-12.899016, 11.679616, -2.1800697},
+	{-11.416729, 10.220598, -1.8279178},
+	{-10.056479, 8.871227, -1.4993305},
+	{-8.767489, 7.5842314, -1.1836681},
+	{-7.5015035, 6.3146057, -0.87077624},
+	{-6.206707, 5.0139, -0.54968476},
+	{-4.8204026, 3.623285, -0.2070161},
+	{-3.258303, 2.0633717, 0.17537218},
+	{-47.42051, 44.834522, -9.881079},
+	{-35.428295, 33.58429, -7.3253326},
+	{-28.338083, 26.915113, -5.805078},
+	{-23.516434, 22.362429, -4.761955},
+	{-19.95722, 18.98503, -3.982992},
+	{-17.180576, 16.334253, -3.3667843},
+	{-14.9229965, 14.163878, -2.8577456},
+	{-13.024168, 12.32422, -2.4221208},
+	{-11.378, 10.716298, -2.0376108},
+	{-9.908509, 9.269283, -1.6882715},
+	{-8.556506, 7.928029, -1.3616942},
+	{-7.271172, 6.6452, -1.0472231},
+	{-6.003671, 5.3752832, -0.73459995},
+	{-4.7010145, 4.0688276, -0.412652},
+	{-3.2985759, 2.6653955, -0.067679726},
+	{-1.7090924, 1.083165, 0.31893632},
+	{-45.109966, 43.212273, -9.601569},
+	{-33.44577, 32.237694, -7.1032367},
+	{-26.507336, 25.696812, -5.6098833},
+	{-21.769415, 21.216352, -4.582219},
+	{-18.261044, 17.884237, -3.813248},
+	{-15.517171, 15.263895, -3.2040122},
+	{-13.281481, 13.114912, -2.7000887},
+	{-11.397399, 11.290602, -2.26832},
+	{-9.760771, 9.693534, -1.8867168},
+	{-8.296493, 8.253576, -1.5394768},
+	{-6.9455123, 6.915695, -1.2142174},
+	{-5.656546, 5.632178, -0.900214},
+	{-4.379682, 4.3566427, -0.58704287},
+	{-3.0601454, 3.0382032, -0.26325592},
+	{-1.6306374, 1.6142876, 0.08525362},
+	{-3.446588e-06, 2.4901567e-06, 0.477685},
+	{-127.92925, 104.6417, -20.347723},
+	{-98.49173, 79.22006, -14.992406},
+	{-83.30011, 66.019775, -12.190486},
+	{-73.773506, 57.673214, -10.400088},
+	{-67.16222, 51.818573, -9.127047},
+	{-62.280037, 47.436558, -8.158087},
+	{-58.51945, 44.004635, -7.383787},
+	{-55.53104, 41.2217, -6.74096},
+	{-53.09509, 38.897762, -6.1895657},
+	{-51.061977, 36.90269, -5.701979},
+	{-49.32083, 35.139187, -5.257311},
+	{-47.78037, 33.52613, -4.8378806},
+	{-46.353962, 31.985476, -4.4263887},
+	{-44.94278, 30.427107, -4.0025954},
+	{-43.407074, 28.722864, -3.5375223},
+	{-41.49901, 26.64521, -2.9796839},
+	{-116.30647, 96.08463, -18.835566},
+	{-89.88974, 73.05749, -13.942749},
+	{-76.03611, 60.90733, -11.341607},
+	{-67.2688, 53.155205, -9.664745},
+	{-61.142014, 47.68103, -8.46484},
+	{-56.58866, 43.55946, -7.546654},
+	{-53.057842, 40.31237, -6.8092437},
+	{-50.230526, 37.66239, -6.193934},
+	{-47.904613, 35.433273, -5.663299},
+	{-45.941242, 33.503254, -5.1913114},
+	{-44.23619, 31.780205, -4.758075},
+	{-42.702072, 30.186014, -4.346491},
+	{-41.253925, 28.64379, -3.9394753},
+	{-39.79207, 27.062391, -3.51654},
+	{-38.17189, 25.30886, -3.0476084},
+	{-36.13158, 23.141964, -2.4780033},
+	{-106.87986, 89.16152, -17.614124},
+	{-82.80636, 67.997986, -13.082737},
+	{-70.01146, 56.681255, -10.641527},
+	{-61.851933, 49.406204, -9.056076},
+	{-56.116646, 44.240112, -7.9155335},
+	{-51.831543, 40.33128, -7.0388675},
+	{-48.490314, 37.236652, -6.33185},
+	{-45.798, 34.69772, -5.7394037},
+	{-43.566574, 32.549194, -5.2261915},
+	{-41.665802, 30.676031, -4.7674623},
+	{-39.99683, 28.990232, -4.344107},
+	{-38.475407, 27.41604, -3.9394653},
+	{-37.01783, 25.877337, -3.5365725},
+	{-35.523502, 24.281782, -3.1146271},
+	{-33.843258, 22.491707, -2.6424258},
+	{-31.702381, 20.252825, -2.062162},
+	{-99.05759, 83.43293, -16.60508},
+	{-76.85332, 63.760532, -12.364003},
+	{-64.91692, 53.12137, -10.053264},
+	{-57.255, 46.237766, -8.543032},
+	{-51.84281, 41.326363, -7.451668},
+	{-47.780907, 37.594704, -6.60961},
+	{-44.598972, 34.62812, -5.9280887},
+	{-42.02159, 32.183426, -5.3549557},
+	{-39.872204, 30.104286, -4.85658},
+	{-38.02764, 28.281128, -4.40925},
+	{-36.393444, 26.629324, -3.99449},
+	{-34.88791, 25.07497, -3.595975},
+	{-33.428276, 23.542425, -3.1967876},
+	{-31.91296, 21.938034, -2.7757716},
+	{-30.188503, 20.11954, -2.300595},
+	{-27.968351, 17.820364, -1.7104418},
+	{-92.43932, 78.60207, -15.755644},
+	{-71.76117, 60.15036, -11.753098},
+	{-60.535156, 50.0731, -9.550903},
+	{-53.28832, 43.51663, -8.103697},
+	{-48.1475, 38.819435, -7.0537734},
+	{-44.274418, 35.23776, -6.2410355},
+	{-41.22842, 32.38037, -5.5812316},
+	{-38.750244, 30.016777, -5.0246487},
+	{-36.67286, 27.99808, -4.539073},
+	{-34.87897, 26.219254, -4.101655},
+	{-33.277775, 24.59844, -3.694434},
+	{-31.789658, 23.06321, -3.301342},
+	{-30.332544, 21.538176, -2.9054575},
+	{-28.803816, 19.92829, -2.485243},
+	{-27.045958, 18.08686, -2.007254},
+	{-24.76168, 15.735885, -1.4078263},
+	{-86.74434, 74.461205, -15.028953},
+	{-67.33621, 57.027702, -11.226064},
+	{-56.708015, 47.424187, -9.11566},
+	{-49.812897, 41.145317, -7.7220726},
+	{-44.90342, 36.630924, -6.7075777},
+	{-41.192352, 33.17798, -5.9200234},
+	{-38.263897, 30.414946, -5.278967},
+	{-35.872395, 28.122011, -4.7367477},
+	{-33.858845, 26.156546, -4.262348},
+	{-32.110905, 24.417345, -3.8336415},
+	{-30.540846, 22.824862, -3.4330924},
+	{-29.070772, 21.307844, -3.0448291},
+	{-27.619104, 19.790983, -2.6518872},
+	{-26.08215, 18.177794, -2.232333},
+	{-24.298563, 16.31731, -1.7516344},
+	{-21.961388, 13.921003, -1.143462},
+	{-81.76875, 70.85976, -14.398351},
+	{-63.434555, 54.288937, -10.765184},
+	{-53.31666, 45.090424, -8.733484},
+	{-46.72355, 39.0502, -7.3861065},
+	{-42.013767, 34.693733, -6.4022756},
+	{-38.443367, 31.3526, -5.6366134},
+	{-35.617714, 28.672024, -5.0119286},
+	{-33.30274, 26.441317, -4.4823174},
+	{-31.346296, 24.523193, -4.0177827},
+	{-29.640274, 22.819706, -3.596811},
+	{-28.099554, 21.25324, -3.2022212},
+	{-26.647663, 19.753492, -2.8182898},
+	{-25.20335, 18.245089, -2.4279795},
+	{-23.661814, 16.63007, -2.0089502},
+	{-21.858088, 14.753364, -1.5256147},
+	{-19.476515, 12.316756, -0.9091575},
+	{-77.35945, 67.68499, -13.843935},
+	{-59.945847, 51.85483, -10.356958},
+	{-50.268837, 43.006653, -8.3935375},
+	{-43.93792, 37.17383, -7.086422},
+	{-39.40231, 32.955204, -6.129413},
+	{-35.955254, 29.71214, -5.382977},
+	{-33.22035, 27.104315, -4.772735},
+	{-30.97353, 24.928915, -4.2543025},
+	{-29.06857, 23.053265, -3.798562},
+	{-27.401009, 21.382208, -3.384526},
+	{-25.887945, 19.839766, -2.995308},
+	{-24.454092, 18.356375, -2.6152935},
+	{-23.018375, 16.856485, -2.2273486},
+	{-21.474842, 15.240623, -1.8087196},
+	{-19.65506, 13.349751, -1.322793},
+	{-17.235476, 10.876703, -0.69843096},
+	{-73.39785, 64.84989, -13.350409},
+	{-56.782585, 49.662827, -9.990764},
+	{-47.490295, 41.120655, -8.087167},
+	{-41.38902, 35.46965, -6.815456},
+	{-37.006523, 31.37232, -5.882114},
+	{-33.668407, 28.215937, -5.152709},
+	{-31.014158, 25.672787, -4.5553193},
+	{-28.828432, 23.546906, -4.0468845},
+	{-26.970156, 21.709631, -3.5990543},
+	{-25.338028, 20.06821, -3.1912928},
+	{-23.851051, 18.548048, -2.8069587},
+	{-22.434895, 17.08015, -2.4305139},
+	{-21.00852, 15.588666, -2.0447047},
+	{-19.464756, 13.972565, -1.6263542},
+	{-17.631784, 12.068918, -1.137845},
+	{-15.1787, 9.562159, -0.50584894},
+	{-69.78882, 62.285046, -12.905628},
+	{-53.872578, 47.661587, -9.657936},
+	{-44.91855, 39.388733, -7.807178},
+	{-39.01961, 33.898136, -6.566822},
+	{-34.772354, 29.908121, -5.6545043},
+	{-31.530838, 26.828737, -4.940286},
+	{-28.948515, 24.343351, -4.354411},
+	{-26.817717, 22.262022, -3.8549836},
+	{-25.001879, 20.459583, -3.4143226},
+	{-23.402441, 18.845364, -3.0122814},
+	{-21.940016, 17.345917, -2.6324222},
+	{-20.541027, 15.892654, -2.2592502},
+	{-19.124302, 14.409304, -1.8753688},
+	{-17.581333, 12.793177, -1.4571636},
+	{-15.736858, 10.877451, -0.9660183},
+	{-13.252848, 8.338438, -0.32650042},
+	{-66.45275, 59.93281, -12.499578},
+	{-51.153152, 45.806908, -9.351067},
+	{-42.497833, 37.772205, -7.547243},
+	{-36.77748, 32.42352, -6.3347836},
+	{-32.649662, 28.52859, -5.4412155},
+	{-29.493677, 25.517658, -4.7405934},
+	{-26.975363, 23.083899, -4.1650796},
+	{-24.893822, 21.042679, -3.673807},
+	{-23.116446, 19.271887, -3.2396798},
+	{-21.547035, 17.682634, -2.8428855},
+	{-20.107544, 16.202415, -2.4671476},
+	{-18.724915, 14.762864, -2.0969837},
+	{-17.317644, 13.287129, -1.7148253},
+	{-15.775653, 11.670702, -1.2965921},
+	{-13.919991, 9.7427, -0.80264986},
+	{-11.405214, 7.1712184, -0.15547855},
+	{-63.31935, 57.742794, -12.123586},
+	{-48.56593, 44.05802, -9.063388},
+	{-40.174164, 36.233994, -7.3013573},
+	{-34.610645, 31.010513, -6.1137323},
+	{-30.58741, 27.19941, -5.236881},
+	{-27.506317, 24.248941, -4.5484247},
+	{-25.044239, 21.860998, -3.9822328},
+	{-23.006273, 19.85563, -3.4983487},
+	{-21.26326, 18.113388, -3.0701866},
+	{-19.720999, 16.546877, -2.6782134},
+	{-18.30249, 15.084299, -2.3062732},
+	{-16.93492, 13.657309, -1.9388574},
+	{-15.536151, 12.188243, -1.5581862},
+	{-13.994136, 10.570478, -1.1396631},
+	{-12.125632, 8.628645, -0.6425699},
+	{-9.576698, 6.021931, 0.012786417},
+	{-60.321445, 55.667465, -11.769567},
+	{-46.05109, 42.373653, -8.788124},
+	{-37.889553, 34.734695, -7.063205},
+	{-32.461224, 29.620234, -5.89755},
+	{-28.527185, 25.881695, -5.035475},
+	{-25.509577, 22.983479, -4.3577995},
+	{-23.095144, 20.635248, -3.7999182},
+	{-21.094267, 18.661165, -3.3226738},
+	{-19.380745, 16.944052, -2.8999183},
+	{-17.861992, 15.397714, -2.512343},
+	{-16.461689, 13.950784, -2.1438634},
+	{-15.10689, 12.534646, -1.7788937},
+	{-13.714327, 11.070463, -1.3993789},
+	{-12.16927, 9.448963, -0.98011655},
+	{-10.2829485, 7.4893994, -0.47915953},
+	{-7.690387, 4.840298, 0.18562354},
+	{-57.387543, 53.656963, -11.429159},
+	{-43.53957, 40.70672, -8.517639},
+	{-35.573265, 33.226757, -6.825243},
+	{-30.255663, 28.203737, -5.678607},
+	{-26.392365, 24.524868, -4.829227},
+	{-23.4239, 21.669117, -4.1608076},
+	{-21.045866, 19.353064, -3.6100996},
+	{-19.073257, 17.404451, -3.1386428},
+	{-17.382303, 15.707953, -2.7206488},
+	{-15.881538, 14.178198, -2.3369658},
+	{-14.494816, 12.743872, -1.9715137},
+	{-13.148435, 11.335628, -1.6085498},
+	{-11.75714, 9.872819, -1.2296357},
+	{-10.20214, 8.242516, -0.8087917},
+	{-8.286663, 6.2568097, -0.3025465},
+	{-5.629416, 3.5498354, 0.37426168},
+	{-54.43077, 51.651546, -11.092483},
+	{-40.93977, 38.995434, -8.242018},
+	{-33.125526, 31.643688, -6.5770187},
+	{-27.88536, 26.689302, -5.4458094},
+	{-24.065945, 23.05224, -4.606438},
+	{-21.124763, 20.22475, -3.945225},
+	{-18.765396, 17.92959, -3.4001262},
+	{-16.806723, 15.997479, -2.9332626},
+	{-15.126695, 14.314401, -2.5191047},
+	{-13.634215, 12.795239, -2.1385586},
+	{-12.252421, 11.368086, -1.7754365},
+	{-10.905715, 9.962032, -1.4136982},
+	{-9.505196, 8.493416, -1.0343174},
+	{-7.9253144, 6.8436913, -0.61020637},
+	{-5.9563947, 4.814261, -0.09578596},
+	{-3.1905086, 2.0175717, 0.5983165},
+	{-51.328655, 49.567944, -10.745932},
+	{-38.109364, 37.144547, -7.9461074},
+	{-30.380402, 29.875612, -6.301377},
+	{-25.161192, 24.952765, -5.1800694},
+	{-21.337997, 21.327002, -4.346357},
+	{-18.384558, 18.503012, -3.688966},
+	{-16.011549, 16.20891, -3.1468818},
+	{-14.040635, 14.277504, -2.6826348},
+	{-12.350173, 12.595097, -2.270779},
+	{-10.847921, 11.075731, -1.8920892},
+	{-9.45451, 9.645618, -1.5300546},
+	{-8.090155, 8.230648, -1.168061},
+	{-6.6590824, 6.7418127, -0.78610116},
+	{-5.023979, 5.051325, -0.35536534},
+	{-2.9539664, 2.9439278, 0.17283607},
+	{-7.124614e-06, 5.1485117e-06, 0.89381325},
+	{-155.33458, 126.72967, -24.323557},
+	{-118.83585, 95.36808, -17.783741},
+	{-100.94806, 79.864235, -14.512653},
+	{-90.12865, 70.37227, -12.476947},
+	{-82.877365, 63.90409, -11.059105},
+	{-77.73074, 59.208534, -10.000118},
+	{-73.9559, 55.65719, -9.169387},
+	{-71.140884, 52.894714, -8.492474},
+	{-69.03242, 50.7003, -7.9224424},
+	{-67.46154, 48.923824, -7.4265847},
+	{-66.30428, 47.45182, -6.9792433},
+	{-65.45515, 46.18396, -6.556713},
+	{-64.80216, 45.01022, -6.1320834},
+	{-64.18419, 43.771263, -5.666144},
+	{-63.278717, 42.15416, -5.0834556},
+	{-61.153725, 39.2771, -4.1773286},
+	{-139.83247, 115.20279, -22.27529},
+	{-107.7163, 87.3285, -16.407877},
+	{-91.63057, 73.247635, -13.409525},
+	{-81.77798, 64.5203, -11.52125},
+	{-75.107025, 58.51585, -10.194461},
+	{-70.32239, 54.11617, -9.195641},
+	{-66.7686, 50.753937, -8.405889},
+	{-64.07307, 48.104977, -7.7567825},
+	{-62.00364, 45.965378, -7.204724},
+	{-60.4025, 44.194252, -6.7189403},
+	{-59.150356, 42.682457, -6.274838},
+	{-58.141724, 41.330544, -5.8492055},
+	{-57.259537, 40.02553, -5.4149737},
+	{-56.329945, 38.598923, -4.931622},
+	{-54.999767, 36.71273, -4.319038},
+	{-52.235424, 33.39262, -3.3503335},
+	{-127.73082, 106.244316, -20.687748},
+	{-98.84269, 80.944916, -15.318841},
+	{-84.12439, 67.946014, -12.528617},
+	{-75.019226, 59.81068, -10.754805},
+	{-68.80449, 54.170956, -9.499709},
+	{-64.31054, 50.008423, -8.548993},
+	{-60.940388, 46.801987, -7.792635},
+	{-58.35172, 44.25142, -7.1668305},
+	{-56.328987, 42.16604, -6.6305833},
+	{-54.723507, 40.412395, -6.1546426},
+	{-53.42036, 38.88512, -5.715278},
+	{-52.31492, 37.485855, -5.2896624},
+	{-51.287106, 36.099606, -4.850538},
+	{-50.153877, 34.550507, -4.3560963},
+	{-48.537025, 32.47832, -3.7215989},
+	{-45.309425, 28.819424, -2.6996775},
+	{-117.987816, 99.06869, -19.41985},
+	{-91.57147, 75.744675, -14.434756},
+	{-77.92577, 63.595505, -11.808468},
+	{-69.41576, 55.93186, -10.126044},
+	{-63.569454, 50.58662, -8.928863},
+	{-59.31449, 46.618618, -8.017498},
+	{-56.099564, 43.542908, -7.2888856},
+	{-53.606277, 41.078156, -6.6828723},
+	{-51.63245, 39.044292, -6.1605325},
+	{-50.036926, 37.313915, -5.693836},
+	{-48.70866, 35.784866, -5.2597475},
+	{-47.544106, 34.359783, -4.8357215},
+	{-46.421154, 32.92205, -4.3942676},
+	{-45.149418, 31.289328, -3.8922865},
+	{-43.328915, 29.080849, -3.24048},
+	{-39.745434, 25.147211, -2.1712072},
+	{-109.94411, 93.18043, -18.382868},
+	{-85.48122, 71.41904, -13.702269},
+	{-72.69867, 59.953953, -11.208266},
+	{-64.67363, 52.674644, -9.600415},
+	{-59.13134, 47.572155, -8.450971},
+	{-55.07671, 43.76679, -7.572406},
+	{-51.995037, 40.802532, -6.8672366},
+	{-49.58728, 38.413326, -6.278257},
+	{-47.66218, 36.42774, -5.7682233},
+	{-46.084873, 34.723354, -5.3100967},
+	{-44.747684, 33.200714, -4.8814025},
+	{-43.548145, 31.763296, -4.4597983},
+	{-42.362625, 30.293043, -4.0175195},
+	{-40.99451, 28.601692, -3.5101833},
+	{-39.024246, 26.289227, -2.844026},
+	{-35.157402, 22.12518, -1.7318345},
+	{-103.16608, 88.25451, -17.518732},
+	{-80.28173, 67.75606, -13.084845},
+	{-68.20789, 56.852398, -10.699615},
+	{-60.585552, 49.89193, -9.153689},
+	{-55.2986, 44.993004, -8.044254},
+	{-51.414707, 41.32581, -7.1934643},
+	{-48.449074, 38.457966, -6.5083976},
+	{-46.11859, 36.135967, -5.93427},
+	{-44.2411, 34.19552, -5.435222},
+	{-42.68704, 32.518375, -4.9850354},
+	{-41.351643, 31.007347, -4.561687},
+	{-40.13346, 29.566593, -4.1429744},
+	{-38.907627, 28.0767, -3.700832},
+	{-37.472088, 26.343918, -3.1896117},
+	{-35.389423, 23.950048, -2.5112114},
+	{-31.29216, 19.589113, -1.3598562},
+	{-97.34811, 84.062675, -16.786793},
+	{-75.76368, 64.60346, -12.556335},
+	{-64.28162, 54.167965, -10.261933},
+	{-56.99893, 47.475952, -8.768178},
+	{-51.929676, 42.750175, -7.6927457},
+	{-48.193386, 39.202007, -6.8657985},
+	{-45.33012, 36.41868, -6.198203},
+	{-43.070103, 34.157135, -5.6371913},
+	{-41.23884, 32.259068, -5.148061},
+	{-39.71129, 30.60975, -4.7052727},
+	{-38.385223, 29.113905, -4.2871757},
+	{-37.160103, 27.67623, -3.8716552},
+	{-35.910027, 26.176022, -3.430329},
+	{-34.428074, 24.414383, -2.9163127},
+	{-32.260426, 21.955944, -2.2273128},
+	{-27.973534, 17.424898, -1.0401226},
+	{-92.26663, 80.438965, -16.157635},
+	{-71.76995, 61.84769, -12.09733},
+	{-60.788815, 51.80754, -9.879708},
+	{-53.796318, 45.34428, -8.430421},
+	{-48.914978, 40.767536, -7.384227},
+	{-45.307858, 37.323143, -6.5779943},
+	{-42.53597, 34.614796, -5.9257736},
+	{-40.34083, 32.40825, -5.3764935},
+	{-38.554485, 30.550282, -4.8964257},
+	{-37.05579, 28.929146, -4.4606037},
+	{-35.744717, 27.451185, -4.0476794},
+	{-34.52155, 26.021519, -3.6355884},
+	{-33.259483, 24.518198, -3.1956205},
+	{-31.747194, 22.737473, -2.6796892},
+	{-29.515717, 20.227728, -1.9814675},
+	{-25.071669, 15.548569, -0.76140666},
+	{-87.752106, 77.25851, -15.609262},
+	{-68.17673, 59.40003, -11.692772},
+	{-57.62434, 49.697075, -9.540686},
+	{-50.882263, 43.430576, -8.129661},
+	{-46.16471, 38.983307, -7.1088505},
+	{-42.671726, 35.630283, -6.3208046},
+	{-39.982216, 32.989162, -5.68227},
+	{-37.847374, 30.833221, -5.1436186},
+	{-36.104866, 29.013556, -4.6719484},
+	{-34.636917, 27.420979, -4.242775},
+	{-33.345436, 25.9632, -3.8349977},
+	{-32.131428, 24.545652, -3.4265718},
+	{-30.867256, 23.045174, -2.9884512},
+	{-29.337568, 21.253399, -2.4713805},
+	{-27.05928, 18.70326, -1.7651464},
+	{-22.484276, 13.894145, -0.5148018},
+	{-83.66724, 74.4214, -15.124247},
+	{-64.88058, 57.1873, -11.330359},
+	{-54.698036, 47.77391, -9.2346},
+	{-48.173374, 41.677593, -7.856704},
+	{-43.599323, 37.343445, -6.858086},
+	{-40.207764, 34.071392, -6.08614},
+	{-37.593018, 31.491066, -5.4599185},
+	{-35.514637, 29.382164, -4.9310226},
+	{-33.815144, 27.599483, -4.4672537},
+	{-32.379692, 26.036015, -4.044533},
+	{-31.11186, 24.600622, -3.6419573},
+	{-29.913263, 23.198938, -3.237475},
+	{-28.65548, 21.706587, -2.801695},
+	{-27.119333, 19.91074, -2.2842155},
+	{-24.808361, 17.329447, -1.571053},
+	{-20.123217, 12.404957, -0.292564},
+	{-79.89461, 71.8437, -14.688132},
+	{-61.78778, 55.144314, -10.999282},
+	{-51.924824, 45.979843, -8.952036},
+	{-45.58864, 40.030598, -7.6028705},
+	{-41.14, 35.7952, -6.623706},
+	{-37.838413, 32.595005, -5.8660893},
+	{-35.29158, 30.069904, -5.2510467},
+	{-33.26627, 28.00509, -4.7312245},
+	{-31.609207, 26.258516, -4.2750187},
+	{-30.208046, 24.725002, -3.858686},
+	{-28.967773, 23.314352, -3.4614773},
+	{-27.790466, 21.93226, -3.061301},
+	{-26.546846, 20.453074, -2.6284058},
+	{-25.013947, 18.659508, -2.1112428},
+	{-22.682144, 16.054907, -1.3921067},
+	{-17.902172, 11.0256405, -0.087011896},
+	{-76.323685, 69.44805, -14.287839},
+	{-58.80214, 53.205406, -10.688815},
+	{-49.212616, 44.25295, -8.683117},
+	{-43.037224, 38.429176, -7.3587},
+	{-38.69616, 34.278866, -6.3965054},
+	{-35.473026, 31.141804, -5.651639},
+	{-32.987125, 28.666668, -5.046804},
+	{-31.011457, 26.64332, -4.535531},
+	{-29.3963, 24.932337, -4.086706},
+	{-28.031376, 23.430025, -3.676854},
+	{-26.822765, 22.046865, -3.2853272},
+	{-25.672747, 20.688416, -2.8899553},
+	{-24.450947, 19.227552, -2.46059},
+	{-22.930285, 17.442274, -1.944487},
+	{-20.587278, 14.820667, -1.2201368},
+	{-15.720489, 9.691607, 0.110968925},
+	{-72.83293, 67.1513, -13.909571},
+	{-55.80705, 51.29236, -10.386384},
+	{-46.443264, 42.514923, -8.415516},
+	{-40.397972, 36.79376, -7.111875},
+	{-36.143524, 32.713436, -6.1641293},
+	{-32.98465, 29.629595, -5.430416},
+	{-30.55073, 27.198391, -4.8348546},
+	{-28.620047, 25.213552, -4.3317003},
+	{-27.045753, 23.537743, -3.8902247},
+	{-25.719105, 22.06832, -3.4871387},
+	{-24.54678, 20.716076, -3.1018293},
+	{-23.43077, 19.386063, -2.7119792},
+	{-22.238983, 17.949215, -2.2869575},
+	{-20.739126, 16.177969, -1.7726789},
+	{-18.39125, 13.543108, -1.0434748},
+	{-13.432466, 8.308914, 0.31485325},
+	{-69.25966, 64.84408, -13.535492},
+	{-52.631676, 49.291927, -10.073991},
+	{-43.43302, 40.645107, -8.130408},
+	{-37.47373, 34.99586, -6.8426585},
+	{-33.272858, 30.963459, -5.9060507},
+	{-30.154148, 27.91737, -5.181318},
+	{-27.755865, 25.520098, -4.5937552},
+	{-25.860626, 23.568434, -4.0981793},
+	{-24.32359, 21.926424, -3.6641128},
+	{-23.03664, 20.49181, -3.2683296},
+	{-21.906103, 19.17499, -2.8901284},
+	{-20.832521, 17.879677, -2.5069017},
+	{-19.680437, 16.473652, -2.0873294},
+	{-18.209333, 14.721517, -1.5755885},
+	{-15.855297, 12.071009, -0.84084886},
+	{-10.766869, 6.7013364, 0.550255},
+	{-65.33585, 62.347984, -13.136818},
+	{-48.965572, 46.99955, -9.719341},
+	{-39.821056, 38.40761, -7.791178},
+	{-33.85428, 32.769188, -6.510318},
+	{-29.630342, 28.736223, -5.5780888},
+	{-26.49227, 25.691425, -4.8575773},
+	{-24.08673, 23.302864, -4.2750344},
+	{-22.199419, 21.369255, -3.7855964},
+	{-20.685703, 19.75463, -3.3587756},
+	{-19.43593, 18.355537, -2.9711354},
+	{-18.353493, 17.080175, -2.6015806},
+	{-17.334234, 15.828624, -2.226834},
+	{-16.234484, 14.462041, -1.8143051},
+	{-14.796317, 12.730449, -1.3052846},
+	{-12.408688, 10.041273, -0.56081164},
+	{-7.045852, 4.425696, 0.88220376},
+	{-60.494137, 59.286705, -12.653138},
+	{-44.04442, 43.91276, -9.243374},
+	{-34.62046, 35.15918, -7.298335},
+	{-28.343565, 29.340647, -5.997119},
+	{-23.844767, 25.152357, -5.048214},
+	{-20.493593, 21.993217, -4.3171434},
+	{-17.9429, 19.534134, -3.7304287},
+	{-15.974081, 17.570065, -3.242479},
+	{-14.4335985, 15.958546, -2.8216977},
+	{-13.201102, 14.588619, -2.4434056},
+	{-12.1679125, 13.360023, -2.0851066},
+	{-11.214527, 12.161854, -1.7216611},
+	{-10.173001, 10.837304, -1.3173292},
+	{-8.736103, 9.098772, -0.80662125},
+	{-6.165036, 6.254591, -0.032449152},
+	{-1.9831561e-05, 1.4328059e-05, 1.5294791},
+	{-186.86365, 152.1625, -28.96402},
+	{-140.88086, 112.87684, -20.859613},
+	{-119.678764, 94.56266, -17.021751},
+	{-107.41299, 83.794205, -14.713343},
+	{-99.56053, 76.73355, -13.150561},
+	{-94.31255, 71.841156, -12.017621},
+	{-90.788376, 68.3625, -11.158299},
+	{-88.51974, 65.89058, -10.4865675},
+	{-87.23789, 64.18485, -9.94953},
+	{-86.78554, 63.094967, -9.511365},
+	{-87.07496, 62.523388, -9.145133},
+	{-88.051605, 62.39069, -8.824563},
+	{-89.675545, 62.61282, -8.517278},
+	{-91.85228, 63.028343, -8.165744},
+	{-94.16327, 63.129627, -7.6204324},
+	{-93.797806, 60.098713, -6.1771708},
+	{-166.11943, 136.58484, -26.180567},
+	{-126.6439, 102.49047, -19.07356},
+	{-107.893585, 86.12107, -15.608456},
+	{-96.85448, 76.33093, -13.490004},
+	{-89.68186, 69.82333, -12.0382395},
+	{-84.801834, 65.245056, -10.972993},
+	{-81.43904, 61.925583, -10.154271},
+	{-79.17111, 59.495125, -9.503505},
+	{-77.74856, 57.729904, -8.971532},
+	{-77.01656, 56.483982, -8.524228},
+	{-76.87325, 55.65212, -8.134411},
+	{-77.23809, 55.13984, -7.774824},
+	{-78.0221, 54.83285, -7.4102993},
+	{-79.042595, 54.512802, -6.9770403},
+	{-79.707855, 53.552986, -6.309769},
+	{-76.569176, 48.603474, -4.600631},
+	{-150.6556, 125.04309, -24.125702},
+	{-115.6749, 94.542145, -17.71228},
+	{-98.69686, 79.58064, -14.518102},
+	{-88.57122, 70.51957, -12.541589},
+	{-81.91903, 64.434784, -11.1746435},
+	{-77.334465, 60.106693, -10.1627445},
+	{-74.11804, 56.92522, -9.377595},
+	{-71.88257, 54.548965, -8.746264},
+	{-70.394844, 52.767883, -8.22254},
+	{-69.50455, 51.440983, -7.773735},
+	{-69.104805, 50.460976, -7.372973},
+	{-69.10254, 49.726433, -6.9927163},
+	{-69.38304, 49.10687, -6.596235},
+	{-69.717354, 48.352898, -6.115982},
+	{-69.40705, 46.756657, -5.373377},
+	{-64.4465, 40.46062, -3.4492896},
+	{-138.61885, 116.12298, -22.54393},
+	{-106.93313, 88.2588, -16.641161},
+	{-91.29729, 74.36348, -13.65265},
+	{-81.87877, 65.86634, -11.786054},
+	{-75.638756, 60.115597, -10.485988},
+	{-71.297264, 55.99172, -9.517197},
+	{-68.21209, 52.930195, -8.760198},
+	{-66.02365, 50.611774, -8.146432},
+	{-64.512276, 48.83762, -7.6320333},
+	{-63.53263, 47.471474, -7.18552},
+	{-62.976585, 46.406185, -6.780463},
+	{-62.744534, 45.53677, -6.3892536},
+	{-62.706844, 44.723057, -5.9741383},
+	{-62.60545, 43.69471, -5.4645853},
+	{-61.664913, 41.679855, -4.669888},
+	{-55.354053, 34.318714, -2.5548754},
+	{-128.95695, 109.02607, -21.29158},
+	{-99.78656, 83.17254, -15.778934},
+	{-85.19789, 70.10778, -12.950863},
+	{-76.34142, 62.057922, -11.171459},
+	{-70.43594, 56.577343, -9.925329},
+	{-66.298386, 52.623455, -8.992059},
+	{-63.33105, 49.66707, -8.258997},
+	{-61.196426, 47.40652, -7.6610374},
+	{-59.68611, 45.65217, -7.1562033},
+	{-58.659664, 44.272076, -6.714022},
+	{-58.009415, 43.16011, -6.308516},
+	{-57.63233, 42.209473, -5.912082},
+	{-57.389614, 41.273697, -5.4862065},
+	{-57.002937, 40.066666, -4.9577293},
+	{-55.635796, 37.761917, -4.124551},
+	{-48.265266, 29.515036, -1.8361338},
+	{-121.028946, 103.26644, -20.28128},
+	{-93.81684, 78.974815, -15.07216},
+	{-80.0633, 66.57045, -12.37173},
+	{-71.66323, 58.882492, -10.662805},
+	{-66.034904, 53.624664, -9.46097},
+	{-62.071552, 49.814808, -8.557474},
+	{-59.210873, 46.95167, -7.845064},
+	{-57.133297, 44.74779, -7.261407},
+	{-55.6399, 43.021164, -6.766062},
+	{-54.594833, 41.64371, -6.3294086},
+	{-53.89173, 40.5107, -5.925886},
+	{-53.425953, 39.514496, -5.527927},
+	{-53.052917, 38.504353, -5.0964065},
+	{-52.479546, 37.18167, -4.555797},
+	{-50.81854, 34.67181, -3.6932395},
+	{-42.593735, 25.672495, -1.2468596},
+	{-114.38148, 98.50255, -19.451921},
+	{-88.73061, 75.45076, -14.483813},
+	{-75.65617, 63.580658, -11.886569},
+	{-67.63327, 56.19016, -10.235447},
+	{-62.238544, 51.118935, -9.070526},
+	{-58.42647, 47.43305, -8.192361},
+	{-55.66342, 44.65375, -7.498032},
+	{-53.644634, 42.505196, -6.9274573},
+	{-52.17925, 40.811756, -6.4414606},
+	{-51.13567, 39.44883, -6.011144},
+	{-50.409294, 38.313225, -5.611321},
+	{-49.895054, 37.29713, -5.214479},
+	{-49.444813, 36.24688, -4.780988},
+	{-48.75462, 34.853825, -4.2331896},
+	{-46.89415, 32.200356, -3.3481278},
+	{-37.973022, 22.556215, -0.7580098},
+	{-108.70154, 94.501114, -18.762074},
+	{-84.31524, 72.446205, -13.987509},
+	{-71.79994, 61.01265, -11.474419},
+	{-64.09284, 53.869366, -9.871174},
+	{-58.897854, 48.95648, -8.737376},
+	{-55.21917, 45.37889, -7.8810596},
+	{-52.54669, 42.676125, -7.2028074},
+	{-50.58801, 40.581913, -6.6443787},
+	{-49.159245, 38.92607, -6.1676507},
+	{-48.132736, 37.587097, -5.7443414},
+	{-47.40594, 36.463455, -5.3496046},
+	{-46.874237, 35.447643, -4.9560003},
+	{-46.38763, 34.38415, -4.5234895},
+	{-45.6344, 32.95637, -3.97253},
+	{-43.648003, 30.208616, -3.0708165},
+	{-34.160065, 20.011488, -0.35024056},
+	{-103.76002, 91.09352, -18.182043},
+	{-80.40423, 69.84225, -13.563115},
+	{-68.35407, 58.767918, -11.119032},
+	{-60.91432, 51.83184, -9.555731},
+	{-55.892216, 47.054764, -8.448431},
+	{-52.332817, 43.57311, -7.6112056},
+	{-49.74557, 40.94134, -6.947488},
+	{-47.848522, 38.901196, -6.4005494},
+	{-46.463863, 37.28712, -5.9331465},
+	{-45.46766, 35.980473, -5.5175333},
+	{-44.759655, 34.88145, -5.129176},
+	{-44.236393, 33.883312, -4.740735},
+	{-43.74747, 32.82966, -4.3118587},
+	{-42.976173, 31.39789, -3.7613902},
+	{-40.926296, 28.599249, -2.8485255},
+	{-30.983742, 17.931099, -0.01002946},
+	{-99.365166, 88.1418, -17.687927},
+	{-76.858665, 67.54196, -13.194482},
+	{-65.19776, 56.76367, -10.806973},
+	{-57.985477, 50.00167, -9.277045},
+	{-53.11404, 45.34179, -8.192437},
+	{-49.662445, 41.94607, -7.372092},
+	{-47.15648, 39.381268, -6.7217507},
+	{-45.32312, 37.395767, -6.1859274},
+	{-43.98992, 35.828045, -5.7281137},
+	{-43.03646, 34.562084, -5.3210297},
+	{-42.365025, 33.499947, -4.940445},
+	{-41.87401, 32.5361, -4.5591493},
+	{-41.413937, 31.514198, -4.136592},
+	{-40.665802, 30.10766, -3.590293},
+	{-38.610405, 27.299923, -2.6719048},
+	{-28.315653, 16.237179, 0.27245355},
+	{-95.35821, 85.53559, -17.261084},
+	{-73.54949, 65.45788, -12.86735},
+	{-62.212208, 54.920635, -10.525622},
+	{-55.192116, 48.303528, -9.023354},
+	{-50.451546, 43.744514, -7.958179},
+	{-47.09769, 40.426308, -7.152908},
+	{-44.67007, 37.92565, -6.5151258},
+	{-42.903244, 35.99646, -5.9903502},
+	{-41.62952, 34.480675, -5.542683},
+	{-40.731853, 33.26475, -5.1452546},
+	{-40.115284, 32.25275, -4.7741265},
+	{-39.680775, 31.340845, -4.402269},
+	{-39.281185, 30.373802, -3.9890618},
+	{-38.597958, 29.02315, -3.45102},
+	{-36.595886, 26.250185, -2.533303},
+	{-26.047804, 14.8676405, 0.5049753},
+	{-91.57809, 83.16697, -16.883795},
+	{-70.33175, 63.494495, -12.566498},
+	{-59.25534, 53.14617, -10.260507},
+	{-52.39223, 46.645615, -8.780552},
+	{-47.762295, 42.17159, -7.7318316},
+	{-44.49594, 38.923058, -6.9401126},
+	{-42.144047, 36.484653, -6.314397},
+	{-40.44756, 34.61476, -5.8009796},
+	{-39.242943, 33.158276, -5.3644557},
+	{-38.41634, 32.003967, -4.978311},
+	{-37.8758, 31.058071, -4.6188993},
+	{-37.52555, 30.219023, -4.259426},
+	{-37.22225, 29.333815, -3.859347},
+	{-36.650852, 28.074507, -3.3345363},
+	{-34.767437, 25.386238, -2.4247696},
+	{-24.067146, 13.760897, 0.6951991},
+	{-87.822296, 80.90425, -16.535032},
+	{-66.99875, 61.51788, -12.270906},
+	{-56.11279, 51.30217, -9.990295},
+	{-49.363655, 44.885677, -8.526986},
+	{-44.817684, 40.477592, -7.4915576},
+	{-41.624313, 37.289146, -6.7119007},
+	{-39.343723, 34.910988, -6.098028},
+	{-37.72207, 33.10492, -5.59679},
+	{-36.599216, 31.71818, -5.1731367},
+	{-35.864132, 30.641586, -4.8008375},
+	{-35.427864, 29.78361, -4.45652},
+	{-35.198483, 29.045473, -4.113677},
+	{-35.037937, 28.27764, -3.7319968},
+	{-34.63778, 27.155226, -3.2270815},
+	{-32.95284, 24.613358, -2.3344185},
+	{-22.20849, 12.827084, 0.85462445},
+	{-83.74385, 78.523254, -16.179304},
+	{-63.173096, 59.284332, -11.9425335},
+	{-52.368637, 49.121105, -9.6737995},
+	{-45.65352, 42.733887, -8.218628},
+	{-41.134228, 38.354786, -7.1912146},
+	{-37.97744, 35.20478, -6.4209247},
+	{-35.751545, 32.879135, -5.8184295},
+	{-34.206646, 31.14189, -5.3308625},
+	{-33.184544, 29.841518, -4.9233003},
+	{-32.57552, 28.869892, -4.5696006},
+	{-32.292343, 28.137003, -4.2465224},
+	{-32.2462, 27.546633, -3.9277785},
+	{-32.30462, 26.95334, -3.573346},
+	{-32.167873, 26.037321, -3.0988152},
+	{-30.794285, 23.727808, -2.2360368},
+	{-20.115463, 11.860115, 1.0111104},
+	{-78.57672, 75.52725, -15.737947},
+	{-57.901222, 56.175453, -11.4846945},
+	{-46.84777, 45.84428, -9.194078},
+	{-39.86982, 39.296707, -7.7196555},
+	{-35.135395, 34.797405, -6.6799545},
+	{-31.8417, 31.583397, -5.9063077},
+	{-29.569643, 29.255804, -5.3098884},
+	{-28.071526, 27.578796, -4.8375382},
+	{-27.185701, 26.398026, -4.453668},
+	{-26.796217, 25.601397, -4.131344},
+	{-26.808777, 25.094933, -3.8465538},
+	{-27.12822, 24.779375, -3.5723393},
+	{-27.618017, 24.507904, -3.268097},
+	{-27.97702, 23.960087, -2.8484411},
+	{-27.147272, 22.045765, -2.0391188},
+	{-16.55156, 10.088746, 1.274193},
+	{-69.45083, 70.03709, -14.911538},
+	{-46.29968, 49.016693, -10.400867},
+	{-32.31047, 36.82, -7.8377094},
+	{-22.85776, 28.762941, -6.155185},
+	{-16.392714, 23.258701, -4.988581},
+	{-11.986635, 19.441767, -4.1496863},
+	{-9.07365, 16.808199, -3.531775},
+	{-7.3055487, 15.052654, -3.07024},
+	{-6.463609, 13.982086, -2.7231734},
+	{-6.40881, 13.468982, -2.4609742},
+	{-7.0492454, 13.421045, -2.259459},
+	{-8.308007, 13.751267, -2.092815},
+	{-10.065601, 14.324138, -1.9208826},
+	{-11.986388, 14.79417, -1.6519233},
+	{-12.682455, 13.827433, -0.96651363},
+	{-8.5586136e-05, 6.183518e-05, 2.7452419},
+	{-209.86865, 170.72433, -32.36928},
+	{-156.1063, 124.97315, -22.99907},
+	{-132.39052, 104.53926, -18.7372},
+	{-119.119225, 92.88513, -16.23986},
+	{-110.92405, 85.47203, -14.586633},
+	{-105.739204, 80.54616, -13.419648},
+	{-102.57766, 77.260796, -12.563475},
+	{-100.95381, 75.18854, -11.926246},
+	{-100.61555, 74.0939, -11.453304},
+	{-101.458984, 73.85689, -11.110349},
+	{-103.505844, 74.449486, -10.877314},
+	{-106.85624, 75.88518, -10.734896},
+	{-111.74544, 78.244804, -10.6629305},
+	{-118.551704, 81.63891, -10.61915},
+	{-127.55858, 85.86586, -10.426788},
+	{-133.90912, 85.33632, -8.500862},
+	{-184.73601, 151.73485, -28.96468},
+	{-139.46405, 112.763466, -20.89302},
+	{-118.75202, 94.71763, -17.088507},
+	{-106.90699, 84.2063, -14.813848},
+	{-99.45358, 77.40432, -13.285277},
+	{-94.61373, 72.78623, -12.188465},
+	{-91.529076, 69.60898, -11.368249},
+	{-89.75679, 67.481155, -10.74042},
+	{-89.05412, 66.17812, -10.25358},
+	{-89.299095, 65.57157, -9.87392},
+	{-90.4525, 65.5939, -9.576928},
+	{-92.52308, 66.20147, -9.33767},
+	{-95.57048, 67.363785, -9.124458},
+	{-99.61374, 68.95637, -8.867925},
+	{-104.20905, 70.31001, -8.3411255},
+	{-101.57753, 63.593433, -5.579081},
+	{-166.65134, 138.16486, -26.541433},
+	{-126.95577, 103.65724, -19.32941},
+	{-108.33938, 87.27996, -15.845869},
+	{-97.529366, 77.59842, -13.73336},
+	{-90.634735, 71.255585, -12.29823},
+	{-86.07776, 66.88546, -11.256719},
+	{-83.08929, 63.816643, -10.467661},
+	{-81.26137, 61.686592, -9.85281},
+	{-80.363045, 60.28192, -9.363618},
+	{-80.26607, 59.47254, -8.967064},
+	{-80.904686, 59.17441, -8.637149},
+	{-82.24548, 59.318237, -8.346787},
+	{-84.26494, 59.820328, -8.05871},
+	{-86.83395, 60.460255, -7.693128},
+	{-89.17841, 60.340725, -6.993131},
+	{-81.281265, 49.644043, -3.5706692},
+	{-152.88872, 127.92246, -24.720766},
+	{-117.17333, 96.60245, -18.124544},
+	{-100.1087, 81.45957, -14.87893},
+	{-90.08587, 72.40802, -12.8895855},
+	{-83.629265, 66.42358, -11.527087},
+	{-79.30859, 62.25751, -10.530212},
+	{-76.42046, 59.291073, -9.768051},
+	{-74.585144, 57.184715, -9.16703},
+	{-73.58293, 55.735126, -8.680953},
+	{-73.28448, 54.81318, -8.277641},
+	{-73.611595, 54.32761, -7.9306874},
+	{-74.508415, 54.19571, -7.611992},
+	{-75.90637, 54.304657, -7.281169},
+	{-77.5972, 54.381535, -6.852372},
+	{-78.610634, 53.382298, -6.04564},
+	{-66.80937, 39.483017, -2.0115423},
+	{-142.06245, 119.9516, -23.312223},
+	{-109.31418, 91.0015, -17.174318},
+	{-93.43358, 76.79783, -14.109877},
+	{-84.02572, 68.236786, -12.2163515},
+	{-77.921265, 62.538906, -10.911622},
+	{-73.8011, 58.543686, -9.951443},
+	{-71.011894, 55.672207, -9.212643},
+	{-69.1967, 53.603336, -8.625336},
+	{-68.145966, 52.142456, -8.145251},
+	{-67.731834, 51.162685, -7.7410345},
+	{-67.870575, 50.56997, -7.386314},
+	{-68.494156, 50.274437, -7.0525184},
+	{-69.50869, 50.146725, -6.6974273},
+	{-70.658714, 49.881107, -6.230868},
+	{-70.84167, 48.326157, -5.353343},
+	{-55.9093, 31.685959, -0.74483794},
+	{-133.36298, 113.63366, -22.204067},
+	{-102.8555, 86.46663, -16.411375},
+	{-87.89881, 72.99233, -13.487583},
+	{-78.98263, 64.82132, -11.670063},
+	{-73.167816, 59.35747, -10.412181},
+	{-69.22093, 55.50793, -9.482704},
+	{-66.52768, 52.72466, -8.76444},
+	{-64.74979, 50.70139, -8.190442},
+	{-63.686733, 49.25102, -7.718025},
+	{-63.213463, 48.2495, -7.3166065},
+	{-63.244503, 47.60233, -6.9600544},
+	{-63.705708, 47.216194, -6.6196284},
+	{-64.48924, 46.95246, -6.252007},
+	{-65.31097, 46.484066, -5.763714},
+	{-64.9736, 44.576164, -4.8381248},
+	{-47.43474, 25.526974, 0.31058064},
+	{-126.2001, 108.52217, -21.316261},
+	{-97.437805, 82.733696, -15.790131},
+	{-83.217834, 69.83624, -12.977287},
+	{-74.70214, 61.98031, -11.220885},
+	{-69.13008, 56.71083, -10.001553},
+	{-65.33558, 52.98769, -9.098175},
+	{-62.73547, 50.28717, -8.398237},
+	{-61.006927, 48.315166, -7.8371587},
+	{-59.9575, 46.891087, -7.373574},
+	{-59.46572, 45.89404, -6.9776134},
+	{-59.446674, 45.23049, -6.6234913},
+	{-59.824074, 44.806175, -6.2824993},
+	{-60.483383, 44.477863, -5.910688},
+	{-61.12376, 43.90487, -5.4120264},
+	{-60.47864, 41.785973, -4.4547973},
+	{-40.71175, 20.574297, 1.2063302},
+	{-120.19816, 104.33723, -20.599096},
+	{-92.80986, 79.62052, -15.279407},
+	{-79.182, 67.181015, -12.554238},
+	{-70.99628, 59.581715, -10.847285},
+	{-65.6308, 54.47581, -9.660019},
+	{-61.97272, 50.864273, -8.77917},
+	{-59.463898, 48.24265, -8.095893},
+	{-57.794468, 46.326843, -7.547515},
+	{-56.779305, 44.942028, -7.0937853},
+	{-56.301086, 43.97072, -6.7055073},
+	{-56.277245, 43.321465, -6.3573174},
+	{-56.63248, 42.901062, -6.0207286},
+	{-57.2512, 42.5655, -5.651561},
+	{-57.82574, 41.967148, -5.1519837},
+	{-57.042107, 39.75412, -4.1773868},
+	{-35.32789, 16.559563, 1.9776931},
+	{-115.087425, 100.880806, -20.017735},
+	{-88.77448, 76.98699, -14.85555},
+	{-75.62626, 64.91167, -12.199552},
+	{-67.7155, 57.52283, -10.532747},
+	{-62.528515, 52.556343, -9.372414},
+	{-58.9949, 49.04521, -8.511335},
+	{-56.57693, 46.50037, -7.8435454},
+	{-54.97578, 44.64604, -7.307919},
+	{-54.013138, 43.31263, -6.8651586},
+	{-53.57639, 42.386333, -6.486717},
+	{-53.58686, 41.778812, -6.1477413},
+	{-53.97309, 41.3999, -5.820159},
+	{-54.623543, 41.108345, -5.459968},
+	{-55.23323, 40.55637, -4.9683785},
+	{-54.463326, 38.358513, -3.9905248},
+	{-31.02533, 13.316723, 2.6481857},
+	{-110.63189, 97.98529, -19.543386},
+	{-85.16987, 74.72197, -14.500222},
+	{-72.41048, 62.93369, -11.898034},
+	{-64.72943, 55.716877, -10.263608},
+	{-59.698055, 50.870037, -9.125997},
+	{-56.279865, 47.450874, -8.282558},
+	{-53.953854, 44.98248, -7.629544},
+	{-52.43079, 43.196102, -7.1070805},
+	{-51.538853, 41.927, -6.6767025},
+	{-51.17079, 41.06547, -6.310518},
+	{-51.253456, 40.527454, -5.984292},
+	{-51.72223, 40.227966, -5.670612},
+	{-52.475044, 40.0325, -5.326129},
+	{-53.219986, 39.60085, -4.8521056},
+	{-52.619236, 37.53421, -3.8866663},
+	{-27.662992, 10.763836, 3.230224},
+	{-106.65435, 95.53207, -19.15651},
+	{-81.84504, 72.726, -14.197515},
+	{-69.39431, 61.1558, -11.6354},
+	{-61.902622, 54.07671, -10.026477},
+	{-57.00664, 49.332333, -8.9079895},
+	{-53.696686, 45.998722, -8.0805645},
+	{-51.465412, 43.608387, -7.4420977},
+	{-50.031956, 41.898487, -6.9337053},
+	{-49.230946, 40.709007, -6.5176697},
+	{-48.961304, 39.934925, -6.1667876},
+	{-49.157383, 39.49794, -5.8576083},
+	{-49.76503, 39.32101, -5.563706},
+	{-50.698345, 39.281456, -5.2430234},
+	{-51.691734, 39.056774, -4.798232},
+	{-51.441925, 37.261505, -3.8646762},
+	{-25.201948, 8.899932, 3.7246072},
+	{-102.9576, 93.39513, -18.837152},
+	{-78.62131, 70.88554, -13.9297905},
+	{-66.39991, 59.46543, -11.394419},
+	{-59.056023, 52.48969, -9.804358},
+	{-54.274445, 47.83097, -8.701699},
+	{-51.06579, 44.577682, -7.889087},
+	{-48.933582, 42.269196, -7.2655087},
+	{-47.6043, 40.64761, -6.7728314},
+	{-46.91904, 39.557594, -6.3740206},
+	{-46.784107, 38.899776, -6.042649},
+	{-47.14388, 38.60362, -5.7562885},
+	{-47.959557, 38.603897, -5.48999},
+	{-49.170647, 38.797012, -5.2039247},
+	{-50.556694, 38.892876, -4.804132},
+	{-50.89657, 37.55746, -3.9289906},
+	{-23.713614, 7.8226824, 4.116903},
+	{-99.28003, 91.41377, -18.560797},
+	{-75.21501, 69.02222, -13.669671},
+	{-63.129826, 57.675434, -11.146647},
+	{-55.880375, 50.762, -9.56816},
+	{-51.18357, 46.167934, -8.477794},
+	{-48.064774, 42.988483, -7.679014},
+	{-46.036034, 40.767567, -7.0713725},
+	{-44.830177, 39.251305, -6.597252},
+	{-44.294804, 38.28914, -6.2202373},
+	{-44.344822, 37.788437, -5.9147873},
+	{-44.937855, 37.689228, -5.6598477},
+	{-46.05718, 37.943695, -5.432677},
+	{-47.681404, 38.478428, -5.197133},
+	{-49.664955, 39.059483, -4.865773},
+	{-50.94571, 38.465782, -4.088878},
+	{-23.42732, 7.788427, 4.3666744},
+	{-95.080505, 89.2499, -18.275322},
+	{-71.03075, 66.75902, -13.359091},
+	{-58.91953, 55.364532, -10.827835},
+	{-51.64687, 48.433025, -9.248456},
+	{-46.954403, 43.852947, -8.1633},
+	{-43.881397, 40.723526, -7.3757744},
+	{-41.947903, 38.591873, -6.785552},
+	{-40.891853, 37.206966, -6.335196},
+	{-40.5658, 36.422115, -5.9886727},
+	{-40.892387, 36.151512, -5.7212586},
+	{-41.844124, 36.348095, -5.513547},
+	{-43.433594, 36.9885, -5.3459377},
+	{-45.698338, 38.04672, -5.1882854},
+	{-48.617256, 39.385918, -4.9651},
+	{-51.426643, 40.01064, -4.353974},
+	{-24.848286, 9.369719, 4.3786607},
+	{-88.887856, 85.96589, -17.834085},
+	{-64.1277, 62.84791, -12.803899},
+	{-51.246838, 50.914783, -10.189445},
+	{-43.252254, 43.522938, -8.545275},
+	{-37.992897, 38.607704, -7.4177213},
+	{-34.573112, 35.29987, -6.6132236},
+	{-32.54395, 33.160786, -6.0323577},
+	{-31.649685, 31.94078, -5.6169586},
+	{-31.735197, 31.488237, -5.329566},
+	{-32.7085, 31.71079, -5.1439614},
+	{-34.527733, 32.558517, -5.0398874},
+	{-37.20732, 34.020657, -4.999076},
+	{-40.836926, 36.124928, -4.9981766},
+	{-45.577538, 38.890675, -4.981601},
+	{-51.237972, 41.780632, -4.6852117},
+	{-28.810875, 13.7611265, 3.938853},
+	{-69.259796, 74.11504, -16.054506},
+	{-29.232056, 41.12477, -9.504977},
+	{-4.826291, 22.23029, -5.906643},
+	{10.647285, 10.61338, -3.7225392},
+	{20.896786, 3.0740957, -2.3004966},
+	{27.847689, -1.9594043, -1.3322836},
+	{32.580524, -5.338601, -0.65453756},
+	{35.714443, -7.542397, -0.17537306},
+	{37.57543, -8.825708, 0.15670586},
+	{38.260036, -9.2793455, 0.36637038},
+	{37.627213, -8.836, 0.45590746},
+	{35.202904, -7.2193403, 0.40237442},
+	{29.914032, -3.790355, 0.1448479},
+	{19.418999, 2.836907, -0.44266582},
+	{-1.3289762, 15.50117, -1.5245919},
+	{-0.00078209594, 0.0005650549, 5.9769945},
+	{-217.7791, 177.10704, -33.54234},
+	{-161.18013, 129.00482, -23.713886},
+	{-136.58644, 107.83254, -19.304918},
+	{-122.98008, 95.883545, -16.744724},
+	{-114.6851, 88.36424, -15.063278},
+	{-109.54772, 83.447556, -13.888337},
+	{-106.54495, 80.25517, -13.037765},
+	{-105.19233, 78.357956, -12.418522},
+	{-105.25103, 77.52749, -11.976065},
+	{-106.64901, 77.66335, -11.677752},
+	{-109.47463, 78.78204, -11.508787},
+	{-113.92538, 80.958694, -11.455818},
+	{-120.427895, 84.39517, -11.510382},
+	{-129.75368, 89.44989, -11.653873},
+	{-142.97325, 96.366455, -11.73107},
+	{-155.0359, 98.45355, -9.644509},
+	{-191.02434, 156.85329, -29.907312},
+	{-143.68922, 116.149704, -21.494349},
+	{-122.303474, 97.52947, -17.57397},
+	{-110.1935, 86.78111, -15.247959},
+	{-102.6591, 79.89119, -13.695619},
+	{-97.85284, 75.27573, -12.591012},
+	{-94.88906, 72.16751, -11.77384},
+	{-93.322296, 70.17103, -11.158471},
+	{-92.9171, 69.06484, -10.693213},
+	{-93.57154, 68.7328, -10.345162},
+	{-95.284096, 69.13173, -10.092294},
+	{-98.11844, 70.2517, -9.912356},
+	{-102.23605, 72.125336, -9.77892},
+	{-107.82287, 74.72403, -9.627346},
+	{-114.692444, 77.48976, -9.2189455},
+	{-112.2169, 69.54971, -5.7788095},
+	{-172.0105, 142.56166, -27.352768},
+	{-130.65187, 106.64405, -19.860794},
+	{-111.47743, 89.78571, -16.279287},
+	{-100.44405, 79.90166, -14.122358},
+	{-93.47985, 73.48209, -12.666215},
+	{-88.94885, 69.1114, -11.6171875},
+	{-86.0593, 66.09806, -10.829817},
+	{-84.39931, 64.07466, -10.224402},
+	{-83.74246, 62.829376, -9.751966},
+	{-83.97457, 62.240448, -9.379983},
+	{-85.05492, 62.239544, -9.083793},
+	{-86.98731, 62.779766, -8.83778},
+	{-89.812744, 63.816067, -8.607162},
+	{-93.49506, 65.175865, -8.311608},
+	{-97.345085, 65.9614, -7.670539},
+	{-86.75182, 51.767464, -3.1559324},
+	{-157.64468, 131.85547, -25.448149},
+	{-120.51808, 99.327965, -18.61054},
+	{-102.96962, 83.7636, -15.278356},
+	{-92.75054, 74.53194, -13.249079},
+	{-86.2321, 68.478264, -11.867392},
+	{-81.933235, 64.3102, -10.863293},
+	{-79.130844, 61.391327, -10.102088},
+	{-77.440796, 59.37705, -9.508781},
+	{-76.64657, 58.06481, -9.036693},
+	{-76.6295, 57.33167, -8.653912},
+	{-77.32999, 57.09772, -8.334814},
+	{-78.72021, 57.296814, -8.05218},
+	{-80.77691, 57.8422, -7.766671},
+	{-83.35079, 58.487175, -7.3897295},
+	{-85.47293, 58.131237, -6.611109},
+	{-68.720535, 38.75145, -1.0656936},
+	{-146.4217, 123.58619, -23.986206},
+	{-112.42477, 93.55766, -17.631325},
+	{-96.10752, 78.969925, -14.487432},
+	{-86.52092, 70.24306, -12.556821},
+	{-80.359856, 64.48095, -11.234098},
+	{-76.25927, 60.48322, -10.266952},
+	{-73.547966, 57.654846, -9.528748},
+	{-71.8645, 55.66965, -8.948212},
+	{-71.0018, 54.3334, -8.4805765},
+	{-70.84085, 53.52423, -8.094633},
+	{-71.31318, 53.157265, -7.7645316},
+	{-72.373985, 53.15621, -7.462293},
+	{-73.96483, 53.411392, -7.1460257},
+	{-75.87241, 53.63279, -6.721845},
+	{-76.95098, 52.581524, -5.855822},
+	{-55.127327, 28.641125, 0.6809346},
+	{-137.4705, 117.08637, -22.846193},
+	{-105.811005, 88.91636, -16.850689},
+	{-90.447266, 75.080894, -13.851743},
+	{-81.36373, 66.75311, -11.998903},
+	{-75.49606, 61.22848, -10.723812},
+	{-71.56792, 57.37663, -9.787609},
+	{-68.94837, 54.63437, -9.069829},
+	{-67.29472, 52.690514, -8.502176},
+	{-66.408585, 51.35818, -8.041473},
+	{-66.17287, 50.51782, -7.657228},
+	{-66.515755, 50.083103, -7.3237233},
+	{-67.38404, 49.97298, -7.0126987},
+	{-68.70083, 50.06568, -6.680865},
+	{-70.21661, 50.045357, -6.2306285},
+	{-70.66827, 48.572872, -5.30827},
+	{-44.460007, 20.475084, 2.1908777},
+	{-130.14685, 111.86821, -21.94063},
+	{-100.293785, 85.12242, -16.220013},
+	{-85.68531, 71.8773, -13.3344345},
+	{-77.009575, 63.870045, -11.543718},
+	{-71.38748, 58.54219, -10.307669},
+	{-67.612015, 54.817513, -9.397799},
+	{-65.08417, 52.157757, -8.698431},
+	{-63.47684, 50.26401, -8.143662},
+	{-62.599716, 48.955982, -7.6916585},
+	{-62.338966, 48.11712, -7.312633},
+	{-62.622875, 47.66186, -6.9811974},
+	{-63.39542, 47.507954, -6.6691318},
+	{-64.57164, 47.528385, -6.3324676},
+	{-65.88326, 47.39273, -5.8710055},
+	{-65.987885, 45.687176, -4.914215},
+	{-35.756348, 13.597539, 3.555638},
+	{-124.0558, 107.63763, -21.217302},
+	{-95.609505, 81.98476, -15.706593},
+	{-81.60245, 69.20314, -12.909526},
+	{-73.26075, 61.45506, -11.168637},
+	{-67.847435, 56.292435, -9.964926},
+	{-64.2097, 52.680717, -9.077829},
+	{-61.77404, 50.101223, -8.39539},
+	{-60.226524, 48.265217, -7.8536353},
+	{-59.384384, 46.998352, -7.4118733},
+	{-59.13821, 46.187763, -7.0410333},
+	{-59.419006, 45.75035, -6.7162104},
+	{-60.17254, 45.60572, -6.409524},
+	{-61.314095, 45.627277, -6.07695},
+	{-62.571278, 45.479992, -5.6167865},
+	{-62.555317, 43.698944, -4.6448827},
+	{-28.423006, 7.5816636, 4.844913},
+	{-118.91447, 104.187126, -20.639723},
+	{-91.55049, 79.356125, -15.285603},
+	{-78.025536, 66.93812, -12.557288},
+	{-69.96061, 59.400875, -10.856444},
+	{-64.72775, 54.378902, -9.67978},
+	{-61.2169, 50.869667, -8.812744},
+	{-58.875225, 48.369953, -8.146256},
+	{-57.400154, 46.599552, -7.617911},
+	{-56.616207, 45.389736, -7.1880193},
+	{-56.419407, 44.631912, -6.828241},
+	{-56.745937, 44.24717, -6.5143247},
+	{-57.54759, 44.159904, -6.2190447},
+	{-58.74769, 44.24947, -5.8989606},
+	{-60.084404, 44.186703, -5.452142},
+	{-60.157997, 42.48215, -4.4847145},
+	{-22.054829, 2.1144607, 6.119796},
+	{-114.470634, 101.338615, -20.17742},
+	{-87.9481, 77.12146, -14.938142},
+	{-74.80872, 64.98452, -12.26208},
+	{-66.97338, 57.61774, -10.593066},
+	{-61.89804, 52.716408, -9.439116},
+	{-58.506283, 49.30208, -8.590087},
+	{-56.262108, 46.88364, -7.939077},
+	{-54.872925, 45.18816, -7.4249473},
+	{-54.17055, 44.052383, -7.008919},
+	{-54.05767, 43.37286, -6.663442},
+	{-54.478218, 43.076717, -6.36512},
+	{-55.394737, 43.096638, -6.087788},
+	{-56.747204, 43.324257, -5.7893586},
+	{-58.301147, 43.44879, -5.3692627},
+	{-58.68805, 41.988914, -4.4288187},
+	{-16.360754, -3.0562556, 7.442224},
+	{-110.545006, 98.97433, -19.811241},
+	{-84.64506, 75.17843, -14.647977},
+	{-71.805214, 63.247925, -12.009232},
+	{-64.157265, 56.015408, -10.364731},
+	{-59.219337, 51.21741, -9.229797},
+	{-55.94098, 47.892696, -8.397276},
+	{-53.79988, 45.55939, -7.7618265},
+	{-52.512394, 43.950836, -7.263327},
+	{-51.91793, 42.9094, -6.8638544},
+	{-51.927425, 42.337948, -6.5367646},
+	{-52.495823, 42.17216, -6.259811},
+	{-53.602448, 42.35762, -6.0084624},
+	{-55.21524, 42.806908, -5.743074},
+	{-57.15043, 43.245434, -5.366743},
+	{-58.130558, 42.246815, -4.482869},
+	{-11.117005, -8.165383, 8.887559},
+	{-106.92851, 96.96346, -19.520828},
+	{-81.45233, 73.40877, -14.396951},
+	{-68.82577, 61.610004, -11.780788},
+	{-61.32123, 54.47504, -10.1536455},
+	{-56.49976, 49.763496, -9.034349},
+	{-53.3297, 46.52467, -8.217334},
+	{-51.299545, 44.28325, -7.59823},
+	{-50.13378, 42.778046, -7.1177063},
+	{-49.68004, 41.857487, -6.7386913},
+	{-49.85972, 41.432556, -6.435646},
+	{-50.643295, 41.4511, -6.1879344},
+	{-52.035416, 41.8784, -5.973535},
+	{-54.048923, 42.6613, -5.7569695},
+	{-56.586544, 43.589344, -5.4486217},
+	{-58.556038, 43.36522, -4.664392},
+	{-6.131641, -13.480354, 10.566351},
+	{-103.34212, 95.13922, -19.281328},
+	{-78.05868, 71.61915, -14.155657},
+	{-65.54284, 59.866592, -11.545926},
+	{-58.124516, 52.784863, -9.928203},
+	{-53.389248, 48.138424, -8.820919},
+	{-50.31811, 44.980972, -8.0187435},
+	{-48.408016, 42.841335, -7.417716},
+	{-47.39092, 41.463158, -6.9590793},
+	{-47.123455, 40.70164, -6.606642},
+	{-47.540257, 40.478325, -6.3362637},
+	{-48.633553, 40.758537, -6.129621},
+	{-50.446236, 41.537937, -5.9686275},
+	{-53.063347, 42.81976, -5.8248935},
+	{-56.537525, 44.508026, -5.622648},
+	{-60.138233, 45.57368, -5.008898},
+	{-1.235229, -19.367632, 12.6706085},
+	{-99.166885, 93.12015, -19.034597},
+	{-73.78042, 69.379745, -13.858245},
+	{-61.19004, 57.534348, -11.231177},
+	{-53.7246, 50.414772, -9.608727},
+	{-48.98603, 45.777584, -8.505694},
+	{-45.96784, 42.677944, -7.716011},
+	{-44.17526, 40.647644, -7.135659},
+	{-43.346725, 39.43423, -6.706137},
+	{-43.346752, 38.899387, -6.391964},
+	{-44.123802, 38.97683, -6.1705484},
+	{-45.697502, 39.655495, -6.026693},
+	{-48.166462, 40.97741, -5.948438},
+	{-51.733593, 43.041077, -5.919746},
+	{-56.723366, 45.964947, -5.8918576},
+	{-63.153343, 49.297096, -5.582913},
+	{3.593497, -26.362957, 15.593591},
+	{-92.588234, 89.756615, -18.601637},
+	{-66.16648, 65.11842, -13.260501},
+	{-52.508995, 52.52708, -10.5164},
+	{-44.047035, 44.770412, -8.802556},
+	{-38.522427, 39.665188, -7.638517},
+	{-35.023582, 36.313747, -6.8229256},
+	{-33.102596, 34.27034, -6.253408},
+	{-32.509018, 33.28631, -5.870728},
+	{-33.0997, 33.219307, -5.637851},
+	{-34.804653, 33.996883, -5.5305758},
+	{-37.62445, 35.60802, -5.533172},
+	{-41.67414, 38.12824, -5.6379085},
+	{-47.29454, 41.789097, -5.8464017},
+	{-55.29772, 47.122646, -6.1639175},
+	{-67.500786, 55.127743, -6.493126},
+	{6.612184, -34.464783, 20.276798},
+	{-61.628086, 70.80332, -15.726935},
+	{-8.2660055, 29.190035, -7.8254833},
+	{22.180023, 6.869494, -3.765418},
+	{41.021004, -6.4033747, -1.4000134},
+	{53.52976, -14.90334, 0.10107773},
+	{62.50207, -20.752575, 1.1301229},
+	{69.61869, -25.157227, 1.9008639},
+	{75.93491, -28.83418, 2.536012},
+	{82.15168, -32.225826, 3.1088185},
+	{88.82905, -35.64782, 3.669296},
+	{96.554565, -39.388382, 4.2606254},
+	{106.12602, -43.792435, 4.9319825},
+	{118.85929, -49.369865, 5.7542486},
+	{137.23245, -56.935272, 6.8423953},
+	{164.97739, -66.55607, 8.252081},
+	{-0.041696273, 0.030125199, 22.733437},
+	{-218.41507, 177.62018, -33.636684},
+	{-161.58453, 129.32616, -23.770893},
+	{-136.92, 108.09435, -19.350077},
+	{-123.28695, 96.121864, -16.784876},
+	{-114.98433, 88.594345, -15.101224},
+	{-109.851326, 83.67885, -13.925725},
+	{-106.86207, 80.494514, -13.0757},
+	{-105.53236, 78.61221, -12.458042},
+	{-105.624664, 77.80424, -12.01823},
+	{-107.06985, 77.97199, -11.723791},
+	{-109.96251, 79.13618, -11.560441},
+	{-114.50929, 81.37778, -11.515416},
+	{-121.15548, 84.91056, -11.58145},
+	{-130.7129, 90.11878, -11.742578},
+	{-144.33928, 97.29703, -11.846801},
+	{-157.01405, 99.67544, -9.748643},
+	{-191.52737, 157.26276, -29.982758},
+	{-144.02496, 116.41879, -21.542162},
+	{-122.5851, 97.75246, -17.612494},
+	{-110.45409, 86.985275, -15.282404},
+	{-102.91352, 80.08857, -13.728209},
+	{-98.11037, 75.473656, -12.623041},
+	{-95.156845, 72.37143, -11.80619},
+	{-93.607376, 70.3861, -11.191922},
+	{-93.22723, 69.29659, -10.728536},
+	{-93.9163, 68.9879, -10.38322},
+	{-95.676575, 69.41912, -10.134194},
+	{-98.576836, 70.583534, -9.959483},
+	{-102.7884, 72.51993, -9.833211},
+	{-108.51394, 75.20964, -9.691363},
+	{-115.595856, 78.10869, -9.294762},
+	{-113.113495, 70.029976, -5.7843523},
+	{-172.43826, 142.91261, -27.417562},
+	{-130.9451, 106.88102, -19.902979},
+	{-111.72594, 89.98415, -16.313635},
+	{-100.674866, 80.08405, -14.153185},
+	{-93.70535, 73.65857, -12.695404},
+	{-89.17679, 69.28812, -11.645827},
+	{-86.29563, 66.279594, -10.858657},
+	{-84.649734, 64.26524, -10.254083},
+	{-84.01317, 63.03344, -9.783101},
+	{-84.273026, 62.46321, -9.413244},
+	{-85.39091, 62.4877, -9.1199875},
+	{-87.37411, 63.062138, -8.877874},
+	{-90.269806, 64.14529, -8.652405},
+	{-94.05134, 65.56973, -8.36334},
+	{-98.04062, 66.44029, -7.728395},
+	{-87.127625, 51.853302, -3.095642},
+	{-158.02374, 132.16895, -25.506153},
+	{-120.78322, 99.54402, -18.649092},
+	{-103.19605, 83.94596, -15.309991},
+	{-92.96145, 74.70004, -13.277553},
+	{-86.4383, 68.64104, -11.894371},
+	{-82.14148, 64.47307, -10.889741},
+	{-79.34635, 61.558323, -10.128672},
+	{-77.6685, 59.551857, -9.536056},
+	{-76.891716, 58.251232, -9.065185},
+	{-76.898346, 57.53409, -8.684182},
+	{-77.6305, 57.3216, -8.367509},
+	{-79.062965, 57.549202, -8.088046},
+	{-81.176895, 58.13275, -7.806599},
+	{-83.82907, 58.828537, -7.434482},
+	{-86.0534, 58.533134, -6.6591024},
+	{-68.72537, 38.530884, -0.9457776},
+	{-146.76897, 123.87572, -24.039927},
+	{-112.67131, 93.76027, -17.667572},
+	{-96.31915, 79.14183, -14.517335},
+	{-86.718414, 70.40185, -12.58379},
+	{-80.55305, 64.634796, -11.2596655},
+	{-76.454315, 60.63711, -10.292007},
+	{-73.74962, 57.812492, -9.553905},
+	{-72.07722, 55.834404, -8.973979},
+	{-71.23029, 54.508694, -8.507431},
+	{-71.09066, 53.713985, -8.123074},
+	{-71.591286, 53.36628, -7.7951193},
+	{-72.689514, 53.39059, -7.495661},
+	{-74.33039, 53.67925, -7.182884},
+	{-76.30509, 53.944214, -6.7626777},
+	{-77.46632, 52.940697, -5.8983793},
+	{-54.84253, 28.163868, 0.85626644},
+	{-137.79782, 117.36151, -22.897394},
+	{-106.04536, 89.1106, -16.88555},
+	{-90.649055, 75.24627, -13.880599},
+	{-81.552284, 66.90608, -12.024964},
+	{-75.6806, 61.376778, -10.748533},
+	{-71.75424, 57.52498, -9.811836},
+	{-69.14096, 54.7863, -9.094148},
+	{-67.49776, 52.849197, -8.527069},
+	{-66.62648, 51.526867, -8.067391},
+	{-66.41079, 50.700184, -7.6846423},
+	{-66.78017, 50.283623, -7.3531523},
+	{-67.68335, 50.197315, -7.0447254},
+	{-69.04649, 50.321236, -6.716121},
+	{-70.6239, 50.341118, -6.2694855},
+	{-71.14885, 48.91041, -5.348135},
+	{-43.922096, 19.758839, 2.4221182},
+	{-130.4616, 112.135056, -21.990456},
+	{-100.52044, 85.31199, -16.254154},
+	{-85.88086, 72.03905, -13.362761},
+	{-77.19247, 64.01982, -11.569326},
+	{-71.56658, 58.68748, -10.331976},
+	{-67.79292, 54.96292, -9.421631},
+	{-65.27123, 52.306736, -8.722362},
+	{-63.67412, 50.419666, -8.168167},
+	{-62.811493, 49.121483, -7.7171793},
+	{-62.570267, 48.29608, -7.339632},
+	{-62.87996, 47.85866, -7.0101857},
+	{-63.686474, 47.728153, -6.7006845},
+	{-64.90777, 47.77923, -6.3672047},
+	{-66.27922, 47.682983, -5.9092846},
+	{-66.45401, 46.01753, -4.953296},
+	{-34.965084, 12.628996, 3.849555},
+	{-124.36386, 107.90118, -21.2667},
+	{-95.83198, 82.17262, -15.740563},
+	{-81.794525, 69.3636, -12.937742},
+	{-73.440475, 61.603733, -11.194163},
+	{-68.023544, 56.436752, -9.989171},
+	{-64.38773, 52.82527, -9.10162},
+	{-61.958317, 50.249474, -8.419303},
+	{-60.421104, 48.420288, -7.87815},
+	{-59.593575, 47.16347, -7.4374423},
+	{-59.367065, 46.366592, -7.068128},
+	{-59.673878, 45.94739, -6.7453604},
+	{-60.46179, 45.82672, -6.4413357},
+	{-61.649147, 45.879807, -6.112091},
+	{-62.967525, 45.773403, -5.6556973},
+	{-63.024048, 44.034657, -4.6848674},
+	{-27.351034, 6.3216166, 5.2144427},
+	{-119.220665, 104.45166, -20.689522},
+	{-91.77146, 79.5447, -15.31986},
+	{-78.216255, 67.09919, -12.585747},
+	{-70.139114, 59.55018, -10.882201},
+	{-64.902794, 54.523952, -9.704265},
+	{-61.394077, 51.015133, -8.8368},
+	{-59.058937, 48.519382, -8.170476},
+	{-57.59455, 46.756184, -7.6427913},
+	{-56.825752, 45.55693, -7.214035},
+	{-56.64938, 44.81355, -6.855897},
+	{-57.003014, 44.448036, -6.5441923},
+	{-57.840706, 44.386242, -6.2518},
+	{-59.089268, 44.509663, -5.9353824},
+	{-60.49166, 44.491566, -5.4928594},
+	{-60.645706, 42.835682, -4.52731},
+	{-20.643564, 0.49087268, 6.586509},
+	{-114.778496, 101.60754, -20.228302},
+	{-88.16974, 77.312874, -14.973104},
+	{-74.99977, 65.14789, -12.291103},
+	{-67.152176, 57.76919, -10.619339},
+	{-62.073547, 52.86369, -9.464116},
+	{-58.684246, 49.45003, -8.61469},
+	{-56.447098, 47.035988, -7.9639063},
+	{-55.069305, 45.348335, -7.4505324},
+	{-54.383068, 44.224003, -7.0357733},
+	{-54.292023, 43.560158, -6.692123},
+	{-54.74172, 43.285015, -6.396275},
+	{-55.697353, 43.33302, -6.12221},
+	{-57.10312, 43.59852, -5.828015},
+	{-58.73087, 43.77432, -5.4131103},
+	{-59.213047, 42.374702, -4.476027},
+	{-14.505651, -5.1664424, 8.041789},
+	{-110.858, 99.251236, -19.863949},
+	{-84.86904, 75.374596, -14.684038},
+	{-71.99779, 63.415016, -12.039114},
+	{-64.3374, 56.170288, -10.391778},
+	{-59.396366, 51.368206, -9.255565},
+	{-56.12092, 48.044525, -8.422689},
+	{-53.98759, 45.716244, -7.787558},
+	{-52.712578, 44.11645, -7.289954},
+	{-52.13579, 43.087784, -6.8919506},
+	{-52.16932, 42.5339, -6.5669684},
+	{-52.77008, 42.391838, -6.2928905},
+	{-53.92066, 42.60942, -6.045391},
+	{-55.594482, 43.10293, -5.7851253},
+	{-57.61673, 43.603336, -5.4154215},
+	{-58.71729, 42.684605, -4.537443},
+	{-8.63297, -10.977041, 9.68158},
+	{-107.249176, 97.251595, -19.576075},
+	{-81.67956, 73.611275, -14.434475},
+	{-69.02016, 61.781837, -11.811774},
+	{-61.50282, 54.63417, -10.181668},
+	{-56.678417, 49.91862, -9.061078},
+	{-53.511894, 46.68133, -8.2437725},
+	{-51.490574, 44.445827, -7.625117},
+	{-50.33886, 42.950737, -7.1456914},
+	{-49.905056, 42.04489, -6.7684383},
+	{-50.112026, 41.640297, -6.467917},
+	{-50.932774, 41.68662, -6.223679},
+	{-52.37626, 42.152206, -6.0140195},
+	{-54.46282, 42.989174, -5.8039594},
+	{-57.108776, 43.996178, -5.5045547},
+	{-59.241165, 43.885242, -4.730549},
+	{-2.6677341, -17.399794, 11.671073},
+	{-103.67168, 95.44145, -19.339828},
+	{-78.2878, 71.82839, -14.194849},
+	{-65.736916, 60.04282, -11.57807},
+	{-58.305096, 52.94762, -9.957201},
+	{-53.567047, 48.297245, -8.848604},
+	{-50.500267, 45.142014, -8.046231},
+	{-48.60049, 43.009575, -7.445844},
+	{-47.599716, 41.643463, -6.9886065},
+	{-47.3555, 40.899498, -6.638366},
+	{-47.8044, 40.700623, -6.3711343},
+	{-48.942062, 41.0147, -6.168873},
+	{-50.817387, 41.84183, -6.013995},
+	{-53.526566, 43.193394, -5.8789883},
+	{-57.144455, 44.98925, -5.689595},
+	{-60.984707, 46.22872, -5.0939913},
+	{3.9633367, -25.271784, 14.337664},
+	{-99.5001, 93.43582, -19.096584},
+	{-74.00272, 69.591736, -13.898669},
+	{-61.373108, 57.709427, -11.263765},
+	{-53.892147, 50.57471, -9.637845},
+	{-49.150196, 45.933228, -8.533423},
+	{-46.137047, 42.83654, -7.7436595},
+	{-44.35681, 40.815266, -7.164244},
+	{-43.548107, 39.616943, -6.7366066},
+	{-43.576675, 39.104164, -6.4253407},
+	{-44.39355, 39.212605, -6.2080846},
+	{-46.02312, 39.934875, -6.070087},
+	{-48.57289, 41.319847, -6.0002165},
+	{-52.263737, 43.479702, -5.9840503},
+	{-57.460205, 46.56297, -5.9761767},
+	{-64.28427, 50.193134, -5.701718},
+	{12.552448, -36.62538, 18.508963},
+	{-92.889496, 90.06638, -18.664501},
+	{-66.32714, 65.29993, -13.297204},
+	{-52.602577, 52.6524, -10.542136},
+	{-44.098938, 44.863827, -8.822305},
+	{-38.549725, 39.74146, -7.655078},
+	{-35.042862, 36.385784, -6.8385253},
+	{-33.13086, 34.35069, -6.270056},
+	{-32.563995, 33.3878, -5.8903723},
+	{-33.20051, 33.355865, -5.662522},
+	{-34.9727, 34.184532, -5.5625334},
+	{-37.88544, 35.86682, -5.5751696},
+	{-42.06449, 38.48757, -5.6938763},
+	{-47.879513, 42.30196, -5.9232597},
+	{-56.22388, 47.90675, -6.276545},
+	{-69.207, 56.528427, -6.6827183},
+	{27.930737, -59.327656, 27.443214},
+	{-60.503994, 70.21563, -15.650938},
+	{-5.869797, 27.807337, -7.6289234},
+	{25.126436, 5.193958, -3.5324998},
+	{44.248833, -8.200056, -1.156944},
+	{56.947994, -16.766876, 0.3468683},
+	{66.132996, -22.697739, 1.3813848},
+	{73.566925, -27.245502, 2.166706},
+	{80.35744, -31.152887, 2.8284745},
+	{87.2667, -34.891525, 3.4431698},
+	{94.963715, -38.83134, 4.0672655},
+	{104.25083, -43.369766, 4.7573566},
+	{116.3913, -49.089954, 5.592147},
+	{133.87563, -57.10395, 6.7173095},
+	{163.04044, -70.20431, 8.493376},
+	{228.98386, -99.39783, 12.330355},
+	{-1841.4076, 1671.5839, 1321.0122},
+	{-4550.5884, -14814.54, -1043.932},
+	{-6526.419, -2907.4734, -5979.5854},
+	{7405.1133, -22702.9, -1316.159},
+	{7765.2397, -13323.566, -990.7473},
+	{1361.2021, -2338.9626, -3582.1897},
+	{-5540.376, 1647.6876, -5494.556},
+	{-15354.772, 12500.195, -10712.912},
+	{3455.7473, -5595.266, -2836.5217},
+	{4279.331, -10323.56, -8310.357},
+	{6437.3687, -6516.2666, -4051.6677},
+	{-31353.662, 58808.24, -46034.145},
+	{7535.6836, -4368.4766, -4969.794},
+	{3813.7144, -4535.4165, -3795.2402},
+	{-45548.727, 15229.698, -5747.6885},
+	{1048.7015, -9745.793, -4961.5312},
+	{15804.442, -16532.31, -4564.7627},
+	{-10261.82, -2669.2322, -472.39984},
+	{-3912.5874, -2073.6794, -6565.3813},
+	{2157.0996, -3423.8828, -3075.1487},
+	{-1069.6387, -9481.424, -1842.6968},
+	{-4344.1035, -3982.711, -2346.075},
+	{5761.672, -6177.671, -2809.26},
+	{9183.193, -8623.19, -2006.2299},
+	{10369.392, -5819.548, -9125.093},
+	{10884.061, -11809.603, -3108.238},
+	{8721.955, -5701.1445, -3888.235},
+	{3792.3062, -4086.5034, -4262.75},
+	{3578.4365, -2165.8235, -4946.884},
+	{14771.652, -10255.018, -8127.727},
+	{18073.016, -21072.168, -1332.3463},
+	{6397.197, -5391.252, -5797.077},
+	{11900.447, -15266.192, -2678.1067},
+	{-11782.373, 3887.2239, -9368.622},
+	{6633.549, -2335.5723, -8617.091},
+	{-4946.3804, -4351.9106, -3547.1292},
+	{-58.923042, -121.72589, -4142.535},
+	{11129.294, -12277.793, -870.45416},
+	{-1469.1976, -2613.982, -9610.349},
+	{5586.6245, -3670.9626, -2846.0874},
+	{14527.636, -9384.957, -5964.7905},
+	{4120.1904, -2344.0315, -5321.3013},
+	{3729.85, -2725.271, -6496.29},
+	{3375.483, -6888.681, -2262.3208},
+	{-2953.6655, -2972.3457, -3843.338},
+	{-7380.13, -641.978, -7888.2676},
+	{-19554.543, -4848.163, -2790.9348},
+	{4773.241, -2919.8591, -4547.3},
+	{8733.991, -10176.136, -4542.3853},
+	{-10235.224, 7992.865, -16349.269},
+	{-14964.994, 4042.8633, -7015.7344},
+	{-24267.592, 2462.9426, -3344.264},
+	{14412.331, -16469.82, -5759.361},
+	{4611.37, -11597.922, -7059.6675},
+	{7698.0723, -8372.267, -2708.6396},
+	{-8098.343, 7180.7856, -8421.239},
+	{12228.441, -11059.514, -1273.6315},
+	{4822.8115, -6705.385, -2716.0967},
+	{308.43787, -3995.5703, -2898.997},
+	{-13481.501, 6668.5815, -3942.8367},
+	{6168.8477, -1302.836, -7062.3516},
+	{-216.93692, -2457.2705, -5220.1553},
+	{3543.2942, -4042.7964, -5043.777},
+	{5419.2495, -6354.2607, -4464.697},
+	{7797.7974, -6501.352, -4151.7856},
+	{-7214.6846, -3562.4487, -4038.6892},
+	{12114.042, -8644.103, -3826.821},
+	{-2929.878, -8303.355, -3705.6763},
+	{7817.2207, -16578.53, -2907.1345},
+	{971.34595, -11655.674, -4392.651},
+	{7508.1772, -9000.298, 215.29301},
+	{-53110.97, 29406.834, -12056.954},
+	{14747.977, -11348.739, -6904.1685},
+	{8574.502, -7453.6733, -3245.7441},
+	{20212.94, -17106.893, -13116.35},
+	{4055.3237, -4038.2825, -5213.477},
+	{-12129.66, -4009.7327, -4718.4424},
+	{10735.075, -9930.595, -1484.584},
+	{10099.567, -6005.6445, -7736.3354},
+	{4732.078, -3931.9465, -7248.82},
+	{3990.554, -3203.048, -7796.224},
+	{-5587.936, -465.0442, -4154.525},
+	{-8263.637, 7068.7593, -8055.7324},
+	{23013.305, -23289.084, -1173.0153},
+	{-259.6503, -2869.7095, -6307.368},
+	{8643.367, -10487.654, -3648.5295},
+	{10019.234, -10981.405, 346.68576},
+	{5688.862, -5428.163, -4192.2866},
+	{4730.971, -1012.0433, -7541.262},
+	{6204.7983, -7579.4136, -2001.2678},
+	{1255.4873, -3990.3127, -5509.182},
+	{1944.7094, -10846.44, -3689.8484},
+	{6763.057, 6914.219, -15185.274},
+	{-12084.169, 3484.9653, -2849.0393},
+	{-4992.118, 5294.797, -14734.273},
+	{-8759.48, 25117.248, -19568.812},
+	{2714.739, -8000.218, -2994.8767},
+	{1627.1973, -4784.515, -3942.4092},
+	{6588.3774, -7585.2207, -4125.9883},
+	{-3002.4058, -2579.3877, -7295.5137},
+	{4346.38, 1736.5251, -6930.5093},
+	{10701.095, -9374.663, -8005.039},
+	{11153.623, -11865.167, -3815.5764},
+	{7322.142, -6478.111, -4548.547},
+	{10079.186, -10627.902, -3406.407},
+	{11501.006, -10713.421, -3955.2456},
+	{7215.7026, -5532.8423, -5726.2397},
+	{20154.066, -14454.765, -6391.9756},
+	{5454.854, -5834.6953, -4773.6763},
+	{6911.408, -2896.7358, -6631.91},
+	{-7835.9966, 3219.257, -7789.1816},
+	{1724.3962, -9343.211, -1657.385},
+	{11271.692, -14080.054, -1149.9332},
+	{-81980.016, -19556.85, -28.55962},
+	{-18427.512, 8511.756, -7751.415},
+	{-8907.296, 7701.211, -6675.7153},
+	{-1536.9893, 1399.0869, -5515.761},
+	{-4778.4243, -2225.796, -6604.9155},
+	{-11245.644, 238.57637, -5058.9067},
+	{6744.9453, -18206.979, -2645.7017},
+	{3690.892, -5068.7476, -2650.0896},
+	{-28745.16, 17887.273, -8719.779},
+	{874.93866, -1536.9808, -3196.7576},
+	{-2248.7065, 1063.731, -5864.2817},
+	{-2254.534, -4263.2603, -3196.1306},
+	{-1190.6348, -7919.461, -6018.817},
+	{10975.398, -8726.762, -4418.138},
+	{7081.8794, -8977.072, -4351.96},
+	{8682.083, -8809.742, -4000.2866},
+	{13123.16, -9617.389, -3926.4614},
+	{-22660.75, 11682.063, -5055.541},
+	{-645.1587, -2793.7712, -3584.921},
+	{-65569.54, 28475.795, -7318.423},
+	{895.9208, -2792.6348, -6796.3755},
+	{-19052.338, 5469.761, -4048.657},
+	{-4299.4297, 1170.6952, -5393.749},
+	{-27290.93, 17739.152, -6879.6953},
+	{18560.893, -18969.023, 21.20212},
+	{6465.3145, -6847.0103, -3683.7983},
+	{4731.953, -3994.9475, -5956.978},
+	{-20238.795, 13766.821, -8229.986},
+	{14653.95, -11654.047, -3068.4478},
+	{9904.905, -6075.2163, -4295.5103},
+	{2002.906, -2232.8018, -3673.5298},
+	{3692.7673, -4059.2278, -4234.502},
+	{-13212.06, 9706.3125, -9056.387},
+	{-36469.582, 20174.393, -10585.725},
+	{2889.966, -1131.6119, -5188.2383},
+	{-30698.8, 16883.727, -7578.1807},
+	{8176.001, -11763.666, -5268.315},
+	{2603.4358, -9101.812, -3062.9077},
+	{-2908.8115, 1115.8898, -2340.9036},
+	{2042.307, 1376.08, -5657.295},
+	{2181.7224, 2829.3, -8114.174},
+	{1134.4022, 4118.54, -9717.604},
+	{-11498.736, 9191.15, -5236.69},
+	{9038.033, -8121.255, -5909.6245},
+	{-3716.0195, -1799.9232, -3368.8838},
+	{25105.455, -23694.535, -6285.3877},
+	{1646.9187, -7289.319, -71095.984},
+	{-13129.2, -15534.034, -3016.372},
+	{-942.4897, -1710.8292, -6001.0513},
+	{6116.3574, -8185.6675, -19387.105},
+	{-784.1529, -4890.0254, -7835.6177},
+	{12013.821, -9704.991, -4283.135},
+	{-11443.023, 13699.857, -8607.444},
+	{-479.8376, -5452.951, -2847.2358},
+	{5000.8794, -3495.641, -6581.8535},
+	{3259.1213, -2445.3994, -5893.5044},
+	{-2350.4167, 2170.0674, -5753.387},
+	{2567.7686, 5900.94, -11426.536},
+	{14392.903, -11164.33, -10685.921},
+	{1077.7681, -1101.8467, -5560.938},
+	{6884.592, -4166.5596, -5816.154},
+	{8201.828, -5479.2456, -3757.1003},
+	{1660.4827, -5713.62, -5712.278},
+	{7597.5356, -3733.3557, -5524.377},
+	{3855.038, -6686.8003, -1806.0544},
+	{-9725.148, 10006.014, -11534.435},
+	{-30919.275, 21874.314, -7078.2886},
+	{-4679.596, -3742.549, -4742.355},
+	{-257.17633, -1476.43, -3696.0925},
+	{395.0208, 1045.4543, -8004.057},
+	{-1399.1572, -269.5904, -7701.346},
+	{3121.3684, -4006.394, -2334.7192},
+	{-13044.454, 8357.905, -3504.6443},
+	{2625.8313, 3379.044, -10456.816},
+	{-150.35461, 440.59015, -5102.467},
+	{-1316.2257, 1187.4415, -4788.4136},
+	{5236.4033, -6166.435, -8146.216},
+	{4796.5605, -5784.427, -1509.5385},
+	{2078.4307, -2920.8918, -4057.5327},
+	{1558.7676, 8425.41, -11638.911},
+	{-5297.62, 278.1511, -11037.561},
+	{-18936.94, 10862.398, -6379.492},
+	{-7030.512, 5930.264, -9702.583},
+	{-14304.683, 8238.861, -10198.669},
+	{87.2433, 5405.2173, -10635.186},
+	{-1705.3201, -727.90314, -5494.043},
+	{-6759.25, 7484.4844, -8406.421},
+	{-957.47577, 4696.7383, -10566.003},
+	{-25982.621, 4984.649, -9801.906},
+	{875.10626, 8359.06, -10027.657},
+	{-7250.3022, 6766.8267, -5870.567},
+	{3996.824, -4209.402, -1768.8025},
+	{3241.8464, -1416.2994, -6341.4814},
+	{6932.9863, -1084.1348, -7791.1772},
+	{9792.606, -6647.2905, -5868.095},
+	{-2422.207, 6871.4233, -9195.603},
+	{-21297.395, 12456.071, -6076.963},
+	{-13065.667, 6915.776, -6252.9688},
+	{-4988.3643, -1071.9498, -5393.838},
+	{-9356.931, 13629.379, -12577.679},
+	{-2646.2898, 8383.6455, -10163.09},
+	{-10330.791, 9595.216, -8987.978},
+	{-6629.8555, 4252.2305, -8445.609},
+	{-11373.074, 4065.8813, -5562.3564},
+	{8579.186, -4943.4233, -5938.952},
+	{-1456.0891, 6161.3076, -7830.833},
+	{-21576.873, 12395.391, -9700.108},
+	{1317.2179, -2343.5188, -4103.443},
+	{-5688.5493, 1061.4102, -6040.3535},
+	{3605.9348, -2116.5652, -6311.9634},
+	{1977.1398, -2264.837, -5674.0356},
+	{-2174.6377, 340.8903, -4527.817},
+	{18192.377, -17462.88, -1093.9491},
+	{-13418.332, 9707.624, -8299.6},
+	{-13038.626, 11557.449, -6980.937},
+	{-8871.463, 4515.335, -5032.6533},
+	{-1894.7437, -785.63135, -5451.425},
+	{-2793.4985, 7309.372, -9243.994},
+	{-1952.4485, 3455.6387, -6994.078},
+	{-3820.2163, 1236.1704, -4191.4434},
+	{4133.79, -2105.6873, -6733.712},
+	{3429.4165, -1224.3363, -5530.471},
+	{-12110.498, 17481.87, -9301.03},
+	{5896.7314, -3025.1792, -4666.1567},
+	{974.6524, -1006.69385, -3858.0193},
+	{5592.8794, -4534.397, -5058.328},
+	{-493.6073, 3917.7754, -9209.028},
+	{-4234.442, 4025.9392, -11601.594},
+	{-5363.1353, 14279.27, -14485.001},
+	{-13433.048, 14096.674, -7436.6484},
+	{-7101.3384, 1831.1825, -9058.643},
+	{-5493.7144, 3408.9143, -2825.57},
+	{2962.2454, -4689.379, -3777.0369},
+	{-11322.381, 10404.939, -9351.669},
+	{-6739.074, -4071.609, -5534.0483},
+	{-9858.432, 10690.921, -8415.926},
+	{-536.58514, 436.95233, -6630.3584},
+	{-11596.118, 13648.123, -11839.071},
+	{-6205.41, 6952.5757, -5344.1543},
+	{10880.293, -8767.975, -5470.1313},
+	{1700.8386, 2939.377, -7468.3477},
+	{912.8561, 1040.415, -4985.5923},
+	{-343.9615, -3936.3977, -6713.8267},
+	{2323.3647, -1996.7037, -3848.9011},
+	{-2669.7922, 1166.987, -148.07303},
+	{-492.9725, 6.4731784, -2.9134548},
+	{56.256134, -248.11618, 19.046196},
+	{341.61264, -379.16452, 28.541742},
+	{432.1329, -418.4888, 31.260246},
+	{450.24506, -422.68518, 31.476988},
+	{459.95105, -423.05978, 31.426647},
+	{453.97318, -415.86322, 30.83713},
+	{460.59, -415.26163, 30.72535},
+	{454.53094, -408.50378, 30.172358},
+	{447.38654, -401.39615, 29.594887},
+	{440.83005, -394.76535, 29.052298},
+	{437.44238, -389.90573, 28.639297},
+	{440.62952, -388.5469, 28.48434},
+	{436.09567, -383.4019, 28.051903},
+	{430.3198, -377.7467, 27.58086},
+	{-932.93256, 328.72543, -49.456463},
+	{-203.192, -75.48539, 1.7073263},
+	{66.9005, -204.45561, 13.078981},
+	{246.72212, -293.48108, 21.236622},
+	{336.65033, -335.52484, 24.493446},
+	{361.5441, -345.13348, 25.161655},
+	{374.96985, -349.15118, 25.406418},
+	{381.62497, -349.8881, 25.40407},
+	{380.50522, -346.7796, 25.107336},
+	{386.23492, -347.29175, 25.091387},
+	{382.9024, -343.24423, 24.719608},
+	{378.82156, -338.88272, 24.31794},
+	{375.1876, -334.81567, 23.933516},
+	{374.52707, -332.36163, 23.675581},
+	{377.111, -331.68103, 23.562874},
+	{374.61038, -328.3873, 23.22845},
+	{-544.72754, 164.48514, -32.55414},
+	{-140.08244, -66.38852, -2.5454106},
+	{62.24554, -173.8644, 9.429441},
+	{175.41963, -227.73122, 13.76752},
+	{259.63297, -269.97876, 17.700405},
+	{298.90854, -289.305, 19.611128},
+	{317.41614, -297.22525, 20.31329},
+	{326.93573, -300.31424, 20.53153},
+	{328.40433, -299.0552, 20.317806},
+	{329.5025, -297.64923, 20.086767},
+	{333.5076, -297.9351, 20.030655},
+	{331.10437, -294.6685, 19.600718},
+	{327.9792, -291.01822, 19.12184},
+	{325.19455, -287.597, 18.663153},
+	{324.54245, -285.4487, 18.34408},
+	{326.72928, -284.9905, 18.213186},
+	{-408.57584, 121.82429, -29.852583},
+	{-137.12619, -36.421116, -8.792588},
+	{35.378696, -135.79771, 3.9951193},
+	{133.78868, -187.56126, 9.477091},
+	{200.61786, -220.95062, 12.527573},
+	{243.19489, -241.04004, 14.07979},
+	{265.05725, -250.81358, 14.827083},
+	{276.37836, -255.26735, 15.151647},
+	{284.24362, -257.98734, 15.334548},
+	{286.0741, -257.57382, 15.249737},
+	{287.69772, -257.09955, 15.162995},
+	{291.60355, -257.87503, 15.184704},
+	{290.8436, -256.23135, 15.004805},
+	{289.33362, -254.23796, 14.800531},
+	{288.01846, -252.38768, 14.612},
+	{287.9845, -251.24426, 14.483623},
+	{-353.18192, 115.48185, -31.170412},
+	{-151.77655, -3.8443058, -14.98294},
+	{-11.662278, -86.58988, -3.9299338},
+	{87.35063, -144.4628, 3.6144612},
+	{149.9939, -178.26242, 7.3575077},
+	{197.41151, -203.72127, 10.147768},
+	{226.01266, -218.34286, 11.6330385},
+	{240.32614, -224.85742, 12.20088},
+	{249.60313, -228.6657, 12.501607},
+	{256.7343, -231.36101, 12.699697},
+	{258.26254, -231.10066, 12.636196},
+	{260.26395, -231.12001, 12.5979},
+	{263.63504, -231.90561, 12.629926},
+	{263.4036, -230.76889, 12.486498},
+	{262.38052, -229.20795, 12.299161},
+	{261.41666, -227.68024, 12.109143},
+	{-330.49454, 122.22308, -33.78866},
+	{-171.52855, 26.838602, -20.645975},
+	{-54.822266, -43.096443, -11.10753},
+	{32.585075, -95.30439, -4.064431},
+	{98.87048, -134.64888, 1.1720121},
+	{148.71733, -163.73184, 4.927057},
+	{182.14073, -181.84277, 6.9539127},
+	{202.7503, -192.2712, 7.9879527},
+	{214.35477, -197.85419, 8.55278},
+	{222.99095, -201.8387, 8.958949},
+	{229.38734, -204.56885, 9.2266655},
+	{231.56828, -204.83293, 9.203101},
+	{232.84624, -204.58685, 9.120319},
+	{236.27487, -205.64658, 9.195111},
+	{236.6809, -204.93109, 9.05954},
+	{235.9429, -203.56335, 8.849724},
+	{-322.86032, 133.89323, -36.778515},
+	{-192.08318, 54.609512, -25.712332},
+	{-92.23125, -5.899402, -17.328802},
+	{-14.711681, -52.80088, -10.878596},
+	{46.24019, -89.572235, -5.8601255},
+	{94.595795, -118.61372, -1.9294055},
+	{133.1244, -141.59613, 1.1503578},
+	{162.56293, -158.9211, 3.4357927},
+	{181.76022, -169.82195, 4.8262014},
+	{192.68564, -175.56017, 5.512171},
+	{200.57709, -179.42432, 5.9448547},
+	{205.87213, -181.72389, 6.1776886},
+	{209.19885, -182.86333, 6.269544},
+	{210.2561, -182.66718, 6.2019196},
+	{213.12204, -183.57306, 6.2684927},
+	{214.52847, -183.62262, 6.2327375},
+	{-322.67624, 147.21008, -39.783356},
+	{-211.86806, 79.45075, -30.221825},
+	{-124.672905, 26.127977, -22.73979},
+	{-55.100197, -16.386206, -16.807224},
+	{1.0321853, -50.63222, -12.054485},
+	{46.714924, -78.43184, -8.217587},
+	{84.12801, -101.11619, -5.104431},
+	{114.87701, -119.66731, -2.5738754},
+	{139.58197, -134.45155, -0.5719448},
+	{156.93631, -144.61569, 0.785984},
+	{168.55693, -151.16951, 1.6433481},
+	{175.78445, -154.95535, 2.1188693},
+	{181.20363, -157.61299, 2.4399023},
+	{186.20297, -160.02481, 2.7283163},
+	{187.69951, -160.22037, 2.7124612},
+	{189.23073, -160.45412, 2.7029936},
+	{-326.30746, 160.75163, -42.66158},
+	{-230.33374, 101.62938, -34.239956},
+	{-152.97903, 53.960205, -27.48005},
+	{-89.89603, 15.095591, -21.992445},
+	{-37.951828, -16.878363, -17.496704},
+	{5.1584435, -43.373436, -13.786666},
+	{41.160473, -65.44923, -10.708123},
+	{71.36437, -83.91192, -8.144123},
+	{96.77171, -99.3789, -6.0053687},
+	{117.956726, -112.199776, -4.241026},
+	{134.24307, -121.924164, -2.9133425},
+	{145.55847, -128.48907, -2.0299711},
+	{153.18053, -132.70334, -1.476318},
+	{159.38487, -136.02574, -1.046888},
+	{163.4943, -138.015, -0.8038411},
+	{167.05396, -139.66554, -0.60729814},
+	{-331.88647, 173.88669, -45.36059},
+	{-247.34138, 121.46973, -37.83241},
+	{-177.85303, 78.36441, -31.664032},
+	{-120.16342, 42.57554, -26.560427},
+	{-71.86235, 12.623404, -22.303444},
+	{-31.134548, -12.609159, -18.72887},
+	{3.4086342, -33.978718, -15.711166},
+	{32.843678, -52.15071, -13.153083},
+	{58.013905, -67.647446, -10.978494},
+	{79.58359, -80.88098, -9.127497},
+	{98.05871, -92.165016, -7.5545654},
+	{113.18649, -101.32477, -6.2840657},
+	{124.56667, -108.08912, -5.3541517},
+	{132.83636, -112.86028, -4.7071877},
+	{138.50691, -115.96216, -4.296969},
+	{143.59937, -118.704414, -3.9368992},
+	{-338.4042, 186.34476, -47.866627},
+	{-262.9204, 139.28018, -41.057728},
+	{-199.86311, 99.93728, -35.38305},
+	{-146.72581, 66.77398, -30.613386},
+	{-101.612816, 38.6213, -26.575445},
+	{-63.067715, 14.57915, -23.136154},
+	{-29.956074, -6.0550737, -20.1919},
+	{-1.383468, -23.83646, -17.661032},
+	{23.362694, -39.208096, -15.478529},
+	{44.85492, -52.526577, -13.592191},
+	{63.55539, -64.08019, -11.959907},
+	{79.83438, -74.10004, -10.547991},
+	{93.80999, -82.654854, -9.346263},
+	{105.16217, -89.528145, -8.385676},
+	{113.907845, -94.72277, -7.665683},
+	{120.41331, -98.47102, -7.1528316},
+	{-345.29688, 198.02731, -50.183125},
+	{-277.16592, 155.33223, -43.965958},
+	{-219.4642, 119.14545, -38.709846},
+	{-170.22089, 88.24938, -34.232964},
+	{-127.916885, 61.70358, -30.39521},
+	{-91.365074, 38.77158, -27.087124},
+	{-59.626286, 18.869587, -24.22214},
+	{-31.949331, 1.5297388, -21.731037},
+	{-7.727478, -13.626536, -19.557926},
+	{13.533476, -26.908136, -17.657303},
+	{32.238884, -38.56882, -15.99186},
+	{48.722725, -48.81796, -14.530878},
+	{63.25977, -57.827938, -13.249111},
+	{76.06471, -65.7332, -12.126897},
+	{87.03146, -72.45874, -11.175027},
+	{96.019066, -77.90636, -10.407694},
+	{-352.24374, 208.91953, -52.320885},
+	{-290.19418, 169.85808, -46.599247},
+	{-237.0216, 136.35762, -41.702736},
+	{-191.1475, 107.4393, -37.484554},
+	{-151.33511, 82.33465, -33.829704},
+	{-116.60347, 60.432995, -30.646984},
+	{-86.1659, 41.24371, -27.863283},
+	{-59.385303, 24.368706, -25.419395},
+	{-35.741013, 9.482095, -23.266947},
+	{-14.804145, -3.6850288, -21.366102},
+	{3.7810996, -15.356096, -19.683817},
+	{20.311634, -25.717834, -18.19253},
+	{35.03629, -34.926937, -16.869152},
+	{48.163826, -43.114994, -15.6943245},
+	{59.867634, -50.391315, -14.651993},
+	{70.25463, -56.822205, -13.732423},
+	{-359.06152, 219.04678, -54.2934},
+	{-302.1227, 183.05414, -48.99294},
+	{-252.83058, 151.86858, -44.408897},
+	{-209.90074, 124.69089, -40.42094},
+	{-172.31235, 100.88467, -36.933434},
+	{-139.24504, 79.9376, -33.869537},
+	{-110.03312, 61.433216, -31.16691},
+	{-84.131386, 45.02986, -28.774492},
+	{-61.08972, 30.444988, -26.650143},
+	{-40.533745, 17.443203, -24.758806},
+	{-22.149933, 5.8270817, -23.071136},
+	{-5.6741805, -4.569936, -21.562414},
+	{9.117147, -13.889079, -20.21171},
+	{22.414179, -22.250566, -19.001236},
+	{34.3789, -29.756931, -17.915848},
+	{45.149002, -36.495388, -16.942677},
+	{-365.6475, 228.45279, -56.114666},
+	{-313.0622, 195.08614, -51.176735},
+	{-267.132, 165.91666, -46.86687},
+	{-226.79735, 140.28297, -43.085125},
+	{-191.20554, 117.651855, -39.75106},
+	{-159.66333, 97.5891, -36.7993},
+	{-131.6021, 79.73798, -34.176212},
+	{-106.5516, 63.80301, -31.837452},
+	{-84.120026, 49.537575, -29.746073},
+	{-63.9787, 36.734497, -27.871078},
+	{-45.850113, 25.218582, -26.1863},
+	{-29.498636, 14.840867, -24.669523},
+	{-14.723179, 5.474037, -23.301785},
+	{-1.3513205, -2.991205, -22.066828},
+	{10.765314, -10.649034, -20.950668},
+	{21.754745, -17.58079, -19.94124},
+	{-371.9467, 237.18828, -57.798157},
+	{-323.11322, 206.09416, -53.17567},
+	{-280.12387, 178.69669, -49.10843},
+	{-242.09427, 154.44215, -45.512478},
+	{-208.30443, 132.87912, -42.319473},
+	{-178.16309, 113.63623, -39.47329},
+	{-151.18054, 96.40545, -36.927444},
+	{-126.94829, 80.92942, -34.643166},
+	{-105.12312, 66.991615, -32.587868},
+	{-85.41491, 54.40871, -30.734018},
+	{-67.57691, 43.02457, -29.05819},
+	{-51.398182, 32.70553, -27.54036},
+	{-36.69746, 23.336576, -26.163317},
+	{-23.3183, 14.818311, -24.91221},
+	{-11.12512, 7.064487, -23.77417},
+	{-1.9675597e-05, 1.4160513e-05, -22.738},
+	{-709.15405, 309.78204, -39.224598},
+	{-129.3739, 0.54471767, -0.52718186},
+	{44.966206, -88.19708, 8.996948},
+	{126.25436, -130.05852, 13.345899},
+	{152.56104, -142.79059, 14.612323},
+	{159.43378, -145.01941, 14.78376},
+	{162.85649, -145.46114, 14.77274},
+	{164.1711, -144.82838, 14.652181},
+	{164.22351, -143.57335, 14.468372},
+	{163.16609, -141.76508, 14.227442},
+	{162.39322, -140.18571, 14.012473},
+	{161.7423, -138.73788, 13.813104},
+	{160.54628, -137.03299, 13.587092},
+	{159.01044, -135.18156, 13.345968},
+	{157.42004, -133.343, 13.106899},
+	{155.93416, -131.60602, 12.879624},
+	{-247.41884, 86.92549, -13.008287},
+	{-52.604404, -21.12703, 0.6935214},
+	{36.279743, -68.5285, 6.0994654},
+	{86.17931, -94.81741, 8.941901},
+	{112.79349, -108.63258, 10.408299},
+	{123.44239, -113.675385, 10.919594},
+	{128.31177, -115.52483, 11.082597},
+	{131.22574, -116.31808, 11.131521},
+	{132.725, -116.34876, 11.098008},
+	{133.58781, -116.05524, 11.030244},
+	{133.60272, -115.30865, 10.913455},
+	{133.47032, -114.51008, 10.792194},
+	{133.3882, -113.771965, 10.678973},
+	{133.11707, -112.95275, 10.55782},
+	{132.57425, -112.00143, 10.422864},
+	{131.87598, -110.98355, 10.281354},
+	{-144.38202, 43.360752, -8.528323},
+	{-36.647106, -18.140816, -0.5289968},
+	{23.58806, -51.73822, 3.5839157},
+	{60.485092, -71.866516, 5.9098573},
+	{84.15467, -84.62268, 7.345552},
+	{97.53415, -91.59678, 8.106759},
+	{104.149506, -94.75335, 8.433666},
+	{107.92998, -96.31903, 8.581919},
+	{110.474815, -97.203255, 8.654517},
+	{111.99557, -97.520485, 8.664048},
+	{113.08847, -97.61262, 8.649103},
+	{113.60978, -97.391846, 8.599365},
+	{113.834175, -97.015945, 8.532621},
+	{113.99044, -96.617584, 8.463969},
+	{114.07143, -96.19136, 8.392717},
+	{113.99527, -95.68751, 8.313012},
+	{-108.32185, 32.107174, -7.8227205},
+	{-36.073734, -10.024885, -2.213474},
+	{10.249068, -36.812595, 1.2556509},
+	{40.64599, -54.042828, 3.3892617},
+	{61.556038, -65.7057, 4.785139},
+	{75.68569, -73.44764, 5.6887903},
+	{84.44827, -78.09667, 6.2191257},
+	{89.50145, -80.598785, 6.4933796},
+	{92.730774, -82.049545, 6.643123},
+	{95.01048, -82.95884, 6.7292595},
+	{96.552315, -83.44757, 6.766046},
+	{97.68194, -83.70658, 6.7761736},
+	{98.44833, -83.76278, 6.7626953},
+	{98.90081, -83.64366, 6.72875},
+	{99.19874, -83.44358, 6.6856046},
+	{99.4205, -83.20887, 6.638798},
+	{-93.70279, 30.494606, -8.183922},
+	{-40.094025, -1.2744308, -3.8732204},
+	{-2.7889786, -23.315466, -0.92706853},
+	{23.739563, -38.869785, 1.1115292},
+	{42.833885, -49.908394, 2.5184722},
+	{56.791218, -57.859432, 3.5069625},
+	{66.68581, -63.39596, 4.1815524},
+	{73.33424, -67.00617, 4.6115575},
+	{77.57658, -69.18836, 4.8629036},
+	{80.477036, -70.578575, 5.0162616},
+	{82.56965, -71.49546, 5.111558},
+	{84.13996, -72.10907, 5.169927},
+	{85.290985, -72.47974, 5.1987786},
+	{86.173164, -72.69735, 5.2092037},
+	{86.80021, -72.7689, 5.2019696},
+	{87.23553, -72.73201, 5.181676},
+	{-87.76027, 32.350105, -8.88948},
+	{-45.439568, 6.9517303, -5.389437},
+	{-14.368474, -11.67239, -2.8484542},
+	{8.909607, -25.58245, -0.97066134},
+	{26.590105, -36.088783, 0.4303467},
+	{40.11212, -44.049828, 1.4752313},
+	{50.443623, -50.05645, 2.249609},
+	{58.111526, -54.440342, 2.8051245},
+	{63.616657, -57.51012, 3.1868129},
+	{67.41991, -59.547466, 3.4339588},
+	{70.12082, -60.91857, 3.5951293},
+	{72.132935, -61.875828, 3.7032924},
+	{73.70098, -62.56851, 3.777806},
+	{74.905876, -63.045166, 3.8249128},
+	{75.84538, -63.36522, 3.8522508},
+	{76.58356, -63.567516, 3.864795},
+	{-85.80828, 35.509407, -9.692937},
+	{-50.98322, 14.393581, -6.745419},
+	{-24.393667, -1.7224596, -4.5121093},
+	{-3.7486923, -14.216459, -2.7932746},
+	{12.48832, -24.015501, -1.455194},
+	{25.377089, -31.760588, -0.40592355},
+	{35.661793, -37.90259, 0.41882923},
+	{43.83633, -42.74069, 1.061719},
+	{50.175533, -46.44159, 1.5472931},
+	{54.970356, -49.185974, 1.90214},
+	{58.505157, -51.15095, 2.1517076},
+	{61.104313, -52.53823, 2.3238885},
+	{63.095497, -53.551983, 2.4463718},
+	{64.65607, -54.302418, 2.534018},
+	{65.91302, -54.868507, 2.5974193},
+	{66.9147, -55.279884, 2.6405237},
+	{-85.829605, 39.102184, -10.499793},
+	{-56.313637, 21.050917, -7.9524827},
+	{-33.08797, 6.845506, -5.959017},
+	{-14.555012, -4.481592, -4.378088},
+	{0.40004843, -13.607606, -3.1111841},
+	{12.574232, -21.018082, -2.0879612},
+	{22.548538, -27.067867, -1.2572122},
+	{30.75123, -32.019047, -0.5812516},
+	{37.480877, -36.05418, -0.03385318},
+	{42.91232, -39.279068, 0.4003184},
+	{47.20352, -41.790134, 0.73519367},
+	{50.544174, -43.70524, 0.98755664},
+	{53.099613, -45.127625, 1.1720229},
+	{55.08565, -46.193497, 1.3075964},
+	{56.673286, -47.01101, 1.4092821},
+	{57.963478, -47.6439, 1.4858913},
+	{-86.86038, 42.751812, -11.272603},
+	{-61.28804, 26.996931, -9.028399},
+	{-40.67722, 14.294141, -7.22693},
+	{-23.868122, 3.9368372, -5.764333},
+	{-10.025341, -4.5853615, -4.565845},
+	{1.4655386, -11.648814, -3.5765247},
+	{11.064441, -17.53594, -2.7552805},
+	{19.12052, -22.461615, -2.070948},
+	{25.900824, -26.590477, -1.4997178},
+	{31.602262, -30.043869, -1.0240713},
+	{36.34955, -32.897938, -0.6329829},
+	{40.238525, -35.21104, -0.31800896},
+	{43.38927, -37.05779, -0.068479836},
+	{45.90664, -38.50363, 0.12492071},
+	{47.9099, -39.62388, 0.27284527},
+	{49.529167, -40.50139, 0.38696587},
+	{-88.40559, 46.291325, -11.997502},
+	{-65.87131, 32.319035, -9.990826},
+	{-47.350548, 20.82898, -8.346565},
+	{-31.973803, 11.288655, -6.986003},
+	{-19.098122, 3.303305, -5.850958},
+	{-8.239421, -3.4250054, -4.897649},
+	{0.97252095, -9.124656, -4.092614},
+	{8.824656, -13.9730425, -3.4099352},
+	{15.541791, -18.109411, -2.829322},
+	{21.301088, -21.643671, -2.3348005},
+	{26.2399, -24.661057, -1.914008},
+	{30.452644, -27.219784, -1.5584997},
+	{34.006744, -29.361097, -1.2622938},
+	{36.972637, -31.128609, -1.0191063},
+	{39.430534, -32.57268, -0.8217201},
+	{41.44615, -33.73463, -0.6642322},
+	{-90.19786, 49.649357, -12.670911},
+	{-70.07227, 37.09988, -10.855432},
+	{-53.260143, 26.609478, -9.342347},
+	{-39.092266, 17.766409, -8.070477},
+	{-27.062738, 10.25868, -6.9935856},
+	{-16.783026, 3.8461556, -6.0761786},
+	{-7.9505677, -1.658568, -5.2906275},
+	{-0.32686293, -6.4035206, -4.615164},
+	{6.278089, -10.506853, -4.032454},
+	{12.016948, -14.063664, -3.52858},
+	{17.013012, -17.150871, -3.0923085},
+	{21.365356, -19.830326, -2.7146258},
+	{25.148397, -22.148344, -2.3887904},
+	{28.414757, -24.137415, -2.1100764},
+	{31.20966, -25.825468, -1.8744342},
+	{33.58507, -27.245104, -1.6771568},
+	{-92.08795, 52.800076, -13.293794},
+	{-73.91688, 41.412067, -11.635588},
+	{-58.527607, 31.76016, -10.233696},
+	{-45.39373, 23.519106, -9.039564},
+	{-34.109688, 16.437803, -8.015795},
+	{-24.358656, 10.319686, -7.133181},
+	{-15.890061, 5.0089765, -6.3686304},
+	{-8.503519, 0.38086337, -5.70368},
+	{-2.0372071, -3.6656353, -5.123427},
+	{3.6406796, -7.2129116, -4.6157355},
+	{8.638264, -10.328661, -4.1706553},
+	{13.044653, -13.068755, -3.7799902},
+	{16.933289, -15.47928, -3.436992},
+	{20.36324, -17.597284, -3.1362455},
+	{23.377949, -19.449854, -2.873795},
+	{26.011696, -21.05827, -2.6465402},
+	{-93.99096, 55.739758, -13.869052},
+	{-77.436424, 45.317593, -12.342538},
+	{-63.25044, 36.379333, -11.036163},
+	{-51.011204, 28.663385, -9.9107065},
+	{-40.3884, 21.964483, -8.935459},
+	{-31.120115, 16.119568, -8.086078},
+	{-22.996384, 10.99767, -7.343049},
+	{-15.847187, 6.4925475, -6.6905756},
+	{-9.533612, 2.5172415, -6.1157537},
+	{-3.9412415, -0.99999374, -5.6079555},
+	{1.0248468, -4.118774, -5.1583676},
+	{5.4438543, -6.888917, -4.7596374},
+	{9.382207, -9.35226, -4.4056015},
+	{12.895677, -11.543963, -4.0910873},
+	{16.030785, -13.493372, -3.811786},
+	{18.824661, -15.223856, -3.5642707},
+	{-95.858444, 58.475323, -14.400295},
+	{-80.66245, 48.868847, -12.985707},
+	{-67.5074, 40.545536, -11.76232},
+	{-56.04997, 33.291718, -10.69797},
+	{-46.017376, 26.937323, -9.767111},
+	{-37.190514, 21.345507, -8.94922},
+	{-29.391615, 16.405043, -8.227652},
+	{-22.475143, 12.024713, -7.5887785},
+	{-16.320961, 8.129098, -7.0213532},
+	{-10.829159, 4.6553717, -6.5160227},
+	{-5.91607, 1.5508422, -6.0649557},
+	{-1.5112349, -1.2289331, -5.6615562},
+	{2.4450386, -3.7216568, -5.3002396},
+	{6.003504, -5.959427, -4.976257},
+	{9.207435, -7.9696403, -4.6855607},
+	{12.093685, -9.775649, -4.4247074},
+	{-97.66327, 61.018497, -14.891258},
+	{-83.62455, 52.11001, -13.573006},
+	{-71.36269, 44.32225, -12.42243},
+	{-60.594387, 37.478348, -11.412809},
+	{-51.091663, 31.435734, -10.522645},
+	{-42.669292, 26.078371, -9.734467},
+	{-35.17537, 21.310957, -9.033951},
+	{-28.484324, 17.054564, -8.409257},
+	{-22.491539, 13.243341, -7.8505197},
+	{-17.109257, 9.821965, -7.3494644},
+	{-12.2634325, 6.743683, -6.899109},
+	{-7.891157, 3.9687166, -6.4935217},
+	{-3.938758, 1.4630805, -6.127642},
+	{-0.36021155, -0.80240536, -5.797131},
+	{2.884098, -2.8528903, -5.4982533},
+	{5.828371, -4.710109, -5.2277856},
+	{-99.390976, 63.382843, -15.345533},
+	{-86.34961, 55.07839, -14.111099},
+	{-74.86911, 47.761356, -13.024955},
+	{-64.712875, 41.283592, -12.06464},
+	{-55.68838, 35.52436, -11.211882},
+	{-47.637558, 30.384361, -10.451677},
+	{-40.429546, 25.781275, -9.7716055},
+	{-33.955204, 21.646334, -9.161308},
+	{-28.122862, 17.921688, -8.612084},
+	{-22.855028, 14.558372, -8.116578},
+	{-18.085842, 11.514702, -7.6685386},
+	{-13.758968, 8.754975, -7.2626166},
+	{-9.826016, 6.2484813, -6.8942194},
+	{-6.2452183, 3.9686804, -6.559381},
+	{-2.9803767, 1.8925402, -6.2546644},
+	{-5.3900603e-06, 3.8933763e-06, -5.977081},
+	{-338.80518, 147.65067, -18.550173},
+	{-59.12066, -1.7676119, 0.17717679},
+	{31.450941, -49.747406, 5.7271194},
+	{70.729256, -70.801346, 8.129197},
+	{84.22421, -77.64518, 8.886419},
+	{89.20589, -79.70216, 9.087247},
+	{91.445564, -80.23734, 9.112352},
+	{92.51749, -80.147705, 9.066435},
+	{93.00088, -79.7624, 8.987852},
+	{93.06782, -79.17481, 8.887239},
+	{92.855484, -78.460045, 8.773202},
+	{92.49328, -77.690704, 8.654186},
+	{92.02157, -76.88708, 8.532431},
+	{91.45752, -76.05606, 8.408597},
+	{90.82858, -75.211296, 8.284189},
+	{90.1641, -74.36814, 8.160953},
+	{-117.502686, 40.822083, -5.9855328},
+	{-23.335672, -11.5605755, 0.68245375},
+	{22.130856, -36.567062, 3.7015417},
+	{47.182133, -50.315174, 5.324353},
+	{60.42732, -57.46405, 6.154562},
+	{66.734436, -60.65916, 6.5127187},
+	{70.12672, -62.188663, 6.672702},
+	{72.116905, -62.922523, 6.7384577},
+	{73.38052, -63.251957, 6.756754},
+	{74.18855, -63.333065, 6.7461767},
+	{74.69979, -63.257286, 6.7176056},
+	{74.986374, -63.065144, 6.675796},
+	{75.11244, -62.79312, 6.625064},
+	{75.12487, -62.46784, 6.5685587},
+	{75.0499, -62.103756, 6.5079646},
+	{74.90494, -61.71018, 6.4443502},
+	{-68.416245, 20.117678, -3.862454},
+	{-16.50503, -9.573269, 0.008840765},
+	{13.463536, -26.561531, 2.148026},
+	{32.096283, -37.048637, 3.4368582},
+	{43.91516, -43.637814, 4.2340393},
+	{50.987976, -47.485283, 4.691245},
+	{55.152306, -49.639835, 4.9399996},
+	{57.79791, -50.911064, 5.080563},
+	{59.567013, -51.674812, 5.1592584},
+	{60.814125, -52.139652, 5.201747},
+	{61.714394, -52.408413, 5.2206364},
+	{62.372334, -52.542374, 5.2234254},
+	{62.85047, -52.577995, 5.214555},
+	{63.18914, -52.538612, 5.196855},
+	{63.420464, -52.442856, 5.1725874},
+	{63.56846, -52.304607, 5.143436},
+	{-51.37702, 14.88206, -3.5470061},
+	{-16.599573, -5.4242134, -0.8396037},
+	{5.8596716, -18.473629, 0.8633066},
+	{20.968317, -27.187437, 1.9768897},
+	{31.425013, -33.170197, 2.729537},
+	{38.60787, -37.22876, 3.2332199},
+	{43.37151, -39.857628, 3.554207},
+	{46.544586, -41.543564, 3.7555544},
+	{48.761414, -42.663433, 3.8854759},
+	{50.365025, -43.42152, 3.9699566},
+	{51.564533, -43.94275, 4.024837},
+	{52.48603, -44.30254, 4.0596547},
+	{53.20394, -44.545284, 4.0800047},
+	{53.768112, -44.700676, 4.0896206},
+	{54.211884, -44.788486, 4.0909805},
+	{54.559235, -44.82289, 4.085855},
+	{-44.56308, 14.241414, -3.7396743},
+	{-18.75225, -1.0681812, -1.6604743},
+	{-0.7749674, -11.707773, -0.2348816},
+	{12.087267, -19.283918, 0.7657714},
+	{21.481567, -24.781021, 1.4819623},
+	{28.414408, -28.803885, 1.9998808},
+	{33.47508, -31.703672, 2.3689015},
+	{37.10159, -33.740425, 2.6246083},
+	{39.713184, -35.165485, 2.800521},
+	{41.653236, -36.18637, 2.9239883},
+	{43.13218, -36.93048, 3.011702},
+	{44.285828, -37.480175, 3.0744092},
+	{45.205307, -37.890812, 3.1193144},
+	{45.949745, -38.198273, 3.151074},
+	{46.558727, -38.426548, 3.1727915},
+	{47.0604, -38.59265, 3.186664},
+	{-41.87475, 15.244654, -4.0949373},
+	{-21.48416, 2.998926, -2.4064806},
+	{-6.5120673, -5.984624, -1.1792748},
+	{4.7162294, -12.705002, -0.2698574},
+	{13.270209, -17.803225, 0.41341123},
+	{19.858763, -21.706884, 0.93159455},
+	{24.950249, -24.699669, 1.3252054},
+	{28.852234, -26.967428, 1.6206263},
+	{31.813147, -28.660358, 1.8387839},
+	{34.0647, -29.919518, 1.9989648},
+	{35.811134, -30.870127, 2.118101},
+	{37.193325, -31.598719, 2.2078273},
+	{38.306213, -32.163673, 2.2759612},
+	{39.216938, -32.60632, 2.3280194},
+	{39.97298, -32.955902, 2.3678966},
+	{40.607544, -33.232853, 2.3983066},
+	{-41.078575, 16.860954, -4.4954987},
+	{-24.283598, 6.6717997, -3.072766},
+	{-11.460916, -1.1057914, -1.9941835},
+	{-1.5009958, -7.1391983, -1.1631019},
+	{6.3395724, -11.877167, -0.51482993},
+	{12.573841, -15.630483, -0.004748086},
+	{17.563278, -18.618887, 0.39860058},
+	{21.560535, -20.996328, 0.71722907},
+	{24.745256, -22.872332, 0.96676016},
+	{27.268183, -24.339146, 1.1602154},
+	{29.267138, -25.481627, 1.3094256},
+	{30.869385, -26.378664, 1.425287},
+	{32.17358, -27.091597, 1.5162271},
+	{33.2499, -27.6641, 1.5882233},
+	{34.14943, -28.127989, 1.6456175},
+	{34.9101, -28.50691, 1.6916282},
+	{-41.215252, 18.677378, -4.896644},
+	{-26.965488, 9.958501, -3.6660929},
+	{-15.753443, 3.0970407, -2.7028031},
+	{-6.8045306, -2.376167, -1.9383355},
+	{0.4209188, -6.7890134, -1.3250469},
+	{6.3083377, -10.376368, -0.8289406},
+	{11.138612, -13.309865, -0.4252628},
+	{15.119077, -15.716498, -0.09575572},
+	{18.401577, -17.689386, 0.17293805},
+	{21.099371, -19.298029, 0.39076424},
+	{23.308662, -20.601728, 0.5661608},
+	{25.116917, -21.654745, 0.70679307},
+	{26.60559, -22.507948, 0.819802},
+	{27.84456, -23.205181, 0.9113139},
+	{28.887325, -23.780064, 0.98601294},
+	{29.774, -24.25783, 1.047405},
+	{-41.827198, 20.515951, -5.280787},
+	{-29.467346, 12.898117, -4.195636},
+	{-19.50633, 6.75616, -3.3244069},
+	{-11.38106, 1.7469654, -2.6167269},
+	{-4.686586, -2.3769019, -2.0363927},
+	{0.8745586, -5.797688, -1.5568281},
+	{5.524873, -8.65205, -1.1581702},
+	{9.433243, -11.043954, -0.8253543},
+	{12.72905, -13.053199, -0.5468508},
+	{15.510936, -14.740684, -0.31388298},
+	{17.854876, -16.153332, -0.119704},
+	{19.825228, -17.331036, 0.041398842},
+	{21.48053, -18.310299, 0.17462973},
+	{22.874765, -19.12499, 0.2847974},
+	{24.057056, -19.806105, 0.37628797},
+	{25.068308, -20.379532, 0.45275703},
+	{-42.679802, 22.298216, -5.641508},
+	{-31.77558, 15.53471, -4.670211},
+	{-22.814106, 9.9729805, -3.8742466},
+	{-15.372646, 5.354075, -3.215381},
+	{-9.1390915, 1.4863257, -2.6653967},
+	{-3.8787389, -1.7747467, -2.2030866},
+	{0.58774537, -4.5397954, -1.8122542},
+	{4.3992906, -6.89473, -1.48036},
+	{7.6647534, -8.906999, -1.1975853},
+	{10.470071, -10.62994, -0.9561853},
+	{12.883012, -12.105657, -0.75006026},
+	{14.957137, -13.367442, -0.5743984},
+	{16.737518, -14.4433775, -0.4251521},
+	{18.264936, -15.359, -0.29865283},
+	{19.576893, -16.137926, -0.19151779},
+	{20.707977, -16.802055, -0.10061749},
+	{-43.6466, 23.990953, -5.977235},
+	{-33.896294, 17.90914, -5.097529},
+	{-25.751766, 12.825447, -4.3643017},
+	{-18.887197, 8.539347, -3.7477903},
+	{-13.056642, 4.8991265, -3.2255366},
+	{-8.0714445, 1.7881557, -2.7803197},
+	{-3.784842, -0.8844969, -2.3987474},
+	{-0.08121824, -3.1906114, -2.070274},
+	{3.1315043, -5.1874657, -1.7865041},
+	{5.927332, -6.9211793, -1.5406934},
+	{8.366144, -8.429129, -1.3273885},
+	{10.496467, -9.741634, -1.142174},
+	{12.357589, -10.883266, -0.9814801},
+	{13.982437, -11.8746395, -0.8423172},
+	{15.400409, -12.734235, -0.7220127},
+	{16.638597, -13.479167, -0.61809623},
+	{-44.657112, 25.58242, -6.288519},
+	{-35.843075, 20.056963, -5.4841194},
+	{-28.37878, 15.374103, -4.8040323},
+	{-22.007551, 11.375198, -4.2246017},
+	{-16.531933, 7.937956, -3.7276328},
+	{-11.797864, 4.966759, -3.2989328},
+	{-7.6836185, 2.3859096, -2.927291},
+	{-4.0919294, 0.13480023, -2.6037512},
+	{-0.9442846, -1.8355803, -2.3210855},
+	{1.8232551, -3.5652182, -2.0734093},
+	{4.2631636, -5.086984, -1.8558953},
+	{6.4187603, -6.428054, -1.6645594},
+	{8.325931, -7.6109695, -1.496103},
+	{10.014389, -8.654411, -1.3477981},
+	{11.509089, -9.5740795, -1.217355},
+	{12.8319845, -10.383826, -1.1027583},
+	{-45.671364, 27.071257, -6.576811},
+	{-37.63168, 22.008375, -5.835446},
+	{-30.742609, 17.666588, -5.200983},
+	{-24.798187, 13.918102, -4.6542835},
+	{-19.637344, 10.662795, -4.180383},
+	{-15.132535, 7.8212247, -3.7674336},
+	{-11.181611, 5.3296514, -3.4059477},
+	{-7.7019277, 3.1364107, -3.0882504},
+	{-4.6260023, 1.199241, -2.808075},
+	{-1.8982724, -0.51671386, -2.5602632},
+	{0.5273552, -2.040417, -2.3405356},
+	{2.6893637, -3.396088, -2.1453204},
+	{4.620067, -4.604092, -1.9716191},
+	{6.3466988, -5.6816154, -1.8169051},
+	{7.8922505, -6.6431813, -1.6790468},
+	{9.276215, -7.5011144, -1.5562379},
+	{-46.666637, 28.46107, -6.8438807},
+	{-39.277718, 23.788809, -6.1560736},
+	{-32.881474, 19.740873, -5.5612335},
+	{-27.310007, 16.212723, -5.043641},
+	{-22.43011, 13.121273, -4.590827},
+	{-18.13492, 10.399749, -4.192788},
+	{-14.337825, 7.993936, -3.8414187},
+	{-10.967979, 5.859411, -3.5300872},
+	{-7.966943, 3.9594684, -3.2533245},
+	{-5.2861357, 2.2635458, -3.0065842},
+	{-2.8848987, 0.7460234, -2.786061},
+	{-0.7289713, -0.61472076, -2.5885487},
+	{1.2106705, -1.8370299, -2.4113312},
+	{2.958739, -2.9365444, -2.252096},
+	{4.536361, -3.926652, -2.1088662},
+	{5.961648, -4.8188424, -1.9799482},
+	{-47.630276, 29.757687, -7.091546},
+	{-40.795723, 25.419653, -6.4498253},
+	{-34.82646, 21.627604, -5.8897376},
+	{-29.583738, 18.294863, -5.3982024},
+	{-24.95606, 15.351674, -4.96471},
+	{-20.852947, 12.741331, -4.580732},
+	{-17.200285, 10.417304, -4.2392807},
+	{-13.936851, 8.3411, -3.9345863},
+	{-11.011686, 6.480624, -3.661845},
+	{-8.38207, 4.80893, -3.4170291},
+	{-6.01196, 3.303249, -3.1967409},
+	{-3.870756, 1.9442198, -2.9980953},
+	{-1.9323403, 0.71529615, -2.8186297},
+	{-0.17431055, -0.3977359, -2.6562316},
+	{1.4226376, -1.4071404, -2.5090806},
+	{2.8752034, -2.3235395, -2.375602},
+	{-48.5556, 30.967762, -7.321547},
+	{-42.198826, 26.918951, -6.719917},
+	{-36.603096, 23.351791, -6.190573},
+	{-31.652365, 20.193573, -5.722505},
+	{-27.252325, 17.385124, -5.306766},
+	{-23.325655, 14.877843, -4.936019},
+	{-19.808401, 12.631477, -4.6041965},
+	{-16.647284, 10.612436, -4.306243},
+	{-13.79758, 8.792484, -4.0379143},
+	{-11.221517, 7.147737, -3.7956257},
+	{-8.886996, 5.6578684, -3.5763307},
+	{-6.7665815, 4.305481, -3.3774254},
+	{-4.8367066, 3.0756094, -3.196672},
+	{-3.0770297, 1.9553187, -3.0321388},
+	{-1.4699129, 0.933379, -2.8821516},
+	{-2.6640603e-06, 1.9246584e-06, -2.7452514},
+	{-207.42786, 89.888626, -11.091157},
+	{-32.442997, -3.9385445, 0.7113282},
+	{26.211773, -35.9212, 4.5969257},
+	{50.020123, -49.054096, 6.1897507},
+	{58.471535, -53.45131, 6.705972},
+	{62.076324, -55.053135, 6.8762465},
+	{63.958942, -55.676548, 6.926374},
+	{64.99621, -55.83246, 6.919837},
+	{65.55799, -55.73567, 6.8832707},
+	{65.829956, -55.493057, 6.8298764},
+	{65.92192, -55.167385, 6.7673817},
+	{65.89512, -54.79282, 6.6999483},
+	{65.78577, -54.388874, 6.629912},
+	{65.619446, -53.96906, 6.5588737},
+	{65.41474, -53.54295, 6.487958},
+	{65.18432, -53.11679, 6.417878},
+	{-70.8999, 23.955673, -3.339154},
+	{-11.934094, -9.028949, 0.8896927},
+	{17.166655, -25.377253, 2.936462},
+	{32.897247, -34.2397, 4.037732},
+	{41.1201, -38.792618, 4.5966806},
+	{45.427338, -41.058598, 4.8670464},
+	{47.96423, -42.288486, 5.006837},
+	{49.611694, -43.00203, 5.081876},
+	{50.74043, -43.41841, 5.119908},
+	{51.537533, -43.648163, 5.1349053},
+	{52.11116, -43.75506, 5.1349187},
+	{52.528492, -43.778557, 5.1249185},
+	{52.833416, -43.74429, 5.1081257},
+	{53.055004, -43.669067, 5.0866423},
+	{53.21331, -43.564342, 5.0618916},
+	{53.322937, -43.43835, 5.0348926},
+	{-41.0494, 11.437071, -2.0642235},
+	{-8.65875, -7.168925, 0.37522435},
+	{10.298753, -18.0569, 1.7763604},
+	{22.092224, -24.824774, 2.6384578},
+	{29.513086, -29.051691, 3.1722841},
+	{34.088043, -31.602606, 3.4902008},
+	{37.008255, -33.172226, 3.681852},
+	{38.9997, -34.191067, 3.802805},
+	{40.438496, -34.883812, 3.8820615},
+	{41.52081, -35.3679, 3.934769},
+	{42.358067, -35.710052, 3.9695208},
+	{43.01974, -35.951843, 3.9916656},
+	{43.55174, -36.120697, 4.0047264},
+	{43.985638, -36.23543, 4.0111165},
+	{44.343773, -36.309303, 4.012533},
+	{44.642292, -36.35185, 4.0101933},
+	{-30.890985, 8.436107, -1.902643},
+	{-9.226248, -4.251659, -0.20498614},
+	{4.842606, -12.4827175, 0.8808283},
+	{14.370368, -18.044924, 1.606909},
+	{20.96622, -21.877178, 2.1031125},
+	{25.525398, -24.498682, 2.4396317},
+	{28.672358, -26.274546, 2.6650016},
+	{30.910828, -27.504663, 2.8188207},
+	{32.570423, -28.387222, 2.9272008},
+	{33.848476, -29.041477, 3.0058348},
+	{34.86301, -29.53892, 3.0641196},
+	{35.687275, -29.923943, 3.1078808},
+	{36.36948, -30.22576, 3.1409473},
+	{36.942947, -30.464539, 3.1659591},
+	{37.431473, -30.654675, 3.1847973},
+	{37.852524, -30.806704, 3.198837},
+	{-26.965446, 8.2314825, -2.0497525},
+	{-10.87883, -1.3309628, -0.748159},
+	{0.34074706, -7.9960885, 0.1496787},
+	{8.402132, -12.775057, 0.78758675},
+	{14.315506, -16.267857, 1.2502611},
+	{18.694456, -18.838356, 1.5883745},
+	{21.935553, -20.721424, 1.8341643},
+	{24.347633, -22.101507, 2.0126376},
+	{26.182724, -23.130922, 2.1443145},
+	{27.618708, -23.917934, 2.2437365},
+	{28.772867, -24.534199, 2.320509},
+	{29.722254, -25.0269, 2.3809478},
+	{30.518217, -25.427498, 2.429258},
+	{31.196142, -25.757683, 2.4683337},
+	{31.78132, -26.032948, 2.5002396},
+	{32.292362, -26.264683, 2.5264916},
+	{-25.536955, 9.014049, -2.2935176},
+	{-12.808611, 1.3572226, -1.2363043},
+	{-3.4611044, -4.265013, -0.46597117},
+	{3.5606477, -8.482403, 0.10773737},
+	{8.926631, -11.696618, 0.5421156},
+	{13.075889, -14.171585, 0.8745692},
+	{16.301428, -16.083473, 1.1298529},
+	{18.813147, -17.55866, 1.3255494},
+	{20.782148, -18.700903, 1.4759635},
+	{22.350365, -19.597012, 1.5929961},
+	{23.624762, -20.312782, 1.685638},
+	{24.681095, -20.894962, 1.7602638},
+	{25.572598, -21.376467, 1.821354},
+	{26.336855, -21.78056, 1.8720706},
+	{27.000862, -22.123957, 1.9146819},
+	{27.58449, -22.418959, 1.9508549},
+	{-25.246433, 10.157768, -2.5628557},
+	{-14.7404375, 3.7755094, -1.670947},
+	{-6.7204103, -1.0975106, -0.9939495},
+	{-0.48589516, -4.882685, -0.47100255},
+	{4.4306483, -7.8623137, -0.06151039},
+	{8.3508005, -10.231207, 0.26241925},
+	{11.500545, -12.1265955, 0.5203393},
+	{14.041476, -13.646734, 0.7261709},
+	{16.097036, -14.866886, 0.8905051},
+	{17.77024, -15.850243, 1.0221759},
+	{19.148022, -16.650494, 1.1286542},
+	{20.299461, -17.310514, 1.2158877},
+	{21.276423, -17.86259, 1.2883455},
+	{22.117254, -18.330643, 1.3493327},
+	{22.850323, -18.732384, 1.4012935},
+	{23.496778, -19.081028, 1.4460489},
+	{-25.513197, 11.414208, -2.8310637},
+	{-16.577934, 5.941016, -2.0582693},
+	{-9.548929, 1.63367, -1.4529054},
+	{-3.9357462, -1.8049431, -0.9717533},
+	{0.60204566, -4.5817037, -0.5848342},
+	{4.3069334, -6.844411, -0.27081087},
+	{7.355361, -8.700901, -0.014173332},
+	{9.8778105, -10.231099, 0.19653067},
+	{11.972468, -11.495255, 0.36989957},
+	{13.717345, -12.541393, 0.512755},
+	{15.178555, -13.410429, 0.630882},
+	{16.412762, -14.137634, 0.72924966},
+	{17.466839, -14.75232, 0.81197625},
+	{18.377773, -15.277684, 0.8823143},
+	{19.174088, -15.731651, 0.94277394},
+	{19.8777, -16.128014, 0.9952828},
+	{-26.061495, 12.677419, -3.0878491},
+	{-18.291388, 7.8838787, -2.404932},
+	{-12.030648, 4.019306, -1.856425},
+	{-6.9216037, 0.8656911, -1.4104105},
+	{-2.7079606, -1.7336202, -1.0440297},
+	{0.79804444, -3.8937008, -0.74054587},
+	{3.7366111, -5.700693, -0.48746938},
+	{6.214132, -7.2201214, -0.27532965},
+	{8.312423, -8.502481, -0.09685063},
+	{10.09541, -9.587305, 0.05364661},
+	{11.615179, -10.5069, 0.18078762},
+	{12.916354, -11.289078, 0.28854117},
+	{14.037691, -11.958117, 0.38036188},
+	{15.012197, -12.534781, 0.4591986},
+	{15.867008, -13.036198, 0.5274792},
+	{16.623863, -13.476113, 0.58715},
+	{-26.750536, 13.901359, -3.3295987},
+	{-19.877216, 9.634538, -2.7169142},
+	{-14.229596, 6.126281, -2.2147121},
+	{-9.538259, 3.2114775, -1.7986791},
+	{-5.604987, 0.76834923, -1.4509344},
+	{-2.2811375, -1.2946521, -1.1580737},
+	{0.54664886, -3.047507, -0.90987855},
+	{2.9661047, -4.544499, -0.6984439},
+	{5.0460134, -5.828277, -0.51757485},
+	{6.840919, -6.9327307, -0.3623614},
+	{8.394672, -7.885159, -0.22885807},
+	{9.74363, -8.708258, -0.11379293},
+	{10.91918, -9.421702, -0.014336625},
+	{11.948872, -10.042839, 0.0720003},
+	{12.856663, -10.586815, 0.1473878},
+	{13.662893, -11.066532, 0.21367186},
+	{-27.504759, 15.067021, -3.555561},
+	{-21.341991, 11.220092, -2.9992847},
+	{-16.195107, 8.004966, -2.5355744},
+	{-11.855744, 5.2933307, -2.1454368},
+	{-8.167167, 2.9884434, -1.8145864},
+	{-5.00948, 1.0161376, -1.5320996},
+	{-2.2896085, -0.68129116, -1.2894971},
+	{0.06566885, -2.1493342, -1.0801102},
+	{2.1146412, -3.4242806, -0.89863086},
+	{3.9042103, -4.535387, -0.7407892},
+	{5.4724913, -5.506476, -0.60311556},
+	{6.8508205, -6.3571696, -0.48275787},
+	{8.065532, -7.1039996, -0.37731823},
+	{9.139479, -7.761362, -0.28471217},
+	{10.092883, -8.342045, -0.20309141},
+	{10.943592, -8.857381, -0.13082021},
+	{-28.282282, 16.168129, -3.7662196},
+	{-22.695822, 12.663632, -3.2562587},
+	{-17.965647, 9.694027, -2.8250854},
+	{-13.927049, 7.157438, -2.4575467},
+	{-10.453748, 4.9755964, -2.1420274},
+	{-7.447493, 3.0874796, -1.8694906},
+	{-4.8308268, 1.4448801, -1.6328131},
+	{-2.541956, 0.009263494, -1.4263121},
+	{-0.5310488, -1.2505054, -1.2454057},
+	{1.2424746, -2.3598301, -1.0863621},
+	{2.8119628, -3.3396254, -0.9461149},
+	{4.205061, -4.2072606, -0.82212186},
+	{5.4449105, -4.9773035, -0.7122545},
+	{6.551188, -5.6621656, -0.61470217},
+	{7.541039, -6.2726955, -0.5278848},
+	{8.429708, -6.8185725, -0.45039454},
+	{-29.05951, 17.204494, -3.9625633},
+	{-23.949543, 13.984527, -3.491325},
+	{-19.571602, 11.223653, -3.0880396},
+	{-15.793056, 8.839514, -2.740403},
+	{-12.510531, 6.7677913, -2.4388254},
+	{-9.642398, 4.9575987, -2.1757348},
+	{-7.123432, 3.3681996, -1.9450812},
+	{-4.900958, 1.9666343, -1.7419783},
+	{-2.9320185, 0.72597337, -1.5624409},
+	{-1.1812652, -0.37598145, -1.4031895},
+	{0.38063046, -1.3576809, -1.261503},
+	{1.7781938, -2.234581, -1.135105},
+	{3.0320904, -3.0197277, -1.0220777},
+	{4.1598783, -3.7242248, -0.9207903},
+	{5.1766477, -4.357634, -0.8298411},
+	{6.0955977, -4.928341, -0.74800205},
+	{-29.823166, 18.178791, -4.1457477},
+	{-25.113537, 15.198955, -3.7073762},
+	{-21.037268, 12.6177845, -3.3282769},
+	{-17.485857, 10.367652, -2.9983053},
+	{-14.3735075, 8.394989, -2.7094407},
+	{-11.631583, 6.656863, -2.4552662},
+	{-9.204584, 5.1185, -2.2305934},
+	{-7.0472045, 3.7514603, -2.0311856},
+	{-5.1221232, 2.532279, -1.8535535},
+	{-3.3983498, 1.4414366, -1.6947982},
+	{-1.8499516, 0.46257272, -1.5524939},
+	{-0.4550743, -0.41812316, -1.4245958},
+	{0.8048257, -1.2123883, -1.3093684},
+	{1.9455881, -1.9302653, -1.2053285},
+	{2.9808397, -2.5804079, -1.1111999},
+	{3.9224072, -3.1703405, -1.025874},
+	{-30.565968, 19.094929, -4.316938},
+	{-26.197289, 16.320454, -3.906822},
+	{-22.382305, 13.895665, -3.54891},
+	{-19.031025, 11.764284, -3.2347279},
+	{-16.071379, 9.8811655, -2.9574902},
+	{-13.445043, 8.209715, -2.7117047},
+	{-11.104356, 6.7199764, -2.4928832},
+	{-9.01003, 5.3872194, -2.2973254},
+	{-7.1294155, 4.1908565, -2.1219554},
+	{-5.4351754, 3.1136227, -1.9641978},
+	{-3.90426, 2.1409388, -1.8218801},
+	{-2.5171077, 1.2604117, -1.6931586},
+	{-1.2570146, 0.46144283, -1.5764581},
+	{-0.10963084, -0.26508728, -1.4704249},
+	{0.93744355, -0.9270708, -1.3738887},
+	{1.8949896, -1.5313807, -1.2858318},
+	{-31.28418, 19.957247, -4.4772396},
+	{-27.20928, 17.360395, -4.0916815},
+	{-23.622807, 15.072964, -3.752492},
+	{-20.449217, 13.0475645, -3.452511},
+	{-17.627335, 11.245756, -3.1859396},
+	{-15.107128, 9.636057, -2.948035},
+	{-12.84733, 8.192456, -2.7348847},
+	{-10.813623, 6.8932934, -2.5432348},
+	{-8.97726, 5.720393, -2.3703587},
+	{-7.313987, 4.6584015, -2.213956},
+	{-5.803219, 3.6942635, -2.0720735},
+	{-4.42737, 2.8168135, -1.9430417},
+	{-3.171337, 2.0164454, -1.8254268},
+	{-2.0220745, 1.2848513, -1.7179898},
+	{-0.9682574, 0.6148077, -1.6196547},
+	{-1.7599256e-06, 1.2715252e-06, -1.5294815},
+	{-146.21356, 62.673237, -7.4794397},
+	{-17.88502, -6.611684, 1.2985631},
+	{25.097517, -30.65894, 4.3423457},
+	{41.179752, -39.715706, 5.490745},
+	{47.1168, -42.85104, 5.8740406},
+	{49.98525, -44.18403, 6.023875},
+	{51.63391, -44.812374, 6.083235},
+	{52.67317, -45.099247, 6.099461},
+	{53.36854, -45.201977, 6.093016},
+	{53.85176, -45.19771, 6.073878},
+	{54.197083, -45.129284, 6.0475426},
+	{54.44943, -45.022064, 6.017231},
+	{54.63784, -44.892063, 5.9849486},
+	{54.78226, -44.74999, 5.952019},
+	{54.896713, -44.603134, 5.9193263},
+	{54.991154, -44.456467, 5.887458},
+	{-48.520504, 15.449786, -1.9285806},
+	{-5.356745, -8.924013, 1.2345207},
+	{15.913971, -21.113323, 2.810905},
+	{27.065699, -27.526215, 3.6394181},
+	{32.853817, -30.788927, 4.0561247},
+	{36.115585, -32.547962, 4.275286},
+	{38.204098, -33.609325, 4.4029183},
+	{39.665535, -34.30112, 4.482315},
+	{40.75245, -34.775436, 4.533558},
+	{41.598743, -35.11288, 4.567284},
+	{42.281586, -35.359745, 4.589591},
+	{42.848637, -35.544468, 4.604212},
+	{43.33116, -35.685547, 4.6135674},
+	{43.750633, -35.795578, 4.6192966},
+	{44.12241, -35.883476, 4.622553},
+	{44.457825, -35.955734, 4.6241727},
+	{-27.751373, 6.838964, -1.0636507},
+	{-4.144531, -6.82646, 0.7462365},
+	{9.696792, -14.891066, 1.8082355},
+	{18.22579, -19.869667, 2.4620361},
+	{23.539509, -22.948107, 2.864088},
+	{26.91089, -24.863342, 3.1115057},
+	{29.196686, -26.1253, 3.2720149},
+	{30.8595, -27.012962, 3.3828256},
+	{32.137268, -27.670637, 3.463214},
+	{33.160915, -28.177912, 3.5238154},
+	{34.008488, -28.582262, 3.570972},
+	{34.72931, -28.91366, 3.6086845},
+	{35.35604, -29.191908, 3.6395917},
+	{35.911236, -29.43063, 3.6655052},
+	{36.411045, -29.639534, 3.6877134},
+	{36.867397, -29.825756, 3.7071598},
+	{-20.96154, 5.002003, -0.9930586},
+	{-5.1997137, -4.281392, 0.25785205},
+	{5.0547843, -10.339569, 1.0690742},
+	{11.992961, -14.441644, 1.6162448},
+	{16.780466, -17.262295, 1.9909778},
+	{20.113743, -19.207516, 2.2478604},
+	{22.496449, -20.576622, 2.4271386},
+	{24.278385, -21.580885, 2.5573068},
+	{25.672102, -22.349764, 2.6558552},
+	{26.803791, -22.960453, 2.733221},
+	{27.75099, -23.460484, 2.7958307},
+	{28.563742, -23.880568, 2.8478353},
+	{29.275753, -24.241383, 2.8920276},
+	{29.910526, -24.557335, 2.930352},
+	{30.48496, -24.838766, 2.9642022},
+	{31.011555, -25.093306, 2.9946046},
+	{-18.525429, 5.110714, -1.1363492},
+	{-6.8109727, -1.8821534, -0.18006338},
+	{1.3646007, -6.770572, 0.48456937},
+	{7.2479577, -10.289017, 0.9609047},
+	{11.568424, -12.867538, 1.308699},
+	{14.778072, -14.773281, 1.5646785},
+	{17.190428, -16.193064, 1.7543784},
+	{19.046638, -17.272596, 1.8976995},
+	{20.520884, -18.118246, 2.0091827},
+	{21.728882, -18.80112, 2.0985532},
+	{22.74617, -19.367794, 2.1721826},
+	{23.62289, -19.849249, 2.2343075},
+	{24.393377, -20.266718, 2.2878315},
+	{25.081867, -20.6352, 2.3348033},
+	{25.705948, -20.965569, 2.3767076},
+	{26.27868, -21.2659, 2.4146454},
+	{-17.809734, 5.888058, -1.3435599},
+	{-8.516248, 0.27936563, -0.566791},
+	{-1.6920727, -3.843715, 0.0014020032},
+	{3.4417543, -6.9454737, 0.42710292},
+	{7.374627, -9.318359, 0.7516203},
+	{10.426552, -11.153981, 1.0017983},
+	{12.817251, -12.584333, 1.1960033},
+	{14.712616, -13.709851, 1.3481534},
+	{16.244125, -14.610948, 1.4693775},
+	{17.51073, -15.348614, 1.5681154},
+	{18.58272, -15.966382, 1.6503901},
+	{19.509172, -16.494741, 1.7204198},
+	{20.324617, -16.955185, 1.7811757},
+	{21.053806, -17.363132, 1.8347894},
+	{21.714884, -17.72989, 1.8828226},
+	{22.321426, -18.063929, 1.9264439},
+	{-17.869059, 6.900775, -1.5657718},
+	{-10.170409, 2.2118318, -0.90926033},
+	{-4.295852, -1.3694924, -0.40989852},
+	{0.27561343, -4.1565056, -0.022697799},
+	{3.889063, -6.35737, 0.2820815},
+	{6.7806387, -8.114914, 0.52473265},
+	{9.116829, -9.530089, 0.7195207},
+	{11.020425, -10.677634, 0.876957},
+	{12.588668, -11.617135, 1.0053955},
+	{13.900417, -12.39729, 1.111654},
+	{15.017251, -13.056359, 1.2010851},
+	{15.985223, -13.623072, 1.2777066},
+	{16.83818, -14.118599, 1.3444778},
+	{17.601034, -14.55855, 1.4035795},
+	{18.292322, -14.954555, 1.4566352},
+	{18.926056, -15.315406, 1.5048723},
+	{-18.303808, 7.980379, -1.7852067},
+	{-11.7287855, 3.9443135, -1.214721},
+	{-6.558996, 0.7680772, -0.7673101},
+	{-2.4273407, -1.7707348, -0.4107964},
+	{0.91930914, -3.8259428, -0.12301104},
+	{3.6604238, -5.5069175, 0.111746356},
+	{5.9264007, -6.8933363, 0.3048681},
+	{7.814355, -8.0447035, 0.46482962},
+	{9.399523, -9.007296, 0.5982001},
+	{10.743295, -9.81909, 0.7103573},
+	{11.896474, -10.511714, 0.80577326},
+	{12.899991, -11.110773, 0.88806623},
+	{13.785737, -11.636293, 0.9600636},
+	{14.578124, -12.103641, 1.0239339},
+	{15.295783, -12.524561, 1.0813339},
+	{15.953019, -12.908084, 1.1335362},
+	{-18.926003, 9.0567, -1.9953388},
+	{-13.182497, 5.5069733, -1.4893967},
+	{-8.556948, 2.6457777, -1.0827637},
+	{-4.779965, 0.3088471, -0.75151384},
+	{-1.659724, -1.621114, -0.4786176},
+	{0.9436728, -3.2298803, -0.25166047},
+	{3.1344283, -4.58151, -0.061399546},
+	{4.9916897, -5.7247863, 0.09918309},
+	{6.5768723, -6.697669, 0.235533},
+	{7.9392138, -7.530704, 0.35202125},
+	{9.119745, -8.249474, 0.45230156},
+	{10.15304, -8.875643, 0.53946453},
+	{11.067685, -9.42721, 0.61607516},
+	{11.88671, -9.918718, 0.6842049},
+	{12.628342, -10.361705, 0.7454961},
+	{13.306922, -10.765264, 0.8012424},
+	{-19.64055, 10.100277, -2.194112},
+	{-14.535808, 6.926337, -1.7383721},
+	{-10.343374, 4.3174853, -1.3646841},
+	{-6.859044, 2.1484878, -1.0546982},
+	{-3.9334998, 0.32754013, -0.7949989},
+	{-1.4552798, -1.214058, -0.57557344},
+	{0.66036284, -2.5286715, -0.38880923},
+	{2.478957, -3.65689, -0.22881976},
+	{4.052004, -4.6306944, -0.09097765},
+	{5.4207325, -5.475758, 0.028423818},
+	{6.6190305, -6.2132545, 0.13243587},
+	{7.675594, -6.8611917, 0.22364967},
+	{8.614981, -7.4350505, 0.30429035},
+	{9.457949, -7.947967, 0.37624446},
+	{10.221679, -8.410848, 0.4410776},
+	{10.92016, -8.832594, 0.50006646},
+	{-20.39694, 11.099733, -2.3813298},
+	{-15.797458, 8.224592, -1.9657488},
+	{-11.957946, 5.822568, -1.6192769},
+	{-8.719452, 3.7956786, -1.3274791},
+	{-5.963128, 2.0704937, -1.0795633},
+	{-3.5984926, 0.5909806, -0.86731255},
+	{-1.5555383, -0.6863127, -0.6843689},
+	{0.22070594, -1.7955846, -0.52573854},
+	{1.7740093, -2.7641273, -0.38744402},
+	{3.1397092, -3.6140282, -0.26627237},
+	{4.3467894, -4.363452, -0.15958567},
+	{5.419529, -5.0276604, -0.065170795},
+	{6.3787527, -5.6197963, 0.01887557},
+	{7.2425194, -6.1513004, 0.09420896},
+	{8.026374, -6.632056, 0.16225848},
+	{8.743478, -7.0704584, 0.22423719},
+	{-21.167538, 12.05205, -2.5575647},
+	{-16.977213, 9.420009, -2.1748378},
+	{-13.430806, 7.1906667, -1.8512514},
+	{-10.401833, 5.2856913, -1.5752084},
+	{-7.7938986, 3.6452603, -1.337871},
+	{-5.5323668, 2.2229555, -1.1323947},
+	{-3.5586104, 0.9822388, -0.9534023},
+	{-1.8259716, -0.10602987, -0.79661316},
+	{-0.2968609, -1.0653769, -0.6585771},
+	{1.0593634, -1.9150219, -0.53648},
+	{2.2679808, -2.6708577, -0.42799857},
+	{3.3501306, -3.3462062, -0.3311878},
+	{4.323791, -3.9524305, -0.24439085},
+	{5.2045465, -4.499413, -0.16616806},
+	{6.006061, -4.9958496, -0.09525374},
+	{6.740276, -5.4493647, -0.030538822},
+	{-21.937056, 12.958106, -2.7236803},
+	{-18.084496, 10.527596, -2.3683276},
+	{-14.785364, 8.444632, -2.0642662},
+	{-11.937117, 6.6454353, -1.802011},
+	{-9.460304, 5.0804987, -1.5742134},
+	{-7.2924995, 3.7108445, -1.3750975},
+	{-5.3840117, 2.5053878, -1.2000651},
+	{-3.6948242, 1.4390469, -1.0454117},
+	{-2.192357, 0.49136022, -0.9081199},
+	{-0.84980845, -0.35453805, -0.7857062},
+	{0.35509536, -1.1126928, -0.67610526},
+	{1.4410585, -1.7949238, -0.577581},
+	{2.4239702, -2.4112947, -0.48865706},
+	{3.3175154, -2.970496, -0.4080598},
+	{4.133665, -3.480152, -0.33467287},
+	{4.8830094, -3.9470296, -0.26750597},
+	{-22.69713, 13.820539, -2.8806145},
+	{-19.127893, 11.559729, -2.5484195},
+	{-16.04013, 9.602427, -2.261218},
+	{-13.349364, 7.8958883, -2.0111337},
+	{-10.989194, 6.3985806, -1.7919743},
+	{-8.906765, 5.077382, -1.59881},
+	{-7.059514, 3.9055748, -1.4276695},
+	{-5.4127994, 2.8613725, -1.2753198},
+	{-3.9381492, 1.9268372, -1.1391023},
+	{-2.6119442, 1.0870602, -1.0168104},
+	{-1.4144127, 0.3295392, -0.90659577},
+	{-0.3288591, -0.35630444, -0.8068964},
+	{0.658946, -0.97950417, -0.71637976},
+	{1.5612502, -1.5478525, -0.6338976},
+	{2.3887172, -2.0681503, -0.5584486},
+	{3.1507494, -2.5464096, -0.48914826},
+	{-23.443386, 14.642693, -3.0292835},
+	{-20.11504, 12.526668, -2.7169306},
+	{-17.209978, 10.678411, -2.4444377},
+	{-14.657637, 9.053703, -2.205176},
+	{-12.401843, 7.617285, -1.9938678},
+	{-10.397366, 6.3407164, -1.806262},
+	{-8.607399, 5.200818, -1.638899},
+	{-7.001705, 4.1785145, -1.488935},
+	{-5.5552173, 3.257965, -1.3540119},
+	{-4.246979, 2.425905, -1.2321571},
+	{-3.059332, 1.6711366, -1.1217073},
+	{-1.9772743, 0.9841318, -1.0212488},
+	{-0.98796666, 0.3567198, -0.92957044},
+	{-0.080328256, -0.218164, -0.84562594},
+	{0.75528616, -0.74668133, -0.7685035},
+	{1.5273536, -1.2342596, -0.6974007},
+	{-24.173803, 15.42809, -3.170542},
+	{-21.052637, 13.436987, -2.8753762},
+	{-18.307014, 11.684231, -2.6158273},
+	{-15.87727, 10.132305, -2.3862512},
+	{-13.715371, 8.750958, -2.1821027},
+	{-11.782241, 7.5155444, -1.999684},
+	{-10.045782, 6.405787, -1.8359565},
+	{-8.479402, 5.4048595, -1.6884017},
+	{-7.060881, 4.498683, -1.5549148},
+	{-5.771516, 3.6753852, -1.433723},
+	{-4.5954423, 2.9248815, -1.3233215},
+	{-3.5191116, 2.2385447, -1.2224247},
+	{-2.5308716, 1.608943, -1.129926},
+	{-1.6206309, 1.029629, -1.0448663},
+	{-0.7795864, 0.49496874, -0.96640795},
+	{-1.4241919e-06, 1.0289297e-06, -0.89381415},
+	{-112.761986, 47.398106, -5.3486176},
+	{-7.1901283, -10.265333, 2.0521111},
+	{26.610903, -29.65347, 4.602345},
+	{38.06764, -36.199066, 5.462394},
+	{42.605125, -38.614635, 5.7667203},
+	{45.057655, -39.789326, 5.9043207},
+	{46.634365, -40.454994, 5.974435},
+	{47.76181, -40.86959, 6.0120273},
+	{48.629353, -41.146656, 6.0324197},
+	{49.335983, -41.344746, 6.0434575},
+	{49.93966, -41.497585, 6.049616},
+	{50.476906, -41.625988, 6.053627},
+	{50.972134, -41.74356, 6.0572534},
+	{51.44251, -41.859646, 6.0616875},
+	{51.90049, -41.980865, 6.067763},
+	{52.355415, -42.112053, 6.076077},
+	{-35.3437, 9.895285, -0.9368321},
+	{-0.13006023, -10.275523, 1.730437},
+	{16.753298, -20.147388, 3.0485823},
+	{25.233667, -25.11019, 3.7118042},
+	{29.681345, -27.653255, 4.0474644},
+	{32.38521, -29.141932, 4.239814},
+	{34.257996, -30.130686, 4.364421},
+	{35.675205, -30.848991, 4.452658},
+	{36.81694, -31.407038, 4.519598},
+	{37.78025, -31.864058, 4.573334},
+	{38.622635, -32.25499, 4.6186266},
+	{39.38086, -32.602028, 4.6584907},
+	{40.07984, -32.92011, 4.694947},
+	{40.737293, -33.21976, 4.729414},
+	{41.366295, -33.508705, 4.7629275},
+	{41.97685, -33.79279, 4.796269},
+	{-19.703974, 3.5537522, -0.31734332},
+	{-0.5491327, -7.668185, 1.1929839},
+	{10.541845, -14.235827, 2.080134},
+	{17.249725, -18.216068, 2.6182399},
+	{21.404375, -20.659477, 2.9470959},
+	{24.144934, -22.242735, 3.1582375},
+	{26.118082, -23.358519, 3.3053808},
+	{27.645498, -24.204039, 3.4156325},
+	{28.894213, -24.882132, 3.5031567},
+	{29.958405, -25.45088, 3.5759585},
+	{30.895105, -25.94544, 3.638882},
+	{31.741056, -26.388426, 3.6950402},
+	{32.521236, -26.795166, 3.7465403},
+	{33.253407, -27.176533, 3.794874},
+	{33.950714, -27.540539, 3.8411381},
+	{34.6232, -27.893288, 3.886168},
+	{-14.971143, 2.519304, -0.32331878},
+	{-2.1909707, -5.078757, 0.71298695},
+	{6.079396, -10.026991, 1.3884602},
+	{11.63366, -13.356863, 1.8431458},
+	{15.448519, -15.635934, 2.153762},
+	{18.145212, -17.232502, 2.3703992},
+	{20.151743, -18.405891, 2.5286665},
+	{21.72971, -19.316624, 2.650735},
+	{23.030277, -20.058058, 2.7495368},
+	{24.143242, -20.685848, 2.832792},
+	{25.12468, -21.234806, 2.9053333},
+	{26.01133, -21.727753, 2.9703286},
+	{26.828348, -22.180305, 3.0299454},
+	{27.59366, -22.603586, 3.0857255},
+	{28.320503, -23.005793, 3.1388054},
+	{29.018978, -23.393173, 3.1900504},
+	{-13.534161, 2.9434276, -0.4878519},
+	{-4.015059, -2.780219, 0.30168813},
+	{2.6121342, -6.781307, 0.853308},
+	{7.370563, -9.658971, 1.2499154},
+	{10.86174, -11.767394, 1.5401858},
+	{13.471464, -13.336117, 1.7556494},
+	{15.477912, -14.533348, 1.9195257},
+	{17.080465, -15.481379, 2.048789},
+	{18.410337, -16.261383, 2.1547458},
+	{19.551327, -16.925457, 2.2446663},
+	{20.557823, -17.507517, 2.3232884},
+	{21.4663, -18.03034, 2.3937967},
+	{22.302061, -18.509752, 2.4584029},
+	{23.083258, -18.9571, 2.5186937},
+	{23.82332, -19.380783, 2.5758429},
+	{24.53248, -19.787197, 2.6307435},
+	{-13.368338, 3.8434486, -0.6952505},
+	{-5.7855444, -0.7588744, -0.05401272},
+	{-0.22578163, -4.142795, 0.41688994},
+	{3.9564586, -6.691643, 0.7713},
+	{7.164719, -8.645938, 1.0427799},
+	{9.665517, -10.1654625, 1.2535533},
+	{11.64913, -11.365391, 1.4196452},
+	{13.261006, -12.334857, 1.5534989},
+	{14.609042, -13.140652, 1.6644697},
+	{15.768743, -13.829816, 1.759161},
+	{16.79186, -14.434719, 1.8421229},
+	{17.714186, -14.97781, 1.9165131},
+	{18.561024, -15.474984, 1.9845687},
+	{19.350723, -15.937781, 2.0479138},
+	{20.096935, -16.374794, 2.1077583},
+	{20.810081, -16.79258, 2.165025},
+	{-13.764469, 4.898648, -0.9098391},
+	{-7.44907, 1.0346203, -0.36664662},
+	{-2.6357625, -1.9164302, 0.047592387},
+	{1.1120559, -4.2166, 0.37011585},
+	{4.081108, -6.038559, 0.62533015},
+	{6.467545, -7.500909, 0.8299355},
+	{8.41177, -8.689024, 0.99593097},
+	{10.020947, -9.668615, 1.1325564},
+	{11.379816, -10.49214, 1.2472037},
+	{12.553344, -11.200123, 1.3455951},
+	{13.589287, -11.822505, 1.4319648},
+	{14.522151, -12.380997, 1.5093853},
+	{15.37698, -12.891391, 1.5800934},
+	{16.172222, -13.365339, 1.6457396},
+	{16.921734, -13.811606, 1.7075657},
+	{17.636147, -14.236919, 1.7665248},
+	{-14.4291115, 5.9895697, -1.119607},
+	{-9.001699, 2.6454363, -0.6457321},
+	{-4.7389026, 0.014655485, -0.27350053},
+	{-1.3297746, -2.091058, 0.024080494},
+	{1.438162, -3.8007548, 0.26544115},
+	{3.714929, -5.205855, 0.46359387},
+	{5.6098795, -6.3732615, 0.6280383},
+	{7.20592, -7.3539653, 0.7660061},
+	{8.569077, -8.188865, 0.8833},
+	{9.7529, -8.9113865, 0.98466825},
+	{10.799704, -9.548098, 1.0738903},
+	{11.741845, -10.119407, 1.1538721},
+	{12.603698, -10.640731, 1.2268101},
+	{13.403633, -11.123716, 1.2943643},
+	{14.155648, -11.5772505, 1.3578014},
+	{14.870566, -12.008214, 1.4181026},
+	{-15.226997, 7.0692186, -1.3207085},
+	{-10.45345, 4.109612, -0.8983024},
+	{-6.6131034, 1.725439, -0.5585104},
+	{-3.4753733, -0.22393973, -0.28102005},
+	{-0.8775381, -1.8380549, -0.051498063},
+	{1.2984173, -3.1893077, 0.14045385},
+	{3.1403, -4.3317432, 0.30258003},
+	{4.715208, -5.306818, 0.44081125},
+	{6.076167, -6.1474524, 0.55985343},
+	{7.266664, -6.8808107, 0.6635903},
+	{8.322743, -7.529547, 0.7552637},
+	{9.273651, -8.112138, 0.83751935},
+	{10.142501, -8.643238, 0.9124577},
+	{10.947296, -9.1342945, 0.98171973},
+	{11.702042, -9.594229, 1.0465848},
+	{12.4177065, -10.030041, 1.1080565},
+	{-16.091217, 8.119694, -1.5123736},
+	{-11.81757, 5.455231, -1.1296185},
+	{-8.311378, 3.2667887, -0.8156607},
+	{-5.395983, 1.4459379, -0.55473894},
+	{-2.9433708, -0.0861014, -0.3354287},
+	{-0.8585678, -1.3879333, -0.14925018},
+	{0.93042165, -2.5041115, 0.010230974},
+	{2.4793408, -3.4692404, 0.14800425},
+	{3.832354, -4.310836, 0.26803246},
+	{5.0254545, -5.051421, 0.37355757},
+	{6.0888233, -5.7099833, 0.46731266},
+	{7.04796, -6.3026466, 0.5516202},
+	{7.924047, -6.8428664, 0.6284189},
+	{8.734295, -7.34161, 0.69929},
+	{9.4924965, -7.807688, 0.7655038},
+	{10.209666, -8.248152, 0.82807726},
+	{-16.987244, 9.135594, -1.695025},
+	{-13.107099, 6.7042675, -1.3436983},
+	{-9.871534, 4.674859, -1.0508041},
+	{-7.141527, 2.9615564, -0.8037979},
+	{-4.814064, 1.5006235, -0.5933806},
+	{-2.8112357, 0.24373716, -0.4125149},
+	{-1.0730038, -0.8464372, -0.255773},
+	{0.4477343, -1.79927, -0.11889104},
+	{1.7886074, -2.638295, 0.0015434867},
+	{2.9802854, -3.3827581, 0.108319014},
+	{4.0483646, -4.048787, 0.20377102},
+	{5.0146976, -4.650213, 0.28990263},
+	{5.8980665, -5.198978, 0.3684438},
+	{6.714402, -5.7052507, 0.4408681},
+	{7.476963, -6.17752, 0.508406},
+	{8.196637, -6.6227727, 0.57207024},
+	{-17.896975, 10.117066, -1.869527},
+	{-14.333835, 7.874127, -1.5436753},
+	{-11.3216, 5.9763446, -1.2682729},
+	{-8.748373, 4.3542957, -1.0331188},
+	{-6.5296526, 2.955443, -0.8305067},
+	{-4.6004643, 1.7392951, -0.6545065},
+	{-2.9100103, 0.6741248, -0.5004784},
+	{-1.417951, -0.2653252, -0.36473376},
+	{-0.09175824, -1.099474, -0.24429363},
+	{1.0952184, -1.8450984, -0.13671277},
+	{2.1652017, -2.5162325, -0.039947007},
+	{3.1370966, -3.1248584, 0.04774838},
+	{4.0272913, -3.6814053, 0.12789188},
+	{4.850088, -4.1950116, 0.20181431},
+	{5.6178474, -4.6736035, 0.27067003},
+	{6.341078, -5.1239367, 0.33544323},
+	{-18.811085, 11.066701, -2.0368714},
+	{-15.508141, 8.978797, -1.7320424},
+	{-12.683012, 7.191579, -1.4713774},
+	{-10.2438965, 5.647822, -1.2464285},
+	{-8.120325, 4.3035126, -1.0507082},
+	{-6.2573204, 3.124245, -0.87915194},
+	{-4.611357, 2.0827205, -0.727747},
+	{-3.1474876, 1.156975, -0.5932683},
+	{-1.8372768, 0.32909447, -0.4730882},
+	{-0.6572714, -0.4157318, -0.36503637},
+	{0.41214806, -1.0899268, -0.2672935},
+	{1.3876528, -1.704078, -0.17830999},
+	{2.2836545, -2.267368, -0.0967422},
+	{3.1128137, -2.7878935, -0.021405604},
+	{3.8863335, -3.2728443, 0.048751753},
+	{4.61406, -3.7285595, 0.1146575},
+	{-19.725124, 11.988098, -2.198047},
+	{-16.63903, 10.029691, -1.9108196},
+	{-13.972611, 8.336464, -1.662716},
+	{-11.649293, 6.8604765, -1.4466288},
+	{-9.609455, 5.564335, -1.2570211},
+	{-7.8059897, 4.4184384, -1.0895162},
+	{-6.2011805, 3.3990407, -0.94060653},
+	{-4.764444, 2.4868503, -0.8074461},
+	{-3.4706836, 1.6660038, -0.68769664},
+	{-2.2990608, 0.9233003, -0.579414},
+	{-1.2320592, 0.24761795, -0.4809615},
+	{-0.25476557, -0.37053594, -0.39094302},
+	{0.64569646, -0.9393802, -0.3081503},
+	{1.4806141, -1.4661412, -0.23152137},
+	{2.2600226, -1.9572649, -0.16010903},
+	{2.9929135, -2.4185448, -0.09306201},
+	{-20.637445, 12.885183, -2.353988},
+	{-17.734364, 11.036265, -2.0816722},
+	{-15.203937, 9.423721, -1.8443754},
+	{-12.981366, 8.006818, -1.636034},
+	{-11.015566, 6.7533846, -1.4518658},
+	{-9.265716, 5.6376743, -1.2880473},
+	{-7.6988006, 4.638827, -1.1414841},
+	{-6.2878146, 3.7397478, -1.0096432},
+	{-5.010422, 2.9262726, -0.89042723},
+	{-3.847955, 2.1865401, -0.78208166},
+	{-2.7846408, 1.5105084, -0.6831219},
+	{-1.8070064, 0.88958, -0.5922779},
+	{-0.90340483, 0.31630605, -0.5084498},
+	{-0.06363877, -0.21585232, -0.4306724},
+	{0.7213421, -0.7127146, -0.35808715},
+	{1.4596736, -1.1795275, -0.28992},
+	{-21.548037, 13.761889, -2.5055573},
+	{-18.801025, 12.0064745, -2.2459962},
+	{-16.388102, 10.46374, -2.0180652},
+	{-14.253733, 9.098641, -1.8165293},
+	{-12.353645, 7.883192, -1.6372119},
+	{-10.65211, 6.794776, -1.4767416},
+	{-9.119968, 5.8149047, -1.3323646},
+	{-7.7331734, 4.9283075, -1.201809},
+	{-6.471698, 4.122244, -1.0831808},
+	{-5.318698, 3.3859804, -0.9748852},
+	{-4.25987, 2.710385, -0.87556696},
+	{-3.282948, 2.0876117, -0.78406197},
+	{-2.3773038, 1.5108484, -0.6993602},
+	{-1.5336268, 0.9741136, -0.62057525},
+	{-0.74366236, 0.4720922, -0.54691976},
+	{-1.3752957e-06, 9.852218e-07, -0.4776855},
+	{-91.89794, 37.285862, -3.8253865},
+	{2.9883022, -15.47485, 3.0910485},
+	{30.204037, -31.458994, 5.2720146},
+	{38.582798, -36.274815, 5.9221244},
+	{42.310555, -38.264847, 6.1784816},
+	{44.577003, -39.38042, 6.314034},
+	{46.202003, -40.1259, 6.3995976},
+	{47.491436, -40.688343, 6.4613557},
+	{48.59064, -41.15563, 6.5115304},
+	{49.580074, -41.57569, 6.5567336},
+	{50.509247, -41.97758, 6.6009355},
+	{51.411095, -42.38033, 6.646714},
+	{52.308983, -42.79726, 6.6958623},
+	{53.220448, -43.238304, 6.749709},
+	{54.159298, -43.711246, 6.809297},
+	{55.136986, -44.222576, 6.875496},
+	{-25.700974, 5.070152, -0.019562569},
+	{5.248342, -13.004572, 2.4331794},
+	{19.259018, -21.364243, 3.5857892},
+	{25.956856, -25.34076, 4.1335893},
+	{29.627487, -27.465105, 4.4223046},
+	{32.053997, -28.828037, 4.6044493},
+	{33.876167, -29.825525, 4.735805},
+	{35.363686, -30.625023, 4.8400135},
+	{36.650154, -31.309395, 4.92877},
+	{37.810894, -31.925205, 5.008635},
+	{38.892807, -32.501328, 5.08367},
+	{39.927483, -33.057133, 5.1565914},
+	{40.937576, -33.606464, 5.2293415},
+	{41.940285, -34.159817, 5.303389},
+	{42.949287, -34.725544, 5.3799076},
+	{43.976013, -35.310658, 5.459883},
+	{-13.537328, 0.37222835, 0.4090563},
+	{3.2075903, -9.599969, 1.7816497},
+	{12.625874, -15.276733, 2.569984},
+	{18.173449, -18.620811, 3.0352054},
+	{21.643627, -20.690626, 3.3218732},
+	{24.060436, -22.110725, 3.5171657},
+	{25.916157, -23.185974, 3.664056},
+	{27.446651, -24.063404, 3.7833562},
+	{28.776009, -24.82059, 3.8860676},
+	{29.97591, -25.502367, 3.9785526},
+	{31.091337, -26.136864, 4.064803},
+	{32.152615, -26.743032, 4.1475077},
+	{33.181484, -27.334452, 4.228595},
+	{34.1944, -27.921404, 4.3095245},
+	{35.20443, -28.512077, 4.391462},
+	{36.22245, -29.113302, 4.4753823},
+	{-10.384275, 0.07588061, 0.315412},
+	{0.81858945, -6.6756296, 1.2532876},
+	{7.956908, -11.012298, 1.859195},
+	{12.686003, -13.890338, 2.2622654},
+	{15.931889, -15.857196, 2.5374548},
+	{18.295435, -17.277838, 2.735613},
+	{20.14223, -18.378366, 2.888605},
+	{21.674871, -19.285227, 3.0143528},
+	{23.007788, -20.070177, 3.1230576},
+	{24.209528, -20.776323, 3.2208586},
+	{25.323818, -21.431202, 3.3116813},
+	{26.380268, -22.05348, 3.3981895},
+	{27.40008, -22.656532, 3.4822936},
+	{28.399227, -23.250456, 3.5654373},
+	{29.390339, -23.843248, 3.6487663},
+	{30.383854, -24.441542, 3.7332325},
+	{-9.814289, 0.89270055, 0.10474349},
+	{-1.4247733, -4.2085466, 0.8186342},
+	{4.3663077, -7.750368, 1.3162514},
+	{8.49651, -10.281885, 1.672735},
+	{11.52296, -12.134149, 1.9336778},
+	{13.817964, -13.532637, 2.130484},
+	{15.642725, -14.638484, 2.2858522},
+	{17.165821, -15.556883, 2.4147038},
+	{18.491198, -16.353119, 2.5263367},
+	{19.684074, -17.068317, 2.6266224},
+	{20.787094, -17.729437, 2.7194166},
+	{21.829453, -18.354979, 2.8073683},
+	{22.832113, -18.95824, 2.8923845},
+	{23.810837, -19.549248, 2.9759042},
+	{24.778013, -20.135893, 3.0590634},
+	{25.743818, -20.724682, 3.1427996},
+	{-10.200527, 2.0368133, -0.13052547},
+	{-3.472421, -2.084492, 0.4500014},
+	{1.4361837, -5.104468, 0.87654823},
+	{5.1174083, -7.3741245, 1.1977378},
+	{7.9422545, -9.11547, 1.4443879},
+	{10.161354, -10.480358, 1.6377044},
+	{11.959035, -11.582197, 1.7936624},
+	{13.46981, -12.50482, 1.9241616},
+	{14.785669, -13.306034, 2.0374465},
+	{15.967929, -14.0245695, 2.1390586},
+	{17.058023, -14.686691, 2.2327633},
+	{18.084837, -15.310755, 2.3211968},
+	{19.06926, -15.910069, 2.406273},
+	{20.027023, -16.494675, 2.489438},
+	{20.970451, -17.072458, 2.5718303},
+	{21.909613, -17.64988, 2.6543827},
+	{-10.996972, 3.2698, -0.36496338},
+	{-5.3498645, -0.21164434, 0.12837261},
+	{-1.0598091, -2.865387, 0.5051043},
+	{2.276837, -4.9332848, 0.7991113},
+	{4.924204, -6.5746083, 1.0326964},
+	{7.0644255, -7.900104, 1.2214077},
+	{8.831657, -8.992236, 1.3768826},
+	{10.329793, -9.915645, 1.5083032},
+	{11.637238, -10.719583, 1.6227037},
+	{12.810386, -11.439706, 1.7251952},
+	{13.889117, -12.101339, 1.8194168},
+	{14.901938, -12.722643, 1.9079831},
+	{15.869746, -13.316965, 1.9928186},
+	{16.80835, -13.894425, 2.0753853},
+	{17.730139, -14.462977, 2.1568334},
+	{18.645195, -15.029105, 2.2381022},
+	{-11.987219, 4.5112915, -0.59161294},
+	{-7.092022, 1.47598, -0.15910004},
+	{-3.2566948, -0.9083947, 0.18105973},
+	{-0.19022195, -2.8178525, 0.45376658},
+	{2.3047352, -4.3723, 0.67595893},
+	{4.3676085, -5.656961, 0.8596828},
+	{6.1014194, -6.7352753, 1.0139275},
+	{7.5865283, -7.6571937, 1.1458012},
+	{8.887327, -8.46313, 1.261084},
+	{10.053978, -9.184807, 1.364331},
+	{11.124141, -9.84617, 1.4589907},
+	{12.125731, -10.465037, 1.5476345},
+	{13.079627, -11.0547695, 1.6321939},
+	{14.0017805, -11.625607, 1.7141515},
+	{14.904735, -12.1856165, 1.7946768},
+	{15.798679, -12.741368, 1.8747239},
+	{-13.076452, 5.7347975, -0.80935615},
+	{-8.730116, 3.0256736, -0.42131823},
+	{-5.241059, 0.8464076, -0.10894618},
+	{-2.390309, -0.9365334, 0.14680816},
+	{-0.024865145, -2.4167898, 0.35928106},
+	{1.9658568, -3.662341, 0.5381502},
+	{3.6645374, -4.72429, 0.6906969},
+	{5.1354737, -5.6426435, 0.82263434},
+	{6.4308615, -6.4501395, 0.93865407},
+	{7.593688, -7.173956, 1.0426657},
+	{8.658454, -7.8360424, 1.1378369},
+	{9.65204, -8.453577, 1.2266526},
+	{10.595162, -9.039848, 1.3110383},
+	{11.50392, -9.605205, 1.3924959},
+	{12.391063, -10.157867, 1.4722201},
+	{13.266968, -10.704537, 1.5511858},
+	{-14.221004, 6.9341726, -1.0190215},
+	{-10.289952, 4.47211, -0.6646636},
+	{-7.071707, 2.4531796, -0.37396458},
+	{-4.3958087, 0.7726885, -0.13188396},
+	{-2.140094, -0.6446266, 0.07237499},
+	{-0.21446632, -1.8544697, 0.24679698},
+	{1.4493948, -2.8992567, 0.39746225},
+	{2.904855, -3.8122845, 0.5291484},
+	{4.1950665, -4.620639, 0.64574945},
+	{5.3561373, -5.347142, 0.75055575},
+	{6.418547, -6.011187, 0.84637165},
+	{7.407451, -6.628865, 0.93552995},
+	{8.343099, -7.2131786, 1.0199196},
+	{9.24164, -7.7745333, 1.101054},
+	{10.116049, -8.321304, 1.1801533},
+	{10.976939, -8.860361, 1.258219},
+	{-15.400899, 8.111419, -1.2220584},
+	{-11.792693, 5.8415084, -0.8938822},
+	{-8.790588, 3.9504323, -0.62042445},
+	{-6.2581234, 2.3538382, -0.389493},
+	{-4.09537, 0.9897915, -0.19214682},
+	{-2.227308, -0.18834285, -0.021659246},
+	{-0.59626573, -1.2165383, 0.12715693},
+	{0.8431833, -2.12324, 0.2584044},
+	{2.127789, -2.9315662, 0.37542072},
+	{3.2882068, -3.6609046, 0.4810097},
+	{4.350743, -4.327993, 0.5775973},
+	{5.33812, -4.9473715, 0.6672961},
+	{6.269597, -5.53142, 0.7519086},
+	{7.1611457, -6.0904417, 0.83293694},
+	{8.025901, -6.632932, 0.9116215},
+	{8.874739, -7.1659403, 0.98899245},
+	{-16.608023, 9.271871, -1.4200654},
+	{-13.25598, 7.1543922, -1.1126388},
+	{-10.429017, 5.3667655, -0.85309386},
+	{-8.015268, 3.839437, -0.6313233},
+	{-5.9312954, 2.5204072, -0.43977848},
+	{-4.1134806, 1.3699247, -0.27269357},
+	{-2.5122924, 0.35695305, -0.12556878},
+	{-1.088359, -0.5432583, 0.0051840725},
+	{0.19031613, -1.350897, 0.12249275},
+	{1.3503854, -2.08285, 0.22880857},
+	{2.41467, -2.753647, 0.32624358},
+	{3.4031968, -3.3761058, 0.41666448},
+	{4.333653, -3.9616017, 0.5017311},
+	{5.22142, -4.5200667, 0.58289486},
+	{6.0796123, -5.059995, 0.6613987},
+	{6.919329, -5.588591, 0.7382977},
+	{-17.84062, 10.422225, -1.6146282},
+	{-14.69492, 8.427406, -1.3238715},
+	{-12.011409, 6.7243176, -1.0756583},
+	{-9.696484, 5.2544446, -0.8614442},
+	{-7.6792183, 3.973357, -0.67474735},
+	{-5.904795, 2.8466368, -0.5105505},
+	{-4.330047, 1.8471158, -0.36489493},
+	{-2.9203527, 0.95294356, -0.23459776},
+	{-1.647409, 0.14620385, -0.11704885},
+	{-0.48759314, -0.5881082, -0.010061411},
+	{0.57927555, -1.2628614, 0.0882416},
+	{1.5708812, -1.889381, 0.17951407},
+	{2.5030715, -2.4778633, 0.26524708},
+	{3.3901503, -3.0375495, 0.34679413},
+	{4.2448716, -3.5767086, 0.42536774},
+	{5.0784087, -4.1026015, 0.50203353},
+	{-19.100592, 11.569727, -1.8072755},
+	{-16.122866, 9.674555, -1.5300266},
+	{-13.557639, 8.040934, -1.2910839},
+	{-11.3251705, 6.61876, -1.0830992},
+	{-9.364203, 5.3694744, -0.9004215},
+	{-7.626829, 4.262858, -0.7386255},
+	{-6.0749364, 3.274822, -0.59418535},
+	{-4.677701, 2.385837, -0.46424356},
+	{-3.409769, 1.5797983, -0.3464439},
+	{-2.2499034, 0.84317815, -0.23880692},
+	{-1.1799562, 0.1643789, -0.13963501},
+	{-0.18406594, -0.4667714, -0.04743814},
+	{0.7519676, -1.0594147, 0.039124046},
+	{1.6411304, -1.6219447, 0.12128355},
+	{2.4954004, -2.1621308, 0.20018199},
+	{3.325726, -2.6870954, 0.2768667},
+	{-20.392155, 12.721892, -1.9994838},
+	{-17.55203, 10.908068, -1.7332194},
+	{-15.084597, 9.331589, -1.5018562},
+	{-12.920822, 7.9488688, -1.2989755},
+	{-11.007042, 6.72597, -1.1195829},
+	{-9.300842, 5.6360216, -0.9597272},
+	{-7.768177, 4.6574154, -0.81623226},
+	{-6.3813014, 3.7725043, -0.68650526},
+	{-5.117254, 2.9666536, -0.5683965},
+	{-3.9567227, 2.2275243, -0.4600937},
+	{-2.8831758, 1.5445256, -0.36004096},
+	{-1.8821766, 0.9083818, -0.26687527},
+	{-0.94083583, 0.31078476, -0.1793751},
+	{-0.047372837, -0.25588486, -0.09641952},
+	{0.8092046, -0.7987601, -0.0169583},
+	{1.6392602, -1.3245866, 0.06000242},
+	{-21.721165, 13.88645, -2.1926992},
+	{-18.99398, 12.139038, -1.935351},
+	{-16.607256, 10.609368, -1.7101426},
+	{-14.500356, 9.258974, -1.5113882},
+	{-12.625647, 8.057599, -1.3346177},
+	{-10.945137, 6.9810677, -1.1762627},
+	{-9.428081, 6.0097885, -1.0334322},
+	{-8.04925, 5.1276555, -0.9037506},
+	{-6.7876453, 4.3212376, -0.7852378},
+	{-5.6255345, 3.5791693, -0.6762182},
+	{-4.5476966, 2.891674, -0.5752507},
+	{-3.5408359, 2.2501898, -0.4810731},
+	{-2.5931003, 1.6470648, -0.39255726},
+	{-1.6936882, 1.0753052, -0.3086716},
+	{-0.832521, 0.52836704, -0.22845057},
+	{-1.6060721e-06, 1.1627377e-06, -0.15097181},
+	{-76.18003, 28.777971, -2.4281561},
+	{14.53026, -22.929592, 4.5618267},
+	{35.80421, -35.66843, 6.358553},
+	{42.170174, -39.311527, 6.858925},
+	{45.45633, -41.064934, 7.0885563},
+	{47.702362, -42.200798, 7.2315445},
+	{49.478024, -43.07371, 7.33917},
+	{51.017704, -43.82811, 7.4322023},
+	{52.442223, -44.537548, 7.521142},
+	{53.823666, -45.24541, 7.612146},
+	{55.21004, -45.980392, 7.709271},
+	{56.636326, -46.7634, 7.8154745},
+	{58.130367, -47.61119, 7.933145},
+	{59.71657, -48.538723, 8.06444},
+	{61.418243, -49.560604, 8.211501},
+	{63.25927, -50.692196, 8.376613},
+	{-16.518505, -0.49231637, 1.0662768},
+	{11.74792, -17.386463, 3.431809},
+	{23.441965, -24.49667, 4.4433093},
+	{28.874088, -27.755802, 4.904536},
+	{32.11257, -29.651594, 5.169349},
+	{34.45599, -30.996435, 5.355232},
+	{36.360302, -32.077396, 5.5038958},
+	{38.026573, -33.021133, 5.6337624},
+	{39.561172, -33.89454, 5.754555},
+	{41.02861, -34.73813, 5.872164},
+	{42.47244, -35.579247, 5.9905677},
+	{43.92483, -36.43817, 6.112726},
+	{45.41157, -37.331253, 6.2410393},
+	{46.95496, -38.27277, 6.3776183},
+	{48.575745, -39.27618, 6.52447},
+	{50.29452, -40.35501, 6.683629},
+	{-7.3990483, -3.5682905, 1.2826469},
+	{7.8812675, -12.850916, 2.5962677},
+	{16.087742, -17.888353, 3.316506},
+	{20.8032, -20.772394, 3.729151},
+	{23.875526, -22.630768, 3.993939},
+	{26.167358, -24.002728, 4.1886353},
+	{28.05037, -25.122887, 4.3473177},
+	{29.702944, -26.104324, 4.4864645},
+	{31.22235, -27.008827, 4.6150975},
+	{32.668095, -27.87425, 4.738753},
+	{34.080395, -28.726234, 4.8611894},
+	{35.488914, -29.583767, 4.9852004},
+	{36.91734, -30.462097, 5.1130404},
+	{38.386017, -31.374426, 5.246673},
+	{39.913647, -32.332993, 5.387931},
+	{41.518482, -33.349857, 5.538632},
+	{-5.780539, -3.004826, 1.06837},
+	{4.540224, -9.334279, 1.9692371},
+	{10.943656, -13.291932, 2.5370607},
+	{15.113063, -15.869114, 2.9078627},
+	{18.01925, -17.656343, 3.1648722},
+	{20.239273, -19.01344, 3.3597806},
+	{22.075779, -20.131474, 3.5202925},
+	{23.688316, -21.111818, 3.661177},
+	{25.167696, -22.012394, 3.7908995},
+	{26.570353, -22.869303, 3.9147499},
+	{27.93451, -23.707075, 4.0363336},
+	{29.28826, -24.543814, 4.1583204},
+	{30.653898, -25.393959, 4.28285},
+	{32.05043, -26.2699, 4.411767},
+	{33.49516, -27.182997, 4.5467753},
+	{35.00481, -28.144312, 4.68954},
+	{-6.138987, -1.653007, 0.7804309},
+	{1.6788647, -6.480448, 1.4702739},
+	{6.987065, -9.778813, 1.9447644},
+	{10.732015, -12.109593, 2.281249},
+	{13.487733, -13.821238, 2.528654},
+	{15.640121, -15.153478, 2.7212598},
+	{17.43126, -16.258963, 2.8811433},
+	{19.003334, -17.228092, 3.0214615},
+	{20.441729, -18.115433, 3.150187},
+	{21.800814, -18.955866, 3.2724338},
+	{23.11771, -19.773296, 3.3917167},
+	{24.41966, -20.585337, 3.510636},
+	{25.728159, -21.405949, 3.6312616},
+	{27.061392, -22.247011, 3.755362},
+	{28.435776, -23.119312, 3.8845532},
+	{29.867044, -24.033247, 4.0203996},
+	{-7.180678, -0.121321954, 0.48871043},
+	{-0.83733827, -4.059866, 1.0533135},
+	{3.7412038, -6.9175158, 1.4652983},
+	{7.1523757, -9.051197, 1.7740415},
+	{9.772859, -10.689688, 2.0116208},
+	{11.865741, -11.995803, 2.20121},
+	{13.618892, -13.087718, 2.359844},
+	{15.156942, -14.044644, 2.499031},
+	{16.560013, -14.9178295, 2.6262531},
+	{17.880886, -15.741192, 2.7464814},
+	{19.156116, -16.538317, 2.8631847},
+	{20.412615, -17.326635, 2.9789355},
+	{21.671526, -18.119905, 3.0957727},
+	{22.95062, -18.92974, 3.2154257},
+	{24.265821, -19.766603, 3.3394582},
+	{25.632257, -20.640482, 3.4693713},
+	{-8.501405, 1.4305253, 0.2079576},
+	{-3.1142323, -1.9300716, 0.6910113},
+	{0.94852114, -4.4755716, 1.0586822},
+	{4.095778, -6.451972, 1.3451655},
+	{6.59519, -8.02226, 1.5733309},
+	{8.635255, -9.302839, 1.759695},
+	{10.358444, -10.383044, 1.9170852},
+	{11.870566, -11.330026, 2.055234},
+	{13.245816, -12.191247, 2.1810627},
+	{14.535443, -12.999671, 2.2993994},
+	{15.77575, -13.7787485, 2.413691},
+	{16.993673, -14.54595, 2.5265157},
+	{18.210371, -15.315053, 2.6399136},
+	{19.443525, -16.097618, 2.7556043},
+	{20.708864, -16.903973, 2.8751297},
+	{22.02123, -17.743902, 2.9999576},
+	{-9.955244, 2.9625123, -0.060611196},
+	{-5.228652, 0.0016671075, 0.36601436},
+	{-1.5452764, -2.3141017, 0.70106965},
+	{1.392404, -4.16504, 0.96974826},
+	{3.7854974, -5.6740932, 1.1893413},
+	{5.777368, -6.929827, 1.3724043},
+	{7.4770837, -8.000449, 1.5286989},
+	{8.971226, -8.940777, 1.6661463},
+	{10.326524, -9.793466, 1.7909553},
+	{11.592234, -10.590206, 1.9077632},
+	{12.804488, -11.354359, 2.0200016},
+	{13.990504, -12.10359, 2.1302755},
+	{15.171736, -12.851877, 2.2406538},
+	{16.366056, -13.610909, 2.3528724},
+	{17.58927, -14.391053, 2.4684775},
+	{18.85618, -15.202057, 2.5889282},
+	{-11.487734, 4.4720006, -0.31940377},
+	{-7.237521, 1.7993708, 0.06656334},
+	{-3.8406293, -0.34307772, 0.37703595},
+	{-1.0699068, -2.0939546, 0.6315056},
+	{1.2322848, -3.550093, 0.8436391},
+	{3.1801786, -4.782222, 1.0234739},
+	{4.860656, -5.8446374, 1.1787691},
+	{6.343578, -6.781465, 1.315882},
+	{7.686562, -7.629472, 1.4401518},
+	{8.935713, -8.41831, 1.5559113},
+	{10.126719, -9.171062, 1.6665484},
+	{11.287195, -9.905663, 1.7747056},
+	{12.439121, -10.636438, 1.8825015},
+	{13.600812, -11.375366, 1.9917128},
+	{14.788385, -12.133013, 2.1039133},
+	{16.016846, -12.919245, 2.220577},
+	{-13.081686, 5.9690995, -0.5717204},
+	{-9.185007, 3.509987, -0.21582356},
+	{-6.007922, 1.5001533, 0.07588255},
+	{-3.3701189, -0.1712239, 0.31907868},
+	{-1.1436886, -1.5831445, 0.5249645},
+	{0.7655311, -2.794115, 0.7018635},
+	{2.4295876, -3.8492198, 0.8562211},
+	{3.9061556, -4.78485, 0.9932718},
+	{5.2436686, -5.631851, 1.1174839},
+	{6.4835043, -6.4168024, 1.2327335},
+	{7.6600657, -7.1619506, 1.3422819},
+	{8.801216, -7.885398, 1.4487942},
+	{9.929606, -8.601913, 1.5544522},
+	{11.064221, -9.323911, 1.6610951},
+	{12.221726, -10.062312, 1.7703475},
+	{13.417555, -10.827251, 1.8837202},
+	{-14.737078, 7.4685755, -0.82098526},
+	{-11.107374, 5.1701236, -0.48764995},
+	{-8.100062, 3.2621915, -0.21030816},
+	{-5.567336, 1.6533, 0.024060672},
+	{-3.4023538, 0.2770764, 0.22491251},
+	{-1.5253421, -0.9162615, 0.39935452},
+	{0.12524863, -1.9653332, 0.5529182},
+	{1.5986786, -2.9012458, 0.69007856},
+	{2.9361048, -3.7501836, 0.814623},
+	{4.1734214, -4.5351663, 0.92990166},
+	{5.342396, -5.2767177, 1.0389184},
+	{6.470504, -5.9926867, 1.1442977},
+	{7.5810056, -6.698242, 1.2482784},
+	{8.693727, -7.4063478, 1.352779},
+	{9.826117, -8.128436, 1.4594972},
+	{10.994272, -8.875066, 1.5700045},
+	{-16.463022, 8.987249, -1.0705848},
+	{-13.036316, 6.810208, -0.7542243},
+	{-10.159708, 4.9800577, -0.48778087},
+	{-7.7086773, 3.4192169, -0.26015237},
+	{-5.59174, 2.0705173, -0.06315407},
+	{-3.7397213, 0.89058, 0.109432146},
+	{-2.0987883, -0.1544954, 0.26247582},
+	{-0.6257205, -1.0920616, 0.3999178},
+	{0.7154583, -1.9450194, 0.5250706},
+	{1.9559785, -2.7333803, 0.6408426},
+	{3.1240714, -3.4753587, 0.7498953},
+	{4.2456546, -4.18778, 0.8546987},
+	{5.344087, -4.8858747, 0.95749813},
+	{6.440034, -5.583156, 1.0602914},
+	{7.5519357, -6.291703, 1.1648692},
+	{8.696808, -7.0226684, 1.2728884},
+	{-18.274595, 10.543436, -1.3239137},
+	{-15.001427, 8.4572935, -1.0201752},
+	{-12.223808, 6.685223, -0.7617932},
+	{-9.834271, 5.1598434, -0.539078},
+	{-7.752754, 3.830832, -0.34479162},
+	{-5.918039, 2.6596026, -0.17338003},
+	{-4.282093, 1.615781, -0.020465352},
+	{-2.8061826, 0.67477703, 0.11750375},
+	{-1.4580806, -0.18396005, 0.24350347},
+	{-0.20997104, -0.97826165, 0.36012533},
+	{0.96319073, -1.7242746, 0.46972585},
+	{2.084874, -2.4372172, 0.5745359},
+	{3.1774707, -3.1316812, 0.67670333},
+	{4.262048, -3.8214393, 0.7782618},
+	{5.3580894, -4.5192513, 0.8811001},
+	{6.4837766, -5.237029, 0.98698485},
+	{-20.191872, 12.157265, -1.5845002},
+	{-17.03225, 10.13717, -1.2898285},
+	{-14.326807, 8.406402, -1.0370867},
+	{-11.980734, 6.9051447, -0.81763524},
+	{-9.922554, 5.5882163, -0.6249476},
+	{-8.09708, 4.420654, -0.45397124},
+	{-6.460693, 3.3747463, -0.3006981},
+	{-4.978046, 2.4279742, -0.16186658},
+	{-3.6196988, 1.561512, -0.034744963},
+	{-2.3603177, 0.75909585, 0.083032705},
+	{-1.1772496, 0.006115506, 0.1935984},
+	{-0.049336683, -0.71113944, 0.29895994},
+	{1.044002, -1.4060673, 0.40108648},
+	{2.123215, -2.0920277, 0.5019479},
+	{3.2084196, -2.7821906, 0.6034907},
+	{4.319072, -3.4893, 0.70760167},
+	{-22.240187, 13.851483, -1.8561794},
+	{-19.160194, 11.876174, -1.5675201},
+	{-16.503239, 10.171749, -1.3182536},
+	{-14.183922, 8.684006, -1.1005192},
+	{-12.137222, 7.371613, -0.9083229},
+	{-10.312522, 6.2023315, -0.7369883},
+	{-8.669548, 5.1504607, -0.5827853},
+	{-7.175521, 4.19503, -0.44266772},
+	{-5.803087, 3.3184934, -0.31408346},
+	{-4.5287423, 2.5057263, -0.19482885},
+	{-3.3315754, 1.7432259, -0.082932286},
+	{-2.1922023, 1.0184284, 0.023445643},
+	{-1.0918175, 0.3190996, 0.1261011},
+	{-0.011392064, -0.36718106, 0.22686265},
+	{1.0687969, -1.0533159, 0.3276342},
+	{2.1690662, -1.7526059, 0.43038148},
+	{-24.451273, 15.652704, -2.1433144},
+	{-21.420624, 13.702978, -1.8578955},
+	{-18.790178, 12.01087, -1.6100665},
+	{-16.481459, 10.526277, -1.392534},
+	{-14.434227, 9.210687, -1.1996926},
+	{-12.601261, 8.03387, -1.0271415},
+	{-10.944782, 6.9715986, -0.871353},
+	{-9.433923, 6.004043, -0.7294379},
+	{-8.0428705, 5.114586, -0.59897333},
+	{-6.749458, 4.2889233, -0.47787145},
+	{-5.534039, 3.5143452, -0.3642741},
+	{-4.378521, 2.779114, -0.2564625},
+	{-3.2654874, 2.0718994, -0.15277399},
+	{-2.1773505, 1.3812295, -0.051521815},
+	{-1.095554, 0.69497836, 0.04908032},
+	{-2.1032154e-06, 1.5195495e-06, 0.15097141},
+	{-59.659008, 18.546356, -0.65794903},
+	{28.497995, -33.24638, 6.6157703},
+	{43.850132, -42.512844, 7.9549775},
+	{49.01206, -45.422382, 8.356949},
+	{52.1166, -47.075012, 8.576017},
+	{54.473347, -48.298298, 8.735369},
+	{56.505444, -49.35728, 8.874256},
+	{58.408276, -50.372948, 9.010477},
+	{60.292816, -51.413475, 9.154017},
+	{62.232178, -52.523743, 9.31146},
+	{64.28144, -53.7379, 9.487855},
+	{66.48855, -55.086315, 9.687772},
+	{68.90047, -56.599594, 9.915899},
+	{71.56813, -58.311783, 10.177544},
+	{74.550385, -60.26312, 10.479065},
+	{77.91761, -62.502495, 10.828262},
+	{-5.649864, -8.065313, 2.5520809},
+	{20.076134, -23.821432, 4.83403},
+	{29.535763, -29.656319, 5.6878695},
+	{34.13282, -32.433704, 6.090356},
+	{37.1915, -34.24696, 6.3506656},
+	{39.610386, -35.669006, 6.554247},
+	{41.72208, -36.91239, 6.7328725},
+	{43.687214, -38.07973, 6.9018974},
+	{45.599606, -39.231033, 7.070317},
+	{47.522655, -40.407036, 7.2442656},
+	{49.50489, -41.63918, 7.428516},
+	{51.587948, -42.954807, 7.627258},
+	{53.811504, -44.380352, 7.844589},
+	{56.216797, -45.943703, 8.084876},
+	{58.84965, -47.67623, 8.353073},
+	{61.763504, -49.614902, 8.65506},
+	{0.050122887, -9.074711, 2.4650126},
+	{14.114921, -17.805231, 3.73922},
+	{21.210596, -22.235327, 4.391347},
+	{25.324526, -24.784542, 4.76625},
+	{28.213219, -26.558922, 5.026608},
+	{30.538279, -27.981176, 5.235328},
+	{32.578617, -29.23057, 5.4192233},
+	{34.474895, -30.397804, 5.5919023},
+	{36.310402, -31.536776, 5.7614827},
+	{38.141716, -32.6843, 5.933537},
+	{40.0122, -33.86881, 6.1124005},
+	{41.95888, -35.114838, 6.3018456},
+	{44.01662, -36.44572, 6.505492},
+	{46.221035, -37.885555, 6.72711},
+	{48.611004, -39.460896, 6.970879},
+	{51.23115, -41.20242, 7.241658},
+	{-0.07078484, -7.4004335, 2.0779295},
+	{9.619936, -13.46362, 2.9660203},
+	{15.410793, -17.107172, 3.5040054},
+	{19.158428, -19.459993, 3.8522465},
+	{21.893179, -21.16945, 4.105418},
+	{24.11781, -22.556772, 4.3111606},
+	{26.073174, -23.777094, 4.492636},
+	{27.887339, -24.913315, 4.6622796},
+	{29.63746, -26.015614, 4.827651},
+	{31.376097, -27.118359, 4.993956},
+	{33.143414, -28.248007, 5.1652293},
+	{34.97355, -29.427221, 5.3449535},
+	{36.89838, -30.677359, 5.5364366},
+	{38.95019, -32.020218, 5.743078},
+	{41.163857, -33.479515, 5.9685993},
+	{43.579025, -35.082355, 6.217275},
+	{-1.5222535, -5.3281145, 1.6754289},
+	{5.9659333, -10.0398245, 2.366896},
+	{10.927516, -13.178576, 2.8310478},
+	{14.394053, -15.372279, 3.156714},
+	{17.006062, -17.021923, 3.4021256},
+	{19.147657, -18.37259, 3.6034644},
+	{21.029482, -19.56003, 3.7809436},
+	{22.770737, -20.661552, 3.9461398},
+	{24.445042, -21.725151, 4.1062756},
+	{26.102821, -22.783876, 4.2663527},
+	{27.782509, -23.863087, 4.430233},
+	{29.516579, -24.98435, 4.6012244},
+	{31.335167, -26.167837, 4.782442},
+	{33.268593, -27.433958, 4.9770603},
+	{35.349403, -28.804754, 5.1885247},
+	{37.614414, -30.30526, 5.4207654},
+	{-3.3737693, -3.2503383, 1.296704},
+	{2.8175411, -7.163096, 1.871465},
+	{7.2106676, -9.9534645, 2.284325},
+	{10.455526, -12.01772, 2.591148},
+	{12.972792, -13.6181965, 2.8297386},
+	{15.051907, -14.93904, 3.0271223},
+	{16.876371, -16.098448, 3.2008345},
+	{18.558363, -17.169226, 3.3617508},
+	{20.169554, -18.198156, 3.5168982},
+	{21.75956, -19.217804, 3.6711955},
+	{23.366169, -20.253109, 3.828434},
+	{25.02113, -21.325157, 3.9918385},
+	{26.753725, -22.453503, 4.164422},
+	{28.593248, -23.65782, 4.3492312},
+	{30.57102, -24.959225, 4.549556},
+	{32.72235, -26.381609, 4.7691298},
+	{-5.375015, -1.2315142, 0.94229037},
+	{-0.021767156, -4.6265574, 1.4411238},
+	{3.9644632, -7.1663938, 1.81686},
+	{7.0315795, -9.12476, 2.1079772},
+	{9.475377, -10.685627, 2.3408055},
+	{11.511182, -11.985427, 2.5352204},
+	{13.294861, -13.124373, 2.7060173},
+	{14.931739, -14.170811, 2.8633726},
+	{16.49244, -15.170865, 3.0141973},
+	{18.02676, -16.157238, 3.1634202},
+	{19.57272, -17.155024, 3.3148453},
+	{21.162119, -18.185326, 3.471692},
+	{22.824099, -19.267567, 3.6369424},
+	{24.58765, -20.421164, 3.8135934},
+	{26.483677, -21.666866, 4.0048633},
+	{28.54695, -23.02809, 4.2143974},
+	{-7.4588447, 0.73865396, 0.6056898},
+	{-2.679117, -2.3014848, 1.0522361},
+	{1.0086931, -4.6570163, 1.4004617},
+	{3.9340208, -6.529823, 1.6786765},
+	{6.319433, -8.0582285, 1.9065714},
+	{8.327904, -9.34508, 2.099013},
+	{10.086782, -10.471979, 2.2679713},
+	{11.692593, -11.501481, 2.4227204},
+	{13.2149725, -12.479063, 2.5700529},
+	{14.704606, -13.438013, 2.7149715},
+	{16.200514, -14.404082, 2.8613694},
+	{17.735247, -15.398848, 3.012528},
+	{19.33845, -16.442038, 3.171465},
+	{21.039444, -17.55323, 3.3411906},
+	{22.86938, -18.753265, 3.5249245},
+	{24.863274, -20.065615, 3.726304},
+	{-9.619492, 2.6895733, 0.27952638},
+	{-5.245371, -0.09942965, 0.68886447},
+	{-1.7784165, -2.3183439, 1.0165066},
+	{1.0364554, -4.1239924, 1.2844063},
+	{3.375603, -5.6260514, 1.5081112},
+	{5.368402, -6.9059715, 1.6993173},
+	{7.117061, -8.028975, 1.8675188},
+	{8.705895, -9.049555, 2.0207472},
+	{10.202111, -10.011557, 2.1655247},
+	{11.657486, -10.948973, 2.306943},
+	{13.112707, -11.888646, 2.449047},
+	{14.601778, -12.853094, 2.59525},
+	{16.155489, -13.862771, 2.7486682},
+	{17.804113, -14.937834, 2.9123888},
+	{19.579672, -16.099634, 3.0896957},
+	{21.518133, -17.372175, 3.2842927},
+	{-11.876574, 4.656315, -0.04335955},
+	{-7.792653, 2.046765, 0.3391931},
+	{-4.487718, -0.07196504, 0.65154237},
+	{-1.7554107, -1.8272532, 0.9115095},
+	{0.54969835, -3.3096933, 1.1318969},
+	{2.535183, -4.58697, 1.3223734},
+	{4.285379, -5.7127337, 1.4906934},
+	{5.871107, -6.7325807, 1.6435279},
+	{7.3539076, -7.686561, 1.7868012},
+	{8.785659, -8.608701, 1.9255891},
+	{10.209076, -9.52716, 2.064119},
+	{11.660311, -10.465856, 2.206003},
+	{13.172011, -11.446442, 2.3545265},
+	{14.776061, -12.490094, 2.512919},
+	{16.506023, -13.619119, 2.6845953},
+	{18.39954, -14.858536, 2.8733976},
+	{-14.264042, 6.677316, -0.37001318},
+	{-10.384932, 4.193813, -0.006472978},
+	{-7.194556, 2.145624, 0.29488698},
+	{-4.5192943, 0.4250314, 0.5491521},
+	{-2.2349722, -1.0455351, 0.7672651},
+	{-0.2489081, -2.3244357, 0.9575308},
+	{1.5114392, -3.4577584, 1.126572},
+	{3.1062956, -4.4841495, 1.2800022},
+	{4.5891113, -5.4382677, 1.4229119},
+	{6.008974, -6.352235, 1.560066},
+	{7.4099154, -7.2550445, 1.6957983},
+	{8.830742, -8.172345, 1.8339651},
+	{10.306754, -9.127513, 1.9780986},
+	{11.872243, -10.143272, 2.1316483},
+	{13.563083, -11.243394, 2.298235},
+	{15.4194145, -12.454453, 2.4819193},
+	{-16.828068, 8.795726, -0.7078015},
+	{-13.085674, 6.3952837, -0.35700864},
+	{-9.968887, 4.391759, -0.06287759},
+	{-7.3262424, 2.6906037, 0.18786581},
+	{-5.0481987, 1.2231036, 0.40491506},
+	{-3.0523953, -0.06271501, 0.5956492},
+	{-1.2742889, -1.20787, 0.7659374},
+	{0.33926696, -2.246407, 0.92069495},
+	{1.8348193, -3.208409, 1.0643086},
+	{3.2560089, -4.122334, 1.2009704},
+	{4.645412, -5.0161524, 1.3348371},
+	{6.0439215, -5.916852, 1.4699466},
+	{7.490176, -6.849986, 1.610142},
+	{9.021858, -7.8404765, 1.7591869},
+	{10.678143, -8.914211, 1.921004},
+	{12.502675, -10.099984, 2.099969},
+	{-19.629244, 11.062582, -1.06502},
+	{-15.96476, 8.707649, -0.7214953},
+	{-12.88345, 6.7243996, -0.4310415},
+	{-10.248629, 5.0269194, -0.18155546},
+	{-7.9605737, 3.552317, 0.03584968},
+	{-5.9438977, 2.252843, 0.22795023},
+	{-4.1394386, 1.090832, 0.40012214},
+	{-2.4987206, 0.03523103, 0.5568288},
+	{-0.979837, -0.9409939, 0.7019882},
+	{0.45593038, -1.8630086, 0.8392809},
+	{1.847025, -2.755982, 0.9724212},
+	{3.2334902, -3.646276, 1.1053299},
+	{4.656748, -4.5612383, 1.242097},
+	{6.158829, -5.528623, 1.3868914},
+	{7.783543, -6.5773106, 1.5440644},
+	{9.579415, -7.739214, 1.7184364},
+	{-22.748346, 13.541916, -1.4517277},
+	{-19.106329, 11.196637, -1.1102406},
+	{-16.022139, 9.208776, -0.8198356},
+	{-13.368309, 7.497626, -0.5691008},
+	{-11.051222, 6.003771, -0.34961894},
+	{-8.999813, 4.681979, -0.15496375},
+	{-7.158275, 3.4965994, 0.019949844},
+	{-5.4810514, 2.4184008, 0.17931241},
+	{-3.9291537, 1.4222379, 0.32675177},
+	{-2.4671657, 0.48513597, 0.46560752},
+	{-1.0604857, -0.41548616, 0.5991884},
+	{0.32741407, -1.3035927, 0.7310329},
+	{1.7373217, -2.206039, 0.865132},
+	{3.214903, -3.1529224, 1.0059785},
+	{4.8100305, -4.17706, 1.1584861},
+	{6.5781174, -5.3147964, 1.3281094},
+	{-26.29682, 16.318949, -1.8810135},
+	{-22.619694, 13.945597, -1.5360677},
+	{-19.490711, 11.925586, -1.2416981},
+	{-16.786901, 10.180461, -0.9867714},
+	{-14.417571, 8.652263, -0.7630525},
+	{-12.3137045, 7.296809, -0.5642521},
+	{-10.421108, 6.079318, -0.38540602},
+	{-8.6957, 4.971417, -0.22245067},
+	{-7.1000752, 3.948948, -0.071910314},
+	{-5.6007566, 2.9902024, 0.06935577},
+	{-4.1656957, 2.07431, 0.20438676},
+	{-2.7616472, 1.1795325, 0.3363693},
+	{-1.3511338, 0.28128508, 0.46892965},
+	{0.11086843, -0.6500143, 0.60645235},
+	{1.6791279, -1.6503267, 0.75428486},
+	{3.4182749, -2.761954, 0.9187303},
+	{-30.435379, 19.514002, -2.37109},
+	{-26.656864, 17.068592, -2.016289},
+	{-23.433159, 14.983257, -1.7131292},
+	{-20.641157, 13.178824, -1.4503367},
+	{-18.189917, 11.596754, -1.2195696},
+	{-16.010225, 10.192442, -1.0144644},
+	{-14.0478115, 8.930865, -0.8300162},
+	{-12.258712, 7.783608, -0.6621551},
+	{-10.605924, 6.7267046, -0.5074383},
+	{-9.056767, 5.738944, -0.36280718},
+	{-7.580563, 4.8003645, -0.22536966},
+	{-6.1462216, 3.890689, -0.092173114},
+	{-4.719341, 2.9874344, 0.040073127},
+	{-3.258303, 2.0633717, 0.17537218},
+	{-1.7090924, 1.083165, 0.31893632},
+	{-3.446588e-06, 2.4901567e-06, 0.477685},
+	{-35.78077, 2.329474, 2.1940427},
+	{44.478237, -46.252243, 9.284551},
+	{55.071205, -52.5492, 10.199403},
+	{59.611057, -55.04387, 10.5416},
+	{62.71914, -56.689438, 10.761254},
+	{65.302826, -58.062046, 10.945878},
+	{67.70839, -59.378708, 11.128163},
+	{70.11514, -60.750862, 11.324823},
+	{72.63566, -62.24856, 11.546399},
+	{75.3562, -63.92646, 11.801239},
+	{78.35603, -65.83638, 12.097451},
+	{81.71991, -68.035576, 12.444197},
+	{85.54757, -70.5933, 12.852754},
+	{89.96094, -73.5958, 13.337343},
+	{95.1185, -77.15663, 13.916827},
+	{101.23673, -81.43229, 14.6173525},
+	{8.180588, -18.550854, 4.6246376},
+	{30.390713, -32.456715, 6.704509},
+	{37.88578, -37.11028, 7.4008584},
+	{42.050434, -39.641087, 7.775773},
+	{45.14126, -41.50205, 8.050772},
+	{47.782185, -43.096825, 8.287681},
+	{50.233723, -44.594044, 8.512369},
+	{52.63795, -46.085907, 8.739029},
+	{55.088734, -47.63379, 8.977179},
+	{57.659008, -49.286003, 9.234393},
+	{60.414585, -51.086884, 9.517721},
+	{63.422768, -53.08257, 9.834592},
+	{66.75912, -55.32563, 10.193566},
+	{70.514, -57.87966, 10.605081},
+	{74.80081, -60.825123, 11.082416},
+	{79.76769, -64.26773, 11.6431},
+	{9.685915, -16.774143, 4.092787},
+	{22.250908, -24.736084, 5.291404},
+	{28.274288, -28.54843, 5.8681903},
+	{32.04382, -30.915133, 6.226076},
+	{34.941666, -32.72847, 6.5007463},
+	{37.448322, -34.301147, 6.7400956},
+	{39.779358, -35.774063, 6.9657965},
+	{42.05554, -37.22654, 7.1901164},
+	{44.357925, -38.712254, 7.4214206},
+	{46.75008, -40.27375, 7.666407},
+	{49.289124, -41.949764, 7.9312415},
+	{52.03271, -43.77986, 8.22229},
+	{55.04448, -45.80818, 8.546712},
+	{58.399433, -48.087166, 8.913071},
+	{62.190346, -50.682056, 9.332073},
+	{66.53651, -53.67709, 9.817591},
+	{7.5516725, -13.701593, 3.473445},
+	{16.52136, -19.429922, 4.338614},
+	{21.683249, -22.733318, 4.8409805},
+	{25.157133, -24.950123, 5.179101},
+	{27.883076, -26.68768, 5.4449778},
+	{30.254221, -28.20254, 5.6778216},
+	{32.460144, -29.619154, 5.8967648},
+	{34.60964, -31.009365, 6.1129193},
+	{36.776344, -32.422173, 6.3339224},
+	{39.01817, -33.896484, 6.5658956},
+	{41.387127, -35.467587, 6.814448},
+	{43.93542, -37.171253, 7.085315},
+	{46.720264, -39.046997, 7.3848815},
+	{49.80862, -41.14134, 7.7207055},
+	{53.282787, -43.5117, 8.102157},
+	{57.247883, -46.231644, 8.541278},
+	{4.8542366, -10.744343, 2.9224975},
+	{12.002411, -15.335691, 3.6170547},
+	{16.605013, -18.30251, 4.069314},
+	{19.867807, -20.404972, 4.3912826},
+	{22.462824, -22.076971, 4.64835},
+	{24.723692, -23.536486, 4.873728},
+	{26.82392, -24.89769, 5.0849347},
+	{28.865864, -26.228373, 5.2924385},
+	{30.919302, -27.57507, 5.503483},
+	{33.039112, -28.974686, 5.7238617},
+	{35.274494, -30.460583, 5.9588623},
+	{37.674706, -32.06643, 6.213864},
+	{40.293594, -33.829235, 6.494819},
+	{43.194004, -35.792416, 6.808748},
+	{46.453053, -38.00939, 7.1643195},
+	{50.169106, -40.548466, 7.5726533},
+	{2.07917, -8.002841, 2.433324},
+	{8.152283, -11.92083, 3.0262685},
+	{12.368748, -14.6524315, 3.44297},
+	{15.484696, -16.673187, 3.7529166},
+	{17.988113, -18.29743, 4.0031524},
+	{20.166529, -19.713026, 4.2221813},
+	{22.18307, -21.027466, 4.4264474},
+	{24.137333, -22.306808, 4.6261287},
+	{26.097809, -23.596786, 4.8283253},
+	{28.118298, -24.933586, 5.038703},
+	{30.246878, -26.349764, 5.2624025},
+	{32.53158, -27.87805, 5.504633},
+	{35.024857, -29.554346, 5.7711415},
+	{37.78794, -31.420717, 6.068697},
+	{40.89608, -33.52896, 6.405661},
+	{44.44549, -35.9454, 6.7927723},
+	{-0.7082749, -5.415705, 1.9860893},
+	{4.675731, -8.90095, 2.5132437},
+	{8.616849, -11.463361, 2.9039423},
+	{11.633253, -13.428185, 3.2053084},
+	{14.080525, -15.023557, 3.451176},
+	{16.203554, -16.409252, 3.6656451},
+	{18.157675, -17.687658, 3.8643196},
+	{20.042324, -18.924788, 4.0573373},
+	{21.926815, -20.166948, 4.251866},
+	{23.865538, -21.450706, 4.4536104},
+	{25.906864, -22.808765, 4.6677146},
+	{28.098953, -24.273844, 4.899363},
+	{30.49436, -25.881773, 5.154265},
+	{33.154575, -27.67457, 5.439146},
+	{36.155426, -29.704123, 5.762339},
+	{39.594387, -32.03717, 6.134591},
+	{-3.538814, -2.904438, 1.5631275},
+	{1.3851302, -6.100206, 2.0457754},
+	{5.1302285, -8.541445, 2.4174368},
+	{8.081031, -10.469325, 2.7127707},
+	{10.503214, -12.053517, 2.9566748},
+	{12.597691, -13.424657, 3.1686907},
+	{14.510482, -14.678902, 3.3633935},
+	{16.34235, -15.883153, 3.551017},
+	{18.165314, -17.085533, 3.7389812},
+	{20.035978, -18.324066, 3.9331946},
+	{22.004358, -19.632515, 4.138945},
+	{24.120003, -21.044447, 4.3615246},
+	{26.436905, -22.596525, 4.6067467},
+	{29.018396, -24.331816, 4.881469},
+	{31.942999, -26.303751, 5.1942244},
+	{35.312424, -28.581532, 5.5560946},
+	{-6.4725876, -0.39289626, 1.1495034},
+	{-1.8573965, -3.3941104, 1.6016631},
+	{1.754336, -5.7526197, 1.9598405},
+	{4.666061, -7.6586885, 2.2511542},
+	{7.0879965, -9.246116, 2.495034},
+	{9.180787, -10.618815, 2.7068534},
+	{11.074786, -11.862375, 2.8994842},
+	{12.871062, -13.04384, 3.0831265},
+	{14.645812, -14.214105, 3.265591},
+	{16.459558, -15.413796, 3.4531617},
+	{18.36548, -16.67875, 3.6514225},
+	{20.41585, -18.044277, 3.8659127},
+	{22.667452, -19.5488, 4.10269},
+	{25.187038, -21.2375, 4.3689084},
+	{28.05791, -23.16675, 4.67352},
+	{31.389025, -25.410244, 5.0282555},
+	{-9.586189, 2.194737, 0.73168665},
+	{-5.169522, -0.68126154, 1.1634798},
+	{-1.637832, -2.9900792, 1.5128865},
+	{1.2602649, -4.8893313, 1.8021725},
+	{3.700851, -6.490863, 2.0474155},
+	{5.8164907, -7.8800173, 2.2610924},
+	{7.7168536, -9.128425, 2.453856},
+	{9.497376, -10.299217, 2.6352355},
+	{11.238021, -11.445739, 2.8133688},
+	{13.004896, -12.612324, 2.995075},
+	{14.8561535, -13.838094, 3.1864166},
+	{16.84843, -15.161218, 3.3933449},
+	{19.04288, -16.622921, 3.6223218},
+	{21.511435, -18.271685, 3.880975},
+	{24.344498, -20.168365, 4.1789093},
+	{27.661686, -22.393398, 4.528848},
+	{-12.975235, 4.94101, 0.29602593},
+	{-8.668102, 2.1340992, 0.7154956},
+	{-5.167369, -0.15564017, 1.0604081},
+	{-2.2554348, -2.064679, 1.3498584},
+	{0.22182612, -3.690784, 1.5977526},
+	{2.3799531, -5.108093, 1.814803},
+	{4.3122587, -6.3772197, 2.0099087},
+	{6.1016264, -7.5526958, 2.1912055},
+	{7.8260894, -8.686421, 2.3665435},
+	{9.5572605, -9.8263445, 2.5432482},
+	{11.360298, -11.016234, 2.728057},
+	{13.298395, -12.29858, 2.9275513},
+	{15.439145, -13.718806, 3.1488025},
+	{17.861887, -15.330155, 3.4001307},
+	{20.666952, -17.199839, 3.6920698},
+	{23.988869, -19.417795, 4.038748},
+	{-16.76529, 7.94567, -0.17301197},
+	{-12.486769, 5.1565933, 0.24130927},
+	{-8.967359, 2.8548203, 0.5859597},
+	{-6.0101724, 0.91672355, 0.8780766},
+	{-3.4747658, -0.74671924, 1.1301819},
+	{-1.2558792, -2.2028909, 1.3518947},
+	{0.73019576, -3.505936, 1.55106},
+	{2.5555494, -4.7030025, 1.734615},
+	{4.2888565, -5.8395042, 1.9093438},
+	{6.000743, -6.9625626, 2.0823774},
+	{7.763265, -8.120482, 2.2610917},
+	{9.648878, -9.361885, 2.4529603},
+	{11.734655, -10.738422, 2.6659613},
+	{14.110451, -12.310166, 2.9094088},
+	{16.890333, -14.153161, 3.1951275},
+	{20.22941, -16.370518, 3.539188},
+	{-21.133318, 11.342462, -0.6953825},
+	{-16.798513, 8.516971, -0.27875668},
+	{-13.204291, 6.1677303, 0.07034308},
+	{-10.163385, 4.1767497, 0.36816356},
+	{-7.5421324, 2.4592447, 0.62650806},
+	{-5.240578, 0.95131814, 0.85438526},
+	{-3.1800427, -0.39776072, 1.0590453},
+	{-1.2944225, -1.6310054, 1.2467242},
+	{0.47692198, -2.7882566, 1.4232849},
+	{2.1960788, -3.9106798, 1.5948722},
+	{3.9331927, -5.0450983, 1.7685584},
+	{5.769041, -6.2455864, 1.9525766},
+	{7.7941704, -7.5726905, 2.1561942},
+	{10.113908, -9.096601, 2.390195},
+	{12.86173, -10.905901, 2.6682277},
+	{16.221458, -13.122108, 3.0090592},
+	{-26.349186, 15.3297, -1.2999101},
+	{-21.853682, 12.400071, -0.8717188},
+	{-18.112286, 9.957064, -0.51203984},
+	{-14.936027, 7.8808107, -0.2044022},
+	{-12.190761, 6.0859065, 0.06300607},
+	{-9.776907, 4.508637, 0.29907393},
+	{-7.616975, 3.099121, 0.5108355},
+	{-5.64716, 1.816003, 0.70419544},
+	{-3.810898, 0.6223374, 0.88450134},
+	{-2.052834, -0.5183114, 1.0571023},
+	{-0.31182247, -1.6464679, 1.2280265},
+	{1.4881392, -2.812736, 1.4048803},
+	{3.44691, -4.083681, 1.5977374},
+	{5.691785, -5.543924, 1.8194574},
+	{8.385506, -7.301074, 2.0864387},
+	{11.753573, -9.503397, 2.421329},
+	{-32.861603, 20.232235, -2.0331361},
+	{-28.055489, 17.099627, -1.5797952},
+	{-24.060108, 14.493214, -1.2001845},
+	{-20.670805, 12.28195, -0.87627256},
+	{-17.743784, 10.373623, -0.5953307},
+	{-15.173556, 8.700425, -0.34795302},
+	{-12.87926, 7.210222, -0.12685877},
+	{-10.795718, 5.860827, 0.07389464},
+	{-8.86693, 4.6158257, 0.25949153},
+	{-7.0404997, 3.440968, 0.43486798},
+	{-5.2615957, 2.3002245, 0.6052834},
+	{-3.4646125, 1.1502957, 0.77713203},
+	{-1.5596591, -0.068299316, 0.9592757},
+	{0.58858824, -1.4451036, 1.1651313},
+	{3.1828558, -3.113771, 1.4147903},
+	{6.5153766, -5.265602, 1.7370743},
+	{-41.49901, 26.64521, -2.9796839},
+	{-36.13158, 23.141964, -2.4780033},
+	{-31.702381, 20.252825, -2.062162},
+	{-27.968351, 17.820364, -1.7104418},
+	{-24.76168, 15.735885, -1.4078263},
+	{-21.961388, 13.921003, -1.143462},
+	{-19.476515, 12.316756, -0.9091575},
+	{-17.235476, 10.876703, -0.69843096},
+	{-15.1787, 9.562159, -0.50584894},
+	{-13.252848, 8.338438, -0.32650042},
+	{-11.405214, 7.1712184, -0.15547855},
+	{-9.576698, 6.021931, 0.012786417},
+	{-7.690387, 4.840298, 0.18562354},
+	{-5.629416, 3.5498354, 0.37426168},
+	{-3.1905086, 2.0175717, 0.5983165},
+	{-7.124614e-06, 5.1485117e-06, 0.89381325},
+	{-2.5080535, -21.59499, 6.49196},
+	{61.46882, -61.178436, 12.448795},
+	{69.60972, -65.830124, 13.11137},
+	{73.89331, -68.10005, 13.41553},
+	{77.129166, -69.79455, 13.641605},
+	{80.03708, -71.36862, 13.859403},
+	{82.93096, -73.01795, 14.098675},
+	{85.98628, -74.85145, 14.37597},
+	{89.33181, -76.9508, 14.703948},
+	{93.08503, -79.39349, 15.0949955},
+	{97.37862, -82.27077, 15.564133},
+	{102.373344, -85.69673, 16.130545},
+	{108.27167, -89.817795, 16.819157},
+	{115.35852, -94.84264, 17.665777},
+	{124.03899, -101.070816, 18.722122},
+	{134.90392, -108.94112, 20.064207},
+	{24.4038, -31.561941, 7.240757},
+	{42.022335, -42.75243, 8.95758},
+	{48.354046, -46.686855, 9.556227},
+	{52.43122, -49.183777, 9.934396},
+	{55.731495, -51.20823, 10.242796},
+	{58.72907, -53.070168, 10.529927},
+	{61.651367, -54.918587, 10.819185},
+	{64.63832, -56.845917, 11.125208},
+	{67.79525, -58.922806, 11.459341},
+	{71.217636, -61.21463, 11.832259},
+	{75.00601, -63.7916, 12.25561},
+	{79.27748, -66.736855, 12.743352},
+	{84.17759, -70.154945, 13.313183},
+	{89.897575, -74.18431, 13.9886875},
+	{96.69968, -79.01588, 14.802521},
+	{104.959015, -84.92373, 15.801709},
+	{21.211067, -26.43627, 6.1397586},
+	{31.827757, -33.272583, 7.1974735},
+	{37.120155, -36.659966, 7.723154},
+	{40.82219, -39.020794, 8.0907135},
+	{43.906643, -40.99433, 8.399985},
+	{46.73427, -42.818844, 8.688373},
+	{49.487484, -44.615482, 8.97501},
+	{52.28254, -46.462193, 9.272328},
+	{55.208996, -48.41982, 9.590151},
+	{58.348236, -50.544487, 9.937667},
+	{61.784912, -52.8954, 10.324684},
+	{65.61619, -55.541267, 10.7626915},
+	{69.9614, -58.567165, 11.266016},
+	{74.97485, -62.0837, 11.853372},
+	{80.86457, -66.24059, 12.550183},
+	{87.92265, -71.24862, 13.392344},
+	{17.00789, -21.839281, 5.255272},
+	{24.984097, -27.027027, 6.0622473},
+	{29.641888, -30.05587, 6.5366464},
+	{33.052624, -32.273827, 6.8860416},
+	{35.936977, -34.15621, 7.1845393},
+	{38.59475, -35.902103, 7.4633904},
+	{41.183887, -37.617092, 7.7392893},
+	{43.807148, -39.3705, 8.023302},
+	{46.544792, -41.2171, 8.324276},
+	{49.47021, -43.207573, 8.6504965},
+	{52.659645, -45.395153, 9.0107565},
+	{56.20024, -47.841175, 9.415269},
+	{60.198627, -50.621136, 9.876678},
+	{64.791985, -53.832558, 10.411389},
+	{70.16428, -57.606575, 11.041532},
+	{76.5719, -62.1263, 11.798083},
+	{13.104794, -17.98557, 4.549487},
+	{19.728792, -22.32654, 5.226988},
+	{23.982723, -25.12103, 5.666855},
+	{27.186552, -27.229027, 6.000953},
+	{29.91523, -29.030617, 6.288388},
+	{32.433563, -30.70226, 6.556821},
+	{34.886414, -32.34117, 6.821612},
+	{37.36936, -34.012054, 7.0930796},
+	{39.957653, -35.766342, 7.3795195},
+	{42.720432, -37.651783, 7.6887164},
+	{45.729713, -39.71851, 8.028923},
+	{49.06789, -42.02426, 8.409709},
+	{52.835876, -44.640038, 8.842908},
+	{57.163666, -47.65758, 9.343865},
+	{62.225796, -51.200283, 9.933285},
+	{68.26567, -55.44044, 10.6401415},
+	{9.480686, -14.615606, 3.954873},
+	{15.309448, -18.457806, 4.5555983},
+	{19.306719, -21.102543, 4.9729657},
+	{22.373672, -23.136518, 5.2963734},
+	{24.989891, -24.877157, 5.575001},
+	{27.400118, -26.487942, 5.834416},
+	{29.743555, -28.062542, 6.0893903},
+	{32.113075, -29.66395, 6.349952},
+	{34.582024, -31.342335, 6.6241727},
+	{37.21795, -33.14429, 6.9196234},
+	{40.091396, -35.118793, 7.2443347},
+	{43.28337, -37.322353, 7.6076303},
+	{46.893505, -39.824688, 8.0210705},
+	{51.050835, -42.71626, 8.499706},
+	{55.929646, -46.119373, 9.063946},
+	{61.774624, -50.205864, 9.742521},
+	{5.9955, -11.517884, 3.4256253},
+	{11.3265505, -15.048072, 3.977841},
+	{15.1686325, -17.603539, 4.3814874},
+	{18.159115, -19.598114, 4.6990666},
+	{20.703217, -21.29995, 4.9719},
+	{23.034355, -22.86519, 5.2243266},
+	{25.291662, -24.387629, 5.4710984},
+	{27.569038, -25.931038, 5.7223387},
+	{29.940569, -27.546099, 5.986175},
+	{32.474377, -29.279736, 6.270204},
+	{35.241722, -31.181278, 6.5824776},
+	{38.32476, -33.307808, 6.9323688},
+	{41.825207, -35.730125, 7.331558},
+	{45.875908, -38.540806, 7.7953653},
+	{50.658085, -41.86617, 8.344749},
+	{56.429146, -45.885666, 9.00955},
+	{2.5072496, -8.531165, 2.929749},
+	{7.526683, -11.866828, 3.451168},
+	{11.286619, -14.377416, 3.8475406},
+	{14.255485, -16.365885, 4.1641126},
+	{16.768114, -18.053278, 4.4346685},
+	{19.04882, -19.589682, 4.682489},
+	{21.24105, -21.071915, 4.9227443},
+	{23.443354, -22.566952, 5.166029},
+	{25.733236, -24.127813, 5.4208107},
+	{28.18153, -25.803207, 5.69494},
+	{30.862192, -27.644196, 5.9967117},
+	{33.860817, -29.71001, 6.335802},
+	{37.284206, -32.074635, 6.7243485},
+	{41.27336, -34.835773, 7.1784296},
+	{46.02328, -38.128498, 7.7203293},
+	{51.815506, -42.14773, 8.382272},
+	{-1.125796, -5.5183315, 2.4419928},
+	{3.7161744, -8.744728, 2.9453456},
+	{7.451093, -11.245614, 3.3394852},
+	{10.44721, -13.258386, 3.6594455},
+	{12.972789, -14.959261, 3.9318364},
+	{15.235268, -16.48678, 4.1779823},
+	{17.384125, -17.941837, 4.413611},
+	{19.52629, -19.397139, 4.6501684},
+	{21.74583, -20.910162, 4.8967905},
+	{24.11875, -22.533104, 5.161846},
+	{26.723888, -24.32025, 5.454105},
+	{29.652624, -26.3346, 5.7837996},
+	{33.019917, -28.655424, 6.163859},
+	{36.97954, -31.388704, 6.6116176},
+	{41.747852, -34.683407, 7.1515017},
+	{47.643894, -38.75897, 7.8195677},
+	{-5.061257, -2.3433218, 1.9390339},
+	{-0.28620175, -5.5311265, 2.4346766},
+	{3.477848, -8.056208, 2.8313615},
+	{6.542449, -10.119002, 3.1583073},
+	{9.128548, -11.863854, 3.4369977},
+	{11.413629, -13.408683, 3.685356},
+	{13.546382, -14.853662, 3.9188716},
+	{15.644478, -16.278769, 4.150059},
+	{17.80243, -17.748594, 4.3891225},
+	{20.104713, -19.321075, 4.645293},
+	{22.637827, -21.055628, 4.9281087},
+	{25.501726, -23.020926, 5.248652},
+	{28.823067, -25.303925, 5.62101},
+	{32.77383, -28.022535, 6.06434},
+	{37.60108, -31.345884, 6.606188},
+	{43.679035, -35.529686, 7.2882886},
+	{-9.497541, 1.1520602, 1.3954358},
+	{-4.6845927, -2.064391, 1.8929256},
+	{-0.8308095, -4.6521177, 2.2974632},
+	{2.343343, -6.790609, 2.634842},
+	{5.0340567, -8.607529, 2.9237795},
+	{7.3922167, -10.20244, 3.179161},
+	{9.549374, -11.663464, 3.4144263},
+	{11.627143, -13.073057, 3.642357},
+	{13.733408, -14.504884, 3.8745022},
+	{15.965746, -16.025785, 4.1214333},
+	{18.422403, -17.703115, 4.3938794},
+	{21.215708, -19.61381, 4.7041626},
+	{24.488548, -21.855562, 5.0679884},
+	{28.438013, -24.562822, 5.507036},
+	{33.354416, -27.933168, 6.0532355},
+	{39.692375, -32.275234, 6.7565794},
+	{-14.714382, 5.179636, 0.77887833},
+	{-9.747983, 1.8604465, 1.2883072},
+	{-5.7291512, -0.83794063, 1.7071186},
+	{-2.3928154, -3.0852861, 2.0592678},
+	{0.4466593, -5.0019546, 2.362097},
+	{2.9281883, -6.6791034, 2.6289923},
+	{5.1659184, -8.192589, 2.8713057},
+	{7.266921, -9.6145115, 3.1000364},
+	{9.342371, -11.020531, 3.3269107},
+	{11.50587, -12.488355, 3.564103},
+	{13.873699, -14.097584, 3.824176},
+	{16.57664, -15.937573, 4.1213107},
+	{19.780293, -18.121132, 4.473478},
+	{23.71635, -20.805552, 4.9058084},
+	{28.736103, -24.228512, 5.456367},
+	{35.41311, -28.776777, 6.1872406},
+	{-21.149351, 10.059914, 0.042552643},
+	{-15.880613, 6.54304, 0.57617146},
+	{-11.593029, 3.6680703, 1.0176983},
+	{-8.019148, 1.2643901, 1.3906219},
+	{-4.9723854, -0.7884576, 1.711909},
+	{-2.315286, -2.5801883, 1.994451},
+	{0.060581937, -4.1823416, 2.2487228},
+	{2.2495565, -5.6578603, 2.4841158},
+	{4.346016, -7.070419, 2.7103095},
+	{6.4597316, -8.494737, 2.9388392},
+	{8.724666, -10.022341, 3.1839786},
+	{11.29973, -11.761647, 3.4627376},
+	{14.384461, -13.848196, 3.7964659},
+	{18.259548, -16.471708, 4.215134},
+	{23.3639, -19.927412, 4.7654943},
+	{30.452545, -24.72082, 5.527804},
+	{-29.567772, 16.340527, -0.89033896},
+	{-23.76906, 12.481689, -0.31490165},
+	{-19.052061, 9.328898, 0.16159612},
+	{-15.122705, 6.695216, 0.56413794},
+	{-11.779036, 4.451017, 0.9104234},
+	{-8.8747425, 2.5014462, 1.2136847},
+	{-6.297703, 0.7731132, 1.4843665},
+	{-3.9555063, -0.79503775, 1.7313278},
+	{-1.7636064, -2.2593944, 1.9629083},
+	{0.36765176, -3.6802857, 2.1881955},
+	{2.5520527, -5.1348104, 2.4189792},
+	{4.9565687, -6.7364583, 2.6727839},
+	{7.8280067, -8.6526165, 2.9755912},
+	{11.523663, -11.12405, 3.3648787},
+	{16.614534, -14.532721, 3.900246},
+	{24.14604, -19.57321, 4.6903715},
+	{-41.534245, 25.123281, -2.1692574},
+	{-34.764637, 20.639889, -1.5176008},
+	{-29.32015, 17.02148, -0.9841485},
+	{-24.824272, 14.027277, -0.53722596},
+	{-21.029078, 11.498263, -0.15572153},
+	{-17.761343, 9.322812, 0.17538679},
+	{-14.893007, 7.417906, 0.46745956},
+	{-12.323325, 5.717798, 0.7296634},
+	{-9.966418, 4.166073, 0.97001964},
+	{-7.740307, 2.7085233, 1.1963891},
+	{-5.5534077, 1.2842078, 1.4177744},
+	{-3.281148, -0.19021462, 1.6466756},
+	{-0.7147057, -1.8549174, 1.9043328},
+	{2.5547955, -3.984253, 2.2325528},
+	{7.3198256, -7.1064806, 2.7120502},
+	{15.138216, -12.248688, 3.5001652},
+	{-61.153725, 39.2771, -4.1773286},
+	{-52.235424, 33.39262, -3.3503335},
+	{-45.309425, 28.819424, -2.6996775},
+	{-39.745434, 25.147211, -2.1712072},
+	{-35.157402, 22.12518, -1.7318345},
+	{-31.29216, 19.589113, -1.3598562},
+	{-27.973534, 17.424898, -1.0401226},
+	{-25.071669, 15.548569, -0.76140666},
+	{-22.484276, 13.894145, -0.5148018},
+	{-20.123217, 12.404957, -0.292564},
+	{-17.902172, 11.0256405, -0.087011896},
+	{-15.720489, 9.691607, 0.110968925},
+	{-13.432466, 8.308914, 0.31485325},
+	{-10.766869, 6.7013364, 0.550255},
+	{-7.045852, 4.425696, 0.88220376},
+	{-1.9831561e-05, 1.4328059e-05, 1.5294791},
+	{31.641926, -47.378822, 11.271602},
+	{77.87011, -76.14564, 15.6636095},
+	{84.985535, -80.00208, 16.190332},
+	{89.18845, -82.13081, 16.464106},
+	{92.598015, -83.888145, 16.69663},
+	{95.86132, -85.67831, 16.949886},
+	{99.27693, -87.6848, 17.251703},
+	{103.03024, -90.02243, 17.61968},
+	{107.27524, -92.790924, 18.0697},
+	{112.18242, -96.1068, 18.621098},
+	{117.96488, -100.12233, 19.299915},
+	{124.880455, -105.02606, 20.138926},
+	{133.31287, -111.10265, 21.18803},
+	{143.82225, -118.77306, 22.521656},
+	{157.22127, -128.6507, 24.248627},
+	{174.97606, -141.84282, 26.565395},
+	{39.26546, -44.04626, 9.8141},
+	{53.09401, -52.862843, 11.18672},
+	{59.003048, -56.53671, 11.753136},
+	{63.208744, -59.13741, 12.155239},
+	{66.81466, -61.391037, 12.508181},
+	{70.23153, -63.567955, 12.854921},
+	{73.67773, -65.81275, 13.218604},
+	{77.30099, -68.22467, 13.615336},
+	{81.226776, -70.88992, 14.059389},
+	{85.58352, -73.898865, 14.566008},
+	{90.52033, -77.35853, 15.153522},
+	{96.22276, -81.40396, 15.845301},
+	{102.938156, -86.216835, 16.673004},
+	{111.01153, -92.0522, 17.681295},
+	{120.94638, -99.28373, 18.93582},
+	{133.53172, -108.498665, 20.540005},
+	{32.064484, -35.88317, 8.169752},
+	{41.067818, -41.738243, 9.094489},
+	{46.09664, -44.99231, 9.61102},
+	{49.934612, -47.48146, 10.009271},
+	{53.31209, -49.69104, 10.366446},
+	{56.532818, -51.823925, 10.715007},
+	{59.76847, -53.995827, 11.073648},
+	{63.140953, -56.29006, 11.456021},
+	{66.75559, -58.77987, 11.874318},
+	{70.71957, -61.54101, 12.341336},
+	{75.15546, -64.661316, 12.87208},
+	{80.21475, -68.250305, 13.4854145},
+	{86.095634, -72.45223, 14.206325},
+	{93.069824, -77.46572, 15.069354},
+	{101.526924, -83.57639, 16.12433},
+	{112.053665, -91.21543, 17.446661},
+	{26.27756, -30.057745, 7.0641036},
+	{33.34587, -34.71992, 7.807469},
+	{37.81122, -37.669235, 8.282129},
+	{41.34646, -40.014465, 8.663041},
+	{44.502995, -42.124264, 9.008881},
+	{47.52922, -44.16583, 9.3464575},
+	{50.571236, -46.238438, 9.691857},
+	{53.73588, -48.415627, 10.057154},
+	{57.11713, -50.763004, 10.453276},
+	{60.811108, -53.348526, 10.891686},
+	{64.92769, -56.250645, 11.385746},
+	{69.60246, -59.56685, 11.952169},
+	{75.01231, -63.42487, 12.6129675},
+	{81.3986, -67.99958, 13.398379},
+	{89.10502, -73.5405, 14.351672},
+	{98.6433, -80.41974, 15.537535},
+	{21.551817, -25.573486, 6.249399},
+	{27.610342, -29.612171, 6.8975706},
+	{31.712166, -32.35739, 7.3430614},
+	{35.026775, -34.587036, 7.7084103},
+	{38.008984, -36.606556, 8.042208},
+	{40.877327, -38.56383, 8.368174},
+	{43.764015, -40.549152, 8.700949},
+	{46.767628, -42.63063, 9.051716},
+	{49.97612, -44.86975, 9.430708},
+	{53.48023, -47.3306, 9.84871},
+	{57.38419, -50.08735, 10.31831},
+	{61.817135, -53.232353, 10.855277},
+	{66.9481, -56.886906, 11.480411},
+	{73.00848, -61.21738, 12.222335},
+	{80.32845, -66.461525, 13.122062},
+	{89.400375, -72.97421, 14.240947},
+	{17.431255, -21.839928, 5.5985956},
+	{22.912352, -25.524252, 6.1925955},
+	{26.787495, -28.142775, 6.6198797},
+	{29.950937, -30.291779, 6.9741178},
+	{32.803555, -32.241444, 7.298232},
+	{35.54928, -34.13033, 7.6144357},
+	{38.314114, -36.044792, 7.9367223},
+	{41.19306, -38.05063, 8.275885},
+	{44.27174, -40.20772, 8.641869},
+	{47.639217, -42.57888, 9.0452175},
+	{51.398567, -45.237217, 9.498294},
+	{55.67843, -48.274303, 10.01667},
+	{60.64853, -51.811245, 10.621018},
+	{66.54313, -56.015385, 11.3399935},
+	{73.699646, -61.127937, 12.215001},
+	{82.6263, -67.51241, 13.308577},
+	{13.604918, -18.512808, 5.04174},
+	{18.757158, -21.999582, 5.6056085},
+	{22.511387, -24.555468, 6.0242333},
+	{25.584246, -26.65883, 6.3724294},
+	{28.347193, -28.560717, 6.689989},
+	{31.00049, -30.397617, 6.9987626},
+	{33.66998, -32.25604, 7.312745},
+	{36.450962, -34.20207, 7.64276},
+	{39.42966, -36.296024, 7.9987984},
+	{42.69636, -38.60147, 8.391496},
+	{46.35638, -41.192886, 8.833401},
+	{50.54245, -44.16437, 9.340446},
+	{55.431664, -47.641644, 9.933997},
+	{61.271645, -51.80057, 10.644027},
+	{68.424385, -56.898296, 11.514462},
+	{77.44474, -63.328964, 12.612788},
+	{9.8398485, -15.36005, 4.534156},
+	{14.828052, -18.754702, 5.0840864},
+	{18.553425, -21.306358, 5.5029793},
+	{21.595074, -23.401203, 5.8508024},
+	{24.307053, -25.278915, 6.165405},
+	{26.8949, -27.07994, 6.4692144},
+	{29.490158, -28.894897, 6.776859},
+	{32.19224, -30.792818, 7.0996113},
+	{35.090836, -32.836426, 7.4478292},
+	{38.280067, -35.091885, 7.8325424},
+	{41.87057, -37.637222, 8.266842},
+	{46.00331, -40.572, 8.767513},
+	{50.869076, -44.031033, 9.35736},
+	{56.739227, -48.206047, 10.068899},
+	{64.01863, -53.382977, 10.950744},
+	{73.3434, -60.010834, 12.0794},
+	{5.9263215, -12.192383, 4.0416813},
+	{10.876206, -15.576514, 4.5901623},
+	{14.6582985, -18.179863, 5.017934},
+	{17.735262, -20.309841, 5.3721986},
+	{20.440084, -22.191805, 5.688319},
+	{22.9902, -23.97458, 5.989969},
+	{25.529383, -25.757381, 6.293109},
+	{28.16576, -27.615368, 6.6099668},
+	{30.995777, -29.615973, 6.9516344},
+	{34.120255, -31.829971, 7.329858},
+	{37.658268, -34.341133, 7.7586274},
+	{41.763355, -37.257534, 8.256075},
+	{46.646828, -40.727856, 8.847224},
+	{52.615772, -44.96803, 9.568511},
+	{60.14052, -50.30841, 10.475811},
+	{69.98462, -57.28476, 11.65995},
+	{1.6324176, -8.817976, 3.5319781},
+	{6.6605496, -12.268459, 4.0906634},
+	{10.579895, -14.976974, 4.535477},
+	{13.771419, -17.195425, 4.904539},
+	{16.527939, -19.12124, 5.2284756},
+	{19.075987, -20.909462, 5.5317893},
+	{21.578396, -22.67259, 5.8324895},
+	{24.158316, -24.496294, 6.144446},
+	{26.923523, -26.455925, 6.47999},
+	{29.985138, -28.629404, 6.851984},
+	{33.47436, -31.10883, 7.275729},
+	{37.562252, -34.01424, 7.771252},
+	{42.488777, -37.513836, 8.366703},
+	{48.611893, -41.858166, 9.104118},
+	{56.498413, -47.443275, 10.0501},
+	{67.108315, -54.938824, 11.317549},
+	{-3.3492336, -4.997908, 2.966983},
+	{1.8891293, -8.602862, 3.549019},
+	{6.0326085, -11.474799, 4.019606},
+	{9.425839, -13.840882, 4.4126034},
+	{12.318777, -15.868495, 4.75353},
+	{14.922668, -17.70162, 5.064856},
+	{17.418093, -19.464958, 5.366389},
+	{19.951143, -21.260199, 5.6744833},
+	{22.648127, -23.17566, 6.0034976},
+	{25.63592, -25.300255, 6.3680143},
+	{29.062757, -27.737865, 6.7851796},
+	{33.123314, -30.624746, 7.277579},
+	{38.096478, -34.15569, 7.877596},
+	{44.41148, -38.629807, 8.635082},
+	{52.77716, -44.53985, 9.632356},
+	{64.462006, -52.76544, 11.016756},
+	{-9.491685, -0.37791622, 2.2924013},
+	{-3.867617, -4.255061, 2.9149122},
+	{0.6199831, -7.3713107, 3.423149},
+	{4.3123794, -9.951072, 3.8499796},
+	{7.446246, -12.152013, 4.2189918},
+	{10.205197, -14.098218, 4.5491533},
+	{12.757397, -15.90517, 4.8585253},
+	{15.267689, -17.687458, 5.1653514},
+	{17.890392, -19.553015, 5.4870253},
+	{20.777704, -21.608501, 5.8408923},
+	{24.102694, -23.9751, 6.2467923},
+	{28.091976, -26.811102, 6.7307525},
+	{33.076687, -30.347073, 7.3307953},
+	{39.585777, -34.950077, 8.107595},
+	{48.540504, -41.25717, 9.166715},
+	{61.7148, -50.4908, 10.711301},
+	{-17.628038, 5.652559, 1.4171791},
+	{-11.346062, 1.321211, 2.1056886},
+	{-6.322402, -2.168437, 2.669974},
+	{-2.1870952, -5.0587773, 3.1446726},
+	{1.3106129, -7.516136, 3.5541098},
+	{4.3534894, -9.663168, 3.9166002},
+	{7.0895104, -11.600441, 4.2474794},
+	{9.660462, -13.425673, 4.5619826},
+	{12.22795, -15.251557, 4.8780146},
+	{14.977853, -17.20838, 5.2165203},
+	{18.122768, -19.445066, 5.601618},
+	{21.934982, -22.151663, 6.064228},
+	{26.816135, -25.607183, 6.649878},
+	{33.43482, -30.273787, 7.4341507},
+	{43.038013, -37.009144, 8.557651},
+	{58.28151, -47.63062, 10.319251},
+	{-29.459623, 14.320104, 0.1636405},
+	{-22.018843, 9.204015, 0.96186084},
+	{-16.107004, 5.1067715, 1.6139977},
+	{-11.2719, 1.7346983, 2.1602285},
+	{-7.2142916, -1.109312, 2.6283855},
+	{-3.7244422, -3.5649834, 3.0386827},
+	{-0.6448695, -5.738316, 3.4067864},
+	{2.1548562, -7.7180276, 3.7460887},
+	{4.800578, -9.59068, 4.06994},
+	{7.443739, -11.46154, 4.394894},
+	{10.30799, -13.48702, 4.745867},
+	{13.728839, -15.901555, 5.1604395},
+	{18.209917, -19.054926, 5.694848},
+	{24.616009, -23.543194, 6.445109},
+	{34.704712, -30.568619, 7.605309},
+	{52.811672, -43.073814, 9.652109},
+	{-49.25134, 28.631561, -1.8809505},
+	{-39.486523, 21.984465, -0.8850094},
+	{-31.89983, 16.770588, -0.082494825},
+	{-25.8112, 12.557118, 0.5807766},
+	{-20.795246, 9.0693865, 1.1406306},
+	{-16.566051, 6.1199794, 1.6225201},
+	{-12.920623, 3.574358, 2.045281},
+	{-9.705419, 1.3299333, 2.4236333},
+	{-6.793455, -0.6986835, 2.7701006},
+	{-4.064225, -2.5930192, 3.0968966},
+	{-1.3773805, -4.44868, 3.4186296},
+	{1.4810257, -6.412482, 3.758079},
+	{4.948918, -8.786535, 4.162558},
+	{10.093581, -12.306066, 4.7476506},
+	{19.496307, -18.730293, 5.7902193},
+	{41.09497, -33.392166, 8.132109},
+	{-93.797806, 60.098713, -6.1771708},
+	{-76.569176, 48.603474, -4.600631},
+	{-64.4465, 40.46062, -3.4492896},
+	{-55.354053, 34.318714, -2.5548754},
+	{-48.265266, 29.515036, -1.8361338},
+	{-42.593735, 25.672495, -1.2468596},
+	{-37.973022, 22.556215, -0.7580098},
+	{-34.160065, 20.011488, -0.35024056},
+	{-30.983742, 17.931099, -0.01002946},
+	{-28.315653, 16.237179, 0.27245355},
+	{-26.047804, 14.8676405, 0.5049753},
+	{-24.067146, 13.760897, 0.6951991},
+	{-22.20849, 12.827084, 0.85462445},
+	{-20.115463, 11.860115, 1.0111104},
+	{-16.55156, 10.088746, 1.274193},
+	{-8.5586136e-05, 6.183518e-05, 2.7452419},
+	{53.26961, -64.38131, 14.516599},
+	{88.91352, -86.36862, 17.866936},
+	{95.637405, -89.85712, 18.323164},
+	{99.81637, -91.89658, 18.575008},
+	{103.34951, -93.69367, 18.810541},
+	{106.865906, -95.63728, 19.089127},
+	{110.65009, -97.898674, 19.4364},
+	{114.90555, -100.604614, 19.871994},
+	{119.803444, -103.866974, 20.413984},
+	{125.572464, -107.845825, 21.08954},
+	{132.47906, -112.73535, 21.932575},
+	{140.87476, -118.79617, 22.989128},
+	{151.3296, -126.45759, 24.335726},
+	{164.62943, -136.31897, 26.080189},
+	{182.051, -149.35197, 28.397104},
+	{206.17691, -167.53105, 31.642036},
+	{48.115627, -51.76903, 11.445107},
+	{60.294537, -59.527035, 12.660329},
+	{66.13274, -63.162148, 13.225516},
+	{70.49017, -65.87673, 13.65091},
+	{74.337166, -68.311226, 14.038842},
+	{78.06435, -70.72378, 14.430688},
+	{81.89011, -73.25996, 14.850016},
+	{85.971664, -76.02659, 15.314431},
+	{90.45221, -79.12352, 15.840765},
+	{95.48841, -82.66278, 16.44827},
+	{101.26884, -86.78178, 17.160906},
+	{108.03675, -91.65996, 18.01025},
+	{116.12918, -97.54808, 19.040709},
+	{126.02767, -104.80639, 20.31637},
+	{138.47182, -113.98986, 21.936216},
+	{154.678, -126.0145, 24.064102},
+	{38.783875, -41.894783, 9.48108},
+	{47.08373, -47.321007, 10.348384},
+	{52.097847, -50.592033, 10.875261},
+	{56.095325, -53.215622, 11.302422},
+	{59.71082, -55.61589, 11.697997},
+	{63.228584, -57.984024, 12.092924},
+	{66.81964, -60.436394, 12.506269},
+	{70.613266, -63.062477, 12.952939},
+	{74.72885, -65.94648, 13.447208},
+	{79.29514, -69.18076, 14.004965},
+	{84.46595, -72.877014, 14.645627},
+	{90.43791, -77.17928, 15.394423},
+	{97.47666, -82.28325, 16.285803},
+	{105.957634, -88.46656, 17.368828},
+	{116.43953, -96.143486, 18.71691},
+	{129.79802, -105.96492, 20.445698},
+	{32.20665, -35.429253, 8.25779},
+	{38.85937, -39.85551, 8.974489},
+	{43.329277, -42.84086, 9.46354},
+	{47.01923, -45.323025, 9.874719},
+	{50.405834, -47.622944, 10.259684},
+	{53.71873, -49.89649, 10.643752},
+	{57.102604, -52.243034, 11.043273},
+	{60.6707, -54.741558, 11.471445},
+	{64.52946, -57.46741, 11.941058},
+	{68.7942, -60.50329, 12.466318},
+	{73.60279, -63.948944, 13.064517},
+	{79.13149, -67.93272, 13.75804},
+	{85.617455, -72.62796, 14.57726},
+	{93.3945, -78.279366, 15.56519},
+	{102.95434, -85.24809, 16.785492},
+	{115.05779, -94.09388, 18.337076},
+	{27.135841, -30.678068, 7.3993807},
+	{32.910484, -34.570408, 8.035489},
+	{37.02461, -37.36063, 8.497554},
+	{40.4888, -39.72751, 8.893956},
+	{43.69636, -41.937416, 9.267611},
+	{46.847404, -44.127056, 9.640756},
+	{50.072094, -46.386356, 10.028214},
+	{53.474937, -48.788494, 10.44223},
+	{57.155853, -51.404327, 10.894822},
+	{61.224186, -54.312164, 11.399422},
+	{65.8115, -57.606884, 11.97243},
+	{71.086876, -61.410965, 12.635132},
+	{77.27889, -65.890465, 13.416501},
+	{84.71042, -71.28036, 14.357666},
+	{93.85821, -77.9281, 15.519592},
+	{105.46157, -86.37305, 16.997158},
+	{22.885052, -26.864328, 6.743659},
+	{28.160744, -30.457527, 7.3350596},
+	{32.050133, -33.125946, 7.7805405},
+	{35.358307, -35.41252, 8.166705},
+	{38.43445, -37.55488, 8.531839},
+	{41.464, -39.680336, 8.896672},
+	{44.570232, -41.874435, 9.2753105},
+	{47.853832, -44.20788, 9.679583},
+	{51.412514, -46.75, 10.12124},
+	{55.354404, -49.57814, 10.613522},
+	{59.810875, -52.78677, 11.172711},
+	{64.95234, -56.4988, 11.820102},
+	{71.011406, -60.88234, 12.584914},
+	{78.31995, -66.1775, 13.509013},
+	{87.37337, -72.74296, 14.655089},
+	{98.94973, -81.142395, 16.121895},
+	{19.054373, -23.571669, 6.207232},
+	{24.064066, -27.01367, 6.776935},
+	{27.831343, -29.622894, 7.21539},
+	{31.043287, -31.86419, 7.5966024},
+	{34.02941, -33.962734, 7.95683},
+	{36.971264, -36.043697, 8.316447},
+	{39.99109, -38.192207, 8.689492},
+	{43.189686, -40.47919, 9.087823},
+	{46.66591, -42.974827, 9.523317},
+	{50.53037, -45.758152, 10.009476},
+	{54.918945, -48.926617, 10.563048},
+	{60.010174, -52.60855, 11.206198},
+	{66.05105, -56.981674, 11.969712},
+	{73.39951, -62.30366, 12.898354},
+	{82.59953, -68.96619, 14.060332},
+	{94.524254, -77.598, 15.565406},
+	{15.366186, -20.53316, 5.7393966},
+	{20.279697, -23.934807, 6.304914},
+	{24.01846, -26.545599, 6.746026},
+	{27.191414, -28.778353, 7.128224},
+	{30.125874, -30.85753, 7.487596},
+	{33.00906, -32.91269, 7.845212},
+	{35.9678, -35.03242, 8.215666},
+	{39.10675, -37.290493, 8.611275},
+	{42.529015, -39.76014, 9.044411},
+	{46.35102, -42.52448, 9.529238},
+	{50.717545, -45.687138, 10.083519},
+	{55.821648, -49.386475, 10.731075},
+	{61.935165, -53.81738, 11.505556},
+	{69.46027, -59.268124, 12.456866},
+	{79.02357, -66.18773, 13.6629305},
+	{91.66236, -75.319244, 15.25302},
+	{11.582263, -17.539146, 5.3031273},
+	{16.541271, -20.995424, 5.8796387},
+	{20.350843, -23.675173, 6.3344026},
+	{23.549347, -25.943352, 6.724946},
+	{26.47318, -28.031261, 7.0883474},
+	{29.32535, -30.079832, 7.447483},
+	{32.243877, -32.185734, 7.8182487},
+	{35.341606, -34.428654, 8.213922},
+	{38.729305, -36.887325, 8.647771},
+	{42.532722, -39.651474, 9.135049},
+	{46.910107, -42.8342, 9.695087},
+	{52.076088, -46.588974, 10.354219},
+	{58.33941, -51.13647, 11.150421},
+	{66.16902, -56.811604, 12.141469},
+	{76.3197, -64.15297, 13.420511},
+	{90.09362, -74.08903, 15.148538},
+	{7.444094, -14.3806925, 4.864432},
+	{12.584041, -17.984581, 5.4668803},
+	{16.579117, -20.813309, 5.9484835},
+	{19.889196, -23.17759, 6.3576565},
+	{22.8562, -25.312696, 6.731898},
+	{25.70942, -27.37822, 7.097028},
+	{28.607115, -29.485289, 7.471265},
+	{31.67642, -31.723858, 7.869552},
+	{35.04022, -34.18136, 8.306582},
+	{38.837746, -36.957153, 8.799195},
+	{43.246002, -40.177647, 9.368927},
+	{48.50958, -44.01744, 10.045637},
+	{54.989403, -48.73389, 10.873451},
+	{63.251884, -54.730278, 11.921687},
+	{74.24748, -62.682327, 13.30678},
+	{89.707756, -73.819435, 15.241171},
+	{2.6005921, -10.790994, 4.382602},
+	{8.0813, -14.654194, 5.0289006},
+	{12.394585, -17.726126, 5.5528955},
+	{15.940602, -20.275723, 5.9958105},
+	{19.037254, -22.52099, 6.3919797},
+	{21.94082, -24.640472, 6.7701116},
+	{24.841967, -26.768358, 7.152106},
+	{27.891987, -29.01193, 7.55571},
+	{31.232803, -31.472338, 7.9978576},
+	{35.02278, -34.262695, 8.497647},
+	{39.464325, -37.527573, 9.079626},
+	{44.842434, -41.470257, 9.778424},
+	{51.590473, -46.39924, 10.646728},
+	{60.416676, -52.817516, 11.770637},
+	{72.57481, -61.613552, 13.30262},
+	{90.52085, -74.524155, 15.541657},
+	{-3.519767, -6.349567, 3.795496},
+	{2.5345876, -10.636163, 4.511648},
+	{7.338276, -14.074721, 5.0982547},
+	{11.286883, -16.93035, 5.595278},
+	{14.664242, -19.396252, 6.0325484},
+	{17.71717, -21.643675, 6.4372244},
+	{20.670183, -23.830748, 6.8349156},
+	{23.713894, -26.092928, 7.2478924},
+	{27.02186, -28.554228, 7.696807},
+	{30.780994, -31.348457, 8.204153},
+	{35.228016, -34.644947, 8.798561},
+	{40.701717, -38.68546, 9.521039},
+	{47.735188, -43.849216, 10.43605},
+	{57.244656, -50.7858, 11.654247},
+	{70.969574, -60.72433, 13.385527},
+	{92.673325, -76.31584, 16.084154},
+	{-12.009337, -0.26314136, 2.9869354},
+	{-4.9825463, -5.2537355, 3.816389},
+	{0.59809726, -9.264051, 4.4985476},
+	{5.1801, -12.593501, 5.0776553},
+	{9.063619, -15.445519, 5.58448},
+	{12.473217, -17.974491, 6.0429287},
+	{15.61377, -20.32381, 6.475816},
+	{18.704353, -22.649195, 6.9084845},
+	{21.968723, -25.111006, 7.367437},
+	{25.641106, -27.877607, 7.880802},
+	{30.0074, -31.15427, 8.48311},
+	{35.47746, -35.234207, 9.223845},
+	{42.719505, -40.593254, 10.183437},
+	{52.956387, -48.097847, 11.508564},
+	{68.73856, -59.546, 13.5042925},
+	{96.43393, -79.40533, 16.931479},
+	{-25.380053, 9.28243, 1.6920682},
+	{-16.554298, 3.0122068, 2.7204297},
+	{-9.617785, -1.9800769, 3.5617015},
+	{-3.972764, -6.0927625, 4.2728662},
+	{0.7637461, -9.58455, 4.8920135},
+	{4.8554473, -12.635784, 5.446368},
+	{8.501761, -15.384702, 5.9573402},
+	{11.87879, -17.955297, 6.4446626},
+	{15.1888685, -20.492332, 6.931955},
+	{18.708502, -23.195473, 7.4522963},
+	{22.800047, -26.32673, 8.049458},
+	{27.970623, -30.25132, 8.784693},
+	{35.062412, -35.57165, 9.759114},
+	{45.735924, -43.466373, 11.170552},
+	{63.942657, -56.717808, 13.486565},
+	{102.045494, -83.95948, 18.163515},
+	{-51.261543, 27.741247, -0.8685201},
+	{-38.321426, 18.622656, 0.57232636},
+	{-28.495184, 11.585992, 1.7261982},
+	{-20.718912, 5.938278, 2.6833057},
+	{-14.351132, 1.2512826, 3.5030663},
+	{-8.9800415, -2.7543275, 4.2258167},
+	{-4.3234406, -6.272561, 4.880532},
+	{-0.17245932, -9.448937, 5.4896784},
+	{3.6456838, -12.40557, 6.072771},
+	{7.3020654, -15.265109, 6.650023},
+	{11.021806, -18.190672, 7.248782},
+	{15.232073, -21.492771, 7.921083},
+	{20.887949, -25.862692, 8.782076},
+	{30.133465, -32.835552, 10.086204},
+	{49.141487, -46.768314, 12.5531435},
+	{107.464424, -88.16849, 19.576487},
+	{-133.90912, 85.33632, -8.500862},
+	{-101.57753, 63.593433, -5.579081},
+	{-81.281265, 49.644043, -3.5706692},
+	{-66.80937, 39.483017, -2.0115423},
+	{-55.9093, 31.685959, -0.74483794},
+	{-47.43474, 25.526974, 0.31058064},
+	{-40.71175, 20.574297, 1.2063302},
+	{-35.32789, 16.559563, 1.9776931},
+	{-31.02533, 13.316723, 2.6481857},
+	{-27.662992, 10.763836, 3.230224},
+	{-25.201948, 8.899932, 3.7246072},
+	{-23.713614, 7.8226824, 4.116903},
+	{-23.42732, 7.788427, 4.3666744},
+	{-24.848286, 9.369719, 4.3786607},
+	{-28.810875, 13.7611265, 3.938853},
+	{-0.00078209594, 0.0005650549, 5.9769945},
+	{59.98228, -69.791794, 15.568099},
+	{92.59554, -89.792755, 18.605478},
+	{99.21422, -93.17088, 19.039343},
+	{103.386345, -95.17907, 19.28324},
+	{106.96058, -96.98827, 19.519442},
+	{110.56256, -98.983765, 19.806627},
+	{114.472916, -101.33299, 20.169703},
+	{118.90118, -104.1668, 20.629007},
+	{124.02604, -107.60231, 21.203547},
+	{130.09914, -111.817024, 21.923672},
+	{137.40312, -117.01816, 22.825796},
+	{146.33089, -123.49812, 23.961824},
+	{157.52515, -131.74345, 25.418964},
+	{171.85506, -142.42004, 27.317654},
+	{190.81624, -156.66896, 29.863735},
+	{217.48978, -176.85715, 33.48582},
+	{50.863525, -54.21044, 11.966685},
+	{62.641365, -61.708984, 13.14322},
+	{68.47949, -65.34654, 13.710363},
+	{72.89532, -68.10462, 14.144471},
+	{76.82737, -70.60319, 14.54482},
+	{80.662056, -73.097916, 14.952498},
+	{84.618416, -75.735146, 15.391284},
+	{88.85755, -78.62474, 15.879374},
+	{93.52927, -81.871666, 16.43456},
+	{98.800735, -85.59605, 17.077606},
+	{104.8747, -89.946304, 17.834532},
+	{112.016205, -95.11878, 18.740063},
+	{120.5961, -101.390465, 19.8435},
+	{131.14815, -109.16216, 21.216486},
+	{144.50818, -119.06325, 22.971798},
+	{162.06671, -132.14491, 25.298407},
+	{40.913475, -43.823467, 9.904757},
+	{49.04297, -49.14745, 10.758952},
+	{54.072006, -52.437527, 11.291434},
+	{58.131245, -55.11229, 11.729386},
+	{61.8317, -57.58076, 12.138706},
+	{65.4533, -60.03163, 12.550038},
+	{69.167625, -62.582027, 12.982658},
+	{73.10694, -65.32387, 13.4519615},
+	{77.395905, -68.345474, 13.973006},
+	{82.17123, -71.74535, 14.562832},
+	{87.59808, -75.64398, 15.242497},
+	{93.88982, -80.198265, 16.039625},
+	{101.337296, -85.623276, 16.99227},
+	{110.35551, -92.22704, 18.155132},
+	{121.569496, -100.47473, 19.611048},
+	{135.97058, -111.10588, 21.492062},
+	{34.113056, -37.173485, 8.6474},
+	{40.66591, -41.5459, 9.358929},
+	{45.15543, -44.555786, 9.854861},
+	{48.906548, -47.090874, 10.277486},
+	{52.3767, -49.459866, 10.676664},
+	{55.791256, -51.816147, 11.077411},
+	{59.29525, -54.2597, 11.496258},
+	{63.004604, -56.871708, 11.94685},
+	{67.030464, -59.731216, 12.44268},
+	{71.49527, -62.926403, 12.998987},
+	{76.54721, -66.56496, 13.634539},
+	{82.377625, -70.786736, 14.373863},
+	{89.246376, -75.78245, 15.250546},
+	{97.522644, -81.82374, 16.31259},
+	{107.75605, -89.3158, 17.631786},
+	{120.80737, -98.89434, 19.321053},
+	{28.958332, -32.358288, 7.7794003},
+	{34.664562, -36.218906, 8.414106},
+	{38.800335, -39.036587, 8.8838415},
+	{42.325474, -41.457783, 9.292233},
+	{45.616764, -43.73835, 9.680663},
+	{48.870003, -46.012455, 10.071057},
+	{52.21557, -48.370525, 10.478403},
+	{55.76053, -50.88787, 10.915376},
+	{59.609413, -53.63895, 11.394685},
+	{63.87853, -56.70744, 11.930767},
+	{68.70965, -60.196022, 12.541475},
+	{74.28692, -64.23863, 13.250217},
+	{80.86157, -69.01853, 14.089148},
+	{88.79188, -74.79751, 15.104335},
+	{98.61256, -81.9668, 16.36481},
+	{111.163246, -91.14146, 17.979366},
+	{24.696396, -28.545399, 7.127865},
+	{29.920938, -32.11978, 7.720262},
+	{33.832718, -34.81772, 8.174112},
+	{37.20228, -37.160507, 8.572942},
+	{40.36393, -39.376354, 8.953681},
+	{43.498634, -41.589924, 9.336728},
+	{46.72971, -43.887146, 9.736339},
+	{50.160442, -46.34089, 10.164784},
+	{53.893295, -49.024204, 10.634527},
+	{58.043674, -52.020027, 11.159854},
+	{62.753704, -55.43101, 11.758568},
+	{68.20985, -59.392242, 12.45421},
+	{74.66903, -64.0902, 13.279391},
+	{82.501625, -69.79399, 14.281304},
+	{92.2667, -76.91014, 15.531422},
+	{104.85426, -86.08622, 17.143875},
+	{20.903496, -25.298725, 6.605718},
+	{25.874195, -28.731928, 7.1785035},
+	{29.66357, -31.372356, 7.626081},
+	{32.93767, -33.672337, 8.020836},
+	{36.01244, -35.84852, 8.397825},
+	{39.06456, -38.023186, 8.777068},
+	{42.216125, -40.281746, 9.172737},
+	{45.570633, -42.697384, 9.597155},
+	{49.232044, -45.344276, 10.0629635},
+	{53.318897, -48.307537, 10.584804},
+	{57.979, -51.69369, 11.181107},
+	{63.408836, -55.644623, 11.876534},
+	{69.883095, -60.35891, 12.705708},
+	{77.804276, -66.12763, 13.719519},
+	{87.79161, -73.398766, 14.996479},
+	{100.85416, -82.902374, 16.664864},
+	{17.292107, -22.343885, 6.1611185},
+	{22.179937, -25.748371, 6.732274},
+	{25.939713, -28.392115, 7.1834483},
+	{29.175432, -30.686485, 7.5803094},
+	{32.202663, -32.848755, 7.957988},
+	{35.203278, -35.005314, 8.337165},
+	{38.303627, -37.24485, 8.732555},
+	{41.611164, -39.64351, 9.156972},
+	{45.234653, -42.278965, 9.623637},
+	{49.29948, -45.241207, 10.148002},
+	{53.964123, -48.644257, 10.749756},
+	{59.442642, -52.64233, 11.455636},
+	{66.03993, -57.45511, 12.303841},
+	{74.21262, -63.411594, 13.351725},
+	{84.68211, -71.0314, 14.690104},
+	{98.66354, -81.1896, 16.472301},
+	{13.619689, -19.469246, 5.7571874},
+	{18.57386, -22.946339, 6.343205},
+	{22.404297, -25.662397, 6.8095055},
+	{25.665363, -27.995464, 7.2161455},
+	{28.686567, -30.173172, 7.599859},
+	{31.66526, -32.333183, 7.983148},
+	{34.738476, -34.57199, 8.382003},
+	{38.021923, -36.971783, 8.810236},
+	{41.632736, -39.616306, 9.282085},
+	{45.70708, -42.60329, 9.814301},
+	{50.419502, -46.058224, 10.428509},
+	{56.01007, -50.153736, 11.154565},
+	{62.82876, -55.141403, 12.036102},
+	{71.4145, -61.408333, 13.140427},
+	{82.64876, -69.58721, 14.577818},
+	{98.084045, -80.791565, 16.542904},
+	{9.626644, -16.46751, 5.361093},
+	{14.794759, -20.12027, 5.9789586},
+	{18.818308, -22.995615, 6.4751253},
+	{22.190018, -25.428972, 6.902388},
+	{25.258482, -27.661661, 7.299531},
+	{28.248888, -29.85129, 7.6922565},
+	{31.317926, -32.10854, 8.098871},
+	{34.59551, -34.525925, 8.534894},
+	{38.21171, -37.196613, 9.016138},
+	{42.31815, -40.229523, 9.561239},
+	{47.111763, -43.766243, 10.194548},
+	{52.86912, -48.005486, 10.950352},
+	{60.004642, -53.244682, 11.880109},
+	{69.17918, -59.95745, 13.06595},
+	{81.52311, -68.952805, 14.648533},
+	{99.1487, -81.7407, 16.891155},
+	{4.961215, -13.075446, 4.9341917},
+	{10.517591, -17.028168, 5.604484},
+	{14.887576, -20.173946, 6.14949},
+	{18.499952, -22.803198, 6.614218},
+	{21.70064, -25.155134, 7.036774},
+	{24.752386, -27.414082, 7.4470897},
+	{27.845156, -29.714659, 7.867359},
+	{31.13277, -32.166744, 8.315967},
+	{34.76515, -34.878025, 8.81115},
+	{38.91563, -37.97328, 9.374222},
+	{43.8116, -41.61619, 10.033283},
+	{49.77964, -46.0415, 10.828749},
+	{57.325394, -51.611904, 11.8232155},
+	{67.29113, -58.93024, 13.120946},
+	{81.20339, -69.08672, 14.910997},
+	{102.1509, -84.28321, 17.576433},
+	{-0.9579813, -8.871873, 4.416659},
+	{5.2443013, -13.310091, 5.169898},
+	{10.161759, -16.87412, 5.789025},
+	{14.210259, -19.844591, 6.316793},
+	{17.700722, -22.435118, 6.7866316},
+	{20.910982, -24.840424, 7.22986},
+	{24.07783, -27.228746, 7.6740723},
+	{27.396229, -29.739887, 8.142569},
+	{31.048475, -32.50531, 8.6575575},
+	{35.239483, -35.673138, 9.244298},
+	{40.237984, -39.43744, 9.936059},
+	{46.43863, -44.082504, 10.781704},
+	{54.476295, -50.06422, 11.859725},
+	{65.46809, -58.182026, 13.308023},
+	{81.59902, -69.99453, 15.396131},
+	{107.806244, -89.0135, 18.733532},
+	{-9.273305, -3.0372083, 3.6921227},
+	{-1.9706053, -8.288172, 4.581174},
+	{3.8265777, -12.515325, 5.3158045},
+	{8.5930605, -16.038801, 5.943926},
+	{12.648236, -19.076948, 6.4990234},
+	{16.241055, -21.802877, 7.008373},
+	{19.616285, -24.39025, 7.5001473},
+	{23.02312, -27.01839, 8.004064},
+	{26.701117, -29.861092, 8.549383},
+	{30.906282, -33.104713, 9.167853},
+	{35.96582, -36.987175, 9.900255},
+	{42.366722, -41.861248, 10.807192},
+	{50.926792, -48.316456, 11.990001},
+	{63.188423, -57.45885, 13.638984},
+	{82.49781, -71.675896, 16.165737},
+	{117.74652, -97.28279, 20.6633},
+	{-22.726902, 6.396003, 2.4780283},
+	{-13.34635, -0.36503294, 3.6117496},
+	{-5.977828, -5.7607, 4.544874},
+	{0.031858757, -10.230673, 5.341443},
+	{5.0976734, -14.058274, 6.0439644},
+	{9.505584, -17.441637, 6.6830273},
+	{13.476819, -20.536688, 7.2834597},
+	{17.225996, -23.497787, 7.870638},
+	{21.024755, -26.523458, 8.477955},
+	{25.212393, -29.863548, 9.148021},
+	{30.211008, -33.829235, 9.934385},
+	{36.635483, -38.87183, 10.9149065},
+	{45.556225, -45.77105, 12.223875},
+	{59.175003, -56.117043, 14.134587},
+	{83.02753, -73.87317, 17.32813},
+	{136.24622, -112.62534, 24.147781},
+	{-50.30409, 25.860611, -0.1489769},
+	{-35.91212, 15.546493, 1.5258896},
+	{-25.034248, 7.5957522, 2.8720326},
+	{-16.409945, 1.172168, 4.0027437},
+	{-9.2984085, -4.227253, 4.990213},
+	{-3.2296157, -8.927823, 5.88328},
+	{2.1184309, -13.15705, 6.7177086},
+	{6.9877625, -17.090288, 7.522537},
+	{11.587326, -20.883364, 8.324998},
+	{16.145891, -24.711071, 9.156824},
+	{21.032629, -28.85676, 10.069424},
+	{26.97559, -33.8746, 11.161665},
+	{35.351646, -40.801395, 12.616574},
+	{49.254147, -51.929497, 14.833566},
+	{78.38508, -74.314, 19.027025},
+	{179.40833, -148.33359, 32.128033},
+	{-155.0359, 98.45355, -9.644509},
+	{-112.2169, 69.54971, -5.7788095},
+	{-86.75182, 51.767464, -3.1559324},
+	{-68.720535, 38.75145, -1.0656936},
+	{-55.127327, 28.641125, 0.6809346},
+	{-44.460007, 20.475084, 2.1908777},
+	{-35.756348, 13.597539, 3.555638},
+	{-28.423006, 7.5816636, 4.844913},
+	{-22.054829, 2.1144607, 6.119796},
+	{-16.360754, -3.0562556, 7.442224},
+	{-11.117005, -8.165383, 8.887559},
+	{-6.131641, -13.480354, 10.566351},
+	{-1.235229, -19.367632, 12.6706085},
+	{3.593497, -26.362957, 15.593591},
+	{6.612184, -34.464783, 20.276798},
+	{-0.041696273, 0.030125199, 22.733437},
+	{60.503883, -70.215546, 15.650925},
+	{92.88946, -90.06635, 18.664495},
+	{99.50006, -93.43578, 19.096577},
+	{103.67163, -95.44142, 19.33982},
+	{107.249146, -97.25157, 19.57607},
+	{110.85798, -99.25121, 19.863943},
+	{114.77847, -101.60752, 20.228296},
+	{119.22064, -104.45164, 20.689518},
+	{124.36383, -107.90116, 21.266697},
+	{130.46158, -112.13503, 21.99045},
+	{137.79779, -117.36149, 22.897388},
+	{146.76894, -123.875694, 24.039923},
+	{158.0237, -132.16891, 25.50615},
+	{172.43822, -142.91258, 27.417557},
+	{191.52733, -157.26271, 29.982752},
+	{218.41501, -177.62015, 33.636677},
+	{51.07892, -54.402626, 12.007852},
+	{62.827454, -61.88217, 13.181554},
+	{68.665985, -65.520195, 13.748897},
+	{73.0866, -68.281845, 14.183717},
+	{77.02554, -70.78561, 14.585073},
+	{80.86889, -73.286964, 14.994032},
+	{84.83576, -75.93234, 15.43439},
+	{89.08757, -78.83185, 15.924392},
+	{93.77467, -82.09088, 16.481913},
+	{99.0651, -85.830215, 17.127836},
+	{105.162766, -90.19918, 17.888351},
+	{112.334496, -95.3955, 18.798449},
+	{120.95392, -101.69836, 19.907827},
+	{131.55914, -109.5119, 21.288765},
+	{144.99406, -119.47177, 23.055197},
+	{162.6639, -132.6406, 25.398224},
+	{41.081177, -43.975784, 9.938272},
+	{49.198288, -49.29239, 10.791539},
+	{54.22886, -52.584217, 11.324507},
+	{58.293198, -55.263195, 11.763344},
+	{62.00054, -57.7372, 12.17378},
+	{65.63052, -60.194763, 12.586441},
+	{69.35479, -62.75308, 13.02062},
+	{73.30585, -65.504265, 13.4917555},
+	{77.60878, -68.53699, 14.014969},
+	{82.40096, -71.95025, 14.607393},
+	{87.848495, -75.865265, 15.290223},
+	{94.1661, -80.43997, 16.091278},
+	{101.646706, -85.89106, 17.048912},
+	{110.70859, -92.529076, 18.218294},
+	{121.98228, -100.8234, 19.68304},
+	{136.46883, -111.521065, 21.57659},
+	{34.263695, -37.311638, 8.678298},
+	{40.809353, -41.68026, 9.389492},
+	{45.300777, -44.69234, 9.886014},
+	{49.05696, -47.231796, 10.30958},
+	{52.533916, -49.606415, 10.709916},
+	{55.95671, -51.969414, 11.112019},
+	{59.470425, -54.420834, 11.532435},
+	{63.191196, -57.042034, 11.984849},
+	{67.23057, -59.912373, 12.48281},
+	{71.71156, -63.120476, 13.04164},
+	{76.7832, -66.77469, 13.680233},
+	{82.63809, -71.015816, 14.42329},
+	{89.53794, -76.035995, 15.304666},
+	{97.85485, -82.1091, 16.372772},
+	{108.14329, -89.64402, 17.700089},
+	{121.272415, -99.28283, 19.40071},
+	{29.102873, -32.491825, 7.8096347},
+	{34.80422, -36.350296, 8.444289},
+	{38.942062, -39.170414, 8.9146805},
+	{42.472286, -41.596123, 9.324063},
+	{45.770435, -43.88248, 9.713704},
+	{49.032, -46.163475, 10.105508},
+	{52.38739, -48.529594, 10.514478},
+	{55.943897, -51.05632, 10.953324},
+	{59.806423, -53.818413, 11.434814},
+	{64.09185, -56.89999, 11.97347},
+	{68.94281, -60.40438, 12.587261},
+	{74.544655, -64.46647, 13.299774},
+	{81.15051, -69.27092, 14.143422},
+	{89.121605, -75.08179, 15.164695},
+	{98.997475, -82.29396, 16.4333},
+	{111.62623, -91.52889, 18.0592},
+	{24.840687, -28.67957, 7.1585555},
+	{30.06159, -32.252754, 7.751083},
+	{33.975525, -34.95332, 8.205651},
+	{37.350254, -37.30081, 8.605535},
+	{40.51897, -39.522728, 8.987562},
+	{43.6623, -41.743534, 9.3721075},
+	{46.903587, -44.049213, 9.773443},
+	{50.346344, -46.512814, 10.203876},
+	{54.09343, -49.2077, 10.675926},
+	{58.26085, -52.217266, 11.20397},
+	{62.991623, -55.64485, 11.805937},
+	{68.47352, -59.626537, 12.505553},
+	{74.96553, -64.350365, 13.335717},
+	{82.84122, -70.087845, 14.344067},
+	{92.665, -77.24954, 15.602819},
+	{105.33641, -86.49019, 17.22742},
+	{21.051605, -25.437292, 6.63771},
+	{26.019503, -28.870018, 7.210783},
+	{29.811045, -31.513247, 7.6591473},
+	{33.09045, -33.81819, 8.05504},
+	{36.17261, -36.000843, 8.433426},
+	{39.233852, -38.183277, 8.814298},
+	{42.396286, -40.450935, 9.211842},
+	{45.763638, -42.877197, 9.638421},
+	{49.440292, -45.536583, 10.106742},
+	{53.54546, -48.51471, 10.63154},
+	{58.22794, -51.91886, 11.231389},
+	{63.68569, -55.89204, 11.931156},
+	{70.19576, -60.634605, 12.765793},
+	{78.16439, -66.44045, 13.786708},
+	{88.21718, -73.76233, 15.073291},
+	{101.37485, -83.33904, 16.755417},
+	{17.447456, -22.490143, 6.1951923},
+	{22.33337, -25.894976, 6.7668266},
+	{26.095257, -28.541698, 7.218874},
+	{29.336428, -30.841358, 7.6169825},
+	{32.3715, -33.010635, 7.996203},
+	{35.381935, -35.17569, 8.37719},
+	{38.494083, -37.425232, 8.774669},
+	{41.815647, -39.835625, 9.201497},
+	{45.45586, -42.48491, 9.670969},
+	{49.540874, -45.46366, 10.198646},
+	{54.23032, -48.886784, 10.80438},
+	{59.74, -52.909805, 11.515152},
+	{66.37764, -57.75455, 12.369554},
+	{74.60449, -63.75348, 13.425577},
+	{85.15009, -71.432304, 14.775153},
+	{99.24496, -81.67752, 16.573702},
+	{13.785525, -19.626484, 5.7941694},
+	{18.73924, -23.105246, 6.380964},
+	{22.571756, -25.824564, 6.848264},
+	{25.838387, -28.163298, 7.2562933},
+	{28.867966, -30.348703, 7.6417437},
+	{31.8574, -32.51819, 8.027087},
+	{34.94368, -34.768246, 8.428328},
+	{38.242775, -37.181293, 8.859323},
+	{41.872364, -39.84152, 9.334395},
+	{45.969513, -42.847336, 9.870426},
+	{50.71013, -46.325268, 10.489232},
+	{56.33648, -50.449615, 11.220979},
+	{63.202057, -55.474598, 12.109786},
+	{71.851814, -61.79184, 13.223786},
+	{83.17827, -70.04225, 14.674768},
+	{98.75589, -81.355675, 16.660337},
+	{9.806358, -16.639374, 5.4019556},
+	{14.976557, -20.296022, 6.02108},
+	{19.002645, -23.17539, 6.518491},
+	{22.379967, -25.614882, 6.9473367},
+	{25.457375, -27.856117, 7.3464746},
+	{28.459671, -30.056498, 7.7415915},
+	{31.543447, -32.32669, 8.151004},
+	{34.83888, -34.759453, 8.590285},
+	{38.476677, -37.44846, 9.075346},
+	{42.609543, -40.503468, 9.62498},
+	{47.436108, -44.067356, 10.263781},
+	{53.235752, -48.340973, 11.026429},
+	{60.42753, -53.62523, 11.965025},
+	{69.68056, -60.399937, 13.162832},
+	{82.14116, -69.48599, 14.76268},
+	{99.955734, -82.4186, 17.032469},
+	{5.158587, -13.26628, 4.9801693},
+	{10.720941, -17.22629, 5.652454},
+	{15.095565, -20.378239, 6.1992373},
+	{18.71391, -23.01454, 6.6658807},
+	{21.924057, -25.376057, 7.0907874},
+	{24.989035, -27.64742, 7.503961},
+	{28.098719, -29.963259, 7.9276195},
+	{31.407187, -32.433712, 8.380205},
+	{35.065094, -35.167057, 8.880076},
+	{39.247116, -38.289124, 9.448747},
+	{44.18282, -41.96527, 10.114627},
+	{50.202503, -46.433067, 10.91866},
+	{57.818222, -52.060055, 11.924332},
+	{67.88423, -59.45804, 13.237551},
+	{81.95182, -69.735664, 15.050736},
+	{103.16806, -85.13788, 17.754797},
+	{-0.73911726, -9.086762, 4.469356},
+	{5.475213, -13.537591, 5.2257357},
+	{10.402096, -17.112232, 5.8476562},
+	{14.459154, -20.092659, 6.3781157},
+	{17.95996, -22.69449, 6.850896},
+	{21.184855, -25.114359, 7.2976594},
+	{24.371347, -27.521143, 7.746135},
+	{27.714722, -30.05497, 8.219705},
+	{31.39815, -32.84806, 8.740733},
+	{35.6282, -36.049854, 9.334738},
+	{40.67654, -39.856705, 10.0354185},
+	{46.94292, -44.556816, 10.892367},
+	{55.071533, -50.61314, 11.985382},
+	{66.19802, -58.839046, 13.454919},
+	{82.54919, -70.824265, 15.57619},
+	{109.174774, -90.16368, 18.973793},
+	{-9.031496, -3.2806625, 3.7534888},
+	{-1.7057703, -8.553764, 4.647703},
+	{4.1098266, -12.799704, 5.3869586},
+	{8.892337, -16.340322, 6.0194736},
+	{12.962808, -19.395256, 6.57897},
+	{16.572796, -22.13962, 7.0930862},
+	{19.970808, -24.749933, 7.5905066},
+	{23.4082, -27.40727, 8.101284},
+	{27.1258, -30.286545, 8.6549225},
+	{31.381758, -33.575893, 9.283528},
+	{36.507298, -37.51645, 10.028505},
+	{42.996838, -42.46678, 10.951551},
+	{51.68258, -49.027443, 12.156061},
+	{64.13783, -58.32792, 13.836648},
+	{83.78722, -72.814285, 16.415628},
+	{119.779396, -98.99164, 21.020533},
+	{-22.476091, 6.1288033, 2.5493085},
+	{-13.048029, -0.67505723, 3.6924238},
+	{-5.641662, -6.106756, 4.633923},
+	{0.40077662, -10.609415, 5.438447},
+	{5.4969773, -14.468508, 6.1489043},
+	{9.934858, -17.88376, 6.796195},
+	{13.937884, -21.012938, 7.405509},
+	{17.725405, -24.014132, 8.002919},
+	{21.57599, -27.091331, 8.622832},
+	{25.83407, -30.498646, 9.308715},
+	{30.9278, -34.552357, 10.115159},
+	{37.48365, -39.713165, 11.121872},
+	{46.59625, -46.779903, 12.466788},
+	{60.524654, -57.38691, 14.431458},
+	{84.97493, -75.62625, 17.720634},
+	{139.84491, -115.650734, 24.780628},
+	{-50.16618, 25.651093, -0.07730843},
+	{-35.64546, 15.228938, 1.6192669},
+	{-24.671988, 7.193268, 2.983765},
+	{-15.967918, 0.6950422, 4.1316023},
+	{-8.783618, -4.775555, 5.1361885},
+	{-2.6437237, -9.54822, 6.047222},
+	{2.7777839, -13.854052, 6.9012537},
+	{7.726516, -17.871807, 7.7281175},
+	{12.416045, -21.761812, 8.556123},
+	{17.083965, -25.7069, 9.418798},
+	{22.122965, -30.010153, 10.371529},
+	{28.296919, -35.255955, 11.51932},
+	{37.03462, -42.525505, 13.054425},
+	{51.559338, -54.21785, 15.397469},
+	{82.04726, -77.76027, 19.833431},
+	{189.06784, -156.45352, 33.82724},
+	{-157.01405, 99.67544, -9.748643},
+	{-113.113495, 70.029976, -5.7843523},
+	{-87.127625, 51.853302, -3.095642},
+	{-68.72537, 38.530884, -0.9457776},
+	{-54.84253, 28.163868, 0.85626644},
+	{-43.922096, 19.758839, 2.4221182},
+	{-34.965084, 12.628996, 3.849555},
+	{-27.351034, 6.3216166, 5.2144427},
+	{-20.643564, 0.49087268, 6.586509},
+	{-14.505651, -5.1664424, 8.041789},
+	{-8.63297, -10.977041, 9.68158},
+	{-2.6677341, -17.399794, 11.671073},
+	{3.9633367, -25.271784, 14.337664},
+	{12.552448, -36.62538, 18.508963},
+	{27.930737, -59.327656, 27.443214},
+	{-1841.4076, 1671.5839, 1321.0122},
+}
@@ -0,0 +1,104 @@
+// Copyright © 2019 Xavier Basty <xavier@hexbee.net>
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package gocolor
+
+import (
+	"bytes"
+	"flag"
+	"fmt"
+	"go/format"
+	"io/ioutil"
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var updateTables = flag.Bool("update", false, "regenerate the precomputed Jakob-Hanika coefficient table")
+
+// TestJakobHanikaD65Coefficients checks the precomputed table against the
+// optimization of a sample of its columns, or regenerates it with -update.
+func TestJakobHanikaD65Coefficients(t *testing.T) {
+	const res = jakobHanikaResolution
+
+	w, err := spectralWeights(Observer2, IlluminantsSpectres[RefIlluminantD65])
+	require.NoError(t, err)
+	table := newJakobHanikaTable(w)
+
+	if *updateTables {
+		table.optimize(w)
+		require.NoError(t, writeJakobHanikaTable("spectral_table_data.go", table.coefficients))
+		return
+	}
+
+	require.Len(t, jakobHanikaD65Coefficients, 3*res*res*res)
+	table.coefficients = make([][3]float32, 3*res*res*res)
+	for l := 0; l < 3; l++ {
+		for j := 0; j < res; j += 5 {
+			for i := l; i < res; i += 4 {
+				table.optimizeColumn(w, l, j, i)
+
+				for k := 0; k < res; k++ {
+					n := ((l*res+k)*res+j)*res + i
+					expected, actual := jakobHanikaD65Coefficients[n], table.coefficients[n]
+					for c := range expected {
+						// A few float32 ulps.
+						tolerance := 4 * float64(math.Nextafter32(expected[c], float32(math.Inf(1)))-expected[c])
+						assert.InDeltaf(t, expected[c], actual[c], tolerance, "coefficient %v of color (%v, %v, %v, %v)", c, l, k, j, i)
+					}
+				}
+			}
+		}
+	}
+}
+
+// writeJakobHanikaTable writes the Go source of the precomputed table.
+func writeJakobHanikaTable(path string, coefficients [][3]float32) error {
+	header, err := ioutil.ReadFile(path)
+	if err != nil {
+		return err
+	}
+	// Keep the license header, up to the first empty line.
+	end := bytes.Index(header, []byte("\n\n"))
+	if end < 0 {
+		return fmt.Errorf("could not find the license header of %v", path)
+	}
+
+	var b bytes.Buffer
+	b.Write(header[:end+2])
+	b.WriteString(`// Code generated by "go test -run TestJakobHanikaD65Coefficients -update"; DO NOT EDIT.
+
+package gocolor
+
+// jakobHanikaD65Coefficients are the sigmoid coefficients of the
+// Jakob-Hanika table of the D65 illuminant and the 2° standard observer,
+// computed by (*jakobHanikaTable).optimize.
+//
+// Spectra close to 0 at all wavelengths need steep sigmoids, so the
+// coefficients of the darkest colors reach magnitudes of 10⁴.
+var jakobHanikaD65Coefficients = [][3]float32{
+`)
+	for _, c := range coefficients {
+		fmt.Fprintf(&b, "\t{%v, %v, %v},\n", c[0], c[1], c[2])
+	}
+	b.WriteString("}\n")
+
+	src, err := format.Source(b.Bytes())
+	if err != nil {
+		return err
+	}
+	return ioutil.WriteFile(path, src, 0644)
+}
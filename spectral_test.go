@@ -0,0 +1,109 @@
+// Copyright © 2019 Xavier Basty <xavier@hexbee.net>
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package gocolor_test
+
+import (
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/Hexbee-net/gocolor"
+)
+
+var spectralMethods = []string{
+	gocolor.SpectralSmits,
+	gocolor.SpectralMeng,
+	gocolor.SpectralJakobHanika,
+}
+
+func TestXYZtoSpectral_InvalidParameters(t *testing.T) {
+	d65 := gocolor.IlluminantsSpectres[gocolor.RefIlluminantD65]
+
+	_, err := gocolor.XYZtoSpectral(0.2, 0.2, 0.2, gocolor.Observer2, d65, "invalid method")
+	assert.Errorf(t, err, "invalid method should return an error")
+
+	_, err = gocolor.XYZtoSpectral(0.2, 0.2, 0.2, gocolor.Observer2, d65[1:], gocolor.SpectralMeng)
+	assert.Errorf(t, err, "invalid illuminant should return an error")
+
+	_, err = gocolor.XYZtoSpectral(0.9, 0.1, 0.0, gocolor.Observer2, d65, gocolor.SpectralJakobHanika)
+	assert.Errorf(t, err, "color outside of the reflectance gamut should return an error")
+}
+
+func TestXYZtoSpectral(t *testing.T) {
+	// Reference reflectances, from which the XYZ coordinates are computed.
+	ramp := make([]float64, 50)
+	bump := make([]float64, 50)
+	dip := make([]float64, 50)
+	for i := range ramp {
+		ramp[i] = 0.1 + 0.8*float64(i)/49
+		bump[i] = 0.1 + 0.6*math.Exp(-math.Pow(float64(i-15)/4, 2))
+		dip[i] = 0.8 - 0.6*math.Exp(-math.Pow(float64(i-20)/5, 2))
+	}
+	reflectances := [][]float64{ramp, bump, dip}
+
+	for _, observer := range []int{gocolor.Observer2, gocolor.Observer10} {
+		for _, illuminant := range []string{gocolor.RefIlluminantD65, gocolor.RefIlluminantA, gocolor.RefIlluminantF11} {
+			spd := gocolor.IlluminantsSpectres[illuminant]
+
+			for n := 0; n < len(reflectances); n++ {
+				tx, ty, tz, err := gocolor.SpectralToXYZ(reflectances[n], observer, spd)
+				assert.NoError(t, err)
+
+				for _, method := range spectralMethods {
+					s, err := gocolor.XYZtoSpectral(tx, ty, tz, observer, spd, method)
+					if !assert.NoErrorf(t, err, "%v reconstruction failed for test #%v under %v", method, n+1, illuminant) {
+						continue
+					}
+
+					x, y, z, err := gocolor.SpectralToXYZ(s, observer, spd)
+					assert.NoError(t, err)
+					assert.InDeltaf(t, tx, x, 1e-5, "%v: x is wrong for test #%v under %v", method, n+1, illuminant)
+					assert.InDeltaf(t, ty, y, 1e-5, "%v: y is wrong for test #%v under %v", method, n+1, illuminant)
+					assert.InDeltaf(t, tz, z, 1e-5, "%v: z is wrong for test #%v under %v", method, n+1, illuminant)
+				}
+			}
+		}
+	}
+}
+
+func TestRGBtoSpectral(t *testing.T) {
+	for _, method := range spectralMethods {
+		s, err := gocolor.RGBtoSpectral(1, 1, 1, gocolor.SRGB, method)
+		assert.NoError(t, err)
+		for i, v := range s {
+			assert.InDeltaf(t, 1, v, 5e-3, "%v: white is not a perfect reflector at sample #%v", method, i)
+		}
+
+		s, err = gocolor.RGBtoSpectral(0.5, 0.5, 0.5, gocolor.SRGB, method)
+		assert.NoError(t, err)
+		for i, v := range s {
+			assert.InDeltaf(t, s[0], v, 5e-3, "%v: gray is not flat at sample #%v", method, i)
+		}
+	}
+
+	_, err := gocolor.RGBtoSpectral(0, 0, 0, "invalid space", gocolor.SpectralMeng)
+	assert.Errorf(t, err, "invalid color space should return an error")
+}
+
+func BenchmarkRGBtoSpectral(b *testing.B) {
+	for _, method := range spectralMethods {
+		b.Run(method, func(b *testing.B) {
+			for i := 0; i < b.N; i++ {
+				_, _ = gocolor.RGBtoSpectral(0.8, 0.4, 0.2, gocolor.SRGB, method)
+			}
+		})
+	}
+}
@@ -0,0 +1,215 @@
+// Copyright © 2019 Xavier Basty <xavier@hexbee.net>
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package gocolor
+
+import (
+	"errors"
+	"fmt"
+	"math"
+)
+
+// Number of hue bins used for the TM-30 gamut index and graphics.
+const tm30HueBins = 16
+
+// CIECAM02 viewing conditions used by TM-30.
+const (
+	tm30LA = 100.0
+	tm30Yb = 20.0
+)
+
+// Scaling factor converting CAM02-UCS color differences to fidelity values.
+const tm30CF = 6.73
+
+// ColorFidelity holds the IES TM-30 color rendition properties of a light source.
+type ColorFidelity struct {
+	CCT  float64             // Correlated color temperature of the source, in Kelvin
+	Duv  float64             // Distance of the source to the Planckian locus, in the CIE 1960 UCS
+	Rf   float64             // Fidelity index, from 0 to 100
+	Rg   float64             // Gamut index, 100 when the source matches the reference gamut area
+	Rfi  []float64           // Fidelity index of each color evaluation sample
+	Bins [tm30HueBins]HueBin // Hue bins, by increasing hue angle of the reference
+}
+
+// HueBin holds the TM-30 values of the samples whose hue under the reference
+// illuminant falls in a 22.5° wide bin.
+type HueBin struct {
+	Rf          float64    // Local fidelity index
+	ChromaShift float64    // Relative chroma change of the average sample (Rcs)
+	HueShift    float64    // Hue angle change of the average sample (Rhs), in radians
+	Reference   [2]float64 // Average CAM02-UCS a' and b' coordinates under the reference illuminant
+	Test        [2]float64 // Average CAM02-UCS a' and b' coordinates under the source
+	Samples     int        // Number of samples in the bin
+}
+
+// ColorFidelityIndex computes the IES TM-30 (CIE 224:2017) fidelity and gamut
+// indices of a light source from its spectral distribution.
+//
+// The standard uses a set of 99 color evaluation samples (CES), which must be
+// provided resampled on the 340nm-830nm range, with 10nm steps. Other sample
+// sets can be used for custom evaluations, but every hue bin must contain at
+// least one sample.
+//
+// The CES reflectances are not bundled with the package: they must be taken
+// from the IES TM-30 or CIE 224:2017 tables. The indices only match the
+// published values of the standard when computed with these samples.
+//
+// The reference illuminant is a Planckian radiator below 4000K, a CIE daylight
+// illuminant above 5000K, and a blend of both in between. The samples are
+// compared in the CAM02-UCS color space for the 10° standard observer.
+//
+// See https://www.ies.org/standards/standards-theory/tm-30 for more information.
+func ColorFidelityIndex(spd []float64, samples []SpectralColor) (ColorFidelity, error) {
+	var cf ColorFidelity
+
+	if len(samples) == 0 {
+		return cf, errors.New("no color evaluation sample")
+	}
+
+	test, err := spectralWeights(Observer10, spd)
+	if err != nil {
+		return cf, err
+	}
+
+	cf.CCT, cf.Duv, err = SpectralToCCT(spd)
+	if err != nil {
+		return cf, err
+	}
+
+	refSPD, err := tm30Reference(cf.CCT)
+	if err != nil {
+		return cf, err
+	}
+	ref, err := spectralWeights(Observer10, refSPD)
+	if err != nil {
+		return cf, err
+	}
+
+	camTest := newCAM02(test.white().vscale(100), tm30LA, tm30Yb, 1)
+	camRef := newCAM02(ref.white().vscale(100), tm30LA, tm30Yb, 1)
+
+	var (
+		binDE [tm30HueBins]float64
+		sumDE float64
+	)
+	cf.Rfi = make([]float64, len(samples))
+	for i, sample := range samples {
+		if len(sample) != spectralSamples {
+			return cf, fmt.Errorf("mismatching spectral sampling length for sample %v", i)
+		}
+
+		t := camTest.ucs(test.xyz(sample).vscale(100))
+		r := camRef.ucs(ref.xyz(sample).vscale(100))
+
+		de := math.Sqrt(math.Pow(t.v0-r.v0, 2) + math.Pow(t.v1-r.v1, 2) + math.Pow(t.v2-r.v2, 2))
+		cf.Rfi[i] = tm30Fidelity(de)
+		sumDE += de
+
+		h := math.Atan2(r.v2, r.v1)
+		if h < 0 {
+			h += 2 * math.Pi
+		}
+		k := int(h / (2 * math.Pi / tm30HueBins))
+		if k >= tm30HueBins {
+			k = tm30HueBins - 1
+		}
+
+		bin := &cf.Bins[k]
+		bin.Samples++
+		bin.Reference[0] += r.v1
+		bin.Reference[1] += r.v2
+		bin.Test[0] += t.v1
+		bin.Test[1] += t.v2
+		binDE[k] += de
+	}
+
+	cf.Rf = tm30Fidelity(sumDE / float64(len(samples)))
+
+	for k := range cf.Bins {
+		bin := &cf.Bins[k]
+		if bin.Samples == 0 {
+			return cf, fmt.Errorf("no color evaluation sample in hue bin %v", k+1)
+		}
+
+		n := float64(bin.Samples)
+		bin.Reference[0] /= n
+		bin.Reference[1] /= n
+		bin.Test[0] /= n
+		bin.Test[1] /= n
+		bin.Rf = tm30Fidelity(binDE[k] / n)
+
+		cRef := math.Hypot(bin.Reference[0], bin.Reference[1])
+		hRef := math.Atan2(bin.Reference[1], bin.Reference[0])
+		hTest := math.Atan2(bin.Test[1], bin.Test[0])
+
+		bin.ChromaShift = (math.Hypot(bin.Test[0], bin.Test[1]) - cRef) / cRef
+		bin.HueShift = math.Remainder(hTest-hRef, 2*math.Pi)
+	}
+
+	// Shoelace formula on the polygons joining the averages of the bins.
+	var areaRef, areaTest float64
+	for k := range cf.Bins {
+		a, b := cf.Bins[k], cf.Bins[(k+1)%tm30HueBins]
+		areaRef += a.Reference[0]*b.Reference[1] - b.Reference[0]*a.Reference[1]
+		areaTest += a.Test[0]*b.Test[1] - b.Test[0]*a.Test[1]
+	}
+	cf.Rg = 100 * areaTest / areaRef
+
+	return cf, nil
+}
+
+////////////////////////////////////////
+
+// tm30Fidelity converts an average CAM02-UCS color difference to a fidelity
+// value, rescaled to stay in the [0, 100] range.
+func tm30Fidelity(de float64) float64 {
+	return 10 * math.Log(math.Exp((100-tm30CF*de)/10)+1)
+}
+
+// tm30Reference computes the spectral distribution of the TM-30 reference illuminant.
+func tm30Reference(cct float64) ([]float64, error) {
+	if cct < 4000 {
+		return PlanckianSpectrum(cct)
+	}
+	if cct > 5000 {
+		return DaylightSpectrum(math.Min(cct, 25000))
+	}
+
+	planck, err := PlanckianSpectrum(cct)
+	if err != nil {
+		return nil, err
+	}
+	daylight, err := DaylightSpectrum(cct)
+	if err != nil {
+		return nil, err
+	}
+
+	// Both illuminants are normalized to the same luminance before blending.
+	luminance := func(spd []float64) float64 {
+		var y float64
+		for i := range spd {
+			y += stdObs10Y[i] * spd[i]
+		}
+		return y
+	}
+	yp, yd := luminance(planck), luminance(daylight)
+
+	m := (cct - 4000) / 1000
+	spd := make([]float64, spectralSamples)
+	for i := range spd {
+		spd[i] = (1-m)*planck[i]/yp + m*daylight[i]/yd
+	}
+
+	return spd, nil
+}
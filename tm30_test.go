@@ -0,0 +1,128 @@
+// Copyright © 2019 Xavier Basty <xavier@hexbee.net>
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package gocolor_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/Hexbee-net/gocolor"
+)
+
+// hueCircleSamples builds reflectances spread over the hue circle, standing in
+// for the TM-30 color evaluation samples.
+func hueCircleSamples(t *testing.T) []gocolor.SpectralColor {
+	var samples []gocolor.SpectralColor
+	for h := 0.0; h < 360; h += 5 {
+		r, g, b, err := gocolor.HSLtoRGB(h, 0.5, 0.5)
+		assert.NoError(t, err)
+		s, err := gocolor.RGBtoSpectral(r, g, b, gocolor.SRGB, gocolor.SpectralMeng)
+		assert.NoError(t, err)
+		samples = append(samples, s)
+	}
+	return samples
+}
+
+func TestXYZtoCAM02UCS(t *testing.T) {
+	// The adaptation to the white point is incomplete at 64 cd/m²,
+	// so the white keeps a slight residual chroma.
+	j, a, b, err := gocolor.XYZtoCAM02UCS(0.9642, 1, 0.8251, gocolor.Observer2, gocolor.RefIlluminantD50)
+	assert.NoError(t, err)
+	assert.InDelta(t, 100, j, 1e-3)
+	assert.InDelta(t, 0, a, 2)
+	assert.InDelta(t, 0, b, 2)
+
+	j, a, _, err = gocolor.XYZtoCAM02UCS(0.2, 0.1, 0.05, gocolor.Observer2, gocolor.RefIlluminantD50)
+	assert.NoError(t, err)
+	assert.Less(t, j, 100.0)
+	assert.Greater(t, a, 10.0)
+
+	_, _, _, err = gocolor.XYZtoCAM02UCS(0, 0, 2, gocolor.Observer2, gocolor.RefIlluminantD50)
+	assert.Error(t, err)
+}
+
+func TestColorFidelityIndex(t *testing.T) {
+	samples := hueCircleSamples(t)
+
+	cf, err := gocolor.ColorFidelityIndex(gocolor.IlluminantsSpectres[gocolor.RefIlluminantD65], samples)
+	assert.NoError(t, err)
+	assert.InDelta(t, 100, cf.Rf, 0.5)
+	assert.InDelta(t, 100, cf.Rg, 0.5)
+	assert.Len(t, cf.Rfi, len(samples))
+	for k, bin := range cf.Bins {
+		assert.Truef(t, bin.Samples > 0, "hue bin %v is empty", k+1)
+		assert.InDeltaf(t, 0, bin.ChromaShift, 0.01, "chroma shift is wrong for hue bin %v", k+1)
+		assert.InDeltaf(t, 0, bin.HueShift, 0.01, "hue shift is wrong for hue bin %v", k+1)
+	}
+
+	// The expected values were recomputed with an independent implementation
+	// of CIE 224:2017 for the same samples, at the same 10nm sampling.
+	// They differ from the published TM-30 values, which use the 99 CES.
+	tests := []struct {
+		illuminant string
+		cct        float64
+		rf, rg     float64
+		binRf      [16]float64
+		binRcs     [16]float64
+	}{
+		{
+			gocolor.RefIlluminantF2, 3918.089, 64.9964, 83.7779,
+			[16]float64{49.55, 43.82, 56.67, 73.29, 86.24, 74.16, 59.12, 60.31, 62.40, 58.62, 63.07, 78.31, 81.62, 69.60, 59.55, 58.55},
+			[16]float64{-0.2006, -0.1684, -0.0619, 0.0391, 0.0520, 0.0030, -0.0682, -0.1683, -0.1808, -0.1125, -0.0227, 0.0282, 0.0350, 0.0072, -0.0609, -0.1482},
+		},
+		{
+			gocolor.RefIlluminantF7, 5857.279, 92.6958, 96.5071,
+			[16]float64{89.54, 88.90, 91.94, 95.36, 97.68, 92.83, 90.87, 91.53, 92.38, 92.36, 93.83, 96.51, 94.32, 91.35, 90.44, 90.88},
+			[16]float64{-0.0414, -0.0367, -0.0132, 0.0049, 0.0061, -0.0065, -0.0236, -0.0393, -0.0402, -0.0253, -0.0052, 0.0058, 0.0058, -0.0043, -0.0189, -0.0346},
+		},
+		{
+			gocolor.RefIlluminantF11, 3523.582, 82.8189, 103.2113,
+			[16]float64{90.42, 91.81, 87.54, 81.75, 79.42, 80.40, 82.02, 88.22, 92.86, 89.78, 78.87, 69.10, 71.23, 79.98, 84.50, 87.29},
+			[16]float64{-0.0036, -0.0235, -0.0192, 0.0391, 0.0761, 0.0812, 0.0547, 0.0069, -0.0260, -0.0318, -0.0116, 0.0162, 0.0440, 0.0631, 0.0545, 0.0270},
+		},
+	}
+	for _, test := range tests {
+		cf, err := gocolor.ColorFidelityIndex(gocolor.IlluminantsSpectres[test.illuminant], samples)
+		assert.NoError(t, err, test.illuminant)
+		assert.InDelta(t, test.cct, cf.CCT, 1e-3, test.illuminant)
+		assert.InDelta(t, test.rf, cf.Rf, 1e-4, test.illuminant)
+		assert.InDelta(t, test.rg, cf.Rg, 1e-4, test.illuminant)
+		for k, bin := range cf.Bins {
+			assert.InDeltaf(t, test.binRf[k], bin.Rf, 5e-3, "%v: Rf is wrong for hue bin %v", test.illuminant, k+1)
+			assert.InDeltaf(t, test.binRcs[k], bin.ChromaShift, 5e-5, "%v: Rcs is wrong for hue bin %v", test.illuminant, k+1)
+		}
+		for _, rfi := range cf.Rfi {
+			assert.True(t, rfi >= 0 && rfi <= 100, test.illuminant)
+		}
+	}
+}
+
+func TestColorFidelityIndexErrors(t *testing.T) {
+	d65 := gocolor.IlluminantsSpectres[gocolor.RefIlluminantD65]
+
+	_, err := gocolor.ColorFidelityIndex(d65, nil)
+	assert.Error(t, err)
+
+	// All the samples fall in the same hue bin.
+	_, err = gocolor.ColorFidelityIndex(d65, hueCircleSamples(t)[:1])
+	assert.Error(t, err)
+
+	_, err = gocolor.ColorFidelityIndex(d65, []gocolor.SpectralColor{{1, 2, 3}})
+	assert.Error(t, err)
+
+	_, err = gocolor.ColorFidelityIndex([]float64{1, 2, 3}, hueCircleSamples(t))
+	assert.Error(t, err)
+}
@@ -0,0 +1,147 @@
+// Copyright © 2019 Xavier Basty <xavier@hexbee.net>
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package gocolor
+
+import (
+	"fmt"
+)
+
+// Transform is a precompiled conversion between two color spaces.
+//
+// The color space matrices and the chromatic adaptation are fused into a
+// single matrix, and the transfer functions are resolved when the transform
+// is created. A transform is immutable, and can be used concurrently from
+// multiple goroutines.
+type Transform struct {
+	pc *pixelConverter
+}
+
+// NewTransform creates a transform between two color spaces, which are either
+// RGB color spaces, CIEXYZ, CIELab or Oklab.
+//
+// The observer and illuminant are the reference white of the CIEXYZ and CIELab
+// coordinates, the RGB color spaces use their own white point, and Oklab is
+// relative to illuminant D65. The adaptation method is used between differing
+// white points, and defaults to ChromaBradford.
+//
+// Colors out of the gamut of a destination RGB color space are not clipped.
+func NewTransform(src, dst string, observer int, illuminant string, adaptation string) (*Transform, error) {
+	wp, err := getWhitePoint(observer, illuminant)
+	if err != nil {
+		return nil, err
+	}
+
+	pc, err := newPixelConverterWhite(src, dst, *wp, adaptation, GamutNone)
+	if err != nil {
+		return nil, err
+	}
+
+	return &Transform{pc: pc}, nil
+}
+
+// NewTransformWithIntent creates a transform between two color spaces for a
+// rendering intent, see NewTransform.
+//
+// Colors out of the gamut of a destination RGB color space are brought into
+// the gamut as the intent specifies, and the black point compensation is
+// applied if bpc is set or the intent is IntentPerceptual.
+//
+// The black point of a space is the color of its zero coordinates. It is the
+// XYZ origin for all the built-in spaces, so the compensation only changes
+// conversions from or to registered RGB spaces whose transfer curves do not
+// start at zero. Black points of ICC profiles are compensated by
+// icc.NewLink.
+func NewTransformWithIntent(src, dst string, observer int, illuminant, adaptation, intent string, bpc bool) (*Transform, error) {
+	wp, err := getWhitePoint(observer, illuminant)
+	if err != nil {
+		return nil, err
+	}
+
+	pc, err := newPixelConverterIntent(src, dst, *wp, adaptation, "", intent, bpc)
+	if err != nil {
+		return nil, err
+	}
+
+	return &Transform{pc: pc}, nil
+}
+
+// Apply converts a color.
+func (t *Transform) Apply(c0, c1, c2 float64) (float64, float64, float64) {
+	v := t.pc.convert(vector{c0, c1, c2})
+	return v.v0, v.v1, v.v2
+}
+
+// ApplySlice converts interleaved colors, storing three coordinates per color.
+// The destination can be the source itself.
+func (t *Transform) ApplySlice(dst, src []float64) error {
+	if err := checkInterleaved(len(dst), len(src)); err != nil {
+		return err
+	}
+
+	for i := 0; i+2 < len(src); i += 3 {
+		v := t.pc.convert(vector{src[i], src[i+1], src[i+2]})
+		dst[i], dst[i+1], dst[i+2] = v.v0, v.v1, v.v2
+	}
+
+	return nil
+}
+
+// ApplySlice32 converts interleaved colors, storing three coordinates per color.
+// The destination can be the source itself.
+func (t *Transform) ApplySlice32(dst, src []float32) error {
+	if err := checkInterleaved(len(dst), len(src)); err != nil {
+		return err
+	}
+
+	for i := 0; i+2 < len(src); i += 3 {
+		v := t.pc.convert(vector{float64(src[i]), float64(src[i+1]), float64(src[i+2])})
+		dst[i], dst[i+1], dst[i+2] = float32(v.v0), float32(v.v1), float32(v.v2)
+	}
+
+	return nil
+}
+
+// ApplySliceAlpha converts interleaved colors, storing three coordinates and
+// an alpha per color. The alpha is kept as is. When premultiplied is set, the
+// coordinates are multiplied by the alpha, and are divided by it before the
+// conversion. The destination can be the source itself.
+func (t *Transform) ApplySliceAlpha(dst, src []float64, premultiplied bool) error {
+	if len(src)%4 != 0 {
+		return fmt.Errorf("source length is not a multiple of 4 (%v)", len(src))
+	}
+	if len(dst) < len(src) {
+		return fmt.Errorf("destination is shorter than the source (%v < %v)", len(dst), len(src))
+	}
+
+	for i := 0; i+3 < len(src); i += 4 {
+		alpha := src[i+3]
+		v := vector{src[i], src[i+1], src[i+2]}
+		if premultiplied {
+			if alpha == 0 {
+				dst[i], dst[i+1], dst[i+2], dst[i+3] = 0, 0, 0, 0
+				continue
+			}
+			v = v.vscale(1 / alpha)
+		}
+
+		v = t.pc.convert(v)
+		if premultiplied {
+			v = v.vscale(alpha)
+		}
+		dst[i], dst[i+1], dst[i+2], dst[i+3] = v.v0, v.v1, v.v2, alpha
+	}
+
+	return nil
+}
@@ -0,0 +1,117 @@
+// Copyright © 2019 Xavier Basty <xavier@hexbee.net>
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package gocolor_test
+
+import (
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/Hexbee-net/gocolor"
+)
+
+func TestTransform(t *testing.T) {
+	tr, err := gocolor.NewTransform(gocolor.SRGB, gocolor.CIEXYZ, gocolor.Observer2, gocolor.RefIlluminantD65, "")
+	assert.NoError(t, err)
+	x, y, z := tr.Apply(0.2, 0.5, 0.8)
+	ex, ey, ez, err := gocolor.RGBtoXYZ(0.2, 0.5, 0.8, gocolor.SRGB)
+	assert.NoError(t, err)
+	assert.InDelta(t, ex, x, 1e-12)
+	assert.InDelta(t, ey, y, 1e-12)
+	assert.InDelta(t, ez, z, 1e-12)
+
+	// The white of sRGB is adapted to the white of the Lab coordinates.
+	tr, err = gocolor.NewTransform(gocolor.SRGB, gocolor.CIELab, gocolor.Observer2, gocolor.RefIlluminantD50, gocolor.ChromaBradford)
+	assert.NoError(t, err)
+	l, a, bb := tr.Apply(1, 1, 1)
+	assert.InDelta(t, 100, l, 1e-2)
+	assert.InDelta(t, 0, a, 1e-2)
+	assert.InDelta(t, 0, bb, 1e-2)
+
+	// Out of gamut colors are not clipped.
+	tr, err = gocolor.NewTransform(gocolor.ProPhotoRGB, gocolor.SRGB, gocolor.Observer2, gocolor.RefIlluminantD65, "")
+	assert.NoError(t, err)
+	r, g, b := tr.Apply(0.2, 0.8, 0.1)
+	assert.True(t, r < 0 || g > 1 || b < 0)
+}
+
+func TestTransformApplySlice(t *testing.T) {
+	tr, err := gocolor.NewTransform(gocolor.AdobeRGB, gocolor.Oklab, gocolor.Observer2, gocolor.RefIlluminantD65, "")
+	assert.NoError(t, err)
+
+	pix := bulkPixels(1000)
+	src := make([]float64, len(pix))
+	src32 := make([]float32, len(pix))
+	for i, v := range pix {
+		src[i] = float64(v) / 0xff
+		src32[i] = float32(src[i])
+	}
+
+	expected := make([]float64, len(src))
+	for i := 0; i < len(src); i += 3 {
+		expected[i], expected[i+1], expected[i+2] = tr.Apply(src[i], src[i+1], src[i+2])
+	}
+
+	// Concurrent use, converting in place.
+	var wg sync.WaitGroup
+	for n := 0; n < 8; n++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			buf := append([]float64(nil), src...)
+			assert.NoError(t, tr.ApplySlice(buf, buf))
+			assert.Equal(t, expected, buf)
+		}()
+	}
+	wg.Wait()
+
+	dst32 := make([]float32, len(src32))
+	assert.NoError(t, tr.ApplySlice32(dst32, src32))
+	for i := range dst32 {
+		assert.InDelta(t, expected[i], float64(dst32[i]), 1e-5)
+	}
+
+	assert.Error(t, tr.ApplySlice(src[:3], src))
+	assert.Error(t, tr.ApplySlice32(dst32, src32[:4]))
+}
+
+func TestNewTransform_InvalidParameters(t *testing.T) {
+	_, err := gocolor.NewTransform(gocolor.SRGB, gocolor.CIELab, 5, gocolor.RefIlluminantD65, "")
+	assert.Error(t, err)
+	_, err = gocolor.NewTransform(gocolor.SRGB, gocolor.CIELab, gocolor.Observer2, "unknown", "")
+	assert.Error(t, err)
+	_, err = gocolor.NewTransform("unknown", gocolor.CIELab, gocolor.Observer2, gocolor.RefIlluminantD65, "")
+	assert.Error(t, err)
+	_, err = gocolor.NewTransform(gocolor.SRGB, "unknown", gocolor.Observer2, gocolor.RefIlluminantD65, "")
+	assert.Error(t, err)
+	_, err = gocolor.NewTransform(gocolor.SRGB, gocolor.CIELab, gocolor.Observer2, gocolor.RefIlluminantD65, "unknown")
+	assert.Error(t, err)
+}
+
+func BenchmarkTransformApplySlice(b *testing.B) {
+	tr, _ := gocolor.NewTransform(gocolor.SRGB, gocolor.CIEXYZ, gocolor.Observer2, gocolor.RefIlluminantD65, "")
+	pix := bulkPixels(benchPixels)
+	src := make([]float64, len(pix))
+	for i, v := range pix {
+		src[i] = float64(v) / 0xff
+	}
+	dst := make([]float64, len(src))
+	b.SetBytes(int64(len(pix)))
+	b.ResetTimer()
+	for n := 0; n < b.N; n++ {
+		_ = tr.ApplySlice(dst, src)
+	}
+}
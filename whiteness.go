@@ -0,0 +1,170 @@
+// Copyright © 2019 Xavier Basty <xavier@hexbee.net>
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package gocolor
+
+import (
+	"errors"
+	"fmt"
+)
+
+// GanzGriesser stores the coefficients of the Ganz-Griesser whiteness and tint
+// formulas, which are calibrated for each instrument:
+//
+//	W = D·Y + P·x + Q·y + C
+//	T = M·x + N·y + K
+type GanzGriesser struct {
+	D, P, Q, C float64 // Whiteness coefficients
+	M, N, K    float64 // Tint coefficients
+}
+
+// GanzGriesserDefault holds the nominal Ganz-Griesser coefficients for
+// illuminant D65 and the 10° standard observer, for uncalibrated instruments.
+var GanzGriesserDefault = GanzGriesser{
+	D: 1, P: -1868.322, Q: -3695.690, C: 1809.441,
+	M: -1001.223, N: 748.366, K: 68.261,
+}
+
+// Yellowness coefficients of ASTM E313, by observer and illuminant.
+var yellownessCoefficients = map[int]map[string][2]float64{
+	Observer2: {
+		RefIlluminantC:   {1.2769, 1.0592},
+		RefIlluminantD65: {1.2985, 1.1335},
+	},
+	Observer10: {
+		RefIlluminantC:   {1.2871, 1.0781},
+		RefIlluminantD65: {1.3013, 1.1498},
+	},
+}
+
+// WhitenessRangeError is returned with the whiteness and tint indices of
+// colors out of the validity range of the CIE formulas, which only apply to
+// near whites with a whiteness between 40 and 5·Y-280, and a tint between -3
+// and 3.
+type WhitenessRangeError struct {
+	W, T float64 // Whiteness and tint indices
+	Y    float64 // Luminance, in the [0, 100] range
+}
+
+func (e *WhitenessRangeError) Error() string {
+	if e.T < -3 || e.T > 3 {
+		return fmt.Sprintf("tint is out of the [-3, 3] range (%v)", e.T)
+	}
+	return fmt.Sprintf("whiteness is out of the [40, %v] range (%v)", 5*e.Y-280, e.W)
+}
+
+// CIEWhiteness computes the CIE whiteness and tint indices of a color
+// from its XYZ coordinates under illuminant D65, as defined by ISO 11475.
+//
+// The whiteness of a perfect reflecting diffuser is 100, and higher values
+// indicate bluish whites. Negative tints indicate reddish whites and positive
+// ones greenish whites.
+// The formulas are only meaningful for whites with a whiteness between 40 and
+// 5·Y-280, and a tint between -3 and 3. Out of this range, the indices are
+// returned with a *WhitenessRangeError.
+func CIEWhiteness(x, y, z float64, observer int) (w, t float64, err error) {
+	return whitenessTint(x, y, z, observer, RefIlluminantD65)
+}
+
+// ASTME313Whiteness computes the ASTM E313 whiteness and tint indices of a
+// color from its XYZ coordinates, for illuminants D65 or C.
+// It uses the same formulas and validity range as CIEWhiteness, with the
+// chromaticity of the perfect reflecting diffuser under the given illuminant.
+func ASTME313Whiteness(x, y, z float64, observer int, illuminant string) (w, t float64, err error) {
+	if illuminant != RefIlluminantD65 && illuminant != RefIlluminantC {
+		return 0, 0, fmt.Errorf("unsupported illuminant: %v", illuminant)
+	}
+
+	return whitenessTint(x, y, z, observer, illuminant)
+}
+
+// ASTME313Yellowness computes the ASTM E313 yellowness index of a color from
+// its XYZ coordinates, for illuminants D65 or C.
+// The yellowness of a perfect reflecting diffuser is 0, and negative values
+// indicate bluish colors.
+func ASTME313Yellowness(x, y, z float64, observer int, illuminant string) (float64, error) {
+	if err := checkWhitenessXYZ(x, y, z); err != nil {
+		return 0, err
+	}
+
+	obsCoefs, ok := yellownessCoefficients[observer]
+	if !ok {
+		return 0, fmt.Errorf("unrecognized observer angle: %v", observer)
+	}
+	c, ok := obsCoefs[illuminant]
+	if !ok {
+		return 0, fmt.Errorf("unsupported illuminant: %v", illuminant)
+	}
+
+	return 100 * (c[0]*x - c[1]*z) / y, nil
+}
+
+// GanzGriesserWhiteness computes the Ganz-Griesser whiteness and tint indices
+// of a color from its XYZ coordinates, for illuminant D65 and the 10° standard
+// observer, using the given instrument coefficients.
+func GanzGriesserWhiteness(x, y, z float64, coefs GanzGriesser) (w, t float64, err error) {
+	if err := checkWhitenessXYZ(x, y, z); err != nil {
+		return 0, 0, err
+	}
+
+	cx, cy := x/(x+y+z), y/(x+y+z)
+
+	w = coefs.D*100*y + coefs.P*cx + coefs.Q*cy + coefs.C
+	t = coefs.M*cx + coefs.N*cy + coefs.K
+
+	return w, t, nil
+}
+
+////////////////////////////////////////
+
+func whitenessTint(x, y, z float64, observer int, illuminant string) (w, t float64, err error) {
+	if err := checkWhitenessXYZ(x, y, z); err != nil {
+		return 0, 0, err
+	}
+
+	wp, err := getWhitePoint(observer, illuminant)
+	if err != nil {
+		return 0, 0, err
+	}
+
+	xn, yn := wp.v0/(wp.v0+wp.v1+wp.v2), wp.v1/(wp.v0+wp.v1+wp.v2)
+	cx, cy := x/(x+y+z), y/(x+y+z)
+
+	w = 100*y + 800*(xn-cx) + 1700*(yn-cy)
+
+	if observer == Observer2 {
+		t = 1000*(xn-cx) - 650*(yn-cy)
+	} else {
+		t = 900*(xn-cx) - 650*(yn-cy)
+	}
+
+	if w <= 40 || w >= 5*100*y-280 || t <= -3 || t >= 3 {
+		return w, t, &WhitenessRangeError{W: w, T: t, Y: 100 * y}
+	}
+
+	return w, t, nil
+}
+
+// checkWhitenessXYZ checks the XYZ coordinates of a white sample. They are not
+// bounded by 1, since fluorescent whitening agents can make samples brighter
+// than the perfect reflecting diffuser.
+func checkWhitenessXYZ(x, y, z float64) error {
+	if x < 0 || y < 0 || z < 0 {
+		return errors.New("XYZ coordinates are negative")
+	}
+	if y == 0 {
+		return errors.New("luminance (Y) is zero")
+	}
+	return nil
+}
@@ -0,0 +1,124 @@
+// Copyright © 2019 Xavier Basty <xavier@hexbee.net>
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package gocolor_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/Hexbee-net/gocolor"
+)
+
+func TestCIEWhiteness(t *testing.T) {
+	// Perfect reflecting diffuser.
+	w, tint, err := gocolor.CIEWhiteness(0.95047, 1, 1.08883, gocolor.Observer2)
+	assert.NoError(t, err)
+	assert.InDelta(t, 100, w, 1e-3)
+	assert.InDelta(t, 0, tint, 1e-3)
+
+	w, tint, err = gocolor.CIEWhiteness(0.9481, 1, 1.073, gocolor.Observer10)
+	assert.NoError(t, err)
+	assert.InDelta(t, 100, w, 1e-3)
+	assert.InDelta(t, 0, tint, 1e-3)
+
+	// Bluish white, as obtained with fluorescent whitening agents.
+	w, _, err = gocolor.CIEWhiteness(0.9, 0.94, 1.15, gocolor.Observer10)
+	assert.NoError(t, err)
+	assert.Greater(t, w, 100.0)
+
+	// Colors out of the validity range of the formulas.
+	tests := []struct {
+		x, y, z float64
+	}{
+		{0.3, 0.32, 0.33}, // too dark, W < 40
+		{0.75, 0.78, 1.0}, // too blue, W > 5Y-280
+		{0.93, 1, 1},      // too green, T > 3
+		{0.82, 0.8, 1.1},  // too red, T < -3
+		{0.8, 0.82, 0.6},  // yellowish
+	}
+	for n := 0; n < len(tests); n++ {
+		w, tint, err = gocolor.CIEWhiteness(tests[n].x, tests[n].y, tests[n].z, gocolor.Observer10)
+		if assert.Errorf(t, err, "no error for test #%v", n+1) {
+			rangeErr, ok := err.(*gocolor.WhitenessRangeError)
+			if assert.Truef(t, ok, "wrong error type for test #%v", n+1) {
+				assert.Equal(t, w, rangeErr.W)
+				assert.Equal(t, tint, rangeErr.T)
+			}
+		}
+	}
+
+	_, _, err = gocolor.CIEWhiteness(-0.1, 1, 1, gocolor.Observer2)
+	assert.Error(t, err)
+	_, _, err = gocolor.CIEWhiteness(0.5, 0, 0.5, gocolor.Observer2)
+	assert.Error(t, err)
+	_, _, err = gocolor.CIEWhiteness(0.9, 1, 1, 5)
+	assert.Error(t, err)
+}
+
+func TestASTME313Whiteness(t *testing.T) {
+	w, tint, err := gocolor.ASTME313Whiteness(0.98074, 1, 1.18232, gocolor.Observer2, gocolor.RefIlluminantC)
+	assert.NoError(t, err)
+	assert.InDelta(t, 100, w, 1e-3)
+	assert.InDelta(t, 0, tint, 1e-3)
+
+	_, _, err = gocolor.ASTME313Whiteness(0.98074, 1, 1.18232, gocolor.Observer10, gocolor.RefIlluminantC)
+	assert.Error(t, err)
+	_, _, err = gocolor.ASTME313Whiteness(0.9, 1, 1, gocolor.Observer2, gocolor.RefIlluminantA)
+	assert.Error(t, err)
+}
+
+func TestASTME313Yellowness(t *testing.T) {
+	tests := []struct {
+		observer   int
+		illuminant string
+		x, y, z    float64
+	}{
+		{gocolor.Observer2, gocolor.RefIlluminantD65, 0.95047, 1, 1.08883},
+		{gocolor.Observer10, gocolor.RefIlluminantD65, 0.9481, 1, 1.073},
+		{gocolor.Observer2, gocolor.RefIlluminantC, 0.98074, 1, 1.18232},
+	}
+
+	for n := 0; n < len(tests); n++ {
+		yi, err := gocolor.ASTME313Yellowness(tests[n].x, tests[n].y, tests[n].z, tests[n].observer, tests[n].illuminant)
+		assert.NoError(t, err)
+		assert.InDeltaf(t, 0, yi, 0.1, "yellowness of white is wrong for %v/%v", tests[n].illuminant, tests[n].observer)
+	}
+
+	yi, err := gocolor.ASTME313Yellowness(0.8, 0.82, 0.6, gocolor.Observer2, gocolor.RefIlluminantD65)
+	assert.NoError(t, err)
+	assert.Greater(t, yi, 20.0)
+
+	_, err = gocolor.ASTME313Yellowness(0.8, 0.82, 0.6, gocolor.Observer2, gocolor.RefIlluminantA)
+	assert.Error(t, err)
+	_, err = gocolor.ASTME313Yellowness(0.8, 0.82, 0.6, 5, gocolor.RefIlluminantD65)
+	assert.Error(t, err)
+	_, err = gocolor.ASTME313Yellowness(0.8, 0, 0.6, gocolor.Observer2, gocolor.RefIlluminantD65)
+	assert.Error(t, err)
+}
+
+func TestGanzGriesserWhiteness(t *testing.T) {
+	w, tint, err := gocolor.GanzGriesserWhiteness(0.9481, 1, 1.073, gocolor.GanzGriesserDefault)
+	assert.NoError(t, err)
+	assert.InDelta(t, 100, w, 1)
+	assert.InDelta(t, 0, tint, 2)
+
+	yellowish, _, err := gocolor.GanzGriesserWhiteness(0.8, 0.82, 0.6, gocolor.GanzGriesserDefault)
+	assert.NoError(t, err)
+	assert.Less(t, yellowish, w)
+
+	_, _, err = gocolor.GanzGriesserWhiteness(0.9, -1, 1, gocolor.GanzGriesserDefault)
+	assert.Error(t, err)
+}